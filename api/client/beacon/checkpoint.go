@@ -8,8 +8,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -55,6 +57,11 @@ func (o *OriginData) BlockBytes() []byte {
 	return o.bb
 }
 
+// Config returns the chain config detected from the fork version of the downloaded BeaconState.
+func (o *OriginData) Config() *params.BeaconChainConfig {
+	return o.vu.Config
+}
+
 func fname(prefix string, vu *detect.VersionedUnmarshaler, slot types.Slot, root [32]byte) string {
 	return fmt.Sprintf("%s_%s_%s_%d-%#x.ssz", prefix, vu.Config.ConfigName, version.String(vu.Fork), slot, root)
 }
@@ -103,6 +110,12 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	log.Printf("BeaconState slot=%d, Block slot=%d", s.Slot(), b.Block().Slot())
 	log.Printf("BeaconState htr=%#xd, Block state_root=%#x", sr, b.Block().StateRoot())
 	log.Printf("BeaconState latest_block_header htr=%#xd, block htr=%#x", br, realBlockRoot)
+	if realBlockRoot != br {
+		return nil, errors.Wrapf(errCheckpointBlockMismatch, "state latest_block_header htr=%#x, block htr=%#x", br, realBlockRoot)
+	}
+	if sr != bytesutil.ToBytes32(b.Block().StateRoot()) {
+		return nil, errors.Wrapf(errCheckpointStateMismatch, "state htr=%#x, block state_root=%#x", sr, b.Block().StateRoot())
+	}
 	return &OriginData{
 		st: s,
 		b:  b,
@@ -156,6 +169,12 @@ const (
 // errUnsupportedPrysmCheckpointVersion indicates remote beacon node can't be used for checkpoint retrieval.
 var errUnsupportedPrysmCheckpointVersion = errors.New("node does not meet minimum version requirements for checkpoint retrieval")
 
+// errCheckpointBlockMismatch indicates the downloaded block is not the block integrated by the downloaded state.
+var errCheckpointBlockMismatch = errors.New("checkpoint block root does not match the state latest block header")
+
+// errCheckpointStateMismatch indicates the downloaded state is not the post-state of the downloaded block.
+var errCheckpointStateMismatch = errors.New("checkpoint state root does not match the block state root")
+
 // for older endpoints or clients that do not support the weak_subjectivity api method
 // we gather the necessary data for a checkpoint sync by:
 // - inspecting the remote server's head state and computing the weak subjectivity epoch locally
@@ -485,3 +485,64 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
 }
+
+func TestDownloadFinalizedData_BlockMismatch(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.MainnetConfig().Copy()
+
+	epoch := cfg.AltairForkEpoch - 1
+	slot, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	fork, err := forkForEpoch(cfg, epoch)
+	require.NoError(t, err)
+	require.NoError(t, st.SetFork(fork))
+
+	b, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	require.NoError(t, wrapper.SetBlockParentRoot(b, cfg.ZeroHash))
+	require.NoError(t, wrapper.SetBlockSlot(b, slot))
+	require.NoError(t, wrapper.SetProposerIndex(b, 0))
+	header, err := b.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	sr, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, wrapper.SetBlockStateRoot(b, sr))
+	br, err := b.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// serve a different block than the one integrated by the state under the requested root.
+	require.NoError(t, wrapper.SetProposerIndex(b, 1))
+	mb, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	ms, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	hc := &http.Client{
+		Transport: &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			res := &http.Response{Request: req}
+			switch req.URL.Path {
+			case renderGetStatePath(IdFinalized):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(ms))
+			case renderGetBlockPath(IdFromRoot(br)):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(mb))
+			default:
+				res.StatusCode = http.StatusInternalServerError
+				res.Body = io.NopCloser(bytes.NewBufferString(""))
+			}
+
+			return res, nil
+		}},
+	}
+	c := &Client{
+		hc:      hc,
+		baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+	}
+
+	_, err = DownloadFinalizedData(ctx, c)
+	require.ErrorIs(t, err, errCheckpointBlockMismatch)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -35,6 +36,7 @@ const (
 	getForkSchedulePath     = "/eth/v1/config/fork_schedule"
 	getStatePath            = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath      = "/eth/v1/node/version"
+	getCanonicalBlocksPath  = "/eth/v1alpha1/beacon/blocks/canonical/stream"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -345,6 +347,64 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// canonicalBlockMessage is a message of the newline delimited stream of canonical blocks, holding
+// either a block or the error which ended the stream.
+type canonicalBlockMessage struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// StreamCanonicalBlocks requests the blocks of the canonical chain from the start to the end slot, and
+// calls fn with each block in slot order as it is received. This uses the Prysm API rather than the Eth
+// Beacon Node API, which has no equivalent.
+func (c *Client) StreamCanonicalBlocks(ctx context.Context, start, end types.Slot, fn func(*ethpb.CanonicalBlock) error) error {
+	u := c.baseURL.ResolveReference(&url.URL{Path: getCanonicalBlocksPath})
+	q := url.Values{}
+	q.Set("start_slot", strconv.FormatUint(uint64(start), 10))
+	q.Set("end_slot", strconv.FormatUint(uint64(end), 10))
+	u.RawQuery = q.Encode()
+	log.Printf("requesting %s", u.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if r.StatusCode != http.StatusOK {
+		return non200Err(r)
+	}
+	dec := json.NewDecoder(r.Body)
+	for {
+		msg := &canonicalBlockMessage{}
+		if err := dec.Decode(msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "error decoding canonical blocks stream")
+		}
+		if msg.Error != nil {
+			return errors.Errorf("canonical blocks stream failed with code %d: %s", msg.Error.Code, msg.Error.Message)
+		}
+		blk := &ethpb.CanonicalBlock{}
+		if err := protojson.Unmarshal(msg.Result, blk); err != nil {
+			return errors.Wrap(err, "error decoding canonical block")
+		}
+		if err := fn(blk); err != nil {
+			return err
+		}
+	}
+}
+
 func non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
 	var body string
@@ -1,10 +1,19 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestParseNodeVersion(t *testing.T) {
@@ -136,3 +145,49 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamCanonicalBlocks(t *testing.T) {
+	blks := []*ethpb.CanonicalBlock{
+		{Slot: 1, BlockRoot: bytesutil.PadTo([]byte{1}, 32), ProposerIndex: 3},
+		{Slot: 3, BlockRoot: bytesutil.PadTo([]byte{3}, 32), ExecutionBlockHash: bytesutil.PadTo([]byte{4}, 32), ProposerIndex: 5},
+	}
+	body := new(bytes.Buffer)
+	for _, b := range blks {
+		result, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(b)
+		require.NoError(t, err)
+		body.WriteString(fmt.Sprintf("{\"result\":%s}\n", result))
+	}
+	streamErr := "{\"error\":{\"code\":13,\"message\":\"Could not retrieve blocks\",\"details\":[]}}\n"
+
+	newClient := func(body string) *Client {
+		return &Client{
+			hc: &http.Client{Transport: &testRT{rt: func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, getCanonicalBlocksPath, req.URL.Path)
+				require.Equal(t, "1", req.URL.Query().Get("start_slot"))
+				require.Equal(t, "3", req.URL.Query().Get("end_slot"))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(body)),
+					Request:    req,
+				}, nil
+			}}},
+			baseURL: &url.URL{Host: "localhost:3500", Scheme: "http"},
+		}
+	}
+
+	var received []*ethpb.CanonicalBlock
+	collect := func(b *ethpb.CanonicalBlock) error {
+		received = append(received, b)
+		return nil
+	}
+	require.NoError(t, newClient(body.String()).StreamCanonicalBlocks(context.Background(), types.Slot(1), types.Slot(3), collect))
+	require.Equal(t, len(blks), len(received))
+	for i := range blks {
+		require.DeepEqual(t, blks[i], received[i])
+	}
+
+	received = nil
+	err := newClient(body.String()+streamErr).StreamCanonicalBlocks(context.Background(), types.Slot(1), types.Slot(3), collect)
+	require.ErrorContains(t, "canonical blocks stream failed with code 13: Could not retrieve blocks", err)
+	require.Equal(t, len(blks), len(received))
+}
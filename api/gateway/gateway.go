@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
 	"github.com/prysmaticlabs/prysm/runtime"
 	"github.com/rs/cors"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -53,6 +54,9 @@ type config struct {
 	pbHandlers                   []*PbMux
 	router                       *mux.Router
 	timeout                      time.Duration
+	socketPath                   string
+	socketActivation             bool
+	pathPrefix                   string
 }
 
 // Gateway is the gRPC gateway to serve HTTP JSON traffic as a proxy and forward it to the gRPC server.
@@ -120,15 +124,29 @@ func (g *Gateway) Start() {
 		})
 	}
 
+	var handler http.Handler = corsMux
+	if g.cfg.pathPrefix != "" {
+		handler = http.StripPrefix(g.cfg.pathPrefix, corsMux)
+	}
 	g.server = &http.Server{
 		Addr:              g.cfg.gatewayAddr,
-		Handler:           corsMux,
+		Handler:           handler,
 		ReadHeaderTimeout: time.Second,
 	}
 
+	listener, err := g.listen()
+	if err != nil {
+		log.WithError(err).Error("Failed to listen for gRPC gateway requests")
+		g.startFailure = err
+		return
+	}
+
 	go func() {
-		log.WithField("address", g.cfg.gatewayAddr).Info("Starting gRPC gateway")
-		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
+		log.WithFields(logrus.Fields{
+			"address":    listener.Addr().String(),
+			"pathPrefix": g.cfg.pathPrefix,
+		}).Info("Starting gRPC gateway")
+		if err := g.server.Serve(listener); err != http.ErrServerClosed {
 			log.WithError(err).Error("Failed to start gRPC gateway")
 			g.startFailure = err
 			return
@@ -4,9 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -126,3 +130,69 @@ func TestGateway_NilHandler_NotFoundHandlerRegistered(t *testing.T) {
 	g.cfg.router.ServeHTTP(writer, &http.Request{Method: "GET", Host: "localhost", URL: &url.URL{Path: "/foo"}})
 	assert.Equal(t, http.StatusNotFound, writer.Code)
 }
+
+func TestGateway_PathPrefixOption(t *testing.T) {
+	_, err := New(context.Background(), WithPathPrefix("beacon"))
+	assert.ErrorContains(t, "must start with /", err)
+
+	g, err := New(context.Background(), WithPathPrefix("/beacon/"))
+	require.NoError(t, err)
+	assert.Equal(t, "/beacon", g.cfg.pathPrefix)
+}
+
+func TestGateway_UnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "gateway.sock")
+	opts := []Option{
+		WithSocketPath(socketPath),
+		WithPathPrefix("/beacon"),
+		WithRemoteAddr("127.0.0.1:4000"),
+		WithMuxHandler(func(
+			_ *apimiddleware.ApiProxyMiddleware,
+			_ http.HandlerFunc,
+			w http.ResponseWriter,
+			r *http.Request,
+		) {
+			_, err := w.Write([]byte(r.URL.Path))
+			require.NoError(t, err)
+		}),
+	}
+	g, err := New(context.Background(), opts...)
+	require.NoError(t, err)
+	g.Start()
+	require.NoError(t, g.startFailure)
+	defer func() {
+		require.NoError(t, g.Stop())
+	}()
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(socketPermissions), info.Mode().Perm())
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+	resp, err := client.Get("http://gateway/beacon/eth/v1/node/version")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "/eth/v1/node/version", string(body))
+
+	// Requests outside of the path prefix are not served.
+	resp, err = client.Get("http://gateway/eth/v1/node/version")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGateway_SocketActivation_NoSocket(t *testing.T) {
+	t.Setenv("LISTEN_PID", "")
+	t.Setenv("LISTEN_FDS", "")
+	g, err := New(context.Background(), WithSocketActivation(true), WithRemoteAddr("127.0.0.1:4000"))
+	require.NoError(t, err)
+	g.Start()
+	assert.ErrorContains(t, "no socket passed by the service manager", g.startFailure)
+	require.NoError(t, g.Stop())
+}
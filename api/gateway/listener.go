@@ -0,0 +1,87 @@
+package gateway
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// First file descriptor of the sockets passed by the service manager, after stdin, stdout and stderr.
+const listenFdsStart = 3
+
+// Permissions of the unix domain socket, restricting access to the user and group of the process.
+const socketPermissions = 0660
+
+// listen returns the listener of the gateway: the socket passed by the service manager when socket
+// activated, a unix domain socket when a socket path is configured, or a TCP socket on the gateway
+// address otherwise.
+func (g *Gateway) listen() (net.Listener, error) {
+	switch {
+	case g.cfg.socketActivation:
+		return activatedListener()
+	case g.cfg.socketPath != "":
+		return unixListener(g.cfg.socketPath)
+	default:
+		return net.Listen("tcp", g.cfg.gatewayAddr)
+	}
+}
+
+// activatedListener returns the socket passed by the service manager following the systemd socket
+// activation protocol, in which LISTEN_PID holds the process the sockets are meant for and
+// LISTEN_FDS the number of sockets passed from file descriptor 3 on.
+func activatedListener() (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, errors.New("no socket passed by the service manager to this process")
+	}
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return nil, errors.New("no socket passed by the service manager")
+	}
+	if fds > 1 {
+		return nil, errors.Errorf("expected a single socket passed by the service manager, got %d", fds)
+	}
+	// The sockets are not meant for the child processes.
+	for _, env := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		if err := os.Unsetenv(env); err != nil {
+			return nil, err
+		}
+	}
+	f := os.NewFile(listenFdsStart, "LISTEN_FD_"+strconv.Itoa(listenFdsStart))
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close socket file")
+		}
+	}()
+	listener, err := net.FileListener(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not listen on the socket passed by the service manager")
+	}
+	return listener, nil
+}
+
+// unixListener listens on a unix domain socket at the given path, replacing the socket left over by
+// a previous run.
+func unixListener(path string) (net.Listener, error) {
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, errors.Errorf("%s already exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "could not remove previous socket")
+		}
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, socketPermissions); err != nil {
+		if closeErr := listener.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close socket")
+		}
+		return nil, errors.Wrap(err, "could not set socket permissions")
+	}
+	return listener, nil
+}
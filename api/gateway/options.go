@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -91,3 +93,36 @@ func WithTimeout(seconds uint64) Option {
 		return nil
 	}
 }
+
+// WithSocketPath serves the gateway on a unix domain socket at the given path, instead of the
+// gateway address.
+func WithSocketPath(path string) Option {
+	return func(g *Gateway) error {
+		g.cfg.socketPath = path
+		return nil
+	}
+}
+
+// WithSocketActivation serves the gateway on the socket passed by the service manager, such as a
+// systemd socket unit, instead of the gateway address.
+func WithSocketActivation(enabled bool) Option {
+	return func(g *Gateway) error {
+		g.cfg.socketActivation = enabled
+		return nil
+	}
+}
+
+// WithPathPrefix serves all the gateway endpoints under the given path prefix, for example /beacon
+// when the gateway is exposed behind a reverse proxy.
+func WithPathPrefix(prefix string) Option {
+	return func(g *Gateway) error {
+		if prefix == "" {
+			return nil
+		}
+		if !strings.HasPrefix(prefix, "/") {
+			return fmt.Errorf("path prefix %s must start with /", prefix)
+		}
+		g.cfg.pathPrefix = strings.TrimSuffix(prefix, "/")
+		return nil
+	}
+}
@@ -0,0 +1,285 @@
+package lightclient
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrInvalidPeriod is returned when an update is signed outside of the sync committee periods
+	// known to the store.
+	ErrInvalidPeriod = errors.New("update signature period not known to the store")
+	// ErrIrrelevantUpdate is returned when an update neither advances the attested header past the
+	// finalized header of the store nor carries the next sync committee of the store.
+	ErrIrrelevantUpdate = errors.New("update is not relevant to the store")
+	// ErrSyncCommitteeMismatch is returned when an update carries a next sync committee different
+	// from the one already known to the store for the same period.
+	ErrSyncCommitteeMismatch = errors.New("update next sync committee does not match the store")
+)
+
+// Store is the state of a light client following the chain from a trusted bootstrap, as the
+// LightClientStore of the light client sync protocol. The next sync committee is nil until an update
+// carrying it has been applied.
+type Store struct {
+	FinalizedHeader               *ethpbv1.BeaconBlockHeader
+	CurrentSyncCommittee          *ethpbv2.SyncCommittee
+	NextSyncCommittee             *ethpbv2.SyncCommittee
+	BestValidUpdate               *ethpbv2.LightClientUpdate
+	OptimisticHeader              *ethpbv1.BeaconBlockHeader
+	PreviousMaxActiveParticipants uint64
+	CurrentMaxActiveParticipants  uint64
+}
+
+// NewStore initializes a light client store from a bootstrap verified against the trusted block root.
+func NewStore(trustedBlockRoot [32]byte, bootstrap *Bootstrap) (*Store, error) {
+	if bootstrap == nil {
+		return nil, errors.New("nil bootstrap")
+	}
+	if err := bootstrap.Verify(trustedBlockRoot); err != nil {
+		return nil, errors.Wrap(err, "could not verify bootstrap")
+	}
+	return &Store{
+		FinalizedHeader:      bootstrap.Header,
+		CurrentSyncCommittee: bootstrap.CurrentSyncCommittee,
+		OptimisticHeader:     bootstrap.Header,
+	}, nil
+}
+
+// ProcessUpdate validates the update against the store at the current slot, and applies it when it
+// is signed by a supermajority of the sync committee and advances the finalized header or provides the
+// next sync committee. Otherwise the update is kept as the best valid update if it ranks above the
+// previous one, so that it can be forced after the update timeout.
+func (s *Store) ProcessUpdate(update *Update, currentSlot types.Slot, genesisValidatorsRoot []byte) error {
+	if update == nil || update.LightClientUpdate == nil {
+		return errors.New("nil update")
+	}
+	u := update.LightClientUpdate
+	if err := s.validateUpdate(u, currentSlot, genesisValidatorsRoot); err != nil {
+		return err
+	}
+
+	if s.BestValidUpdate == nil || IsBetterUpdate(u, s.BestValidUpdate) {
+		s.BestValidUpdate = u
+	}
+
+	participants := u.SyncAggregate.SyncCommitteeBits.Count()
+	if participants > s.CurrentMaxActiveParticipants {
+		s.CurrentMaxActiveParticipants = participants
+	}
+	if participants > s.safetyThreshold() && u.AttestedHeader.Slot > s.OptimisticHeader.Slot {
+		s.OptimisticHeader = u.AttestedHeader
+	}
+
+	hasFinalizedNextSyncCommittee := !s.isNextSyncCommitteeKnown() &&
+		isSyncCommitteeUpdate(u) && isFinalityUpdate(u) &&
+		hasSyncCommitteeFinality(u)
+	if hasSupermajority(u) && (finalizedSlot(u) > s.FinalizedHeader.Slot || hasFinalizedNextSyncCommittee) {
+		if err := s.applyUpdate(u); err != nil {
+			return err
+		}
+		s.BestValidUpdate = nil
+	}
+	return nil
+}
+
+// ProcessForceUpdate applies the best valid update once the update timeout has elapsed since the
+// finalized header of the store. The attested header of the best valid update is treated as
+// finalized if its finalized header does not advance the store, so that the light client keeps
+// progressing through sync committee periods without finality.
+func (s *Store) ProcessForceUpdate(currentSlot types.Slot) error {
+	if s.BestValidUpdate == nil || currentSlot <= s.FinalizedHeader.Slot+updateTimeout() {
+		return nil
+	}
+	u := proto.Clone(s.BestValidUpdate).(*ethpbv2.LightClientUpdate)
+	if finalizedSlot(u) <= s.FinalizedHeader.Slot {
+		u.FinalizedHeader = u.AttestedHeader
+	}
+	if err := s.applyUpdate(u); err != nil {
+		return err
+	}
+	s.BestValidUpdate = nil
+	return nil
+}
+
+// validateUpdate follows validate_light_client_update of the light client specification. The checks
+// independent of the store are those of verifyUpdate, with the sync committee of the signature period.
+func (s *Store) validateUpdate(u *ethpbv2.LightClientUpdate, currentSlot types.Slot, genesisValidatorsRoot []byte) error {
+	if u.AttestedHeader == nil || u.SyncAggregate == nil {
+		return errors.New("nil update field")
+	}
+	if u.SignatureSlot > currentSlot {
+		return ErrInvalidSlots
+	}
+	if finalizedSlot(u) > u.AttestedHeader.Slot {
+		return ErrInvalidSlots
+	}
+
+	storePeriod := syncCommitteePeriodAtSlot(s.FinalizedHeader.Slot)
+	signaturePeriod := syncCommitteePeriodAtSlot(u.SignatureSlot)
+	nextKnown := s.isNextSyncCommitteeKnown()
+	if signaturePeriod != storePeriod && (!nextKnown || signaturePeriod != storePeriod+1) {
+		return ErrInvalidPeriod
+	}
+
+	attestedPeriod := syncCommitteePeriodAtSlot(u.AttestedHeader.Slot)
+	hasNextSyncCommittee := !nextKnown && isSyncCommitteeUpdate(u) && attestedPeriod == storePeriod
+	if u.AttestedHeader.Slot <= s.FinalizedHeader.Slot && !hasNextSyncCommittee {
+		return ErrIrrelevantUpdate
+	}
+
+	if !isFinalityUpdate(u) && !isEmptyHeader(u.FinalizedHeader) {
+		return errors.New("finalized header of update without finality branch is not empty")
+	}
+	if !isSyncCommitteeUpdate(u) {
+		if !isEmptySyncCommittee(u.NextSyncCommittee) {
+			return errors.New("next sync committee of update without sync committee branch is not empty")
+		}
+	} else if attestedPeriod == storePeriod && nextKnown && !proto.Equal(u.NextSyncCommittee, s.NextSyncCommittee) {
+		return ErrSyncCommitteeMismatch
+	}
+
+	committee := s.CurrentSyncCommittee
+	if signaturePeriod != storePeriod {
+		committee = s.NextSyncCommittee
+	}
+	return verifyUpdate(u, committee, genesisValidatorsRoot)
+}
+
+// applyUpdate follows apply_light_client_update of the light client specification, rotating the sync
+// committees of the store when the update finalizes the next period.
+func (s *Store) applyUpdate(u *ethpbv2.LightClientUpdate) error {
+	storePeriod := syncCommitteePeriodAtSlot(s.FinalizedHeader.Slot)
+	finalizedPeriod := syncCommitteePeriodAtSlot(finalizedSlot(u))
+	if !s.isNextSyncCommitteeKnown() {
+		if finalizedPeriod != storePeriod {
+			return errors.Errorf("update finalized period %d is not the store period %d", finalizedPeriod, storePeriod)
+		}
+		s.NextSyncCommittee = u.NextSyncCommittee
+	} else if finalizedPeriod == storePeriod+1 {
+		s.CurrentSyncCommittee = s.NextSyncCommittee
+		s.NextSyncCommittee = u.NextSyncCommittee
+		s.PreviousMaxActiveParticipants = s.CurrentMaxActiveParticipants
+		s.CurrentMaxActiveParticipants = 0
+	}
+	if finalizedSlot(u) > s.FinalizedHeader.Slot {
+		s.FinalizedHeader = u.FinalizedHeader
+		if s.FinalizedHeader.Slot > s.OptimisticHeader.Slot {
+			s.OptimisticHeader = s.FinalizedHeader
+		}
+	}
+	return nil
+}
+
+func (s *Store) isNextSyncCommitteeKnown() bool {
+	return !isEmptySyncCommittee(s.NextSyncCommittee)
+}
+
+// safetyThreshold is half of the highest sync committee participation seen over the current and
+// previous periods, which an update must exceed to advance the optimistic header.
+func (s *Store) safetyThreshold() uint64 {
+	if s.PreviousMaxActiveParticipants > s.CurrentMaxActiveParticipants {
+		return s.PreviousMaxActiveParticipants / 2
+	}
+	return s.CurrentMaxActiveParticipants / 2
+}
+
+// IsBetterUpdate reports whether the new update ranks above the old update, following
+// is_better_update of the light client specification. Supermajority participation comes first, then
+// the presence of the sync committee of the signature period, the presence of finality and the
+// finality of the sync committee, with the participation and the age of the update as tiebreakers.
+func IsBetterUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	newParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newSupermajority := hasSupermajority(newUpdate)
+	oldSupermajority := hasSupermajority(oldUpdate)
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	newRelevantSyncCommittee := hasRelevantSyncCommittee(newUpdate)
+	oldRelevantSyncCommittee := hasRelevantSyncCommittee(oldUpdate)
+	if newRelevantSyncCommittee != oldRelevantSyncCommittee {
+		return newRelevantSyncCommittee
+	}
+
+	newFinality := isFinalityUpdate(newUpdate)
+	oldFinality := isFinalityUpdate(oldUpdate)
+	if newFinality != oldFinality {
+		return newFinality
+	}
+	if newFinality {
+		newSyncCommitteeFinality := hasSyncCommitteeFinality(newUpdate)
+		oldSyncCommitteeFinality := hasSyncCommitteeFinality(oldUpdate)
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	// Prefer older data, which changes the best valid update less often.
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func hasSupermajority(u *ethpbv2.LightClientUpdate) bool {
+	bits := u.SyncAggregate.SyncCommitteeBits
+	return bits.Count()*3 >= bits.Len()*2
+}
+
+func hasRelevantSyncCommittee(u *ethpbv2.LightClientUpdate) bool {
+	return isSyncCommitteeUpdate(u) &&
+		syncCommitteePeriodAtSlot(u.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(u.SignatureSlot)
+}
+
+func hasSyncCommitteeFinality(u *ethpbv2.LightClientUpdate) bool {
+	return syncCommitteePeriodAtSlot(finalizedSlot(u)) == syncCommitteePeriodAtSlot(u.AttestedHeader.Slot)
+}
+
+// finalizedSlot returns the slot of the finalized header of the update, which is the genesis slot
+// for an update without finality.
+func finalizedSlot(u *ethpbv2.LightClientUpdate) types.Slot {
+	if u.FinalizedHeader == nil {
+		return params.BeaconConfig().GenesisSlot
+	}
+	return u.FinalizedHeader.Slot
+}
+
+func isSyncCommitteeUpdate(u *ethpbv2.LightClientUpdate) bool {
+	return !isZeroBranch(u.NextSyncCommitteeBranch)
+}
+
+func isFinalityUpdate(u *ethpbv2.LightClientUpdate) bool {
+	return !isZeroBranch(u.FinalityBranch)
+}
+
+func isEmptyHeader(h *ethpbv1.BeaconBlockHeader) bool {
+	return h == nil || (h.Slot == 0 && h.ProposerIndex == 0 &&
+		isZeroBranch([][]byte{h.ParentRoot, h.StateRoot, h.BodyRoot}))
+}
+
+func isEmptySyncCommittee(c *ethpbv2.SyncCommittee) bool {
+	return c == nil || (isZeroBranch(c.Pubkeys) && isZeroBranch([][]byte{c.AggregatePubkey}))
+}
+
+func syncCommitteePeriodAtSlot(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// updateTimeout is the number of slots after the finalized header of the store at which the best
+// valid update is forced, which is one sync committee period.
+func updateTimeout() types.Slot {
+	cfg := params.BeaconConfig()
+	return cfg.SlotsPerEpoch.Mul(uint64(cfg.EpochsPerSyncCommitteePeriod))
+}
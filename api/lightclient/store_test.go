@@ -0,0 +1,222 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/runtime/interop"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// testStore returns a store bootstrapped at slot 8 from the attested state of testUpdate, along with
+// the update and the genesis validators root.
+func testStore(t *testing.T) (*Store, *Update, []byte) {
+	u, committee, st := testUpdate(t)
+	branch, err := st.CurrentSyncCommitteeProof(context.Background())
+	require.NoError(t, err)
+	header := &ethpbv1.BeaconBlockHeader{
+		Slot:       8,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  u.AttestedHeader.StateRoot,
+		BodyRoot:   make([]byte, 32),
+	}
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := NewStore(root, &Bootstrap{&ethpbv2.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}})
+	require.NoError(t, err)
+	return s, u, st.GenesisValidatorsRoot()
+}
+
+// signUpdate signs the attested header of the update by the first n members of the sync committee of
+// testUpdate.
+func signUpdate(t *testing.T, u *Update, n uint64, genesisValidatorsRoot []byte) {
+	keys, _, err := interop.DeterministicallyGenerateKeys(0, 64)
+	require.NoError(t, err)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(u.AttestedHeader, domain)
+	require.NoError(t, err)
+	bits := bitfield.NewBitvector512()
+	sigs := make([]bls.Signature, n)
+	for i := uint64(0); i < n; i++ {
+		bits.SetBitAt(i, true)
+		sigs[i] = keys[i%uint64(len(keys))].Sign(signingRoot[:])
+	}
+	u.SyncAggregate = &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	s, _, _ := testStore(t)
+	assert.Equal(t, types.Slot(8), s.FinalizedHeader.Slot)
+	assert.Equal(t, types.Slot(8), s.OptimisticHeader.Slot)
+	assert.Equal(t, false, s.isNextSyncCommitteeKnown())
+
+	_, err := NewStore([32]byte{'a'}, &Bootstrap{&ethpbv2.LightClientBootstrap{
+		Header:               s.FinalizedHeader,
+		CurrentSyncCommittee: s.CurrentSyncCommittee,
+	}})
+	require.ErrorContains(t, "could not verify bootstrap", err)
+}
+
+func TestStore_ProcessUpdate(t *testing.T) {
+	t.Run("without supermajority", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		require.NoError(t, s.ProcessUpdate(u, u.SignatureSlot, gvr))
+		assert.Equal(t, types.Slot(8), s.FinalizedHeader.Slot)
+		assert.Equal(t, u.AttestedHeader.Slot, s.OptimisticHeader.Slot)
+		assert.Equal(t, uint64(64), s.CurrentMaxActiveParticipants)
+		assert.Equal(t, u.LightClientUpdate, s.BestValidUpdate)
+		assert.Equal(t, false, s.isNextSyncCommitteeKnown())
+	})
+	t.Run("with supermajority", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		signUpdate(t, u, params.BeaconConfig().SyncCommitteeSize, gvr)
+		require.NoError(t, s.ProcessUpdate(u, u.SignatureSlot, gvr))
+		assert.Equal(t, types.Slot(8), s.FinalizedHeader.Slot)
+		assert.Equal(t, u.AttestedHeader.Slot, s.OptimisticHeader.Slot)
+		assert.Equal(t, true, s.isNextSyncCommitteeKnown())
+		assert.Equal(t, (*ethpbv2.LightClientUpdate)(nil), s.BestValidUpdate)
+	})
+	t.Run("signature slot after current slot", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		require.ErrorIs(t, s.ProcessUpdate(u, u.SignatureSlot-1, gvr), ErrInvalidSlots)
+	})
+	t.Run("signature period unknown", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		u.SignatureSlot = updateTimeout()
+		require.ErrorIs(t, s.ProcessUpdate(u, u.SignatureSlot, gvr), ErrInvalidPeriod)
+	})
+	t.Run("irrelevant update", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		s.FinalizedHeader = u.AttestedHeader
+		u.NextSyncCommittee = nil
+		u.NextSyncCommitteeBranch = nil
+		require.ErrorIs(t, s.ProcessUpdate(u, u.SignatureSlot, gvr), ErrIrrelevantUpdate)
+	})
+	t.Run("next sync committee mismatch", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		s.NextSyncCommittee = &ethpbv2.SyncCommittee{Pubkeys: [][]byte{{'a'}}}
+		require.ErrorIs(t, s.ProcessUpdate(u, u.SignatureSlot, gvr), ErrSyncCommitteeMismatch)
+	})
+	t.Run("finalized header without finality branch", func(t *testing.T) {
+		s, u, gvr := testStore(t)
+		u.FinalityBranch = nil
+		require.ErrorContains(t, "finalized header of update without finality branch", s.ProcessUpdate(u, u.SignatureSlot, gvr))
+	})
+}
+
+func TestStore_ProcessForceUpdate(t *testing.T) {
+	s, u, gvr := testStore(t)
+	require.NoError(t, s.ProcessUpdate(u, u.SignatureSlot, gvr))
+	require.NotNil(t, s.BestValidUpdate)
+
+	timeout := s.FinalizedHeader.Slot + updateTimeout()
+	require.NoError(t, s.ProcessForceUpdate(timeout))
+	assert.Equal(t, types.Slot(8), s.FinalizedHeader.Slot)
+
+	require.NoError(t, s.ProcessForceUpdate(timeout+1))
+	assert.Equal(t, u.AttestedHeader.Slot, s.FinalizedHeader.Slot)
+	assert.Equal(t, true, s.isNextSyncCommitteeKnown())
+	assert.Equal(t, (*ethpbv2.LightClientUpdate)(nil), s.BestValidUpdate)
+	// The best valid update itself is left untouched.
+	assert.Equal(t, types.Slot(8), u.FinalizedHeader.Slot)
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	withParticipants := func(u *ethpbv2.LightClientUpdate, n uint64) *ethpbv2.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < n; i++ {
+			bits.SetBitAt(i, true)
+		}
+		u.SyncAggregate = &ethpbv1.SyncAggregate{SyncCommitteeBits: bits}
+		return u
+	}
+	newUpdate := func(t *testing.T, n uint64) *ethpbv2.LightClientUpdate {
+		u, _, _ := testUpdate(t)
+		return withParticipants(u.LightClientUpdate, n)
+	}
+	supermajority := params.BeaconConfig().SyncCommitteeSize * 2 / 3
+
+	tests := []struct {
+		name   string
+		better *ethpbv2.LightClientUpdate
+		worse  func() *ethpbv2.LightClientUpdate
+	}{
+		{
+			name:   "supermajority",
+			better: newUpdate(t, supermajority+1),
+			worse:  func() *ethpbv2.LightClientUpdate { return newUpdate(t, supermajority-1) },
+		},
+		{
+			name:   "participation below supermajority",
+			better: newUpdate(t, 100),
+			worse:  func() *ethpbv2.LightClientUpdate { return newUpdate(t, 99) },
+		},
+		{
+			name:   "relevant sync committee",
+			better: newUpdate(t, supermajority+1),
+			worse: func() *ethpbv2.LightClientUpdate {
+				u := newUpdate(t, supermajority+1)
+				u.NextSyncCommitteeBranch = nil
+				return u
+			},
+		},
+		{
+			name:   "finality",
+			better: newUpdate(t, supermajority+1),
+			worse: func() *ethpbv2.LightClientUpdate {
+				u := newUpdate(t, supermajority+1)
+				u.FinalityBranch = nil
+				return u
+			},
+		},
+		{
+			name:   "participation above supermajority",
+			better: newUpdate(t, supermajority+2),
+			worse:  func() *ethpbv2.LightClientUpdate { return newUpdate(t, supermajority+1) },
+		},
+		{
+			name:   "older attested header",
+			better: newUpdate(t, supermajority+1),
+			worse: func() *ethpbv2.LightClientUpdate {
+				u := newUpdate(t, supermajority+1)
+				u.AttestedHeader.Slot++
+				u.SignatureSlot++
+				return u
+			},
+		},
+		{
+			name:   "older signature slot",
+			better: newUpdate(t, supermajority+1),
+			worse: func() *ethpbv2.LightClientUpdate {
+				u := newUpdate(t, supermajority+1)
+				u.SignatureSlot++
+				return u
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worse := tt.worse()
+			assert.Equal(t, true, IsBetterUpdate(tt.better, worse))
+			assert.Equal(t, false, IsBetterUpdate(worse, tt.better))
+		})
+	}
+	u := newUpdate(t, supermajority)
+	assert.Equal(t, false, IsBetterUpdate(u, u))
+}
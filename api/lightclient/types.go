@@ -0,0 +1,345 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+)
+
+// Bootstrap is the light client bootstrap of a trusted block root. It serializes to SSZ through the
+// embedded message, and to the JSON format of the Beacon API.
+type Bootstrap struct {
+	*ethpbv2.LightClientBootstrap
+}
+
+// Update is a light client update, which may carry the next sync committee and a finalized header.
+type Update struct {
+	*ethpbv2.LightClientUpdate
+}
+
+// FinalityUpdate is a light client update carrying the latest finalized header.
+type FinalityUpdate struct {
+	*ethpbv2.LightClientFinalityUpdate
+}
+
+// OptimisticUpdate is a light client update carrying the latest attested header.
+type OptimisticUpdate struct {
+	*ethpbv2.LightClientOptimisticUpdate
+}
+
+// BeaconBlockHeader wraps a beacon block header for its Beacon API JSON serialization.
+type BeaconBlockHeader struct {
+	*ethpbv1.BeaconBlockHeader
+}
+
+// SyncCommittee wraps a sync committee for its Beacon API JSON serialization.
+type SyncCommittee struct {
+	*ethpbv2.SyncCommittee
+}
+
+// SyncAggregate wraps a sync aggregate for its Beacon API JSON serialization.
+type SyncAggregate struct {
+	*ethpbv1.SyncAggregate
+}
+
+type bootstrapJSON struct {
+	Header                     *BeaconBlockHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []hexutil.Bytes    `json:"current_sync_committee_branch"`
+}
+
+type updateJSON struct {
+	AttestedHeader          *BeaconBlockHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []hexutil.Bytes    `json:"next_sync_committee_branch"`
+	FinalizedHeader         *BeaconBlockHeader `json:"finalized_header"`
+	FinalityBranch          []hexutil.Bytes    `json:"finality_branch"`
+	SyncAggregate           *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           string             `json:"signature_slot"`
+}
+
+type finalityUpdateJSON struct {
+	AttestedHeader  *BeaconBlockHeader `json:"attested_header"`
+	FinalizedHeader *BeaconBlockHeader `json:"finalized_header"`
+	FinalityBranch  []hexutil.Bytes    `json:"finality_branch"`
+	SyncAggregate   *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   string             `json:"signature_slot"`
+}
+
+type optimisticUpdateJSON struct {
+	AttestedHeader *BeaconBlockHeader `json:"attested_header"`
+	SyncAggregate  *SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  string             `json:"signature_slot"`
+}
+
+type beaconBlockHeaderJSON struct {
+	Slot          string        `json:"slot"`
+	ProposerIndex string        `json:"proposer_index"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	BodyRoot      hexutil.Bytes `json:"body_root"`
+}
+
+type syncCommitteeJSON struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+type syncAggregateJSON struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+// UnmarshalSSZ decodes the bootstrap from its SSZ encoding.
+func (b *Bootstrap) UnmarshalSSZ(buf []byte) error {
+	if b.LightClientBootstrap == nil {
+		b.LightClientBootstrap = &ethpbv2.LightClientBootstrap{}
+	}
+	return b.LightClientBootstrap.UnmarshalSSZ(buf)
+}
+
+func (b *Bootstrap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&bootstrapJSON{
+		Header:                     &BeaconBlockHeader{b.Header},
+		CurrentSyncCommittee:       &SyncCommittee{b.CurrentSyncCommittee},
+		CurrentSyncCommitteeBranch: toHexSlice(b.CurrentSyncCommitteeBranch),
+	})
+}
+
+func (b *Bootstrap) UnmarshalJSON(buf []byte) error {
+	o := &bootstrapJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	if o.Header == nil || o.CurrentSyncCommittee == nil {
+		return errors.New("missing bootstrap field")
+	}
+	b.LightClientBootstrap = &ethpbv2.LightClientBootstrap{
+		Header:                     o.Header.BeaconBlockHeader,
+		CurrentSyncCommittee:       o.CurrentSyncCommittee.SyncCommittee,
+		CurrentSyncCommitteeBranch: fromHexSlice(o.CurrentSyncCommitteeBranch),
+	}
+	return nil
+}
+
+// UnmarshalSSZ decodes the update from its SSZ encoding.
+func (u *Update) UnmarshalSSZ(buf []byte) error {
+	if u.LightClientUpdate == nil {
+		u.LightClientUpdate = &ethpbv2.LightClientUpdate{}
+	}
+	return u.LightClientUpdate.UnmarshalSSZ(buf)
+}
+
+func (u *Update) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&updateJSON{
+		AttestedHeader:          &BeaconBlockHeader{u.AttestedHeader},
+		NextSyncCommittee:       &SyncCommittee{u.NextSyncCommittee},
+		NextSyncCommitteeBranch: toHexSlice(u.NextSyncCommitteeBranch),
+		FinalizedHeader:         &BeaconBlockHeader{u.FinalizedHeader},
+		FinalityBranch:          toHexSlice(u.FinalityBranch),
+		SyncAggregate:           &SyncAggregate{u.SyncAggregate},
+		SignatureSlot:           fmt.Sprintf("%d", u.SignatureSlot),
+	})
+}
+
+func (u *Update) UnmarshalJSON(buf []byte) error {
+	o := &updateJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	if o.AttestedHeader == nil || o.NextSyncCommittee == nil || o.FinalizedHeader == nil || o.SyncAggregate == nil {
+		return errors.New("missing update field")
+	}
+	slot, err := strconv.ParseUint(o.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse signature slot")
+	}
+	u.LightClientUpdate = &ethpbv2.LightClientUpdate{
+		AttestedHeader:          o.AttestedHeader.BeaconBlockHeader,
+		NextSyncCommittee:       o.NextSyncCommittee.SyncCommittee,
+		NextSyncCommitteeBranch: fromHexSlice(o.NextSyncCommitteeBranch),
+		FinalizedHeader:         o.FinalizedHeader.BeaconBlockHeader,
+		FinalityBranch:          fromHexSlice(o.FinalityBranch),
+		SyncAggregate:           o.SyncAggregate.SyncAggregate,
+		SignatureSlot:           types.Slot(slot),
+	}
+	return nil
+}
+
+// UnmarshalSSZ decodes the finality update from its SSZ encoding.
+func (u *FinalityUpdate) UnmarshalSSZ(buf []byte) error {
+	if u.LightClientFinalityUpdate == nil {
+		u.LightClientFinalityUpdate = &ethpbv2.LightClientFinalityUpdate{}
+	}
+	return u.LightClientFinalityUpdate.UnmarshalSSZ(buf)
+}
+
+func (u *FinalityUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&finalityUpdateJSON{
+		AttestedHeader:  &BeaconBlockHeader{u.AttestedHeader},
+		FinalizedHeader: &BeaconBlockHeader{u.FinalizedHeader},
+		FinalityBranch:  toHexSlice(u.FinalityBranch),
+		SyncAggregate:   &SyncAggregate{u.SyncAggregate},
+		SignatureSlot:   fmt.Sprintf("%d", u.SignatureSlot),
+	})
+}
+
+func (u *FinalityUpdate) UnmarshalJSON(buf []byte) error {
+	o := &finalityUpdateJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	if o.AttestedHeader == nil || o.FinalizedHeader == nil || o.SyncAggregate == nil {
+		return errors.New("missing finality update field")
+	}
+	slot, err := strconv.ParseUint(o.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse signature slot")
+	}
+	u.LightClientFinalityUpdate = &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  o.AttestedHeader.BeaconBlockHeader,
+		FinalizedHeader: o.FinalizedHeader.BeaconBlockHeader,
+		FinalityBranch:  fromHexSlice(o.FinalityBranch),
+		SyncAggregate:   o.SyncAggregate.SyncAggregate,
+		SignatureSlot:   types.Slot(slot),
+	}
+	return nil
+}
+
+// UnmarshalSSZ decodes the optimistic update from its SSZ encoding.
+func (u *OptimisticUpdate) UnmarshalSSZ(buf []byte) error {
+	if u.LightClientOptimisticUpdate == nil {
+		u.LightClientOptimisticUpdate = &ethpbv2.LightClientOptimisticUpdate{}
+	}
+	return u.LightClientOptimisticUpdate.UnmarshalSSZ(buf)
+}
+
+func (u *OptimisticUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&optimisticUpdateJSON{
+		AttestedHeader: &BeaconBlockHeader{u.AttestedHeader},
+		SyncAggregate:  &SyncAggregate{u.SyncAggregate},
+		SignatureSlot:  fmt.Sprintf("%d", u.SignatureSlot),
+	})
+}
+
+func (u *OptimisticUpdate) UnmarshalJSON(buf []byte) error {
+	o := &optimisticUpdateJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	if o.AttestedHeader == nil || o.SyncAggregate == nil {
+		return errors.New("missing optimistic update field")
+	}
+	slot, err := strconv.ParseUint(o.SignatureSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse signature slot")
+	}
+	u.LightClientOptimisticUpdate = &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: o.AttestedHeader.BeaconBlockHeader,
+		SyncAggregate:  o.SyncAggregate.SyncAggregate,
+		SignatureSlot:  types.Slot(slot),
+	}
+	return nil
+}
+
+func (h *BeaconBlockHeader) MarshalJSON() ([]byte, error) {
+	if h.BeaconBlockHeader == nil {
+		return nil, errors.New("nil beacon block header")
+	}
+	return json.Marshal(&beaconBlockHeaderJSON{
+		Slot:          fmt.Sprintf("%d", h.Slot),
+		ProposerIndex: fmt.Sprintf("%d", h.ProposerIndex),
+		ParentRoot:    h.ParentRoot,
+		StateRoot:     h.StateRoot,
+		BodyRoot:      h.BodyRoot,
+	})
+}
+
+func (h *BeaconBlockHeader) UnmarshalJSON(buf []byte) error {
+	o := &beaconBlockHeaderJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	slot, err := strconv.ParseUint(o.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse slot")
+	}
+	proposerIndex, err := strconv.ParseUint(o.ProposerIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse proposer index")
+	}
+	h.BeaconBlockHeader = &ethpbv1.BeaconBlockHeader{
+		Slot:          types.Slot(slot),
+		ProposerIndex: types.ValidatorIndex(proposerIndex),
+		ParentRoot:    o.ParentRoot,
+		StateRoot:     o.StateRoot,
+		BodyRoot:      o.BodyRoot,
+	}
+	return nil
+}
+
+func (c *SyncCommittee) MarshalJSON() ([]byte, error) {
+	if c.SyncCommittee == nil {
+		return nil, errors.New("nil sync committee")
+	}
+	return json.Marshal(&syncCommitteeJSON{
+		Pubkeys:         toHexSlice(c.Pubkeys),
+		AggregatePubkey: c.AggregatePubkey,
+	})
+}
+
+func (c *SyncCommittee) UnmarshalJSON(buf []byte) error {
+	o := &syncCommitteeJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	c.SyncCommittee = &ethpbv2.SyncCommittee{
+		Pubkeys:         fromHexSlice(o.Pubkeys),
+		AggregatePubkey: o.AggregatePubkey,
+	}
+	return nil
+}
+
+func (s *SyncAggregate) MarshalJSON() ([]byte, error) {
+	if s.SyncAggregate == nil {
+		return nil, errors.New("nil sync aggregate")
+	}
+	return json.Marshal(&syncAggregateJSON{
+		SyncCommitteeBits:      hexutil.Bytes(s.SyncCommitteeBits),
+		SyncCommitteeSignature: s.SyncCommitteeSignature,
+	})
+}
+
+func (s *SyncAggregate) UnmarshalJSON(buf []byte) error {
+	o := &syncAggregateJSON{}
+	if err := json.Unmarshal(buf, o); err != nil {
+		return err
+	}
+	s.SyncAggregate = &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      []byte(o.SyncCommitteeBits),
+		SyncCommitteeSignature: o.SyncCommitteeSignature,
+	}
+	return nil
+}
+
+func toHexSlice(bs [][]byte) []hexutil.Bytes {
+	hs := make([]hexutil.Bytes, len(bs))
+	for i, b := range bs {
+		hs[i] = b
+	}
+	return hs
+}
+
+func fromHexSlice(hs []hexutil.Bytes) [][]byte {
+	bs := make([][]byte, len(hs))
+	for i, h := range hs {
+		bs[i] = h
+	}
+	return bs
+}
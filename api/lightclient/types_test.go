@@ -0,0 +1,82 @@
+package lightclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestUpdate_JSON(t *testing.T) {
+	u, _, _ := testUpdate(t)
+	b, err := json.Marshal(u)
+	require.NoError(t, err)
+
+	o := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b, &o))
+	assert.Equal(t, "41", o["signature_slot"])
+	assert.Equal(t, "40", o["attested_header"].(map[string]interface{})["slot"])
+	assert.Equal(t, 5, len(o["next_sync_committee_branch"].([]interface{})))
+	assert.Equal(t, 6, len(o["finality_branch"].([]interface{})))
+
+	decoded := &Update{}
+	require.NoError(t, json.Unmarshal(b, decoded))
+	assert.Equal(t, true, proto.Equal(u.LightClientUpdate, decoded.LightClientUpdate))
+}
+
+func TestUpdate_UnmarshalJSON_Invalid(t *testing.T) {
+	u, _, _ := testUpdate(t)
+	b, err := json.Marshal(u)
+	require.NoError(t, err)
+	o := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b, &o))
+
+	o["signature_slot"] = "foo"
+	b, err = json.Marshal(o)
+	require.NoError(t, err)
+	require.ErrorContains(t, "could not parse signature slot", json.Unmarshal(b, &Update{}))
+
+	delete(o, "sync_aggregate")
+	b, err = json.Marshal(o)
+	require.NoError(t, err)
+	require.ErrorContains(t, "missing update field", json.Unmarshal(b, &Update{}))
+}
+
+func TestLightClientTypes_SSZ(t *testing.T) {
+	u, committee, _ := testUpdate(t)
+	b, err := u.MarshalSSZ()
+	require.NoError(t, err)
+	decoded := &Update{}
+	require.NoError(t, decoded.UnmarshalSSZ(b))
+	assert.Equal(t, true, proto.Equal(u.LightClientUpdate, decoded.LightClientUpdate))
+
+	bootstrap := &Bootstrap{&ethpbv2.LightClientBootstrap{
+		Header:                     u.AttestedHeader,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: u.NextSyncCommitteeBranch,
+	}}
+	b, err = bootstrap.MarshalSSZ()
+	require.NoError(t, err)
+	decodedBootstrap := &Bootstrap{}
+	require.NoError(t, decodedBootstrap.UnmarshalSSZ(b))
+	assert.Equal(t, true, proto.Equal(bootstrap.LightClientBootstrap, decodedBootstrap.LightClientBootstrap))
+
+	optimisticUpdate := &OptimisticUpdate{&ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: u.AttestedHeader,
+		SyncAggregate:  u.SyncAggregate,
+		SignatureSlot:  u.SignatureSlot,
+	}}
+	b, err = optimisticUpdate.MarshalSSZ()
+	require.NoError(t, err)
+	decodedOptimisticUpdate := &OptimisticUpdate{}
+	require.NoError(t, decodedOptimisticUpdate.UnmarshalSSZ(b))
+	assert.Equal(t, true, proto.Equal(optimisticUpdate.LightClientOptimisticUpdate, decodedOptimisticUpdate.LightClientOptimisticUpdate))
+	j, err := json.Marshal(optimisticUpdate)
+	require.NoError(t, err)
+	decodedOptimisticUpdate = &OptimisticUpdate{}
+	require.NoError(t, json.Unmarshal(j, decodedOptimisticUpdate))
+	assert.Equal(t, true, proto.Equal(optimisticUpdate.LightClientOptimisticUpdate, decodedOptimisticUpdate.LightClientOptimisticUpdate))
+}
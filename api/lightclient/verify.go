@@ -0,0 +1,227 @@
+package lightclient
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+// Depths and indices of the Merkle branches proving the sync committees and the finalized root
+// against a beacon state root, as given by the generalized indices of the light client specification.
+const (
+	syncCommitteeBranchDepth  = 5
+	currentSyncCommitteeIndex = 22 // Generalized index 54.
+	nextSyncCommitteeIndex    = 23 // Generalized index 55.
+	finalityBranchDepth       = 6
+	finalizedRootIndex        = 41 // Generalized index 105.
+)
+
+var (
+	// ErrInvalidBranch is returned when a Merkle branch does not prove its leaf against the state root.
+	ErrInvalidBranch = errors.New("invalid merkle branch")
+	// ErrInvalidSlots is returned when the slots of an update are not ordered as signature slot >
+	// attested slot >= finalized slot.
+	ErrInvalidSlots = errors.New("invalid update slots")
+	// ErrInsufficientParticipants is returned when fewer sync committee members than the minimum
+	// participated in the sync aggregate.
+	ErrInsufficientParticipants = errors.New("insufficient sync committee participants")
+	// ErrInvalidSignature is returned when the sync aggregate signature does not verify.
+	ErrInvalidSignature = errors.New("invalid sync aggregate signature")
+)
+
+// Verify checks that the bootstrap header is the block of the trusted root, and that the current
+// sync committee is proven against its state root.
+func (b *Bootstrap) Verify(trustedBlockRoot [32]byte) error {
+	if b.LightClientBootstrap == nil || b.Header == nil || b.CurrentSyncCommittee == nil {
+		return errors.New("nil bootstrap")
+	}
+	root, err := b.Header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash header")
+	}
+	if root != trustedBlockRoot {
+		return errors.Errorf("header root %#x does not match trusted block root %#x", root, trustedBlockRoot)
+	}
+	return verifySyncCommitteeBranch(b.CurrentSyncCommittee, b.CurrentSyncCommitteeBranch, currentSyncCommitteeIndex, b.Header.StateRoot)
+}
+
+// Verify checks the branches of the update against its attested header, and the signature of the
+// sync aggregate by the given sync committee, which is the committee of the period of the signature
+// slot.
+func (u *Update) Verify(committee *ethpbv2.SyncCommittee, genesisValidatorsRoot []byte) error {
+	if u.LightClientUpdate == nil {
+		return errors.New("nil update")
+	}
+	return verifyUpdate(u.LightClientUpdate, committee, genesisValidatorsRoot)
+}
+
+// Verify checks the finality branch of the update against its attested header, and the signature
+// of the sync aggregate by the given sync committee.
+func (u *FinalityUpdate) Verify(committee *ethpbv2.SyncCommittee, genesisValidatorsRoot []byte) error {
+	if u.LightClientFinalityUpdate == nil {
+		return errors.New("nil finality update")
+	}
+	return verifyUpdate(&ethpbv2.LightClientUpdate{
+		AttestedHeader:  u.AttestedHeader,
+		FinalizedHeader: u.FinalizedHeader,
+		FinalityBranch:  u.FinalityBranch,
+		SyncAggregate:   u.SyncAggregate,
+		SignatureSlot:   u.SignatureSlot,
+	}, committee, genesisValidatorsRoot)
+}
+
+// Verify checks the signature of the sync aggregate of the update by the given sync committee.
+func (u *OptimisticUpdate) Verify(committee *ethpbv2.SyncCommittee, genesisValidatorsRoot []byte) error {
+	if u.LightClientOptimisticUpdate == nil {
+		return errors.New("nil optimistic update")
+	}
+	return verifyUpdate(&ethpbv2.LightClientUpdate{
+		AttestedHeader: u.AttestedHeader,
+		SyncAggregate:  u.SyncAggregate,
+		SignatureSlot:  u.SignatureSlot,
+	}, committee, genesisValidatorsRoot)
+}
+
+// verifyUpdate follows validate_light_client_update of the light client specification, without the
+// checks against the store of the light client. An update without a finality branch or a next sync
+// committee branch is not checked for the corresponding leaf.
+func verifyUpdate(u *ethpbv2.LightClientUpdate, committee *ethpbv2.SyncCommittee, genesisValidatorsRoot []byte) error {
+	if u.AttestedHeader == nil || u.SyncAggregate == nil {
+		return errors.New("nil update field")
+	}
+	if committee == nil {
+		return errors.New("nil sync committee")
+	}
+	if u.SyncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return ErrInsufficientParticipants
+	}
+
+	if u.SignatureSlot <= u.AttestedHeader.Slot {
+		return ErrInvalidSlots
+	}
+	if !isZeroBranch(u.FinalityBranch) {
+		if u.FinalizedHeader == nil || u.FinalizedHeader.Slot > u.AttestedHeader.Slot {
+			return ErrInvalidSlots
+		}
+		// The finalized root is zero until the first finalized checkpoint, in which case the
+		// finalized header is empty.
+		var leaf [32]byte
+		if u.FinalizedHeader.Slot != params.BeaconConfig().GenesisSlot {
+			r, err := u.FinalizedHeader.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not hash finalized header")
+			}
+			leaf = r
+		} else if !proto.Equal(u.FinalizedHeader, &ethpbv1.BeaconBlockHeader{}) {
+			return errors.New("finalized header at genesis slot is not empty")
+		}
+		if !verifyBranch(leaf, u.FinalityBranch, finalityBranchDepth, finalizedRootIndex, u.AttestedHeader.StateRoot) {
+			return errors.Wrap(ErrInvalidBranch, "finality branch")
+		}
+	}
+	if !isZeroBranch(u.NextSyncCommitteeBranch) {
+		if err := verifySyncCommitteeBranch(u.NextSyncCommittee, u.NextSyncCommitteeBranch, nextSyncCommitteeIndex, u.AttestedHeader.StateRoot); err != nil {
+			return err
+		}
+	}
+
+	return verifySyncAggregate(u, committee, genesisValidatorsRoot)
+}
+
+func verifySyncCommitteeBranch(committee *ethpbv2.SyncCommittee, branch [][]byte, index uint64, stateRoot []byte) error {
+	if committee == nil {
+		return errors.New("nil sync committee")
+	}
+	leaf, err := committee.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash sync committee")
+	}
+	if !verifyBranch(leaf, branch, syncCommitteeBranchDepth, index, stateRoot) {
+		return errors.Wrap(ErrInvalidBranch, "sync committee branch")
+	}
+	return nil
+}
+
+func verifyBranch(leaf [32]byte, branch [][]byte, depth, index uint64, root []byte) bool {
+	if uint64(len(branch)) != depth {
+		return false
+	}
+	return trie.VerifyMerkleProof(root, leaf[:], index, branch)
+}
+
+func isZeroBranch(branch [][]byte) bool {
+	for _, b := range branch {
+		for _, x := range b {
+			if x != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// verifySyncAggregate verifies the signature of the attested header by the participants of the sync
+// aggregate. The sync committee members sign the block of the slot before the signature slot, with
+// the fork version of that slot.
+func verifySyncAggregate(u *ethpbv2.LightClientUpdate, committee *ethpbv2.SyncCommittee, genesisValidatorsRoot []byte) error {
+	bits := u.SyncAggregate.SyncCommitteeBits
+	if bits.Len() < uint64(len(committee.Pubkeys)) {
+		return errors.Errorf("sync committee bits length %d is less than committee size %d", bits.Len(), len(committee.Pubkeys))
+	}
+	pubkeys := make([]bls.PublicKey, 0, bits.Count())
+	for i, k := range committee.Pubkeys {
+		if !bits.BitAt(uint64(i)) {
+			continue
+		}
+		pubkey, err := bls.PublicKeyFromBytes(k)
+		if err != nil {
+			return errors.Wrap(err, "could not decode sync committee public key")
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	if uint64(len(pubkeys)) < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return ErrInsufficientParticipants
+	}
+	sig, err := bls.SignatureFromBytes(u.SyncAggregate.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(err, "could not decode sync aggregate signature")
+	}
+
+	signedSlot := u.SignatureSlot
+	if signedSlot > 0 {
+		signedSlot--
+	}
+	domain, err := syncCommitteeDomain(slots.ToEpoch(signedSlot), genesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	root, err := signing.ComputeSigningRoot(u.AttestedHeader, domain)
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing root")
+	}
+	if !sig.FastAggregateVerify(pubkeys, root) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func syncCommitteeDomain(epoch types.Epoch, genesisValidatorsRoot []byte) ([]byte, error) {
+	if len(genesisValidatorsRoot) != fieldparams.RootLength {
+		return nil, errors.Errorf("invalid genesis validators root length %d", len(genesisValidatorsRoot))
+	}
+	cfg := params.BeaconConfig()
+	version, err := forks.NewOrderedSchedule(cfg).VersionForEpoch(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork version")
+	}
+	return signing.ComputeDomain(cfg.DomainSyncCommittee, version[:], genesisValidatorsRoot)
+}
@@ -0,0 +1,156 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// testUpdate returns an update attesting to a state with a finalized checkpoint, signed by the
+// first members of its sync committee, along with the sync committee and the attested state.
+func testUpdate(t *testing.T) (*Update, *ethpbv2.SyncCommittee, state.BeaconState) {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(40))
+
+	committee := &ethpb.SyncCommittee{AggregatePubkey: keys[0].PublicKey().Marshal()}
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		committee.Pubkeys = append(committee.Pubkeys, keys[i%uint64(len(keys))].PublicKey().Marshal())
+	}
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	require.NoError(t, st.SetNextSyncCommittee(committee))
+
+	finalizedHeader := &ethpbv1.BeaconBlockHeader{
+		Slot:       8,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+	finalizedRoot, err := finalizedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	attestedHeader := &ethpbv1.BeaconBlockHeader{
+		Slot:       st.Slot(),
+		ParentRoot: make([]byte, 32),
+		StateRoot:  stateRoot[:],
+		BodyRoot:   make([]byte, 32),
+	}
+	nextSyncCommitteeBranch, err := st.NextSyncCommitteeProof(ctx)
+	require.NoError(t, err)
+	finalityBranch, err := st.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, params.BeaconConfig().GenesisForkVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(attestedHeader, domain)
+	require.NoError(t, err)
+	bits := bitfield.NewBitvector512()
+	sigs := make([]bls.Signature, len(keys))
+	for i, k := range keys {
+		bits.SetBitAt(uint64(i), true)
+		sigs[i] = k.Sign(signingRoot[:])
+	}
+
+	syncCommittee := &ethpbv2.SyncCommittee{Pubkeys: committee.Pubkeys, AggregatePubkey: committee.AggregatePubkey}
+	return &Update{&ethpbv2.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       syncCommittee,
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		FinalizedHeader:         finalizedHeader,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      bits,
+			SyncCommitteeSignature: bls.AggregateSignatures(sigs).Marshal(),
+		},
+		SignatureSlot: attestedHeader.Slot + 1,
+	}}, syncCommittee, st
+}
+
+func TestBootstrap_Verify(t *testing.T) {
+	u, committee, st := testUpdate(t)
+	branch, err := st.CurrentSyncCommitteeProof(context.Background())
+	require.NoError(t, err)
+	b := &Bootstrap{&ethpbv2.LightClientBootstrap{
+		Header:                     u.AttestedHeader,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}}
+	root, err := u.AttestedHeader.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, b.Verify(root))
+
+	require.ErrorContains(t, "does not match trusted block root", b.Verify([32]byte{'a'}))
+	b.CurrentSyncCommitteeBranch = u.FinalityBranch[1:]
+	require.ErrorIs(t, b.Verify(root), ErrInvalidBranch)
+}
+
+func TestUpdate_Verify(t *testing.T) {
+	u, committee, st := testUpdate(t)
+	gvr := st.GenesisValidatorsRoot()
+	require.NoError(t, u.Verify(committee, gvr))
+
+	finalityUpdate := &FinalityUpdate{&ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  u.AttestedHeader,
+		FinalizedHeader: u.FinalizedHeader,
+		FinalityBranch:  u.FinalityBranch,
+		SyncAggregate:   u.SyncAggregate,
+		SignatureSlot:   u.SignatureSlot,
+	}}
+	require.NoError(t, finalityUpdate.Verify(committee, gvr))
+	optimisticUpdate := &OptimisticUpdate{&ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: u.AttestedHeader,
+		SyncAggregate:  u.SyncAggregate,
+		SignatureSlot:  u.SignatureSlot,
+	}}
+	require.NoError(t, optimisticUpdate.Verify(committee, gvr))
+
+	t.Run("invalid finality branch", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		u.FinalizedHeader.Slot = 9
+		require.ErrorIs(t, u.Verify(committee, gvr), ErrInvalidBranch)
+	})
+	t.Run("invalid next sync committee branch", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		u.NextSyncCommitteeBranch = u.FinalityBranch[1:]
+		require.ErrorIs(t, u.Verify(committee, gvr), ErrInvalidBranch)
+	})
+	t.Run("signature slot not after attested slot", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		u.SignatureSlot = u.AttestedHeader.Slot
+		require.ErrorIs(t, u.Verify(committee, gvr), ErrInvalidSlots)
+	})
+	t.Run("no participants", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		u.SyncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+		require.ErrorIs(t, u.Verify(committee, gvr), ErrInsufficientParticipants)
+	})
+	t.Run("missing participant", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		u.SyncAggregate.SyncCommitteeBits.SetBitAt(0, false)
+		require.ErrorIs(t, u.Verify(committee, gvr), ErrInvalidSignature)
+	})
+	t.Run("wrong genesis validators root", func(t *testing.T) {
+		u, committee, _ := testUpdate(t)
+		require.ErrorIs(t, u.Verify(committee, make([]byte, 32)), ErrInvalidSignature)
+	})
+}
+
+func TestIsZeroBranch(t *testing.T) {
+	assert.Equal(t, true, isZeroBranch(nil))
+	assert.Equal(t, true, isZeroBranch([][]byte{make([]byte, 32), make([]byte, 32)}))
+	assert.Equal(t, false, isZeroBranch([][]byte{make([]byte, 32), {0, 1}}))
+}
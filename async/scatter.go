@@ -26,10 +26,10 @@ func Scatter(inputLen int, sFunc func(int, int, *sync.RWMutex) (interface{}, err
 	if inputLen%chunkSize != 0 {
 		workers++
 	}
+	// The channels are buffered for every worker and intentionally left open, as
+	// remaining workers may still send after the first error has been returned.
 	resultCh := make(chan *WorkerResults, workers)
-	defer close(resultCh)
 	errorCh := make(chan error, workers)
-	defer close(errorCh)
 	mutex := new(sync.RWMutex)
 	for worker := 0; worker < workers; worker++ {
 		offset := worker * chunkSize
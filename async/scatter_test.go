@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/async"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -106,3 +107,14 @@ func TestError(t *testing.T) {
 		t.Fatalf("Missing expected error")
 	}
 }
+
+func TestError_AllWorkersFail(t *testing.T) {
+	_, err := async.Scatter(1024, func(offset int, entries int, mu *sync.RWMutex) (interface{}, error) {
+		return nil, errors.New("bad worker")
+	})
+	if err == nil {
+		t.Fatalf("Missing expected error")
+	}
+	// Give the remaining workers time to report their errors, which must not panic.
+	time.Sleep(10 * time.Millisecond)
+}
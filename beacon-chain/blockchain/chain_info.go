@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -59,6 +61,7 @@ type HeadFetcher interface {
 	HeadGenesisValidatorsRoot() [32]byte
 	HeadETH1Data() *ethpb.Eth1Data
 	HeadPublicKeyToValidatorIndex(pubKey [fieldparams.BLSPubkeyLength]byte) (types.ValidatorIndex, bool)
+	HeadPublicKeysToValidatorIndices(pubKeys [][fieldparams.BLSPubkeyLength]byte) map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
 	HeadValidatorIndexToPublicKey(ctx context.Context, index types.ValidatorIndex) ([fieldparams.BLSPubkeyLength]byte, error)
 	ChainHeads() ([][32]byte, []types.Slot)
 	HeadSyncCommitteeFetcher
@@ -76,6 +79,13 @@ type CanonicalFetcher interface {
 	IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error)
 }
 
+// AncestryFetcher answers ancestry queries between blocks, using fork choice for recent blocks and
+// the database for finalized history.
+type AncestryFetcher interface {
+	AncestorAtSlot(ctx context.Context, root [32]byte, slot types.Slot) ([32]byte, error)
+	IsAncestor(ctx context.Context, ancestorRoot [32]byte, root [32]byte) (bool, error)
+}
+
 // FinalizationFetcher defines a common interface for methods in blockchain service which
 // directly retrieve finalization and justification related data.
 type FinalizationFetcher interface {
@@ -129,7 +139,12 @@ func (s *Service) HeadSlot() types.Slot {
 }
 
 // HeadRoot returns the root of the head of the chain.
+// A head snapshot carried by the context takes precedence over the current head.
 func (s *Service) HeadRoot(ctx context.Context) ([]byte, error) {
+	if snapshot := HeadSnapshotFromContext(ctx); snapshot != nil {
+		root := snapshot.Root()
+		return root[:], nil
+	}
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 
@@ -156,7 +171,11 @@ func (s *Service) HeadRoot(ctx context.Context) ([]byte, error) {
 // HeadBlock returns the head block of the chain.
 // If the head is nil from service struct,
 // it will attempt to get the head block from DB.
+// A head snapshot carried by the context takes precedence over the current head.
 func (s *Service) HeadBlock(ctx context.Context) (interfaces.SignedBeaconBlock, error) {
+	if snapshot := HeadSnapshotFromContext(ctx); snapshot != nil {
+		return snapshot.Block(), nil
+	}
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 
@@ -170,9 +189,14 @@ func (s *Service) HeadBlock(ctx context.Context) (interfaces.SignedBeaconBlock,
 // HeadState returns the head state of the chain.
 // If the head is nil from service struct,
 // it will attempt to get the head state from DB.
+// A head snapshot carried by the context takes precedence over the current head.
 func (s *Service) HeadState(ctx context.Context) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "blockChain.HeadState")
 	defer span.End()
+	if snapshot := HeadSnapshotFromContext(ctx); snapshot != nil {
+		span.AddAttributes(trace.BoolAttribute("snapshot", true))
+		return snapshot.State(), nil
+	}
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 
@@ -262,6 +286,79 @@ func (s *Service) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, er
 	return s.cfg.BeaconDB.IsFinalizedBlock(ctx, blockRoot), nil
 }
 
+// AncestorAtSlot returns the root of the block at the given slot in the chain of the input block root,
+// or of the most recent block before that slot when it was skipped. The input root is returned when its
+// block is not older than the slot.
+func (s *Service) AncestorAtSlot(ctx context.Context, root [32]byte, slot types.Slot) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.AncestorAtSlot")
+	defer span.End()
+
+	if s.cfg.ForkChoiceStore.HasNode(root) {
+		ar, err := s.cfg.ForkChoiceStore.AncestorRoot(ctx, root, slot)
+		if err == nil {
+			return ar, nil
+		}
+		if ctx.Err() != nil {
+			return [32]byte{}, ctx.Err()
+		}
+		// Fork choice only holds descendants of the finalized checkpoint, so an ancestor older than
+		// its blocks is part of the finalized chain.
+		if ar, err := s.finalizedAncestorAtSlot(ctx, slot); err == nil {
+			return ar, nil
+		}
+	} else if s.cfg.BeaconDB.IsFinalizedBlock(ctx, root) {
+		blkSlot, err := s.cfg.BeaconDB.BlockSlot(ctx, root)
+		if err != nil && !errors.Is(err, db.ErrNotFound) {
+			return [32]byte{}, err
+		}
+		// Blocks which are not found are reported by the walk below.
+		if err == nil {
+			if blkSlot <= slot {
+				return root, nil
+			}
+			// Blocks of the latest finalized epoch are indexed as finalized whether or not they are
+			// canonical, only the finalized block and older blocks are known to be canonical.
+			f := s.FinalizedCheckpt()
+			fSlot, err := slots.EpochStart(f.Epoch)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			if blkSlot < fSlot || root == bytesutil.ToBytes32(f.Root) {
+				if ar, err := s.finalizedAncestorAtSlot(ctx, slot); err == nil {
+					return ar, nil
+				}
+			}
+		}
+	}
+
+	// Walk up the chain through the DB as the last resort.
+	ar, err := s.ancestorByDB(ctx, root, slot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(ar), nil
+}
+
+// IsAncestor returns true if the block of ancestorRoot is an ancestor of the block of root, or
+// the same block.
+func (s *Service) IsAncestor(ctx context.Context, ancestorRoot [32]byte, root [32]byte) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.IsAncestor")
+	defer span.End()
+
+	if ancestorRoot == root {
+		return true, nil
+	}
+	ancestorSlot, err := s.blockSlot(ctx, ancestorRoot)
+	if err != nil {
+		return false, err
+	}
+	ar, err := s.AncestorAtSlot(ctx, root, ancestorSlot)
+	if err != nil {
+		return false, err
+	}
+	return ar == ancestorRoot, nil
+}
+
 // ChainHeads returns all possible chain heads (leaves of fork choice tree).
 // Heads roots and heads slots are returned.
 func (s *Service) ChainHeads() ([][32]byte, []types.Slot) {
@@ -278,6 +375,17 @@ func (s *Service) HeadPublicKeyToValidatorIndex(pubKey [fieldparams.BLSPubkeyLen
 	return s.headValidatorIndexAtPubkey(pubKey)
 }
 
+// HeadPublicKeysToValidatorIndices returns the validator indices of the `pubKeys` in current head state.
+// The public keys which are not in the head state's registry are absent from the returned map.
+func (s *Service) HeadPublicKeysToValidatorIndices(pubKeys [][fieldparams.BLSPubkeyLength]byte) map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex {
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+	if !s.hasHeadState() {
+		return map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex{}
+	}
+	return s.headValidatorIndicesAtPubkeys(pubKeys)
+}
+
 // HeadValidatorIndexToPublicKey returns the pubkey of the validator `index`  in current head state.
 func (s *Service) HeadValidatorIndexToPublicKey(_ context.Context, index types.ValidatorIndex) ([fieldparams.BLSPubkeyLength]byte, error) {
 	s.headLock.RLock()
@@ -298,7 +406,11 @@ func (s *Service) ForkChoicer() forkchoice.ForkChoicer {
 }
 
 // IsOptimistic returns true if the current head is optimistic.
+// A head snapshot carried by the context takes precedence over the current head.
 func (s *Service) IsOptimistic(ctx context.Context) (bool, error) {
+	if snapshot := HeadSnapshotFromContext(ctx); snapshot != nil {
+		return snapshot.IsOptimistic(), nil
+	}
 	s.headLock.RLock()
 	defer s.headLock.RUnlock()
 	if slots.ToEpoch(s.CurrentSlot()) < params.BeaconConfig().BellatrixForkEpoch {
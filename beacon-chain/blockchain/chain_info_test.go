@@ -5,14 +5,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -61,7 +61,7 @@ func prepareForkchoiceState(
 	}
 
 	base.BlockRoots[0] = append(base.BlockRoots[0], blockRoot[:]...)
-	st, err := v3.InitializeFromProto(base)
+	st, err := statenative.InitializeFromProtoBellatrix(base)
 	return st, blockRoot, err
 }
 
@@ -125,7 +125,7 @@ func TestCurrentJustifiedCheckpt_CanRetrieve(t *testing.T) {
 
 func TestHeadSlot_CanRetrieve(t *testing.T) {
 	c := &Service{}
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{})
 	require.NoError(t, err)
 	c.head = &head{slot: 100, state: s}
 	assert.Equal(t, types.Slot(100), c.HeadSlot())
@@ -179,7 +179,7 @@ func TestHeadRoot_UseDB(t *testing.T) {
 func TestHeadBlock_CanRetrieve(t *testing.T) {
 	b := util.NewBeaconBlock()
 	b.Block.Slot = 1
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{})
 	require.NoError(t, err)
 	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
 	require.NoError(t, err)
@@ -192,7 +192,7 @@ func TestHeadBlock_CanRetrieve(t *testing.T) {
 }
 
 func TestHeadState_CanRetrieve(t *testing.T) {
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{Slot: 2, GenesisValidatorsRoot: params.BeaconConfig().ZeroHash[:]})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: 2, GenesisValidatorsRoot: params.BeaconConfig().ZeroHash[:]})
 	require.NoError(t, err)
 	c := &Service{}
 	c.head = &head{state: s}
@@ -209,7 +209,7 @@ func TestGenesisTime_CanRetrieve(t *testing.T) {
 
 func TestCurrentFork_CanRetrieve(t *testing.T) {
 	f := &ethpb.Fork{Epoch: 999}
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{Fork: f})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Fork: f})
 	require.NoError(t, err)
 	c := &Service{}
 	c.head = &head{state: s}
@@ -234,7 +234,7 @@ func TestGenesisValidatorsRoot_CanRetrieve(t *testing.T) {
 	c := &Service{}
 	assert.Equal(t, [32]byte{}, c.GenesisValidatorsRoot(), "Did not get correct genesis validators root")
 
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{GenesisValidatorsRoot: []byte{'a'}})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{GenesisValidatorsRoot: []byte{'a'}})
 	require.NoError(t, err)
 	c.head = &head{state: s}
 	assert.Equal(t, [32]byte{'a'}, c.GenesisValidatorsRoot(), "Did not get correct genesis validators root")
@@ -248,7 +248,7 @@ func TestHeadETH1Data_Nil(t *testing.T) {
 
 func TestHeadETH1Data_CanRetrieve(t *testing.T) {
 	d := &ethpb.Eth1Data{DepositCount: 999}
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{Eth1Data: d})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Eth1Data: d})
 	require.NoError(t, err)
 	c := &Service{}
 	c.head = &head{state: s}
@@ -400,6 +400,20 @@ func TestService_HeadPublicKeyToValidatorIndexNil(t *testing.T) {
 	require.Equal(t, types.ValidatorIndex(0), i)
 }
 
+func TestService_HeadPublicKeysToValidatorIndices(t *testing.T) {
+	s, _ := util.DeterministicGenesisState(t, 10)
+	c := &Service{validatorIndexCache: cache.NewValidatorIndexCache()}
+	b, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	c.setHeadInitialSync([32]byte{}, b, s)
+
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{s.PubkeyAtIndex(3), {}, s.PubkeyAtIndex(7)}
+	indices := c.HeadPublicKeysToValidatorIndices(pubKeys)
+	require.Equal(t, 2, len(indices))
+	require.Equal(t, types.ValidatorIndex(3), indices[pubKeys[0]])
+	require.Equal(t, types.ValidatorIndex(7), indices[pubKeys[2]])
+}
+
 func TestService_HeadValidatorIndexToPublicKey(t *testing.T) {
 	s, _ := util.DeterministicGenesisState(t, 10)
 	c := &Service{}
@@ -691,3 +705,106 @@ func TestService_IsFinalized(t *testing.T) {
 	require.Equal(t, true, c.IsFinalized(ctx, br))
 	require.Equal(t, false, c.IsFinalized(ctx, [32]byte{'c'}))
 }
+
+// setupAncestryChain saves a canonical chain with blocks at slots 0, 1, 2, 4, 32, 33 and 35, and a fork block at slot 3
+// branching off slot 2. Epoch 1 is finalized, so fork choice only holds the blocks from slot 32.
+func setupAncestryChain(t *testing.T) (*Service, map[types.Slot][32]byte, [32]byte) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	c := &Service{cfg: &config{BeaconDB: beaconDB, ForkChoiceStore: doublylinkedtree.New()}}
+
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	roots := map[types.Slot][32]byte{0: genesisRoot}
+	parent := genesisRoot
+	for _, slot := range []types.Slot{1, 2, 4, 32, 33, 35} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, beaconDB, b)
+		roots[slot] = r
+		parent = r
+	}
+	forkParent := roots[2]
+	fork := util.NewBeaconBlock()
+	fork.Block.Slot = 3
+	fork.Block.ParentRoot = forkParent[:]
+	forkRoot, err := fork.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, beaconDB, fork)
+
+	finalizedRoot := roots[32]
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Root: finalizedRoot[:], Slot: 32}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	require.NoError(t, c.cfg.ForkChoiceStore.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: 1, Root: finalizedRoot}))
+
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	parents := map[types.Slot][32]byte{32: roots[4], 33: roots[32], 35: roots[33]}
+	for _, slot := range []types.Slot{32, 33, 35} {
+		st, root, err := prepareForkchoiceState(ctx, slot, roots[slot], parents[slot], params.BeaconConfig().ZeroHash, cp, cp)
+		require.NoError(t, err)
+		require.NoError(t, c.cfg.ForkChoiceStore.InsertNode(ctx, st, root))
+	}
+	return c, roots, forkRoot
+}
+
+func TestService_AncestorAtSlot(t *testing.T) {
+	ctx := context.Background()
+	c, roots, forkRoot := setupAncestryChain(t)
+
+	tests := []struct {
+		name string
+		root [32]byte
+		slot types.Slot
+		want [32]byte
+	}{
+		{name: "fork choice", root: roots[35], slot: 34, want: roots[33]},
+		{name: "fork choice, finalized ancestor", root: roots[35], slot: 3, want: roots[2]},
+		{name: "finalized", root: roots[4], slot: 1, want: roots[1]},
+		{name: "finalized, later slot", root: roots[4], slot: 10, want: roots[4]},
+		{name: "non canonical", root: forkRoot, slot: 2, want: roots[2]},
+		{name: "genesis", root: roots[35], slot: 0, want: roots[0]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := c.AncestorAtSlot(ctx, tt.root, tt.slot)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r)
+		})
+	}
+
+	_, err := c.AncestorAtSlot(ctx, [32]byte{'a'}, 1)
+	require.ErrorIs(t, err, errBlockNotFoundInCacheOrDB)
+}
+
+func TestService_IsAncestor(t *testing.T) {
+	ctx := context.Background()
+	c, roots, forkRoot := setupAncestryChain(t)
+
+	tests := []struct {
+		name     string
+		ancestor [32]byte
+		root     [32]byte
+		want     bool
+	}{
+		{name: "same block", ancestor: roots[33], root: roots[33], want: true},
+		{name: "fork choice", ancestor: roots[32], root: roots[35], want: true},
+		{name: "finalized", ancestor: roots[1], root: roots[35], want: true},
+		{name: "descendant", ancestor: roots[35], root: roots[2], want: false},
+		{name: "other branch", ancestor: forkRoot, root: roots[35], want: false},
+		{name: "branch point", ancestor: roots[2], root: forkRoot, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isAncestor, err := c.IsAncestor(ctx, tt.ancestor, tt.root)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, isAncestor)
+		})
+	}
+}
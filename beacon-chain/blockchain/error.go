@@ -29,6 +29,10 @@ var (
 	errWSBlockNotFound = errors.New("weak subjectivity root not found in db")
 	// errWSBlockNotFoundInEpoch is returned when a block is not found in the WS cache or DB within epoch.
 	errWSBlockNotFoundInEpoch = errors.New("weak subjectivity root not found in db within epoch")
+	// errWSCheckpointBeforeOrigin is returned when the WS checkpoint is too old to be verified against the origin checkpoint state.
+	errWSCheckpointBeforeOrigin = errors.New("weak subjectivity checkpoint is too far before the origin checkpoint to be verified, provide a more recent checkpoint")
+	// errFinalizedAncestorNotFound is returned when no canonical block is found in the finalized block index of the DB.
+	errFinalizedAncestorNotFound = errors.New("finalized ancestor not found in db")
 	// errNotDescendantOfFinalized is returned when a block is not a descendant of the finalized checkpoint
 	errNotDescendantOfFinalized = invalidBlock{error: errors.New("not descendant of finalized checkpoint")}
 )
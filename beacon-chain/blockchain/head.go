@@ -50,10 +50,11 @@ func (s *Service) UpdateAndSaveHeadWithBalances(ctx context.Context) error {
 
 // This defines the current chain service's view of head.
 type head struct {
-	slot  types.Slot                   // current head slot.
-	root  [32]byte                     // current head root.
-	block interfaces.SignedBeaconBlock // current head block.
-	state state.BeaconState            // current head state.
+	slot    types.Slot                   // current head slot.
+	root    [32]byte                     // current head root.
+	block   interfaces.SignedBeaconBlock // current head block.
+	state   state.BeaconState            // current head state.
+	version uint64                       // incremented on every head update.
 }
 
 // This saves head info to the local service cache, it also saves the
@@ -121,6 +122,12 @@ func (s *Service) saveHead(ctx context.Context, newHeadRoot [32]byte, headBlock
 		if err := s.saveOrphanedAtts(ctx, oldHeadRoot, newHeadRoot); err != nil {
 			return err
 		}
+		if err := s.saveOrphanedOperations(ctx, oldHeadRoot, newHeadRoot, headState); err != nil {
+			log.WithError(err).Error("Could not save the operations of orphaned blocks")
+		}
+		if err := s.invalidateOrphanedAssignments(ctx, oldHeadRoot, newHeadRoot, headSlot, newHeadSlot); err != nil {
+			log.WithError(err).Error("Could not invalidate committee assignments of orphaned blocks")
+		}
 		reorgCount.Inc()
 	}
 
@@ -169,11 +176,13 @@ func (s *Service) setHead(root [32]byte, block interfaces.SignedBeaconBlock, sta
 
 	// This does a full copy of the block and state.
 	s.head = &head{
-		slot:  block.Block().Slot(),
-		root:  root,
-		block: block.Copy(),
-		state: state.Copy(),
+		slot:    block.Block().Slot(),
+		root:    root,
+		block:   block.Copy(),
+		state:   state.Copy(),
+		version: s.nextHeadVersion(),
 	}
+	s.updateValidatorIndexCache(state)
 }
 
 // This sets head view object which is used to track the head slot, root, block and state. The method
@@ -185,10 +194,28 @@ func (s *Service) setHeadInitialSync(root [32]byte, block interfaces.SignedBeaco
 
 	// This does a full copy of the block only.
 	s.head = &head{
-		slot:  block.Block().Slot(),
-		root:  root,
-		block: block.Copy(),
-		state: state,
+		slot:    block.Block().Slot(),
+		root:    root,
+		block:   block.Copy(),
+		state:   state,
+		version: s.nextHeadVersion(),
+	}
+	s.updateValidatorIndexCache(state)
+}
+
+// This returns the version of the next head view object.
+// This is a lock free version.
+func (s *Service) nextHeadVersion() uint64 {
+	if s.head == nil {
+		return 1
+	}
+	return s.head.version + 1
+}
+
+// This adds the validators of the new head state's registry to the validator index cache.
+func (s *Service) updateValidatorIndexCache(state state.BeaconState) {
+	if s.validatorIndexCache != nil {
+		s.validatorIndexCache.Update(state)
 	}
 }
 
@@ -246,6 +273,22 @@ func (s *Service) headValidatorIndexAtPubkey(pubKey [fieldparams.BLSPubkeyLength
 	return s.head.state.ValidatorIndexByPubkey(pubKey)
 }
 
+// This returns the validator indices referenced by the provided pubkeys in
+// the head state, resolved from the validator index cache.
+// This is a lock free version.
+func (s *Service) headValidatorIndicesAtPubkeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex {
+	if s.validatorIndexCache != nil {
+		return s.validatorIndexCache.Indices(pubKeys, s.head.state.NumValidators())
+	}
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if idx, ok := s.head.state.ValidatorIndexByPubkey(pubKey); ok {
+			indices[pubKey] = idx
+		}
+	}
+	return indices
+}
+
 // Returns true if head state exists.
 // This is the lock free version.
 func (s *Service) hasHeadState() bool {
@@ -308,6 +351,28 @@ func (s *Service) notifyNewHeadEvent(
 	return nil
 }
 
+// This drops the cached committee assignments derived from the orphaned blocks, when the reorg
+// between `orphanedRoot` and `newHeadRoot` crosses an epoch boundary.
+func (s *Service) invalidateOrphanedAssignments(ctx context.Context, orphanedRoot, newHeadRoot [32]byte, orphanedSlot, newHeadSlot types.Slot) error {
+	commonAncestorRoot, err := s.ForkChoicer().CommonAncestorRoot(ctx, newHeadRoot, orphanedRoot)
+	switch {
+	// Exit early if there's no common ancestor, the assignments are keyed by their dependent root anyway.
+	case errors.Is(err, forkchoice.ErrUnknownCommonAncestor):
+		return nil
+	case err != nil:
+		return err
+	}
+	commonAncestor, err := s.getBlock(ctx, commonAncestorRoot)
+	if err != nil {
+		return err
+	}
+	ancestorEpoch := slots.ToEpoch(commonAncestor.Block().Slot())
+	if slots.ToEpoch(orphanedSlot) > ancestorEpoch || slots.ToEpoch(newHeadSlot) > ancestorEpoch {
+		helpers.InvalidateCommitteeAssignments(ancestorEpoch + 1)
+	}
+	return nil
+}
+
 // This saves the attestations between `orphanedRoot` and the common ancestor root that is derived using `newHeadRoot`.
 // It also filters out the attestations that is one epoch older as a defense so invalid attestations don't flow into the attestation pool.
 func (s *Service) saveOrphanedAtts(ctx context.Context, orphanedRoot [32]byte, newHeadRoot [32]byte) error {
@@ -353,3 +418,51 @@ func (s *Service) saveOrphanedAtts(ctx context.Context, orphanedRoot [32]byte, n
 	}
 	return nil
 }
+
+// This saves the voluntary exits and slashings of the blocks between `orphanedRoot` and the common ancestor
+// root that is derived using `newHeadRoot` back into their pools, so they can be included in the new canonical
+// chain. The operations are validated against the new head state, which filters out the ones that the new
+// canonical chain already included.
+func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot, newHeadRoot [32]byte, headState state.ReadOnlyBeaconState) error {
+	commonAncestorRoot, err := s.ForkChoicer().CommonAncestorRoot(ctx, newHeadRoot, orphanedRoot)
+	switch {
+	// Exit early if there's no common ancestor and root doesn't exist, there would be nothing to save.
+	case errors.Is(err, forkchoice.ErrUnknownCommonAncestor):
+		return nil
+	case err != nil:
+		return err
+	}
+	for orphanedRoot != commonAncestorRoot {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		orphanedBlk, err := s.getBlock(ctx, orphanedRoot)
+		if err != nil {
+			return err
+		}
+		body := orphanedBlk.Block().Body()
+		for _, e := range body.VoluntaryExits() {
+			s.cfg.ExitPool.InsertVoluntaryExit(ctx, headState, e)
+			saveOrphanedExitCount.Inc()
+		}
+		for _, ps := range body.ProposerSlashings() {
+			s.cfg.SlashingPool.MarkOrphanedProposerSlashing(ps)
+			if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, headState, ps); err != nil {
+				log.WithError(err).Debug("Could not save orphaned proposer slashing")
+				continue
+			}
+			saveOrphanedSlashingCount.Inc()
+		}
+		for _, as := range body.AttesterSlashings() {
+			s.cfg.SlashingPool.MarkOrphanedAttesterSlashing(as)
+			if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, headState, as); err != nil {
+				log.WithError(err).Debug("Could not save orphaned attester slashing")
+				continue
+			}
+			saveOrphanedSlashingCount.Inc()
+		}
+		orphanedRoot = bytesutil.ToBytes32(orphanedBlk.Block().ParentRoot())
+	}
+	return nil
+}
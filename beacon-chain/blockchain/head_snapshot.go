@@ -0,0 +1,122 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// errNoHeadSnapshot is returned when a head snapshot is requested before the head is set.
+var errNoHeadSnapshot = errors.New("head is not set, no head snapshot available")
+
+// HeadSnapshotFetcher defines a common interface for methods in blockchain service which
+// capture a versioned snapshot of the head.
+type HeadSnapshotFetcher interface {
+	HeadSnapshot(ctx context.Context) (*HeadSnapshot, error)
+}
+
+// HeadSnapshot is a consistent view of the head block, the head state and the fork choice view of the head,
+// captured atomically with respect to head updates. Its version increases with every head update, so that two
+// snapshots of the same version describe the same head.
+type HeadSnapshot struct {
+	head       *head
+	optimistic bool
+	justified  *ethpb.Checkpoint
+	finalized  *ethpb.Checkpoint
+}
+
+// Version returns the version of the head the snapshot was captured from.
+func (h *HeadSnapshot) Version() uint64 {
+	return h.head.version
+}
+
+// Slot returns the slot of the head block.
+func (h *HeadSnapshot) Slot() types.Slot {
+	return h.head.slot
+}
+
+// Root returns the root of the head block.
+func (h *HeadSnapshot) Root() [32]byte {
+	return h.head.root
+}
+
+// Block returns a copy of the head block.
+func (h *HeadSnapshot) Block() interfaces.SignedBeaconBlock {
+	return h.head.block.Copy()
+}
+
+// State returns a copy of the head state.
+func (h *HeadSnapshot) State() state.BeaconState {
+	return h.head.state.Copy()
+}
+
+// IsOptimistic returns true if the head block was optimistic when the snapshot was captured.
+func (h *HeadSnapshot) IsOptimistic() bool {
+	return h.optimistic
+}
+
+// CurrentJustifiedCheckpt returns the justified checkpoint of fork choice when the snapshot was captured.
+func (h *HeadSnapshot) CurrentJustifiedCheckpt() *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: h.justified.Epoch, Root: bytesutil.SafeCopyBytes(h.justified.Root)}
+}
+
+// FinalizedCheckpt returns the finalized checkpoint of fork choice when the snapshot was captured.
+func (h *HeadSnapshot) FinalizedCheckpt() *ethpb.Checkpoint {
+	return &ethpb.Checkpoint{Epoch: h.finalized.Epoch, Root: bytesutil.SafeCopyBytes(h.finalized.Root)}
+}
+
+// HeadSnapshot captures the current head along with its fork choice view. The head cannot be updated while the
+// snapshot is captured. Neither the head block nor the head state are copied until they are read from the
+// snapshot, which makes capturing a snapshot cheap enough to do for every API request.
+func (s *Service) HeadSnapshot(ctx context.Context) (*HeadSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.HeadSnapshot")
+	defer span.End()
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	if !s.hasHeadState() || s.head.block == nil {
+		return nil, errNoHeadSnapshot
+	}
+	optimistic := false
+	if slots.ToEpoch(s.CurrentSlot()) >= params.BeaconConfig().BellatrixForkEpoch {
+		var err error
+		optimistic, err = s.IsOptimisticForRoot(ctx, s.head.root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check if head is optimistic")
+		}
+	}
+	justified := s.ForkChoicer().JustifiedCheckpoint()
+	finalized := s.ForkChoicer().FinalizedCheckpoint()
+	return &HeadSnapshot{
+		head:       s.head,
+		optimistic: optimistic,
+		justified:  &ethpb.Checkpoint{Epoch: justified.Epoch, Root: bytesutil.SafeCopyBytes(justified.Root[:])},
+		finalized:  &ethpb.Checkpoint{Epoch: finalized.Epoch, Root: bytesutil.SafeCopyBytes(finalized.Root[:])},
+	}, nil
+}
+
+type headSnapshotKey struct{}
+
+// ContextWithHeadSnapshot returns a context carrying the given head snapshot. The head getters of the
+// blockchain service called with this context read from the snapshot instead of the current head, so that a
+// request sees the same head throughout even if a new head is set while it is served.
+func ContextWithHeadSnapshot(ctx context.Context, snapshot *HeadSnapshot) context.Context {
+	return context.WithValue(ctx, headSnapshotKey{}, snapshot)
+}
+
+// HeadSnapshotFromContext returns the head snapshot carried by the context, or nil if there is none.
+func HeadSnapshotFromContext(ctx context.Context) *HeadSnapshot {
+	snapshot, ok := ctx.Value(headSnapshotKey{}).(*HeadSnapshot)
+	if !ok {
+		return nil
+	}
+	return snapshot
+}
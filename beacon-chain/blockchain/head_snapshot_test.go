@@ -0,0 +1,81 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestService_HeadSnapshot_NoHead(t *testing.T) {
+	c := &Service{cfg: &config{ForkChoiceStore: doublylinkedtree.New()}}
+	_, err := c.HeadSnapshot(context.Background())
+	require.ErrorIs(t, err, errNoHeadSnapshot)
+}
+
+func TestService_HeadSnapshot_IsolatedFromHeadUpdates(t *testing.T) {
+	ctx := context.Background()
+	c := &Service{cfg: &config{ForkChoiceStore: doublylinkedtree.New()}}
+
+	oldBlock := util.NewBeaconBlock()
+	oldBlock.Block.Slot = 1
+	oldWsb, err := wrapper.WrappedSignedBeaconBlock(oldBlock)
+	require.NoError(t, err)
+	oldState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, oldState.SetSlot(1))
+	oldRoot := [32]byte{'a'}
+	c.setHead(oldRoot, oldWsb, oldState)
+
+	snapshot, err := c.HeadSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), snapshot.Version())
+
+	newBlock := util.NewBeaconBlock()
+	newBlock.Block.Slot = 2
+	newWsb, err := wrapper.WrappedSignedBeaconBlock(newBlock)
+	require.NoError(t, err)
+	newState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, newState.SetSlot(2))
+	newRoot := [32]byte{'b'}
+	c.setHead(newRoot, newWsb, newState)
+
+	current, err := c.HeadSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), current.Version())
+	assert.Equal(t, newRoot, current.Root())
+
+	assert.Equal(t, types.Slot(1), snapshot.Slot())
+	assert.Equal(t, oldRoot, snapshot.Root())
+	assert.Equal(t, types.Slot(1), snapshot.Block().Block().Slot())
+	assert.Equal(t, types.Slot(1), snapshot.State().Slot())
+
+	snapshotCtx := ContextWithHeadSnapshot(ctx, snapshot)
+	r, err := c.HeadRoot(snapshotCtx)
+	require.NoError(t, err)
+	assert.Equal(t, oldRoot, bytesutil.ToBytes32(r))
+	b, err := c.HeadBlock(snapshotCtx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), b.Block().Slot())
+	st, err := c.HeadState(snapshotCtx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), st.Slot())
+
+	r, err = c.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, newRoot, bytesutil.ToBytes32(r))
+	st, err = c.HeadState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(2), st.Slot())
+}
+
+func TestHeadSnapshotFromContext_None(t *testing.T) {
+	assert.Equal(t, (*HeadSnapshot)(nil), HeadSnapshotFromContext(context.Background()))
+}
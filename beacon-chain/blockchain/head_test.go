@@ -10,6 +10,8 @@ import (
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -567,6 +569,69 @@ func TestSaveOrphanedAtts_CanFilter_DoublyLinkedTrie(t *testing.T) {
 	require.Equal(t, 0, service.cfg.AttPool.AggregatedAttestationCount())
 }
 
+func TestSaveOrphanedOperations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	service.cfg.ExitPool = voluntaryexits.NewPool()
+	service.cfg.SlashingPool = slashings.NewPool()
+
+	// Chain setup
+	// 0 -- 1 -- 2
+	//  \-3
+	st, keys := util.DeterministicGenesisState(t, 64)
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 5},
+		Signature: make([]byte, 96),
+	}
+	proposerSlashing, err := util.GenerateProposerSlashingForValidator(st, keys[1], 1)
+	require.NoError(t, err)
+	attesterSlashing, err := util.GenerateAttesterSlashingForValidator(st, keys[2], 2)
+	require.NoError(t, err)
+
+	blkG := util.NewBeaconBlock()
+	rG, err := blkG.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk1 := util.NewBeaconBlock()
+	blk1.Block.Slot = 1
+	blk1.Block.ParentRoot = rG[:]
+	blk1.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{exit}
+	r1, err := blk1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk2 := util.NewBeaconBlock()
+	blk2.Block.Slot = 2
+	blk2.Block.ParentRoot = r1[:]
+	blk2.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{proposerSlashing}
+	blk2.Block.Body.AttesterSlashings = []*ethpb.AttesterSlashing{attesterSlashing}
+	r2, err := blk2.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk3 := util.NewBeaconBlock()
+	blk3.Block.Slot = 3
+	blk3.Block.ParentRoot = rG[:]
+	r3, err := blk3.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	ojc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	ofc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	for _, blk := range []*ethpb.SignedBeaconBlock{blkG, blk1, blk2, blk3} {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		state, blkRoot, err := prepareForkchoiceState(ctx, blk.Block.Slot, r, bytesutil.ToBytes32(blk.Block.ParentRoot), [32]byte{}, ojc, ofc)
+		require.NoError(t, err)
+		require.NoError(t, service.ForkChoicer().InsertNode(ctx, state, blkRoot))
+		util.SaveBlock(t, ctx, beaconDB, blk)
+	}
+	// The operations were marked as included when the orphaned blocks were processed.
+	service.cfg.ExitPool.MarkIncluded(exit)
+	service.cfg.SlashingPool.MarkIncludedProposerSlashing(proposerSlashing)
+	service.cfg.SlashingPool.MarkIncludedAttesterSlashing(attesterSlashing)
+
+	require.NoError(t, service.saveOrphanedOperations(ctx, r2, r3, st))
+	assert.DeepEqual(t, []*ethpb.SignedVoluntaryExit{exit}, service.cfg.ExitPool.PendingExits(st, 0, true /* no limit */))
+	assert.DeepEqual(t, []*ethpb.ProposerSlashing{proposerSlashing}, service.cfg.SlashingPool.PendingProposerSlashings(ctx, st, true /* no limit */))
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{attesterSlashing}, service.cfg.SlashingPool.PendingAttesterSlashings(ctx, st, true /* no limit */))
+}
+
 func TestUpdateHead_noSavedChanges(t *testing.T) {
 	ctx := context.Background()
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 )
 
@@ -62,6 +63,17 @@ func (s *Service) getBlock(ctx context.Context, r [32]byte) (interfaces.SignedBe
 	return b, nil
 }
 
+// Returns the slot of a block for a given root `r` from either the initial sync blocks cache or the DB.
+func (s *Service) blockSlot(ctx context.Context, r [32]byte) (types.Slot, error) {
+	s.initSyncBlocksLock.RLock()
+	b, ok := s.initSyncBlocks[r]
+	s.initSyncBlocksLock.RUnlock()
+	if ok {
+		return b.Block().Slot(), nil
+	}
+	return s.cfg.BeaconDB.BlockSlot(ctx, r)
+}
+
 // This retrieves all the beacon blocks from the initial sync blocks cache, the returned
 // blocks are unordered.
 func (s *Service) getInitSyncBlocks() []interfaces.SignedBeaconBlock {
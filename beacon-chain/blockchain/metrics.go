@@ -115,6 +115,14 @@ var (
 		Name: "saved_orphaned_att_total",
 		Help: "Count the number of times an orphaned attestation is saved",
 	})
+	saveOrphanedExitCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "saved_orphaned_exit_total",
+		Help: "Count the number of times an orphaned voluntary exit is saved",
+	})
+	saveOrphanedSlashingCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "saved_orphaned_slashing_total",
+		Help: "Count the number of times an orphaned slashing is saved",
+	})
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -59,6 +60,14 @@ func WithExecutionEngineCaller(c powchain.EngineCaller) Option {
 	}
 }
 
+// WithBlockFetcher to look up the execution blocks recorded in the deposit snapshot.
+func WithBlockFetcher(f powchain.POWBlockFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.BlockFetcher = f
+		return nil
+	}
+}
+
 // WithDepositCache for deposit lifecycle after chain inclusion.
 func WithDepositCache(c *depositcache.DepositCache) Option {
 	return func(s *Service) error {
@@ -99,6 +108,14 @@ func WithSlashingPool(p slashings.PoolManager) Option {
 	}
 }
 
+// WithBLSToExecPool for pruning the BLS to execution changes applied by the chain.
+func WithBLSToExecPool(p blstoexec.PoolManager) Option {
+	return func(s *Service) error {
+		s.cfg.BLSToExecPool = p
+		return nil
+	}
+}
+
 // WithP2PBroadcaster to broadcast messages after appropriate processing.
 func WithP2PBroadcaster(p p2p.Broadcaster) Option {
 	return func(s *Service) error {
@@ -468,6 +468,12 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState state.Beaco
 		if err := helpers.UpdateProposerIndicesInCache(ctx, copied); err != nil {
 			return err
 		}
+		// Compute the assignments of the next epoch ahead of the validator duties requests.
+		go func() {
+			if _, _, err := helpers.CommitteeAssignments(s.ctx, copied, coreTime.CurrentEpoch(copied)); err != nil {
+				log.WithError(err).Error("Could not compute committee assignments of the next epoch")
+			}
+		}()
 	} else if postState.Slot() >= s.nextEpochBoundarySlot {
 		s.headLock.RLock()
 		st := s.head.state
@@ -475,6 +481,7 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState state.Beaco
 		if err := reportEpochMetrics(ctx, postState, st); err != nil {
 			return err
 		}
+		s.pruneBLSToExecChanges(ctx, st)
 
 		var err error
 		s.nextEpochBoundarySlot, err = slots.EpochStart(coreTime.NextEpoch(postState))
@@ -495,6 +502,20 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState state.Beaco
 	return nil
 }
 
+// pruneBLSToExecChanges removes the BLS to execution changes which the head state applied already, or which
+// cannot be applied anymore, and saves the remaining ones so that they are not lost if the node stops abruptly.
+func (s *Service) pruneBLSToExecChanges(ctx context.Context, headState state.ReadOnlyBeaconState) {
+	if s.cfg.BLSToExecPool == nil || headState == nil || headState.IsNil() {
+		return
+	}
+	if pruned := s.cfg.BLSToExecPool.PruneChanges(headState); pruned > 0 {
+		log.WithField("count", pruned).Debug("Pruned BLS to execution changes")
+	}
+	if err := s.cfg.BeaconDB.SaveBLSToExecChanges(ctx, s.cfg.BLSToExecPool.PendingBLSToExecChanges()); err != nil {
+		log.WithError(err).Error("Could not save BLS to execution changes")
+	}
+}
+
 // This feeds in the block and block's attestations to fork choice store. It's allows fork choice store
 // to gain information on the most current chain.
 func (s *Service) insertBlockAndAttestationsToForkChoiceStore(ctx context.Context, blk interfaces.BeaconBlock, root [32]byte, st state.BeaconState) error {
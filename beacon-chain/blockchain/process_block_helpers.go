@@ -184,32 +184,38 @@ func (s *Service) ancestor(ctx context.Context, root []byte, slot types.Slot) ([
 	ctx, span := trace.StartSpan(ctx, "blockChain.ancestor")
 	defer span.End()
 
-	r := bytesutil.ToBytes32(root)
-	// Get ancestor root from fork choice store instead of recursively looking up blocks in DB.
-	// This is most optimal outcome.
-	ar, err := s.ancestorByForkChoiceStore(ctx, r, slot)
+	ar, err := s.AncestorAtSlot(ctx, bytesutil.ToBytes32(root), slot)
 	if err != nil {
-		// Try getting ancestor root from DB when failed to retrieve from fork choice store.
-		// This is the second line of defense for retrieving ancestor root.
-		ar, err = s.ancestorByDB(ctx, r, slot)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
-
-	return ar, nil
+	return ar[:], nil
 }
 
-// This retrieves an ancestor root using fork choice store. The look up is looping through the a flat array structure.
-func (s *Service) ancestorByForkChoiceStore(ctx context.Context, r [32]byte, slot types.Slot) ([]byte, error) {
-	ctx, span := trace.StartSpan(ctx, "blockChain.ancestorByForkChoiceStore")
+// This retrieves the canonical root at or before the slot from the finalized block index of the DB, without walking
+// the chain. The slot must precede the finalized checkpoint, as only older blocks are known to be canonical in the index.
+func (s *Service) finalizedAncestorAtSlot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.finalizedAncestorAtSlot")
 	defer span.End()
 
-	if !s.cfg.ForkChoiceStore.HasParent(r) {
-		return nil, errors.New("could not find root in fork choice store")
+	for {
+		if ctx.Err() != nil {
+			return [32]byte{}, ctx.Err()
+		}
+		// The slot index is searched strictly below the requested slot.
+		rootsSlot, roots, err := s.cfg.BeaconDB.HighestRootsBelowSlot(ctx, slot+1)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		for _, r := range roots {
+			if r != params.BeaconConfig().ZeroHash && s.cfg.BeaconDB.IsFinalizedBlock(ctx, r) {
+				return r, nil
+			}
+		}
+		if rootsSlot == 0 {
+			return [32]byte{}, errFinalizedAncestorNotFound
+		}
+		slot = rootsSlot - 1
 	}
-	root, err := s.cfg.ForkChoiceStore.AncestorRoot(ctx, r, slot)
-	return root[:], err
 }
 
 // This retrieves an ancestor root using DB. The look up is recursively looking up DB. Slower than `ancestorByForkChoiceStore`.
@@ -294,7 +300,13 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) e
 	// to be included(rather than the last one to be processed). This was most likely
 	// done as the state cannot represent signed integers.
 	eth1DepositIndex -= 1
-	s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(eth1DepositIndex))
+	// The deposit snapshot records the execution block of the finalized eth1 data along with its height.
+	executionHash := bytesutil.ToBytes32(finalizedState.Eth1Data().BlockHash)
+	executionNumber, err := s.executionBlockHeight(ctx, executionHash)
+	if err != nil {
+		return errors.Wrap(err, "could not get height of finalized execution block")
+	}
+	s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(eth1DepositIndex), executionHash, executionNumber)
 	// Deposit proofs are only used during state transition and can be safely removed to save space.
 	if err = s.cfg.DepositCache.PruneProofs(ctx, int64(eth1DepositIndex)); err != nil {
 		return errors.Wrap(err, "could not prune deposit proofs")
@@ -327,3 +339,18 @@ func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
 	}
 	return root
 }
+
+// executionBlockHeight returns the height of the execution block with the given hash.
+func (s *Service) executionBlockHeight(ctx context.Context, hash [32]byte) (uint64, error) {
+	if s.cfg.BlockFetcher == nil {
+		return 0, errors.New("no execution block fetcher")
+	}
+	exists, height, err := s.cfg.BlockFetcher.BlockExists(ctx, hash)
+	if err != nil {
+		return 0, err
+	}
+	if !exists || height == nil {
+		return 0, fmt.Errorf("execution block %#x not found", hash)
+	}
+	return height.Uint64(), nil
+}
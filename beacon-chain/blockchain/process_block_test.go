@@ -24,16 +24,18 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -1132,9 +1134,9 @@ func TestHandleEpochBoundary_BadMetrics(t *testing.T) {
 	s, err := util.NewBeaconState()
 	require.NoError(t, err)
 	require.NoError(t, s.SetSlot(1))
-	service.head = &head{state: (*v1.BeaconState)(nil)}
+	service.head = &head{state: &statenative.BeaconState{}}
 
-	require.ErrorContains(t, "failed to initialize precompute: nil inner state", service.handleEpochBoundary(ctx, s))
+	require.ErrorContains(t, "failed to initialize precompute: nil validators in state", service.handleEpochBoundary(ctx, s))
 }
 
 func TestHandleEpochBoundary_UpdateFirstSlot(t *testing.T) {
@@ -1150,6 +1152,45 @@ func TestHandleEpochBoundary_UpdateFirstSlot(t *testing.T) {
 	require.Equal(t, 3*params.BeaconConfig().SlotsPerEpoch, service.nextEpochBoundarySlot)
 }
 
+func TestHandleEpochBoundary_PruneBLSToExecChanges(t *testing.T) {
+	ctx := context.Background()
+	pool := blstoexec.NewPool()
+	opts := append(testServiceOptsWithDB(t), WithBLSToExecPool(pool))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	s, _ := util.DeterministicGenesisState(t, 4)
+	for i := types.ValidatorIndex(0); i < 6; i++ {
+		pubkey := bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength)
+		if i < 2 {
+			val, err := s.ValidatorAtIndex(i)
+			require.NoError(t, err)
+			cred := hash.Hash(pubkey)
+			cred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+			val.WithdrawalCredentials = cred[:]
+			require.NoError(t, s.UpdateValidatorAtIndex(i, val))
+		}
+		pool.InsertBLSToExecChange(&ethpb.SignedBLSToExecutionChange{
+			Message: &ethpb.BLSToExecutionChange{
+				ValidatorIndex:     i,
+				FromBlsPubkey:      pubkey,
+				ToExecutionAddress: make([]byte, 20),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		})
+	}
+	service.head = &head{state: s}
+	require.NoError(t, s.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, service.handleEpochBoundary(ctx, s))
+
+	// Only the changes matching the withdrawal credentials of the head state are kept, and saved.
+	pending := pool.PendingBLSToExecChanges()
+	require.Equal(t, 2, len(pending))
+	saved, err := service.cfg.BeaconDB.BLSToExecChanges(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pending, saved)
+}
+
 func TestOnBlock_CanFinalize_WithOnTick(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -1340,7 +1381,8 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 	opts := testServiceOptsWithDB(t)
 	depositCache, err := depositcache.New()
 	require.NoError(t, err)
-	opts = append(opts, WithDepositCache(depositCache))
+	blockFetcher := &mockPOW.POWChain{HashesByHeight: map[int][]byte{100: make([]byte, 32)}}
+	opts = append(opts, WithDepositCache(depositCache), WithBlockFetcher(blockFetcher))
 	service, err := NewService(ctx, opts...)
 	require.NoError(t, err)
 
@@ -1367,6 +1409,34 @@ func TestInsertFinalizedDeposits(t *testing.T) {
 	for _, d := range deps {
 		assert.DeepEqual(t, [][]byte(nil), d.Proof, "Proofs are not empty")
 	}
+	snapshot, err := depositCache.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), snapshot.ExecutionDepth)
+}
+
+func TestInsertFinalizedDeposits_UnknownExecutionBlock(t *testing.T) {
+	ctx := context.Background()
+	opts := testServiceOptsWithDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	opts = append(opts, WithDepositCache(depositCache), WithBlockFetcher(&mockPOW.POWChain{}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	gs, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.saveGenesisData(ctx, gs))
+	gs = gs.Copy()
+	assert.NoError(t, gs.SetEth1Data(&ethpb.Eth1Data{DepositCount: 10}))
+	assert.NoError(t, gs.SetEth1DepositIndex(8))
+	assert.NoError(t, service.cfg.StateGen.SaveState(ctx, [32]byte{'m', 'o', 'c', 'k'}, gs))
+	assert.NoError(t, depositCache.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+		PublicKey:             bytesutil.FromBytes48([fieldparams.BLSPubkeyLength]byte{}),
+		WithdrawalCredentials: params.BeaconConfig().ZeroHash[:],
+		Signature:             make([]byte, fieldparams.BLSSignatureLength),
+	}}, 100, 0, [32]byte{}))
+
+	assert.ErrorContains(t, "could not get height of finalized execution block", service.insertFinalizedDeposits(ctx, [32]byte{'m', 'o', 'c', 'k'}))
+	assert.Equal(t, int64(-1), depositCache.FinalizedDeposits(ctx).MerkleTrieIndex)
 }
 
 func TestInsertFinalizedDeposits_MultipleFinalizedRoutines(t *testing.T) {
@@ -1374,7 +1444,8 @@ func TestInsertFinalizedDeposits_MultipleFinalizedRoutines(t *testing.T) {
 	opts := testServiceOptsWithDB(t)
 	depositCache, err := depositcache.New()
 	require.NoError(t, err)
-	opts = append(opts, WithDepositCache(depositCache))
+	blockFetcher := &mockPOW.POWChain{HashesByHeight: map[int][]byte{100: make([]byte, 32)}}
+	opts = append(opts, WithDepositCache(depositCache), WithBlockFetcher(blockFetcher))
 	service, err := NewService(ctx, opts...)
 	require.NoError(t, err)
 
@@ -1399,7 +1470,7 @@ func TestInsertFinalizedDeposits_MultipleFinalizedRoutines(t *testing.T) {
 		}, Proof: [][]byte{root}}, 100+i, int64(i), bytesutil.ToBytes32(root)))
 	}
 	// Insert 3 deposits before hand.
-	depositCache.InsertFinalizedDeposits(ctx, 2)
+	depositCache.InsertFinalizedDeposits(ctx, 2, [32]byte{}, 0)
 
 	assert.NoError(t, service.insertFinalizedDeposits(ctx, [32]byte{'m', 'o', 'c', 'k'}))
 	fDeposits := depositCache.FinalizedDeposits(ctx)
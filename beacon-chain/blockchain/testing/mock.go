@@ -359,6 +359,48 @@ func (s *ChainService) IsCanonical(_ context.Context, r [32]byte) (bool, error)
 	return true, nil
 }
 
+// AncestorAtSlot mocks the same method in the chain service, walking up the blocks of the mock DB.
+func (s *ChainService) AncestorAtSlot(ctx context.Context, root [32]byte, slot types.Slot) ([32]byte, error) {
+	if s.DB == nil {
+		return [32]byte{}, errors.New("nil db")
+	}
+	for {
+		blk, err := s.DB.Block(ctx, root)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if blk == nil || blk.IsNil() {
+			return [32]byte{}, errors.New("block not found")
+		}
+		if blk.Block().Slot() <= slot {
+			return root, nil
+		}
+		root = bytesutil.ToBytes32(blk.Block().ParentRoot())
+	}
+}
+
+// IsAncestor mocks the same method in the chain service.
+func (s *ChainService) IsAncestor(ctx context.Context, ancestorRoot [32]byte, root [32]byte) (bool, error) {
+	if ancestorRoot == root {
+		return true, nil
+	}
+	if s.DB == nil {
+		return false, errors.New("nil db")
+	}
+	ancestor, err := s.DB.Block(ctx, ancestorRoot)
+	if err != nil {
+		return false, err
+	}
+	if ancestor == nil || ancestor.IsNil() {
+		return false, errors.New("block not found")
+	}
+	ar, err := s.AncestorAtSlot(ctx, root, ancestor.Block().Slot())
+	if err != nil {
+		return false, err
+	}
+	return ar == ancestorRoot, nil
+}
+
 // HasBlock mocks the same method in the chain service.
 func (s *ChainService) HasBlock(ctx context.Context, rt [32]byte) bool {
 	if s.DB == nil {
@@ -413,6 +455,20 @@ func (_ *ChainService) HeadPublicKeyToValidatorIndex(_ [fieldparams.BLSPubkeyLen
 	return 0, true
 }
 
+// HeadPublicKeysToValidatorIndices mocks HeadPublicKeysToValidatorIndices and resolves the indices from the state.
+func (s *ChainService) HeadPublicKeysToValidatorIndices(pubKeys [][fieldparams.BLSPubkeyLength]byte) map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex {
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, len(pubKeys))
+	if s.State == nil {
+		return indices
+	}
+	for _, pubKey := range pubKeys {
+		if idx, ok := s.State.ValidatorIndexByPubkey(pubKey); ok {
+			indices[pubKey] = idx
+		}
+	}
+	return indices
+}
+
 // HeadValidatorIndexToPublicKey mocks HeadValidatorIndexToPublicKey and always return empty and nil.
 func (s *ChainService) HeadValidatorIndexToPublicKey(_ context.Context, _ types.ValidatorIndex) ([fieldparams.BLSPubkeyLength]byte, error) {
 	return s.PublicKey, nil
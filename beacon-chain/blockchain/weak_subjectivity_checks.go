@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -16,6 +18,8 @@ import (
 type weakSubjectivityDB interface {
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
+	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 }
 
 type WeakSubjectivityVerifier struct {
@@ -72,6 +76,15 @@ func (v *WeakSubjectivityVerifier) VerifyWeakSubjectivity(ctx context.Context, f
 	log.Infof("Performing weak subjectivity check for root %#x in epoch %d", v.root, v.epoch)
 
 	if !v.db.HasBlock(ctx, v.root) {
+		origin, err := v.originState(ctx)
+		if err != nil {
+			return err
+		}
+		if origin != nil && origin.Slot() > v.slot {
+			// A checkpoint synced node has no history before its origin until it is backfilled, so the
+			// weak subjectivity checkpoint is checked against the block roots of the trusted origin state.
+			return v.verifyWithOriginState(origin)
+		}
 		return errors.Wrap(errWSBlockNotFound, fmt.Sprintf("missing root %#x", v.root))
 	}
 	endSlot := v.slot + params.BeaconConfig().SlotsPerEpoch
@@ -91,3 +104,42 @@ func (v *WeakSubjectivityVerifier) VerifyWeakSubjectivity(ctx context.Context, f
 	}
 	return errors.Wrap(errWSBlockNotFoundInEpoch, fmt.Sprintf("root=%#x, epoch=%d", v.root, v.epoch))
 }
+
+// originState returns the state of the origin checkpoint the node was synced from, or nil if the node was
+// synced from genesis.
+func (v *WeakSubjectivityVerifier) originState(ctx context.Context) (state.BeaconState, error) {
+	originRoot, err := v.db.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve origin checkpoint block root")
+	}
+	st, err := v.db.State(ctx, originRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve origin checkpoint state")
+	}
+	if st == nil || st.IsNil() {
+		return nil, fmt.Errorf("origin checkpoint state %#x not found", originRoot)
+	}
+	return st, nil
+}
+
+// verifyWithOriginState checks that the weak subjectivity root is the block root at the start of its epoch in
+// the block roots of the origin state. Older checkpoints are only committed to by the historical roots of the
+// state, which cannot be checked without the blocks, so they are rejected.
+func (v *WeakSubjectivityVerifier) verifyWithOriginState(origin state.ReadOnlyBeaconState) error {
+	if origin.Slot() > v.slot+params.BeaconConfig().SlotsPerHistoricalRoot {
+		return errors.Wrap(errWSCheckpointBeforeOrigin, fmt.Sprintf("epoch=%d, origin slot=%d", v.epoch, origin.Slot()))
+	}
+	root, err := origin.BlockRootAtIndex(uint64(v.slot % params.BeaconConfig().SlotsPerHistoricalRoot))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve block root from origin checkpoint state")
+	}
+	if bytesutil.ToBytes32(root) != v.root {
+		return errors.Wrap(errWSBlockNotFoundInEpoch, fmt.Sprintf("root=%#x, epoch=%d, origin state root=%#x", v.root, v.epoch, root))
+	}
+	log.Info("Weak subjectivity check has passed against the origin checkpoint state")
+	v.verified = true
+	return nil
+}
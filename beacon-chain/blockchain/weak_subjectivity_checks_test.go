@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -88,3 +89,50 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestService_VerifyWeakSubjectivityRoot_CheckpointSynced(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 1792480
+	util.SaveBlock(t, ctx, beaconDB, b)
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginCheckpointBlockRoot(ctx, r))
+	originEpoch := slots.ToEpoch(b.Block.Slot)
+
+	// The origin state holds the root of the block at the start of the previous epoch.
+	wsRoot := bytesutil.PadTo([]byte{'w'}, fieldparams.RootLength)
+	wsSlot, err := slots.EpochStart(originEpoch - 1)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(b.Block.Slot))
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(wsSlot%params.BeaconConfig().SlotsPerHistoricalRoot), bytesutil.ToBytes32(wsRoot)))
+	require.NoError(t, beaconDB.SaveState(ctx, st, r))
+	missing := bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength)
+
+	wv, err := NewWeakSubjectivityVerifier(&ethpb.Checkpoint{Root: wsRoot, Epoch: originEpoch - 1}, beaconDB)
+	require.NoError(t, err)
+	require.NoError(t, wv.VerifyWeakSubjectivity(ctx, originEpoch+1))
+	require.Equal(t, true, wv.verified)
+
+	wv, err = NewWeakSubjectivityVerifier(&ethpb.Checkpoint{Root: missing, Epoch: originEpoch - 1}, beaconDB)
+	require.NoError(t, err)
+	err = wv.VerifyWeakSubjectivity(ctx, originEpoch+1)
+	require.Equal(t, true, errors.Is(err, errWSBlockNotFoundInEpoch))
+	require.Equal(t, false, wv.verified)
+
+	historicalEpochs := types.Epoch(params.BeaconConfig().SlotsPerHistoricalRoot) / types.Epoch(params.BeaconConfig().SlotsPerEpoch)
+	wv, err = NewWeakSubjectivityVerifier(&ethpb.Checkpoint{Root: wsRoot, Epoch: originEpoch - historicalEpochs - 1}, beaconDB)
+	require.NoError(t, err)
+	err = wv.VerifyWeakSubjectivity(ctx, originEpoch+1)
+	require.Equal(t, true, errors.Is(err, errWSCheckpointBeforeOrigin))
+	require.Equal(t, false, wv.verified)
+
+	wv, err = NewWeakSubjectivityVerifier(&ethpb.Checkpoint{Root: missing, Epoch: originEpoch}, beaconDB)
+	require.NoError(t, err)
+	err = wv.VerifyWeakSubjectivity(ctx, originEpoch+1)
+	require.Equal(t, true, errors.Is(err, errWSBlockNotFound))
+}
@@ -34,4 +34,10 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	refreshedRegistrations = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "refreshed_validator_registrations_total",
+			Help: "Count the number of validator registrations resubmitted to the builder relay network",
+		},
+	)
 )
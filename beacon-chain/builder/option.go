@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
@@ -16,6 +18,7 @@ func FlagOptions(c *cli.Context) ([]Option, error) {
 	endpoint := c.String(flags.MevRelayEndpoint.Name)
 	opts := []Option{
 		WithBuilderEndpoints(endpoint),
+		WithRegistrationRefreshInterval(c.Duration(flags.BuilderRegistrationRefreshInterval.Name)),
 	}
 	return opts, nil
 }
@@ -28,6 +31,15 @@ func WithBuilderEndpoints(endpoint string) Option {
 	}
 }
 
+// WithRegistrationRefreshInterval sets how often the stored validator registrations are resubmitted
+// to the builder relay network. A zero interval disables the refresh.
+func WithRegistrationRefreshInterval(interval time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.registrationRefreshInterval = interval
+		return nil
+	}
+}
+
 // WithHeadFetcher gets the head info from chain service.
 func WithHeadFetcher(svc *blockchain.Service) Option {
 	return func(s *Service) error {
@@ -28,9 +28,10 @@ type BlockBuilder interface {
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderEndpoint network.Endpoint
-	beaconDB        db.HeadAccessDatabase
-	headFetcher     blockchain.HeadFetcher
+	builderEndpoint             network.Endpoint
+	beaconDB                    db.HeadAccessDatabase
+	headFetcher                 blockchain.HeadFetcher
+	registrationRefreshInterval time.Duration
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
@@ -71,11 +72,18 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	return s, nil
 }
 
-// Start initializes the service.
-func (*Service) Start() {}
+// Start initializes the service, and starts refreshing the stored validator registrations to the
+// builder relay network.
+func (s *Service) Start() {
+	if s.c == nil || s.cfg.registrationRefreshInterval == 0 {
+		return
+	}
+	go s.refreshRegistrations()
+}
 
 // Stop halts the service.
-func (*Service) Stop() error {
+func (s *Service) Stop() error {
+	s.cancel()
 	return nil
 }
 
@@ -121,7 +129,7 @@ func (s *Service) Status() error {
 }
 
 // RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
+// It also saves the signed registration object to the DB, to refresh it to the relays.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
@@ -131,7 +139,6 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	}()
 
 	idxs := make([]types.ValidatorIndex, 0)
-	msgs := make([]*ethpb.ValidatorRegistrationV1, 0)
 	valid := make([]*ethpb.SignedValidatorRegistrationV1, 0)
 	for i := 0; i < len(reg); i++ {
 		r := reg[i]
@@ -143,14 +150,51 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 			continue
 		}
 		idxs = append(idxs, nx)
-		msgs = append(msgs, r.Message)
 		valid = append(valid, r)
 	}
 	if err := s.c.RegisterValidator(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
-	return s.cfg.beaconDB.SaveRegistrationsByValidatorIDs(ctx, idxs, msgs)
+	return s.cfg.beaconDB.SaveSignedRegistrationsByValidatorIDs(ctx, idxs, valid)
+}
+
+// refreshRegistrations resubmits the stored validator registrations to the builder relay network on
+// every refresh interval, so that the relays keep them across restarts of the beacon node, even if
+// validators do not register again.
+func (s *Service) refreshRegistrations() {
+	ticker := time.NewTicker(s.cfg.registrationRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.resubmitRegistrations(s.ctx); err != nil {
+				log.WithError(err).Warn("Could not refresh validator registrations")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// resubmitRegistrations submits all the stored validator registrations to the builder relay network.
+func (s *Service) resubmitRegistrations(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "builder.resubmitRegistrations")
+	defer span.End()
+
+	regs, err := s.cfg.beaconDB.SignedRegistrations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get validator registrations")
+	}
+	if len(regs) == 0 {
+		return nil
+	}
+	if err := s.c.RegisterValidator(ctx, regs); err != nil {
+		return errors.Wrap(err, "could not register validator(s)")
+	}
+	refreshedRegistrations.Add(float64(len(regs)))
+	log.WithField("count", len(regs)).Debug("Refreshed validator registrations")
+	return nil
 }
 
 // Configured returns true if the user has input a builder URL.
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+// maxBlockArrivals is the number of recent block arrivals kept, two epochs of blocks on mainnet.
+const maxBlockArrivals = 64
+
+// BlockArrival records when a block was received over gossip, relative to the start of its slot.
+type BlockArrival struct {
+	Root          [32]byte
+	Slot          types.Slot
+	ProposerIndex types.ValidatorIndex
+	Delay         time.Duration
+}
+
+// BlockArrivalCache keeps the arrivals of the most recent blocks received over gossip.
+type BlockArrivalCache struct {
+	arrivals []BlockArrival
+	lock     sync.RWMutex
+}
+
+// NewBlockArrivalCache creates a new block arrival cache.
+func NewBlockArrivalCache() *BlockArrivalCache {
+	return &BlockArrivalCache{
+		arrivals: make([]BlockArrival, 0, maxBlockArrivals),
+	}
+}
+
+// Add records the arrival of a block, evicting the oldest arrival once the cache is full. Only the first
+// arrival of a block is kept.
+func (c *BlockArrivalCache) Add(arrival BlockArrival) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, a := range c.arrivals {
+		if a.Root == arrival.Root {
+			return
+		}
+	}
+	if len(c.arrivals) == maxBlockArrivals {
+		copy(c.arrivals, c.arrivals[1:])
+		c.arrivals = c.arrivals[:maxBlockArrivals-1]
+	}
+	c.arrivals = append(c.arrivals, arrival)
+}
+
+// Get returns the arrival of the block with the given root, if it is among the recent arrivals.
+func (c *BlockArrivalCache) Get(root [32]byte) (BlockArrival, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, a := range c.arrivals {
+		if a.Root == root {
+			return a, true
+		}
+	}
+	return BlockArrival{}, false
+}
+
+// Recent returns the recent arrivals, from the oldest to the latest.
+func (c *BlockArrivalCache) Recent() []BlockArrival {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	arrivals := make([]BlockArrival, len(c.arrivals))
+	copy(arrivals, c.arrivals)
+	return arrivals
+}
@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestBlockArrivalCache_AddAndGet(t *testing.T) {
+	c := NewBlockArrivalCache()
+	_, ok := c.Get([32]byte{'a'})
+	require.Equal(t, false, ok)
+
+	c.Add(BlockArrival{Root: [32]byte{'a'}, Slot: 1, ProposerIndex: 2, Delay: time.Second})
+	c.Add(BlockArrival{Root: [32]byte{'a'}, Slot: 1, ProposerIndex: 2, Delay: 2 * time.Second})
+	a, ok := c.Get([32]byte{'a'})
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(1), a.Slot)
+	assert.Equal(t, types.ValidatorIndex(2), a.ProposerIndex)
+	assert.Equal(t, time.Second, a.Delay)
+	assert.Equal(t, 1, len(c.Recent()))
+}
+
+func TestBlockArrivalCache_EvictsOldest(t *testing.T) {
+	c := NewBlockArrivalCache()
+	for i := 0; i < maxBlockArrivals+2; i++ {
+		c.Add(BlockArrival{Root: [32]byte{byte(i)}, Slot: types.Slot(i)})
+	}
+	recent := c.Recent()
+	require.Equal(t, maxBlockArrivals, len(recent))
+	assert.Equal(t, types.Slot(2), recent[0].Slot)
+	assert.Equal(t, types.Slot(maxBlockArrivals+1), recent[len(recent)-1].Slot)
+	_, ok := c.Get([32]byte{0})
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+var (
+	// maxCommitteeAssignmentsSize defines the max number of epoch assignments the cache can contain.
+	// Assignments are requested for the current and next epochs, an entry holds a map over the
+	// whole validator registry.
+	maxCommitteeAssignmentsSize = 4
+
+	// Metrics.
+	committeeAssignmentsCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "committee_assignments_cache_miss",
+		Help: "The number of committee assignments requests that aren't present in the cache.",
+	})
+	committeeAssignmentsCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "committee_assignments_cache_hit",
+		Help: "The number of committee assignments requests that are present in the cache.",
+	})
+)
+
+// CommitteeAssignment is the attestation committee assignment of a validator in an epoch.
+type CommitteeAssignment struct {
+	Committee      []types.ValidatorIndex
+	AttesterSlot   types.Slot
+	CommitteeIndex types.CommitteeIndex
+}
+
+// CommitteeAssignments holds the committee and proposer assignments of the validators in an epoch.
+type CommitteeAssignments struct {
+	// Committees maps the active validators to their committee assignment.
+	Committees map[types.ValidatorIndex]*CommitteeAssignment
+	// ProposerSlots maps the proposers of the epoch to the slots they propose at.
+	ProposerSlots map[types.ValidatorIndex][]types.Slot
+}
+
+type committeeAssignmentsKey struct {
+	dependentRoot [32]byte
+	epoch         types.Epoch
+}
+
+// CommitteeAssignmentsCache stores the committee and proposer assignments of an epoch, keyed by
+// the root of the block they depend on, so that they are computed once for the duties requests
+// and the gossip validation of the epoch.
+type CommitteeAssignmentsCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewCommitteeAssignmentsCache creates a new committee assignments cache.
+func NewCommitteeAssignmentsCache() *CommitteeAssignmentsCache {
+	return &CommitteeAssignmentsCache{
+		cache: lruwrpr.New(maxCommitteeAssignmentsSize),
+	}
+}
+
+// Get returns the assignments of the epoch depending on the given block root, or nil if they are
+// not in the cache.
+func (c *CommitteeAssignmentsCache) Get(dependentRoot [32]byte, epoch types.Epoch) *CommitteeAssignments {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(committeeAssignmentsKey{dependentRoot: dependentRoot, epoch: epoch})
+	if !exists || item == nil {
+		committeeAssignmentsCacheMiss.Inc()
+		return nil
+	}
+	committeeAssignmentsCacheHit.Inc()
+	return item.(*CommitteeAssignments)
+}
+
+// Add stores the assignments of the epoch depending on the given block root. The assignments must
+// not be modified once added.
+func (c *CommitteeAssignmentsCache) Add(dependentRoot [32]byte, epoch types.Epoch, assignments *CommitteeAssignments) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(committeeAssignmentsKey{dependentRoot: dependentRoot, epoch: epoch}, assignments)
+}
+
+// RemoveFromEpoch removes the assignments of the given epoch and of all the later epochs.
+func (c *CommitteeAssignmentsCache) RemoveFromEpoch(epoch types.Epoch) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, k := range c.cache.Keys() {
+		key, ok := k.(committeeAssignmentsKey)
+		if ok && key.epoch >= epoch {
+			c.cache.Remove(k)
+		}
+	}
+}
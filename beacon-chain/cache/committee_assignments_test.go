@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestCommitteeAssignmentsCache_GetAndAdd(t *testing.T) {
+	c := NewCommitteeAssignmentsCache()
+	root := [32]byte{'a'}
+	assert.Equal(t, (*CommitteeAssignments)(nil), c.Get(root, 1))
+
+	assignment := &CommitteeAssignment{Committee: []types.ValidatorIndex{1, 2}, AttesterSlot: 33, CommitteeIndex: 1}
+	assignments := &CommitteeAssignments{
+		Committees:    map[types.ValidatorIndex]*CommitteeAssignment{1: assignment, 2: assignment},
+		ProposerSlots: map[types.ValidatorIndex][]types.Slot{2: {34}},
+	}
+	c.Add(root, 1, assignments)
+	require.DeepEqual(t, assignments, c.Get(root, 1))
+	// The assignments are keyed by both the dependent root and the epoch.
+	assert.Equal(t, (*CommitteeAssignments)(nil), c.Get(root, 2))
+	assert.Equal(t, (*CommitteeAssignments)(nil), c.Get([32]byte{'b'}, 1))
+}
+
+func TestCommitteeAssignmentsCache_RemoveFromEpoch(t *testing.T) {
+	c := NewCommitteeAssignmentsCache()
+	for i := 0; i < maxCommitteeAssignmentsSize; i++ {
+		c.Add([32]byte{'a'}, types.Epoch(i), &CommitteeAssignments{})
+	}
+	c.RemoveFromEpoch(2)
+	assert.Equal(t, 2, c.cache.Len())
+	assert.NotNil(t, c.Get([32]byte{'a'}, 1))
+	assert.Equal(t, (*CommitteeAssignments)(nil), c.Get([32]byte{'a'}, 2))
+}
@@ -5,6 +5,7 @@
 package depositcache
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"math/big"
@@ -14,9 +15,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositsnapshot"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
-	"github.com/prysmaticlabs/prysm/config/params"
-	"github.com/prysmaticlabs/prysm/container/trie"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
@@ -39,38 +39,48 @@ type DepositFetcher interface {
 	NonFinalizedDeposits(ctx context.Context, lastFinalizedIndex int64, untilBlk *big.Int) []*ethpb.Deposit
 }
 
+// MerkleTree defines the operations of a deposit merkle tree used to build deposit proofs.
+type MerkleTree interface {
+	HashTreeRoot() ([32]byte, error)
+	NumOfItems() int
+	Insert(item []byte, index int) error
+	MerkleProof(index int) ([][]byte, error)
+}
+
 // FinalizedDeposits stores the trie of deposits that have been included
 // in the beacon state up to the latest finalized checkpoint.
 type FinalizedDeposits struct {
-	Deposits        *trie.SparseMerkleTrie
+	Deposits        MerkleTree
 	MerkleTrieIndex int64
 }
 
+// finalizedDepositsTree is the deposit tree of the finalized deposits, which only keeps the
+// right-most branch of the finalized deposits rather than all of their leaves.
+type finalizedDepositsTree struct {
+	tree            *depositsnapshot.DepositTree
+	merkleTrieIndex int64
+}
+
 // DepositCache stores all in-memory deposit objects. This
 // stores all the deposit related data that is required by the beacon-node.
 type DepositCache struct {
 	// Beacon chain deposits in memory.
 	pendingDeposits   []*ethpb.DepositContainer
 	deposits          []*ethpb.DepositContainer
-	finalizedDeposits *FinalizedDeposits
+	finalizedDeposits *finalizedDepositsTree
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
 }
 
 // New instantiates a new deposit cache
 func New() (*DepositCache, error) {
-	finalizedDepositsTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-	if err != nil {
-		return nil, err
-	}
-
-	// finalizedDeposits.MerkleTrieIndex is initialized to -1 because it represents the index of the last trie item.
+	// finalizedDeposits.merkleTrieIndex is initialized to -1 because it represents the index of the last trie item.
 	// Inserting the first item into the trie will set the value of the index to 0.
 	return &DepositCache{
 		pendingDeposits:   []*ethpb.DepositContainer{},
 		deposits:          []*ethpb.DepositContainer{},
 		depositsByKey:     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer{},
-		finalizedDeposits: &FinalizedDeposits{Deposits: finalizedDepositsTrie, MerkleTrieIndex: -1},
+		finalizedDeposits: &finalizedDepositsTree{tree: depositsnapshot.New(), merkleTrieIndex: -1},
 	}, nil
 }
 
@@ -128,15 +138,16 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*eth
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
-// InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
-func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64) {
+// InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache. The
+// execution block is the one at which the deposits are finalized, and is recorded in the deposit snapshot.
+func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64, executionHash [32]byte, executionNumber uint64) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
 	defer span.End()
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	depositTrie := dc.finalizedDeposits.Deposits
-	insertIndex := int(dc.finalizedDeposits.MerkleTrieIndex + 1)
+	depositTrie := dc.finalizedDeposits.tree
+	insertIndex := int(dc.finalizedDeposits.merkleTrieIndex + 1)
 
 	// Don't insert into finalized trie if there is no deposit to
 	// insert.
@@ -153,8 +164,11 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	if int(eth1DepositIndex) < insertIndex {
 		return
 	}
+	// The finalized tree is only replaced once all deposits are inserted, so that
+	// a failure leaves the cache untouched.
+	depositTrie = depositTrie.Copy()
 	for _, d := range dc.deposits {
-		if d.Index <= dc.finalizedDeposits.MerkleTrieIndex {
+		if d.Index <= dc.finalizedDeposits.merkleTrieIndex {
 			continue
 		}
 		if d.Index > eth1DepositIndex {
@@ -171,13 +185,69 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 		}
 		insertIndex++
 	}
+	if err := depositTrie.Finalize(uint64(eth1DepositIndex+1), executionHash, executionNumber); err != nil {
+		log.WithError(err).Error("Could not finalize deposit tree")
+		return
+	}
 
-	dc.finalizedDeposits = &FinalizedDeposits{
-		Deposits:        depositTrie,
-		MerkleTrieIndex: eth1DepositIndex,
+	dc.finalizedDeposits = &finalizedDepositsTree{
+		tree:            depositTrie,
+		merkleTrieIndex: eth1DepositIndex,
 	}
 }
 
+// DepositSnapshot returns the EIP-4881 snapshot of the finalized deposits, or nil if no deposit was finalized yet.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	if dc.finalizedDeposits.merkleTrieIndex < 0 {
+		return nil, nil
+	}
+	snapshot, err := dc.finalizedDeposits.tree.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.ToProto(), nil
+}
+
+// RestoreFinalizedDeposits rebuilds the finalized deposits from an EIP-4881 snapshot. The snapshot must
+// agree with the deposits of the cache, as the finalized deposits are never reinserted from them.
+func (dc *DepositCache) RestoreFinalizedDeposits(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.RestoreFinalizedDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	tree, err := depositsnapshot.FromProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not rebuild deposit tree from snapshot")
+	}
+	count := snapshot.DepositCount
+	if count == 0 {
+		return nil
+	}
+	if count > uint64(len(dc.deposits)) {
+		return errors.Errorf("snapshot holds %d deposits but only %d deposits are known", count, len(dc.deposits))
+	}
+	// Each deposit container holds the root of the deposit contract right after the deposit was made.
+	root, err := tree.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root[:], dc.deposits[count-1].DepositRoot) {
+		return errors.Errorf("snapshot deposit root %#x does not match the root %#x of deposit %d",
+			root, dc.deposits[count-1].DepositRoot, count-1)
+	}
+	dc.finalizedDeposits = &finalizedDepositsTree{
+		tree:            tree,
+		merkleTrieIndex: int64(count) - 1, // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	}
+	return nil
+}
+
 // AllDepositContainers returns all historical deposit containers.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*ethpb.DepositContainer {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDepositContainers")
@@ -255,8 +325,8 @@ func (dc *DepositCache) FinalizedDeposits(ctx context.Context) *FinalizedDeposit
 	defer dc.depositsLock.RUnlock()
 
 	return &FinalizedDeposits{
-		Deposits:        dc.finalizedDeposits.Deposits.Copy(),
-		MerkleTrieIndex: dc.finalizedDeposits.MerkleTrieIndex,
+		Deposits:        dc.finalizedDeposits.tree.Copy(),
+		MerkleTrieIndex: dc.finalizedDeposits.merkleTrieIndex,
 	}
 }
 
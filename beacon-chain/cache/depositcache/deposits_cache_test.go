@@ -416,7 +416,7 @@ func TestFinalizedDeposits_DepositsCachedCorrectly(t *testing.T) {
 		Index: 3,
 	})
 
-	dc.InsertFinalizedDeposits(context.Background(), 2)
+	dc.InsertFinalizedDeposits(context.Background(), 2, [32]byte{}, 0)
 
 	cachedDeposits := dc.FinalizedDeposits(context.Background())
 	require.NotNil(t, cachedDeposits, "Deposits not cached")
@@ -474,9 +474,9 @@ func TestFinalizedDeposits_UtilizesPreviouslyCachedDeposits(t *testing.T) {
 		Index: 2,
 	}
 	dc.deposits = oldFinalizedDeposits
-	dc.InsertFinalizedDeposits(context.Background(), 1)
+	dc.InsertFinalizedDeposits(context.Background(), 1, [32]byte{}, 0)
 
-	dc.InsertFinalizedDeposits(context.Background(), 2)
+	dc.InsertFinalizedDeposits(context.Background(), 2, [32]byte{}, 0)
 
 	dc.deposits = append(dc.deposits, []*ethpb.DepositContainer{newFinalizedDeposit}...)
 
@@ -503,7 +503,7 @@ func TestFinalizedDeposits_HandleZeroDeposits(t *testing.T) {
 	dc, err := New()
 	require.NoError(t, err)
 
-	dc.InsertFinalizedDeposits(context.Background(), 2)
+	dc.InsertFinalizedDeposits(context.Background(), 2, [32]byte{}, 0)
 
 	cachedDeposits := dc.FinalizedDeposits(context.Background())
 	require.NotNil(t, cachedDeposits, "Deposits not cached")
@@ -548,7 +548,7 @@ func TestFinalizedDeposits_HandleSmallerThanExpectedDeposits(t *testing.T) {
 	}
 	dc.deposits = finalizedDeposits
 
-	dc.InsertFinalizedDeposits(context.Background(), 5)
+	dc.InsertFinalizedDeposits(context.Background(), 5, [32]byte{}, 0)
 
 	cachedDeposits := dc.FinalizedDeposits(context.Background())
 	require.NotNil(t, cachedDeposits, "Deposits not cached")
@@ -623,10 +623,10 @@ func TestFinalizedDeposits_HandleLowerEth1DepositIndex(t *testing.T) {
 	}
 	dc.deposits = finalizedDeposits
 
-	dc.InsertFinalizedDeposits(context.Background(), 5)
+	dc.InsertFinalizedDeposits(context.Background(), 5, [32]byte{}, 0)
 
 	// Reinsert finalized deposits with a lower index.
-	dc.InsertFinalizedDeposits(context.Background(), 2)
+	dc.InsertFinalizedDeposits(context.Background(), 2, [32]byte{}, 0)
 
 	cachedDeposits := dc.FinalizedDeposits(context.Background())
 	require.NotNil(t, cachedDeposits, "Deposits not cached")
@@ -639,8 +639,8 @@ func TestFinalizedDeposits_InitializedCorrectly(t *testing.T) {
 
 	finalizedDeposits := dc.finalizedDeposits
 	assert.NotNil(t, finalizedDeposits)
-	assert.NotNil(t, finalizedDeposits.Deposits)
-	assert.Equal(t, int64(-1), finalizedDeposits.MerkleTrieIndex)
+	assert.NotNil(t, finalizedDeposits.tree)
+	assert.Equal(t, int64(-1), finalizedDeposits.merkleTrieIndex)
 }
 
 func TestNonFinalizedDeposits_ReturnsAllNonFinalizedDeposits(t *testing.T) {
@@ -694,7 +694,7 @@ func TestNonFinalizedDeposits_ReturnsAllNonFinalizedDeposits(t *testing.T) {
 			},
 			Index: 3,
 		})
-	dc.InsertFinalizedDeposits(context.Background(), 1)
+	dc.InsertFinalizedDeposits(context.Background(), 1, [32]byte{}, 0)
 
 	deps := dc.NonFinalizedDeposits(context.Background(), 1, nil)
 	assert.Equal(t, 2, len(deps))
@@ -751,7 +751,7 @@ func TestNonFinalizedDeposits_ReturnsNonFinalizedDepositsUpToBlockNumber(t *test
 			},
 			Index: 3,
 		})
-	dc.InsertFinalizedDeposits(context.Background(), 1)
+	dc.InsertFinalizedDeposits(context.Background(), 1, [32]byte{}, 0)
 
 	deps := dc.NonFinalizedDeposits(context.Background(), 1, big.NewInt(10))
 	assert.Equal(t, 1, len(deps))
@@ -799,10 +799,10 @@ func TestFinalizedDeposits_ReturnsTrieCorrectly(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Perform this in a non-sensical ordering
-	dc.InsertFinalizedDeposits(context.Background(), 10)
-	dc.InsertFinalizedDeposits(context.Background(), 2)
-	dc.InsertFinalizedDeposits(context.Background(), 3)
-	dc.InsertFinalizedDeposits(context.Background(), 4)
+	dc.InsertFinalizedDeposits(context.Background(), 10, [32]byte{}, 0)
+	dc.InsertFinalizedDeposits(context.Background(), 2, [32]byte{}, 0)
+	dc.InsertFinalizedDeposits(context.Background(), 3, [32]byte{}, 0)
+	dc.InsertFinalizedDeposits(context.Background(), 4, [32]byte{}, 0)
 
 	// Mimick finalized deposit trie fetch.
 	fd := dc.FinalizedDeposits(context.Background())
@@ -817,9 +817,9 @@ func TestFinalizedDeposits_ReturnsTrieCorrectly(t *testing.T) {
 		}
 		insertIndex++
 	}
-	dc.InsertFinalizedDeposits(context.Background(), 15)
-	dc.InsertFinalizedDeposits(context.Background(), 15)
-	dc.InsertFinalizedDeposits(context.Background(), 14)
+	dc.InsertFinalizedDeposits(context.Background(), 15, [32]byte{}, 0)
+	dc.InsertFinalizedDeposits(context.Background(), 15, [32]byte{}, 0)
+	dc.InsertFinalizedDeposits(context.Background(), 14, [32]byte{}, 0)
 
 	fd = dc.FinalizedDeposits(context.Background())
 	deps = dc.NonFinalizedDeposits(context.Background(), fd.MerkleTrieIndex, big.NewInt(30))
@@ -1056,3 +1056,63 @@ func makeDepositProof() [][]byte {
 	}
 	return proof
 }
+
+func TestDepositSnapshot_RestoreFinalizedDeposits(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+
+	snapshot, err := dc.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.DepositSnapshot)(nil), snapshot)
+
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		d := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		depHash, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositTrie.Insert(depHash[:], i))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, dc.InsertDeposit(ctx, d, uint64(10+i), int64(i), root))
+	}
+	dc.InsertFinalizedDeposits(ctx, 3, [32]byte{'a'}, 12)
+	snapshot, err = dc.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), snapshot.DepositCount)
+	assert.DeepEqual(t, dc.deposits[3].DepositRoot, snapshot.DepositRoot)
+	assert.Equal(t, uint64(12), snapshot.ExecutionDepth)
+
+	restored, err := New()
+	require.NoError(t, err)
+	restored.InsertDepositContainers(ctx, dc.AllDepositContainers(ctx))
+	require.NoError(t, restored.RestoreFinalizedDeposits(ctx, snapshot))
+	fd := restored.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(3), fd.MerkleTrieIndex)
+	wantFd := dc.FinalizedDeposits(ctx)
+	wantRoot, err := wantFd.Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := fd.Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+
+	// Finalizing further deposits continues from the restored snapshot.
+	restored.InsertFinalizedDeposits(ctx, 5, [32]byte{'b'}, 15)
+	root, err = restored.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, dc.deposits[5].DepositRoot, root[:])
+
+	// A snapshot which does not agree with the known deposits is rejected.
+	mismatched, err := New()
+	require.NoError(t, err)
+	mismatched.InsertDepositContainers(ctx, dc.AllDepositContainers(ctx)[:2])
+	require.ErrorContains(t, "snapshot holds 4 deposits but only 2 deposits are known", mismatched.RestoreFinalizedDeposits(ctx, snapshot))
+	assert.Equal(t, int64(-1), mismatched.FinalizedDeposits(ctx).MerkleTrieIndex)
+}
@@ -0,0 +1,181 @@
+// Package depositsnapshot implements the EIP-4881 deposit tree, which only keeps the right-most branch of the
+// finalized deposits instead of all of their leaves. The finalized part of a tree can be exported as a snapshot
+// and the tree rebuilt from it.
+package depositsnapshot
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+var (
+	// ErrEmptyExecutionBlock occurs when the execution block is nil.
+	ErrEmptyExecutionBlock = errors.New("empty execution block")
+	// ErrInvalidSnapshotRoot occurs when the snapshot root does not match the calculated root.
+	ErrInvalidSnapshotRoot = errors.New("snapshot root is invalid")
+	// ErrInvalidIndex occurs when a proof is requested for a deposit which is finalized or not in the tree.
+	ErrInvalidIndex = errors.New("index should be greater than the finalized index and less than the deposit count")
+)
+
+// executionBlock is the execution block at which the deposits of a tree were finalized.
+type executionBlock struct {
+	Hash  [32]byte
+	Depth uint64
+}
+
+// DepositTree is the deposit tree of the deposit contract as specified in EIP-4881.
+type DepositTree struct {
+	tree                    merkleTreeNode
+	mixInLength             uint64 // number of deposits in the tree, reference implementation calls this mix_in_length.
+	finalizedExecutionBlock *executionBlock
+}
+
+// New creates an empty deposit tree.
+func New() *DepositTree {
+	return &DepositTree{
+		tree: &zeroNode{depth: params.BeaconConfig().DepositContractTreeDepth},
+	}
+}
+
+// fromSnapshot rebuilds a deposit tree from the finalized roots of a snapshot.
+func fromSnapshot(snapshot DepositTreeSnapshot) (*DepositTree, error) {
+	root, err := snapshot.CalculateRoot()
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.depositRoot != root {
+		return nil, ErrInvalidSnapshotRoot
+	}
+	tree, err := fromSnapshotParts(snapshot.finalized, snapshot.depositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositTree{
+		tree:                    tree,
+		mixInLength:             snapshot.depositCount,
+		finalizedExecutionBlock: &snapshot.executionBlock,
+	}, nil
+}
+
+// GetSnapshot returns a snapshot of the finalized deposits of the tree.
+func (d *DepositTree) GetSnapshot() (DepositTreeSnapshot, error) {
+	if d.finalizedExecutionBlock == nil {
+		return DepositTreeSnapshot{}, ErrEmptyExecutionBlock
+	}
+	finalized, depositCount := d.tree.getFinalized([][32]byte{})
+	return fromTreeParts(finalized, depositCount, *d.finalizedExecutionBlock)
+}
+
+// Finalize marks the given number of first deposits of the tree as finalized, at the given execution block.
+// The leaves of the finalized deposits are dropped, keeping only the roots of the subtrees holding them.
+func (d *DepositTree) Finalize(depositCount uint64, executionHash [32]byte, executionDepth uint64) error {
+	if depositCount > d.mixInLength {
+		return errors.Errorf("cannot finalize %d deposits in a tree of %d deposits", depositCount, d.mixInLength)
+	}
+	d.finalizedExecutionBlock = &executionBlock{
+		Hash:  executionHash,
+		Depth: executionDepth,
+	}
+	if depositCount == 0 {
+		return nil
+	}
+	tree, err := d.tree.finalize(depositCount, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	d.tree = tree
+	return nil
+}
+
+// FinalizedCount returns the number of finalized deposits in the tree.
+func (d *DepositTree) FinalizedCount() uint64 {
+	_, count := d.tree.getFinalized(nil)
+	return count
+}
+
+// getProof returns the leaf at the given index along with its merkle branch, which ends with the
+// number of deposits in the tree as the deposit contract mixes it in the root.
+func (d *DepositTree) getProof(index uint64) ([32]byte, [][32]byte, error) {
+	if index < d.FinalizedCount() || index >= d.mixInLength {
+		return [32]byte{}, nil, ErrInvalidIndex
+	}
+	leaf, proof := generateProof(d.tree, index, params.BeaconConfig().DepositContractTreeDepth)
+	var enc [32]byte
+	binary.LittleEndian.PutUint64(enc[:], d.mixInLength)
+	proof = append(proof, enc)
+	return leaf, proof, nil
+}
+
+// getRoot returns the root of the tree, with the number of deposits mixed in.
+func (d *DepositTree) getRoot() [32]byte {
+	root := d.tree.getRoot()
+	var enc [32]byte
+	binary.LittleEndian.PutUint64(enc[:], d.mixInLength)
+	return hash.Hash(append(root[:], enc[:]...))
+}
+
+// pushLeaf adds a deposit to the tree.
+func (d *DepositTree) pushLeaf(leaf [32]byte) error {
+	tree, err := d.tree.pushLeaf(leaf, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	d.tree = tree
+	d.mixInLength++
+	return nil
+}
+
+// Insert adds a deposit to the tree. As the tree is append only, the index must be the number of
+// deposits in the tree.
+func (d *DepositTree) Insert(item []byte, index int) error {
+	if index < 0 || uint64(index) != d.mixInLength {
+		return fmt.Errorf("wanted deposit with index %d to be inserted but received %d", d.mixInLength, index)
+	}
+	return d.pushLeaf(bytesutil.ToBytes32(item))
+}
+
+// HashTreeRoot returns the root of the tree as defined in the deposit contract.
+func (d *DepositTree) HashTreeRoot() ([32]byte, error) {
+	return d.getRoot(), nil
+}
+
+// NumOfItems returns the number of deposits in the tree, including the finalized ones.
+func (d *DepositTree) NumOfItems() int {
+	return int(d.mixInLength) // lint:ignore uintcast -- deposit count will not exceed int in your lifetime.
+}
+
+// MerkleProof returns the merkle branch of the deposit at the given index, in the format expected by
+// the beacon chain. No proof can be built for finalized deposits.
+func (d *DepositTree) MerkleProof(index int) ([][]byte, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("merkle index is negative: %d", index)
+	}
+	_, proof, err := d.getProof(uint64(index))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate merkle proof for deposit at index %d", index)
+	}
+	result := make([][]byte, len(proof))
+	for i := range proof {
+		result[i] = bytesutil.SafeCopyBytes(proof[i][:])
+	}
+	return result, nil
+}
+
+// Copy returns a copy of the tree. Nodes are never modified once created, so the copy shares them
+// with the tree.
+func (d *DepositTree) Copy() *DepositTree {
+	cpy := &DepositTree{
+		tree:        d.tree,
+		mixInLength: d.mixInLength,
+	}
+	if d.finalizedExecutionBlock != nil {
+		block := *d.finalizedExecutionBlock
+		cpy.finalizedExecutionBlock = &block
+	}
+	return cpy
+}
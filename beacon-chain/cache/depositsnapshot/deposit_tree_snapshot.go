@@ -0,0 +1,108 @@
+package depositsnapshot
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// DepositTreeSnapshot represents the finalized part of a deposit tree, from which the tree can be rebuilt.
+type DepositTreeSnapshot struct {
+	finalized      [][32]byte
+	depositRoot    [32]byte
+	depositCount   uint64
+	executionBlock executionBlock
+}
+
+// fromTreeParts builds a snapshot from the roots of the finalized subtrees of a tree.
+func fromTreeParts(finalized [][32]byte, depositCount uint64, block executionBlock) (DepositTreeSnapshot, error) {
+	snapshot := DepositTreeSnapshot{
+		finalized:      finalized,
+		depositCount:   depositCount,
+		executionBlock: block,
+	}
+	root, err := snapshot.CalculateRoot()
+	if err != nil {
+		return DepositTreeSnapshot{}, err
+	}
+	snapshot.depositRoot = root
+	return snapshot, nil
+}
+
+// CalculateRoot returns the root of the deposit tree described by the snapshot.
+func (ds *DepositTreeSnapshot) CalculateRoot() ([32]byte, error) {
+	size := ds.depositCount
+	index := len(ds.finalized)
+	root := trie.ZeroHashes[0]
+	for i := uint64(0); i < params.BeaconConfig().DepositContractTreeDepth; i++ {
+		if (size & 1) == 1 {
+			if index == 0 {
+				return [32]byte{}, errors.New("not enough finalized roots for the deposit count")
+			}
+			index--
+			root = hash.Hash(append(ds.finalized[index][:], root[:]...))
+		} else {
+			root = hash.Hash(append(root[:], trie.ZeroHashes[i][:]...))
+		}
+		size >>= 1
+	}
+	var enc [32]byte
+	binary.LittleEndian.PutUint64(enc[:], ds.depositCount)
+	return hash.Hash(append(root[:], enc[:]...)), nil
+}
+
+// DepositCount returns the number of finalized deposits in the snapshot.
+func (ds *DepositTreeSnapshot) DepositCount() uint64 {
+	return ds.depositCount
+}
+
+// DepositRoot returns the root of the deposit tree described by the snapshot.
+func (ds *DepositTreeSnapshot) DepositRoot() [32]byte {
+	return ds.depositRoot
+}
+
+// ToProto converts the snapshot to its protobuf representation.
+func (ds *DepositTreeSnapshot) ToProto() *ethpb.DepositSnapshot {
+	finalized := make([][]byte, len(ds.finalized))
+	for i := range ds.finalized {
+		finalized[i] = bytesutil.SafeCopyBytes(ds.finalized[i][:])
+	}
+	return &ethpb.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    bytesutil.SafeCopyBytes(ds.depositRoot[:]),
+		DepositCount:   ds.depositCount,
+		ExecutionHash:  bytesutil.SafeCopyBytes(ds.executionBlock.Hash[:]),
+		ExecutionDepth: ds.executionBlock.Depth,
+	}
+}
+
+// FromProto rebuilds a deposit tree from the protobuf representation of its snapshot.
+func FromProto(pb *ethpb.DepositSnapshot) (*DepositTree, error) {
+	if pb == nil {
+		return nil, errors.New("nil deposit snapshot")
+	}
+	if uint64(len(pb.Finalized)) > params.BeaconConfig().DepositContractTreeDepth {
+		return nil, errors.Errorf("snapshot has %d finalized roots, more than the depth of the tree", len(pb.Finalized))
+	}
+	finalized := make([][32]byte, len(pb.Finalized))
+	for i := range pb.Finalized {
+		if len(pb.Finalized[i]) != 32 {
+			return nil, errors.Errorf("finalized root %d has length %d", i, len(pb.Finalized[i]))
+		}
+		finalized[i] = bytesutil.ToBytes32(pb.Finalized[i])
+	}
+	return fromSnapshot(DepositTreeSnapshot{
+		finalized:    finalized,
+		depositRoot:  bytesutil.ToBytes32(pb.DepositRoot),
+		depositCount: pb.DepositCount,
+		executionBlock: executionBlock{
+			Hash:  bytesutil.ToBytes32(pb.ExecutionHash),
+			Depth: pb.ExecutionDepth,
+		},
+	})
+}
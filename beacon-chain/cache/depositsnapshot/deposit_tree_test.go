@@ -0,0 +1,160 @@
+package depositsnapshot
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func depositLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		h := hash.Hash([]byte{byte(i), byte(i >> 8)})
+		leaves[i] = h[:]
+	}
+	return leaves
+}
+
+func TestDepositTree_MatchesSparseMerkleTrie(t *testing.T) {
+	leaves := depositLeaves(37)
+	tree := New()
+	sparse, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+
+	emptyRoot, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	wantRoot, err := sparse.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, emptyRoot)
+
+	for i, leaf := range leaves {
+		require.NoError(t, tree.Insert(leaf, i))
+		require.NoError(t, sparse.Insert(leaf, i))
+		root, err := tree.HashTreeRoot()
+		require.NoError(t, err)
+		wantRoot, err := sparse.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, wantRoot, root, "Wrong root after inserting deposit %d", i)
+	}
+	assert.Equal(t, len(leaves), tree.NumOfItems())
+
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	for i, leaf := range leaves {
+		proof, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		wantProof, err := sparse.MerkleProof(i)
+		require.NoError(t, err)
+		require.DeepEqual(t, wantProof, proof)
+		assert.Equal(t, true, trie.VerifyMerkleProof(root[:], leaf, uint64(i), proof))
+	}
+}
+
+func TestDepositTree_Insert_WrongIndex(t *testing.T) {
+	tree := New()
+	leaves := depositLeaves(2)
+	require.NoError(t, tree.Insert(leaves[0], 0))
+	require.ErrorContains(t, "wanted deposit with index 1 to be inserted but received 0", tree.Insert(leaves[1], 0))
+	require.ErrorContains(t, "wanted deposit with index 1 to be inserted but received 2", tree.Insert(leaves[1], 2))
+}
+
+func TestDepositTree_Finalize(t *testing.T) {
+	leaves := depositLeaves(21)
+	tree := New()
+	for i, leaf := range leaves {
+		require.NoError(t, tree.Insert(leaf, i))
+	}
+	rootBefore, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = tree.GetSnapshot()
+	require.ErrorIs(t, err, ErrEmptyExecutionBlock)
+
+	require.ErrorContains(t, "cannot finalize 22 deposits", tree.Finalize(22, [32]byte{'a'}, 10))
+	require.NoError(t, tree.Finalize(13, [32]byte{'a'}, 10))
+	assert.Equal(t, uint64(13), tree.FinalizedCount())
+	rootAfter, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, rootBefore, rootAfter)
+
+	// Only the roots of the subtrees of 8, 4 and 1 deposits are kept for the 13 finalized deposits.
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(snapshot.finalized))
+	assert.Equal(t, uint64(13), snapshot.DepositCount())
+
+	_, err = tree.MerkleProof(12)
+	require.ErrorIs(t, err, ErrInvalidIndex)
+	for i := 13; i < len(leaves); i++ {
+		proof, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		assert.Equal(t, true, trie.VerifyMerkleProof(rootAfter[:], leaves[i], uint64(i), proof))
+	}
+	_, err = tree.MerkleProof(len(leaves))
+	require.ErrorIs(t, err, ErrInvalidIndex)
+
+	// Finalizing fewer deposits than already finalized keeps the tree as is.
+	require.NoError(t, tree.Finalize(5, [32]byte{'a'}, 10))
+	assert.Equal(t, uint64(13), tree.FinalizedCount())
+}
+
+func TestDepositTree_SnapshotRoundTrip(t *testing.T) {
+	leaves := depositLeaves(30)
+	tree := New()
+	for i, leaf := range leaves[:20] {
+		require.NoError(t, tree.Insert(leaf, i))
+	}
+	require.NoError(t, tree.Finalize(20, [32]byte{'b'}, 42))
+	snapshot, err := tree.GetSnapshot()
+	require.NoError(t, err)
+	wantRoot, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, snapshot.DepositRoot())
+
+	pb := snapshot.ToProto()
+	assert.Equal(t, uint64(42), pb.ExecutionDepth)
+	restored, err := FromProto(pb)
+	require.NoError(t, err)
+	root, err := restored.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+	assert.Equal(t, 20, restored.NumOfItems())
+
+	// Both trees keep accepting deposits in the same way.
+	for i := 20; i < len(leaves); i++ {
+		require.NoError(t, tree.Insert(leaves[i], i))
+		require.NoError(t, restored.Insert(leaves[i], i))
+	}
+	wantRoot, err = tree.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = restored.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+
+	pb.DepositRoot = wantRoot[:]
+	_, err = FromProto(pb)
+	require.ErrorIs(t, err, ErrInvalidSnapshotRoot)
+}
+
+func TestDepositTree_Copy(t *testing.T) {
+	leaves := depositLeaves(5)
+	tree := New()
+	for i, leaf := range leaves[:3] {
+		require.NoError(t, tree.Insert(leaf, i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{}, 0))
+	cpy := tree.Copy()
+	require.NoError(t, cpy.Insert(leaves[3], 3))
+	require.NoError(t, cpy.Finalize(4, [32]byte{}, 0))
+
+	assert.Equal(t, 3, tree.NumOfItems())
+	assert.Equal(t, uint64(2), tree.FinalizedCount())
+	_, err := tree.MerkleProof(2)
+	require.NoError(t, err)
+	assert.Equal(t, 4, cpy.NumOfItems())
+	assert.Equal(t, uint64(4), cpy.FinalizedCount())
+}
@@ -0,0 +1,253 @@
+package depositsnapshot
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/math"
+)
+
+var (
+	// ErrFinalizedNodeCannotPushLeaf may occur when attempting to push a leaf to a finalized node. When a node is finalized, it cannot be modified or changed.
+	ErrFinalizedNodeCannotPushLeaf = errors.New("can't push a leaf to a finalized node")
+	// ErrLeafNodeCannotPushLeaf may occur when attempting to push a leaf to a leaf node.
+	ErrLeafNodeCannotPushLeaf = errors.New("can't push a leaf to a leaf node")
+	// ErrZeroLevel occurs when the value of level is 0.
+	ErrZeroLevel = errors.New("level should be greater than 0")
+	// ErrZeroDepth occurs when the value of depth is 0.
+	ErrZeroDepth = errors.New("depth should be greater than 0")
+)
+
+// merkleTreeNode is a node of the deposit tree as specified in EIP-4881. Nodes are never modified once
+// created: pushing a leaf or finalizing deposits returns a new node which shares the unmodified subtrees,
+// so that copies of a tree are free.
+type merkleTreeNode interface {
+	// getRoot returns the root of the subtree of the node.
+	getRoot() [32]byte
+	// isFull returns whether there is no room left for leaves in the subtree of the node.
+	isFull() bool
+	// finalize replaces the subtrees holding the first deposits of the node with their root.
+	finalize(depositsToFinalize uint64, depth uint64) (merkleTreeNode, error)
+	// getFinalized appends the roots of the finalized subtrees to the result, and returns the number of
+	// deposits they hold.
+	getFinalized(result [][32]byte) ([][32]byte, uint64)
+	// pushLeaf adds a leaf to the right of the leaves of the subtree of the node.
+	pushLeaf(leaf [32]byte, depth uint64) (merkleTreeNode, error)
+}
+
+// create builds a tree of the given depth holding the given leaves.
+func create(leaves [][32]byte, depth uint64) merkleTreeNode {
+	length := uint64(len(leaves))
+	if length == 0 {
+		return &zeroNode{depth: depth}
+	}
+	if depth == 0 {
+		return &leafNode{hash: leaves[0]}
+	}
+	split := math.Min(math.PowerOf2(depth-1), length)
+	left := create(leaves[0:split], depth-1)
+	right := create(leaves[split:], depth-1)
+	return newInnerNode(left, right)
+}
+
+// fromSnapshotParts rebuilds a tree of the given level from the roots of its finalized subtrees.
+func fromSnapshotParts(finalized [][32]byte, deposits uint64, level uint64) (merkleTreeNode, error) {
+	if len(finalized) < 1 || deposits == 0 {
+		return &zeroNode{depth: level}, nil
+	}
+	if deposits == math.PowerOf2(level) {
+		return &finalizedNode{depositCount: deposits, hash: finalized[0]}, nil
+	}
+	if level == 0 {
+		return nil, ErrZeroLevel
+	}
+	leftSubtree := math.PowerOf2(level - 1)
+	if deposits <= leftSubtree {
+		left, err := fromSnapshotParts(finalized, deposits, level-1)
+		if err != nil {
+			return nil, err
+		}
+		return newInnerNode(left, &zeroNode{depth: level - 1}), nil
+	}
+	left := &finalizedNode{depositCount: leftSubtree, hash: finalized[0]}
+	right, err := fromSnapshotParts(finalized[1:], deposits-leftSubtree, level-1)
+	if err != nil {
+		return nil, err
+	}
+	return newInnerNode(left, right), nil
+}
+
+// generateProof returns the leaf at the given index along with its merkle branch.
+func generateProof(tree merkleTreeNode, index uint64, depth uint64) ([32]byte, [][32]byte) {
+	var proof [][32]byte
+	node := tree
+	for depth > 0 {
+		inner, ok := node.(*innerNode)
+		if !ok {
+			// Only the finalized subtrees of a tree are not inner nodes at non leaf depths,
+			// and no proof can be generated for the leaves they held.
+			break
+		}
+		ithBit := (index >> (depth - 1)) & 0x1
+		if ithBit == 1 {
+			proof = append(proof, inner.left.getRoot())
+			node = inner.right
+		} else {
+			proof = append(proof, inner.right.getRoot())
+			node = inner.left
+		}
+		depth--
+	}
+	// Reverse the proof so that it goes from the leaf to the root.
+	for i, j := 0, len(proof)-1; i < j; i, j = i+1, j-1 {
+		proof[i], proof[j] = proof[j], proof[i]
+	}
+	return node.getRoot(), proof
+}
+
+// finalizedNode is the root of a subtree of finalized deposits, whose leaves are no longer kept.
+type finalizedNode struct {
+	depositCount uint64
+	hash         [32]byte
+}
+
+func (f *finalizedNode) getRoot() [32]byte {
+	return f.hash
+}
+
+func (*finalizedNode) isFull() bool {
+	return true
+}
+
+func (f *finalizedNode) finalize(_ uint64, _ uint64) (merkleTreeNode, error) {
+	return f, nil
+}
+
+func (f *finalizedNode) getFinalized(result [][32]byte) ([][32]byte, uint64) {
+	return append(result, f.hash), f.depositCount
+}
+
+func (*finalizedNode) pushLeaf(_ [32]byte, _ uint64) (merkleTreeNode, error) {
+	return nil, ErrFinalizedNodeCannotPushLeaf
+}
+
+// leafNode is the hash of a single deposit which is not finalized yet.
+type leafNode struct {
+	hash [32]byte
+}
+
+func (l *leafNode) getRoot() [32]byte {
+	return l.hash
+}
+
+func (*leafNode) isFull() bool {
+	return true
+}
+
+func (l *leafNode) finalize(_ uint64, _ uint64) (merkleTreeNode, error) {
+	return &finalizedNode{depositCount: 1, hash: l.hash}, nil
+}
+
+func (*leafNode) getFinalized(result [][32]byte) ([][32]byte, uint64) {
+	return result, 0
+}
+
+func (*leafNode) pushLeaf(_ [32]byte, _ uint64) (merkleTreeNode, error) {
+	return nil, ErrLeafNodeCannotPushLeaf
+}
+
+// innerNode is a node with two subtrees. Its root is computed once on creation, as nodes are never modified.
+type innerNode struct {
+	left, right merkleTreeNode
+	root        [32]byte
+}
+
+func newInnerNode(left, right merkleTreeNode) *innerNode {
+	leftRoot, rightRoot := left.getRoot(), right.getRoot()
+	return &innerNode{
+		left:  left,
+		right: right,
+		root:  hash.Hash(append(leftRoot[:], rightRoot[:]...)),
+	}
+}
+
+func (n *innerNode) getRoot() [32]byte {
+	return n.root
+}
+
+func (n *innerNode) isFull() bool {
+	return n.right.isFull()
+}
+
+func (n *innerNode) finalize(depositsToFinalize uint64, depth uint64) (merkleTreeNode, error) {
+	if depth == 0 {
+		return nil, ErrZeroDepth
+	}
+	deposits := math.PowerOf2(depth)
+	if deposits <= depositsToFinalize {
+		return &finalizedNode{depositCount: deposits, hash: n.root}, nil
+	}
+	left, err := n.left.finalize(depositsToFinalize, depth-1)
+	if err != nil {
+		return nil, err
+	}
+	right := n.right
+	if depositsToFinalize > deposits/2 {
+		remaining := depositsToFinalize - deposits/2
+		right, err = n.right.finalize(remaining, depth-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The roots of finalized subtrees are the roots they replace, so the root of the node is unchanged.
+	return &innerNode{left: left, right: right, root: n.root}, nil
+}
+
+func (n *innerNode) getFinalized(result [][32]byte) ([][32]byte, uint64) {
+	result, leftDeposits := n.left.getFinalized(result)
+	result, rightDeposits := n.right.getFinalized(result)
+	return result, leftDeposits + rightDeposits
+}
+
+func (n *innerNode) pushLeaf(leaf [32]byte, depth uint64) (merkleTreeNode, error) {
+	if depth == 0 {
+		return nil, ErrZeroDepth
+	}
+	if !n.left.isFull() {
+		left, err := n.left.pushLeaf(leaf, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		return newInnerNode(left, n.right), nil
+	}
+	right, err := n.right.pushLeaf(leaf, depth-1)
+	if err != nil {
+		return nil, err
+	}
+	return newInnerNode(n.left, right), nil
+}
+
+// zeroNode is an empty subtree of the given depth.
+type zeroNode struct {
+	depth uint64
+}
+
+func (z *zeroNode) getRoot() [32]byte {
+	return trie.ZeroHashes[z.depth]
+}
+
+func (*zeroNode) isFull() bool {
+	return false
+}
+
+func (*zeroNode) finalize(_ uint64, _ uint64) (merkleTreeNode, error) {
+	return nil, errors.New("can't finalize a zero node")
+}
+
+func (*zeroNode) getFinalized(result [][32]byte) ([][32]byte, uint64) {
+	return result, 0
+}
+
+func (*zeroNode) pushLeaf(leaf [32]byte, depth uint64) (merkleTreeNode, error) {
+	return create([][32]byte{leaf}, depth), nil
+}
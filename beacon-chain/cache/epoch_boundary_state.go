@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+var (
+	// maxEpochBoundaryStateSize defines the max number of epoch boundary states the cache can contain.
+	// Requests are made for the current and next epochs of the head, allowing for a few forks.
+	maxEpochBoundaryStateSize = 8
+
+	// Metrics.
+	epochBoundaryStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_boundary_state_cache_miss",
+		Help: "The number of epoch boundary state requests that aren't present in the cache.",
+	})
+	epochBoundaryStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_boundary_state_cache_hit",
+		Help: "The number of epoch boundary state requests that are present in the cache.",
+	})
+)
+
+// EpochBoundaryStateCache stores the states of epoch boundaries, keyed by the root of the latest block they
+// include and their epoch, so that the slots up to the boundary are processed or replayed once for all the
+// requests of the same epoch. The slot of the boundary, the first slot of the epoch or the last one before
+// its epoch processing, depends on the use of the cache, so that each use has its own cache. States are
+// copied in and out of the cache, so that callers are free to modify them.
+type EpochBoundaryStateCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewEpochBoundaryStateCache creates a new epoch boundary state cache.
+func NewEpochBoundaryStateCache() *EpochBoundaryStateCache {
+	return &EpochBoundaryStateCache{
+		cache: lruwrpr.New(maxEpochBoundaryStateSize),
+	}
+}
+
+// Get returns a copy of the boundary state of the epoch built on top of the block of the given root, or nil
+// if it is not in the cache.
+func (c *EpochBoundaryStateCache) Get(blockRoot [32]byte, epoch types.Epoch) state.BeaconState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(epochBoundaryStateKey(blockRoot, epoch))
+	if !exists || item == nil {
+		epochBoundaryStateCacheMiss.Inc()
+		return nil
+	}
+	epochBoundaryStateCacheHit.Inc()
+	return item.(state.BeaconState).Copy()
+}
+
+// Add stores a copy of the boundary state of the epoch built on top of the block of the given root.
+func (c *EpochBoundaryStateCache) Add(blockRoot [32]byte, epoch types.Epoch, st state.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(epochBoundaryStateKey(blockRoot, epoch), st.Copy())
+}
+
+func epochBoundaryStateKey(blockRoot [32]byte, epoch types.Epoch) string {
+	return string(append(blockRoot[:], bytesutil.Bytes8(uint64(epoch))...))
+}
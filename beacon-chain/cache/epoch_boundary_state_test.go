@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"testing"
+
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestEpochBoundaryStateCache_GetAndAdd(t *testing.T) {
+	c := NewEpochBoundaryStateCache()
+	root := [32]byte{'a'}
+	assert.Equal(t, nil, c.Get(root, 1))
+
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: 32})
+	require.NoError(t, err)
+	c.Add(root, 1, st)
+	got := c.Get(root, 1)
+	require.NotNil(t, got)
+	assert.Equal(t, types.Slot(32), got.Slot())
+	// States are copied in and out of the cache.
+	require.NoError(t, got.SetSlot(40))
+	require.NoError(t, st.SetSlot(48))
+	assert.Equal(t, types.Slot(32), c.Get(root, 1).Slot())
+	// The state is keyed by both the block root and the epoch.
+	assert.Equal(t, nil, c.Get(root, 2))
+	assert.Equal(t, nil, c.Get([32]byte{'b'}, 1))
+}
+
+func TestEpochBoundaryStateCache_MaxSize(t *testing.T) {
+	c := NewEpochBoundaryStateCache()
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{})
+	require.NoError(t, err)
+	for i := 0; i <= maxEpochBoundaryStateSize; i++ {
+		c.Add([32]byte{}, types.Epoch(i), st)
+	}
+	assert.Equal(t, maxEpochBoundaryStateSize, c.cache.Len())
+	assert.Equal(t, nil, c.Get([32]byte{}, 0))
+}
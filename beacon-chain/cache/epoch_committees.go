@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+var (
+	// maxEpochCommitteesSize defines the max number of epoch committee listings the cache can contain.
+	// Listings are requested for a handful of recent epochs of the head and finalized states.
+	maxEpochCommitteesSize = 16
+
+	// Metrics.
+	epochCommitteesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_committees_cache_miss",
+		Help: "The number of epoch committees requests that aren't present in the cache.",
+	})
+	epochCommitteesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_committees_cache_hit",
+		Help: "The number of epoch committees requests that are present in the cache.",
+	})
+)
+
+// EpochCommittees lists the beacon committees of every slot of an epoch.
+type EpochCommittees struct {
+	// StartSlot is the first slot of the epoch.
+	StartSlot types.Slot
+	// Committees holds the committees of each slot of the epoch, ordered by committee index.
+	Committees [][][]types.ValidatorIndex
+}
+
+// EpochCommitteesCache stores the full committee listings of an epoch computed from a given state,
+// so that the shuffling is computed once for all the requests of the same epoch.
+type EpochCommitteesCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewEpochCommitteesCache creates a new epoch committees cache.
+func NewEpochCommitteesCache() *EpochCommitteesCache {
+	return &EpochCommitteesCache{
+		cache: lruwrpr.New(maxEpochCommitteesSize),
+	}
+}
+
+// Get returns the committee listing of the epoch computed from the state of the given root, or nil
+// if it is not in the cache.
+func (c *EpochCommitteesCache) Get(stateRoot [32]byte, epoch types.Epoch) *EpochCommittees {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(epochCommitteesKey(stateRoot, epoch))
+	if !exists || item == nil {
+		epochCommitteesCacheMiss.Inc()
+		return nil
+	}
+	epochCommitteesCacheHit.Inc()
+	return item.(*EpochCommittees)
+}
+
+// Add stores the committee listing of the epoch computed from the state of the given root. The
+// listing must not be modified once added.
+func (c *EpochCommitteesCache) Add(stateRoot [32]byte, epoch types.Epoch, committees *EpochCommittees) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(epochCommitteesKey(stateRoot, epoch), committees)
+}
+
+func epochCommitteesKey(stateRoot [32]byte, epoch types.Epoch) string {
+	return string(append(stateRoot[:], bytesutil.Bytes8(uint64(epoch))...))
+}
@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestEpochCommitteesCache_GetAndAdd(t *testing.T) {
+	c := NewEpochCommitteesCache()
+	root := [32]byte{'a'}
+	assert.Equal(t, (*EpochCommittees)(nil), c.Get(root, 1))
+
+	committees := &EpochCommittees{
+		StartSlot:  32,
+		Committees: [][][]types.ValidatorIndex{{{1, 2}, {3, 4}}},
+	}
+	c.Add(root, 1, committees)
+	require.DeepEqual(t, committees, c.Get(root, 1))
+	// The listing is keyed by both the state root and the epoch.
+	assert.Equal(t, (*EpochCommittees)(nil), c.Get(root, 2))
+	assert.Equal(t, (*EpochCommittees)(nil), c.Get([32]byte{'b'}, 1))
+}
+
+func TestEpochCommitteesCache_MaxSize(t *testing.T) {
+	c := NewEpochCommitteesCache()
+	for i := 0; i <= maxEpochCommitteesSize; i++ {
+		c.Add([32]byte{}, types.Epoch(i), &EpochCommittees{})
+	}
+	assert.Equal(t, maxEpochCommitteesSize, c.cache.Len())
+	assert.Equal(t, (*EpochCommittees)(nil), c.Get([32]byte{}, 0))
+}
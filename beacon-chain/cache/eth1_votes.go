@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+var (
+	eth1VoteCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth1_vote_cache_miss",
+		Help: "The number of eth1 vote validity checks that aren't present in the cache.",
+	})
+	eth1VoteCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "eth1_vote_cache_hit",
+		Help: "The number of eth1 vote validity checks that are present in the cache.",
+	})
+)
+
+// Eth1VoteCandidates describes the eth1 blocks a proposer may vote for during an eth1 voting period,
+// along with the validity of the votes already checked against them. As candidate blocks are
+// beyond the eth1 follow distance, they do not change during the voting period.
+type Eth1VoteCandidates struct {
+	// PeriodStart is the start time of the eth1 voting period.
+	PeriodStart uint64
+	// LastBlock is the height of the last candidate block, or nil if there is no candidate block.
+	LastBlock *big.Int
+	// LastBlockEth1Data is the eth1 data of the last candidate block, the vote cast by default.
+	LastBlockEth1Data *ethpb.Eth1Data
+
+	lock  sync.RWMutex
+	votes map[[32]byte]bool
+}
+
+// NewEth1VoteCandidates creates the vote candidates of the eth1 voting period starting at the given time.
+func NewEth1VoteCandidates(periodStart uint64, lastBlock *big.Int, lastBlockEth1Data *ethpb.Eth1Data) *Eth1VoteCandidates {
+	return &Eth1VoteCandidates{
+		PeriodStart:       periodStart,
+		LastBlock:         lastBlock,
+		LastBlockEth1Data: lastBlockEth1Data,
+		votes:             make(map[[32]byte]bool),
+	}
+}
+
+// VoteValidity returns whether the vote with the given root was found to be valid during the voting period,
+// and whether it was checked at all.
+func (c *Eth1VoteCandidates) VoteValidity(root [32]byte) (valid bool, ok bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	valid, ok = c.votes[root]
+	if ok {
+		eth1VoteCacheHit.Inc()
+	} else {
+		eth1VoteCacheMiss.Inc()
+	}
+	return valid, ok
+}
+
+// SetVoteValidity records whether the vote with the given root is valid during the voting period.
+func (c *Eth1VoteCandidates) SetVoteValidity(root [32]byte, valid bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.votes[root] = valid
+}
+
+// Eth1VoteCache caches the eth1 vote candidates of the current eth1 voting period, so that the execution
+// chain is only queried once per voting period rather than on every block proposal.
+type Eth1VoteCache struct {
+	lock       sync.RWMutex
+	candidates *Eth1VoteCandidates
+}
+
+// NewEth1VoteCache creates an empty eth1 vote cache.
+func NewEth1VoteCache() *Eth1VoteCache {
+	return &Eth1VoteCache{}
+}
+
+// Candidates returns the vote candidates of the eth1 voting period starting at the given time, or nil if
+// they are not cached.
+func (c *Eth1VoteCache) Candidates(periodStart uint64) *Eth1VoteCandidates {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.candidates == nil || c.candidates.PeriodStart != periodStart {
+		return nil
+	}
+	return c.candidates
+}
+
+// SetCandidates caches the vote candidates of an eth1 voting period, replacing those of the previous period.
+func (c *Eth1VoteCache) SetCandidates(candidates *Eth1VoteCandidates) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.candidates = candidates
+}
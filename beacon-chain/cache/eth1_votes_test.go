@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"math/big"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestEth1VoteCache_Candidates(t *testing.T) {
+	c := NewEth1VoteCache()
+	assert.Equal(t, (*Eth1VoteCandidates)(nil), c.Candidates(100))
+
+	candidates := NewEth1VoteCandidates(100, big.NewInt(10), &ethpb.Eth1Data{DepositCount: 1})
+	c.SetCandidates(candidates)
+	assert.Equal(t, candidates, c.Candidates(100))
+	assert.Equal(t, (*Eth1VoteCandidates)(nil), c.Candidates(200))
+
+	next := NewEth1VoteCandidates(200, nil, nil)
+	c.SetCandidates(next)
+	assert.Equal(t, (*Eth1VoteCandidates)(nil), c.Candidates(100))
+	assert.Equal(t, next, c.Candidates(200))
+}
+
+func TestEth1VoteCandidates_VoteValidity(t *testing.T) {
+	candidates := NewEth1VoteCandidates(100, big.NewInt(10), &ethpb.Eth1Data{DepositCount: 1})
+	_, ok := candidates.VoteValidity([32]byte{'a'})
+	assert.Equal(t, false, ok)
+
+	candidates.SetVoteValidity([32]byte{'a'}, true)
+	candidates.SetVoteValidity([32]byte{'b'}, false)
+	valid, ok := candidates.VoteValidity([32]byte{'a'})
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, valid)
+	valid, ok = candidates.VoteValidity([32]byte{'b'})
+	assert.Equal(t, true, ok)
+	assert.Equal(t, false, valid)
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+// ValidatorIndexCache maps the public keys of the validator registry to their validator indices.
+// Validator indices are assigned in deposit order and never change, so a single mapping serves
+// every state of the chain: it only grows as new validators are appended to the registry, and a
+// lookup against a state is bounded by the size of that state's registry.
+type ValidatorIndexCache struct {
+	indices map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
+	sync.RWMutex
+}
+
+// NewValidatorIndexCache creates a new validator index cache.
+func NewValidatorIndexCache() *ValidatorIndexCache {
+	return &ValidatorIndexCache{
+		indices: make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex),
+	}
+}
+
+// Update adds the validators of the given state's registry which are not in the cache yet.
+func (c *ValidatorIndexCache) Update(st state.ReadOnlyBeaconState) {
+	if st == nil || st.IsNil() {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	for i := len(c.indices); i < st.NumValidators(); i++ {
+		c.indices[st.PubkeyAtIndex(types.ValidatorIndex(i))] = types.ValidatorIndex(i)
+	}
+}
+
+// Index returns the validator index of the public key in a registry of the given number of validators.
+func (c *ValidatorIndexCache) Index(pubKey [fieldparams.BLSPubkeyLength]byte, numValidators int) (types.ValidatorIndex, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	idx, ok := c.indices[pubKey]
+	if !ok || int(idx) >= numValidators {
+		return 0, false
+	}
+	return idx, true
+}
+
+// Indices resolves the validator indices of the public keys in a registry of the given number of
+// validators. The public keys which are not in the registry are absent from the returned map.
+func (c *ValidatorIndexCache) Indices(pubKeys [][fieldparams.BLSPubkeyLength]byte, numValidators int) map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex {
+	c.RLock()
+	defer c.RUnlock()
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		idx, ok := c.indices[pubKey]
+		if ok && int(idx) < numValidators {
+			indices[pubKey] = idx
+		}
+	}
+	return indices
+}
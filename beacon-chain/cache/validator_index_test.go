@@ -0,0 +1,47 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestValidatorIndexCache_UpdateAndResolve(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 16)
+	c := cache.NewValidatorIndexCache()
+	c.Update(st)
+
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, 17)
+	for i := 0; i < 16; i++ {
+		pubKeys = append(pubKeys, st.PubkeyAtIndex(types.ValidatorIndex(i)))
+	}
+	unknown := [fieldparams.BLSPubkeyLength]byte{'a'}
+	pubKeys = append(pubKeys, unknown)
+
+	indices := c.Indices(pubKeys, st.NumValidators())
+	require.Equal(t, 16, len(indices))
+	for i := 0; i < 16; i++ {
+		require.Equal(t, types.ValidatorIndex(i), indices[pubKeys[i]])
+	}
+	_, ok := c.Index(unknown, st.NumValidators())
+	require.Equal(t, false, ok)
+
+	// Validators appended to the registry are added on update.
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{PublicKey: unknown[:]}))
+	_, ok = c.Index(unknown, st.NumValidators())
+	require.Equal(t, false, ok)
+	c.Update(st)
+	idx, ok := c.Index(unknown, st.NumValidators())
+	require.Equal(t, true, ok)
+	require.Equal(t, types.ValidatorIndex(16), idx)
+
+	// Lookups are bounded by the size of the registry.
+	_, ok = c.Index(unknown, 16)
+	require.Equal(t, false, ok)
+	require.Equal(t, 16, len(c.Indices(pubKeys, 16)))
+}
@@ -93,32 +93,43 @@ func ProcessInactivityScores(
 	recoveryRate := cfg.InactivityScoreRecoveryRate
 	prevEpoch := time.PrevEpoch(beaconState)
 	finalizedEpoch := beaconState.FinalizedCheckpointEpoch()
-	for i, v := range vals {
-		if !precompute.EligibleForRewards(v) {
-			continue
-		}
-
-		if v.IsPrevEpochTargetAttester && !v.IsSlashed {
-			// Decrease inactivity score when validator gets target correct.
-			if v.InactivityScore > 0 {
-				v.InactivityScore -= 1
+	inactivityLeak := helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
+	if len(inactivityScores) < len(vals) {
+		return nil, nil, errors.New("num of validators is larger than num of inactivity scores")
+	}
+	if err := helpers.ForEachValidatorChunk(len(vals), func(start, end int) error {
+		for i := start; i < end; i++ {
+			v := vals[i]
+			if !precompute.EligibleForRewards(v) {
+				continue
 			}
-		} else {
-			v.InactivityScore, err = math.Add64(v.InactivityScore, bias)
-			if err != nil {
-				return nil, nil, err
+
+			if v.IsPrevEpochTargetAttester && !v.IsSlashed {
+				// Decrease inactivity score when validator gets target correct.
+				if v.InactivityScore > 0 {
+					v.InactivityScore -= 1
+				}
+			} else {
+				score, err := math.Add64(v.InactivityScore, bias)
+				if err != nil {
+					return err
+				}
+				v.InactivityScore = score
 			}
-		}
 
-		if !helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
-			score := recoveryRate
-			// Prevents underflow below 0.
-			if score > v.InactivityScore {
-				score = v.InactivityScore
+			if !inactivityLeak {
+				score := recoveryRate
+				// Prevents underflow below 0.
+				if score > v.InactivityScore {
+					score = v.InactivityScore
+				}
+				v.InactivityScore -= score
 			}
-			v.InactivityScore -= score
+			inactivityScores[i] = v.InactivityScore
 		}
-		inactivityScores[i] = v.InactivityScore
+		return nil
+	}); err != nil {
+		return nil, nil, err
 	}
 
 	if err := beaconState.SetInactivityScores(inactivityScores); err != nil {
@@ -155,51 +166,69 @@ func ProcessEpochParticipation(
 	targetIdx := cfg.TimelyTargetFlagIndex
 	sourceIdx := cfg.TimelySourceFlagIndex
 	headIdx := cfg.TimelyHeadFlagIndex
-	for i, b := range cp {
-		has, err := HasValidatorFlag(b, sourceIdx)
-		if err != nil {
-			return nil, nil, err
-		}
-		if has && vals[i].IsActiveCurrentEpoch {
-			vals[i].IsCurrentEpochAttester = true
-		}
-		has, err = HasValidatorFlag(b, targetIdx)
-		if err != nil {
-			return nil, nil, err
-		}
-		if has && vals[i].IsActiveCurrentEpoch {
-			vals[i].IsCurrentEpochAttester = true
-			vals[i].IsCurrentEpochTargetAttester = true
+	if len(cp) > len(vals) {
+		return nil, nil, errors.New("current epoch participation is longer than validator registry")
+	}
+	if err := helpers.ForEachValidatorChunk(len(cp), func(start, end int) error {
+		for i := start; i < end; i++ {
+			b := cp[i]
+			has, err := HasValidatorFlag(b, sourceIdx)
+			if err != nil {
+				return err
+			}
+			if has && vals[i].IsActiveCurrentEpoch {
+				vals[i].IsCurrentEpochAttester = true
+			}
+			has, err = HasValidatorFlag(b, targetIdx)
+			if err != nil {
+				return err
+			}
+			if has && vals[i].IsActiveCurrentEpoch {
+				vals[i].IsCurrentEpochAttester = true
+				vals[i].IsCurrentEpochTargetAttester = true
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
 	}
 	pp, err := beaconState.PreviousEpochParticipation()
 	if err != nil {
 		return nil, nil, err
 	}
-	for i, b := range pp {
-		has, err := HasValidatorFlag(b, sourceIdx)
-		if err != nil {
-			return nil, nil, err
-		}
-		if has && vals[i].IsActivePrevEpoch {
-			vals[i].IsPrevEpochAttester = true
-			vals[i].IsPrevEpochSourceAttester = true
-		}
-		has, err = HasValidatorFlag(b, targetIdx)
-		if err != nil {
-			return nil, nil, err
-		}
-		if has && vals[i].IsActivePrevEpoch {
-			vals[i].IsPrevEpochAttester = true
-			vals[i].IsPrevEpochTargetAttester = true
-		}
-		has, err = HasValidatorFlag(b, headIdx)
-		if err != nil {
-			return nil, nil, err
-		}
-		if has && vals[i].IsActivePrevEpoch {
-			vals[i].IsPrevEpochHeadAttester = true
+	if len(pp) > len(vals) {
+		return nil, nil, errors.New("previous epoch participation is longer than validator registry")
+	}
+	if err := helpers.ForEachValidatorChunk(len(pp), func(start, end int) error {
+		for i := start; i < end; i++ {
+			b := pp[i]
+			has, err := HasValidatorFlag(b, sourceIdx)
+			if err != nil {
+				return err
+			}
+			if has && vals[i].IsActivePrevEpoch {
+				vals[i].IsPrevEpochAttester = true
+				vals[i].IsPrevEpochSourceAttester = true
+			}
+			has, err = HasValidatorFlag(b, targetIdx)
+			if err != nil {
+				return err
+			}
+			if has && vals[i].IsActivePrevEpoch {
+				vals[i].IsPrevEpochAttester = true
+				vals[i].IsPrevEpochTargetAttester = true
+			}
+			has, err = HasValidatorFlag(b, headIdx)
+			if err != nil {
+				return err
+			}
+			if has && vals[i].IsActivePrevEpoch {
+				vals[i].IsPrevEpochHeadAttester = true
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
 	}
 	bal = precompute.UpdateBalance(vals, bal, beaconState.Version())
 	return vals, bal, nil
@@ -230,18 +259,23 @@ func ProcessRewardsAndPenaltiesPrecompute(
 	}
 
 	balances := beaconState.Balances()
-	for i := 0; i < numOfVals; i++ {
-		vals[i].BeforeEpochTransitionBalance = balances[i]
+	if err := helpers.ForEachValidatorChunk(numOfVals, func(start, end int) error {
+		for i := start; i < end; i++ {
+			vals[i].BeforeEpochTransitionBalance = balances[i]
 
-		// Compute the post balance of the validator after accounting for the
-		// attester and proposer rewards and penalties.
-		balances[i], err = helpers.IncreaseBalanceWithVal(balances[i], attsRewards[i])
-		if err != nil {
-			return nil, err
-		}
-		balances[i] = helpers.DecreaseBalanceWithVal(balances[i], attsPenalties[i])
+			// Compute the post balance of the validator after accounting for the
+			// attester and proposer rewards and penalties.
+			b, err := helpers.IncreaseBalanceWithVal(balances[i], attsRewards[i])
+			if err != nil {
+				return err
+			}
+			balances[i] = helpers.DecreaseBalanceWithVal(b, attsPenalties[i])
 
-		vals[i].AfterEpochTransitionBalance = balances[i]
+			vals[i].AfterEpochTransitionBalance = balances[i]
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	if err := beaconState.SetBalances(balances); err != nil {
@@ -254,45 +288,113 @@ func ProcessRewardsAndPenaltiesPrecompute(
 // AttestationsDelta computes and returns the rewards and penalties differences for individual validators based on the
 // voting records.
 func AttestationsDelta(beaconState state.BeaconState, bal *precompute.Balance, vals []*precompute.Validator) (rewards, penalties []uint64, err error) {
+	deltas, err := AttestationDeltas(beaconState, bal, vals)
+	if err != nil {
+		return nil, nil, err
+	}
 	numOfVals := beaconState.NumValidators()
 	rewards = make([]uint64, numOfVals)
 	penalties = make([]uint64, numOfVals)
+	for i, d := range deltas {
+		rewards[i], penalties[i] = d.Reward(), d.Penalty()
+	}
+	return rewards, penalties, nil
+}
+
+// AttestationDelta is the breakdown of the attestation rewards and penalties of a validator for
+// the previous epoch of a state.
+type AttestationDelta struct {
+	HeadReward        uint64
+	SourceReward      uint64
+	SourcePenalty     uint64
+	TargetReward      uint64
+	TargetPenalty     uint64
+	InactivityPenalty uint64
+}
 
+// Reward returns the sum of the reward components of the delta.
+func (d AttestationDelta) Reward() uint64 {
+	return d.HeadReward + d.SourceReward + d.TargetReward
+}
+
+// Penalty returns the sum of the penalty components of the delta.
+func (d AttestationDelta) Penalty() uint64 {
+	return d.SourcePenalty + d.TargetPenalty + d.InactivityPenalty
+}
+
+// AttestationDeltas returns the attestation reward and penalty components of every precomputed
+// validator, indexed as the validator registry.
+func AttestationDeltas(beaconState state.BeaconState, bal *precompute.Balance, vals []*precompute.Validator) ([]AttestationDelta, error) {
+	numOfVals := beaconState.NumValidators()
+	if len(vals) > numOfVals {
+		return nil, errors.New("validator precompute is longer than validator registry")
+	}
+	baseRewardMultiplier, inactivityDenominator, leak, err := attestationDeltaParams(beaconState, bal)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := make([]AttestationDelta, numOfVals)
+	if err := helpers.ForEachValidatorChunk(len(vals), func(start, end int) error {
+		for i := start; i < end; i++ {
+			d, err := attestationDelta(bal, vals[i], baseRewardMultiplier, inactivityDenominator, leak)
+			if err != nil {
+				return err
+			}
+			deltas[i] = d
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return deltas, nil
+}
+
+// IdealAttestationDelta returns the attestation delta of an active, unslashed validator of the
+// given effective balance which timely voted for the correct source, target and head in the
+// previous epoch of the state.
+func IdealAttestationDelta(beaconState state.BeaconState, bal *precompute.Balance, effectiveBalance uint64) (AttestationDelta, error) {
+	baseRewardMultiplier, inactivityDenominator, leak, err := attestationDeltaParams(beaconState, bal)
+	if err != nil {
+		return AttestationDelta{}, err
+	}
+	return attestationDelta(bal, &precompute.Validator{
+		IsActivePrevEpoch:            true,
+		IsPrevEpochSourceAttester:    true,
+		IsPrevEpochTargetAttester:    true,
+		IsPrevEpochHeadAttester:      true,
+		CurrentEpochEffectiveBalance: effectiveBalance,
+	}, baseRewardMultiplier, inactivityDenominator, leak)
+}
+
+func attestationDeltaParams(beaconState state.BeaconState, bal *precompute.Balance) (baseRewardMultiplier, inactivityDenominator uint64, leak bool, err error) {
 	cfg := params.BeaconConfig()
 	prevEpoch := time.PrevEpoch(beaconState)
 	finalizedEpoch := beaconState.FinalizedCheckpointEpoch()
 	increment := cfg.EffectiveBalanceIncrement
 	factor := cfg.BaseRewardFactor
-	baseRewardMultiplier := increment * factor / math.IntegerSquareRoot(bal.ActiveCurrentEpoch)
-	leak := helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
+	baseRewardMultiplier = increment * factor / math.IntegerSquareRoot(bal.ActiveCurrentEpoch)
+	leak = helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
 
 	// Modified in Altair and Bellatrix.
 	bias := cfg.InactivityScoreBias
 	inactivityPenaltyQuotient, err := beaconState.InactivityPenaltyQuotient()
 	if err != nil {
-		return nil, nil, err
+		return 0, 0, false, err
 	}
-	inactivityDenominator := bias * inactivityPenaltyQuotient
-
-	for i, v := range vals {
-		rewards[i], penalties[i], err = attestationDelta(bal, v, baseRewardMultiplier, inactivityDenominator, leak)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	return rewards, penalties, nil
+	inactivityDenominator = bias * inactivityPenaltyQuotient
+	return baseRewardMultiplier, inactivityDenominator, leak, nil
 }
 
 func attestationDelta(
 	bal *precompute.Balance,
 	val *precompute.Validator,
 	baseRewardMultiplier, inactivityDenominator uint64,
-	inactivityLeak bool) (reward, penalty uint64, err error) {
+	inactivityLeak bool) (AttestationDelta, error) {
 	eligible := val.IsActivePrevEpoch || (val.IsSlashed && !val.IsWithdrawableCurrentEpoch)
 	// Per spec `ActiveCurrentEpoch` can't be 0 to process attestation delta.
 	if !eligible || bal.ActiveCurrentEpoch == 0 {
-		return 0, 0, nil
+		return AttestationDelta{}, nil
 	}
 
 	cfg := params.BeaconConfig()
@@ -305,32 +407,32 @@ func attestationDelta(
 	srcWeight := cfg.TimelySourceWeight
 	tgtWeight := cfg.TimelyTargetWeight
 	headWeight := cfg.TimelyHeadWeight
-	reward, penalty = uint64(0), uint64(0)
+	d := AttestationDelta{}
 	// Process source reward / penalty
 	if val.IsPrevEpochSourceAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * srcWeight * (bal.PrevEpochAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			d.SourceReward = n / (activeIncrement * weightDenominator)
 		}
 	} else {
-		penalty += baseReward * srcWeight / weightDenominator
+		d.SourcePenalty = baseReward * srcWeight / weightDenominator
 	}
 
 	// Process target reward / penalty
 	if val.IsPrevEpochTargetAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * tgtWeight * (bal.PrevEpochTargetAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			d.TargetReward = n / (activeIncrement * weightDenominator)
 		}
 	} else {
-		penalty += baseReward * tgtWeight / weightDenominator
+		d.TargetPenalty = baseReward * tgtWeight / weightDenominator
 	}
 
 	// Process head reward / penalty
 	if val.IsPrevEpochHeadAttester && !val.IsSlashed {
 		if !inactivityLeak {
 			n := baseReward * headWeight * (bal.PrevEpochHeadAttested / increment)
-			reward += n / (activeIncrement * weightDenominator)
+			d.HeadReward = n / (activeIncrement * weightDenominator)
 		}
 	}
 
@@ -339,10 +441,10 @@ func attestationDelta(
 	if !val.IsPrevEpochTargetAttester || val.IsSlashed {
 		n, err := math.Mul64(effectiveBalance, val.InactivityScore)
 		if err != nil {
-			return 0, 0, err
+			return AttestationDelta{}, err
 		}
-		penalty += n / inactivityDenominator
+		d.InactivityPenalty = n / inactivityDenominator
 	}
 
-	return reward, penalty, nil
+	return d, nil
 }
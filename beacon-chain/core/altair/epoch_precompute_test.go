@@ -6,9 +6,9 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
-	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -18,7 +18,7 @@ import (
 
 func TestInitializeEpochValidators_Ok(t *testing.T) {
 	ffe := params.BeaconConfig().FarFutureEpoch
-	s, err := stateAltair.InitializeFromProto(&ethpb.BeaconStateAltair{
+	s, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
 		Slot: params.BeaconConfig().SlotsPerEpoch,
 		// Validator 0 is slashed
 		// Validator 1 is withdrawable
@@ -66,7 +66,7 @@ func TestInitializeEpochValidators_Ok(t *testing.T) {
 
 func TestInitializeEpochValidators_Overflow(t *testing.T) {
 	ffe := params.BeaconConfig().FarFutureEpoch
-	s, err := stateAltair.InitializeFromProto(&ethpb.BeaconStateAltair{
+	s, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
 		Slot: params.BeaconConfig().SlotsPerEpoch,
 		Validators: []*ethpb.Validator{
 			{WithdrawableEpoch: ffe, ExitEpoch: ffe, EffectiveBalance: math.MaxUint64},
@@ -80,7 +80,7 @@ func TestInitializeEpochValidators_Overflow(t *testing.T) {
 }
 
 func TestInitializeEpochValidators_BadState(t *testing.T) {
-	s, err := stateAltair.InitializeFromProto(&ethpb.BeaconStateAltair{
+	s, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
 		Validators:       []*ethpb.Validator{{}},
 		InactivityScores: []uint64{},
 	})
@@ -140,6 +140,26 @@ func TestProcessEpochParticipation(t *testing.T) {
 	require.Equal(t, balance.PrevEpochHeadAttested, params.BeaconConfig().MaxEffectiveBalance*1)
 }
 
+func TestProcessEpochParticipation_LongerThanRegistry(t *testing.T) {
+	st, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
+		Slot:                       2 * params.BeaconConfig().SlotsPerEpoch,
+		Validators:                 []*ethpb.Validator{{EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance}},
+		CurrentEpochParticipation:  []byte{0, 0},
+		PreviousEpochParticipation: []byte{0},
+		InactivityScores:           []uint64{0},
+	})
+	require.NoError(t, err)
+	validators, balance, err := InitializePrecomputeValidators(context.Background(), st)
+	require.NoError(t, err)
+	_, _, err = ProcessEpochParticipation(context.Background(), st, balance, validators)
+	require.ErrorContains(t, "current epoch participation is longer than validator registry", err)
+
+	require.NoError(t, st.SetCurrentParticipationBits([]byte{0}))
+	require.NoError(t, st.SetPreviousParticipationBits([]byte{0, 0}))
+	_, _, err = ProcessEpochParticipation(context.Background(), st, balance, validators)
+	require.ErrorContains(t, "previous epoch participation is longer than validator registry", err)
+}
+
 func TestProcessEpochParticipation_InactiveValidator(t *testing.T) {
 	generateParticipation := func(flags ...uint8) byte {
 		b := byte(0)
@@ -150,7 +170,7 @@ func TestProcessEpochParticipation_InactiveValidator(t *testing.T) {
 		}
 		return b
 	}
-	st, err := stateAltair.InitializeFromProto(&ethpb.BeaconStateAltair{
+	st, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
 		Slot: 2 * params.BeaconConfig().SlotsPerEpoch,
 		Validators: []*ethpb.Validator{
 			{EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance},                                                  // Inactive
@@ -238,6 +258,80 @@ func TestAttestationsDelta(t *testing.T) {
 	require.DeepEqual(t, want, penalties)
 }
 
+func TestAttestationDeltas(t *testing.T) {
+	s, err := testState()
+	require.NoError(t, err)
+	validators, balance, err := InitializePrecomputeValidators(context.Background(), s)
+	require.NoError(t, err)
+	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
+	require.NoError(t, err)
+	deltas, err := AttestationDeltas(s, balance, validators)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(s, balance, validators)
+	require.NoError(t, err)
+	require.Equal(t, len(rewards), len(deltas))
+	for i, d := range deltas {
+		require.Equal(t, rewards[i], d.Reward())
+		require.Equal(t, penalties[i], d.Penalty())
+	}
+
+	// The last validator voted timely for the source, target and head.
+	ideal, err := IdealAttestationDelta(s, balance, validators[len(validators)-1].CurrentEpochEffectiveBalance)
+	require.NoError(t, err)
+	require.DeepEqual(t, deltas[len(deltas)-1], ideal)
+	require.Equal(t, uint64(0), ideal.Penalty())
+	require.Equal(t, true, ideal.HeadReward > 0 && ideal.SourceReward > 0 && ideal.TargetReward > 0)
+}
+
+func TestAttestationsDelta_Parallel(t *testing.T) {
+	defer func(threshold int) { helpers.ParallelValidatorThreshold = threshold }(helpers.ParallelValidatorThreshold)
+	helpers.ParallelValidatorThreshold = 1
+
+	s, err := testState()
+	require.NoError(t, err)
+	validators, balance, err := InitializePrecomputeValidators(context.Background(), s)
+	require.NoError(t, err)
+	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
+	require.NoError(t, err)
+	rewards, penalties, err := AttestationsDelta(s, balance, validators)
+	require.NoError(t, err)
+
+	// Splitting the work across workers must give the exact same deltas as the sequential loop.
+	want := []uint64{0, 939146, 2101898, 2414946}
+	require.DeepEqual(t, want, rewards)
+	want = []uint64{3577700, 2325505, 0, 0}
+	require.DeepEqual(t, want, penalties)
+}
+
+func BenchmarkAttestationsDelta(b *testing.B) {
+	numVals := 1 << 18
+	vals := make([]*ethpb.Validator, numVals)
+	participation := make([]byte, numVals)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance, ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+		participation[i] = byte(i % 8)
+	}
+	s, err := statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
+		Slot:                       2 * params.BeaconConfig().SlotsPerEpoch,
+		Validators:                 vals,
+		CurrentEpochParticipation:  participation,
+		PreviousEpochParticipation: participation,
+		InactivityScores:           make([]uint64, numVals),
+		Balances:                   make([]uint64, numVals),
+	})
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validators, balance, err := InitializePrecomputeValidators(context.Background(), s)
+		require.NoError(b, err)
+		validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
+		require.NoError(b, err)
+		_, _, err = AttestationsDelta(s, balance, validators)
+		require.NoError(b, err)
+	}
+}
+
 func TestAttestationsDeltaBellatrix(t *testing.T) {
 	s, err := testStateBellatrix()
 	require.NoError(t, err)
@@ -468,7 +562,7 @@ func testState() (state.BeaconState, error) {
 		}
 		return b
 	}
-	return stateAltair.InitializeFromProto(&ethpb.BeaconStateAltair{
+	return statenative.InitializeFromProtoAltair(&ethpb.BeaconStateAltair{
 		Slot: 2 * params.BeaconConfig().SlotsPerEpoch,
 		Validators: []*ethpb.Validator{
 			{EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance, ExitEpoch: params.BeaconConfig().FarFutureEpoch},
@@ -505,7 +599,7 @@ func testStateBellatrix() (state.BeaconState, error) {
 		}
 		return b
 	}
-	return v3.InitializeFromProto(&ethpb.BeaconStateBellatrix{
+	return statenative.InitializeFromProtoBellatrix(&ethpb.BeaconStateBellatrix{
 		Slot: 2 * params.BeaconConfig().SlotsPerEpoch,
 		Validators: []*ethpb.Validator{
 			{EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance, ExitEpoch: params.BeaconConfig().FarFutureEpoch},
@@ -116,7 +116,7 @@ func IsPreBellatrixVersion(v int) bool {
 }
 
 // ValidatePayloadWhenMergeCompletes validates if payload is valid versus input beacon state.
-// These validation steps ONLY apply to post merge.
+// These validation steps ONLY apply to post merge. The payload may also be the header of a blinded block.
 //
 // Spec code:
 //    # Verify consistency of the parent hash with respect to the previous execution payload header
@@ -136,13 +136,13 @@ func ValidatePayloadWhenMergeCompletes(st state.BeaconState, payload interfaces.
 		return err
 	}
 	if !bytes.Equal(payload.ParentHash(), header.BlockHash) {
-		return errors.New("incorrect block hash")
+		return ErrInvalidPayloadBlockHash
 	}
 	return nil
 }
 
 // ValidatePayload validates if payload is valid versus input beacon state.
-// These validation steps apply to both pre merge and post merge.
+// These validation steps apply to both pre merge and post merge. The payload may also be the header of a blinded block.
 //
 // Spec code:
 //    # Verify random
@@ -171,6 +171,7 @@ func ValidatePayload(st state.BeaconState, payload interfaces.ExecutionData) err
 // ProcessPayload processes input execution payload using beacon state.
 // ValidatePayloadWhenMergeCompletes validates if payload is valid versus input beacon state.
 // These validation steps ONLY apply to post merge.
+// The payload of a blinded block is its header, which is cached as is.
 //
 // Spec code:
 // def process_execution_payload(state: BeaconState, payload: ExecutionPayload, execution_engine: ExecutionEngine) -> None:
@@ -221,63 +222,6 @@ func ProcessPayload(st state.BeaconState, payload interfaces.ExecutionData) (sta
 	return st, nil
 }
 
-// ValidatePayloadHeaderWhenMergeCompletes validates the payload header when the merge completes.
-func ValidatePayloadHeaderWhenMergeCompletes(st state.BeaconState, header interfaces.ExecutionData) error {
-	// Skip validation if the state is not merge compatible.
-	complete, err := IsMergeTransitionComplete(st)
-	if err != nil {
-		return err
-	}
-	if !complete {
-		return nil
-	}
-	// Validate current header's parent hash matches state header's block hash.
-	h, err := st.LatestExecutionPayloadHeader()
-	if err != nil {
-		return err
-	}
-	if !bytes.Equal(header.ParentHash(), h.BlockHash) {
-		return ErrInvalidPayloadBlockHash
-	}
-	return nil
-}
-
-// ValidatePayloadHeader validates the payload header.
-func ValidatePayloadHeader(st state.BeaconState, header interfaces.ExecutionData) error {
-	// Validate header's random mix matches with state in current epoch
-	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
-	if err != nil {
-		return err
-	}
-	if !bytes.Equal(header.PrevRandao(), random) {
-		return ErrInvalidPayloadPrevRandao
-	}
-
-	// Validate header's timestamp matches with state in current slot.
-	t, err := slots.ToTime(st.GenesisTime(), st.Slot())
-	if err != nil {
-		return err
-	}
-	if header.Timestamp() != uint64(t.Unix()) {
-		return ErrInvalidPayloadTimeStamp
-	}
-	return nil
-}
-
-// ProcessPayloadHeader processes the payload header.
-func ProcessPayloadHeader(st state.BeaconState, header interfaces.ExecutionData) (state.BeaconState, error) {
-	if err := ValidatePayloadHeaderWhenMergeCompletes(st, header); err != nil {
-		return nil, err
-	}
-	if err := ValidatePayloadHeader(st, header); err != nil {
-		return nil, err
-	}
-	if err := st.SetLatestExecutionPayloadHeader(header); err != nil {
-		return nil, err
-	}
-	return st, nil
-}
-
 // GetBlockPayloadHash returns the hash of the execution payload of the block
 func GetBlockPayloadHash(blk interfaces.BeaconBlock) ([32]byte, error) {
 	payloadHash := [32]byte{}
@@ -3,7 +3,6 @@ package blocks_test
 import (
 	"testing"
 
-	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
@@ -376,7 +375,7 @@ func Test_ValidatePayloadWhenMergeCompletes(t *testing.T) {
 				h.BlockHash = bytesutil.PadTo([]byte{'b'}, fieldparams.RootLength)
 				return h
 			}(),
-			err: errors.New("incorrect block hash"),
+			err: blocks.ErrInvalidPayloadBlockHash,
 		},
 	}
 	for _, tt := range tests {
@@ -502,7 +501,7 @@ func Test_ProcessPayload(t *testing.T) {
 	}
 }
 
-func Test_ProcessPayloadHeader(t *testing.T) {
+func Test_ProcessPayload_Header(t *testing.T) {
 	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
 	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
 	require.NoError(t, err)
@@ -542,7 +541,7 @@ func Test_ProcessPayloadHeader(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(tt.header)
 			require.NoError(t, err)
-			st, err := blocks.ProcessPayloadHeader(st, wrappedHeader)
+			st, err := blocks.ProcessPayload(st, wrappedHeader)
 			if err != nil {
 				require.Equal(t, tt.err.Error(), err.Error())
 			} else {
@@ -555,7 +554,7 @@ func Test_ProcessPayloadHeader(t *testing.T) {
 	}
 }
 
-func Test_ValidatePayloadHeader(t *testing.T) {
+func Test_ValidatePayload_Header(t *testing.T) {
 	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
 	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
 	require.NoError(t, err)
@@ -595,13 +594,13 @@ func Test_ValidatePayloadHeader(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(tt.header)
 			require.NoError(t, err)
-			err = blocks.ValidatePayloadHeader(st, wrappedHeader)
+			err = blocks.ValidatePayload(st, wrappedHeader)
 			require.Equal(t, tt.err, err)
 		})
 	}
 }
 
-func Test_ValidatePayloadHeaderWhenMergeCompletes(t *testing.T) {
+func Test_ValidatePayloadWhenMergeCompletes_Header(t *testing.T) {
 	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
 	emptySt := st.Copy()
 	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(&enginev1.ExecutionPayloadHeader{BlockHash: []byte{'a'}})
@@ -647,7 +646,7 @@ func Test_ValidatePayloadHeaderWhenMergeCompletes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(tt.header)
 			require.NoError(t, err)
-			err = blocks.ValidatePayloadHeaderWhenMergeCompletes(tt.state, wrappedHeader)
+			err = blocks.ValidatePayloadWhenMergeCompletes(tt.state, wrappedHeader)
 			require.Equal(t, tt.err, err)
 		})
 	}
@@ -0,0 +1,186 @@
+package blocks
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+const (
+	// bodyRootIndex is the index of the body root among the fields of a beacon block.
+	bodyRootIndex = 4
+	// attestationsIndex is the index of the attestations among the fields of a beacon block body.
+	attestationsIndex = 5
+)
+
+// AttestationInclusionProof returns the Merkle branch proving the hash tree root of the attestation at the
+// given index of the body of the block against the root of the block, along with the generalized index of
+// the attestation in the block. The branch is ordered from the leaf to the root, so that it can be checked
+// with trie.VerifyMerkleProof.
+func AttestationInclusionProof(blk interfaces.BeaconBlock, index int) ([][]byte, uint64, error) {
+	if blk == nil || blk.IsNil() {
+		return nil, 0, errors.New("nil block")
+	}
+	atts := blk.Body().Attestations()
+	if index < 0 || index >= len(atts) {
+		return nil, 0, fmt.Errorf("attestation index %d out of range for %d attestations", index, len(atts))
+	}
+	maxAtts := params.BeaconConfig().MaxAttestations
+	attRoots := make([][]byte, len(atts))
+	for i, att := range atts {
+		r, err := att.HashTreeRoot()
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "could not hash attestation")
+		}
+		attRoots[i] = r[:]
+	}
+	// The root of a list mixes in its length as the right sibling of the root of its elements.
+	branch := constructProof(attRoots, maxAtts, uint64(index))
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:8], uint64(len(atts)))
+	branch = append(branch, length)
+
+	fieldRoots, err := bodyFieldRoots(blk)
+	if err != nil {
+		return nil, 0, err
+	}
+	branch = append(branch, constructProof(fieldRoots, uint64(len(fieldRoots)), attestationsIndex)...)
+
+	bodyRoot, err := blk.Body().HashTreeRoot()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not hash block body")
+	}
+	slot := ssz.Uint64Root(uint64(blk.Slot()))
+	proposerIndex := ssz.Uint64Root(uint64(blk.ProposerIndex()))
+	headerRoots := [][]byte{slot[:], proposerIndex[:], blk.ParentRoot(), blk.StateRoot(), bodyRoot[:]}
+	branch = append(branch, constructProof(headerRoots, uint64(len(headerRoots)), bodyRootIndex)...)
+
+	gIndex := uint64(1)
+	gIndex = gIndex<<ssz.Depth(uint64(len(headerRoots))) | bodyRootIndex
+	gIndex = gIndex<<ssz.Depth(uint64(len(fieldRoots))) | attestationsIndex
+	// The elements of a list are the left subtree of its root, below the length mix in.
+	gIndex = gIndex<<(ssz.Depth(maxAtts)+1) | uint64(index)
+
+	proof := make([][]byte, len(branch))
+	for i := range branch {
+		proof[i] = append([]byte{}, branch[i][:]...)
+	}
+	return proof, gIndex, nil
+}
+
+// bodyFieldRoots returns the hash tree roots of the fields of the body of the block, in field order.
+func bodyFieldRoots(blk interfaces.BeaconBlock) ([][]byte, error) {
+	body := blk.Body()
+	roots := make([][]byte, 0, 10)
+	appendRoot := func(r [32]byte, err error) error {
+		if err != nil {
+			return err
+		}
+		roots = append(roots, r[:])
+		return nil
+	}
+
+	if err := appendRoot(bytesRoot(body.RandaoReveal())); err != nil {
+		return nil, errors.Wrap(err, "could not hash randao reveal")
+	}
+	if err := appendRoot(body.Eth1Data().HashTreeRoot()); err != nil {
+		return nil, errors.Wrap(err, "could not hash eth1 data")
+	}
+	if err := appendRoot(bytesRoot(body.Graffiti())); err != nil {
+		return nil, errors.Wrap(err, "could not hash graffiti")
+	}
+	cfg := params.BeaconConfig()
+	proposerSlashings := body.ProposerSlashings()
+	if err := appendRoot(listRoot(len(proposerSlashings), cfg.MaxProposerSlashings, func(i int) fastssz.HashRoot {
+		return proposerSlashings[i]
+	})); err != nil {
+		return nil, errors.Wrap(err, "could not hash proposer slashings")
+	}
+	attesterSlashings := body.AttesterSlashings()
+	if err := appendRoot(listRoot(len(attesterSlashings), cfg.MaxAttesterSlashings, func(i int) fastssz.HashRoot {
+		return attesterSlashings[i]
+	})); err != nil {
+		return nil, errors.Wrap(err, "could not hash attester slashings")
+	}
+	atts := body.Attestations()
+	if err := appendRoot(listRoot(len(atts), cfg.MaxAttestations, func(i int) fastssz.HashRoot {
+		return atts[i]
+	})); err != nil {
+		return nil, errors.Wrap(err, "could not hash attestations")
+	}
+	deposits := body.Deposits()
+	if err := appendRoot(listRoot(len(deposits), cfg.MaxDeposits, func(i int) fastssz.HashRoot {
+		return deposits[i]
+	})); err != nil {
+		return nil, errors.Wrap(err, "could not hash deposits")
+	}
+	exits := body.VoluntaryExits()
+	if err := appendRoot(listRoot(len(exits), cfg.MaxVoluntaryExits, func(i int) fastssz.HashRoot {
+		return exits[i]
+	})); err != nil {
+		return nil, errors.Wrap(err, "could not hash voluntary exits")
+	}
+	if blk.Version() == version.Phase0 {
+		return roots, nil
+	}
+
+	syncAggregate, err := body.SyncAggregate()
+	if err != nil {
+		return nil, err
+	}
+	if err := appendRoot(syncAggregate.HashTreeRoot()); err != nil {
+		return nil, errors.Wrap(err, "could not hash sync aggregate")
+	}
+	if blk.Version() == version.Altair {
+		return roots, nil
+	}
+
+	payload, err := body.Execution()
+	if err != nil {
+		return nil, err
+	}
+	if err := appendRoot(payload.HashTreeRoot()); err != nil {
+		return nil, errors.Wrap(err, "could not hash execution payload")
+	}
+	return roots, nil
+}
+
+// constructProof returns the Merkle branch of the leaf at the given index of a tree of the given limit. The leaves
+// are padded with zero hashes to a power of two beforehand, as ssz.ConstructProof does not record the siblings
+// which are only completed by its own padding.
+func constructProof(leaves [][]byte, limit, index uint64) [][32]byte {
+	count := uint64(1) << ssz.Depth(uint64(len(leaves)))
+	hasher := ssz.NewHasherFunc(hash.CustomSHA256Hasher())
+	return ssz.ConstructProof(hasher, count, uint64(1)<<ssz.Depth(limit), func(i uint64) []byte {
+		if i < uint64(len(leaves)) {
+			return leaves[i]
+		}
+		return trie.ZeroHashes[0][:]
+	}, index)
+}
+
+func bytesRoot(b []byte) ([32]byte, error) {
+	hh := fastssz.NewHasher()
+	hh.PutBytes(b)
+	return hh.HashRoot()
+}
+
+func listRoot(num int, limit uint64, elem func(i int) fastssz.HashRoot) ([32]byte, error) {
+	hh := fastssz.NewHasher()
+	indx := hh.Index()
+	for i := 0; i < num; i++ {
+		if err := elem(i).HashTreeRootWith(hh); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(num), limit)
+	return hh.HashRoot()
+}
@@ -0,0 +1,72 @@
+package blocks_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestAttestationInclusionProof(t *testing.T) {
+	atts := make([]*ethpb.Attestation, 3)
+	for i := range atts {
+		atts[i] = util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3, CommitteeIndex: 1}})
+		atts[i].Data.BeaconBlockRoot[0] = byte(i)
+	}
+
+	phase0 := util.NewBeaconBlock()
+	phase0.Block.Body.Attestations = atts
+	altair := util.NewBeaconBlockAltair()
+	altair.Block.Body.Attestations = atts
+	bellatrix := util.NewBeaconBlockBellatrix()
+	bellatrix.Block.Body.Attestations = atts
+
+	tests := []struct {
+		name string
+		blk  interface{}
+	}{
+		{name: "phase 0", blk: phase0},
+		{name: "altair", blk: altair},
+		{name: "bellatrix", blk: bellatrix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wsb, err := wrapper.WrappedSignedBeaconBlock(tt.blk)
+			require.NoError(t, err)
+			blk := wsb.Block()
+			blkRoot, err := blk.HashTreeRoot()
+			require.NoError(t, err)
+
+			for i, att := range atts {
+				attRoot, err := att.HashTreeRoot()
+				require.NoError(t, err)
+				branch, gIndex, err := blocks.AttestationInclusionProof(blk, i)
+				require.NoError(t, err)
+				assert.Equal(t, true, trie.VerifyMerkleProof(blkRoot[:], attRoot[:], gIndex, branch))
+
+				// The proof must not verify another attestation of the block.
+				otherRoot, err := atts[(i+1)%len(atts)].HashTreeRoot()
+				require.NoError(t, err)
+				assert.Equal(t, false, trie.VerifyMerkleProof(blkRoot[:], otherRoot[:], gIndex, branch))
+			}
+		})
+	}
+
+	t.Run("index out of range", func(t *testing.T) {
+		wsb, err := wrapper.WrappedSignedBeaconBlock(phase0)
+		require.NoError(t, err)
+		_, _, err = blocks.AttestationInclusionProof(wsb.Block(), len(atts))
+		require.ErrorContains(t, "out of range", err)
+	})
+	t.Run("nil block", func(t *testing.T) {
+		var blk interfaces.BeaconBlock
+		_, _, err := blocks.AttestationInclusionProof(blk, 0)
+		require.ErrorContains(t, "nil block", err)
+	})
+}
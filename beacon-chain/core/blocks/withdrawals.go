@@ -0,0 +1,197 @@
+package blocks
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+var (
+	errNilBLSToExecutionChange          = errors.New("nil BLS to execution change")
+	errInvalidBLSPrefix                 = errors.New("withdrawal credentials do not have a BLS prefix")
+	errInvalidWithdrawalCredentials     = errors.New("withdrawal credentials do not match the BLS public key")
+	errInvalidBLSToExecutionChangeIndex = errors.New("validator index is invalid")
+)
+
+// ErrWithdrawalsBeforeCapella is returned when expected withdrawals are requested from a state before the Capella fork.
+var ErrWithdrawalsBeforeCapella = errors.New("withdrawals are not processed before the Capella fork")
+
+// ValidateBLSToExecutionChange checks a BLS to execution change against the validator registry of the given
+// state and returns the validator it applies to. The signature of the change is verified separately, by
+// VerifyBLSChangeSignature.
+//
+// Spec pseudocode definition:
+//   def process_bls_to_execution_change(state: BeaconState,
+//                                       signed_address_change: SignedBLSToExecutionChange) -> None:
+//    address_change = signed_address_change.message
+//
+//    assert address_change.validator_index < len(state.validators)
+//
+//    validator = state.validators[address_change.validator_index]
+//
+//    assert validator.withdrawal_credentials[:1] == BLS_WITHDRAWAL_PREFIX
+//    assert validator.withdrawal_credentials[1:] == hash(address_change.from_bls_pubkey)[1:]
+//
+//    domain = get_domain(state, DOMAIN_BLS_TO_EXECUTION_CHANGE)
+//    signing_root = compute_signing_root(address_change, domain)
+//    assert bls.Verify(address_change.from_bls_pubkey, signing_root, signed_address_change.signature)
+//
+//    validator.withdrawal_credentials = (
+//        ETH1_ADDRESS_WITHDRAWAL_PREFIX
+//        + b'\x00' * 11
+//        + address_change.to_execution_address
+//    )
+func ValidateBLSToExecutionChange(st state.ReadOnlyBeaconState, signed *ethpb.SignedBLSToExecutionChange) (state.ReadOnlyValidator, error) {
+	if signed == nil || signed.Message == nil {
+		return nil, errNilBLSToExecutionChange
+	}
+	change := signed.Message
+	if uint64(change.ValidatorIndex) >= uint64(st.NumValidators()) {
+		return nil, errInvalidBLSToExecutionChangeIndex
+	}
+	val, err := st.ValidatorAtIndexReadOnly(change.ValidatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	cred := val.WithdrawalCredentials()
+	if len(cred) == 0 || cred[0] != params.BeaconConfig().BLSWithdrawalPrefixByte {
+		return nil, errInvalidBLSPrefix
+	}
+	pubkeyHash := hash.Hash(change.FromBlsPubkey)
+	if !bytes.Equal(cred[1:], pubkeyHash[1:]) {
+		return nil, errInvalidWithdrawalCredentials
+	}
+	return val, nil
+}
+
+// VerifyBLSChangeSignature verifies the signature of a BLS to execution change with the BLS public key it
+// changes from. The signing domain uses the genesis fork version, so that a change signed once stays valid
+// across forks.
+func VerifyBLSChangeSignature(st state.ReadOnlyBeaconState, signed *ethpb.SignedBLSToExecutionChange) error {
+	if signed == nil || signed.Message == nil {
+		return errNilBLSToExecutionChange
+	}
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainBLSToExecutionChange, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing domain")
+	}
+	return signing.VerifySigningRoot(signed.Message, signed.Message.FromBlsPubkey, signed.Signature, domain)
+}
+
+// ExpectedWithdrawals returns the withdrawals the withdrawals sweep makes from the given state, starting at
+// the given withdrawal index and validator index. The state must be on the Capella fork, as withdrawals are
+// not processed before it.
+//
+// Spec pseudocode definition:
+//   def get_expected_withdrawals(state: BeaconState) -> Sequence[Withdrawal]:
+//    epoch = get_current_epoch(state)
+//    withdrawal_index = state.next_withdrawal_index
+//    validator_index = state.next_withdrawal_validator_index
+//    withdrawals: List[Withdrawal] = []
+//    bound = min(len(state.validators), MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP)
+//    for _ in range(bound):
+//        validator = state.validators[validator_index]
+//        balance = state.balances[validator_index]
+//        if is_fully_withdrawable_validator(validator, balance, epoch):
+//            withdrawals.append(Withdrawal(
+//                index=withdrawal_index,
+//                validator_index=validator_index,
+//                address=ExecutionAddress(validator.withdrawal_credentials[12:]),
+//                amount=balance,
+//            ))
+//            withdrawal_index += WithdrawalIndex(1)
+//        elif is_partially_withdrawable_validator(validator, balance):
+//            withdrawals.append(Withdrawal(
+//                index=withdrawal_index,
+//                validator_index=validator_index,
+//                address=ExecutionAddress(validator.withdrawal_credentials[12:]),
+//                amount=balance - MAX_EFFECTIVE_BALANCE,
+//            ))
+//            withdrawal_index += WithdrawalIndex(1)
+//        if len(withdrawals) == MAX_WITHDRAWALS_PER_PAYLOAD:
+//            break
+//        validator_index = ValidatorIndex((validator_index + 1) % len(state.validators))
+//    return withdrawals
+func ExpectedWithdrawals(
+	st state.ReadOnlyBeaconState,
+	withdrawalIndex uint64,
+	validatorIndex types.ValidatorIndex,
+) ([]*enginev1.Withdrawal, error) {
+	c := params.BeaconConfig()
+	fork := st.Fork()
+	if fork == nil || !bytes.Equal(fork.CurrentVersion, c.CapellaForkVersion) {
+		return nil, ErrWithdrawalsBeforeCapella
+	}
+	numVals := uint64(st.NumValidators())
+	if numVals == 0 {
+		return []*enginev1.Withdrawal{}, nil
+	}
+	if uint64(validatorIndex) >= numVals {
+		return nil, errors.Errorf("validator index %d is out of range of %d validators", validatorIndex, numVals)
+	}
+	epoch := slots.ToEpoch(st.Slot())
+	bound := numVals
+	if c.MaxValidatorsPerWithdrawalsSweep < bound {
+		bound = c.MaxValidatorsPerWithdrawalsSweep
+	}
+	withdrawals := make([]*enginev1.Withdrawal, 0, c.MaxWithdrawalsPerPayload)
+	for i := uint64(0); i < bound; i++ {
+		val, err := st.ValidatorAtIndexReadOnly(validatorIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator at index %d", validatorIndex)
+		}
+		balance, err := st.BalanceAtIndex(validatorIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get balance at index %d", validatorIndex)
+		}
+		var amount uint64
+		if isFullyWithdrawable(val, balance, epoch) {
+			amount = balance
+		} else if isPartiallyWithdrawable(val, balance) {
+			amount = balance - c.MaxEffectiveBalance
+		}
+		if amount > 0 {
+			cred := val.WithdrawalCredentials()
+			withdrawals = append(withdrawals, &enginev1.Withdrawal{
+				WithdrawalIndex:  withdrawalIndex,
+				ValidatorIndex:   validatorIndex,
+				ExecutionAddress: bytesutil.SafeCopyBytes(cred[12:]),
+				Amount:           amount,
+			})
+			withdrawalIndex++
+		}
+		if uint64(len(withdrawals)) == c.MaxWithdrawalsPerPayload {
+			break
+		}
+		validatorIndex = types.ValidatorIndex((uint64(validatorIndex) + 1) % numVals)
+	}
+	return withdrawals, nil
+}
+
+// hasETH1WithdrawalCredential checks whether the validator withdraws to an execution address.
+func hasETH1WithdrawalCredential(val state.ReadOnlyValidator) bool {
+	cred := val.WithdrawalCredentials()
+	return len(cred) == 32 && cred[0] == params.BeaconConfig().ETH1AddressWithdrawalPrefixByte
+}
+
+// isFullyWithdrawable checks whether the whole balance of the validator can be withdrawn at the given epoch.
+func isFullyWithdrawable(val state.ReadOnlyValidator, balance uint64, epoch types.Epoch) bool {
+	return hasETH1WithdrawalCredential(val) && val.WithdrawableEpoch() <= epoch && balance > 0
+}
+
+// isPartiallyWithdrawable checks whether the validator has a balance in excess of the maximum effective
+// balance that can be withdrawn.
+func isPartiallyWithdrawable(val state.ReadOnlyValidator, balance uint64) bool {
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	return hasETH1WithdrawalCredential(val) && val.EffectiveBalance() == maxBalance && balance > maxBalance
+}
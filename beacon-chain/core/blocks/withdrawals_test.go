@@ -0,0 +1,187 @@
+package blocks_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestValidateBLSToExecutionChange(t *testing.T) {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	pubkey := priv.PublicKey().Marshal()
+	blsCred := hash.Hash(pubkey)
+	blsCred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+	execCred := make([]byte, 32)
+	execCred[0] = 0x01
+
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{WithdrawalCredentials: blsCred[:]},
+			{WithdrawalCredentials: execCred},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		change  *ethpb.BLSToExecutionChange
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			change: &ethpb.BLSToExecutionChange{ValidatorIndex: 0, FromBlsPubkey: pubkey},
+		},
+		{
+			name:    "unknown validator",
+			change:  &ethpb.BLSToExecutionChange{ValidatorIndex: 2, FromBlsPubkey: pubkey},
+			wantErr: "validator index is invalid",
+		},
+		{
+			name:    "not a BLS prefix",
+			change:  &ethpb.BLSToExecutionChange{ValidatorIndex: 1, FromBlsPubkey: pubkey},
+			wantErr: "withdrawal credentials do not have a BLS prefix",
+		},
+		{
+			name:    "wrong public key",
+			change:  &ethpb.BLSToExecutionChange{ValidatorIndex: 0, FromBlsPubkey: make([]byte, 48)},
+			wantErr: "withdrawal credentials do not match the BLS public key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := blocks.ValidateBLSToExecutionChange(st, &ethpb.SignedBLSToExecutionChange{Message: tt.change})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, blsCred[:], val.WithdrawalCredentials())
+		})
+	}
+}
+
+func TestVerifyBLSChangeSignature(t *testing.T) {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		GenesisValidatorsRoot: make([]byte, 32),
+		Fork: &ethpb.Fork{
+			PreviousVersion: []byte{1, 0, 0, 0},
+			CurrentVersion:  []byte{1, 0, 0, 0},
+		},
+	})
+	require.NoError(t, err)
+	change := &ethpb.BLSToExecutionChange{
+		ValidatorIndex:     0,
+		FromBlsPubkey:      priv.PublicKey().Marshal(),
+		ToExecutionAddress: make([]byte, 20),
+	}
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainBLSToExecutionChange, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(change, domain)
+	require.NoError(t, err)
+	signed := &ethpb.SignedBLSToExecutionChange{Message: change, Signature: priv.Sign(root[:]).Marshal()}
+	require.NoError(t, blocks.VerifyBLSChangeSignature(st, signed))
+
+	// A signature over the domain of the current fork is not valid.
+	domain, err = signing.ComputeDomain(c.DomainBLSToExecutionChange, st.Fork().CurrentVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err = signing.ComputeSigningRoot(change, domain)
+	require.NoError(t, err)
+	signed.Signature = priv.Sign(root[:]).Marshal()
+	assert.NotNil(t, blocks.VerifyBLSChangeSignature(st, signed))
+}
+
+func TestExpectedWithdrawals(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.CapellaForkVersion = []byte{3, 0, 0, 0}
+	params.OverrideBeaconConfig(cfg)
+
+	maxBalance := cfg.MaxEffectiveBalance
+	blsCred := make([]byte, 32)
+	execCred := func(b byte) []byte {
+		cred := make([]byte, 32)
+		cred[0] = cfg.ETH1AddressWithdrawalPrefixByte
+		cred[31] = b
+		return cred
+	}
+	pb := &ethpb.BeaconState{
+		Slot: 2 * cfg.SlotsPerEpoch,
+		Fork: &ethpb.Fork{
+			PreviousVersion: cfg.BellatrixForkVersion,
+			CurrentVersion:  cfg.CapellaForkVersion,
+		},
+		Validators: []*ethpb.Validator{
+			// BLS withdrawal credentials are never swept.
+			{WithdrawalCredentials: blsCred, EffectiveBalance: maxBalance, WithdrawableEpoch: 0},
+			// Withdrawable, so the whole balance is withdrawn.
+			{WithdrawalCredentials: execCred(1), EffectiveBalance: 1e9, WithdrawableEpoch: 2},
+			// Balance in excess of the maximum effective balance is withdrawn.
+			{WithdrawalCredentials: execCred(2), EffectiveBalance: maxBalance, WithdrawableEpoch: cfg.FarFutureEpoch},
+			// Nothing in excess of the maximum effective balance.
+			{WithdrawalCredentials: execCred(3), EffectiveBalance: maxBalance, WithdrawableEpoch: cfg.FarFutureEpoch},
+		},
+		Balances: []uint64{maxBalance + 1, 1e9, maxBalance + 2e9, maxBalance},
+	}
+	st, err := statenative.InitializeFromProtoPhase0(pb)
+	require.NoError(t, err)
+
+	withdrawals, err := blocks.ExpectedWithdrawals(st, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(withdrawals))
+	assert.DeepEqual(t, &enginev1.Withdrawal{
+		WithdrawalIndex:  5,
+		ValidatorIndex:   2,
+		ExecutionAddress: execCred(2)[12:],
+		Amount:           2e9,
+	}, withdrawals[0])
+	assert.DeepEqual(t, &enginev1.Withdrawal{
+		WithdrawalIndex:  6,
+		ValidatorIndex:   1,
+		ExecutionAddress: execCred(1)[12:],
+		Amount:           1e9,
+	}, withdrawals[1])
+
+	t.Run("bounded by max withdrawals per payload", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.MaxWithdrawalsPerPayload = 1
+		params.OverrideBeaconConfig(cfg)
+		withdrawals, err := blocks.ExpectedWithdrawals(st, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(withdrawals))
+		assert.Equal(t, types.ValidatorIndex(1), withdrawals[0].ValidatorIndex)
+	})
+	t.Run("bounded by max validators per sweep", func(t *testing.T) {
+		cfg := params.BeaconConfig().Copy()
+		cfg.MaxWithdrawalsPerPayload = 16
+		cfg.MaxValidatorsPerWithdrawalsSweep = 2
+		params.OverrideBeaconConfig(cfg)
+		withdrawals, err := blocks.ExpectedWithdrawals(st, 0, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(withdrawals))
+	})
+	t.Run("validator index out of range", func(t *testing.T) {
+		_, err := blocks.ExpectedWithdrawals(st, 0, 4)
+		assert.ErrorContains(t, "out of range", err)
+	})
+	t.Run("before capella", func(t *testing.T) {
+		pb.Fork.CurrentVersion = cfg.BellatrixForkVersion
+		st, err := statenative.InitializeFromProtoPhase0(pb)
+		require.NoError(t, err)
+		_, err = blocks.ExpectedWithdrawals(st, 0, 0)
+		assert.ErrorContains(t, "not processed before the Capella fork", err)
+	})
+}
@@ -249,33 +249,50 @@ func ProcessEffectiveBalanceUpdates(state state.BeaconState) (state.BeaconState,
 	upwardThreshold := hysteresisInc * params.BeaconConfig().HysteresisUpwardMultiplier
 
 	bals := state.Balances()
+	numVals := state.NumValidators()
+	if len(bals) < numVals {
+		return nil, fmt.Errorf("validator index exceeds validator length in state %d >= %d", len(bals), len(bals))
+	}
 
-	// Update effective balances with hysteresis.
-	validatorFunc := func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
-		if val == nil {
-			return false, nil, fmt.Errorf("validator %d is nil in state", idx)
-		}
-		if idx >= len(bals) {
-			return false, nil, fmt.Errorf("validator index exceeds validator length in state %d >= %d", idx, len(state.Balances()))
-		}
-		balance := bals[idx]
-
-		if balance+downwardThreshold < val.EffectiveBalance || val.EffectiveBalance+upwardThreshold < balance {
-			effectiveBal := maxEffBalance
-			if effectiveBal > balance-balance%effBalanceInc {
-				effectiveBal = balance - balance%effBalanceInc
+	// Update effective balances with hysteresis. The updated validators are computed across
+	// worker goroutines for large registries and written to the state in a single pass.
+	updated := make([]*ethpb.Validator, numVals)
+	if err := helpers.ForEachValidatorChunk(numVals, func(start, end int) error {
+		for i := start; i < end; i++ {
+			val, err := state.ValidatorAtIndexReadOnly(types.ValidatorIndex(i))
+			if err != nil {
+				return errors.Wrapf(err, "could not read validator %d", i)
+			}
+			balance := bals[i]
+			effectiveBal := val.EffectiveBalance()
+			if balance+downwardThreshold >= effectiveBal && effectiveBal+upwardThreshold >= balance {
+				continue
 			}
-			if effectiveBal != val.EffectiveBalance {
-				newVal := ethpb.CopyValidator(val)
-				newVal.EffectiveBalance = effectiveBal
-				return true, newVal, nil
+			newEffectiveBal := maxEffBalance
+			if newEffectiveBal > balance-balance%effBalanceInc {
+				newEffectiveBal = balance - balance%effBalanceInc
 			}
-			return false, val, nil
+			if newEffectiveBal == effectiveBal {
+				continue
+			}
+			newVal, err := state.ValidatorAtIndex(types.ValidatorIndex(i))
+			if err != nil {
+				return errors.Wrapf(err, "could not copy validator %d", i)
+			}
+			newVal.EffectiveBalance = newEffectiveBal
+			updated[i] = newVal
 		}
-		return false, val, nil
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	if err := state.ApplyToEveryValidator(validatorFunc); err != nil {
+	if err := state.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
+		if idx < len(updated) && updated[idx] != nil {
+			return true, updated[idx], nil
+		}
+		return false, val, nil
+	}); err != nil {
 		return nil, err
 	}
 
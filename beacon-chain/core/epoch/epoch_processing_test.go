@@ -11,7 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -46,7 +46,7 @@ func TestUnslashedAttestingIndices_CanSortAndFilter(t *testing.T) {
 		Validators:  validators,
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 
 	indices, err := epoch.UnslashedAttestingIndices(context.Background(), beaconState, atts)
@@ -92,7 +92,7 @@ func TestUnslashedAttestingIndices_DuplicatedAttestations(t *testing.T) {
 		Validators:  validators,
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 
 	indices, err := epoch.UnslashedAttestingIndices(context.Background(), beaconState, atts)
@@ -138,7 +138,7 @@ func TestAttestingBalance_CorrectBalance(t *testing.T) {
 		Validators: validators,
 		Balances:   balances,
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 
 	balance, err := epoch.AttestingBalance(context.Background(), beaconState, atts)
@@ -154,7 +154,7 @@ func TestProcessSlashings_NotSlashed(t *testing.T) {
 		Balances:   []uint64{params.BeaconConfig().MaxEffectiveBalance},
 		Slashings:  []uint64{0, 1e9},
 	}
-	s, err := v1.InitializeFromProto(base)
+	s, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := epoch.ProcessSlashings(s, params.BeaconConfig().ProportionalSlashingMultiplier)
 	require.NoError(t, err)
@@ -232,7 +232,7 @@ func TestProcessSlashings_SlashedLess(t *testing.T) {
 	for i, tt := range tests {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
 			original := proto.Clone(tt.state)
-			s, err := v1.InitializeFromProto(tt.state)
+			s, err := statenative.InitializeFromProtoPhase0(tt.state)
 			require.NoError(t, err)
 			helpers.ClearCache()
 			newState, err := epoch.ProcessSlashings(s, params.BeaconConfig().ProportionalSlashingMultiplier)
@@ -280,6 +280,51 @@ func TestProcessFinalUpdates_CanProcess(t *testing.T) {
 	assert.NotNil(t, currAtt, "Nil value stored in current epoch attestations instead of empty slice")
 }
 
+func TestProcessEffectiveBalanceUpdates_Parallel(t *testing.T) {
+	defer func(threshold int) { helpers.ParallelValidatorThreshold = threshold }(helpers.ParallelValidatorThreshold)
+	helpers.ParallelValidatorThreshold = 1
+
+	s := buildState(t, 0, 64)
+	balances := s.Balances()
+	for i := range balances {
+		// Alternate between balances inside and outside of the hysteresis band.
+		if i%2 == 0 {
+			balances[i] = 31.74 * 1e9
+		} else {
+			balances[i] = 31.9 * 1e9
+		}
+	}
+	require.NoError(t, s.SetBalances(balances))
+
+	newS, err := epoch.ProcessEffectiveBalanceUpdates(s)
+	require.NoError(t, err)
+	for i, v := range newS.Validators() {
+		want := params.BeaconConfig().MaxEffectiveBalance
+		if i%2 == 0 {
+			want = 31 * 1e9
+		}
+		require.Equal(t, want, v.EffectiveBalance, "validator %d", i)
+	}
+}
+
+func BenchmarkProcessEffectiveBalanceUpdates(b *testing.B) {
+	s := buildState(b, 0, 1<<18)
+	balances := s.Balances()
+	for i := 0; i < len(balances); i += 3 {
+		balances[i] = 31.74 * 1e9
+	}
+	require.NoError(b, s.SetBalances(balances))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		st := s.Copy()
+		b.StartTimer()
+		_, err := epoch.ProcessEffectiveBalanceUpdates(st)
+		require.NoError(b, err)
+	}
+}
+
 func TestProcessRegistryUpdates_NoRotation(t *testing.T) {
 	base := &ethpb.BeaconState{
 		Slot: 5 * params.BeaconConfig().SlotsPerEpoch,
@@ -293,7 +338,7 @@ func TestProcessRegistryUpdates_NoRotation(t *testing.T) {
 		},
 		FinalizedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := epoch.ProcessRegistryUpdates(context.Background(), beaconState)
 	require.NoError(t, err)
@@ -316,7 +361,7 @@ func TestProcessRegistryUpdates_EligibleToActivate(t *testing.T) {
 			ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
 		})
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	currentEpoch := time.CurrentEpoch(beaconState)
 	newState, err := epoch.ProcessRegistryUpdates(context.Background(), beaconState)
@@ -345,7 +390,7 @@ func TestProcessRegistryUpdates_ActivationCompletes(t *testing.T) {
 		},
 		FinalizedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := epoch.ProcessRegistryUpdates(context.Background(), beaconState)
 	require.NoError(t, err)
@@ -369,7 +414,7 @@ func TestProcessRegistryUpdates_ValidatorsEjected(t *testing.T) {
 		},
 		FinalizedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := epoch.ProcessRegistryUpdates(context.Background(), beaconState)
 	require.NoError(t, err)
@@ -394,7 +439,7 @@ func TestProcessRegistryUpdates_CanExits(t *testing.T) {
 		},
 		FinalizedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
 	}
-	beaconState, err := v1.InitializeFromProto(base)
+	beaconState, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := epoch.ProcessRegistryUpdates(context.Background(), beaconState)
 	require.NoError(t, err)
@@ -450,7 +495,7 @@ func TestProcessSlashings_BadValue(t *testing.T) {
 		Balances:   []uint64{params.BeaconConfig().MaxEffectiveBalance},
 		Slashings:  []uint64{math.MaxUint64, 1e9},
 	}
-	s, err := v1.InitializeFromProto(base)
+	s, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	_, err = epoch.ProcessSlashings(s, params.BeaconConfig().ProportionalSlashingMultiplier)
 	require.ErrorContains(t, "addition overflows", err)
@@ -2,6 +2,11 @@
 package operation
 
 import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
@@ -19,6 +24,22 @@ const (
 
 	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received.
 	SyncCommitteeContributionReceived
+
+	// BLSToExecutionChangeReceived is sent after a BLS to execution change object has been received from the outside
+	// world (eg in RPC or sync)
+	BLSToExecutionChangeReceived
+
+	// OwnMessageEchoed is sent when a block, attestation or sync committee contribution broadcast
+	// by this node is first received back from a peer.
+	OwnMessageEchoed
+
+	// FeeRecipientMismatch is sent when the execution payload of a block proposed by a tracked validator pays
+	// a fee recipient other than the one configured for the validator.
+	FeeRecipientMismatch
+
+	// UpcomingSyncCommittee is sent when a tracked validator is found in the sync committee of the next sync
+	// committee period, ahead of the start of the period.
+	UpcomingSyncCommittee
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -44,3 +65,51 @@ type SyncCommitteeContributionReceivedData struct {
 	// Contribution is the sync committee contribution object.
 	Contribution *ethpb.SignedContributionAndProof
 }
+
+// BLSToExecutionChangeReceivedData is the data sent with BLSToExecutionChangeReceived events.
+type BLSToExecutionChangeReceivedData struct {
+	// Change is the signed BLS to execution change object.
+	Change *ethpb.SignedBLSToExecutionChange
+}
+
+// OwnMessageEchoedData is the data sent with OwnMessageEchoed events.
+type OwnMessageEchoedData struct {
+	// Kind of the message, one of block, attestation, aggregate or contribution.
+	Kind string
+	// Topic the message was broadcast on.
+	Topic string
+	// Peer the message was first received back from.
+	Peer peer.ID
+	// Latency between the broadcast of the message and its first echo.
+	Latency time.Duration
+}
+
+// FeeRecipientMismatchData is the data sent with FeeRecipientMismatch events.
+type FeeRecipientMismatchData struct {
+	// Slot of the block.
+	Slot types.Slot
+	// ProposerIndex of the block.
+	ProposerIndex types.ValidatorIndex
+	// BlockRoot of the block.
+	BlockRoot [32]byte
+	// Expected is the fee recipient configured for the proposer.
+	Expected common.Address
+	// Actual is the fee recipient of the execution payload of the block.
+	Actual common.Address
+}
+
+// UpcomingSyncCommitteeData is the data sent with UpcomingSyncCommittee events.
+type UpcomingSyncCommitteeData struct {
+	// ValidatorIndex of the tracked validator.
+	ValidatorIndex types.ValidatorIndex
+	// Period is the sync committee period the validator is a member of.
+	Period uint64
+	// StartEpoch is the first epoch of the period.
+	StartEpoch types.Epoch
+	// EndEpoch is the last epoch of the period.
+	EndEpoch types.Epoch
+	// StartTime is the wall clock time at which the period starts.
+	StartTime time.Time
+	// EndTime is the wall clock time at which the period ends.
+	EndTime time.Time
+}
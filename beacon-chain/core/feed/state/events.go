@@ -26,6 +26,8 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// InvariantViolated is sent when the invariant checker detects an inconsistency in the node's view of the chain.
+	InvariantViolated
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -59,3 +61,11 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// InvariantViolatedData is the data sent with InvariantViolated events.
+type InvariantViolatedData struct {
+	// Invariant is the name of the violated invariant.
+	Invariant string
+	// Err describes the violation.
+	Err error
+}
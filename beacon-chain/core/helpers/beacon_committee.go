@@ -24,8 +24,9 @@ import (
 )
 
 var (
-	committeeCache       = cache.NewCommitteesCache()
-	proposerIndicesCache = cache.NewProposerIndicesCache()
+	committeeCache            = cache.NewCommitteesCache()
+	proposerIndicesCache      = cache.NewProposerIndicesCache()
+	committeeAssignmentsCache = cache.NewCommitteeAssignmentsCache()
 )
 
 // SlotCommitteeCount returns the number of beacon committees of a slot. The
@@ -140,14 +141,11 @@ func BeaconCommittee(
 }
 
 // CommitteeAssignmentContainer represents a committee list, committee index, and to be attested slot for a given epoch.
-type CommitteeAssignmentContainer struct {
-	Committee      []types.ValidatorIndex
-	AttesterSlot   types.Slot
-	CommitteeIndex types.CommitteeIndex
-}
+type CommitteeAssignmentContainer = cache.CommitteeAssignment
 
 // CommitteeAssignments is a map of validator indices pointing to the appropriate committee
-// assignment for the given epoch.
+// assignment for the given epoch. The assignments are cached by the root of the block they
+// depend on, the returned maps must not be modified.
 //
 // 1. Determine the proposer validator index for each slot.
 // 2. Compute all committees.
@@ -166,6 +164,25 @@ func CommitteeAssignments(
 			nextEpoch,
 		)
 	}
+	// The assignments are not cached if the block they depend on is unknown to the state.
+	dependentRoot, err := assignmentsDependentRoot(ctx, state, epoch)
+	cacheable := err == nil && dependentRoot != params.BeaconConfig().ZeroHash
+	if cacheable {
+		if assignments := committeeAssignmentsCache.Get(dependentRoot, epoch); assignments != nil {
+			// Leave the state at the slot it is at once the assignments are computed below.
+			endSlot, err := slots.EpochEnd(epoch)
+			if err != nil {
+				return nil, nil, err
+			}
+			if epoch == nextEpoch {
+				endSlot -= params.BeaconConfig().SlotsPerEpoch
+			}
+			if err := state.SetSlot(endSlot); err != nil {
+				return nil, nil, err
+			}
+			return assignments.Committees, assignments.ProposerSlots, nil
+		}
+	}
 
 	// We determine the slots in which proposers are supposed to act.
 	// Some validators may need to propose multiple times per epoch, so
@@ -229,9 +246,76 @@ func CommitteeAssignments(
 		}
 	}
 
+	if cacheable {
+		committeeAssignmentsCache.Add(dependentRoot, epoch, &cache.CommitteeAssignments{
+			Committees:    validatorIndexToCommittee,
+			ProposerSlots: proposerIndexToSlots,
+		})
+	}
 	return validatorIndexToCommittee, proposerIndexToSlots, nil
 }
 
+// CachedCommitteeAssignment returns the committee assignment of the validator in the epoch of
+// the given slot, if the assignments of the epoch were computed from the chain of the state.
+// The returned assignment is nil if the validator is not assigned to a committee in the epoch.
+func CachedCommitteeAssignment(
+	state state.ReadOnlyBeaconState,
+	slot types.Slot,
+	validatorIndex types.ValidatorIndex,
+) (*CommitteeAssignmentContainer, bool) {
+	epoch := slots.ToEpoch(slot)
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil || startSlot == 0 || startSlot-1 >= state.Slot() {
+		return nil, false
+	}
+	r, err := BlockRootAtSlot(state, startSlot-1)
+	if err != nil {
+		return nil, false
+	}
+	assignments := committeeAssignmentsCache.Get(bytesutil.ToBytes32(r), epoch)
+	if assignments == nil {
+		return nil, false
+	}
+	return assignments.Committees[validatorIndex], true
+}
+
+// InvalidateCommitteeAssignments removes the cached assignments of the given epoch and of all
+// the later epochs. This is called on chain reorgs crossing epoch boundaries, as the assignments
+// derived from the orphaned blocks are not requested again.
+func InvalidateCommitteeAssignments(epoch types.Epoch) {
+	committeeAssignmentsCache.RemoveFromEpoch(epoch)
+}
+
+// assignmentsDependentRoot returns the root of the block the committee and proposer assignments
+// of the epoch depend on, which is the block at the last slot of the previous epoch. When the
+// state has not processed that slot yet, the assignments depend on the latest block of the state.
+func assignmentsDependentRoot(ctx context.Context, state state.BeaconState, epoch types.Epoch) ([32]byte, error) {
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if startSlot > 0 && startSlot-1 < state.Slot() {
+		r, err := BlockRootAtSlot(state, startSlot-1)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return bytesutil.ToBytes32(r), nil
+	}
+	header := ethpb.CopyBeaconBlockHeader(state.LatestBlockHeader())
+	if header == nil {
+		return [32]byte{}, errors.New("nil latest block header")
+	}
+	// The state root of the latest block header is only filled when the next slot is processed.
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := state.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
+
 // VerifyBitfieldLength verifies that a bitfield length matches the given committee size.
 func VerifyBitfieldLength(bf bitfield.Bitfield, committeeSize uint64) error {
 	if bf.Len() != committeeSize {
@@ -373,6 +457,7 @@ func UpdateProposerIndicesInCache(ctx context.Context, state state.ReadOnlyBeaco
 func ClearCache() {
 	committeeCache = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
+	committeeAssignmentsCache = cache.NewCommitteeAssignmentsCache()
 	syncCommitteeCache = cache.NewSyncCommittee()
 	balanceCache = cache.NewEffectiveBalanceCache()
 }
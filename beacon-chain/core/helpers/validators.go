@@ -3,10 +3,12 @@ package helpers
 import (
 	"bytes"
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/async"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -380,3 +382,24 @@ func isEligibleForActivation(activationEligibilityEpoch, activationEpoch, finali
 	return activationEligibilityEpoch <= finalizedEpoch &&
 		activationEpoch == params.BeaconConfig().FarFutureEpoch
 }
+
+// ParallelValidatorThreshold is the registry size from which ForEachValidatorChunk splits
+// the per validator loops of epoch processing across worker goroutines. Below it the
+// overhead of spawning workers outweighs the gain.
+var ParallelValidatorThreshold = 1 << 12
+
+// ForEachValidatorChunk calls fn over contiguous, disjoint ranges [start, end) covering [0, n).
+// Ranges are processed concurrently for large registries, so fn must only write to
+// the indices of its own range for the result to be deterministic.
+func ForEachValidatorChunk(n int, fn func(start, end int) error) error {
+	if n == 0 {
+		return nil
+	}
+	if n < ParallelValidatorThreshold {
+		return fn(0, n)
+	}
+	_, err := async.Scatter(n, func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		return nil, fn(offset, offset+entries)
+	})
+	return err
+}
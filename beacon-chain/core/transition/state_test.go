@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
@@ -98,9 +98,9 @@ func TestGenesisState_HashEquality(t *testing.T) {
 	state, err := transition.GenesisBeaconState(context.Background(), deposits, 0, &ethpb.Eth1Data{BlockHash: make([]byte, 32)})
 	require.NoError(t, err)
 
-	pbState1, err := v1.ProtobufBeaconState(state1.CloneInnerState())
+	pbState1, err := statenative.ProtobufBeaconStatePhase0(state1.CloneInnerState())
 	require.NoError(t, err)
-	pbstate, err := v1.ProtobufBeaconState(state.CloneInnerState())
+	pbstate, err := statenative.ProtobufBeaconStatePhase0(state.CloneInnerState())
 	require.NoError(t, err)
 
 	root1, err1 := hash.HashProto(pbState1)
@@ -294,11 +294,7 @@ func ProcessBlockForStateRoot(
 		if err != nil {
 			return nil, err
 		}
-		if blk.IsBlinded() {
-			state, err = b.ProcessPayloadHeader(state, executionData)
-		} else {
-			state, err = b.ProcessPayload(state, executionData)
-		}
+		state, err = b.ProcessPayload(state, executionData)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process execution data")
 		}
@@ -6,7 +6,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -46,7 +46,7 @@ func TestInitiateValidatorExit_AlreadyExited(t *testing.T) {
 	base := &ethpb.BeaconState{Validators: []*ethpb.Validator{{
 		ExitEpoch: exitEpoch},
 	}}
-	state, err := v1.InitializeFromProto(base)
+	state, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := InitiateValidatorExit(context.Background(), state, 0)
 	require.NoError(t, err)
@@ -64,7 +64,7 @@ func TestInitiateValidatorExit_ProperExit(t *testing.T) {
 		{ExitEpoch: exitedEpoch + 2},
 		{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
 	}}
-	state, err := v1.InitializeFromProto(base)
+	state, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := InitiateValidatorExit(context.Background(), state, idx)
 	require.NoError(t, err)
@@ -83,7 +83,7 @@ func TestInitiateValidatorExit_ChurnOverflow(t *testing.T) {
 		{ExitEpoch: exitedEpoch + 2}, // overflow here
 		{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
 	}}
-	state, err := v1.InitializeFromProto(base)
+	state, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	newState, err := InitiateValidatorExit(context.Background(), state, idx)
 	require.NoError(t, err)
@@ -104,13 +104,14 @@ func TestInitiateValidatorExit_WithdrawalOverflows(t *testing.T) {
 		{ExitEpoch: params.BeaconConfig().FarFutureEpoch - 1},
 		{EffectiveBalance: params.BeaconConfig().EjectionBalance, ExitEpoch: params.BeaconConfig().FarFutureEpoch},
 	}}
-	state, err := v1.InitializeFromProto(base)
+	state, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	_, err = InitiateValidatorExit(context.Background(), state, 1)
 	require.ErrorContains(t, "addition overflows", err)
 }
 
 func TestSlashValidator_OK(t *testing.T) {
+	helpers.ClearCache()
 	validatorCount := 100
 	registry := make([]*ethpb.Validator, 0, validatorCount)
 	balances := make([]uint64, 0, validatorCount)
@@ -129,7 +130,7 @@ func TestSlashValidator_OK(t *testing.T) {
 		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 		Balances:    balances,
 	}
-	state, err := v1.InitializeFromProto(base)
+	state, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 
 	slashedIdx := types.ValidatorIndex(2)
@@ -214,7 +215,7 @@ func TestActivatedValidatorIndices(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		s, err := v1.InitializeFromProto(tt.state)
+		s, err := statenative.InitializeFromProtoPhase0(tt.state)
 		require.NoError(t, err)
 		activatedIndices := ActivatedValidatorIndices(time.CurrentEpoch(s), tt.state.Validators)
 		assert.DeepEqual(t, tt.wanted, activatedIndices)
@@ -268,7 +269,7 @@ func TestSlashedValidatorIndices(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		s, err := v1.InitializeFromProto(tt.state)
+		s, err := statenative.InitializeFromProtoPhase0(tt.state)
 		require.NoError(t, err)
 		slashedIndices := SlashedValidatorIndices(time.CurrentEpoch(s), tt.state.Validators)
 		assert.DeepEqual(t, tt.wanted, slashedIndices)
@@ -328,7 +329,7 @@ func TestExitedValidatorIndices(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		s, err := v1.InitializeFromProto(tt.state)
+		s, err := statenative.InitializeFromProtoPhase0(tt.state)
 		require.NoError(t, err)
 		activeCount, err := helpers.ActiveValidatorCount(context.Background(), s, time.PrevEpoch(s))
 		require.NoError(t, err)
@@ -29,3 +29,16 @@ type SlasherDatabase = iface.SlasherDatabase
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// StateRetention determines which finalized states are kept in the beacon DB.
+type StateRetention = iface.StateRetention
+
+// State retention modes, see the iface package for their description.
+const (
+	DefaultStateRetention  = iface.DefaultStateRetention
+	ArchivalStateRetention = iface.ArchivalStateRetention
+	MinimalStateRetention  = iface.MinimalStateRetention
+)
+
+// ParseStateRetention returns the state retention mode matching the given name.
+var ParseStateRetention = iface.ParseStateRetention
@@ -0,0 +1,143 @@
+package freezer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+// e2store records start with a header made of a 2 byte type, a 4 byte little endian data length
+// and 2 reserved bytes which must be zero.
+const headerLength = 8
+
+// maxRecordLength bounds the length of the data of a record, compressed or not, well above the size of a
+// mainnet state, so a corrupted or malicious era file cannot make the node allocate gigabytes.
+const maxRecordLength = 1 << 30
+
+var (
+	typeVersion                     = [2]byte{0x65, 0x32}
+	typeCompressedSignedBeaconBlock = [2]byte{0x01, 0x00}
+	typeCompressedBeaconState       = [2]byte{0x02, 0x00}
+	typeSlotIndex                   = [2]byte{0x69, 0x32}
+)
+
+var errInvalidRecord = errors.New("invalid e2store record")
+
+type record struct {
+	typ  [2]byte
+	data []byte
+}
+
+// writeRecord writes a record with the given type and data, returning the number of bytes written.
+func writeRecord(w io.Writer, typ [2]byte, data []byte) (int64, error) {
+	if uint64(len(data)) > math.MaxUint32 {
+		return 0, fmt.Errorf("record data length %d is too large", len(data))
+	}
+	header := make([]byte, headerLength)
+	copy(header, typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(data)
+	return int64(n + m), err
+}
+
+// readRecord reads the record starting at the given offset, which must end before the given end offset.
+func readRecord(r io.ReaderAt, offset, end int64) (*record, error) {
+	header := make([]byte, headerLength)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return nil, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, errors.Wrapf(errInvalidRecord, "non zero reserved bytes at offset %d", offset)
+	}
+	length := int64(binary.LittleEndian.Uint32(header[2:6]))
+	if length > maxRecordLength || offset+headerLength+length > end {
+		return nil, errors.Wrapf(errInvalidRecord, "record data length %d at offset %d is too large", length, offset)
+	}
+	rec := &record{data: make([]byte, length)}
+	copy(rec.typ[:], header[:2])
+	if _, err := r.ReadAt(rec.data, offset+headerLength); err != nil {
+		return nil, errors.Wrapf(err, "could not read record data at offset %d", offset)
+	}
+	return rec, nil
+}
+
+// compress encodes data with the snappy framing format used by era files.
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress decodes data with the snappy framing format, failing if it decodes to more than maxRecordLength bytes.
+func decompress(data []byte) ([]byte, error) {
+	dec, err := io.ReadAll(io.LimitReader(snappy.NewReader(bytes.NewReader(data)), maxRecordLength+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(dec) > maxRecordLength {
+		return nil, errors.Wrap(errInvalidRecord, "decompressed record data is too large")
+	}
+	return dec, nil
+}
+
+// encodeSlotIndex encodes a slot index record made of the starting slot, one offset per slot relative
+// to the start of the index record, or 0 for empty slots, and the number of offsets.
+func encodeSlotIndex(start types.Slot, offsets []int64) []byte {
+	enc := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(enc, uint64(start))
+	for i, o := range offsets {
+		binary.LittleEndian.PutUint64(enc[8*(i+1):], uint64(o))
+	}
+	binary.LittleEndian.PutUint64(enc[len(enc)-8:], uint64(len(offsets)))
+	return enc
+}
+
+// readSlotIndex reads the slot index record ending at the given offset, returning the starting slot, the
+// offsets of the indexed records relative to the start of the file, and the offset of the index record.
+func readSlotIndex(r io.ReaderAt, end int64) (types.Slot, []int64, int64, error) {
+	countEnc := make([]byte, 8)
+	if end < headerLength+16 {
+		return 0, nil, 0, errors.Wrap(errInvalidRecord, "slot index does not fit before its end")
+	}
+	if _, err := r.ReadAt(countEnc, end-8); err != nil {
+		return 0, nil, 0, errors.Wrap(err, "could not read slot index count")
+	}
+	count := binary.LittleEndian.Uint64(countEnc)
+	if count > uint64(end-headerLength-16)/8 {
+		return 0, nil, 0, errors.Wrapf(errInvalidRecord, "slot index count %d is too large", count)
+	}
+	pos := end - headerLength - int64(8*(count+2))
+	rec, err := readRecord(r, pos, end)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	if rec.typ != typeSlotIndex || len(rec.data) != int(8*(count+2)) {
+		return 0, nil, 0, errors.Wrapf(errInvalidRecord, "no slot index at offset %d", pos)
+	}
+	start := types.Slot(binary.LittleEndian.Uint64(rec.data))
+	offsets := make([]int64, count)
+	for i := range offsets {
+		o := int64(binary.LittleEndian.Uint64(rec.data[8*(i+1):]))
+		if o != 0 {
+			o += pos
+		}
+		offsets[i] = o
+	}
+	return start, offsets, pos, nil
+}
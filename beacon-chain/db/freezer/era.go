@@ -0,0 +1,225 @@
+package freezer
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+const eraFileExtension = ".era"
+
+// eraFileName returns the name of an era file, made of the config name, the era number and the first
+// 4 bytes of the historical root of the era, or of the genesis validators root for era 0.
+func eraFileName(configName string, era uint64, shortRoot []byte) string {
+	return fmt.Sprintf("%s-%05d-%x%s", configName, era, shortRoot, eraFileExtension)
+}
+
+// parseEraFileName returns the era number from the name of an era file. The config name may contain
+// dashes, so the name is parsed from its end.
+func parseEraFileName(name string) (uint64, error) {
+	base := filepath.Base(name)
+	if filepath.Ext(base) != eraFileExtension {
+		return 0, fmt.Errorf("%s is not an era file", base)
+	}
+	parts := strings.Split(strings.TrimSuffix(base, eraFileExtension), "-")
+	if len(parts) < 3 {
+		return 0, fmt.Errorf("could not parse era file name %s", base)
+	}
+	era, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse era number of %s", base)
+	}
+	return era, nil
+}
+
+// eraShortRoot returns the first 4 bytes of the root identifying the era of the given state.
+func eraShortRoot(st state.ReadOnlyBeaconState, era uint64) ([]byte, error) {
+	if era == 0 {
+		return st.GenesisValidatorsRoot()[:4], nil
+	}
+	roots := st.HistoricalRoots()
+	if uint64(len(roots)) < era {
+		return nil, fmt.Errorf("state at slot %d has no historical root for era %d", st.Slot(), era)
+	}
+	return roots[era-1][:4], nil
+}
+
+// eraStartSlot returns the first slot of the blocks stored in the given era, which ends right before the
+// slot of its state.
+func eraStartSlot(era uint64) types.Slot {
+	if era == 0 {
+		return 0
+	}
+	return types.Slot(era-1) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// eraForSlot returns the era storing the block at the given slot.
+func eraForSlot(slot types.Slot) uint64 {
+	return uint64(slot/params.BeaconConfig().SlotsPerHistoricalRoot) + 1
+}
+
+// writeEra writes the era file made of a version record, the given blocks ordered by slot, the state at
+// the end of the era, the slot index of the blocks and the slot index of the state. Era 0 only holds the
+// genesis state and has no block index.
+func writeEra(w io.Writer, era uint64, blocks []interfaces.SignedBeaconBlock, st state.ReadOnlyBeaconState) error {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if st.Slot() != types.Slot(era)*sphr {
+		return fmt.Errorf("state slot %d is not the last slot of era %d", st.Slot(), era)
+	}
+	if era == 0 && len(blocks) > 0 {
+		return errors.New("era 0 cannot hold blocks")
+	}
+	pos, err := writeRecord(w, typeVersion, nil)
+	if err != nil {
+		return err
+	}
+
+	start := eraStartSlot(era)
+	var blockOffsets []int64
+	if era > 0 {
+		blockOffsets = make([]int64, sphr)
+	}
+	for i, blk := range blocks {
+		slot := blk.Block().Slot()
+		if slot < start || slot >= start+sphr {
+			return fmt.Errorf("block at slot %d is not part of era %d", slot, era)
+		}
+		if i > 0 && slot <= blocks[i-1].Block().Slot() {
+			return fmt.Errorf("blocks are not ordered by slot at slot %d", slot)
+		}
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+		}
+		compressed, err := compress(enc)
+		if err != nil {
+			return err
+		}
+		blockOffsets[slot-start] = pos
+		n, err := writeRecord(w, typeCompressedSignedBeaconBlock, compressed)
+		if err != nil {
+			return err
+		}
+		pos += n
+	}
+
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	stateOffset := pos
+	n, err := writeRecord(w, typeCompressedBeaconState, compressed)
+	if err != nil {
+		return err
+	}
+	pos += n
+
+	if era > 0 {
+		for i, o := range blockOffsets {
+			if o != 0 {
+				blockOffsets[i] = o - pos
+			}
+		}
+		n, err := writeRecord(w, typeSlotIndex, encodeSlotIndex(start, blockOffsets))
+		if err != nil {
+			return err
+		}
+		pos += n
+	}
+	_, err = writeRecord(w, typeSlotIndex, encodeSlotIndex(st.Slot(), []int64{stateOffset - pos}))
+	return err
+}
+
+// readEraState reads the state at the end of the era stored in r.
+func readEraState(r io.ReaderAt, size int64) (state.BeaconState, error) {
+	slot, offsets, _, err := readSlotIndex(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	if len(offsets) != 1 {
+		return nil, errors.Wrapf(errInvalidRecord, "state index has %d entries", len(offsets))
+	}
+	rec, err := readRecord(r, offsets[0], size)
+	if err != nil {
+		return nil, err
+	}
+	if rec.typ != typeCompressedBeaconState {
+		return nil, errors.Wrapf(errInvalidRecord, "no state at offset %d", offsets[0])
+	}
+	enc, err := decompress(rec.data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state")
+	}
+	unmarshaler, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect state version")
+	}
+	st, err := unmarshaler.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() != slot {
+		return nil, fmt.Errorf("state slot %d does not match indexed slot %d", st.Slot(), slot)
+	}
+	return st, nil
+}
+
+// readEraBlockOffsets reads the block index of the era stored in r, returning the first slot of the
+// era and the offset of the block at each slot, or 0 for empty slots.
+func readEraBlockOffsets(r io.ReaderAt, size int64) (types.Slot, []int64, error) {
+	_, _, stateIndexPos, err := readSlotIndex(r, size)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not read state index")
+	}
+	start, offsets, _, err := readSlotIndex(r, stateIndexPos)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not read block index")
+	}
+	return start, offsets, nil
+}
+
+// readEraBlock reads the block at the given offset of the era stored in r.
+func readEraBlock(r io.ReaderAt, size, offset int64, slot types.Slot) (interfaces.SignedBeaconBlock, error) {
+	rec, err := readRecord(r, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	if rec.typ != typeCompressedSignedBeaconBlock {
+		return nil, errors.Wrapf(errInvalidRecord, "no block at offset %d", offset)
+	}
+	enc, err := decompress(rec.data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress block")
+	}
+	v, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(slots.ToEpoch(slot))
+	if err != nil {
+		return nil, err
+	}
+	unmarshaler, err := detect.FromForkVersion(v)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := unmarshaler.UnmarshalBeaconBlock(enc)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Block().Slot() != slot {
+		return nil, fmt.Errorf("block slot %d does not match indexed slot %d", blk.Block().Slot(), slot)
+	}
+	return blk, nil
+}
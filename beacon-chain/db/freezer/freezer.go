@@ -0,0 +1,420 @@
+// Package freezer implements an append-only store of the finalized blocks of the beacon chain, kept in flat
+// era files next to the hot database. Each era file holds the blocks of SLOTS_PER_HISTORICAL_ROOT slots
+// followed by the state at the end of the era, in the e2store layout shared with other clients, so era files
+// can be exchanged between nodes and clients. The state is only kept to verify and exchange the era, states
+// are still served from the database.
+package freezer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNotFound is returned when the requested era or block is not in the freezer.
+var ErrNotFound = errors.New("not found in the freezer")
+
+// Store is a directory of era files, at most one per era. Era files are only ever added to the store.
+type Store struct {
+	dir  string
+	lock sync.RWMutex
+	eras map[uint64]string
+}
+
+// New opens the freezer in the given directory, creating the directory if needed.
+func New(dir string) (*Store, error) {
+	if err := ensureDir(dir); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		dir:  dir,
+		eras: make(map[uint64]string),
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != eraFileExtension {
+			continue
+		}
+		era, err := parseEraFileName(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := s.eras[era]; ok {
+			return nil, fmt.Errorf("era %d is stored in both %s and %s", era, existing, e.Name())
+		}
+		s.eras[era] = e.Name()
+	}
+	log.WithFields(logrus.Fields{
+		"path": dir,
+		"eras": len(s.eras),
+	}).Info("Opened freezer")
+	return s, nil
+}
+
+// Dir returns the directory of the freezer.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// HasEra returns whether the given era is in the freezer.
+func (s *Store) HasEra(era uint64) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.eras[era]
+	return ok
+}
+
+// Eras returns the eras in the freezer in ascending order.
+func (s *Store) Eras() []uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	eras := make([]uint64, 0, len(s.eras))
+	for era := range s.eras {
+		eras = append(eras, era)
+	}
+	sort.Slice(eras, func(i, j int) bool { return eras[i] < eras[j] })
+	return eras
+}
+
+// WriteEra writes the era file of the given era from its canonical blocks, ordered by slot, and the state
+// at the end of the era.
+func (s *Store) WriteEra(era uint64, blocks []interfaces.SignedBeaconBlock, st state.ReadOnlyBeaconState) error {
+	shortRoot, err := eraShortRoot(st, era)
+	if err != nil {
+		return err
+	}
+	name := eraFileName(params.BeaconConfig().ConfigName, era, shortRoot)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.eras[era]; ok {
+		return fmt.Errorf("era %d is already in the freezer", era)
+	}
+	if err := writeEraFile(filepath.Join(s.dir, name), era, blocks, st); err != nil {
+		return err
+	}
+	s.eras[era] = name
+	return nil
+}
+
+// Block returns the canonical block at the given slot, or ErrNotFound if the era of the slot is not in
+// the freezer or the slot is empty.
+func (s *Store) Block(slot types.Slot) (interfaces.SignedBeaconBlock, error) {
+	f, size, err := s.openEra(eraForSlot(slot))
+	if err != nil {
+		return nil, err
+	}
+	defer closeFile(f)
+	start, offsets, err := readEraBlockOffsets(f, size)
+	if err != nil {
+		return nil, err
+	}
+	if slot < start || slot >= start+types.Slot(len(offsets)) || offsets[slot-start] == 0 {
+		return nil, ErrNotFound
+	}
+	return readEraBlock(f, size, offsets[slot-start], slot)
+}
+
+// EraBlocks returns the blocks of the given era ordered by slot.
+func (s *Store) EraBlocks(era uint64) ([]interfaces.SignedBeaconBlock, error) {
+	f, size, err := s.openEra(era)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFile(f)
+	return readEraBlocks(f, size, era)
+}
+
+// Import verifies the era file at the given path against the trusted state and copies it into the freezer,
+// returning its era. Importing an era which is already in the freezer does nothing.
+func (s *Store) Import(path string, trusted state.ReadOnlyBeaconState) (uint64, error) {
+	era, err := parseEraFileName(path)
+	if err != nil {
+		return 0, err
+	}
+	if s.HasEra(era) {
+		return era, nil
+	}
+	name, _, st, err := verifyEraFile(path, era)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not verify era file %s", path)
+	}
+	if err := VerifyHistoricalRoot(trusted, era, st); err != nil {
+		return 0, errors.Wrapf(err, "could not verify era file %s", path)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.eras[era]; ok {
+		return era, nil
+	}
+	tmp := filepath.Join(s.dir, name+".tmp")
+	if err := file.CopyFile(path, tmp); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, name)); err != nil {
+		return 0, err
+	}
+	s.eras[era] = name
+	return era, nil
+}
+
+// Export copies the file of the given era into the given directory, returning the path of the copy.
+func (s *Store) Export(era uint64, dir string) (string, error) {
+	s.lock.RLock()
+	name, ok := s.eras[era]
+	s.lock.RUnlock()
+	if !ok {
+		return "", errors.Wrapf(ErrNotFound, "era %d", era)
+	}
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	dst := filepath.Join(dir, name)
+	if err := file.CopyFile(filepath.Join(s.dir, name), dst); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
+func (s *Store) openEra(era uint64) (*os.File, int64, error) {
+	s.lock.RLock()
+	name, ok := s.eras[era]
+	s.lock.RUnlock()
+	if !ok {
+		return nil, 0, errors.Wrapf(ErrNotFound, "era %d", era)
+	}
+	return openFile(filepath.Join(s.dir, name))
+}
+
+// WriteEraFile writes the era file of the given era from its canonical blocks, ordered by slot, and the
+// state at the end of the era into the given directory, returning the path of the file.
+func WriteEraFile(dir string, era uint64, blocks []interfaces.SignedBeaconBlock, st state.ReadOnlyBeaconState) (string, error) {
+	shortRoot, err := eraShortRoot(st, era)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, eraFileName(params.BeaconConfig().ConfigName, era, shortRoot))
+	if err := writeEraFile(path, era, blocks, st); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ReadEraFile verifies the era file at the given path and returns its era, its blocks ordered by slot and
+// the state at the end of the era.
+func ReadEraFile(path string) (uint64, []interfaces.SignedBeaconBlock, state.BeaconState, error) {
+	era, err := parseEraFileName(path)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	_, blocks, st, err := verifyEraFile(path, era)
+	if err != nil {
+		return 0, nil, nil, errors.Wrapf(err, "could not verify era file %s", path)
+	}
+	return era, blocks, st, nil
+}
+
+// writeEraFile writes the era file at the given path under a temporary name and renames it once complete,
+// so a partially written era is never read.
+func writeEraFile(path string, era uint64, blocks []interfaces.SignedBeaconBlock, st state.ReadOnlyBeaconState) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := writeEra(w, era, blocks, st); err != nil {
+		closeAndRemove(f, tmp)
+		return errors.Wrapf(err, "could not write era %d", era)
+	}
+	if err := w.Flush(); err != nil {
+		closeAndRemove(f, tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		closeAndRemove(f, tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// verifyEraFile checks that the era file at the given path holds the state at the end of the given era
+// and the canonical blocks of the era according to that state. It returns the expected name of the file
+// with the blocks and the state it holds.
+func verifyEraFile(path string, era uint64) (string, []interfaces.SignedBeaconBlock, state.BeaconState, error) {
+	f, size, err := openFile(path)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	defer closeFile(f)
+	st, err := readEraState(f, size)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if st.Slot() != types.Slot(era)*sphr {
+		return "", nil, nil, fmt.Errorf("state slot %d is not the last slot of era %d", st.Slot(), era)
+	}
+	shortRoot, err := eraShortRoot(st, era)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	name := eraFileName(params.BeaconConfig().ConfigName, era, shortRoot)
+	if filepath.Base(path) != name {
+		return "", nil, nil, fmt.Errorf("era file name does not match the expected name %s", name)
+	}
+	blocks, err := readEraBlocks(f, size, era)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	blockRoots := st.BlockRoots()
+	for _, blk := range blocks {
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return "", nil, nil, err
+		}
+		slot := blk.Block().Slot()
+		if !bytes.Equal(root[:], blockRoots[slot%sphr]) {
+			return "", nil, nil, fmt.Errorf("block at slot %d is not canonical", slot)
+		}
+	}
+	return name, blocks, st, nil
+}
+
+// VerifyHistoricalRoot checks the state at the end of the given era against the historical root of the era
+// recorded by the trusted state, which commits to all the block roots of the era, so that only eras of the
+// chain of the trusted state are accepted.
+func VerifyHistoricalRoot(trusted state.ReadOnlyBeaconState, era uint64, st state.ReadOnlyBeaconState) error {
+	if trusted == nil || trusted.IsNil() {
+		return errors.New("no trusted state to verify the era against")
+	}
+	if !bytes.Equal(st.GenesisValidatorsRoot(), trusted.GenesisValidatorsRoot()) {
+		return fmt.Errorf("era %d belongs to a chain with a different genesis validators root", era)
+	}
+	if era == 0 {
+		// The genesis era holds no blocks, only the genesis state.
+		return nil
+	}
+	roots := trusted.HistoricalRoots()
+	if era > uint64(len(roots)) {
+		return fmt.Errorf("era %d is not covered by the historical roots of the trusted state at slot %d", era, trusted.Slot())
+	}
+	batch := &ethpb.HistoricalBatch{
+		BlockRoots: st.BlockRoots(),
+		StateRoots: st.StateRoots(),
+	}
+	root, err := batch.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash historical batch")
+	}
+	if !bytes.Equal(root[:], roots[era-1]) {
+		return fmt.Errorf("era %d does not match the historical root of the trusted state at slot %d", era, trusted.Slot())
+	}
+	return nil
+}
+
+// EraFilePaths returns the given era file, or the era files of the given directory ordered by name, which
+// orders them by era.
+func EraFilePaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(path, "*"+eraFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no era files found in %s", path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func readEraBlocks(f *os.File, size int64, era uint64) ([]interfaces.SignedBeaconBlock, error) {
+	if era == 0 {
+		return nil, nil
+	}
+	start, offsets, err := readEraBlockOffsets(f, size)
+	if err != nil {
+		return nil, err
+	}
+	if start != eraStartSlot(era) {
+		return nil, fmt.Errorf("block index starts at slot %d instead of %d", start, eraStartSlot(era))
+	}
+	blocks := make([]interfaces.SignedBeaconBlock, 0)
+	for i, o := range offsets {
+		if o == 0 {
+			continue
+		}
+		blk, err := readEraBlock(f, size, o, start+types.Slot(i))
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
+
+func ensureDir(dir string) error {
+	hasDir, err := file.HasDir(dir)
+	if err != nil {
+		return err
+	}
+	if hasDir {
+		return nil
+	}
+	return file.MkdirAll(dir)
+}
+
+func openFile(path string) (*os.File, int64, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		closeFile(f)
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+func closeFile(f *os.File) {
+	if err := f.Close(); err != nil {
+		log.WithError(err).Errorf("Could not close %s", f.Name())
+	}
+}
+
+func closeAndRemove(f *os.File, path string) {
+	closeFile(f)
+	if err := os.Remove(path); err != nil {
+		log.WithError(err).Errorf("Could not remove %s", path)
+	}
+}
@@ -0,0 +1,215 @@
+package freezer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// eraFixture returns the blocks of era 1 at the given slots and the state at the end of era 1, whose
+// historical root commits to the blocks.
+func eraFixture(t *testing.T, blockSlots ...types.Slot) ([]interfaces.SignedBeaconBlock, state.BeaconState) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(sphr))
+
+	blocks := make([]interfaces.SignedBeaconBlock, 0, len(blockSlots))
+	for i, slot := range blockSlots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = types.ValidatorIndex(i)
+		blk, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		next := sphr
+		if i+1 < len(blockSlots) {
+			next = blockSlots[i+1]
+		}
+		for s := slot; s < next; s++ {
+			require.NoError(t, st.UpdateBlockRootAtIndex(uint64(s%sphr), root))
+		}
+		blocks = append(blocks, blk)
+	}
+	batch := &ethpb.HistoricalBatch{BlockRoots: st.BlockRoots(), StateRoots: st.StateRoots()}
+	root, err := batch.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.AppendHistoricalRoots(root))
+	return blocks, st
+}
+
+func TestStore_WriteEra(t *testing.T) {
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+	blocks, st := eraFixture(t, 0, 1, 5, 100)
+	require.NoError(t, s.WriteEra(1, blocks, st))
+	require.Equal(t, true, s.HasEra(1))
+	require.ErrorContains(t, "already in the freezer", s.WriteEra(1, blocks, st))
+
+	for _, want := range blocks {
+		got, err := s.Block(want.Block().Slot())
+		require.NoError(t, err)
+		wantRoot, err := want.Block().HashTreeRoot()
+		require.NoError(t, err)
+		gotRoot, err := got.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, wantRoot, gotRoot)
+	}
+	_, err = s.Block(2)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.Block(params.BeaconConfig().SlotsPerHistoricalRoot)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	got, err := s.EraBlocks(1)
+	require.NoError(t, err)
+	require.Equal(t, len(blocks), len(got))
+
+	_, _, saved, err := ReadEraFile(filepath.Join(s.Dir(), s.eras[1]))
+	require.NoError(t, err)
+	wantRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := saved.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+
+	// The freezer finds the era again once reopened.
+	reopened, err := New(s.Dir())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1}, reopened.Eras())
+}
+
+func TestStore_WriteEra_BlockOutsideEra(t *testing.T) {
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+	blocks, st := eraFixture(t, 1, params.BeaconConfig().SlotsPerHistoricalRoot)
+	require.ErrorContains(t, "not part of era 1", s.WriteEra(1, blocks, st))
+	assert.Equal(t, false, s.HasEra(1))
+}
+
+func TestStore_ExportImport(t *testing.T) {
+	src, err := New(t.TempDir())
+	require.NoError(t, err)
+	blocks, st := eraFixture(t, 3, 4)
+	require.NoError(t, src.WriteEra(1, blocks, st))
+
+	path, err := src.Export(1, t.TempDir())
+	require.NoError(t, err)
+	dst, err := New(t.TempDir())
+	require.NoError(t, err)
+	era, err := dst.Import(path, st)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), era)
+	blk, err := dst.Block(4)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(4), blk.Block().Slot())
+
+	_, err = src.Export(2, t.TempDir())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_Import_NotCanonical(t *testing.T) {
+	src, err := New(t.TempDir())
+	require.NoError(t, err)
+	blocks, st := eraFixture(t, 3)
+	require.NoError(t, st.UpdateBlockRootAtIndex(3, [32]byte{'a'}))
+	require.NoError(t, src.WriteEra(1, blocks, st))
+
+	dst, err := New(t.TempDir())
+	require.NoError(t, err)
+	_, err = dst.Import(filepath.Join(src.Dir(), src.eras[1]), st)
+	require.ErrorContains(t, "not canonical", err)
+	assert.Equal(t, false, dst.HasEra(1))
+}
+
+func TestStore_Import_OtherChain(t *testing.T) {
+	src, err := New(t.TempDir())
+	require.NoError(t, err)
+	blocks, st := eraFixture(t, 3, 4)
+	require.NoError(t, src.WriteEra(1, blocks, st))
+	path := filepath.Join(src.Dir(), src.eras[1])
+	dst, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	otherGenesis := st.Copy()
+	require.NoError(t, otherGenesis.SetGenesisValidatorsRoot(bytesutil.PadTo([]byte{'g'}, 32)))
+	_, err = dst.Import(path, otherGenesis)
+	require.ErrorContains(t, "different genesis validators root", err)
+
+	_, otherChain := eraFixture(t, 3, 5)
+	_, err = dst.Import(path, otherChain)
+	require.ErrorContains(t, "does not match the historical root", err)
+
+	genesis, err := util.NewBeaconState()
+	require.NoError(t, err)
+	_, err = dst.Import(path, genesis)
+	require.ErrorContains(t, "not covered by the historical roots", err)
+	assert.Equal(t, false, dst.HasEra(1))
+	assert.Equal(t, 0, len(dst.Eras()))
+}
+
+func TestWriteEraFile_ReadEraFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "eras")
+	blocks, st := eraFixture(t, 0, 7)
+	path, err := WriteEraFile(dir, 1, blocks, st)
+	require.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(path))
+
+	era, got, gotState, err := ReadEraFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), era)
+	require.Equal(t, len(blocks), len(got))
+	for i := range blocks {
+		assert.Equal(t, blocks[i].Block().Slot(), got[i].Block().Slot())
+	}
+	assert.Equal(t, st.Slot(), gotState.Slot())
+
+	// The file written outside of a freezer can be imported into one.
+	s, err := New(t.TempDir())
+	require.NoError(t, err)
+	era, err = s.Import(path, st)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), era)
+}
+
+func Test_readRecord_TooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := writeRecord(&buf, typeCompressedBeaconState, []byte{1, 2, 3})
+	require.NoError(t, err)
+	enc := buf.Bytes()
+	rec, err := readRecord(bytes.NewReader(enc), 0, int64(len(enc)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 3}, rec.data)
+
+	// The data length claimed by the header exceeds the file.
+	binary.LittleEndian.PutUint32(enc[2:6], 4)
+	_, err = readRecord(bytes.NewReader(enc), 0, int64(len(enc)))
+	require.ErrorIs(t, err, errInvalidRecord)
+	binary.LittleEndian.PutUint32(enc[2:6], math.MaxUint32)
+	_, err = readRecord(bytes.NewReader(enc), 0, math.MaxInt64-headerLength-math.MaxUint32)
+	require.ErrorIs(t, err, errInvalidRecord)
+}
+
+func Test_parseEraFileName(t *testing.T) {
+	era, err := parseEraFileName(filepath.Join("dir", eraFileName("my-config", 42, []byte{1, 2, 3, 4})))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), era)
+	_, err = parseEraFileName("mainnet-00001-01020304.ssz")
+	require.ErrorContains(t, "not an era file", err)
+	_, err = parseEraFileName("mainnet-01020304.era")
+	require.ErrorContains(t, "could not parse", err)
+}
@@ -0,0 +1,5 @@
+package freezer
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "freezer")
@@ -25,6 +25,7 @@ type ReadOnlyDatabase interface {
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
 	BlocksBySlot(ctx context.Context, slot types.Slot) ([]interfaces.SignedBeaconBlock, error)
 	BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, [][32]byte, error)
+	BlockSlot(ctx context.Context, blockRoot [32]byte) (types.Slot, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (interfaces.SignedBeaconBlock, error)
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
@@ -54,6 +55,16 @@ type ReadOnlyDatabase interface {
 	// Fee reicipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
+	SignedRegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.SignedValidatorRegistrationV1, error)
+	SignedRegistrations(ctx context.Context) ([]*ethpb.SignedValidatorRegistrationV1, error)
+	// BLS to execution change operations.
+	BLSToExecChanges(ctx context.Context) ([]*ethpb.SignedBLSToExecutionChange, error)
+	// Pending pool operations.
+	PendingOperations(ctx context.Context) (*ethpb.PendingOperations, error)
+	// Block proposal audit operations.
+	ProposalAudit(ctx context.Context, slot types.Slot) (*ethpb.ProposalAudit, error)
+	// Blob sidecar operations.
+	BlobSidecars(ctx context.Context, root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
@@ -88,8 +99,25 @@ type NoHeadAccessDatabase interface {
 	// Fee reicipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	SaveSignedRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.SignedValidatorRegistrationV1) error
+	// BLS to execution change operations.
+	SaveBLSToExecChanges(ctx context.Context, changes []*ethpb.SignedBLSToExecutionChange) error
+	// Pending pool operations.
+	SavePendingOperations(ctx context.Context, ops *ethpb.PendingOperations) error
+	// Block proposal audit operations.
+	SaveProposalAudit(ctx context.Context, audit *ethpb.ProposalAudit) error
+	// Blob sidecar operations.
+	SaveBlobSidecars(ctx context.Context, sidecars []*ethpb.BlobSidecar) error
+	DeleteBlobSidecars(ctx context.Context, root [32]byte) error
+	PruneBlobSidecars(ctx context.Context, beforeSlot types.Slot) (int, error)
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
+	PruneStates(ctx context.Context, retention StateRetention, slotsPerArchivedPoint, fromSlot types.Slot) (int, error)
+
+	// Freezer operations.
+	FreezerEnabled() bool
+	NextEraToFreeze(ctx context.Context) (uint64, error)
+	FreezeEra(ctx context.Context, st state.ReadOnlyBeaconState) error
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -107,6 +135,7 @@ type HeadAccessDatabase interface {
 
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
+	SaveOriginCheckpointBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 }
 
@@ -0,0 +1,52 @@
+package iface
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StateRetention determines which finalized states are kept in the beacon DB.
+// The genesis state, the origin checkpoint state, the states of the latest finalized
+// and justified checkpoints and every state above the finalized slot are always kept.
+type StateRetention int
+
+const (
+	// DefaultStateRetention keeps the finalized states of the canonical chain which were saved on an
+	// epoch boundary or an archive point, and prunes every other finalized state, such as the states
+	// of orphaned blocks or the hot states saved during long periods of non-finality.
+	DefaultStateRetention StateRetention = iota
+	// ArchivalStateRetention keeps every finalized state saved on an epoch boundary or an archive
+	// point, every slots-per-archive-point slots, whether or not it is canonical.
+	ArchivalStateRetention
+	// MinimalStateRetention only keeps the state of the latest finalized checkpoint. Archive points
+	// are not saved and historical states have to be regenerated from genesis or the origin checkpoint.
+	MinimalStateRetention
+)
+
+// String returns the name of the state retention mode, as used on the command line.
+func (r StateRetention) String() string {
+	switch r {
+	case DefaultStateRetention:
+		return "default"
+	case ArchivalStateRetention:
+		return "archival"
+	case MinimalStateRetention:
+		return "minimal"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(r))
+	}
+}
+
+// ParseStateRetention returns the state retention mode matching the given name.
+func ParseStateRetention(name string) (StateRetention, error) {
+	switch strings.ToLower(name) {
+	case "", "default":
+		return DefaultStateRetention, nil
+	case "archival":
+		return ArchivalStateRetention, nil
+	case "minimal":
+		return MinimalStateRetention, nil
+	default:
+		return DefaultStateRetention, fmt.Errorf("unknown state retention mode %q, expected one of archival, default or minimal", name)
+	}
+}
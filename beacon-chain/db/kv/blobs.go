@@ -0,0 +1,186 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveBlobSidecars saves the blob sidecars of a block, replacing the sidecars stored for the block. The sidecars
+// of the blocks which fell out of the blob retention period, counted back from the slot of the block, are pruned.
+func (s *Store) SaveBlobSidecars(ctx context.Context, sidecars []*ethpb.BlobSidecar) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlobSidecars")
+	defer span.End()
+
+	if len(sidecars) == 0 {
+		return errors.New("no blob sidecars to save")
+	}
+	if len(sidecars) > fieldparams.MaxBlobsPerBlock {
+		return errors.Errorf("too many blob sidecars: %d > %d", len(sidecars), fieldparams.MaxBlobsPerBlock)
+	}
+	root, slot := sidecars[0].BlockRoot, sidecars[0].Slot
+	for _, sc := range sidecars {
+		if !bytes.Equal(sc.BlockRoot, root) || sc.Slot != slot {
+			return errors.New("blob sidecars do not belong to the same block")
+		}
+	}
+	sorted := make([]*ethpb.BlobSidecar, len(sidecars))
+	copy(sorted, sidecars)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+	enc, err := encode(ctx, &ethpb.BlobSidecars{Sidecars: sorted})
+	if err != nil {
+		return err
+	}
+	retention := types.Slot(s.blobRetentionEpochs).Mul(uint64(params.BeaconConfig().SlotsPerEpoch))
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if slot > retention {
+			if _, err := pruneBlobSidecars(tx, slot-retention); err != nil {
+				return err
+			}
+		}
+		if err := tx.Bucket(blobSidecarsBucket).Put(root, enc); err != nil {
+			return err
+		}
+		return addBlobSidecarSlotIndex(tx, slot, root)
+	})
+}
+
+// BlobSidecars retrieves the blob sidecars of the block with the given root, ordered by index. Only the sidecars
+// with the given indices are returned if any are provided. ErrNotFoundBlobSidecars is returned if no sidecars of
+// the block are stored.
+func (s *Store) BlobSidecars(ctx context.Context, root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlobSidecars")
+	defer span.End()
+
+	scs := &ethpb.BlobSidecars{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blobSidecarsBucket).Get(root[:])
+		if enc == nil {
+			return errors.Wrapf(ErrNotFoundBlobSidecars, "%#x", root)
+		}
+		return decode(ctx, enc, scs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		return scs.Sidecars, nil
+	}
+	wanted := make(map[uint64]bool, len(indices))
+	for _, i := range indices {
+		wanted[i] = true
+	}
+	filtered := make([]*ethpb.BlobSidecar, 0, len(indices))
+	for _, sc := range scs.Sidecars {
+		if wanted[sc.Index] {
+			filtered = append(filtered, sc)
+		}
+	}
+	return filtered, nil
+}
+
+// DeleteBlobSidecars deletes the blob sidecars of the block with the given root, if any are stored.
+func (s *Store) DeleteBlobSidecars(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteBlobSidecars")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blobSidecarsBucket)
+		enc := bkt.Get(root[:])
+		if enc == nil {
+			return nil
+		}
+		scs := &ethpb.BlobSidecars{}
+		if err := decode(ctx, enc, scs); err != nil {
+			return err
+		}
+		if len(scs.Sidecars) > 0 {
+			if err := removeBlobSidecarSlotIndex(tx, scs.Sidecars[0].Slot, root[:]); err != nil {
+				return err
+			}
+		}
+		return bkt.Delete(root[:])
+	})
+}
+
+// PruneBlobSidecars deletes the blob sidecars of all blocks before the given slot. It returns the number of blocks
+// whose sidecars were deleted.
+func (s *Store) PruneBlobSidecars(ctx context.Context, beforeSlot types.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBlobSidecars")
+	defer span.End()
+
+	var pruned int
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		pruned, err = pruneBlobSidecars(tx, beforeSlot)
+		return err
+	})
+	return pruned, err
+}
+
+// pruneBlobSidecars deletes the blob sidecars of the blocks before the given slot along with their slot indices.
+func pruneBlobSidecars(tx *bolt.Tx, beforeSlot types.Slot) (int, error) {
+	idx := tx.Bucket(blobSidecarSlotIndicesBucket)
+	bkt := tx.Bucket(blobSidecarsBucket)
+	end := bytesutil.SlotToBytesBigEndian(beforeSlot)
+	var slotKeys [][]byte
+	pruned := 0
+	c := idx.Cursor()
+	for k, v := c.First(); k != nil && bytes.Compare(k, end) < 0; k, v = c.Next() {
+		for i := 0; i+32 <= len(v); i += 32 {
+			if err := bkt.Delete(v[i : i+32]); err != nil {
+				return 0, err
+			}
+			pruned++
+		}
+		slotKeys = append(slotKeys, k)
+	}
+	// Keys are deleted once the cursor is done, as deleting while iterating skips entries.
+	for _, k := range slotKeys {
+		if err := idx.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+	return pruned, nil
+}
+
+// addBlobSidecarSlotIndex adds the block root to the roots of the blocks with blob sidecars at the slot.
+func addBlobSidecarSlotIndex(tx *bolt.Tx, slot types.Slot, root []byte) error {
+	idx := tx.Bucket(blobSidecarSlotIndicesBucket)
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	roots := idx.Get(key)
+	for i := 0; i+32 <= len(roots); i += 32 {
+		if bytes.Equal(roots[i:i+32], root) {
+			return nil
+		}
+	}
+	return idx.Put(key, append(bytesutil.SafeCopyBytes(roots), root...))
+}
+
+// removeBlobSidecarSlotIndex removes the block root from the roots of the blocks with blob sidecars at the slot.
+func removeBlobSidecarSlotIndex(tx *bolt.Tx, slot types.Slot, root []byte) error {
+	idx := tx.Bucket(blobSidecarSlotIndicesBucket)
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	roots := idx.Get(key)
+	kept := make([]byte, 0, len(roots))
+	for i := 0; i+32 <= len(roots); i += 32 {
+		if !bytes.Equal(roots[i:i+32], root) {
+			kept = append(kept, roots[i:i+32]...)
+		}
+	}
+	if len(kept) == 0 {
+		return idx.Delete(key)
+	}
+	return idx.Put(key, kept)
+}
@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func testBlobSidecars(root [32]byte, slot types.Slot, count int) []*ethpb.BlobSidecar {
+	scs := make([]*ethpb.BlobSidecar, count)
+	for i := range scs {
+		scs[i] = &ethpb.BlobSidecar{
+			BlockRoot:       root[:],
+			Index:           uint64(i),
+			Slot:            slot,
+			BlockParentRoot: make([]byte, 32),
+			Blob:            make([]byte, fieldparams.BlobLength),
+			KzgCommitment:   make([]byte, 48),
+			KzgProof:        make([]byte, 48),
+		}
+	}
+	return scs
+}
+
+func TestStore_BlobSidecars(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	root := bytesutil.ToBytes32([]byte("root"))
+
+	_, err := db.BlobSidecars(ctx, root)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	scs := testBlobSidecars(root, 10, 3)
+	// Sidecars are stored ordered by index.
+	require.NoError(t, db.SaveBlobSidecars(ctx, []*ethpb.BlobSidecar{scs[2], scs[0], scs[1]}))
+	got, err := db.BlobSidecars(ctx, root)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, scs, got)
+
+	got, err = db.BlobSidecars(ctx, root, 2, 0)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*ethpb.BlobSidecar{scs[0], scs[2]}, got)
+
+	require.NoError(t, db.DeleteBlobSidecars(ctx, root))
+	_, err = db.BlobSidecars(ctx, root)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStore_SaveBlobSidecars_Invalid(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.ErrorContains(t, "no blob sidecars", db.SaveBlobSidecars(ctx, nil))
+	scs := testBlobSidecars([32]byte{'a'}, 1, fieldparams.MaxBlobsPerBlock+1)
+	require.ErrorContains(t, "too many blob sidecars", db.SaveBlobSidecars(ctx, scs))
+	scs = append(testBlobSidecars([32]byte{'a'}, 1, 1), testBlobSidecars([32]byte{'b'}, 1, 1)...)
+	require.ErrorContains(t, "same block", db.SaveBlobSidecars(ctx, scs))
+}
+
+func TestStore_PruneBlobSidecars(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	roots := [][32]byte{{'a'}, {'b'}, {'c'}}
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars(roots[0], 1, 1)))
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars(roots[1], 1, 2)))
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars(roots[2], 2, 1)))
+
+	pruned, err := db.PruneBlobSidecars(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	for _, r := range roots[:2] {
+		_, err := db.BlobSidecars(ctx, r)
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	_, err = db.BlobSidecars(ctx, roots[2])
+	require.NoError(t, err)
+}
+
+func TestStore_SaveBlobSidecars_PrunesOutsideRetention(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.blobRetentionEpochs = 2
+
+	old, kept := [32]byte{'a'}, [32]byte{'b'}
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars(old, 1, 1)))
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars(kept, 2, 1)))
+
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(2) + 2
+	require.NoError(t, db.SaveBlobSidecars(ctx, testBlobSidecars([32]byte{'c'}, slot, 1)))
+	_, err := db.BlobSidecars(ctx, old)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = db.BlobSidecars(ctx, kept)
+	require.NoError(t, err)
+}
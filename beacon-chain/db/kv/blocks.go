@@ -37,13 +37,8 @@ func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (interfaces.Signe
 	}
 	var blk interfaces.SignedBeaconBlock
 	err := s.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		enc := bkt.Get(blockRoot[:])
-		if enc == nil {
-			return nil
-		}
 		var err error
-		blk, err = unmarshalBlock(ctx, enc)
+		blk, err = s.blockByRoot(ctx, tx, blockRoot[:])
 		return err
 	})
 	return blk, err
@@ -101,12 +96,8 @@ func (s *Store) HeadBlock(ctx context.Context) (interfaces.SignedBeaconBlock, er
 		if headRoot == nil {
 			return nil
 		}
-		enc := bkt.Get(headRoot)
-		if enc == nil {
-			return nil
-		}
 		var err error
-		headBlock, err = unmarshalBlock(ctx, enc)
+		headBlock, err = s.blockByRoot(ctx, tx, headRoot)
 		return err
 	})
 	return headBlock, err
@@ -120,19 +111,19 @@ func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]interface
 	blockRoots := make([][32]byte, 0)
 
 	err := s.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-
 		keys, err := blockRootsByFilter(ctx, tx, f)
 		if err != nil {
 			return err
 		}
 
 		for i := 0; i < len(keys); i++ {
-			encoded := bkt.Get(keys[i])
-			blk, err := unmarshalBlock(ctx, encoded)
+			blk, err := s.blockByRoot(ctx, tx, keys[i])
 			if err != nil {
 				return errors.Wrapf(err, "could not unmarshal block with key %#x", keys[i])
 			}
+			if blk == nil {
+				return fmt.Errorf("block with key %#x is indexed but not found", keys[i])
+			}
 			blocks = append(blocks, blk)
 			blockRoots = append(blockRoots, bytesutil.ToBytes32(keys[i]))
 		}
@@ -177,7 +168,7 @@ func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 	exists := false
 	if err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
-		exists = bkt.Get(blockRoot[:]) != nil
+		exists = bkt.Get(blockRoot[:]) != nil || (s.freezer != nil && tx.Bucket(frozenBlockRootsBucket).Get(blockRoot[:]) != nil)
 		return nil
 	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
 		panic(err)
@@ -192,17 +183,18 @@ func (s *Store) BlocksBySlot(ctx context.Context, slot types.Slot) ([]interfaces
 
 	blocks := make([]interfaces.SignedBeaconBlock, 0)
 	err := s.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
 		roots, err := blockRootsBySlot(ctx, tx, slot)
 		if err != nil {
 			return errors.Wrap(err, "could not retrieve blocks by slot")
 		}
 		for _, r := range roots {
-			encoded := bkt.Get(r[:])
-			blk, err := unmarshalBlock(ctx, encoded)
+			blk, err := s.blockByRoot(ctx, tx, r[:])
 			if err != nil {
 				return err
 			}
+			if blk == nil {
+				return fmt.Errorf("block with key %#x is indexed but not found", r)
+			}
 			blocks = append(blocks, blk)
 		}
 		return nil
@@ -226,6 +218,34 @@ func (s *Store) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, []
 	return len(blockRoots) > 0, blockRoots, nil
 }
 
+// BlockSlot returns the slot of the block with the given root. The slot is resolved from the block cache,
+// the frozen block roots or the state summary index where possible, so that the block is not decoded.
+func (s *Store) BlockSlot(ctx context.Context, blockRoot [32]byte) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockSlot")
+	defer span.End()
+
+	if v, ok := s.blockCache.Get(string(blockRoot[:])); v != nil && ok {
+		return v.(interfaces.SignedBeaconBlock).Block().Slot(), nil
+	}
+	if summary := s.stateSummaryCache.get(blockRoot); summary != nil {
+		return summary.Slot, nil
+	}
+	var slot types.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(frozenBlockRootsBucket).Get(blockRoot[:]); enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+			return nil
+		}
+		if tx.Bucket(stateSummaryBucket).Get(blockRoot[:]) == nil && tx.Bucket(blocksBucket).Get(blockRoot[:]) == nil {
+			return errors.Wrapf(ErrNotFoundBlockSlot, "%#x", blockRoot)
+		}
+		var err error
+		slot, err = s.slotByBlockRoot(ctx, tx, blockRoot[:])
+		return err
+	})
+	return slot, err
+}
+
 // DeleteBlock from the db
 // This deletes the root entry from all buckets in the blocks DB
 // If the block is finalized this function returns an error
@@ -348,12 +368,11 @@ func (s *Store) GenesisBlock(ctx context.Context) (interfaces.SignedBeaconBlock,
 	err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		root := bkt.Get(genesisBlockRootKey)
-		enc := bkt.Get(root)
-		if enc == nil {
+		if root == nil {
 			return nil
 		}
 		var err error
-		blk, err = unmarshalBlock(ctx, enc)
+		blk, err = s.blockByRoot(ctx, tx, root)
 		return err
 	})
 	return blk, err
@@ -707,6 +726,15 @@ func blockRootsBySlot(ctx context.Context, tx *bolt.Tx, slot types.Slot) ([][32]
 	return [][32]byte{}, nil
 }
 
+// blockByRoot reads the block with the given root from the blocks bucket, or from the freezer once the
+// block has been frozen. It returns nil if the block is in neither.
+func (s *Store) blockByRoot(ctx context.Context, tx *bolt.Tx, root []byte) (interfaces.SignedBeaconBlock, error) {
+	if enc := tx.Bucket(blocksBucket).Get(root); enc != nil {
+		return unmarshalBlock(ctx, enc)
+	}
+	return s.frozenBlock(tx, root)
+}
+
 // createBlockIndicesFromBlock takes in a beacon block and returns
 // a map of bolt DB index buckets corresponding to each particular key for indices for
 // data, such as (shard indices bucket -> shard 5).
@@ -771,34 +799,69 @@ func unmarshalBlock(_ context.Context, enc []byte) (interfaces.SignedBeaconBlock
 	if err != nil {
 		return nil, errors.Wrap(err, "could not snappy decode block")
 	}
+	var v int
+	var sszBlock []byte
+	if hasForkVersionKey(enc) {
+		v, err = blockVersionFromForkVersionKey(enc)
+		if err != nil {
+			return nil, err
+		}
+		sszBlock = enc[forkVersionKeyLength:]
+	} else {
+		v, sszBlock = legacyBlockVersion(enc)
+	}
 	var rawBlock ssz.Unmarshaler
-	switch {
-	case hasAltairKey(enc):
-		// Marshal block bytes to altair beacon block.
+	switch v {
+	case version.Phase0:
+		rawBlock = &ethpb.SignedBeaconBlock{}
+	case version.Altair:
 		rawBlock = &ethpb.SignedBeaconBlockAltair{}
-		if err := rawBlock.UnmarshalSSZ(enc[len(altairKey):]); err != nil {
-			return nil, errors.Wrap(err, "could not unmarshal Altair block")
-		}
-	case hasBellatrixKey(enc):
+	case version.Bellatrix:
 		rawBlock = &ethpb.SignedBeaconBlockBellatrix{}
-		if err := rawBlock.UnmarshalSSZ(enc[len(bellatrixKey):]); err != nil {
-			return nil, errors.Wrap(err, "could not unmarshal Bellatrix block")
-		}
-	case hasBellatrixBlindKey(enc):
+	case version.BellatrixBlind:
 		rawBlock = &ethpb.SignedBlindedBeaconBlockBellatrix{}
-		if err := rawBlock.UnmarshalSSZ(enc[len(bellatrixBlindKey):]); err != nil {
-			return nil, errors.Wrap(err, "could not unmarshal blinded Bellatrix block")
-		}
 	default:
-		// Marshal block bytes to phase 0 beacon block.
-		rawBlock = &ethpb.SignedBeaconBlock{}
-		if err := rawBlock.UnmarshalSSZ(enc); err != nil {
-			return nil, errors.Wrap(err, "could not unmarshal Phase0 block")
-		}
+		return nil, errors.Errorf("unknown block version %s", version.String(v))
+	}
+	if err := rawBlock.UnmarshalSSZ(sszBlock); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal %s block", version.String(v))
 	}
 	return wrapper.WrappedSignedBeaconBlock(rawBlock)
 }
 
+// legacyBlockVersion returns the version and the SSZ encoding of a decompressed block saved
+// with the fork name keys, before blocks were prefixed with their fork version.
+func legacyBlockVersion(enc []byte) (int, []byte) {
+	switch {
+	case hasAltairKey(enc):
+		return version.Altair, enc[len(altairKey):]
+	case hasBellatrixKey(enc):
+		return version.Bellatrix, enc[len(bellatrixKey):]
+	case hasBellatrixBlindKey(enc):
+		return version.BellatrixBlind, enc[len(bellatrixBlindKey):]
+	default:
+		// Phase 0 blocks were saved without a key.
+		return version.Phase0, enc
+	}
+}
+
+// legacyBlockKey returns the fork name key which prefixed the blocks of the given version before blocks
+// were prefixed with their fork version.
+func legacyBlockKey(v int) ([]byte, error) {
+	switch v {
+	case version.Phase0:
+		return []byte{}, nil
+	case version.Altair:
+		return altairKey, nil
+	case version.Bellatrix:
+		return bellatrixKey, nil
+	case version.BellatrixBlind:
+		return bellatrixBlindKey, nil
+	default:
+		return nil, errors.Errorf("unknown block version %s", version.String(v))
+	}
+}
+
 // marshal versioned beacon block from struct type down to bytes.
 func marshalBlock(_ context.Context, blk interfaces.SignedBeaconBlock) ([]byte, error) {
 	var encodedBlock []byte
@@ -827,16 +890,9 @@ func marshalBlock(_ context.Context, blk interfaces.SignedBeaconBlock) ([]byte,
 			return nil, err
 		}
 	}
-	switch blockToSave.Version() {
-	case version.BellatrixBlind:
-		return snappy.Encode(nil, append(bellatrixBlindKey, encodedBlock...)), nil
-	case version.Bellatrix:
-		return snappy.Encode(nil, append(bellatrixKey, encodedBlock...)), nil
-	case version.Altair:
-		return snappy.Encode(nil, append(altairKey, encodedBlock...)), nil
-	case version.Phase0:
-		return snappy.Encode(nil, encodedBlock), nil
-	default:
-		return nil, errors.New("Unknown block version")
+	key, err := blockForkVersionKey(blockToSave.Version())
+	if err != nil {
+		return nil, err
 	}
+	return snappy.Encode(nil, append(key, encodedBlock...)), nil
 }
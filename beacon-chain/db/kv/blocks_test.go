@@ -756,6 +756,39 @@ func TestStore_BlocksBySlot_BlockRootsBySlot(t *testing.T) {
 	}
 }
 
+func TestStore_BlockSlot(t *testing.T) {
+	for _, tt := range blockTests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			ctx := context.Background()
+
+			_, err := db.BlockSlot(ctx, [32]byte{'a'})
+			require.ErrorIs(t, err, ErrNotFound)
+
+			blk, err := tt.newBlock(types.Slot(20), nil)
+			require.NoError(t, err)
+			require.NoError(t, db.SaveBlock(ctx, blk))
+			r, err := blk.Block().HashTreeRoot()
+			require.NoError(t, err)
+			slot, err := db.BlockSlot(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, types.Slot(20), slot)
+
+			// The block is decoded when neither the cache nor a state summary holds its slot.
+			db.blockCache.Del(string(r[:]))
+			slot, err = db.BlockSlot(ctx, r)
+			require.NoError(t, err)
+			assert.Equal(t, types.Slot(20), slot)
+
+			// State summaries are resolved without the block.
+			require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 30, Root: bytesutil.PadTo([]byte{'b'}, 32)}))
+			slot, err = db.BlockSlot(ctx, [32]byte{'b'})
+			require.NoError(t, err)
+			assert.Equal(t, types.Slot(30), slot)
+		})
+	}
+}
+
 func TestStore_FeeRecipientByValidatorID(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
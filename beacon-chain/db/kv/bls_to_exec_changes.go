@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveBLSToExecChanges replaces the stored BLS to execution changes with the given ones. Changes are
+// keyed by validator index, as a validator can only change its withdrawal credentials once.
+func (s *Store) SaveBLSToExecChanges(ctx context.Context, changes []*ethpb.SignedBLSToExecutionChange) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBLSToExecChanges")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(blsToExecChangesBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(blsToExecChangesBucket)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if c == nil || c.Message == nil {
+				continue
+			}
+			enc, err := encode(ctx, c)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(c.Message.ValidatorIndex)), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// BLSToExecChanges retrieves the stored BLS to execution changes, ordered by validator index.
+func (s *Store) BLSToExecChanges(ctx context.Context) ([]*ethpb.SignedBLSToExecutionChange, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BLSToExecChanges")
+	defer span.End()
+
+	changes := make([]*ethpb.SignedBLSToExecutionChange, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(blsToExecChangesBucket).ForEach(func(_, enc []byte) error {
+			c := &ethpb.SignedBLSToExecutionChange{}
+			if err := decode(ctx, enc, c); err != nil {
+				return err
+			}
+			changes = append(changes, c)
+			return nil
+		})
+	})
+	return changes, err
+}
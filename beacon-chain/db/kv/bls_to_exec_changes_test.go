@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_BLSToExecChanges(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	changes, err := db.BLSToExecChanges(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(changes))
+
+	newChange := func(idx types.ValidatorIndex) *ethpb.SignedBLSToExecutionChange {
+		return &ethpb.SignedBLSToExecutionChange{
+			Message: &ethpb.BLSToExecutionChange{
+				ValidatorIndex:     idx,
+				FromBlsPubkey:      make([]byte, 48),
+				ToExecutionAddress: make([]byte, 20),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	require.NoError(t, db.SaveBLSToExecChanges(ctx, []*ethpb.SignedBLSToExecutionChange{newChange(2), newChange(1)}))
+	changes, err = db.BLSToExecChanges(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes))
+	assert.DeepEqual(t, newChange(1), changes[0])
+	assert.DeepEqual(t, newChange(2), changes[1])
+
+	// Saving replaces the previously stored changes.
+	require.NoError(t, db.SaveBLSToExecChanges(ctx, []*ethpb.SignedBLSToExecutionChange{newChange(3)}))
+	changes, err = db.BLSToExecChanges(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	assert.Equal(t, types.ValidatorIndex(3), changes[0].Message.ValidatorIndex)
+}
@@ -72,6 +72,10 @@ func isSSZStorageFormat(obj interface{}) bool {
 		return true
 	case *ethpb.ValidatorRegistrationV1:
 		return true
+	case *ethpb.SignedBLSToExecutionChange:
+		return true
+	case *ethpb.BlobSidecars:
+		return true
 	default:
 		return false
 	}
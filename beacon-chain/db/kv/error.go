@@ -21,3 +21,15 @@ var ErrNotFoundBackfillBlockRoot = errors.Wrap(ErrNotFound, "BackfillBlockRoot")
 
 // ErrNotFoundFeeRecipient is a not found error specifically for the fee recipient getter
 var ErrNotFoundFeeRecipient = errors.Wrap(ErrNotFound, "fee recipient")
+
+// ErrNotFoundRegistration is a not found error specifically for the signed validator registration getter
+var ErrNotFoundRegistration = errors.Wrap(ErrNotFound, "validator registration")
+
+// ErrNotFoundBlockSlot means neither the block nor a state summary of the given root was found.
+var ErrNotFoundBlockSlot = errors.Wrap(ErrNotFound, "block slot")
+
+// ErrNotFoundBlobSidecars means no blob sidecars of the given block root were found.
+var ErrNotFoundBlobSidecars = errors.Wrap(ErrNotFound, "blob sidecars")
+
+// ErrFreezerDisabled is returned when freezer operations are attempted on a database opened without a freezer.
+var ErrFreezerDisabled = errors.New("freezer is not enabled")
@@ -0,0 +1,208 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// FreezerEnabled returns whether finalized eras are moved out of the database into the freezer.
+func (s *Store) FreezerEnabled() bool {
+	return s.freezer != nil
+}
+
+// NextEraToFreeze returns the era following the last era in the freezer. Before the first era is frozen,
+// it is the genesis era, or the first era entirely after the origin checkpoint for a database initialized
+// from a checkpoint.
+func (s *Store) NextEraToFreeze(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.NextEraToFreeze")
+	defer span.End()
+	if s.freezer == nil {
+		return 0, ErrFreezerDisabled
+	}
+	if eras := s.freezer.Eras(); len(eras) > 0 {
+		return eras[len(eras)-1] + 1, nil
+	}
+	originRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, ErrNotFoundOriginBlockRoot) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	origin, err := s.Block(ctx, originRoot)
+	if err != nil {
+		return 0, err
+	}
+	if origin == nil || origin.IsNil() {
+		return 0, errors.Wrapf(ErrNotFound, "origin checkpoint block %#x", originRoot)
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	era := uint64(origin.Block().Slot() / sphr)
+	if origin.Block().Slot()%sphr != 0 {
+		era++
+	}
+	return era + 1, nil
+}
+
+// FreezeEra writes the era ending at the slot of the given state to the freezer, from the canonical blocks
+// listed in the block roots of the state, then removes these blocks from the database. Frozen blocks keep
+// their indices and are still served by the block getters, which read them from the freezer.
+func (s *Store) FreezeEra(ctx context.Context, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.FreezeEra")
+	defer span.End()
+	if s.freezer == nil {
+		return ErrFreezerDisabled
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if st.Slot()%sphr != 0 {
+		return fmt.Errorf("state at slot %d is not at the end of an era", st.Slot())
+	}
+	era := uint64(st.Slot() / sphr)
+	if s.freezer.HasEra(era) {
+		return nil
+	}
+	blocks, err := s.CanonicalEraBlocks(ctx, st)
+	if err != nil {
+		return err
+	}
+	if err := s.freezer.WriteEra(era, blocks, st); err != nil {
+		return err
+	}
+	if err := s.moveBlocksToFreezer(ctx, blocks); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"era":    era,
+		"blocks": len(blocks),
+	}).Debug("Moved era to the freezer")
+	return nil
+}
+
+// ImportEra verifies the era file at the given path and adds it to the freezer, indexing its blocks so they
+// are served from the freezer. The era must be part of the chain of this database, as committed to by the
+// historical roots of the latest state saved in the database. It returns the era of the file.
+func (s *Store) ImportEra(ctx context.Context, path string) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ImportEra")
+	defer span.End()
+	if s.freezer == nil {
+		return 0, ErrFreezerDisabled
+	}
+	states, err := s.HighestSlotStatesBelow(ctx, math.MaxUint64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve the latest saved state")
+	}
+	era, err := s.freezer.Import(path, states[0])
+	if err != nil {
+		return 0, err
+	}
+	blocks, err := s.freezer.EraBlocks(era)
+	if err != nil {
+		return 0, err
+	}
+	return era, s.moveBlocksToFreezer(ctx, blocks)
+}
+
+// ExportEra copies the file of the given era from the freezer into the given directory, returning the
+// path of the copy.
+func (s *Store) ExportEra(_ context.Context, era uint64, dir string) (string, error) {
+	if s.freezer == nil {
+		return "", ErrFreezerDisabled
+	}
+	return s.freezer.Export(era, dir)
+}
+
+// CanonicalEraBlocks returns the blocks of the era ending at the slot of the given state, as listed in the
+// block roots of the state, ordered by slot.
+func (s *Store) CanonicalEraBlocks(ctx context.Context, st state.ReadOnlyBeaconState) ([]interfaces.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CanonicalEraBlocks")
+	defer span.End()
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	if st.Slot()%sphr != 0 {
+		return nil, fmt.Errorf("state at slot %d is not at the end of an era", st.Slot())
+	}
+	era := uint64(st.Slot() / sphr)
+	if era == 0 {
+		return nil, nil
+	}
+	start := types.Slot(era-1) * sphr
+	blockRoots := st.BlockRoots()
+	blocks := make([]interfaces.SignedBeaconBlock, 0)
+	var prev [32]byte
+	for slot := start; slot < start+sphr; slot++ {
+		root := bytesutil.ToBytes32(blockRoots[slot%sphr])
+		// Skipped slots repeat the root of the previous block.
+		if root == prev {
+			continue
+		}
+		prev = root
+		blk, err := s.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || blk.IsNil() {
+			return nil, errors.Wrapf(ErrNotFound, "block %#x at slot %d of era %d", root, slot, era)
+		}
+		if blk.Version() == version.BellatrixBlind {
+			return nil, fmt.Errorf("block %#x at slot %d is blinded and cannot be frozen", root, slot)
+		}
+		// The first slots of the era may be skipped, repeating the root of a block of the previous era.
+		if blk.Block().Slot() < start {
+			continue
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
+
+// moveBlocksToFreezer records the slot of each frozen block, keeps its indices up to date and removes it
+// from the blocks bucket.
+func (s *Store) moveBlocksToFreezer(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, blk := range blocks {
+			root, err := blk.Block().HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			slot := bytesutil.SlotToBytesBigEndian(blk.Block().Slot())
+			if err := tx.Bucket(frozenBlockRootsBucket).Put(root[:], slot); err != nil {
+				return err
+			}
+			if err := updateValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block()), root[:], tx); err != nil {
+				return errors.Wrap(err, "could not update block indices")
+			}
+			if err := tx.Bucket(blocksBucket).Delete(root[:]); err != nil {
+				return err
+			}
+			s.blockCache.Del(string(root[:]))
+		}
+		return nil
+	})
+}
+
+// frozenBlock reads the block with the given root from the freezer, or returns nil if the block is not frozen.
+func (s *Store) frozenBlock(tx *bolt.Tx, root []byte) (interfaces.SignedBeaconBlock, error) {
+	if s.freezer == nil {
+		return nil, nil
+	}
+	enc := tx.Bucket(frozenBlockRootsBucket).Get(root)
+	if enc == nil {
+		return nil, nil
+	}
+	blk, err := s.freezer.Block(bytesutil.BytesToSlotBigEndian(enc))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read frozen block %#x", root)
+	}
+	return blk, nil
+}
@@ -0,0 +1,193 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupDBWithFreezer(t testing.TB) *Store {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableOnlyBlindedBeaconBlocks: false,
+	})
+	t.Cleanup(resetFn)
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{FreezerPath: t.TempDir()})
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+// saveEraChain saves a chain of blocks at the given slots and returns them with the state at the end of era 1.
+func saveEraChain(t *testing.T, db *Store, blockSlots ...types.Slot) ([]interfaces.SignedBeaconBlock, state.BeaconState) {
+	ctx := context.Background()
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(sphr))
+
+	blocks := make([]interfaces.SignedBeaconBlock, 0, len(blockSlots))
+	var parent [32]byte
+	for i, slot := range blockSlots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		blk, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		parent, err = blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		next := sphr
+		if i+1 < len(blockSlots) {
+			next = blockSlots[i+1]
+		}
+		for s := slot; s < next; s++ {
+			require.NoError(t, st.UpdateBlockRootAtIndex(uint64(s%sphr), parent))
+		}
+		blocks = append(blocks, blk)
+	}
+	batch := &ethpb.HistoricalBatch{BlockRoots: st.BlockRoots(), StateRoots: st.StateRoots()}
+	root, err := batch.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.AppendHistoricalRoots(root))
+	return blocks, st
+}
+
+func TestStore_FreezeEra(t *testing.T) {
+	ctx := context.Background()
+	db := setupDBWithFreezer(t)
+	blocks, st := saveEraChain(t, db, 0, 1, 3, 7)
+	next, err := db.NextEraToFreeze(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), next)
+
+	require.NoError(t, db.FreezeEra(ctx, st))
+	next, err = db.NextEraToFreeze(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next)
+
+	for _, want := range blocks {
+		root, err := want.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+			assert.Equal(t, true, tx.Bucket(blocksBucket).Get(root[:]) == nil, "block %#x is still in the database", root)
+			return nil
+		}))
+		require.Equal(t, true, db.HasBlock(ctx, root))
+		got, err := db.Block(ctx, root)
+		require.NoError(t, err)
+		gotRoot, err := got.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, gotRoot)
+
+		bySlot, err := db.BlocksBySlot(ctx, want.Block().Slot())
+		require.NoError(t, err)
+		require.Equal(t, 1, len(bySlot))
+	}
+	got, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(7))
+	require.NoError(t, err)
+	assert.Equal(t, len(blocks), len(got))
+
+	// Freezing the same era again is a no-op.
+	require.NoError(t, db.FreezeEra(ctx, st))
+}
+
+func TestStore_FreezeEra_MissingBlock(t *testing.T) {
+	ctx := context.Background()
+	db := setupDBWithFreezer(t)
+	_, st := saveEraChain(t, db, 0, 2)
+	require.NoError(t, st.UpdateBlockRootAtIndex(5, [32]byte{'b'}))
+	require.ErrorIs(t, db.FreezeEra(ctx, st), ErrNotFound)
+	require.Equal(t, false, db.freezer.HasEra(1))
+}
+
+func TestStore_FreezeEra_Disabled(t *testing.T) {
+	db := setupDB(t)
+	require.Equal(t, false, db.FreezerEnabled())
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.ErrorIs(t, db.FreezeEra(context.Background(), st), ErrFreezerDisabled)
+}
+
+func TestStore_ExportImportEra(t *testing.T) {
+	ctx := context.Background()
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableOnlyBlindedBeaconBlocks: false,
+	})
+	defer resetFn()
+	src, err := NewKVStore(ctx, t.TempDir(), &Config{FreezerPath: t.TempDir()})
+	require.NoError(t, err)
+	blocks, st := saveEraChain(t, src, 0, 4)
+	require.NoError(t, src.FreezeEra(ctx, st))
+	path, err := src.ExportEra(ctx, 1, t.TempDir())
+	require.NoError(t, err)
+	// Only one database can be open at a time, as each one registers the same metrics collector.
+	require.NoError(t, src.Close())
+
+	dst := setupDBWithFreezer(t)
+	// The era is not covered by the states of the database.
+	_, err = dst.ImportEra(ctx, path)
+	require.ErrorContains(t, "no trusted state", err)
+	require.Equal(t, false, dst.freezer.HasEra(1))
+
+	// The state at the end of the era commits to the era with its historical roots.
+	stRoot := [32]byte{'s'}
+	require.NoError(t, dst.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: st.Slot(), Root: stRoot[:]}))
+	require.NoError(t, dst.SaveState(ctx, st, stRoot))
+	era, err := dst.ImportEra(ctx, path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), era)
+	for _, want := range blocks {
+		root, err := want.Block().HashTreeRoot()
+		require.NoError(t, err)
+		got, err := dst.Block(ctx, root)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, want.Block().Slot(), got.Block().Slot())
+		hasRoots, roots, err := dst.BlockRootsBySlot(ctx, want.Block().Slot())
+		require.NoError(t, err)
+		require.Equal(t, true, hasRoots)
+		assert.Equal(t, root, roots[0])
+	}
+}
+
+func TestStore_ImportEra_OtherChain(t *testing.T) {
+	ctx := context.Background()
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableOnlyBlindedBeaconBlocks: false,
+	})
+	defer resetFn()
+	src, err := NewKVStore(ctx, t.TempDir(), &Config{FreezerPath: t.TempDir()})
+	require.NoError(t, err)
+	blocks, st := saveEraChain(t, src, 0, 4)
+	require.NoError(t, src.FreezeEra(ctx, st))
+	path, err := src.ExportEra(ctx, 1, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, src.Close())
+
+	dst := setupDBWithFreezer(t)
+	_, other := saveEraChain(t, dst, 0, 5)
+	otherRoot := [32]byte{'o'}
+	require.NoError(t, dst.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: other.Slot(), Root: otherRoot[:]}))
+	require.NoError(t, dst.SaveState(ctx, other, otherRoot))
+	_, err = dst.ImportEra(ctx, path)
+	require.ErrorContains(t, "does not match the historical root", err)
+	require.Equal(t, false, dst.freezer.HasEra(1))
+	root, err := blocks[1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, false, dst.HasBlock(ctx, root))
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -58,7 +58,7 @@ func (s *Store) LoadGenesis(ctx context.Context, sb []byte) error {
 	if err := st.UnmarshalSSZ(sb); err != nil {
 		return err
 	}
-	gs, err := statev1.InitializeFromProtoUnsafe(st)
+	gs, err := statenative.InitializeFromProtoUnsafePhase0(st)
 	if err != nil {
 		return err
 	}
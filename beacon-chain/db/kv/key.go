@@ -1,6 +1,21 @@
 package kv
 
-import "bytes"
+import (
+	"bytes"
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+const (
+	fullBlockMarker    = byte(0)
+	blindedBlockMarker = byte(1)
+)
+
+// forkVersionKeyLength is the length of the prefix of the blocks saved with their fork version.
+var forkVersionKeyLength = len(forkVersionKey) + fieldparams.VersionLength + 1
 
 // In order for an encoding to be Altair compatible, it must be prefixed with altair key.
 func hasAltairKey(enc []byte) bool {
@@ -23,3 +38,60 @@ func hasBellatrixBlindKey(enc []byte) bool {
 	}
 	return bytes.Equal(enc[:len(bellatrixBlindKey)], bellatrixBlindKey)
 }
+
+func hasForkVersionKey(enc []byte) bool {
+	if forkVersionKeyLength >= len(enc) {
+		return false
+	}
+	return bytes.Equal(enc[:len(forkVersionKey)], forkVersionKey)
+}
+
+// blockForkVersionKey returns the prefix of an encoded block of the given version.
+func blockForkVersionKey(v int) ([]byte, error) {
+	cfg := params.BeaconConfig()
+	var forkVersion []byte
+	marker := fullBlockMarker
+	switch v {
+	case version.Phase0:
+		forkVersion = cfg.GenesisForkVersion
+	case version.Altair:
+		forkVersion = cfg.AltairForkVersion
+	case version.Bellatrix:
+		forkVersion = cfg.BellatrixForkVersion
+	case version.BellatrixBlind:
+		forkVersion = cfg.BellatrixForkVersion
+		marker = blindedBlockMarker
+	default:
+		return nil, fmt.Errorf("unknown block version %s", version.String(v))
+	}
+	if len(forkVersion) != fieldparams.VersionLength {
+		return nil, fmt.Errorf("invalid fork version length %d for block version %s", len(forkVersion), version.String(v))
+	}
+	key := make([]byte, 0, forkVersionKeyLength)
+	key = append(key, forkVersionKey...)
+	key = append(key, forkVersion...)
+	return append(key, marker), nil
+}
+
+// blockVersionFromForkVersionKey returns the version of an encoded block prefixed with its fork version.
+func blockVersionFromForkVersionKey(enc []byte) (int, error) {
+	if !hasForkVersionKey(enc) {
+		return 0, fmt.Errorf("encoded block has no fork version key")
+	}
+	forkVersion := enc[len(forkVersionKey) : len(forkVersionKey)+fieldparams.VersionLength]
+	blinded := enc[forkVersionKeyLength-1] == blindedBlockMarker
+	cfg := params.BeaconConfig()
+	switch {
+	case bytes.Equal(forkVersion, cfg.BellatrixForkVersion):
+		if blinded {
+			return version.BellatrixBlind, nil
+		}
+		return version.Bellatrix, nil
+	case bytes.Equal(forkVersion, cfg.AltairForkVersion):
+		return version.Altair, nil
+	case bytes.Equal(forkVersion, cfg.GenesisForkVersion):
+		return version.Phase0, nil
+	default:
+		return 0, fmt.Errorf("unknown fork version %#x", forkVersion)
+	}
+}
@@ -14,9 +14,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/io/file"
 	bolt "go.etcd.io/bbolt"
 )
@@ -73,6 +75,12 @@ var blockedBuckets = [][]byte{
 // Config for the bolt db kv store.
 type Config struct {
 	InitialMMapSize int
+	// FreezerPath is the directory of the era files holding finalized blocks and states, the freezer
+	// is disabled if empty.
+	FreezerPath string
+	// BlobRetentionEpochs is the number of epochs blob sidecars are kept for, MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS
+	// if zero.
+	BlobRetentionEpochs types.Epoch
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -83,6 +91,8 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	freezer             *freezer.Store
+	blobRetentionEpochs types.Epoch
 	ctx                 context.Context
 }
 
@@ -147,8 +157,12 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		blobRetentionEpochs: config.BlobRetentionEpochs,
 		ctx:                 ctx,
 	}
+	if kv.blobRetentionEpochs == 0 {
+		kv.blobRetentionEpochs = params.BeaconNetworkConfig().MinEpochsForBlobSidecarsRequest
+	}
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
@@ -174,17 +188,34 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
 			blockRootValidatorHashesBucket,
+			frozenBlockRootsBucket,
 			// State management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
 			migrationsBucket,
+			schemaVersionBucket,
 
 			feeRecipientBucket,
 			registrationBucket,
+			signedRegistrationBucket,
+			blsToExecChangesBucket,
+			proposalAuditBucket,
+			blobSidecarsBucket,
+			blobSidecarSlotIndicesBucket,
+			pendingOperationsBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
+	if config.FreezerPath != "" {
+		if features.Get().EnableOnlyBlindedBeaconBlocks {
+			return nil, errors.New("the freezer cannot be used while only blinded beacon blocks are saved")
+		}
+		kv.freezer, err = freezer.New(config.FreezerPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open freezer")
+		}
+	}
 	if err = prometheus.Register(createBoltCollector(kv.db)); err != nil {
 		return nil, err
 	}
@@ -2,7 +2,12 @@ package kv
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -10,19 +15,184 @@ var migrationCompleted = []byte("done")
 
 type migration func(context.Context, *bolt.DB) error
 
-var migrations = []migration{
-	migrateArchivedIndex,
-	migrateBlockSlotIndex,
+// schemaMigration moves the database schema from version-1 to version. Migrations with a down
+// function can be reverted to the previous schema version. The schema version is saved once a
+// migration completes, so a migration interrupted midway is run again at the next startup and
+// must be safe to repeat.
+type schemaMigration struct {
+	version uint64
+	name    string
+	up      migration
+	down    migration
+}
+
+// schemaMigrations lists the database schema migrations, ordered by version. New disk format
+// changes are appended here with the next version number.
+var schemaMigrations = []schemaMigration{
+	{version: 1, name: "archived-index", up: migrateArchivedIndex},
+	{version: 2, name: "block-slot-index", up: migrateBlockSlotIndex},
+	{version: 3, name: "block-fork-version", up: markBlockForkVersionEncoding, down: unmarkBlockForkVersionEncoding},
+}
+
+// featureMigrations depend on feature flags rather than on the schema version, so they are
+// attempted at every startup and keep track of their own completion in the migrations bucket.
+var featureMigrations = []migration{
 	migrateStateValidators,
 	migrateBlindedBeaconBlocksEnabled,
 }
 
-// RunMigrations defined in the migrations array.
+// backgroundMigrations are too slow to hold up the startup of the node, so they run in the background
+// once the other migrations are done. They keep track of their own completion in the migrations bucket,
+// and the database must stay readable while they are in progress.
+var backgroundMigrations = []migration{
+	migrateBlockForkVersion,
+}
+
+// RunMigrations upgrades the database to the latest schema version, then runs the migrations
+// enabled by feature flags and starts the background migrations.
 func (s *Store) RunMigrations(ctx context.Context) error {
-	for _, m := range migrations {
+	if err := s.migrateUp(ctx, schemaMigrations); err != nil {
+		return err
+	}
+	for _, m := range featureMigrations {
 		if err := m(ctx, s.db); err != nil {
 			return err
 		}
 	}
+	go s.runBackgroundMigrations(ctx)
 	return nil
 }
+
+func (s *Store) runBackgroundMigrations(ctx context.Context) {
+	for _, m := range backgroundMigrations {
+		if err := m(ctx, s.db); err != nil {
+			if errors.Is(err, bolt.ErrDatabaseNotOpen) || errors.Is(err, context.Canceled) {
+				return
+			}
+			log.WithError(err).Error("Could not run background database migration")
+			return
+		}
+	}
+}
+
+// RollbackMigrations reverts the schema migrations above the target version, newest first.
+// Nothing is reverted unless every migration to revert supports it.
+func (s *Store) RollbackMigrations(ctx context.Context, target uint64) error {
+	return s.migrateDown(ctx, schemaMigrations, target)
+}
+
+// SchemaVersion returns the schema version of the database, which is 0 until the first schema
+// migration completes.
+func (s *Store) SchemaVersion(ctx context.Context) (uint64, error) {
+	var version uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(schemaVersionBucket).Get(schemaVersionKey); v != nil {
+			version = bytesutil.BytesToUint64BigEndian(v)
+		}
+		return nil
+	})
+	return version, err
+}
+
+func (s *Store) saveSchemaVersion(version uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(schemaVersionBucket).Put(schemaVersionKey, bytesutil.Uint64ToBytesBigEndian(version))
+	})
+}
+
+func (s *Store) migrateUp(ctx context.Context, migrations []schemaMigration) error {
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get database schema version")
+	}
+	latest := latestSchemaVersion(migrations)
+	if current > latest {
+		return fmt.Errorf(
+			"database schema version %d is newer than version %d supported by this beacon node, "+
+				"please upgrade the beacon node or roll back the database schema with prysmctl db rollback of the release which migrated it",
+			current,
+			latest,
+		)
+	}
+	if current == latest {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"from": current,
+		"to":   latest,
+	}).Info("Migrating database schema")
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		start := time.Now()
+		if err := m.up(ctx, s.db); err != nil {
+			return errors.Wrapf(err, "could not run database migration %d (%s)", m.version, m.name)
+		}
+		if err := s.saveSchemaVersion(m.version); err != nil {
+			return errors.Wrapf(err, "could not save database schema version %d", m.version)
+		}
+		log.WithFields(logrus.Fields{
+			"version":  m.version,
+			"name":     m.name,
+			"progress": fmt.Sprintf("%d/%d", m.version-current, latest-current),
+			"duration": time.Since(start),
+		}).Info("Completed database migration")
+	}
+	return nil
+}
+
+func (s *Store) migrateDown(ctx context.Context, migrations []schemaMigration, target uint64) error {
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get database schema version")
+	}
+	if target >= current {
+		return nil
+	}
+	if current > latestSchemaVersion(migrations) {
+		return fmt.Errorf("database schema version %d is unknown to this beacon node", current)
+	}
+	for _, m := range migrations {
+		if m.version > target && m.version <= current && m.down == nil {
+			return fmt.Errorf("database migration %d (%s) cannot be reverted", m.version, m.name)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"from": current,
+		"to":   target,
+	}).Info("Rolling back database schema")
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version <= target || m.version > current {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		start := time.Now()
+		if err := m.down(ctx, s.db); err != nil {
+			return errors.Wrapf(err, "could not revert database migration %d (%s)", m.version, m.name)
+		}
+		if err := s.saveSchemaVersion(m.version - 1); err != nil {
+			return errors.Wrapf(err, "could not save database schema version %d", m.version-1)
+		}
+		log.WithFields(logrus.Fields{
+			"version":  m.version,
+			"name":     m.name,
+			"progress": fmt.Sprintf("%d/%d", current-m.version+1, current-target),
+			"duration": time.Since(start),
+		}).Info("Reverted database migration")
+	}
+	return nil
+}
+
+func latestSchemaVersion(migrations []schemaMigration) uint64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].version
+}
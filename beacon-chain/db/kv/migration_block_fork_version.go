@@ -0,0 +1,195 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationBlockForkVersionKey = []byte("block_fork_version_0")
+
+// blockForkVersionMigrationBatchSize is the number of blocks re-encoded in a single transaction,
+// kept small so the migration does not hold up the writes of a running node.
+var blockForkVersionMigrationBatchSize = 256
+
+// markBlockForkVersionEncoding bumps the schema version for blocks saved with their fork version. Blocks saved
+// with the fork name keys are re-encoded in the background by migrateBlockForkVersion. Releases from before the
+// schema version do not check it and cannot read blocks saved with their fork version, so the migration has
+// to be reverted by unmarkBlockForkVersionEncoding before the database is used by one of them.
+func markBlockForkVersionEncoding(_ context.Context, _ *bolt.DB) error {
+	return nil
+}
+
+// unmarkBlockForkVersionEncoding reverts the blocks saved with their fork version to the fork name keys,
+// and resets the background migration so that the blocks are re-encoded again after a later upgrade.
+func unmarkBlockForkVersionEncoding(ctx context.Context, db *bolt.DB) error {
+	log.Info("Re-encoding saved blocks with their fork name")
+	total, reverted, err := reencodeBlocks(ctx, db, reencodeBlockWithForkName, func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationsBucket).Delete(migrationBlockForkVersionKey)
+	})
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"blocksRead":      total,
+		"blocksReencoded": reverted,
+	}).Info("Completed re-encoding saved blocks with their fork name")
+	return nil
+}
+
+// migrateBlockForkVersion re-encodes the blocks saved with the fork name keys so that they are prefixed
+// with their fork version, in small batches. It can be interrupted and resumes from the start at the
+// next startup, skipping the blocks which were already re-encoded.
+func migrateBlockForkVersion(ctx context.Context, db *bolt.DB) error {
+	done := false
+	if err := db.View(func(tx *bolt.Tx) error {
+		done = bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationBlockForkVersionKey), migrationCompleted)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+	log.Info("Re-encoding saved blocks with their fork version in the background")
+	total, migrated, err := reencodeBlocks(ctx, db, reencodeLegacyBlock, func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationsBucket).Put(migrationBlockForkVersionKey, migrationCompleted)
+	})
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"blocksRead":      total,
+		"blocksReencoded": migrated,
+	}).Info("Completed re-encoding saved blocks with their fork version")
+	return nil
+}
+
+// reencodeBlocks replaces every saved block with its encoding returned by reencode, in batches of
+// blockForkVersionMigrationBatchSize blocks. Blocks for which reencode returns nil are left as they are.
+// The finish function is called in the transaction of the last batch. It returns the number of blocks
+// read and the number of blocks re-encoded.
+func reencodeBlocks(
+	ctx context.Context,
+	db *bolt.DB,
+	reencode func([]byte) ([]byte, error),
+	finish func(*bolt.Tx) error,
+) (int, int, error) {
+	var next []byte
+	reencoded, total := 0, 0
+	for {
+		if ctx.Err() != nil {
+			return total, reencoded, ctx.Err()
+		}
+		finished := false
+		if err := db.Update(func(tx *bolt.Tx) error {
+			bkt := tx.Bucket(blocksBucket)
+			c := bkt.Cursor()
+			k, v := c.First()
+			if next != nil {
+				k, v = c.Seek(next)
+			}
+			keys := make([][]byte, 0, blockForkVersionMigrationBatchSize)
+			values := make([][]byte, 0, blockForkVersionMigrationBatchSize)
+			for i := 0; i < blockForkVersionMigrationBatchSize && k != nil; i++ {
+				if !isBlockRootKey(k) {
+					k, v = c.Next()
+					continue
+				}
+				total++
+				enc, err := reencode(v)
+				if err != nil {
+					return errors.Wrapf(err, "could not re-encode block %#x", k)
+				}
+				if enc != nil {
+					keys = append(keys, bytesutil.SafeCopyBytes(k))
+					values = append(values, enc)
+				}
+				k, v = c.Next()
+			}
+			if k == nil {
+				finished = true
+			} else {
+				next = bytesutil.SafeCopyBytes(k)
+			}
+			// The cursor is not used past this point, so the bucket can be safely modified.
+			for i := range keys {
+				if err := bkt.Put(keys[i], values[i]); err != nil {
+					return err
+				}
+			}
+			reencoded += len(keys)
+			if finished {
+				return finish(tx)
+			}
+			return nil
+		}); err != nil {
+			return total, reencoded, err
+		}
+		if finished {
+			return total, reencoded, nil
+		}
+		log.WithFields(logrus.Fields{
+			"blocksRead":      total,
+			"blocksReencoded": reencoded,
+		}).Debug("Re-encoding saved blocks")
+	}
+}
+
+// isBlockRootKey returns true if the key of the blocks bucket is the root of a block, rather than one of the
+// named keys, such as the head or genesis block root keys, which are stored in the same bucket.
+func isBlockRootKey(k []byte) bool {
+	if len(k) != 32 {
+		return false
+	}
+	for _, named := range [][]byte{headBlockRootKey, genesisBlockRootKey, originCheckpointBlockRootKey, backfillBlockRootKey} {
+		if bytes.Equal(k, named) {
+			return false
+		}
+	}
+	return true
+}
+
+// reencodeLegacyBlock returns the given encoded block prefixed with its fork version, or nil if the block
+// is already prefixed with its fork version.
+func reencodeLegacyBlock(enc []byte) ([]byte, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not snappy decode block")
+	}
+	if hasForkVersionKey(dec) {
+		return nil, nil
+	}
+	v, sszBlock := legacyBlockVersion(dec)
+	key, err := blockForkVersionKey(v)
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, append(key, sszBlock...)), nil
+}
+
+// reencodeBlockWithForkName returns the given encoded block prefixed with its fork name key, or nil if the
+// block is already saved with its fork name key.
+func reencodeBlockWithForkName(enc []byte) ([]byte, error) {
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not snappy decode block")
+	}
+	if !hasForkVersionKey(dec) {
+		return nil, nil
+	}
+	v, err := blockVersionFromForkVersionKey(dec)
+	if err != nil {
+		return nil, err
+	}
+	key, err := legacyBlockKey(v)
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, append(append([]byte{}, key...), dec[forkVersionKeyLength:]...)), nil
+}
@@ -0,0 +1,167 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_migrateBlockForkVersion(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	defer func(size int) {
+		blockForkVersionMigrationBatchSize = size
+	}(blockForkVersionMigrationBatchSize)
+	blockForkVersionMigrationBatchSize = 2
+
+	phase0 := util.NewBeaconBlock()
+	phase0.Block.Slot = 1
+	altair := util.NewBeaconBlockAltair()
+	altair.Block.Slot = 2
+	bellatrix := util.NewBeaconBlockBellatrix()
+	bellatrix.Block.Slot = 3
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Slot = 4
+	legacyKeys := [][]byte{nil, altairKey, bellatrixKey, bellatrixBlindKey}
+
+	blks := make([]interfaces.SignedBeaconBlock, 0)
+	for _, b := range []interface{}{phase0, altair, bellatrix, blinded} {
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		blks = append(blks, wsb)
+	}
+	// Save the blocks with the fork name keys, as done before the fork version encoding.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		for i, blk := range blks {
+			root, err := blk.Block().HashTreeRoot()
+			require.NoError(t, err)
+			enc, err := blk.MarshalSSZ()
+			require.NoError(t, err)
+			prefixed := append(append([]byte{}, legacyKeys[i]...), enc...)
+			if err := tx.Bucket(blocksBucket).Put(root[:], snappy.Encode(nil, prefixed)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// The roots saved under named keys in the blocks bucket are not blocks.
+	genesisRoot, err := blks[0].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	headRoot, err := blks[3].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: blinded.Block.Slot, Root: headRoot[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, headRoot))
+
+	require.NoError(t, migrateBlockForkVersion(ctx, db.db))
+
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		require.DeepEqual(t, migrationCompleted, tx.Bucket(migrationsBucket).Get(migrationBlockForkVersionKey))
+		require.DeepEqual(t, genesisRoot[:], tx.Bucket(blocksBucket).Get(genesisBlockRootKey))
+		require.DeepEqual(t, headRoot[:], tx.Bucket(blocksBucket).Get(headBlockRootKey))
+		return tx.Bucket(blocksBucket).ForEach(func(k, v []byte) error {
+			if !isBlockRootKey(k) {
+				return nil
+			}
+			dec, err := snappy.Decode(nil, v)
+			require.NoError(t, err)
+			require.Equal(t, true, hasForkVersionKey(dec), "block %#x was not re-encoded", k)
+			return nil
+		})
+	}))
+	for _, blk := range blks {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		saved, err := db.Block(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, blk.Version(), saved.Version())
+		wanted, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		got, err := saved.MarshalSSZ()
+		require.NoError(t, err)
+		require.DeepEqual(t, wanted, got)
+	}
+
+	// Running the migration again is a no-op.
+	require.NoError(t, migrateBlockForkVersion(ctx, db.db))
+}
+
+func TestStore_RollbackMigrations_BlockForkVersion(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	defer func(size int) {
+		blockForkVersionMigrationBatchSize = size
+	}(blockForkVersionMigrationBatchSize)
+	blockForkVersionMigrationBatchSize = 2
+
+	phase0 := util.NewBeaconBlock()
+	phase0.Block.Slot = 1
+	altair := util.NewBeaconBlockAltair()
+	altair.Block.Slot = 2
+	bellatrix := util.NewBeaconBlockBellatrix()
+	bellatrix.Block.Slot = 3
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Slot = 4
+
+	blks := make([]interfaces.SignedBeaconBlock, 0)
+	for _, b := range []interface{}{phase0, altair, bellatrix, blinded} {
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		blks = append(blks, wsb)
+	}
+	require.NoError(t, db.migrateUp(ctx, schemaMigrations))
+	require.NoError(t, migrateBlockForkVersion(ctx, db.db))
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	saved := make([]interfaces.SignedBeaconBlock, len(blks))
+	for i, blk := range blks {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		saved[i], err = db.Block(ctx, root)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, db.RollbackMigrations(ctx, 2))
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		require.Equal(t, 0, len(tx.Bucket(migrationsBucket).Get(migrationBlockForkVersionKey)))
+		for _, blk := range saved {
+			root, err := blk.Block().HashTreeRoot()
+			require.NoError(t, err)
+			dec, err := snappy.Decode(nil, tx.Bucket(blocksBucket).Get(root[:]))
+			require.NoError(t, err)
+			require.Equal(t, false, hasForkVersionKey(dec), "block %#x was not reverted", root)
+			v, sszBlock := legacyBlockVersion(dec)
+			require.Equal(t, blk.Version(), v)
+			enc, err := blk.MarshalSSZ()
+			require.NoError(t, err)
+			require.DeepEqual(t, enc, sszBlock)
+		}
+		return nil
+	}))
+
+	// Upgrading again re-encodes the blocks with their fork version.
+	require.NoError(t, db.migrateUp(ctx, schemaMigrations))
+	require.NoError(t, migrateBlockForkVersion(ctx, db.db))
+	for _, blk := range saved {
+		root, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		got, err := db.Block(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, blk.Version(), got.Version())
+	}
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		require.DeepEqual(t, migrationCompleted, tx.Bucket(migrationsBucket).Get(migrationBlockForkVersionKey))
+		return nil
+	}))
+}
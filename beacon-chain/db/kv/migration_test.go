@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestSchemaMigrations_Ordered(t *testing.T) {
+	for i, m := range schemaMigrations {
+		require.Equal(t, uint64(i+1), m.version, "migration %s has an unexpected version", m.name)
+		require.NotNil(t, m.up, "migration %s has no up function", m.name)
+	}
+}
+
+func TestStore_RunMigrations_SavesSchemaVersion(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	require.NoError(t, db.RunMigrations(ctx))
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, latestSchemaVersion(schemaMigrations), version)
+	// Migrations are not run again once the schema is up to date.
+	require.NoError(t, db.RunMigrations(ctx))
+}
+
+func TestStore_migrateUp(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	var ran []uint64
+	record := func(version uint64) migration {
+		return func(context.Context, *bolt.DB) error {
+			ran = append(ran, version)
+			return nil
+		}
+	}
+	migrations := []schemaMigration{
+		{version: 1, name: "one", up: record(1)},
+		{version: 2, name: "two", up: record(2)},
+		{version: 3, name: "three", up: record(3)},
+	}
+
+	require.NoError(t, db.saveSchemaVersion(1))
+	require.NoError(t, db.migrateUp(ctx, migrations))
+	require.DeepEqual(t, []uint64{2, 3}, ran)
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), version)
+
+	require.NoError(t, db.saveSchemaVersion(4))
+	require.ErrorContains(t, "newer than version 3", db.migrateUp(ctx, migrations))
+}
+
+func TestStore_migrateUp_Failure(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	migrations := []schemaMigration{
+		{version: 1, name: "one", up: func(context.Context, *bolt.DB) error { return nil }},
+		{version: 2, name: "two", up: func(context.Context, *bolt.DB) error { return errors.New("bad") }},
+	}
+	require.ErrorContains(t, "could not run database migration 2 (two): bad", db.migrateUp(ctx, migrations))
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
+
+func TestStore_migrateDown(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	var reverted []uint64
+	noop := func(context.Context, *bolt.DB) error { return nil }
+	revert := func(version uint64) migration {
+		return func(context.Context, *bolt.DB) error {
+			reverted = append(reverted, version)
+			return nil
+		}
+	}
+	migrations := []schemaMigration{
+		{version: 1, name: "one", up: noop},
+		{version: 2, name: "two", up: noop, down: revert(2)},
+		{version: 3, name: "three", up: noop, down: revert(3)},
+	}
+	require.NoError(t, db.migrateUp(ctx, migrations))
+
+	require.ErrorContains(t, "migration 1 (one) cannot be reverted", db.migrateDown(ctx, migrations, 0))
+	require.Equal(t, 0, len(reverted))
+
+	require.NoError(t, db.migrateDown(ctx, migrations, 1))
+	require.DeepEqual(t, []uint64{3, 2}, reverted)
+	version, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
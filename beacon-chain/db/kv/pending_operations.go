@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SavePendingOperations replaces the stored pending pool operations with the given ones. These are the
+// operations which were pending in the pools of the node when it was stopped.
+func (s *Store) SavePendingOperations(ctx context.Context, ops *ethpb.PendingOperations) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePendingOperations")
+	defer span.End()
+
+	if ops == nil {
+		ops = &ethpb.PendingOperations{}
+	}
+	enc, err := encode(ctx, ops)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(pendingOperationsBucket).Put(pendingOperationsKey, enc)
+	})
+}
+
+// PendingOperations retrieves the stored pending pool operations. An empty container is returned if
+// no operations were stored.
+func (s *Store) PendingOperations(ctx context.Context) (*ethpb.PendingOperations, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PendingOperations")
+	defer span.End()
+
+	ops := &ethpb.PendingOperations{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(pendingOperationsBucket).Get(pendingOperationsKey)
+		if enc == nil {
+			return nil
+		}
+		return decode(ctx, enc, ops)
+	})
+	return ops, err
+}
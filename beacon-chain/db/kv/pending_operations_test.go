@@ -0,0 +1,42 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_PendingOperations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	ops, err := db.PendingOperations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ops.Attestations))
+	assert.Equal(t, 0, len(ops.SyncCommitteeMessages))
+	assert.Equal(t, 0, len(ops.SyncCommitteeContributions))
+
+	want := &ethpb.PendingOperations{
+		Attestations: []*ethpb.Attestation{util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}})},
+		SyncCommitteeMessages: []*ethpb.SyncCommitteeMessage{
+			{Slot: 2, BlockRoot: make([]byte, 32), ValidatorIndex: 3, Signature: make([]byte, 96)},
+		},
+		SyncCommitteeContributions: []*ethpb.SyncCommitteeContribution{
+			{Slot: 4, BlockRoot: make([]byte, 32), SubcommitteeIndex: 1, AggregationBits: []byte{1}, Signature: make([]byte, 96)},
+		},
+	}
+	require.NoError(t, db.SavePendingOperations(ctx, want))
+	ops, err = db.PendingOperations(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want, ops)
+
+	// Saving replaces the previously stored operations.
+	require.NoError(t, db.SavePendingOperations(ctx, nil))
+	ops, err = db.PendingOperations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ops.Attestations))
+}
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposalAudit saves the audit trail of a block proposal, keyed by its slot. A stored audit of the
+// same slot is replaced.
+func (s *Store) SaveProposalAudit(ctx context.Context, audit *ethpb.ProposalAudit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProposalAudit")
+	defer span.End()
+
+	if audit == nil {
+		return errors.New("nil proposal audit")
+	}
+	enc, err := encode(ctx, audit)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(proposalAuditBucket)
+		return bkt.Put(bytesutil.SlotToBytesBigEndian(audit.Slot), enc)
+	})
+}
+
+// ProposalAudit retrieves the audit trail of the block proposal of the slot. It returns nil if no proposal
+// was audited for the slot.
+func (s *Store) ProposalAudit(ctx context.Context, slot types.Slot) (*ethpb.ProposalAudit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ProposalAudit")
+	defer span.End()
+
+	var audit *ethpb.ProposalAudit
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(proposalAuditBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		if enc == nil {
+			return nil
+		}
+		audit = &ethpb.ProposalAudit{}
+		return decode(ctx, enc, audit)
+	})
+	return audit, err
+}
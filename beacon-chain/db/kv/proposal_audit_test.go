@@ -0,0 +1,45 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_ProposalAudit(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	audit, err := db.ProposalAudit(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, audit == nil)
+
+	want := &ethpb.ProposalAudit{
+		Slot:          10,
+		ProposerIndex: 3,
+		Bids: []*ethpb.BuilderBidAudit{{
+			BuilderPubkey:  make([]byte, 48),
+			Value:          make([]byte, 32),
+			BlockHash:      make([]byte, 32),
+			Signature:      make([]byte, 96),
+			ReceivedTimeMs: 1000,
+			Selected:       true,
+		}},
+		BlockRoot: []byte{'a'},
+	}
+	require.NoError(t, db.SaveProposalAudit(ctx, want))
+	audit, err = db.ProposalAudit(ctx, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, audit)
+
+	// Saving replaces the stored audit of the slot.
+	want.Error = "could not broadcast block"
+	require.NoError(t, db.SaveProposalAudit(ctx, want))
+	audit, err = db.ProposalAudit(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, want.Error, audit.Error)
+	require.ErrorContains(t, "nil proposal audit", db.SaveProposalAudit(ctx, nil))
+}
@@ -20,6 +20,12 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
+	blsToExecChangesBucket  = []byte("bls-to-execution-changes")
+	proposalAuditBucket     = []byte("proposal-audit")
+	blobSidecarsBucket      = []byte("blob-sidecars")
+	pendingOperationsBucket = []byte("pending-operations")
+	// The signed validator registrations are refreshed to the builder relays.
+	signedRegistrationBucket = []byte("signed-registration")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -37,6 +43,10 @@ var (
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
 	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
+	blobSidecarSlotIndicesBucket        = []byte("blob-sidecar-slot-indices")
+
+	// Frozen blocks are moved to the freezer, leaving their slot behind to find them in the era files.
+	frozenBlockRootsBucket = []byte("frozen-block-roots")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -46,12 +56,17 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	pendingOperationsKey       = []byte("pending-operations")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
 	altairKey         = []byte("altair")
 	bellatrixKey      = []byte("merge")
 	bellatrixBlindKey = []byte("blind-bellatrix")
+	// Blocks are saved as snappy compressed SSZ, prefixed with forkVersionKey, the 4 byte fork version of
+	// the block and a byte telling whether the block is blinded. The keys above are only read from blocks
+	// saved before this encoding was introduced.
+	forkVersionKey = []byte("fv")
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
@@ -66,5 +81,7 @@ var (
 	newStateServiceCompatibleBucket = []byte("new-state-compatible")
 
 	// Migrations
-	migrationsBucket = []byte("migrations")
+	migrationsBucket    = []byte("migrations")
+	schemaVersionBucket = []byte("schema-version")
+	schemaVersionKey    = []byte("version")
 )
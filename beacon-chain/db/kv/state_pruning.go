@@ -0,0 +1,132 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneStates deletes the finalized states from the given slot up to the latest finalized
+// checkpoint which are not kept by the given state retention mode. The genesis state, the origin
+// checkpoint state and the states of the latest finalized and justified checkpoints are never
+// deleted. It returns the number of deleted states.
+func (s *Store) PruneStates(
+	ctx context.Context,
+	retention iface.StateRetention,
+	slotsPerArchivedPoint, fromSlot types.Slot,
+) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneStates")
+	defer span.End()
+
+	f, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finalizedSlot, err := slots.EpochStart(f.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	if fromSlot >= finalizedSlot {
+		return 0, nil
+	}
+	protected, err := s.protectedStateRoots(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+
+	deletedRoots := make([][32]byte, 0)
+	err = s.db.View(func(tx *bolt.Tx) error {
+		finalizedRoots := tx.Bucket(finalizedBlockRootsIndexBucket)
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(fromSlot)); k != nil; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slot := bytesutil.BytesToSlotBigEndian(k)
+			if slot >= finalizedSlot {
+				return nil
+			}
+			// Several states may be indexed at the same slot, each root takes 32 bytes.
+			for i := 0; i+32 <= len(v); i += 32 {
+				root := bytesutil.ToBytes32(v[i : i+32])
+				if protected[root] {
+					continue
+				}
+				canonical := finalizedRoots.Get(root[:]) != nil
+				if retainState(retention, slot, canonical, slotsPerArchivedPoint) {
+					continue
+				}
+				deletedRoots = append(deletedRoots, root)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(deletedRoots) == 0 {
+		return 0, nil
+	}
+	if err := s.DeleteStates(ctx, deletedRoots); err != nil {
+		return 0, errors.Wrap(err, "could not delete pruned states")
+	}
+	return len(deletedRoots), nil
+}
+
+// protectedStateRoots returns the block roots of the states which must never be pruned.
+func (s *Store) protectedStateRoots(ctx context.Context, finalized *ethpb.Checkpoint) (map[[32]byte]bool, error) {
+	protected := make(map[[32]byte]bool)
+	protected[bytesutil.ToBytes32(finalized.Root)] = true
+	j, err := s.JustifiedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protected[bytesutil.ToBytes32(j.Root)] = true
+
+	genesisRoot, err := s.GenesisBlockRoot(ctx)
+	switch {
+	case errors.Is(err, ErrNotFoundGenesisBlockRoot):
+	case err != nil:
+		return nil, err
+	default:
+		protected[genesisRoot] = true
+	}
+	originRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case errors.Is(err, ErrNotFoundOriginBlockRoot):
+	case err != nil:
+		return nil, err
+	default:
+		protected[originRoot] = true
+	}
+	return protected, nil
+}
+
+// retainState returns true if a finalized state of the given slot is kept by the state retention mode.
+func retainState(retention iface.StateRetention, slot types.Slot, canonical bool, slotsPerArchivedPoint types.Slot) bool {
+	if retention == iface.MinimalStateRetention {
+		return false
+	}
+	saved := slots.IsEpochStart(slot) || onArchivedPoint(slot, slotsPerArchivedPoint)
+	if retention == iface.ArchivalStateRetention {
+		return saved
+	}
+	return saved && canonical
+}
+
+// onArchivedPoint returns true if a state of the given slot is considered to be on an archive point.
+// Like in CleanUpDirtyStates, states right before an archive point are kept to tolerate skip slots.
+func onArchivedPoint(slot, slotsPerArchivedPoint types.Slot) bool {
+	if slotsPerArchivedPoint == 0 {
+		return false
+	}
+	mod := slot % slotsPerArchivedPoint
+	return mod == 0 || mod > slotsPerArchivedPoint-slotsPerArchivedPoint/3
+}
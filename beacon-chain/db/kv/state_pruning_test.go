@@ -0,0 +1,132 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// setupPruningDB saves a canonical chain with a state at every slot up to the start of epoch 3,
+// finalizes epoch 2 and adds a state for a non canonical block at the start of epoch 1.
+func setupPruningDB(t *testing.T) (*Store, map[types.Slot][32]byte, [32]byte, [32]byte) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	genesisState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := make(map[types.Slot][32]byte)
+	prevRoot := genesisRoot
+	for i := types.Slot(1); i <= 3*slotsPerEpoch; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(prevRoot[:])
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(i))
+		require.NoError(t, db.SaveState(ctx, st, r))
+		roots[i] = r
+		prevRoot = r
+	}
+
+	fork := util.NewBeaconBlock()
+	fork.Block.Slot = slotsPerEpoch
+	fork.Block.ParentRoot = genesisRoot[:]
+	fork.Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+	forkRoot, err := fork.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := wrapper.WrappedSignedBeaconBlock(fork)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	require.NoError(t, db.SaveState(ctx, st, forkRoot))
+
+	finalizedRoot := roots[2*slotsPerEpoch]
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: finalizedRoot[:], Epoch: 2}))
+	return db, roots, forkRoot, genesisRoot
+}
+
+func TestStore_PruneStates(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// Archive points fall at slots 48, 96..., with slots 33 to 47 kept to tolerate skip slots.
+	slotsPerArchivedPoint := slotsPerEpoch + slotsPerEpoch/2
+
+	tests := []struct {
+		name      string
+		retention iface.StateRetention
+		kept      []types.Slot
+		deleted   []types.Slot
+		keepFork  bool
+	}{
+		{
+			name:      "minimal",
+			retention: iface.MinimalStateRetention,
+			kept:      []types.Slot{2 * slotsPerEpoch, 2*slotsPerEpoch + 1, 3 * slotsPerEpoch},
+			deleted:   []types.Slot{1, slotsPerEpoch, slotsPerEpoch + 1, slotsPerArchivedPoint, 2*slotsPerEpoch - 1},
+		},
+		{
+			name:      "default",
+			retention: iface.DefaultStateRetention,
+			kept:      []types.Slot{slotsPerEpoch, slotsPerEpoch + 1, slotsPerArchivedPoint, 2 * slotsPerEpoch, 2*slotsPerEpoch + 1},
+			deleted:   []types.Slot{1, slotsPerEpoch - 1, slotsPerArchivedPoint + 1, 2*slotsPerEpoch - 1},
+		},
+		{
+			name:      "archival",
+			retention: iface.ArchivalStateRetention,
+			kept:      []types.Slot{slotsPerEpoch, slotsPerEpoch + 1, slotsPerArchivedPoint, 2 * slotsPerEpoch},
+			deleted:   []types.Slot{1, slotsPerEpoch - 1, slotsPerArchivedPoint + 1, 2*slotsPerEpoch - 1},
+			keepFork:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, roots, forkRoot, genesisRoot := setupPruningDB(t)
+			count, err := db.PruneStates(ctx, tt.retention, slotsPerArchivedPoint, 0)
+			require.NoError(t, err)
+			require.NotEqual(t, 0, count)
+
+			require.Equal(t, true, db.HasState(ctx, genesisRoot))
+			require.Equal(t, tt.keepFork, db.HasState(ctx, forkRoot))
+			for _, slot := range tt.kept {
+				require.Equal(t, true, db.HasState(ctx, roots[slot]), "state at slot %d was pruned", slot)
+			}
+			for _, slot := range tt.deleted {
+				require.Equal(t, false, db.HasState(ctx, roots[slot]), "state at slot %d was not pruned", slot)
+			}
+		})
+	}
+}
+
+func TestStore_PruneStates_FromSlot(t *testing.T) {
+	ctx := context.Background()
+	db, roots, forkRoot, _ := setupPruningDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	count, err := db.PruneStates(ctx, iface.MinimalStateRetention, slotsPerEpoch, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, int(slotsPerEpoch-1), count)
+	require.Equal(t, true, db.HasState(ctx, forkRoot))
+	require.Equal(t, true, db.HasState(ctx, roots[slotsPerEpoch]))
+	require.Equal(t, false, db.HasState(ctx, roots[slotsPerEpoch+1]))
+	require.Equal(t, false, db.HasState(ctx, roots[2*slotsPerEpoch-1]))
+}
@@ -0,0 +1,97 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveSignedRegistrationsByValidatorIDs saves the signed validator registrations for validator ids, along
+// with their messages used by the fee recipient and registration getters. A registration is not replaced
+// by one with an older timestamp, so that relays are always refreshed with the latest registration.
+// Error is returned if `ids` and `regs` are not the same length.
+func (s *Store) SaveSignedRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.SignedValidatorRegistrationV1) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveSignedRegistrationsByValidatorIDs")
+	defer span.End()
+
+	if len(ids) != len(regs) {
+		return errors.New("ids and registrations must be the same length")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		signedBkt := tx.Bucket(signedRegistrationBucket)
+		bkt := tx.Bucket(registrationBucket)
+		for i, id := range ids {
+			reg := regs[i]
+			if reg == nil || reg.Message == nil {
+				continue
+			}
+			key := bytesutil.Uint64ToBytesBigEndian(uint64(id))
+			if enc := signedBkt.Get(key); enc != nil {
+				existing := &ethpb.SignedValidatorRegistrationV1{}
+				if err := decode(ctx, enc, existing); err != nil {
+					return err
+				}
+				if existing.Message.Timestamp > reg.Message.Timestamp {
+					continue
+				}
+			}
+			enc, err := encode(ctx, reg)
+			if err != nil {
+				return err
+			}
+			if err := signedBkt.Put(key, enc); err != nil {
+				return err
+			}
+			enc, err = encode(ctx, reg.Message)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(key, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SignedRegistrationByValidatorID returns the signed validator registration for a validator id.
+// `ErrNotFoundRegistration` is returned if the validator id is not found.
+func (s *Store) SignedRegistrationByValidatorID(ctx context.Context, id types.ValidatorIndex) (*ethpb.SignedValidatorRegistrationV1, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SignedRegistrationByValidatorID")
+	defer span.End()
+
+	reg := &ethpb.SignedValidatorRegistrationV1{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(signedRegistrationBucket).Get(bytesutil.Uint64ToBytesBigEndian(uint64(id)))
+		if enc == nil {
+			return errors.Wrapf(ErrNotFoundRegistration, "validator id %d", id)
+		}
+		return decode(ctx, enc, reg)
+	})
+	return reg, err
+}
+
+// SignedRegistrations retrieves all the stored signed validator registrations, ordered by validator index.
+func (s *Store) SignedRegistrations(ctx context.Context) ([]*ethpb.SignedValidatorRegistrationV1, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SignedRegistrations")
+	defer span.End()
+
+	regs := make([]*ethpb.SignedValidatorRegistrationV1, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(signedRegistrationBucket).ForEach(func(_, enc []byte) error {
+			reg := &ethpb.SignedValidatorRegistrationV1{}
+			if err := decode(ctx, enc, reg); err != nil {
+				return err
+			}
+			regs = append(regs, reg)
+			return nil
+		})
+	})
+	return regs, err
+}
@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_SignedRegistrations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	newReg := func(feeRecipient byte, timestamp uint64) *ethpb.SignedValidatorRegistrationV1 {
+		return &ethpb.SignedValidatorRegistrationV1{
+			Message: &ethpb.ValidatorRegistrationV1{
+				FeeRecipient: append([]byte{feeRecipient}, make([]byte, 19)...),
+				GasLimit:     30000000,
+				Timestamp:    timestamp,
+				Pubkey:       make([]byte, 48),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+
+	_, err := db.SignedRegistrationByValidatorID(ctx, 0)
+	require.ErrorIs(t, err, ErrNotFoundRegistration)
+	require.ErrorContains(t, "ids and registrations must be the same length",
+		db.SaveSignedRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{0, 1}, []*ethpb.SignedValidatorRegistrationV1{newReg(1, 10)}))
+
+	require.NoError(t, db.SaveSignedRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{2, 1}, []*ethpb.SignedValidatorRegistrationV1{newReg(2, 10), newReg(1, 10)}))
+	reg, err := db.SignedRegistrationByValidatorID(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, newReg(1, 10), reg)
+	// The message of the registration is saved for the fee recipient and registration getters.
+	msg, err := db.RegistrationByValidatorID(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, newReg(2, 10).Message, msg)
+
+	// Older registrations do not replace newer ones.
+	require.NoError(t, db.SaveSignedRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{1, 2}, []*ethpb.SignedValidatorRegistrationV1{newReg(3, 9), newReg(4, 11)}))
+	regs, err := db.SignedRegistrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(regs))
+	assert.DeepEqual(t, newReg(1, 10), regs[0])
+	assert.DeepEqual(t, newReg(4, 11), regs[1])
+	msg, err = db.RegistrationByValidatorID(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, newReg(1, 10).Message, msg)
+}
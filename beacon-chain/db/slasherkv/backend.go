@@ -0,0 +1,115 @@
+package slasherkv
+
+import (
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Backend names an ordered key-value engine the slasher database can be stored in.
+type Backend string
+
+const (
+	// BoltBackend stores the slasher database in a BoltDB B+tree, which favors reads.
+	BoltBackend Backend = "bolt"
+	// LevelDBBackend stores the slasher database in a LevelDB log-structured merge tree, which
+	// favors the write-heavy workload of the min and max span chunks with large validator counts.
+	LevelDBBackend Backend = "leveldb"
+)
+
+// ParseBackend returns the backend of the given name, defaulting to BoltDB when empty.
+func ParseBackend(name string) (Backend, error) {
+	switch Backend(name) {
+	case "", BoltBackend:
+		return BoltBackend, nil
+	case LevelDBBackend:
+		return LevelDBBackend, nil
+	default:
+		return "", fmt.Errorf("unknown slasher database backend %q, want one of %q or %q", name, BoltBackend, LevelDBBackend)
+	}
+}
+
+// kvBackend is the ordered key-value engine underlying the slasher database. Its transactions
+// follow the semantics of BoltDB: a view is a consistent read-only snapshot, and an update is
+// atomically applied once its function returns without error.
+type kvBackend interface {
+	View(fn func(tx kvTx) error) error
+	Update(fn func(tx kvTx) error) error
+	Close() error
+}
+
+// kvTx is a transaction of a kvBackend.
+type kvTx interface {
+	Bucket(name []byte) kvBucket
+}
+
+// kvBucket is a keyspace of a kvBackend, iterated in the byte order of its keys.
+// The keys and values it returns are only valid for the lifetime of the transaction.
+// Get returns a nil value without error for a missing key.
+//
+// Unlike BoltDB, a cursor opened inside an update may not see the writes made earlier in that
+// update. Callers may delete the key a cursor is at, but must not expect the cursor to observe
+// their own writes.
+type kvBucket interface {
+	Get(key []byte) ([]byte, error)
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	Cursor() kvCursor
+}
+
+// kvCursor iterates over the keys of a kvBucket, returning a nil key once exhausted.
+type kvCursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Prev() (key, value []byte)
+}
+
+// boltBackend is a kvBackend storing each bucket in a BoltDB bucket.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+func (b *boltBackend) View(fn func(tx kvTx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) Update(fn func(tx kvTx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) kvBucket {
+	return &boltBucket{bkt: t.tx.Bucket(name)}
+}
+
+type boltBucket struct {
+	bkt *bolt.Bucket
+}
+
+func (b *boltBucket) Get(key []byte) ([]byte, error) {
+	return b.bkt.Get(key), nil
+}
+
+func (b *boltBucket) Put(key, value []byte) error {
+	return b.bkt.Put(key, value)
+}
+
+func (b *boltBucket) Delete(key []byte) error {
+	return b.bkt.Delete(key)
+}
+
+func (b *boltBucket) Cursor() kvCursor {
+	return b.bkt.Cursor()
+}
@@ -1,5 +1,5 @@
-// Package slasherkv defines a bolt-db, key-value store implementation
-// of the slasher database interface for Prysm.
+// Package slasherkv defines a key-value store implementation of the slasher
+// database interface for Prysm, backed by either BoltDB or LevelDB.
 package slasherkv
 
 import (
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/syndtr/goleveldb/leveldb/storage"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -20,24 +21,29 @@ var _ iface.SlasherDatabase = (*Store)(nil)
 const (
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "slasher.db"
-	boltAllocSize    = 8 * 1024 * 1024
+	// LevelDBDirName is the name of the directory of the beacon node database stored in LevelDB.
+	LevelDBDirName = "slasher-leveldb"
+	boltAllocSize  = 8 * 1024 * 1024
 )
 
-// Config for the bolt db kv store.
+// Config for the kv store.
 type Config struct {
 	InitialMMapSize int
+	// Backend is the key-value engine of the store, BoltDB if unset.
+	Backend Backend
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum consensus.
+// using BoltDB or LevelDB as the underlying persistent kv-store for Ethereum consensus.
 type Store struct {
-	db           *bolt.DB
+	db           kvBackend
+	backend      Backend
 	databasePath string
 	ctx          context.Context
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
-// path specified, creates the kv-buckets based on the schema, and stores
+// NewKVStore initializes a new key-value store of the configured backend at the
+// directory path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
 	hasDir, err := file.HasDir(dirPath)
@@ -49,6 +55,21 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
+	if config.Backend == LevelDBBackend {
+		ldb, err := openLevelDB(path.Join(dirPath, LevelDBDirName))
+		if err != nil {
+			if errors.Is(err, storage.ErrLocked) {
+				return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+			}
+			return nil, err
+		}
+		return &Store{
+			db:           ldb,
+			backend:      LevelDBBackend,
+			databasePath: dirPath,
+			ctx:          ctx,
+		}, nil
+	}
 	datafile := path.Join(dirPath, DatabaseFileName)
 	boltDB, err := bolt.Open(
 		datafile,
@@ -66,12 +87,13 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 	boltDB.AllocSize = boltAllocSize
 	kv := &Store{
-		db:           boltDB,
+		db:           &boltBackend{db: boltDB},
+		backend:      BoltBackend,
 		databasePath: dirPath,
 		ctx:          ctx,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
 			// Slasher buckets.
@@ -93,13 +115,19 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
+	if s.backend == LevelDBBackend {
+		if err := os.RemoveAll(path.Join(s.databasePath, LevelDBDirName)); err != nil {
+			return errors.Wrap(err, "could not remove database directory")
+		}
+		return nil
+	}
 	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
 	return s.db.Close()
 }
@@ -0,0 +1,187 @@
+package slasherkv
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var errReadOnlyTx = errors.New("cannot write in a read-only transaction")
+
+// levelDBBackend is a kvBackend storing all buckets in a single LevelDB keyspace, the keys of
+// each bucket being prefixed with the length and the name of the bucket.
+//
+// An update reads from a snapshot of the database taken when it begins, and buffers its writes
+// in a batch which is atomically written once it returns. The values it writes are visible to
+// its own Get calls, but not to the cursors it opens. Updates are serialized so that no write
+// is lost between the snapshot and the batch of an update.
+type levelDBBackend struct {
+	db       *leveldb.DB
+	updateMu sync.Mutex
+}
+
+func openLevelDB(dirPath string) (*levelDBBackend, error) {
+	db, err := leveldb.OpenFile(dirPath, &opt.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &levelDBBackend{db: db}, nil
+}
+
+func (b *levelDBBackend) View(fn func(tx kvTx) error) error {
+	snap, err := b.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelDBTx{reader: snap}
+	defer tx.release()
+	return fn(tx)
+}
+
+func (b *levelDBBackend) Update(fn func(tx kvTx) error) error {
+	b.updateMu.Lock()
+	defer b.updateMu.Unlock()
+	snap, err := b.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelDBTx{
+		reader:  snap,
+		batch:   new(leveldb.Batch),
+		pending: make(map[string][]byte),
+	}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return b.db.Write(tx.batch, nil)
+}
+
+func (b *levelDBBackend) Close() error {
+	return b.db.Close()
+}
+
+// levelDBReader is implemented by both snapshots and databases of LevelDB.
+type levelDBReader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+type levelDBTx struct {
+	reader levelDBReader
+	// The writes of the transaction, nil for a read-only one. Pending maps each written key to
+	// its value, or to nil if it was deleted.
+	batch     *leveldb.Batch
+	pending   map[string][]byte
+	iterators []iterator.Iterator
+}
+
+func (t *levelDBTx) Bucket(name []byte) kvBucket {
+	prefix := make([]byte, 0, len(name)+1)
+	prefix = append(prefix, byte(len(name)))
+	prefix = append(prefix, name...)
+	return &levelDBBucket{tx: t, prefix: prefix}
+}
+
+func (t *levelDBTx) release() {
+	for _, it := range t.iterators {
+		it.Release()
+	}
+}
+
+type levelDBBucket struct {
+	tx     *levelDBTx
+	prefix []byte
+}
+
+func (b *levelDBBucket) key(key []byte) []byte {
+	k := make([]byte, 0, len(b.prefix)+len(key))
+	k = append(k, b.prefix...)
+	return append(k, key...)
+}
+
+func (b *levelDBBucket) Get(key []byte) ([]byte, error) {
+	k := b.key(key)
+	if b.tx.pending != nil {
+		if v, ok := b.tx.pending[string(k)]; ok {
+			return v, nil
+		}
+	}
+	v, err := b.tx.reader.Get(k, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read from slasher database")
+	}
+	return v, nil
+}
+
+func (b *levelDBBucket) Put(key, value []byte) error {
+	if b.tx.batch == nil {
+		return errReadOnlyTx
+	}
+	k := b.key(key)
+	v := make([]byte, len(value))
+	copy(v, value)
+	b.tx.batch.Put(k, v)
+	b.tx.pending[string(k)] = v
+	return nil
+}
+
+func (b *levelDBBucket) Delete(key []byte) error {
+	if b.tx.batch == nil {
+		return errReadOnlyTx
+	}
+	k := b.key(key)
+	b.tx.batch.Delete(k)
+	b.tx.pending[string(k)] = nil
+	return nil
+}
+
+func (b *levelDBBucket) Cursor() kvCursor {
+	it := b.tx.reader.NewIterator(util.BytesPrefix(b.prefix), nil)
+	b.tx.iterators = append(b.tx.iterators, it)
+	return &levelDBCursor{it: it, prefixLen: len(b.prefix)}
+}
+
+type levelDBCursor struct {
+	it        iterator.Iterator
+	prefixLen int
+}
+
+func (c *levelDBCursor) First() (key, value []byte) {
+	return c.current(c.it.First())
+}
+
+func (c *levelDBCursor) Last() (key, value []byte) {
+	return c.current(c.it.Last())
+}
+
+func (c *levelDBCursor) Next() (key, value []byte) {
+	return c.current(c.it.Next())
+}
+
+func (c *levelDBCursor) Prev() (key, value []byte) {
+	return c.current(c.it.Prev())
+}
+
+// current returns copies of the key, stripped of the bucket prefix, and of the value the
+// iterator is at, as the iterator reuses its buffers when it moves.
+func (c *levelDBCursor) current(ok bool) (key, value []byte) {
+	if !ok {
+		return nil, nil
+	}
+	k := c.it.Key()[c.prefixLen:]
+	key = make([]byte, len(k))
+	copy(key, k)
+	value = make([]byte, len(c.it.Value()))
+	copy(value, c.it.Value())
+	return key, value
+}
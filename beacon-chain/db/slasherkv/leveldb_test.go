@@ -0,0 +1,138 @@
+package slasherkv
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+func setupLevelDB(t testing.TB) *Store {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{Backend: LevelDBBackend})
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+func TestParseBackend(t *testing.T) {
+	b, err := ParseBackend("")
+	require.NoError(t, err)
+	assert.Equal(t, BoltBackend, b)
+	b, err = ParseBackend("leveldb")
+	require.NoError(t, err)
+	assert.Equal(t, LevelDBBackend, b)
+	_, err = ParseBackend("pebble")
+	assert.ErrorContains(t, "unknown slasher database backend", err)
+}
+
+func TestLevelDBBackend_Transactions(t *testing.T) {
+	db := setupLevelDB(t)
+	require.NoError(t, db.db.Update(func(tx kvTx) error {
+		bkt := tx.Bucket(slasherChunksBucket)
+		for _, k := range []string{"b", "a", "c"} {
+			if err := bkt.Put([]byte(k), []byte(k)); err != nil {
+				return err
+			}
+		}
+		// Writes are visible to the reads of the update.
+		v, err := bkt.Get([]byte("a"))
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte("a"), v)
+		require.NoError(t, bkt.Delete([]byte("a")))
+		v, err = bkt.Get([]byte("a"))
+		require.NoError(t, err)
+		assert.Equal(t, true, v == nil)
+		// Other buckets do not see the keys.
+		v, err = tx.Bucket(proposalRecordsBucket).Get([]byte("b"))
+		require.NoError(t, err)
+		assert.Equal(t, true, v == nil)
+		return nil
+	}))
+
+	require.NoError(t, db.db.View(func(tx kvTx) error {
+		bkt := tx.Bucket(slasherChunksBucket)
+		assert.Equal(t, errReadOnlyTx, bkt.Put([]byte("d"), []byte("d")))
+		var keys []string
+		c := bkt.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.DeepEqual(t, k, v)
+			keys = append(keys, string(k))
+		}
+		assert.DeepEqual(t, []string{"b", "c"}, keys)
+		keys = nil
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			keys = append(keys, string(k))
+		}
+		assert.DeepEqual(t, []string{"c", "b"}, keys)
+		k, _ := tx.Bucket(proposalRecordsBucket).Cursor().First()
+		assert.Equal(t, true, k == nil)
+		return nil
+	}))
+}
+
+type failingReader struct {
+	levelDBReader
+}
+
+func (failingReader) Get(_ []byte, _ *opt.ReadOptions) ([]byte, error) {
+	return nil, leveldb.ErrClosed
+}
+
+func TestLevelDBBackend_GetError(t *testing.T) {
+	tx := &levelDBTx{reader: failingReader{}}
+	_, err := tx.Bucket(slasherChunksBucket).Get([]byte("a"))
+	assert.ErrorContains(t, "could not read from slasher database", err)
+}
+
+func TestStore_LevelDBBackend(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, err := NewKVStore(ctx, t.TempDir(), &Config{Backend: LevelDBBackend})
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveAttestationRecordsForValidators(ctx, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(1, 2, []uint64{0, 1}, []byte{1}),
+		createAttestationWrapper(2, 3, []uint64{0}, []byte{2}),
+	}))
+
+	doubleVotes, err := beaconDB.CheckAttesterDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(2, 3, []uint64{0}, []byte{3}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	assert.Equal(t, types.ValidatorIndex(0), doubleVotes[0].ValidatorIndex)
+
+	highest, err := beaconDB.HighestAttestations(ctx, []types.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(highest))
+	assert.DeepEqual(t, &ethpb.HighestAttestation{ValidatorIndex: 0, HighestSourceEpoch: 2, HighestTargetEpoch: 3}, highest[0])
+	assert.DeepEqual(t, &ethpb.HighestAttestation{ValidatorIndex: 1, HighestSourceEpoch: 1, HighestTargetEpoch: 2}, highest[1])
+
+	numPruned, err := beaconDB.PruneAttestationsAtEpoch(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), numPruned)
+	record, err := beaconDB.AttestationRecordForValidator(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, record == nil)
+	record, err = beaconDB.AttestationRecordForValidator(ctx, 0, 3)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+
+	require.NoError(t, beaconDB.Close())
+	require.NoError(t, beaconDB.ClearDB())
+	_, err = os.Stat(path.Join(beaconDB.DatabasePath(), LevelDBDirName))
+	assert.Equal(t, true, os.IsNotExist(err))
+	beaconDB, err = NewKVStore(ctx, beaconDB.DatabasePath(), &Config{Backend: LevelDBBackend})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, beaconDB.Close())
+	})
+}
@@ -8,7 +8,6 @@ import (
 	fssz "github.com/prysmaticlabs/fastssz"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/time/slots"
-	bolt "go.etcd.io/bbolt"
 )
 
 // PruneAttestationsAtEpoch deletes all attestations from the slasher DB with target epoch
@@ -22,7 +21,7 @@ func (s *Store) PruneAttestationsAtEpoch(
 	// We retrieve the lowest stored epoch in the attestations bucket.
 	var lowestEpoch types.Epoch
 	var hasData bool
-	if err = s.db.View(func(tx *bolt.Tx) error {
+	if err = s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(attestationDataRootsBucket)
 		c := bkt.Cursor()
 		k, _ := c.First()
@@ -48,7 +47,7 @@ func (s *Store) PruneAttestationsAtEpoch(
 		return
 	}
 
-	if err = s.db.Update(func(tx *bolt.Tx) error {
+	if err = s.db.Update(func(tx kvTx) error {
 		signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
 		attRecordsBkt := tx.Bucket(attestationRecordsBucket)
 		c := signingRootsBkt.Cursor()
@@ -97,7 +96,7 @@ func (s *Store) PruneProposalsAtEpoch(
 	// We retrieve the lowest stored slot in the proposals bucket.
 	var lowestSlot types.Slot
 	var hasData bool
-	if err = s.db.View(func(tx *bolt.Tx) error {
+	if err = s.db.View(func(tx kvTx) error {
 		proposalBkt := tx.Bucket(proposalRecordsBucket)
 		c := proposalBkt.Cursor()
 		k, _ := c.First()
@@ -123,7 +122,7 @@ func (s *Store) PruneProposalsAtEpoch(
 		return
 	}
 
-	if err = s.db.Update(func(tx *bolt.Tx) error {
+	if err = s.db.Update(func(tx kvTx) error {
 		proposalBkt := tx.Bucket(proposalRecordsBucket)
 		c := proposalBkt.Cursor()
 		// We begin a pruning iteration starting from the first item in the bucket.
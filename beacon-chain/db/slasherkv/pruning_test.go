@@ -11,7 +11,6 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
-	bolt "go.etcd.io/bbolt"
 )
 
 func TestStore_PruneProposalsAtEpoch(t *testing.T) {
@@ -32,7 +31,7 @@ func TestStore_PruneProposalsAtEpoch(t *testing.T) {
 		lowestStoredSlot, err := slots.EpochEnd(pruningLimitEpoch)
 		require.NoError(t, err)
 
-		err = beaconDB.db.Update(func(tx *bolt.Tx) error {
+		err = beaconDB.db.Update(func(tx kvTx) error {
 			bkt := tx.Bucket(proposalRecordsBucket)
 			key, err := keyForValidatorProposal(lowestStoredSlot+1, 0 /* proposer index */)
 			if err != nil {
@@ -86,7 +85,7 @@ func TestStore_PruneProposalsAtEpoch(t *testing.T) {
 
 		// Everything before epoch 10 should be deleted.
 		for i := types.Epoch(0); i < pruningLimitEpoch; i++ {
-			err = beaconDB.db.View(func(tx *bolt.Tx) error {
+			err = beaconDB.db.View(func(tx kvTx) error {
 				bkt := tx.Bucket(proposalRecordsBucket)
 				startSlot, err := slots.EpochStart(i)
 				require.NoError(t, err)
@@ -101,10 +100,14 @@ func TestStore_PruneProposalsAtEpoch(t *testing.T) {
 					if err != nil {
 						return err
 					}
-					if bkt.Get(prop1Key) != nil {
+					if v, err := bkt.Get(prop1Key); err != nil {
+						return err
+					} else if v != nil {
 						return fmt.Errorf("proposal still exists for epoch %d, validator 0", j)
 					}
-					if bkt.Get(prop2Key) != nil {
+					if v, err := bkt.Get(prop2Key); err != nil {
+						return err
+					} else if v != nil {
 						return fmt.Errorf("proposal still exists for slot %d, validator 1", j)
 					}
 				}
@@ -132,7 +135,7 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 		pruningLimitEpoch := currentEpoch - historyLength
 		lowestStoredEpoch := pruningLimitEpoch
 
-		err := beaconDB.db.Update(func(tx *bolt.Tx) error {
+		err := beaconDB.db.Update(func(tx kvTx) error {
 			bkt := tx.Bucket(attestationDataRootsBucket)
 			encIdx := encodeValidatorIndex(types.ValidatorIndex(0))
 			encodedTargetEpoch := encodeTargetEpoch(lowestStoredEpoch + 1)
@@ -192,7 +195,7 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 
 		// Everything before epoch 10 should be deleted.
 		for i := types.Epoch(0); i < pruningLimitEpoch; i++ {
-			err = beaconDB.db.View(func(tx *bolt.Tx) error {
+			err = beaconDB.db.View(func(tx kvTx) error {
 				bkt := tx.Bucket(attestationDataRootsBucket)
 				startSlot, err := slots.EpochStart(i)
 				require.NoError(t, err)
@@ -203,10 +206,14 @@ func TestStore_PruneAttestations_OK(t *testing.T) {
 					attester2 := types.ValidatorIndex(j + 11)
 					key1 := append(encodeTargetEpoch(i), encodeValidatorIndex(attester1)...)
 					key2 := append(encodeTargetEpoch(i), encodeValidatorIndex(attester2)...)
-					if bkt.Get(key1) != nil {
+					if v, err := bkt.Get(key1); err != nil {
+						return err
+					} else if v != nil {
 						return fmt.Errorf("still exists for epoch %d, validator %d", i, attester1)
 					}
-					if bkt.Get(key2) != nil {
+					if v, err := bkt.Get(key2); err != nil {
+						return err
+					} else if v != nil {
 						return fmt.Errorf("still exists for slot %d, validator %d", i, attester2)
 					}
 				}
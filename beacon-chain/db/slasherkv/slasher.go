@@ -15,7 +15,6 @@ import (
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 	"golang.org/x/sync/errgroup"
 )
@@ -38,11 +37,14 @@ func (s *Store) LastEpochWrittenForValidators(
 	for i, valIdx := range validatorIndices {
 		encodedIndices[i] = encodeValidatorIndex(valIdx)
 	}
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(attestedEpochsByValidator)
 		for i, encodedIndex := range encodedIndices {
 			var epoch types.Epoch
-			epochBytes := bkt.Get(encodedIndex)
+			epochBytes, err := bkt.Get(encodedIndex)
+			if err != nil {
+				return err
+			}
 			if epochBytes != nil {
 				if err := epoch.UnmarshalSSZ(epochBytes); err != nil {
 					return err
@@ -85,7 +87,7 @@ func (s *Store) SaveLastEpochsWrittenForValidators(
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		if err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := s.db.Update(func(tx kvTx) error {
 			if ctx.Err() != nil {
 				return ctx.Err()
 			}
@@ -126,7 +128,7 @@ func (s *Store) CheckAttesterDoubleVotes(
 		attToProcess := att
 		// process every attestation parallelly.
 		eg.Go(func() error {
-			err := s.db.View(func(tx *bolt.Tx) error {
+			err := s.db.View(func(tx kvTx) error {
 				signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
 				attRecordsBkt := tx.Bucket(attestationRecordsBucket)
 				encEpoch := encodeTargetEpoch(attToProcess.IndexedAttestation.Data.Target.Epoch)
@@ -134,12 +136,18 @@ func (s *Store) CheckAttesterDoubleVotes(
 				for _, valIdx := range attToProcess.IndexedAttestation.AttestingIndices {
 					encIdx := encodeValidatorIndex(types.ValidatorIndex(valIdx))
 					validatorEpochKey := append(encEpoch, encIdx...)
-					attRecordsKey := signingRootsBkt.Get(validatorEpochKey)
+					attRecordsKey, err := signingRootsBkt.Get(validatorEpochKey)
+					if err != nil {
+						return err
+					}
 					// An attestation record key is comprised of a signing root (32 bytes).
 					if len(attRecordsKey) < attestationRecordKeySize {
 						continue
 					}
-					encExistingAttRecord := attRecordsBkt.Get(attRecordsKey)
+					encExistingAttRecord, err := attRecordsBkt.Get(attRecordsKey)
+					if err != nil {
+						return err
+					}
 					if encExistingAttRecord == nil {
 						continue
 					}
@@ -189,14 +197,20 @@ func (s *Store) AttestationRecordForValidator(
 	encIdx := encodeValidatorIndex(validatorIdx)
 	encEpoch := encodeTargetEpoch(targetEpoch)
 	key := append(encEpoch, encIdx...)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
-		attRecordKey := signingRootsBkt.Get(key)
+		attRecordKey, err := signingRootsBkt.Get(key)
+		if err != nil {
+			return err
+		}
 		if attRecordKey == nil {
 			return nil
 		}
 		attRecordsBkt := tx.Bucket(attestationRecordsBucket)
-		indexedAttBytes := attRecordsBkt.Get(attRecordKey)
+		indexedAttBytes, err := attRecordsBkt.Get(attRecordKey)
+		if err != nil {
+			return err
+		}
 		if indexedAttBytes == nil {
 			return nil
 		}
@@ -235,7 +249,7 @@ func (s *Store) SaveAttestationRecordsForValidators(
 		encodedTargetEpoch[i] = encEpoch
 		encodedRecords[i] = value
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx kvTx) error {
 		attRecordsBkt := tx.Bucket(attestationRecordsBucket)
 		signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
 		for i, att := range attestations {
@@ -263,11 +277,14 @@ func (s *Store) LoadSlasherChunks(
 	defer span.End()
 	chunks := make([][]uint16, 0)
 	var exists []bool
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(slasherChunksBucket)
 		for _, diskKey := range diskKeys {
 			key := append(ssz.MarshalUint8(make([]byte, 0), uint8(kind)), diskKey...)
-			chunkBytes := bkt.Get(key)
+			chunkBytes, err := bkt.Get(key)
+			if err != nil {
+				return err
+			}
 			if chunkBytes == nil {
 				chunks = append(chunks, []uint16{})
 				exists = append(exists, false)
@@ -302,7 +319,7 @@ func (s *Store) SaveSlasherChunks(
 		}
 		encodedChunks[i] = encodedChunk
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx kvTx) error {
 		bkt := tx.Bucket(slasherChunksBucket)
 		for i := 0; i < len(chunkKeys); i++ {
 			if err := bkt.Put(encodedKeys[i], encodedChunks[i]); err != nil {
@@ -323,7 +340,7 @@ func (s *Store) CheckDoubleBlockProposals(
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.CheckDoubleBlockProposals")
 	defer span.End()
 	proposerSlashings := make([]*ethpb.ProposerSlashing, 0, len(proposals))
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(proposalRecordsBucket)
 		for _, proposal := range proposals {
 			key, err := keyForValidatorProposal(
@@ -333,7 +350,10 @@ func (s *Store) CheckDoubleBlockProposals(
 			if err != nil {
 				return err
 			}
-			encExistingProposalWrapper := bkt.Get(key)
+			encExistingProposalWrapper, err := bkt.Get(key)
+			if err != nil {
+				return err
+			}
 			if len(encExistingProposalWrapper) < signingRootSize {
 				continue
 			}
@@ -366,9 +386,12 @@ func (s *Store) BlockProposalForValidator(
 	if err != nil {
 		return nil, err
 	}
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = s.db.View(func(tx kvTx) error {
 		bkt := tx.Bucket(proposalRecordsBucket)
-		encProposal := bkt.Get(key)
+		encProposal, err := bkt.Get(key)
+		if err != nil {
+			return err
+		}
 		if encProposal == nil {
 			return nil
 		}
@@ -406,7 +429,7 @@ func (s *Store) SaveBlockProposals(
 		encodedKeys[i] = key
 		encodedProposals[i] = enc
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx kvTx) error {
 		bkt := tx.Bucket(proposalRecordsBucket)
 		for i := range proposals {
 			if err := bkt.Put(encodedKeys[i], encodedProposals[i]); err != nil {
@@ -437,14 +460,17 @@ func (s *Store) HighestAttestations(
 	}
 
 	history := make([]*ethpb.HighestAttestation, 0, len(encodedIndices))
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = s.db.View(func(tx kvTx) error {
 		signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
 		attRecordsBkt := tx.Bucket(attestationRecordsBucket)
 		for i := 0; i < len(encodedIndices); i++ {
 			c := signingRootsBkt.Cursor()
 			for k, v := c.Last(); k != nil; k, v = c.Prev() {
 				if suffixForAttestationRecordsKey(k, encodedIndices[i]) {
-					encodedAttRecord := attRecordsBkt.Get(v)
+					encodedAttRecord, err := attRecordsBkt.Get(v)
+					if err != nil {
+						return err
+					}
 					if encodedAttRecord == nil {
 						continue
 					}
@@ -13,7 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime"
@@ -69,7 +69,7 @@ func (s *Service) Start() {
 		if err := genesisState.UnmarshalSSZ(data); err != nil {
 			log.Fatalf("Could not unmarshal pre-loaded state: %v", err)
 		}
-		genesisTrie, err := v1.InitializeFromProto(genesisState)
+		genesisTrie, err := statenative.InitializeFromProtoPhase0(genesisState)
 		if err != nil {
 			log.Fatalf("Could not get state trie: %v", err)
 		}
@@ -84,7 +84,7 @@ func (s *Service) Start() {
 	if err != nil {
 		log.Fatalf("Could not generate interop genesis state: %v", err)
 	}
-	genesisTrie, err := v1.InitializeFromProto(genesisState)
+	genesisTrie, err := statenative.InitializeFromProtoPhase0(genesisState)
 	if err != nil {
 		log.Fatalf("Could not get state trie: %v", err)
 	}
@@ -125,7 +125,7 @@ func (_ *Service) ChainStartEth1Data() *ethpb.Eth1Data {
 
 // PreGenesisState returns an empty beacon state.
 func (_ *Service) PreGenesisState() state.BeaconState {
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{})
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{})
 	if err != nil {
 		panic("could not initialize state")
 	}
@@ -69,6 +69,7 @@ func (f *ForkChoice) Head(
 	if err := f.updateBalances(justifiedStateBalances); err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not update balances")
 	}
+	f.store.committeeWeight = computeCommitteeWeight(justifiedStateBalances)
 
 	if err := f.store.applyProposerBoostScore(justifiedStateBalances); err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not apply proposer boost score")
@@ -26,6 +26,7 @@ type HeadRetriever interface {
 	CachedHeadRoot() [32]byte
 	Tips() ([][32]byte, []types.Slot)
 	IsOptimistic(root [32]byte) (bool, error)
+	GetProposerHead() [32]byte
 }
 
 // BlockProcessor processes the block that's used for accounting fork choice.
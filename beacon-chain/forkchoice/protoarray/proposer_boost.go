@@ -41,3 +41,13 @@ func computeProposerBoostScore(validatorBalances []uint64) (score uint64, err er
 	score = (committeeWeight * params.BeaconConfig().ProposerScoreBoost) / 100
 	return
 }
+
+// computeCommitteeWeight returns the weight of a single slot committee, derived from
+// the total active balance of the input list of validator balances.
+func computeCommitteeWeight(validatorBalances []uint64) uint64 {
+	totalActiveBalance := uint64(0)
+	for _, balance := range validatorBalances {
+		totalActiveBalance += balance
+	}
+	return totalActiveBalance / uint64(params.BeaconConfig().SlotsPerEpoch)
+}
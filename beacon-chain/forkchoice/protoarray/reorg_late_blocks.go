@@ -0,0 +1,102 @@
+package protoarray
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// GetProposerHead returns the block root that a proposer of the current slot should build on.
+// This is the head of the canonical chain, unless the head block arrived late and is weakly
+// attested while its parent is strongly attested. In that case the head is orphaned and the
+// parent root is returned instead, relying on the proposer boost of the new block to outweigh
+// the late one.
+//
+// The late block is only orphaned when all of the following hold:
+//   - it is a single block from the previous slot, built on the slot right before it.
+//   - the current slot is not the start of an epoch, so that the shuffling is stable.
+//   - the chain has finalized within the last ReorgMaxEpochsSinceFinalization epochs.
+//   - it does not improve the unrealized justification of its parent.
+//   - its weight is below ReorgWeightThreshold % of a committee weight and the weight of its
+//     parent is above ReorgParentWeightThreshold % of a committee weight.
+//   - the proposal happens early in the current slot.
+func (f *ForkChoice) GetProposerHead() [32]byte {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	headIndex, ok := f.store.nodesIndices[f.store.lastHeadRoot]
+	if !ok || headIndex >= uint64(len(f.store.nodes)) {
+		return f.store.lastHeadRoot
+	}
+	head := f.store.nodes[headIndex]
+	if head.parent == NonExistentNode || head.parent >= uint64(len(f.store.nodes)) {
+		return head.root
+	}
+	parent := f.store.nodes[head.parent]
+
+	now := uint64(time.Now().Unix())
+	genesisTime := f.store.genesisTime
+	if now < genesisTime {
+		return head.root
+	}
+	currentSlot := types.Slot((now - genesisTime) / params.BeaconConfig().SecondsPerSlot)
+	if head.slot+1 != currentSlot || parent.slot+1 != head.slot {
+		return head.root
+	}
+	if slots.IsEpochStart(currentSlot) {
+		return head.root
+	}
+	if !arrivedLate(head.slot, head.timestamp, genesisTime) {
+		return head.root
+	}
+
+	f.store.checkpointsLock.RLock()
+	finalizedEpoch := f.store.finalizedCheckpoint.Epoch
+	f.store.checkpointsLock.RUnlock()
+	if slots.ToEpoch(currentSlot) > finalizedEpoch+params.BeaconConfig().ReorgMaxEpochsSinceFinalization {
+		return head.root
+	}
+	if head.unrealizedJustifiedEpoch != parent.unrealizedJustifiedEpoch {
+		return head.root
+	}
+
+	committeeWeight := f.store.committeeWeight
+	if committeeWeight == 0 {
+		return head.root
+	}
+	if head.weight*100 >= committeeWeight*params.BeaconConfig().ReorgWeightThreshold {
+		return head.root
+	}
+	if parent.weight*100 <= committeeWeight*params.BeaconConfig().ReorgParentWeightThreshold {
+		return head.root
+	}
+
+	if !proposingEarly(currentSlot, now, genesisTime) {
+		return head.root
+	}
+	return parent.root
+}
+
+// arrivedLate returns true if a block of the given slot was inserted at the given timestamp
+// after the proposer boost deadline of its slot.
+func arrivedLate(slot types.Slot, timestamp, genesisTime uint64) bool {
+	slotStart := genesisTime + uint64(slot)*params.BeaconConfig().SecondsPerSlot
+	if timestamp < slotStart {
+		return false
+	}
+	boostThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
+	return timestamp-slotStart >= boostThreshold
+}
+
+// proposingEarly returns true if the given time falls within the first half of the
+// proposer boost window of the given slot.
+func proposingEarly(slot types.Slot, now, genesisTime uint64) bool {
+	slotStart := genesisTime + uint64(slot)*params.BeaconConfig().SecondsPerSlot
+	if now < slotStart {
+		return false
+	}
+	boostThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
+	return now-slotStart <= boostThreshold/2
+}
@@ -0,0 +1,89 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// prepareLateBlockChain inserts the chain 0 <- 1 <- 2 with the given number of validators voting
+// for blocks 1 and 2 and sets the store clock to the start of slot 3. Block 2 is marked as having
+// arrived at the given number of seconds into its slot.
+func prepareLateBlockChain(t *testing.T, parentVotes, headVotes int, secondsIntoSlot uint64) *ForkChoice {
+	ctx := context.Background()
+	f := setup(0, 0)
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = 10
+	}
+	driftGenesisTime(f, 3, 0)
+	parentRoot := params.BeaconConfig().ZeroHash
+	for i := uint64(1); i <= 2; i++ {
+		st, blkRoot, err := prepareForkchoiceState(ctx, types.Slot(i), indexToHash(i), parentRoot, params.BeaconConfig().ZeroHash, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, blkRoot))
+		parentRoot = blkRoot
+	}
+	f.store.nodes[f.store.nodesIndices[indexToHash(2)]].timestamp = f.store.genesisTime + 2*params.BeaconConfig().SecondsPerSlot + secondsIntoSlot
+
+	for i := 0; i < parentVotes; i++ {
+		f.ProcessAttestation(ctx, []uint64{uint64(i)}, indexToHash(1), 0)
+	}
+	for i := parentVotes; i < parentVotes+headVotes; i++ {
+		f.ProcessAttestation(ctx, []uint64{uint64(i)}, indexToHash(2), 0)
+	}
+	headRoot, err := f.Head(ctx, balances)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(2), headRoot)
+	return f
+}
+
+func TestForkChoice_GetProposerHead(t *testing.T) {
+	lateDelay := params.BeaconConfig().SecondsPerSlot - 1
+
+	t.Run("late and weak head is orphaned", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, lateDelay)
+		require.Equal(t, indexToHash(1), f.GetProposerHead())
+	})
+	t.Run("timely head is kept", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, 0)
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("strongly attested head is kept", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 10, lateDelay)
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("weakly attested parent", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 1, 0, lateDelay)
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("proposing late in the slot", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, lateDelay)
+		driftGenesisTime(f, 3, params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot)
+		f.store.nodes[f.store.nodesIndices[indexToHash(2)]].timestamp = f.store.genesisTime + 2*params.BeaconConfig().SecondsPerSlot + lateDelay
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("head not from the previous slot", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, lateDelay)
+		driftGenesisTime(f, 4, 0)
+		f.store.nodes[f.store.nodesIndices[indexToHash(2)]].timestamp = f.store.genesisTime + 2*params.BeaconConfig().SecondsPerSlot + lateDelay
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("head improves justification", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, lateDelay)
+		f.store.nodes[f.store.nodesIndices[indexToHash(2)]].unrealizedJustifiedEpoch = 1
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+	t.Run("epoch boundary", func(t *testing.T) {
+		f := prepareLateBlockChain(t, 40, 0, lateDelay)
+		slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+		f.store.nodes[f.store.nodesIndices[indexToHash(1)]].slot = slotsPerEpoch - 2
+		f.store.nodes[f.store.nodesIndices[indexToHash(2)]].slot = slotsPerEpoch - 1
+		driftGenesisTime(f, slotsPerEpoch, 0)
+		f.store.nodes[f.store.nodesIndices[indexToHash(2)]].timestamp = f.store.genesisTime + uint64(slotsPerEpoch-1)*params.BeaconConfig().SecondsPerSlot + lateDelay
+		require.Equal(t, indexToHash(2), f.GetProposerHead())
+	})
+}
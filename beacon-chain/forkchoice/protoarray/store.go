@@ -70,6 +70,7 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedStateBalances []uint64)
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
 	}
 	f.votes = newVotes
+	f.store.committeeWeight = computeCommitteeWeight(newBalances)
 
 	if err := f.store.applyWeightChanges(ctx, newBalances, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
@@ -500,6 +501,7 @@ func (s *Store) insert(ctx context.Context,
 		bestDescendant:           NonExistentNode,
 		weight:                   0,
 		payloadHash:              payloadHash,
+		timestamp:                uint64(time.Now().Unix()),
 	}
 
 	s.nodesIndices[root] = index
@@ -507,7 +509,7 @@ func (s *Store) insert(ctx context.Context,
 	s.nodes = append(s.nodes, n)
 
 	// Apply proposer boost
-	timeNow := uint64(time.Now().Unix())
+	timeNow := n.timestamp
 	if timeNow < s.genesisTime {
 		return n, nil
 	}
@@ -39,6 +39,7 @@ type Store struct {
 	proposerBoostLock             sync.RWMutex
 	checkpointsLock               sync.RWMutex
 	genesisTime                   uint64
+	committeeWeight               uint64 // tracks the total active validator balance divided by the number of slots per Epoch.
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -56,6 +57,7 @@ type Node struct {
 	bestChild                uint64                       // bestChild index of this node.
 	bestDescendant           uint64                       // bestDescendant of this node.
 	status                   status                       // optimistic status of this node
+	timestamp                uint64                       // The timestamp when the node was inserted.
 }
 
 // enum used as optimistic status of a node
@@ -0,0 +1,7 @@
+/*
+Package invariants defines an opt-in runtime service which periodically checks
+that the beacon node's view of the chain is internally consistent. Violations
+are reported through logs, prometheus metrics and the state feed so that silent
+data corruption is detected as early as possible.
+*/
+package invariants
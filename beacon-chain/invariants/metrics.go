@@ -0,0 +1,31 @@
+package invariants
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "invariants")
+
+	// violationsCounter counts the invariant violations detected by the service.
+	violationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "invariants",
+			Name:      "violations_total",
+			Help:      "The total number of detected invariant violations",
+		},
+		[]string{
+			"invariant",
+		},
+	)
+	// checksCounter counts the invariant checks run by the service.
+	checksCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "invariants",
+			Name:      "checks_total",
+			Help:      "The total number of invariant check rounds",
+		},
+	)
+)
@@ -0,0 +1,329 @@
+package invariants
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Names of the invariants checked by the service, used as metric labels.
+const (
+	FinalizedCheckpointMonotonic = "finalized_checkpoint_monotonic"
+	CanonicalChainLinked         = "canonical_chain_linked"
+	ForkChoiceWeightBounded      = "fork_choice_weight_bounded"
+	HeadAgreement                = "head_agreement"
+)
+
+// defaultAncestorDepth is the number of blocks walked back from the head when
+// checking the linkage of the canonical chain in the DB.
+const defaultAncestorDepth = 64
+
+// Config for the invariant checker service.
+type Config struct {
+	Database            db.HeadAccessDatabase
+	HeadFetcher         blockchain.HeadFetcher
+	FinalizationFetcher blockchain.FinalizationFetcher
+	ForkFetcher         blockchain.ForkFetcher
+	TimeFetcher         blockchain.TimeFetcher
+	StateNotifier       statefeed.Notifier
+	// AncestorDepth bounds the number of blocks walked back from the head when checking chain linkage.
+	AncestorDepth uint64
+}
+
+// Service periodically checks chain invariants and reports violations.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock                   sync.Mutex
+	lastFinalizedEpoch     types.Epoch
+	lastDBFinalizedEpoch   types.Epoch
+	observedFinalizedEpoch bool
+}
+
+// NewService sets up a new invariant checker service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("nil config")
+	}
+	if cfg.AncestorDepth == 0 {
+		cfg.AncestorDepth = defaultAncestorDepth
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// Start the invariant checker service.
+func (s *Service) Start() {
+	log.Info("Starting invariant checker")
+	go s.run()
+}
+
+// Stop the invariant checker service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the invariant checker service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	genesis := s.cfg.TimeFetcher.GenesisTime()
+	if genesis.IsZero() {
+		var err error
+		genesis, err = s.waitForGenesis()
+		if err != nil {
+			log.WithError(err).Error("Could not wait for chain initialization")
+			return
+		}
+	}
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	ticker := slots.NewSlotTicker(genesis, secondsPerEpoch)
+	defer ticker.Done()
+	for {
+		select {
+		case <-ticker.C():
+			s.CheckInvariants(s.ctx)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting invariant checker")
+			return
+		}
+	}
+}
+
+// waitForGenesis blocks until the state feed announces the chain has been initialized.
+func (s *Service) waitForGenesis() (genesis time.Time, err error) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case e := <-stateChannel:
+			if e.Type != statefeed.Initialized {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.InitializedData)
+			if !ok {
+				return time.Time{}, errors.New("event feed data is not of type *statefeed.InitializedData")
+			}
+			return data.StartTime, nil
+		case err := <-stateSub.Err():
+			return time.Time{}, err
+		case <-s.ctx.Done():
+			return time.Time{}, s.ctx.Err()
+		}
+	}
+}
+
+// CheckInvariants runs every invariant check once and reports the violations found.
+func (s *Service) CheckInvariants(ctx context.Context) map[string]error {
+	checksCounter.Inc()
+	checks := []struct {
+		name  string
+		check func(context.Context) error
+	}{
+		{name: FinalizedCheckpointMonotonic, check: s.checkFinalizedMonotonic},
+		{name: CanonicalChainLinked, check: s.checkCanonicalChainLinked},
+		{name: ForkChoiceWeightBounded, check: s.checkForkChoiceWeights},
+		{name: HeadAgreement, check: s.checkHeadAgreement},
+	}
+	violations := make(map[string]error)
+	for _, c := range checks {
+		if ctx.Err() != nil {
+			return violations
+		}
+		if err := c.check(ctx); err != nil {
+			violations[c.name] = err
+			s.report(c.name, err)
+		}
+	}
+	return violations
+}
+
+func (s *Service) report(invariant string, err error) {
+	violationsCounter.WithLabelValues(invariant).Inc()
+	log.WithError(err).WithField("invariant", invariant).Error("Invariant violated")
+	if s.cfg.StateNotifier != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.InvariantViolated,
+			Data: &statefeed.InvariantViolatedData{
+				Invariant: invariant,
+				Err:       err,
+			},
+		})
+	}
+}
+
+// checkFinalizedMonotonic verifies that neither the in-memory nor the persisted
+// finalized checkpoint ever move backwards.
+func (s *Service) checkFinalizedMonotonic(ctx context.Context) error {
+	cp := s.cfg.FinalizationFetcher.FinalizedCheckpt()
+	if cp == nil {
+		return errors.New("nil finalized checkpoint")
+	}
+	dbCp, err := s.cfg.Database.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint from db")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer func() {
+		s.lastFinalizedEpoch = cp.Epoch
+		s.lastDBFinalizedEpoch = dbCp.Epoch
+		s.observedFinalizedEpoch = true
+	}()
+	if !s.observedFinalizedEpoch {
+		return nil
+	}
+	if cp.Epoch < s.lastFinalizedEpoch {
+		return fmt.Errorf("finalized epoch went backwards from %d to %d", s.lastFinalizedEpoch, cp.Epoch)
+	}
+	if dbCp.Epoch < s.lastDBFinalizedEpoch {
+		return fmt.Errorf("finalized epoch in db went backwards from %d to %d", s.lastDBFinalizedEpoch, dbCp.Epoch)
+	}
+	return nil
+}
+
+// checkCanonicalChainLinked walks back from the head block and verifies every parent
+// is present in the DB with a strictly lower slot, until the finalized checkpoint or
+// the configured depth is reached.
+func (s *Service) checkCanonicalChainLinked(ctx context.Context) error {
+	headRoot, err := s.cfg.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	root := bytesutil.ToBytes32(headRoot)
+	blk, err := s.cfg.Database.Block(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block from db")
+	}
+	if blk == nil || blk.IsNil() {
+		return fmt.Errorf("head block %#x not found in db", root)
+	}
+	var finalizedRoot [32]byte
+	if cp := s.cfg.FinalizationFetcher.FinalizedCheckpt(); cp != nil {
+		finalizedRoot = bytesutil.ToBytes32(cp.Root)
+	}
+	for i := uint64(0); i < s.cfg.AncestorDepth; i++ {
+		if root == finalizedRoot {
+			return nil
+		}
+		parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+		if parentRoot == params.BeaconConfig().ZeroHash {
+			return nil
+		}
+		parent, err := s.cfg.Database.Block(ctx, parentRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block %#x from db", parentRoot)
+		}
+		if parent == nil || parent.IsNil() {
+			// The chain may legitimately start at a checkpoint sync origin.
+			if origin, err := s.cfg.Database.OriginCheckpointBlockRoot(ctx); err == nil && origin == root {
+				return nil
+			}
+			return fmt.Errorf("parent %#x of block %#x at slot %d not found in db", parentRoot, root, blk.Block().Slot())
+		}
+		if parent.Block().Slot() >= blk.Block().Slot() {
+			return fmt.Errorf("parent %#x slot %d is not lower than child %#x slot %d",
+				parentRoot, parent.Block().Slot(), root, blk.Block().Slot())
+		}
+		root, blk = parentRoot, parent
+	}
+	return nil
+}
+
+// checkForkChoiceWeights verifies no fork choice node carries more weight than the
+// total active balance of the head state, plus the maximum proposer boost.
+func (s *Service) checkForkChoiceWeights(ctx context.Context) error {
+	fc := s.cfg.ForkFetcher.ForkChoicer()
+	if fc == nil || fc.NodeCount() == 0 {
+		return nil
+	}
+	st, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() {
+		return nil
+	}
+	totalActive, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return errors.Wrap(err, "could not get total active balance")
+	}
+	committeeWeight := totalActive / uint64(params.BeaconConfig().SlotsPerEpoch)
+	maxWeight := totalActive + committeeWeight*params.BeaconConfig().ProposerScoreBoost/100
+	for _, n := range fc.ForkChoiceNodes() {
+		if n.Weight > maxWeight {
+			return fmt.Errorf("fork choice node %#x at slot %d has weight %d above maximum %d",
+				n.Root, n.Slot, n.Weight, maxWeight)
+		}
+	}
+	return nil
+}
+
+// checkHeadAgreement verifies the cached head root agrees with the head block saved in the DB.
+func (s *Service) checkHeadAgreement(ctx context.Context) error {
+	headRoot, err := s.cfg.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	dbHeadRoot, err := s.dbHeadRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(headRoot) == dbHeadRoot {
+		return nil
+	}
+	// The head may have been updated in between the two reads, check once more.
+	headRoot, err = s.cfg.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	dbHeadRoot, err = s.dbHeadRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if bytesutil.ToBytes32(headRoot) == dbHeadRoot {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"cachedHeadSlot": s.cfg.HeadFetcher.HeadSlot(),
+	}).Debug("Head mismatch")
+	return fmt.Errorf("cached head root %#x does not match db head root %#x", headRoot, dbHeadRoot)
+}
+
+func (s *Service) dbHeadRoot(ctx context.Context) ([32]byte, error) {
+	dbHead, err := s.cfg.Database.HeadBlock(ctx)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get head block from db")
+	}
+	if dbHead == nil || dbHead.IsNil() {
+		return [32]byte{}, errors.New("no head block in db")
+	}
+	root, err := dbHead.Block().HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not hash db head block")
+	}
+	return root, nil
+}
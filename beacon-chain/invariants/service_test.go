@@ -0,0 +1,122 @@
+package invariants
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func setupService(t *testing.T, chain *mock.ChainService) *Service {
+	beaconDB := testDB.SetupDB(t)
+	svc, err := NewService(context.Background(), &Config{
+		Database:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		ForkFetcher:         chain,
+		TimeFetcher:         chain,
+		StateNotifier:       &mock.MockStateNotifier{},
+	})
+	require.NoError(t, err)
+	return svc
+}
+
+// saveChain saves a linear chain of blocks at the given slots and returns their roots.
+func saveChain(t *testing.T, svc *Service, slotsToSave ...types.Slot) [][32]byte {
+	ctx := context.Background()
+	roots := make([][32]byte, 0, len(slotsToSave))
+	parent := [32]byte{}
+	for _, slot := range slotsToSave {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent[:]
+		util.SaveBlock(t, ctx, svc.cfg.Database, b)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, svc.cfg.Database.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: r[:]}))
+		roots = append(roots, r)
+		parent = r
+	}
+	return roots
+}
+
+func TestNewService_NilConfig(t *testing.T) {
+	_, err := NewService(context.Background(), nil)
+	require.ErrorContains(t, "nil config", err)
+}
+
+func TestCheckFinalizedMonotonic(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)}}
+	svc := setupService(t, chain)
+
+	require.NoError(t, svc.checkFinalizedMonotonic(ctx))
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)}
+	require.NoError(t, svc.checkFinalizedMonotonic(ctx))
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)}
+	require.ErrorContains(t, "finalized epoch went backwards from 3 to 1", svc.checkFinalizedMonotonic(ctx))
+}
+
+func TestCheckCanonicalChainLinked(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)}}
+	svc := setupService(t, chain)
+	roots := saveChain(t, svc, 0, 1, 2)
+
+	chain.Root = roots[2][:]
+	require.NoError(t, svc.checkCanonicalChainLinked(ctx))
+
+	// A block whose parent is not in the DB breaks the chain linkage.
+	orphan := util.NewBeaconBlock()
+	orphan.Block.Slot = 3
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte{'m', 'i', 's', 's', 'i', 'n', 'g'}, 32)
+	util.SaveBlock(t, ctx, svc.cfg.Database, orphan)
+	orphanRoot, err := orphan.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.Root = orphanRoot[:]
+	require.ErrorContains(t, "not found in db", svc.checkCanonicalChainLinked(ctx))
+}
+
+func TestCheckHeadAgreement(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{}
+	svc := setupService(t, chain)
+	roots := saveChain(t, svc, 0, 1)
+	require.NoError(t, svc.cfg.Database.SaveHeadBlockRoot(ctx, roots[1]))
+
+	chain.Root = roots[1][:]
+	require.NoError(t, svc.checkHeadAgreement(ctx))
+
+	chain.Root = roots[0][:]
+	require.ErrorContains(t, "does not match db head root", svc.checkHeadAgreement(ctx))
+}
+
+func TestCheckForkChoiceWeights(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	chain := &mock.ChainService{State: st, ForkChoiceStore: doublylinkedtree.New()}
+	svc := setupService(t, chain)
+	require.NoError(t, svc.checkForkChoiceWeights(ctx))
+}
+
+func TestCheckInvariants_ReportsViolations(t *testing.T) {
+	ctx := context.Background()
+	chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)}}
+	svc := setupService(t, chain)
+	roots := saveChain(t, svc, 0, 1)
+	require.NoError(t, svc.cfg.Database.SaveHeadBlockRoot(ctx, roots[1]))
+	chain.Root = roots[0][:]
+
+	violations := svc.CheckInvariants(ctx)
+	assert.Equal(t, 1, len(violations))
+	_, ok := violations[HeadAgreement]
+	assert.Equal(t, true, ok, "Expected head agreement violation")
+}
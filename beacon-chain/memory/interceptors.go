@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Interval at which the memory pressure is polled while an API request is deferred.
+const deferPollInterval = 100 * time.Millisecond
+
+// UnaryServerInterceptor defers unary API requests while the memory pressure is critical. The
+// requests to the methods starting with one of the exempt prefixes are never deferred.
+func (s *Service) UnaryServerInterceptor(exempt ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := s.deferRequest(ctx, info.FullMethod, exempt); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor defers the opening of API streams while the memory pressure is
+// critical. The streams of the methods starting with one of the exempt prefixes are never
+// deferred.
+func (s *Service) StreamServerInterceptor(exempt ...string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := s.deferRequest(ss.Context(), info.FullMethod, exempt); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// deferRequest waits for the memory pressure to go below critical before an API request is
+// served, and rejects the request if it does not within the defer limit.
+func (s *Service) deferRequest(ctx context.Context, method string, exempt []string) error {
+	if s.Pressure() != PressureCritical {
+		return nil
+	}
+	for _, prefix := range exempt {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+	actionsCounter.WithLabelValues(actionDeferAPI).Inc()
+	ticker := time.NewTicker(deferPollInterval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(s.cfg.APIDeferLimit)
+	defer deadline.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.Pressure() != PressureCritical {
+				return nil
+			}
+		case <-deadline.C:
+			actionsCounter.WithLabelValues(actionRejectAPI).Inc()
+			return status.Error(codes.ResourceExhausted, "Beacon node is under critical memory pressure, retry later")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "memory")
+
+	budgetBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "memory_governor",
+		Name:      "budget_bytes",
+		Help:      "The memory budget of the process",
+	})
+	usageBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "memory_governor",
+		Name:      "usage_bytes",
+		Help:      "The bytes of allocated heap objects as of the last evaluation of the memory pressure",
+	})
+	pressureGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "memory_governor",
+		Name:      "pressure",
+		Help:      "The memory pressure: 0 for normal, 1 for elevated and 2 for critical",
+	})
+	consumerBytes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "memory_governor",
+			Name:      "consumer_bytes",
+			Help:      "The estimated bytes held by each tracked cache",
+		},
+		[]string{
+			"consumer",
+		},
+	)
+	// actionsCounter counts the load shedding actions taken by the governor.
+	actionsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "memory_governor",
+			Name:      "actions_total",
+			Help:      "The total number of load shedding actions taken under memory pressure",
+		},
+		[]string{
+			"action",
+		},
+	)
+)
@@ -0,0 +1,270 @@
+// Package memory implements a memory governor for the beacon node. The governor tracks the
+// memory held by the process and by its largest caches against a memory budget, and sheds load
+// before the process gets killed for running out of memory: caches are shrunk, sync batches are
+// throttled and API requests are deferred while the memory pressure is high.
+package memory
+
+import (
+	"context"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Pressure is the level of memory pressure of the process relative to its memory budget.
+type Pressure uint8
+
+const (
+	// PressureNormal is the pressure below the elevated threshold, where no load is shed.
+	PressureNormal Pressure = iota
+	// PressureElevated is the pressure above the elevated threshold, where caches are shrunk and
+	// batches are throttled.
+	PressureElevated
+	// PressureCritical is the pressure above the critical threshold, where API requests are
+	// additionally deferred and memory is returned to the operating system.
+	PressureCritical
+)
+
+// String returns the name of the pressure level.
+func (p Pressure) String() string {
+	switch p {
+	case PressureNormal:
+		return "normal"
+	case PressureElevated:
+		return "elevated"
+	case PressureCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// Fractions of the memory budget above which the pressure is elevated or critical.
+const (
+	elevatedThreshold = 0.8
+	criticalThreshold = 0.95
+)
+
+// Actions taken by the governor, reported in the metrics.
+const (
+	actionShrinkCache   = "shrink_cache"
+	actionThrottleBatch = "throttle_batch"
+	actionDeferAPI      = "defer_api"
+	actionRejectAPI     = "reject_api"
+	actionFreeOSMemory  = "free_os_memory"
+)
+
+const (
+	defaultCheckInterval = 5 * time.Second
+	defaultAPIDeferLimit = 2 * time.Second
+)
+
+// Consumer is a component holding memory that the governor can reclaim under memory pressure.
+type Consumer interface {
+	// EstimatedMemory returns the estimated number of bytes held by the consumer.
+	EstimatedMemory() uint64
+	// Shrink releases an estimated number of bytes at least equal to the given one when
+	// possible, and returns the estimated number of bytes released.
+	Shrink(bytes uint64) uint64
+}
+
+// Config for the memory governor.
+type Config struct {
+	// MaxMemory is the memory budget of the process, in bytes.
+	MaxMemory uint64
+	// CheckInterval is the interval at which the memory pressure is evaluated.
+	CheckInterval time.Duration
+	// APIDeferLimit is the maximum time an API request is deferred for under critical memory
+	// pressure, before being rejected.
+	APIDeferLimit time.Duration
+}
+
+// Service is the memory governor, periodically evaluating the memory pressure of the process
+// and shedding load when it is high. The methods of a nil service are no-ops, so that the
+// components it governs do not need to check whether the governor is enabled.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock      sync.RWMutex
+	consumers map[string]Consumer
+	pressure  Pressure
+	heapAlloc func() uint64
+	freeOS    func()
+}
+
+// NewService sets up a new memory governor.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("nil config")
+	}
+	if cfg.MaxMemory == 0 {
+		return nil, errors.New("no memory budget specified")
+	}
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = defaultCheckInterval
+	}
+	if cfg.APIDeferLimit == 0 {
+		cfg.APIDeferLimit = defaultAPIDeferLimit
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	budgetBytes.Set(float64(cfg.MaxMemory))
+	return &Service{
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+		consumers: make(map[string]Consumer),
+		heapAlloc: func() uint64 {
+			var m runtime.MemStats
+			runtime.ReadMemStats(&m)
+			return m.HeapAlloc
+		},
+		freeOS: debug.FreeOSMemory,
+	}, nil
+}
+
+// Start the memory governor.
+func (s *Service) Start() {
+	log.WithField("maxMemoryBytes", s.cfg.MaxMemory).Info("Starting memory governor")
+	go s.run()
+}
+
+// Stop the memory governor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the memory governor.
+func (*Service) Status() error {
+	return nil
+}
+
+// Register a consumer whose memory is tracked and reclaimed under memory pressure.
+func (s *Service) Register(name string, c Consumer) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.consumers[name] = c
+}
+
+// Pressure returns the memory pressure as of the last evaluation.
+func (s *Service) Pressure() Pressure {
+	if s == nil {
+		return PressureNormal
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.pressure
+}
+
+// ScaleBatchSize scales down a batch size according to the memory pressure: the size is halved
+// under elevated pressure and quartered under critical pressure, but never goes below one.
+func (s *Service) ScaleBatchSize(size uint64) uint64 {
+	var scaled uint64
+	switch s.Pressure() {
+	case PressureElevated:
+		scaled = size / 2
+	case PressureCritical:
+		scaled = size / 4
+	default:
+		return size
+	}
+	if scaled == 0 {
+		scaled = 1
+	}
+	if scaled < size {
+		actionsCounter.WithLabelValues(actionThrottleBatch).Inc()
+	}
+	return scaled
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.check()
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting memory governor")
+			return
+		}
+	}
+}
+
+// check evaluates the memory pressure, and under elevated pressure shrinks the registered
+// consumers, largest first, until the memory in excess of the elevated threshold is released.
+func (s *Service) check() {
+	heapAlloc := s.heapAlloc()
+	pressure := PressureNormal
+	switch {
+	case float64(heapAlloc) >= criticalThreshold*float64(s.cfg.MaxMemory):
+		pressure = PressureCritical
+	case float64(heapAlloc) >= elevatedThreshold*float64(s.cfg.MaxMemory):
+		pressure = PressureElevated
+	}
+
+	s.lock.Lock()
+	previous := s.pressure
+	s.pressure = pressure
+	names := make([]string, 0, len(s.consumers))
+	estimates := make(map[string]uint64, len(s.consumers))
+	for name, c := range s.consumers {
+		names = append(names, name)
+		estimates[name] = c.EstimatedMemory()
+	}
+	s.lock.Unlock()
+
+	usageBytes.Set(float64(heapAlloc))
+	pressureGauge.Set(float64(pressure))
+	for name, estimate := range estimates {
+		consumerBytes.WithLabelValues(name).Set(float64(estimate))
+	}
+	if pressure != previous {
+		log.WithFields(logrus.Fields{
+			"heapAllocBytes": heapAlloc,
+			"maxMemoryBytes": s.cfg.MaxMemory,
+			"pressure":       pressure,
+		}).Warn("Memory pressure changed")
+	}
+	if pressure == PressureNormal {
+		return
+	}
+
+	excess := heapAlloc - uint64(elevatedThreshold*float64(s.cfg.MaxMemory))
+	sort.Slice(names, func(i, j int) bool {
+		return estimates[names[i]] > estimates[names[j]]
+	})
+	var released uint64
+	for _, name := range names {
+		if released >= excess {
+			break
+		}
+		s.lock.RLock()
+		c := s.consumers[name]
+		s.lock.RUnlock()
+		freed := c.Shrink(excess - released)
+		if freed == 0 {
+			continue
+		}
+		released += freed
+		actionsCounter.WithLabelValues(actionShrinkCache).Inc()
+		log.WithFields(logrus.Fields{
+			"consumer":      name,
+			"releasedBytes": freed,
+		}).Debug("Shrunk cache under memory pressure")
+	}
+	if pressure == PressureCritical {
+		s.freeOS()
+		actionsCounter.WithLabelValues(actionFreeOSMemory).Inc()
+	}
+}
@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockConsumer struct {
+	held     uint64
+	requests []uint64
+}
+
+func (c *mockConsumer) EstimatedMemory() uint64 {
+	return c.held
+}
+
+func (c *mockConsumer) Shrink(bytes uint64) uint64 {
+	c.requests = append(c.requests, bytes)
+	if bytes > c.held {
+		bytes = c.held
+	}
+	c.held -= bytes
+	return bytes
+}
+
+func TestNewService(t *testing.T) {
+	_, err := NewService(context.Background(), nil)
+	assert.ErrorContains(t, "nil config", err)
+	_, err = NewService(context.Background(), &Config{})
+	assert.ErrorContains(t, "no memory budget specified", err)
+	s, err := NewService(context.Background(), &Config{MaxMemory: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, defaultCheckInterval, s.cfg.CheckInterval)
+	assert.Equal(t, defaultAPIDeferLimit, s.cfg.APIDeferLimit)
+}
+
+func TestService_Check(t *testing.T) {
+	heap := uint64(500)
+	freed := 0
+	s, err := NewService(context.Background(), &Config{MaxMemory: 1000})
+	require.NoError(t, err)
+	s.heapAlloc = func() uint64 { return heap }
+	s.freeOS = func() { freed++ }
+	small := &mockConsumer{held: 50}
+	large := &mockConsumer{held: 200}
+	s.Register("small", small)
+	s.Register("large", large)
+
+	s.check()
+	assert.Equal(t, PressureNormal, s.Pressure())
+	assert.Equal(t, 0, len(small.requests)+len(large.requests))
+
+	// The excess over the elevated threshold is released from the largest consumer first.
+	heap = 900
+	s.check()
+	assert.Equal(t, PressureElevated, s.Pressure())
+	assert.DeepEqual(t, []uint64{100}, large.requests)
+	assert.Equal(t, 0, len(small.requests))
+	assert.Equal(t, 0, freed)
+
+	heap = 1000
+	s.check()
+	assert.Equal(t, PressureCritical, s.Pressure())
+	assert.DeepEqual(t, []uint64{100, 200}, large.requests)
+	assert.DeepEqual(t, []uint64{100}, small.requests)
+	assert.Equal(t, uint64(0), large.held)
+	assert.Equal(t, uint64(0), small.held)
+	assert.Equal(t, 1, freed)
+
+	heap = 100
+	s.check()
+	assert.Equal(t, PressureNormal, s.Pressure())
+}
+
+func TestService_ScaleBatchSize(t *testing.T) {
+	var nilService *Service
+	assert.Equal(t, uint64(64), nilService.ScaleBatchSize(64))
+	assert.Equal(t, PressureNormal, nilService.Pressure())
+	nilService.Register("consumer", &mockConsumer{})
+
+	s, err := NewService(context.Background(), &Config{MaxMemory: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), s.ScaleBatchSize(64))
+	s.pressure = PressureElevated
+	assert.Equal(t, uint64(32), s.ScaleBatchSize(64))
+	s.pressure = PressureCritical
+	assert.Equal(t, uint64(16), s.ScaleBatchSize(64))
+	assert.Equal(t, uint64(1), s.ScaleBatchSize(2))
+}
+
+func TestService_UnaryServerInterceptor(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{MaxMemory: 1000, APIDeferLimit: 200 * time.Millisecond})
+	require.NoError(t, err)
+	interceptor := s.UnaryServerInterceptor("/exempt.Service/")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", nil
+	}
+	call := func(method string) (interface{}, error) {
+		return interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	resp, err := call("/deferred.Service/Method")
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+
+	s.pressure = PressureCritical
+	_, err = call("/deferred.Service/Method")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	resp, err = call("/exempt.Service/Method")
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+
+	// The request is served once the pressure goes down.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.lock.Lock()
+		s.pressure = PressureElevated
+		s.lock.Unlock()
+	}()
+	resp, err = call("/deferred.Service/Method")
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+}
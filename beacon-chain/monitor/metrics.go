@@ -22,6 +22,73 @@ var (
 			"validator_index",
 		},
 	)
+	// inclusionDistanceGauge used to track the inclusion distance of the latest included attestation
+	inclusionDistanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "inclusion_distance",
+			Help:      "Inclusion distance of the latest included attestation",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// missedAttestationsCounter used to track attestations which were not included in time
+	missedAttestationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "missed_attestations_total",
+			Help:      "Number of attestations not included within their inclusion window",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// feeRecipientMismatchCounter used to track proposed blocks paying an unexpected fee recipient
+	feeRecipientMismatchCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "fee_recipient_mismatch_total",
+			Help:      "Number of proposed blocks whose execution payload pays a fee recipient other than the configured one",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// lateProposedBlocksCounter used to track proposed blocks received after the attestation deadline
+	lateProposedBlocksCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "late_proposed_blocks_total",
+			Help:      "Number of proposed blocks received over gossip after the attestation deadline of their slot",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// upcomingSyncCommitteeGauge used to track the start epoch of the next sync committee period of
+	// validators in the next sync committee
+	upcomingSyncCommitteeGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "upcoming_sync_committee_start_epoch",
+			Help:      "Start epoch of the next sync committee period the validator is a member of",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// balanceGauge used to track the balance at the start of each epoch
+	balanceGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "balance_gwei",
+			Help:      "Balance at the start of the epoch, in Gwei",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 	// timelyHeadCounter used to track attestation timely head flags
 	timelyHeadCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -90,4 +157,16 @@ var (
 			"validator_index",
 		},
 	)
+	// syncCommitteeMissedCounter used to track sync committee
+	// contributions missing from the sync aggregate of blocks
+	syncCommitteeMissedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "sync_committee_contributions_missed_total",
+			Help:      "Number of Sync committee contributions missing from blocks",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 )
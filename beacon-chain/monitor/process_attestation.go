@@ -91,7 +91,9 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			latestPerf.attestedSlot = att.Data.Slot
 			latestPerf.inclusionSlot = state.Slot()
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
+			inclusionDistanceGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot - latestPerf.attestedSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
+			s.recordAttestedEpoch(types.ValidatorIndex(idx), slots.ToEpoch(latestPerf.attestedSlot))
 
 			if state.Version() == version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
@@ -23,6 +23,7 @@ const AggregateReportingPeriod = 5
 // - An Exit by one of our validators was included
 // - A Slashing by one of our tracked validators was included
 // - A Sync Committee Contribution by one of our tracked validators was included
+// - The block is the first one of a new epoch, after which missed attestations are reported
 func (s *Service) processBlock(ctx context.Context, b interfaces.SignedBeaconBlock) {
 	if b == nil || b.Block() == nil {
 		return
@@ -50,13 +51,17 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.SignedBeaconBlo
 	s.RUnlock()
 
 	if currEpoch != lastSyncedEpoch &&
-		slots.SyncCommitteePeriod(currEpoch) == slots.SyncCommitteePeriod(lastSyncedEpoch) {
+		slots.SyncCommitteePeriod(currEpoch) != slots.SyncCommitteePeriod(lastSyncedEpoch) {
 		s.updateSyncCommitteeTrackedVals(st)
 	}
+	s.processUpcomingSyncCommittee(st, currEpoch)
 
 	s.processSyncAggregate(st, blk)
 	s.processProposedBlock(st, root, blk)
+	s.processFeeRecipient(ctx, root, blk)
+	s.processBlockArrival(root, blk)
 	s.processAttestations(ctx, st, blk)
+	s.processEpochSummary(st, currEpoch)
 
 	if blk.Slot()%(AggregateReportingPeriod*params.BeaconConfig().SlotsPerEpoch) == 0 {
 		s.logAggregatedPerformance()
@@ -145,11 +150,11 @@ func (s *Service) logAggregatedPerformance() {
 
 	for idx, p := range s.aggregatedPerformance {
 		if p.totalAttestedCount == 0 || p.totalRequestedCount == 0 || p.startBalance == 0 {
-			break
+			continue
 		}
 		l, ok := s.latestPerformance[idx]
 		if !ok {
-			break
+			continue
 		}
 		percentAtt := float64(p.totalAttestedCount) / float64(p.totalRequestedCount)
 		percentBal := float64(l.balance-p.startBalance) / float64(p.startBalance)
@@ -0,0 +1,48 @@
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// processBlockArrival warns when a block proposed by a tracked validator arrived over gossip after the
+// attestation deadline of its slot. Attesters of the slot have then already voted for its parent, so the
+// late publication of the block shows up as missed head votes.
+func (s *Service) processBlockArrival(root [32]byte, blk interfaces.BeaconBlock) {
+	if s.config.BlockArrivals == nil {
+		return
+	}
+	s.RLock()
+	tracked := s.trackedIndex(blk.ProposerIndex())
+	s.RUnlock()
+	if !tracked {
+		return
+	}
+	arrival, ok := s.config.BlockArrivals.Get(root)
+	if !ok {
+		// The block was not received over gossip, for example during initial sync.
+		return
+	}
+	if arrival.Delay < attestationDeadline() {
+		return
+	}
+
+	lateProposedBlocksCounter.WithLabelValues(fmt.Sprintf("%d", blk.ProposerIndex())).Inc()
+	log.WithFields(logrus.Fields{
+		"ProposerIndex":      blk.ProposerIndex(),
+		"Slot":               blk.Slot(),
+		"BlockRoot":          fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		"SinceSlotStartTime": arrival.Delay,
+	}).Warn("Proposed block arrived after the attestation deadline")
+}
+
+// attestationDeadline is the time into a slot at which attesters vote for the head seen so far.
+func attestationDeadline() time.Duration {
+	cfg := params.BeaconConfig()
+	return time.Duration(cfg.SecondsPerSlot) * time.Second / time.Duration(cfg.IntervalsPerSlot)
+}
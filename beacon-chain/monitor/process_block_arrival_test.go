@@ -0,0 +1,48 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessBlockArrival(t *testing.T) {
+	s := setupService(t)
+	s.config.BlockArrivals = cache.NewBlockArrivalCache()
+	s.config.BlockArrivals.Add(cache.BlockArrival{Root: [32]byte{'a'}, Slot: 10, ProposerIndex: 2, Delay: attestationDeadline() / 2})
+	s.config.BlockArrivals.Add(cache.BlockArrival{Root: [32]byte{'b'}, Slot: 11, ProposerIndex: 2, Delay: attestationDeadline()})
+	s.config.BlockArrivals.Add(cache.BlockArrival{Root: [32]byte{'c'}, Slot: 12, ProposerIndex: 3, Delay: attestationDeadline()})
+
+	tests := []struct {
+		name     string
+		root     [32]byte
+		proposer types.ValidatorIndex
+		late     bool
+	}{
+		{name: "block in time", root: [32]byte{'a'}, proposer: 2},
+		{name: "late block", root: [32]byte{'b'}, proposer: 2, late: true},
+		{name: "untracked proposer", root: [32]byte{'c'}, proposer: 3},
+		{name: "block not received over gossip", root: [32]byte{'d'}, proposer: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			b := util.NewBeaconBlock()
+			b.Block.ProposerIndex = tt.proposer
+			wb, err := wrapper.WrappedSignedBeaconBlock(b)
+			require.NoError(t, err)
+
+			s.processBlockArrival(tt.root, wb.Block())
+			if tt.late {
+				require.LogsContain(t, hook, "Proposed block arrived after the attestation deadline")
+			} else {
+				require.LogsDoNotContain(t, hook, "Proposed block arrived after the attestation deadline")
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/sirupsen/logrus"
+)
+
+// recordAttestedEpoch records that an attestation of the validator for the given epoch was included.
+// It assumes the caller holds the service Lock.
+func (s *Service) recordAttestedEpoch(idx types.ValidatorIndex, epoch types.Epoch) {
+	if s.attestedEpochs == nil {
+		s.attestedEpochs = make(map[types.Epoch]map[types.ValidatorIndex]bool)
+	}
+	if _, ok := s.attestedEpochs[epoch]; !ok {
+		s.attestedEpochs[epoch] = make(map[types.ValidatorIndex]bool)
+	}
+	s.attestedEpochs[epoch][idx] = true
+}
+
+// processEpochSummary reports the balances of the tracked validators when the first block of a new epoch
+// is processed. Attestations can be included until the end of the epoch following their own, so it also
+// reports the missed attestations of the epoch before the previous one, whose inclusion window is closed.
+func (s *Service) processEpochSummary(state state.BeaconState, epoch types.Epoch) {
+	s.Lock()
+	defer s.Unlock()
+	if epoch <= s.lastReportedEpoch {
+		return
+	}
+	s.lastReportedEpoch = epoch
+
+	for idx := range s.TrackedValidators {
+		balance, err := state.BalanceAtIndex(idx)
+		if err != nil {
+			continue
+		}
+		balanceGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(balance))
+	}
+	if epoch < 2 {
+		return
+	}
+	s.processMissedAttestations(state, epoch-2)
+	for e := range s.attestedEpochs {
+		if e <= epoch-2 {
+			delete(s.attestedEpochs, e)
+		}
+	}
+}
+
+// processMissedAttestations logs the tracked validators which were active in the given epoch but had
+// no attestation included for it. Epochs whose inclusion window started before the service did are
+// skipped, as their included attestations may not have been observed.
+// It assumes the caller holds the service Lock.
+func (s *Service) processMissedAttestations(state state.BeaconState, epoch types.Epoch) {
+	for idx := range s.TrackedValidators {
+		aggregatedPerf, ok := s.aggregatedPerformance[idx]
+		if !ok || epoch <= aggregatedPerf.startEpoch {
+			continue
+		}
+		if s.attestedEpochs[epoch][idx] {
+			continue
+		}
+		val, err := state.ValidatorAtIndexReadOnly(idx)
+		if err != nil || !helpers.IsActiveValidatorUsingTrie(val, epoch) {
+			continue
+		}
+		aggregatedPerf.totalRequestedCount++
+		s.aggregatedPerformance[idx] = aggregatedPerf
+		missedAttestationsCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Inc()
+		log.WithFields(logrus.Fields{
+			"ValidatorIndex": idx,
+			"Epoch":          epoch,
+		}).Warn("Attestation was not included")
+	}
+}
@@ -0,0 +1,45 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessEpochSummary_MissedAttestations(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	beaconState, _ := util.DeterministicGenesisState(t, 256)
+
+	s.Lock()
+	s.recordAttestedEpoch(1, 1)
+	s.recordAttestedEpoch(12, 1)
+	s.recordAttestedEpoch(12, 2)
+	s.Unlock()
+
+	s.processEpochSummary(beaconState, 3)
+	require.LogsContain(t, hook, "\"Attestation was not included\" Epoch=1 ValidatorIndex=2 prefix=monitor")
+	require.LogsContain(t, hook, "\"Attestation was not included\" Epoch=1 ValidatorIndex=15 prefix=monitor")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=1 ")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=12 ")
+	require.Equal(t, uint64(15), s.aggregatedPerformance[1].totalRequestedCount)
+	require.Equal(t, uint64(1), s.aggregatedPerformance[2].totalRequestedCount)
+	require.Equal(t, 1, len(s.attestedEpochs))
+
+	// An epoch is only reported once.
+	hook.Reset()
+	s.processEpochSummary(beaconState, 3)
+	require.LogsDoNotContain(t, hook, "Attestation was not included")
+}
+
+func TestProcessEpochSummary_BeforeStartEpoch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	beaconState, _ := util.DeterministicGenesisState(t, 256)
+
+	// The inclusion window of epoch 0 started before the service did.
+	s.processEpochSummary(beaconState, 2)
+	require.LogsDoNotContain(t, hook, "Attestation was not included")
+}
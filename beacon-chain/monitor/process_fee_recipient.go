@@ -0,0 +1,80 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// processFeeRecipient compares the fee recipient of the execution payload of a block proposed by a tracked
+// validator with the fee recipient configured for it, either through the proposer preparation of its validator
+// client or the default fee recipient of the beacon node. A mismatch reveals a misbehaving relay or builder, or a
+// configuration drift between the validator client and the beacon node, so it is logged, counted and notified.
+func (s *Service) processFeeRecipient(ctx context.Context, root [32]byte, blk interfaces.BeaconBlock) {
+	if s.config.BeaconDB == nil {
+		return
+	}
+	s.RLock()
+	tracked := s.trackedIndex(blk.ProposerIndex())
+	s.RUnlock()
+	if !tracked {
+		return
+	}
+	payload, err := blk.Body().Execution()
+	if err != nil {
+		// Blocks before Bellatrix do not have an execution payload.
+		return
+	}
+	if payload.IsNil() || bytesutil.ZeroRoot(payload.BlockHash()) {
+		return
+	}
+
+	expected := params.BeaconConfig().DefaultFeeRecipient
+	recipient, err := s.config.BeaconDB.FeeRecipientByValidatorID(ctx, blk.ProposerIndex())
+	switch {
+	case err == nil:
+		expected = recipient
+	case errors.Is(err, db.ErrNotFound):
+	default:
+		log.WithError(err).Error("Could not get fee recipient")
+		return
+	}
+	if expected == (common.Address{}) {
+		// No fee recipient is configured for the validator.
+		return
+	}
+	actual := common.BytesToAddress(payload.FeeRecipient())
+	if actual == expected {
+		return
+	}
+
+	feeRecipientMismatchCounter.WithLabelValues(fmt.Sprintf("%d", blk.ProposerIndex())).Inc()
+	log.WithFields(logrus.Fields{
+		"ProposerIndex": blk.ProposerIndex(),
+		"Slot":          blk.Slot(),
+		"BlockRoot":     fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		"Expected":      expected.Hex(),
+		"Actual":        actual.Hex(),
+	}).Warn("Proposed block pays an unexpected fee recipient")
+	// The monitor routine itself subscribes to the operation feed, so it must not block on the send.
+	event := &feed.Event{
+		Type: operation.FeeRecipientMismatch,
+		Data: &operation.FeeRecipientMismatchData{
+			Slot:          blk.Slot(),
+			ProposerIndex: blk.ProposerIndex(),
+			BlockRoot:     root,
+			Expected:      expected,
+			Actual:        actual,
+		},
+	}
+	go s.config.AttestationNotifier.OperationFeed().Send(event)
+}
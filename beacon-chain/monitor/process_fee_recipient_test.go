@@ -0,0 +1,72 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessFeeRecipient(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	beaconDB, ok := s.config.BeaconDB.(db.Database)
+	require.Equal(t, true, ok)
+	configured := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	require.NoError(t, beaconDB.SaveFeeRecipientsByValidatorIDs(ctx, []types.ValidatorIndex{2, 3}, []common.Address{configured, configured}))
+
+	tests := []struct {
+		name         string
+		proposer     types.ValidatorIndex
+		feeRecipient common.Address
+		mismatch     bool
+	}{
+		{name: "matching fee recipient", proposer: 2, feeRecipient: configured},
+		{name: "untracked proposer", proposer: 3, feeRecipient: common.HexToAddress("0x22")},
+		{name: "mismatching fee recipient", proposer: 2, feeRecipient: common.HexToAddress("0x22"), mismatch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			opChannel := make(chan *feed.Event, 1)
+			sub := s.config.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+			defer sub.Unsubscribe()
+
+			b := util.NewBeaconBlockBellatrix()
+			b.Block.Slot = 10
+			b.Block.ProposerIndex = tt.proposer
+			b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+			b.Block.Body.ExecutionPayload.FeeRecipient = tt.feeRecipient.Bytes()
+			wb, err := wrapper.WrappedSignedBeaconBlock(b)
+			require.NoError(t, err)
+
+			s.processFeeRecipient(ctx, [32]byte{'a'}, wb.Block())
+			if !tt.mismatch {
+				require.LogsDoNotContain(t, hook, "Proposed block pays an unexpected fee recipient")
+				return
+			}
+			require.LogsContain(t, hook, "Proposed block pays an unexpected fee recipient")
+			select {
+			case e := <-opChannel:
+				require.Equal(t, feed.EventType(operation.FeeRecipientMismatch), e.Type)
+				data, ok := e.Data.(*operation.FeeRecipientMismatchData)
+				require.Equal(t, true, ok)
+				require.Equal(t, configured, data.Expected)
+				require.Equal(t, tt.feeRecipient, data.Actual)
+				require.Equal(t, tt.proposer, data.ProposerIndex)
+			case <-time.After(time.Second):
+				t.Fatal("Did not receive fee recipient mismatch event")
+			}
+		})
+	}
+}
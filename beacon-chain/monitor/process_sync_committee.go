@@ -63,6 +63,8 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.B
 
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
+			syncCommitteeMissedCounter.WithLabelValues(
+				fmt.Sprintf("%d", validatorIdx)).Add(float64(len(committeeIndices) - contrib))
 
 			log.WithFields(logrus.Fields{
 				"ValidatorIndex":       validatorIdx,
@@ -0,0 +1,110 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// upcomingSyncCommitteeWebhookKind is the kind of the webhook notifications of upcoming sync committee duty.
+const upcomingSyncCommitteeWebhookKind = "upcoming_sync_committee"
+
+// upcomingSyncCommitteeNotification is the webhook payload of an upcoming sync committee duty.
+type upcomingSyncCommitteeNotification struct {
+	ValidatorIndex types.ValidatorIndex `json:"validator_index"`
+	Period         uint64               `json:"period"`
+	StartEpoch     types.Epoch          `json:"start_epoch"`
+	EndEpoch       types.Epoch          `json:"end_epoch"`
+	StartTime      time.Time            `json:"start_time"`
+	EndTime        time.Time            `json:"end_time"`
+}
+
+// processUpcomingSyncCommittee notifies, once per sync committee period, the tracked validators which are members of
+// the sync committee of the next period. The next sync committee is known for the whole current period, so operators
+// learn ahead of time the exact boundaries of the duty windows during which they should avoid maintenance.
+func (s *Service) processUpcomingSyncCommittee(st state.BeaconState, epoch types.Epoch) {
+	if st.Version() == version.Phase0 {
+		return
+	}
+	period := slots.SyncCommitteePeriod(epoch) + 1
+	s.Lock()
+	if period <= s.lastNotifiedSyncCommitteePeriod {
+		s.Unlock()
+		return
+	}
+	s.lastNotifiedSyncCommitteePeriod = period
+	tracked := make([]types.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		tracked = append(tracked, idx)
+	}
+	s.Unlock()
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
+
+	epochsPerPeriod := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	startEpoch := types.Epoch(period) * epochsPerPeriod
+	endEpoch := startEpoch + epochsPerPeriod - 1
+	startSlot, err := slots.EpochStart(startEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not compute start slot of the next sync committee period")
+		return
+	}
+	endSlot, err := slots.EpochEnd(endEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not compute end slot of the next sync committee period")
+		return
+	}
+	startTime := slots.StartTime(st.GenesisTime(), startSlot)
+	endTime := slots.StartTime(st.GenesisTime(), endSlot+1)
+
+	for _, idx := range tracked {
+		member, err := helpers.IsNextPeriodSyncCommittee(st, idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error(
+				"Could not determine next period sync committee membership")
+			continue
+		}
+		if !member {
+			continue
+		}
+		upcomingSyncCommitteeGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(startEpoch))
+		log.WithFields(logrus.Fields{
+			"ValidatorIndex": idx,
+			"Period":         period,
+			"StartEpoch":     startEpoch,
+			"EndEpoch":       endEpoch,
+			"StartTime":      startTime.UTC().Format(time.RFC3339),
+			"EndTime":        endTime.UTC().Format(time.RFC3339),
+		}).Info("Validator will be in the next sync committee, avoid maintenance during the period")
+		// The monitor routine itself subscribes to the operation feed, so it must not block on the send.
+		event := &feed.Event{
+			Type: operation.UpcomingSyncCommittee,
+			Data: &operation.UpcomingSyncCommitteeData{
+				ValidatorIndex: idx,
+				Period:         period,
+				StartEpoch:     startEpoch,
+				EndEpoch:       endEpoch,
+				StartTime:      startTime,
+				EndTime:        endTime,
+			},
+		}
+		go s.config.AttestationNotifier.OperationFeed().Send(event)
+		s.notifyWebhook(upcomingSyncCommitteeWebhookKind, &upcomingSyncCommitteeNotification{
+			ValidatorIndex: idx,
+			Period:         period,
+			StartEpoch:     startEpoch,
+			EndEpoch:       endEpoch,
+			StartTime:      startTime,
+			EndTime:        endTime,
+		})
+	}
+}
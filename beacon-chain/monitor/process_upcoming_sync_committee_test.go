@@ -0,0 +1,87 @@
+package monitor
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProcessUpcomingSyncCommittee(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	// Sync committee positions are cached by the root of the period boundary, so the
+	// state is given a boundary root which no other test caches positions for.
+	require.NoError(t, st.SetSlot(1))
+	roots := st.BlockRoots()
+	roots[0] = bytesutil.PadTo([]byte("upcoming sync committee"), 32)
+	require.NoError(t, st.SetBlockRoots(roots))
+	require.NoError(t, st.SetNextSyncCommittee(util.ConvertToCommittee([][]byte{
+		st.Validators()[12].PublicKey, st.Validators()[3].PublicKey,
+	})))
+
+	notifications := make(chan *webhookNotification, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		n := &webhookNotification{Data: &upcomingSyncCommitteeNotification{}}
+		require.NoError(t, json.Unmarshal(body, n))
+		notifications <- n
+	}))
+	defer srv.Close()
+	s.config.WebhookURL = srv.URL
+
+	opChannel := make(chan *feed.Event, 1)
+	sub := s.config.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer sub.Unsubscribe()
+
+	s.processUpcomingSyncCommittee(st, 1)
+	require.LogsContain(t, hook, "Validator will be in the next sync committee")
+	require.LogsContain(t, hook, "ValidatorIndex=12")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=1 ")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=3")
+
+	epochsPerPeriod := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	select {
+	case e := <-opChannel:
+		require.Equal(t, feed.EventType(operation.UpcomingSyncCommittee), e.Type)
+		data, ok := e.Data.(*operation.UpcomingSyncCommitteeData)
+		require.Equal(t, true, ok)
+		require.Equal(t, types.ValidatorIndex(12), data.ValidatorIndex)
+		require.Equal(t, uint64(1), data.Period)
+		require.Equal(t, epochsPerPeriod, data.StartEpoch)
+		require.Equal(t, 2*epochsPerPeriod-1, data.EndEpoch)
+		periodDuration := time.Duration(uint64(epochsPerPeriod)*uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+		require.Equal(t, periodDuration, data.EndTime.Sub(data.StartTime))
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive upcoming sync committee event")
+	}
+
+	select {
+	case n := <-notifications:
+		require.Equal(t, upcomingSyncCommitteeWebhookKind, n.Kind)
+		data, ok := n.Data.(*upcomingSyncCommitteeNotification)
+		require.Equal(t, true, ok)
+		require.Equal(t, types.ValidatorIndex(12), data.ValidatorIndex)
+		require.Equal(t, epochsPerPeriod, data.StartEpoch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive webhook notification")
+	}
+
+	// The tracked validators are only notified once per period.
+	hook.Reset()
+	s.processUpcomingSyncCommittee(st, 2)
+	require.LogsDoNotContain(t, hook, "Validator will be in the next sync committee")
+}
@@ -8,10 +8,12 @@ import (
 
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -63,6 +65,11 @@ type ValidatorMonitorConfig struct {
 	AttestationNotifier operation.Notifier
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
+	BeaconDB            db.ReadOnlyDatabase
+	// BlockArrivals, when set, holds the arrival times of gossiped blocks, used to warn about late proposals.
+	BlockArrivals *cache.BlockArrivalCache
+	// WebhookURL, when set, receives notifications about the tracked validators as JSON.
+	WebhookURL string
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -74,7 +81,8 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch, attestedEpochs, lastReportedEpoch and
+	// lastNotifiedSyncCommitteePeriod
 	sync.RWMutex
 
 	TrackedValidators           map[types.ValidatorIndex]bool
@@ -82,6 +90,11 @@ type Service struct {
 	aggregatedPerformance       map[types.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[types.ValidatorIndex][]types.CommitteeIndex
 	lastSyncedEpoch             types.Epoch
+	attestedEpochs              map[types.Epoch]map[types.ValidatorIndex]bool
+	lastReportedEpoch           types.Epoch
+	// lastNotifiedSyncCommitteePeriod is the latest upcoming sync committee period the tracked validators
+	// were checked against.
+	lastNotifiedSyncCommitteePeriod uint64
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -95,6 +108,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []t
 		latestPerformance:           make(map[types.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[types.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[types.ValidatorIndex][]types.CommitteeIndex),
+		attestedEpochs:              make(map[types.Epoch]map[types.ValidatorIndex]bool),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
@@ -153,6 +167,7 @@ func (s *Service) run(stateChannel chan *feed.Event, stateSub event.Subscription
 	s.Unlock()
 
 	s.updateSyncCommitteeTrackedVals(st)
+	s.processUpcomingSyncCommittee(st, epoch)
 
 	s.Lock()
 	s.isLogging = true
@@ -179,6 +194,7 @@ func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch
 			balance: balance,
 		}
 	}
+	s.lastReportedEpoch = epoch
 }
 
 // Status retrieves the status of the service.
@@ -92,6 +92,7 @@ func setupService(t *testing.T) *Service {
 			StateNotifier:       chainService.StateNotifier(),
 			HeadFetcher:         chainService,
 			AttestationNotifier: chainService.OperationNotifier(),
+			BeaconDB:            beaconDB,
 		},
 
 		ctx:                         context.Background(),
@@ -0,0 +1,52 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// webhookTimeout bounds the time spent posting a notification to the webhook.
+const webhookTimeout = 10 * time.Second
+
+// webhookNotification is the JSON body posted to the webhook.
+type webhookNotification struct {
+	Kind string      `json:"kind"`
+	Data interface{} `json:"data"`
+}
+
+// notifyWebhook posts a notification of the given kind to the webhook configured for the monitor, if any. The
+// notification is sent in the background so that a slow or unreachable webhook never delays the monitor.
+func (s *Service) notifyWebhook(kind string, data interface{}) {
+	if s.config.WebhookURL == "" {
+		return
+	}
+	body, err := json.Marshal(&webhookNotification{Kind: kind, Data: data})
+	if err != nil {
+		log.WithError(err).Error("Could not marshal webhook notification")
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(s.ctx, webhookTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.WebhookURL, bytes.NewReader(body))
+		if err != nil {
+			log.WithError(err).Error("Could not create webhook request")
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.WithError(err).WithField("Kind", kind).Error("Could not post webhook notification")
+			return
+		}
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close webhook response body")
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			log.WithField("Kind", kind).WithField("Status", resp.Status).Error("Webhook rejected notification")
+		}
+	}()
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	tracing2 "github.com/prysmaticlabs/prysm/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -28,7 +30,12 @@ func configureTracing(cliCtx *cli.Context) error {
 func configureChainConfig(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
 		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
-		return params.LoadChainConfigFile(chainConfigFileName, nil)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return err
+		}
+		if err := params.ValidatePreset(params.BeaconConfig()); err != nil {
+			return errors.Wrapf(err, "chain config file %s is not supported by this build", chainConfigFileName)
+		}
 	}
 	return nil
 }
@@ -99,6 +106,34 @@ func configureEth1Config(cliCtx *cli.Context) error {
 	return nil
 }
 
+// configureForkEpochs applies the fork epochs set on the command line over the ones of the chain config.
+func configureForkEpochs(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(flags.AltairForkEpoch.Name) &&
+		!cliCtx.IsSet(flags.BellatrixForkEpoch.Name) &&
+		!cliCtx.IsSet(flags.CapellaForkEpoch.Name) {
+		return nil
+	}
+	c := params.BeaconConfig().Copy()
+	if cliCtx.IsSet(flags.AltairForkEpoch.Name) {
+		c.AltairForkEpoch = types.Epoch(cliCtx.Uint64(flags.AltairForkEpoch.Name))
+	}
+	if cliCtx.IsSet(flags.BellatrixForkEpoch.Name) {
+		c.BellatrixForkEpoch = types.Epoch(cliCtx.Uint64(flags.BellatrixForkEpoch.Name))
+	}
+	if cliCtx.IsSet(flags.CapellaForkEpoch.Name) {
+		c.CapellaForkEpoch = types.Epoch(cliCtx.Uint64(flags.CapellaForkEpoch.Name))
+	}
+	if err := forks.ValidateForkEpochs(c); err != nil {
+		return errors.Wrap(err, "invalid fork epochs")
+	}
+	log.WithFields(logrus.Fields{
+		"altair":    c.AltairForkEpoch,
+		"bellatrix": c.BellatrixForkEpoch,
+		"capella":   c.CapellaForkEpoch,
+	}).Warn("Overriding the fork epochs of the chain config")
+	return params.SetActive(c)
+}
+
 func configureNetwork(cliCtx *cli.Context) {
 	if cliCtx.IsSet(cmd.BootstrapNode.Name) {
 		c := params.BeaconNetworkConfig()
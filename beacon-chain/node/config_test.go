@@ -3,6 +3,7 @@ package node
 import (
 	"flag"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -51,6 +54,47 @@ func TestConfigureSafeSlotsToImportOptimistically(t *testing.T) {
 	assert.Equal(t, types.Slot(128), params.BeaconConfig().SafeSlotsToImportOptimistically)
 }
 
+func TestConfigureChainConfig_ValidatesPreset(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	dir := t.TempDir()
+	mainnetPath := filepath.Join(dir, "mainnet.yaml")
+	minimalPath := filepath.Join(dir, "minimal.yaml")
+	require.NoError(t, file.WriteFile(mainnetPath, params.ConfigToYaml(params.MainnetConfig())))
+	require.NoError(t, file.WriteFile(minimalPath, params.ConfigToYaml(params.MinimalSpecConfig())))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.ChainConfigFileFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.ChainConfigFileFlag.Name, mainnetPath))
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, configureChainConfig(cliCtx))
+
+	require.NoError(t, set.Set(cmd.ChainConfigFileFlag.Name, minimalPath))
+	require.ErrorContains(t, "is not supported by this build", configureChainConfig(cliCtx))
+}
+
+func TestConfigureForkEpochs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.BellatrixForkEpoch.Name, 0, "")
+	set.Uint64(flags.CapellaForkEpoch.Name, 0, "")
+	require.NoError(t, set.Set(flags.BellatrixForkEpoch.Name, strconv.Itoa(int(params.BeaconConfig().AltairForkEpoch)+10)))
+	require.NoError(t, set.Set(flags.CapellaForkEpoch.Name, strconv.Itoa(int(params.BeaconConfig().AltairForkEpoch)+20)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	altairEpoch := params.BeaconConfig().AltairForkEpoch
+	require.NoError(t, configureForkEpochs(cliCtx))
+	assert.Equal(t, altairEpoch, params.BeaconConfig().AltairForkEpoch)
+	assert.Equal(t, altairEpoch+10, params.BeaconConfig().BellatrixForkEpoch)
+	assert.Equal(t, altairEpoch+20, params.BeaconConfig().CapellaForkEpoch)
+	assert.Equal(t, altairEpoch+10, params.BeaconConfig().ForkVersionSchedule[bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion)])
+
+	require.NoError(t, set.Set(flags.CapellaForkEpoch.Name, "1"))
+	require.ErrorContains(t, "capella fork epoch 1 is before the bellatrix fork epoch", configureForkEpochs(cliCtx))
+}
+
 func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 
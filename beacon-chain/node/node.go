@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
@@ -31,9 +32,12 @@ import (
 	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/invariants"
+	"github.com/prysmaticlabs/prysm/beacon-chain/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -57,7 +61,9 @@ import (
 	"github.com/prysmaticlabs/prysm/container/slice"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	"github.com/prysmaticlabs/prysm/monitoring/profiler"
 	"github.com/prysmaticlabs/prysm/monitoring/prometheus"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime"
 	"github.com/prysmaticlabs/prysm/runtime/debug"
 	"github.com/prysmaticlabs/prysm/runtime/prereqs"
@@ -95,14 +101,17 @@ type BeaconNode struct {
 	attestationPool         attestations.Pool
 	exitPool                voluntaryexits.PoolManager
 	slashingsPool           slashings.PoolManager
+	blsToExecPool           blstoexec.PoolManager
 	syncCommitteePool       synccommittee.Pool
 	depositCache            *depositcache.DepositCache
 	proposerIdsCache        *cache.ProposerPayloadIDsCache
+	blockArrivals           *cache.BlockArrivalCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
 	forkChoiceStore         forkchoice.ForkChoicer
 	stateGen                *stategen.State
+	memoryGovernor          *memory.Service
 	collector               *bcnodeCollector
 	slasherBlockHeadersFeed *event.Feed
 	slasherAttestationsFeed *event.Feed
@@ -146,6 +155,9 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err := configureInteropConfig(cliCtx); err != nil {
 		return nil, err
 	}
+	if err := configureForkEpochs(cliCtx); err != nil {
+		return nil, err
+	}
 	if err := configureExecutionSetting(cliCtx); err != nil {
 		return nil, err
 	}
@@ -169,11 +181,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		attestationPool:         attestations.NewPool(),
 		exitPool:                voluntaryexits.NewPool(),
 		slashingsPool:           slashings.NewPool(),
+		blsToExecPool:           blstoexec.NewPool(),
 		syncCommitteePool:       synccommittee.NewPool(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		blockArrivals:           cache.NewBlockArrivalCache(),
 	}
 
 	for _, opt := range opts {
@@ -191,6 +205,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.loadBLSToExecChanges(ctx); err != nil {
+		return nil, err
+	}
+	if err := beacon.loadPendingOperations(ctx); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, err
@@ -201,6 +222,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, errors.Wrap(err, "backfill status initialization error")
 	}
 
+	if cliCtx.Uint64(flags.MaxMemory.Name) > 0 {
+		log.Debugln("Registering Memory Governor Service")
+		if err := beacon.registerMemoryGovernorService(); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Debugln("Starting State Gen")
 	if err := beacon.startStateGen(ctx, bfs); err != nil {
 		return nil, err
@@ -254,6 +282,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.String(flags.ProfileSnapshotDir.Name) != "" {
+		log.Debugln("Registering Profiler Service")
+		if err := beacon.registerProfilerService(); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Debugln("Registering RPC Service")
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
@@ -269,6 +304,13 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.EnableInvariantChecks.Name) {
+		log.Debugln("Registering Invariant Checker Service")
+		if err := beacon.registerInvariantCheckerService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		log.Debugln("Registering Prometheus Service")
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
@@ -344,6 +386,12 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
+	if err := b.db.SaveBLSToExecChanges(b.ctx, b.blsToExecPool.PendingBLSToExecChanges()); err != nil {
+		log.WithError(err).Error("Failed to save BLS to execution changes")
+	}
+	if err := b.savePendingOperations(b.ctx); err != nil {
+		log.WithError(err).Error("Failed to save pending operations")
+	}
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
@@ -368,8 +416,14 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
+	blobRetention := types.Epoch(cliCtx.Uint64(flags.BlobRetentionEpochs.Name))
+	if minRetention := params.BeaconNetworkConfig().MinEpochsForBlobSidecarsRequest; cliCtx.IsSet(flags.BlobRetentionEpochs.Name) && blobRetention < minRetention {
+		return fmt.Errorf("blob retention of %d epochs is below the minimum of %d epochs", blobRetention, minRetention)
+	}
 	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
-		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		InitialMMapSize:     cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		FreezerPath:         cliCtx.String(flags.FreezerDir.Name),
+		BlobRetentionEpochs: blobRetention,
 	})
 	if err != nil {
 		return err
@@ -393,7 +447,9 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 			return errors.Wrap(err, "could not clear database")
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
-			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			InitialMMapSize:     cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			FreezerPath:         cliCtx.String(flags.FreezerDir.Name),
+			BlobRetentionEpochs: blobRetention,
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -453,6 +509,82 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// loadBLSToExecChanges restores the BLS to execution changes which were pending when the node was last stopped.
+func (b *BeaconNode) loadBLSToExecChanges(ctx context.Context) error {
+	changes, err := b.db.BLSToExecChanges(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load BLS to execution changes")
+	}
+	for _, c := range changes {
+		b.blsToExecPool.InsertBLSToExecChange(c)
+	}
+	if len(changes) > 0 {
+		log.WithField("count", len(changes)).Info("Loaded pending BLS to execution changes")
+	}
+	return nil
+}
+
+// savePendingOperations saves the attestations and sync committee objects which are pending in the pools,
+// so that they are not lost when the node is restarted.
+func (b *BeaconNode) savePendingOperations(ctx context.Context) error {
+	unaggregated, err := b.attestationPool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	messages, err := b.syncCommitteePool.AllSyncCommitteeMessages()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee messages")
+	}
+	contributions, err := b.syncCommitteePool.AllSyncCommitteeContributions()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee contributions")
+	}
+	ops := &ethpb.PendingOperations{
+		Attestations:               append(b.attestationPool.AggregatedAttestations(), unaggregated...),
+		SyncCommitteeMessages:      messages,
+		SyncCommitteeContributions: contributions,
+	}
+	return b.db.SavePendingOperations(ctx, ops)
+}
+
+// loadPendingOperations restores the attestations and sync committee objects which were pending in the pools
+// when the node was last stopped.
+func (b *BeaconNode) loadPendingOperations(ctx context.Context) error {
+	ops, err := b.db.PendingOperations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load pending operations")
+	}
+	for _, att := range ops.Attestations {
+		if helpers.IsAggregated(att) {
+			err = b.attestationPool.SaveAggregatedAttestation(att)
+		} else {
+			err = b.attestationPool.SaveUnaggregatedAttestation(att)
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not restore attestation")
+		}
+	}
+	for _, msg := range ops.SyncCommitteeMessages {
+		if err := b.syncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
+			return errors.Wrap(err, "could not restore sync committee message")
+		}
+	}
+	for _, cont := range ops.SyncCommitteeContributions {
+		if err := b.syncCommitteePool.SaveSyncCommitteeContribution(cont); err != nil {
+			return errors.Wrap(err, "could not restore sync committee contribution")
+		}
+	}
+	count := len(ops.Attestations) + len(ops.SyncCommitteeMessages) + len(ops.SyncCommitteeContributions)
+	if count > 0 {
+		log.WithFields(logrus.Fields{
+			"attestations":               len(ops.Attestations),
+			"syncCommitteeMessages":      len(ops.SyncCommitteeMessages),
+			"syncCommitteeContributions": len(ops.SyncCommitteeContributions),
+		}).Info("Loaded pending operations")
+	}
+	return nil
+}
+
 func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -462,10 +594,19 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
 
-	log.WithField("database-path", dbPath).Info("Checking DB")
+	backend, err := slasherkv.ParseBackend(cliCtx.String(flags.SlasherDBBackend.Name))
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"database-path": dbPath,
+		"backend":       backend,
+	}).Info("Checking DB")
 
 	d, err := slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
 		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         backend,
 	})
 	if err != nil {
 		return err
@@ -490,6 +631,7 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 		}
 		d, err = slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
 			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			Backend:         backend,
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -501,7 +643,21 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 }
 
 func (b *BeaconNode) startStateGen(ctx context.Context, bfs *backfill.Status) error {
-	opts := []stategen.StateGenOption{stategen.WithBackfillStatus(bfs)}
+	opts := []stategen.StateGenOption{
+		stategen.WithBackfillStatus(bfs),
+		stategen.WithMemoryGovernor(b.memoryGovernor),
+	}
+	// Finalized states are only pruned online when a state retention mode is explicitly set.
+	if b.cliCtx.IsSet(flags.StateRetention.Name) {
+		retention, err := db.ParseStateRetention(b.cliCtx.String(flags.StateRetention.Name))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, stategen.WithStateRetention(retention))
+	}
+	if interval := b.cliCtx.Uint64(flags.HotStateSnapshotInterval.Name); interval > 0 {
+		opts = append(opts, stategen.WithHotStateSnapshotInterval(types.Slot(interval)))
+	}
 	sg := stategen.New(b.db, opts...)
 
 	cp, err := b.db.FinalizedCheckpoint(ctx)
@@ -538,27 +694,41 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	staticPeers := slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name))
+	staticPeers = append(staticPeers, slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PStaticPeers.Name))...)
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootstrapNodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           dataDir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
-		DB:                b.db,
+		NoDiscovery:         cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:         staticPeers,
+		SentryPeers:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.SentryPeers.Name)),
+		TrustedPeers:        slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PTrustedPeers.Name)),
+		BootstrapNodeAddr:   bootstrapNodeAddrs,
+		RelayNodeAddr:       cliCtx.String(cmd.RelayNode.Name),
+		DataDir:             dataDir,
+		LocalIP:             cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:         cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:             cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:          cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:         cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:             cliCtx.Uint(cmd.P2PTCPPort.Name),
+		QUICPort:            cliCtx.Uint(cmd.P2PQUICPort.Name),
+		UDPPort:             cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:            cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:       cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:        slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		MaxPeersPerIP:       cliCtx.Uint(cmd.P2PMaxPeersPerIP.Name),
+		MaxPeersPerSubnet:   cliCtx.Uint(cmd.P2PMaxPeersPerSubnet.Name),
+		MaxPeersPerASN:      cliCtx.Uint(cmd.P2PMaxPeersPerASN.Name),
+		ASNDatabase:         cliCtx.String(cmd.P2PASNDatabase.Name),
+		ColocationExempt:    slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PColocationAllowList.Name)),
+		DisableIdentifyPush: cliCtx.Bool(cmd.P2PDisableIdentifyPush.Name),
+		EnableIdentifyDelta: cliCtx.Bool(cmd.P2PEnableIdentifyDelta.Name),
+		UserAgent:           cliCtx.String(cmd.P2PUserAgent.Name),
+		EnableUPnP:          cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:       cliCtx.Bool(flags.DisableDiscv5.Name),
+		StateNotifier:       b,
+		OperationNotifier:   b,
+		DB:                  b.db,
 	})
 	if err != nil {
 		return err
@@ -609,10 +779,12 @@ func (b *BeaconNode) registerBlockchainService() error {
 		blockchain.WithDatabase(b.db),
 		blockchain.WithDepositCache(b.depositCache),
 		blockchain.WithChainStartFetcher(web3Service),
+		blockchain.WithBlockFetcher(web3Service),
 		blockchain.WithExecutionEngineCaller(web3Service),
 		blockchain.WithAttestationPool(b.attestationPool),
 		blockchain.WithExitPool(b.exitPool),
 		blockchain.WithSlashingPool(b.slashingsPool),
+		blockchain.WithBLSToExecPool(b.blsToExecPool),
 		blockchain.WithP2PBroadcaster(b.fetchP2P()),
 		blockchain.WithStateNotifier(b),
 		blockchain.WithForkChoiceStore(b.forkChoiceStore),
@@ -689,12 +861,15 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithOperationNotifier(b),
 		regularsync.WithAttestationPool(b.attestationPool),
 		regularsync.WithExitPool(b.exitPool),
+		regularsync.WithBLSToExecPool(b.blsToExecPool),
 		regularsync.WithSlashingPool(b.slashingsPool),
 		regularsync.WithSyncCommsPool(b.syncCommitteePool),
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
+		regularsync.WithMemoryGovernor(b.memoryGovernor),
+		regularsync.WithBlockArrivalCache(b.blockArrivals),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -711,6 +886,7 @@ func (b *BeaconNode) registerInitialSyncService() error {
 		P2P:           b.fetchP2P(),
 		StateNotifier: b,
 		BlockNotifier: b,
+		Governor:      b.memoryGovernor,
 	})
 	return b.services.RegisterService(is)
 }
@@ -768,6 +944,15 @@ func (b *BeaconNode) registerRPCService() error {
 		}
 	}
 
+	var profileSnapshotter profiler.Snapshotter
+	if b.cliCtx.String(flags.ProfileSnapshotDir.Name) != "" {
+		var profilerService *profiler.Service
+		if err := b.services.FetchService(&profilerService); err != nil {
+			return err
+		}
+		profileSnapshotter = profilerService
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -816,7 +1001,9 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainInfoFetcher:              chainService,
 		HeadUpdater:                   chainService,
 		HeadFetcher:                   chainService,
+		HeadSnapshotFetcher:           chainService,
 		CanonicalFetcher:              chainService,
+		AncestryFetcher:               chainService,
 		ForkFetcher:                   chainService,
 		FinalizationFetcher:           chainService,
 		BlockReceiver:                 chainService,
@@ -826,6 +1013,7 @@ func (b *BeaconNode) registerRPCService() error {
 		OptimisticModeFetcher:         chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
+		BLSToExecPool:                 b.blsToExecPool,
 		SlashingsPool:                 b.slashingsPool,
 		SlashingChecker:               slasherService,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
@@ -843,7 +1031,10 @@ func (b *BeaconNode) registerRPCService() error {
 		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
+		BlockArrivals:                 b.blockArrivals,
 		BlockBuilder:                  b.fetchBuilderService(),
+		ProfileSnapshotter:            profileSnapshotter,
+		MemoryGovernor:                b.memoryGovernor,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -919,6 +1110,9 @@ func (b *BeaconNode) registerGRPCGateway() error {
 		apigateway.WithMaxCallRecvMsgSize(maxCallSize),
 		apigateway.WithAllowedOrigins(allowedOrigins),
 		apigateway.WithTimeout(uint64(timeout)),
+		apigateway.WithSocketPath(b.cliCtx.String(flags.GRPCGatewaySocket.Name)),
+		apigateway.WithSocketActivation(b.cliCtx.Bool(flags.GRPCGatewaySocketActivation.Name)),
+		apigateway.WithPathPrefix(b.cliCtx.String(flags.GRPCGatewayPathPrefix.Name)),
 	}
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
@@ -980,6 +1174,9 @@ func (b *BeaconNode) registerValidatorMonitorService() error {
 		AttestationNotifier: b,
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
+		BeaconDB:            b.db,
+		BlockArrivals:       b.blockArrivals,
+		WebhookURL:          b.cliCtx.String(cmd.ValidatorMonitorWebhookFlag.Name),
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
@@ -988,6 +1185,48 @@ func (b *BeaconNode) registerValidatorMonitorService() error {
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerInvariantCheckerService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := invariants.NewService(b.ctx, &invariants.Config{
+		Database:            b.db,
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+		ForkFetcher:         chainService,
+		TimeFetcher:         chainService,
+		StateNotifier:       b,
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerProfilerService() error {
+	svc, err := profiler.NewService(b.ctx, &profiler.Config{
+		Dir:                b.cliCtx.String(flags.ProfileSnapshotDir.Name),
+		HeapThreshold:      b.cliCtx.Uint64(flags.ProfileHeapThreshold.Name) * 1024 * 1024,
+		GoroutineThreshold: b.cliCtx.Int(flags.ProfileGoroutineThreshold.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerMemoryGovernorService() error {
+	svc, err := memory.NewService(b.ctx, &memory.Config{
+		MaxMemory: b.cliCtx.Uint64(flags.MaxMemory.Name) * 1024 * 1024,
+	})
+	if err != nil {
+		return err
+	}
+	b.memoryGovernor = svc
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerBuilderService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
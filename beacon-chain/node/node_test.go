@@ -1,16 +1,24 @@
 package node
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/cmd"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
 )
@@ -66,3 +74,56 @@ func TestClearDB(t *testing.T) {
 
 	require.LogsContain(t, hook, "Removing database")
 }
+
+// pendingOperationsDB is an in-memory database storing only the pending pool operations.
+type pendingOperationsDB struct {
+	db.Database
+	ops *ethpb.PendingOperations
+}
+
+func (d *pendingOperationsDB) SavePendingOperations(_ context.Context, ops *ethpb.PendingOperations) error {
+	d.ops = ops
+	return nil
+}
+
+func (d *pendingOperationsDB) PendingOperations(_ context.Context) (*ethpb.PendingOperations, error) {
+	if d.ops == nil {
+		return &ethpb.PendingOperations{}, nil
+	}
+	return d.ops, nil
+}
+
+func TestPendingOperations_SaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := &pendingOperationsDB{}
+
+	aggregated := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}})
+	unaggregated := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001}})
+	msg := &ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: make([]byte, 32), Signature: make([]byte, 96)}
+	cont := &ethpb.SyncCommitteeContribution{
+		Slot:            1,
+		BlockRoot:       make([]byte, 32),
+		AggregationBits: bitfield.NewBitvector128(),
+		Signature:       make([]byte, 96),
+	}
+
+	stopped := &BeaconNode{db: beaconDB, attestationPool: attestations.NewPool(), syncCommitteePool: synccommittee.NewPool()}
+	require.NoError(t, stopped.attestationPool.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, stopped.attestationPool.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, stopped.syncCommitteePool.SaveSyncCommitteeMessage(msg))
+	require.NoError(t, stopped.syncCommitteePool.SaveSyncCommitteeContribution(cont))
+	require.NoError(t, stopped.savePendingOperations(ctx))
+
+	started := &BeaconNode{db: beaconDB, attestationPool: attestations.NewPool(), syncCommitteePool: synccommittee.NewPool()}
+	require.NoError(t, started.loadPendingOperations(ctx))
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{aggregated}, started.attestationPool.AggregatedAttestations())
+	atts, err := started.attestationPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.Attestation{unaggregated}, atts)
+	msgs, err := started.syncCommitteePool.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.SyncCommitteeMessage{msg}, msgs)
+	conts, err := started.syncCommitteePool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, []*ethpb.SyncCommitteeContribution{cont}, conts)
+}
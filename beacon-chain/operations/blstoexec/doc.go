@@ -0,0 +1,4 @@
+// Package blstoexec defines an in-memory pool of received BLS to execution
+// change messages by the beacon node, handling their lifecycle and serving
+// them as objects for validators to include in blocks.
+package blstoexec
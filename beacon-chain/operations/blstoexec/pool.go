@@ -0,0 +1,138 @@
+package blstoexec
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// PoolManager maintains pending BLS to execution changes.
+// This pool is used by proposers to insert BLS to execution changes into new blocks.
+type PoolManager interface {
+	PendingBLSToExecChanges() []*ethpb.SignedBLSToExecutionChange
+	BLSToExecChangesForInclusion(state state.ReadOnlyBeaconState) []*ethpb.SignedBLSToExecutionChange
+	InsertBLSToExecChange(change *ethpb.SignedBLSToExecutionChange)
+	MarkIncluded(change *ethpb.SignedBLSToExecutionChange)
+	PruneChanges(state state.ReadOnlyBeaconState) int
+	ValidatorExists(idx types.ValidatorIndex) bool
+}
+
+// Pool is a concrete implementation of PoolManager.
+type Pool struct {
+	lock    sync.RWMutex
+	pending []*ethpb.SignedBLSToExecutionChange
+}
+
+// NewPool returns an initialized BLS to execution change pool.
+func NewPool() *Pool {
+	return &Pool{
+		pending: make([]*ethpb.SignedBLSToExecutionChange, 0),
+	}
+}
+
+// PendingBLSToExecChanges returns all the changes in the pool, ordered by validator index.
+func (p *Pool) PendingBLSToExecChanges() []*ethpb.SignedBLSToExecutionChange {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	pending := make([]*ethpb.SignedBLSToExecutionChange, len(p.pending))
+	copy(pending, p.pending)
+	return pending
+}
+
+// BLSToExecChangesForInclusion returns the changes which are still valid against the given state, up to the
+// block enforced MaxBlsToExecutionChanges. Changes of validators whose withdrawal credentials were changed
+// already are skipped, their signatures were verified on insertion.
+func (p *Pool) BLSToExecChangesForInclusion(st state.ReadOnlyBeaconState) []*ethpb.SignedBLSToExecutionChange {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	maxChanges := params.BeaconConfig().MaxBlsToExecutionChanges
+	changes := make([]*ethpb.SignedBLSToExecutionChange, 0, maxChanges)
+	for _, c := range p.pending {
+		if _, err := blocks.ValidateBLSToExecutionChange(st, c); err != nil {
+			continue
+		}
+		changes = append(changes, c)
+		if uint64(len(changes)) == maxChanges {
+			break
+		}
+	}
+	return changes
+}
+
+// InsertBLSToExecChange into the pool. This method is a no-op if a change of the same validator already
+// exists, as a validator can only change its withdrawal credentials once.
+func (p *Pool) InsertBLSToExecChange(change *ethpb.SignedBLSToExecutionChange) {
+	// Prevent malformed messages from being inserted.
+	if change == nil || change.Message == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if exists, _ := existsInList(p.pending, change.Message.ValidatorIndex); exists {
+		return
+	}
+	p.pending = append(p.pending, change)
+	sort.Slice(p.pending, func(i, j int) bool {
+		return p.pending[i].Message.ValidatorIndex < p.pending[j].Message.ValidatorIndex
+	})
+}
+
+// MarkIncluded is used when a change has been included in a beacon block. Every block seen by this node
+// should call this method to include the change. This will remove the change from the pool.
+func (p *Pool) MarkIncluded(change *ethpb.SignedBLSToExecutionChange) {
+	if change == nil || change.Message == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if exists, index := existsInList(p.pending, change.Message.ValidatorIndex); exists {
+		p.pending = append(p.pending[:index], p.pending[index+1:]...)
+	}
+}
+
+// PruneChanges removes the changes which are not valid anymore against the given state, such as the changes of
+// validators whose withdrawal credentials were changed to an execution address already. It returns the number of
+// changes removed.
+func (p *Pool) PruneChanges(st state.ReadOnlyBeaconState) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	kept := p.pending[:0]
+	for _, c := range p.pending {
+		if _, err := blocks.ValidateBLSToExecutionChange(st, c); err != nil {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	pruned := len(p.pending) - len(kept)
+	for i := len(kept); i < len(p.pending); i++ {
+		p.pending[i] = nil
+	}
+	p.pending = kept
+	return pruned
+}
+
+// ValidatorExists checks if the pool holds a change of the given validator.
+func (p *Pool) ValidatorExists(idx types.ValidatorIndex) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	exists, _ := existsInList(p.pending, idx)
+	return exists
+}
+
+// Binary search to check if the index exists in the list of pending changes.
+func existsInList(pending []*ethpb.SignedBLSToExecutionChange, searchingFor types.ValidatorIndex) (bool, int) {
+	i := sort.Search(len(pending), func(j int) bool {
+		return pending[j].Message.ValidatorIndex >= searchingFor
+	})
+	if i < len(pending) && pending[i].Message.ValidatorIndex == searchingFor {
+		return true, i
+	}
+	return false, -1
+}
@@ -0,0 +1,110 @@
+package blstoexec
+
+import (
+	"testing"
+
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func change(idx types.ValidatorIndex, pubkey byte) *ethpb.SignedBLSToExecutionChange {
+	pk := make([]byte, 48)
+	pk[0] = pubkey
+	return &ethpb.SignedBLSToExecutionChange{
+		Message: &ethpb.BLSToExecutionChange{
+			ValidatorIndex:     idx,
+			FromBlsPubkey:      pk,
+			ToExecutionAddress: make([]byte, 20),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestPool_InsertBLSToExecChange(t *testing.T) {
+	p := NewPool()
+	p.InsertBLSToExecChange(nil)
+	p.InsertBLSToExecChange(&ethpb.SignedBLSToExecutionChange{})
+	assert.Equal(t, 0, len(p.PendingBLSToExecChanges()))
+
+	p.InsertBLSToExecChange(change(3, 1))
+	p.InsertBLSToExecChange(change(1, 1))
+	p.InsertBLSToExecChange(change(2, 1))
+	// Only the first change of a validator is kept.
+	p.InsertBLSToExecChange(change(1, 2))
+
+	pending := p.PendingBLSToExecChanges()
+	require.Equal(t, 3, len(pending))
+	for i, c := range pending {
+		assert.Equal(t, types.ValidatorIndex(i+1), c.Message.ValidatorIndex)
+	}
+	assert.Equal(t, byte(1), pending[0].Message.FromBlsPubkey[0])
+	assert.Equal(t, true, p.ValidatorExists(2))
+	assert.Equal(t, false, p.ValidatorExists(4))
+}
+
+func TestPool_MarkIncluded(t *testing.T) {
+	p := NewPool()
+	for i := types.ValidatorIndex(0); i < 3; i++ {
+		p.InsertBLSToExecChange(change(i, 1))
+	}
+	p.MarkIncluded(change(1, 1))
+	p.MarkIncluded(change(5, 1))
+	assert.Equal(t, false, p.ValidatorExists(1))
+	assert.Equal(t, 2, len(p.PendingBLSToExecChanges()))
+}
+
+func TestPool_BLSToExecChangesForInclusion(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxBlsToExecutionChanges = 2
+	params.OverrideBeaconConfig(cfg)
+
+	p := NewPool()
+	vals := make([]*ethpb.Validator, 4)
+	for i := range vals {
+		c := change(types.ValidatorIndex(i), byte(i))
+		cred := hash.Hash(c.Message.FromBlsPubkey)
+		cred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		vals[i] = &ethpb.Validator{WithdrawalCredentials: cred[:]}
+		p.InsertBLSToExecChange(c)
+	}
+	// The credentials of validator 0 were changed to an execution address already.
+	vals[0].WithdrawalCredentials[0] = 0x01
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Validators: vals})
+	require.NoError(t, err)
+
+	changes := p.BLSToExecChangesForInclusion(st)
+	require.Equal(t, 2, len(changes))
+	assert.Equal(t, types.ValidatorIndex(1), changes[0].Message.ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(2), changes[1].Message.ValidatorIndex)
+}
+
+func TestPool_PruneChanges(t *testing.T) {
+	p := NewPool()
+	vals := make([]*ethpb.Validator, 3)
+	for i := range vals {
+		c := change(types.ValidatorIndex(i), byte(i))
+		cred := hash.Hash(c.Message.FromBlsPubkey)
+		cred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		vals[i] = &ethpb.Validator{WithdrawalCredentials: cred[:]}
+		p.InsertBLSToExecChange(c)
+	}
+	// The change of validator 3 is not known to the state.
+	p.InsertBLSToExecChange(change(3, 3))
+	// The credentials of validator 1 were changed to an execution address already.
+	vals[1].WithdrawalCredentials[0] = 0x01
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Validators: vals})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, p.PruneChanges(st))
+	pending := p.PendingBLSToExecChanges()
+	require.Equal(t, 2, len(pending))
+	assert.Equal(t, types.ValidatorIndex(0), pending[0].Message.ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(2), pending[1].Message.ValidatorIndex)
+	assert.Equal(t, 0, p.PruneChanges(st))
+}
@@ -44,3 +44,11 @@ func (*PoolMock) MarkIncludedAttesterSlashing(_ *ethpb.AttesterSlashing) {
 func (*PoolMock) MarkIncludedProposerSlashing(_ *ethpb.ProposerSlashing) {
 	panic("implement me")
 }
+
+// MarkOrphanedAttesterSlashing --
+func (*PoolMock) MarkOrphanedAttesterSlashing(_ *ethpb.AttesterSlashing) {
+}
+
+// MarkOrphanedProposerSlashing --
+func (*PoolMock) MarkOrphanedProposerSlashing(_ *ethpb.ProposerSlashing) {
+}
@@ -261,6 +261,27 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// MarkOrphanedAttesterSlashing is used when a block including an attester slashing was orphaned by a
+// reorg. The slashed validators are no longer considered included, so that the slashing can be
+// inserted in the pool again.
+func (p *Pool) MarkOrphanedAttesterSlashing(as *ethpb.AttesterSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	slashedVal := slice.IntersectionUint64(as.Attestation_1.AttestingIndices, as.Attestation_2.AttestingIndices)
+	for _, val := range slashedVal {
+		delete(p.included, types.ValidatorIndex(val))
+	}
+}
+
+// MarkOrphanedProposerSlashing is used when a block including a proposer slashing was orphaned by a
+// reorg. The slashed proposer is no longer considered included, so that the slashing can be inserted
+// in the pool again.
+func (p *Pool) MarkOrphanedProposerSlashing(ps *ethpb.ProposerSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.included, ps.Header_1.Header.ProposerIndex)
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
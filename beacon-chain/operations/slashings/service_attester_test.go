@@ -606,3 +606,17 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 	}
 	assert.DeepEqual(t, slashings[0:2], p.PendingAttesterSlashings(context.Background(), beaconState, false /*noLimit*/))
 }
+
+func TestPool_MarkOrphanedAttesterSlashing(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	slashing, err := util.GenerateAttesterSlashingForValidator(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	p := NewPool()
+	p.MarkIncludedAttesterSlashing(slashing)
+	err = p.InsertAttesterSlashing(context.Background(), beaconState, slashing)
+	require.ErrorContains(t, "could not slash any", err)
+
+	p.MarkOrphanedAttesterSlashing(slashing)
+	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, slashing))
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{slashing}, p.PendingAttesterSlashings(context.Background(), beaconState, false))
+}
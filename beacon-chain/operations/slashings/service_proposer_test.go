@@ -437,3 +437,17 @@ func TestPool_PendingProposerSlashings_Slashed(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_MarkOrphanedProposerSlashing(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	slashing, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	p := NewPool()
+	p.MarkIncludedProposerSlashing(slashing)
+	err = p.InsertProposerSlashing(context.Background(), beaconState, slashing)
+	require.ErrorContains(t, "cannot be slashed", err)
+
+	p.MarkOrphanedProposerSlashing(slashing)
+	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, slashing))
+	assert.DeepEqual(t, []*ethpb.ProposerSlashing{slashing}, p.PendingProposerSlashings(context.Background(), beaconState, false))
+}
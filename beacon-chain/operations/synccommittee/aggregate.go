@@ -0,0 +1,216 @@
+package synccommittee
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	synccontribution "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation/sync_contribution"
+)
+
+type aggregateKey struct {
+	slot              types.Slot
+	blockRoot         [32]byte
+	subcommitteeIndex uint64
+}
+
+// subnetAggregate holds, for a slot, a block root and a sync subcommittee, the aggregate of the sync
+// committee messages received for the subcommittee, along with the contributions received for it and
+// the best aggregate of these contributions.
+type subnetAggregate struct {
+	bits          bitfield.Bitfield
+	signature     bls.Signature
+	contributions []*ethpb.SyncCommitteeContribution
+	best          *ethpb.SyncCommitteeContribution
+}
+
+// AggregateSyncCommitteeMessage aggregates the sync committee message into the subcommittees of the
+// validator, at the positions of the given sync committee indices of the validator.
+func (s *Store) AggregateSyncCommitteeMessage(msg *ethpb.SyncCommitteeMessage, committeeIndices []types.CommitteeIndex) error {
+	if msg == nil {
+		return errNilMessage
+	}
+	if len(committeeIndices) == 0 {
+		return nil
+	}
+	sig, err := bls.SignatureFromBytes(msg.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not decompress signature")
+	}
+
+	s.aggregateLock.Lock()
+	defer s.aggregateLock.Unlock()
+
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	root := bytesutil.ToBytes32(msg.BlockRoot)
+	for _, index := range committeeIndices {
+		a := s.subnetAggregate(msg.Slot, root, uint64(index)/subCommitteeSize)
+		if a == nil {
+			return nil
+		}
+		// The signature of a validator is aggregated once for each of its positions in the subcommittee.
+		position := uint64(index) % subCommitteeSize
+		if a.bits.BitAt(position) {
+			continue
+		}
+		a.bits.SetBitAt(position, true)
+		if a.signature == nil {
+			a.signature = sig
+		} else {
+			a.signature = bls.AggregateSignatures([]bls.Signature{a.signature, sig})
+		}
+	}
+	return nil
+}
+
+// SyncCommitteeContribution returns the contribution aggregating the sync committee messages received
+// for the slot, block root and subcommittee. It returns nil if no message was received for them.
+func (s *Store) SyncCommitteeContribution(slot types.Slot, blockRoot [32]byte, subcommitteeIndex uint64) *ethpb.SyncCommitteeContribution {
+	s.aggregateLock.RLock()
+	defer s.aggregateLock.RUnlock()
+
+	a, ok := s.aggregates[aggregateKey{slot: slot, blockRoot: blockRoot, subcommitteeIndex: subcommitteeIndex}]
+	if !ok || a.signature == nil {
+		return nil
+	}
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              slot,
+		BlockRoot:         bytesutil.SafeCopyBytes(blockRoot[:]),
+		SubcommitteeIndex: subcommitteeIndex,
+		AggregationBits:   a.bits.Bytes(),
+		Signature:         a.signature.Marshal(),
+	}
+}
+
+// SyncAggregate builds the sync aggregate of a block proposed for the slot on top of the block root.
+// Each subcommittee contributes the best of its aggregated contributions and of the aggregate of its
+// messages, which are merged when they are disjoint. The contributions packed into the sync aggregate
+// are marked as such for the aggregation quality.
+func (s *Store) SyncAggregate(slot types.Slot, blockRoot [32]byte) (*ethpb.SyncAggregate, error) {
+	subnetCount := params.BeaconConfig().SyncCommitteeSubnetCount
+	syncBits := make([]byte, 0, params.BeaconConfig().SyncCommitteeSize/8)
+	sigs := make([]bls.Signature, 0, subnetCount)
+	for i := uint64(0); i < subnetCount; i++ {
+		c, err := s.bestSubnetContribution(slot, blockRoot, i)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			syncBits = append(syncBits, ethpb.NewSyncCommitteeAggregationBits()...)
+			continue
+		}
+		s.MarkSyncCommitteeContributionPacked(c)
+		syncBits = append(syncBits, c.AggregationBits...)
+		sig, err := bls.SignatureFromBytes(c.Signature)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig)
+	}
+
+	var syncSigBytes [96]byte
+	if len(sigs) == 0 {
+		syncSigBytes = [96]byte{0xC0} // Infinity signature if nothing was aggregated.
+	} else {
+		syncSigBytes = bytesutil.ToBytes96(bls.AggregateSignatures(sigs).Marshal())
+	}
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      syncBits,
+		SyncCommitteeSignature: syncSigBytes[:],
+	}, nil
+}
+
+// bestSubnetContribution returns the best contribution of the subcommittee to a sync aggregate, out
+// of its best aggregated contribution and of the aggregate of its messages.
+func (s *Store) bestSubnetContribution(slot types.Slot, blockRoot [32]byte, subcommitteeIndex uint64) (*ethpb.SyncCommitteeContribution, error) {
+	fromMessages := s.SyncCommitteeContribution(slot, blockRoot, subcommitteeIndex)
+
+	s.aggregateLock.RLock()
+	var best *ethpb.SyncCommitteeContribution
+	if a, ok := s.aggregates[aggregateKey{slot: slot, blockRoot: blockRoot, subcommitteeIndex: subcommitteeIndex}]; ok && a.best != nil {
+		best = ethpb.CopySyncCommitteeContribution(a.best)
+	}
+	s.aggregateLock.RUnlock()
+
+	switch {
+	case best == nil:
+		return fromMessages, nil
+	case fromMessages == nil:
+		return best, nil
+	}
+	aggregates, err := synccontribution.Aggregate([]*ethpb.SyncCommitteeContribution{best, fromMessages})
+	if err != nil {
+		return nil, err
+	}
+	return mostParticipants(aggregates), nil
+}
+
+// trackContribution records the contribution, and updates the best aggregate of the contributions
+// received for its slot, block root and subcommittee.
+func (s *Store) trackContribution(cont *ethpb.SyncCommitteeContribution) error {
+	s.aggregateLock.Lock()
+	defer s.aggregateLock.Unlock()
+
+	a := s.subnetAggregate(cont.Slot, bytesutil.ToBytes32(cont.BlockRoot), cont.SubcommitteeIndex)
+	if a == nil {
+		return nil
+	}
+	for _, c := range a.contributions {
+		if bytes.Equal(c.AggregationBits, cont.AggregationBits) {
+			return nil
+		}
+	}
+	a.contributions = append(a.contributions, ethpb.CopySyncCommitteeContribution(cont))
+
+	// Aggregation happens in place, so it operates on copies of the contributions.
+	cs := make([]*ethpb.SyncCommitteeContribution, len(a.contributions))
+	for i, c := range a.contributions {
+		cs[i] = ethpb.CopySyncCommitteeContribution(c)
+	}
+	aggregates, err := synccontribution.Aggregate(cs)
+	if err != nil {
+		return err
+	}
+	a.best = mostParticipants(aggregates)
+	return nil
+}
+
+// subnetAggregate returns the aggregate of the slot, block root and subcommittee, and creates it if
+// needed. Slots older than the last syncCommitteeMaxQueueSize aggregated slots are pruned, and nil is
+// returned for them.
+func (s *Store) subnetAggregate(slot types.Slot, blockRoot [32]byte, subcommitteeIndex uint64) *subnetAggregate {
+	if slot > s.highestAggregateSlot {
+		s.highestAggregateSlot = slot
+		for k := range s.aggregates {
+			if k.slot+syncCommitteeMaxQueueSize <= slot {
+				delete(s.aggregates, k)
+			}
+		}
+	}
+	if slot+syncCommitteeMaxQueueSize <= s.highestAggregateSlot {
+		return nil
+	}
+	k := aggregateKey{slot: slot, blockRoot: blockRoot, subcommitteeIndex: subcommitteeIndex}
+	a, ok := s.aggregates[k]
+	if !ok {
+		a = &subnetAggregate{bits: ethpb.NewSyncCommitteeAggregationBits()}
+		s.aggregates[k] = a
+	}
+	return a
+}
+
+// mostParticipants returns the contribution with the most aggregation bits set, nil if there is none.
+func mostParticipants(cs []*ethpb.SyncCommitteeContribution) *ethpb.SyncCommitteeContribution {
+	var best *ethpb.SyncCommitteeContribution
+	for _, c := range cs {
+		if best == nil || c.AggregationBits.Count() > best.AggregationBits.Count() {
+			best = c
+		}
+	}
+	return best
+}
@@ -0,0 +1,136 @@
+package synccommittee
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestAggregateSyncCommitteeMessage(t *testing.T) {
+	store := NewStore()
+	root := [32]byte{'a'}
+	key1, err := bls.RandKey()
+	require.NoError(t, err)
+	key2, err := bls.RandKey()
+	require.NoError(t, err)
+	sig1 := key1.Sign(root[:])
+	sig2 := key2.Sign(root[:])
+	msg1 := &ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:], ValidatorIndex: 1, Signature: sig1.Marshal()}
+	msg2 := &ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:], ValidatorIndex: 2, Signature: sig2.Marshal()}
+
+	// The first validator sits at the positions 1 and 2 of the first subcommittee, the second one at the
+	// position 3 of the first subcommittee and at the position 0 of the second one.
+	require.NoError(t, store.AggregateSyncCommitteeMessage(msg1, []types.CommitteeIndex{1, 2}))
+	require.NoError(t, store.AggregateSyncCommitteeMessage(msg2, []types.CommitteeIndex{3, 128}))
+	// Duplicates are not aggregated twice.
+	require.NoError(t, store.AggregateSyncCommitteeMessage(msg1, []types.CommitteeIndex{1, 2}))
+
+	c := store.SyncCommitteeContribution(1, root, 0)
+	require.NotNil(t, c)
+	assert.DeepEqual(t, []int{1, 2, 3}, c.AggregationBits.BitIndices())
+	assert.DeepEqual(t, bls.AggregateSignatures([]bls.Signature{sig1, sig1, sig2}).Marshal(), c.Signature)
+
+	c = store.SyncCommitteeContribution(1, root, 1)
+	require.NotNil(t, c)
+	assert.DeepEqual(t, []int{0}, c.AggregationBits.BitIndices())
+	assert.DeepEqual(t, sig2.Marshal(), c.Signature)
+
+	assert.Equal(t, (*ethpb.SyncCommitteeContribution)(nil), store.SyncCommitteeContribution(1, root, 2))
+	assert.Equal(t, (*ethpb.SyncCommitteeContribution)(nil), store.SyncCommitteeContribution(1, [32]byte{'b'}, 0))
+	assert.Equal(t, (*ethpb.SyncCommitteeContribution)(nil), store.SyncCommitteeContribution(2, root, 0))
+}
+
+func TestAggregateSyncCommitteeMessage_PrunesOldSlots(t *testing.T) {
+	store := NewStore()
+	root := [32]byte{'a'}
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := key.Sign(root[:]).Marshal()
+
+	require.NoError(t, store.AggregateSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:], Signature: sig}, []types.CommitteeIndex{0}))
+	require.NotNil(t, store.SyncCommitteeContribution(1, root, 0))
+	require.NoError(t, store.AggregateSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{Slot: 1 + syncCommitteeMaxQueueSize, BlockRoot: root[:], Signature: sig}, []types.CommitteeIndex{0}))
+	assert.Equal(t, (*ethpb.SyncCommitteeContribution)(nil), store.SyncCommitteeContribution(1, root, 0))
+	require.NotNil(t, store.SyncCommitteeContribution(1+syncCommitteeMaxQueueSize, root, 0))
+}
+
+func TestSyncAggregate_BestContributions(t *testing.T) {
+	store := NewStore()
+	root := [32]byte{'a'}
+	sig := bls.NewAggregateSignature().Marshal()
+	conts := []*ethpb.SyncCommitteeContribution{
+		{Slot: 1, SubcommitteeIndex: 0, Signature: sig, AggregationBits: []byte{0b0001}, BlockRoot: root[:]},
+		{Slot: 1, SubcommitteeIndex: 0, Signature: sig, AggregationBits: []byte{0b1001}, BlockRoot: root[:]},
+		{Slot: 1, SubcommitteeIndex: 0, Signature: sig, AggregationBits: []byte{0b1110}, BlockRoot: root[:]},
+		{Slot: 1, SubcommitteeIndex: 1, Signature: sig, AggregationBits: []byte{0b0011}, BlockRoot: root[:]},
+		{Slot: 1, SubcommitteeIndex: 1, Signature: sig, AggregationBits: []byte{0b0111}, BlockRoot: root[:]},
+		// Contributions for another block root are not included.
+		{Slot: 1, SubcommitteeIndex: 2, Signature: sig, AggregationBits: []byte{0b1111}, BlockRoot: make([]byte, 32)},
+	}
+	for _, c := range conts {
+		c.AggregationBits = append(c.AggregationBits, make([]byte, 15)...)
+		require.NoError(t, store.SaveSyncCommitteeContribution(c))
+	}
+
+	aggregate, err := store.SyncAggregate(1, root)
+	require.NoError(t, err)
+	want := bitfield.NewBitvector512()
+	// The disjoint contributions of the first subcommittee are merged.
+	for _, i := range []uint64{0, 1, 2, 3, 128, 129, 130} {
+		want.SetBitAt(i, true)
+	}
+	assert.DeepEqual(t, want, aggregate.SyncCommitteeBits)
+
+	aggregate, err = store.SyncAggregate(2, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, bitfield.NewBitvector512(), aggregate.SyncCommitteeBits)
+	infinite := [96]byte{0xC0}
+	assert.DeepEqual(t, infinite[:], aggregate.SyncCommitteeSignature)
+}
+
+func TestSyncAggregate_MergesMessages(t *testing.T) {
+	store := NewStore()
+	root := [32]byte{'a'}
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	msgSig := key.Sign(root[:])
+	contSig := key.Sign([]byte{'b'})
+
+	bits := ethpb.NewSyncCommitteeAggregationBits()
+	bits.SetBitAt(0, true)
+	require.NoError(t, store.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{
+		Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 0, AggregationBits: bits, Signature: contSig.Marshal(),
+	}))
+	// Messages disjoint from the contribution are merged with it.
+	require.NoError(t, store.AggregateSyncCommitteeMessage(
+		&ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:], Signature: msgSig.Marshal()}, []types.CommitteeIndex{5}))
+	// Messages overlapping the contribution are only included when they have more participants.
+	require.NoError(t, store.AggregateSyncCommitteeMessage(
+		&ethpb.SyncCommitteeMessage{Slot: 1, BlockRoot: root[:], Signature: msgSig.Marshal()}, []types.CommitteeIndex{128, 129}))
+	bits = ethpb.NewSyncCommitteeAggregationBits()
+	bits.SetBitAt(0, true)
+	require.NoError(t, store.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{
+		Slot: 1, BlockRoot: root[:], SubcommitteeIndex: 1, AggregationBits: bits, Signature: contSig.Marshal(),
+	}))
+
+	aggregate, err := store.SyncAggregate(1, root)
+	require.NoError(t, err)
+	want := bitfield.NewBitvector512()
+	for _, i := range []uint64{0, 5, 128, 129} {
+		want.SetBitAt(i, true)
+	}
+	assert.DeepEqual(t, want, aggregate.SyncCommitteeBits)
+	wantSig := bls.AggregateSignatures([]bls.Signature{contSig, msgSig, msgSig, msgSig})
+	assert.DeepEqual(t, wantSig.Marshal(), aggregate.SyncCommitteeSignature)
+
+	// The packed contributions are recorded for the aggregation quality.
+	qualities := store.SyncCommitteeAggregationQuality()
+	require.Equal(t, 2, len(qualities))
+	assert.Equal(t, uint64(2), qualities[0].PackedParticipants)
+	assert.Equal(t, uint64(2), qualities[1].PackedParticipants)
+}
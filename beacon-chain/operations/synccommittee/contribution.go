@@ -19,6 +19,9 @@ func (s *Store) SaveSyncCommitteeContribution(cont *ethpb.SyncCommitteeContribut
 	if cont == nil {
 		return errNilContribution
 	}
+	if err := s.trackContribution(cont); err != nil {
+		return err
+	}
 
 	s.contributionLock.Lock()
 	defer s.contributionLock.Unlock()
@@ -85,6 +88,23 @@ func (s *Store) SyncCommitteeContributions(slot types.Slot) ([]*ethpb.SyncCommit
 	return contributions, nil
 }
 
+// AllSyncCommitteeContributions returns the sync committee contributions of all the slots in the priority
+// queue, ordered by slot. The contributions are not removed from the queue.
+func (s *Store) AllSyncCommitteeContributions() ([]*ethpb.SyncCommitteeContribution, error) {
+	s.contributionLock.RLock()
+	defer s.contributionLock.RUnlock()
+
+	all := make([]*ethpb.SyncCommitteeContribution, 0)
+	for _, item := range s.contributionCache.Items() {
+		contributions, ok := item.Value.([]*ethpb.SyncCommitteeContribution)
+		if !ok {
+			return nil, errors.New("not typed []ethpb.SyncCommitteeContribution")
+		}
+		all = append(all, contributions...)
+	}
+	return all, nil
+}
+
 func syncCommitteeKey(slot types.Slot) string {
 	return strconv.FormatUint(uint64(slot), 10)
 }
@@ -3,6 +3,7 @@ package synccommittee
 import (
 	"testing"
 
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
@@ -106,3 +107,24 @@ func TestSyncCommitteeContributionCache_RoundTrip(t *testing.T) {
 		{Slot: 6, SubcommitteeIndex: 1, Signature: []byte{'l'}},
 	}, conts)
 }
+
+func TestSyncCommitteeContributionCache_All(t *testing.T) {
+	store := NewStore()
+
+	conts, err := store.AllSyncCommitteeContributions()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(conts))
+
+	for slot := types.Slot(1); slot <= 6; slot++ {
+		require.NoError(t, store.SaveSyncCommitteeContribution(&ethpb.SyncCommitteeContribution{Slot: slot, Signature: []byte{'a'}}))
+	}
+
+	conts, err = store.AllSyncCommitteeContributions()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*ethpb.SyncCommitteeContribution{
+		{Slot: 3, Signature: []byte{'a'}},
+		{Slot: 4, Signature: []byte{'a'}},
+		{Slot: 5, Signature: []byte{'a'}},
+		{Slot: 6, Signature: []byte{'a'}},
+	}, conts)
+}
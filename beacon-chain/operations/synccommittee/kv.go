@@ -3,6 +3,7 @@ package synccommittee
 import (
 	"sync"
 
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/container/queue"
 )
 
@@ -13,6 +14,14 @@ type Store struct {
 	messageCache      *queue.PriorityQueue
 	contributionLock  sync.RWMutex
 	contributionCache *queue.PriorityQueue
+
+	qualityLock        sync.RWMutex
+	quality            map[qualityKey]*subnetAggregation
+	highestQualitySlot types.Slot
+
+	aggregateLock        sync.RWMutex
+	aggregates           map[aggregateKey]*subnetAggregate
+	highestAggregateSlot types.Slot
 }
 
 // NewStore initializes a new sync committee store.
@@ -20,5 +29,7 @@ func NewStore() *Store {
 	return &Store{
 		messageCache:      queue.New(),
 		contributionCache: queue.New(),
+		quality:           make(map[qualityKey]*subnetAggregation),
+		aggregates:        make(map[aggregateKey]*subnetAggregate),
 	}
 }
@@ -77,3 +77,20 @@ func (s *Store) SyncCommitteeMessages(slot types.Slot) ([]*ethpb.SyncCommitteeMe
 
 	return messages, nil
 }
+
+// AllSyncCommitteeMessages returns the sync committee messages of all the slots in the priority queue,
+// ordered by slot. The messages are not removed from the queue.
+func (s *Store) AllSyncCommitteeMessages() ([]*ethpb.SyncCommitteeMessage, error) {
+	s.messageLock.RLock()
+	defer s.messageLock.RUnlock()
+
+	all := make([]*ethpb.SyncCommitteeMessage, 0)
+	for _, item := range s.messageCache.Items() {
+		messages, ok := item.Value.([]*ethpb.SyncCommitteeMessage)
+		if !ok {
+			return nil, errors.New("not typed []ethpb.SyncCommitteeMessage")
+		}
+		all = append(all, messages...)
+	}
+	return all, nil
+}
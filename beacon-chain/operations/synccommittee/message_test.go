@@ -3,6 +3,7 @@ package synccommittee
 import (
 	"testing"
 
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
@@ -107,3 +108,25 @@ func TestSyncCommitteeSignatureCache_RoundTrip(t *testing.T) {
 		{Slot: 6, ValidatorIndex: 1, Signature: []byte{'l'}},
 	}, msgs)
 }
+
+func TestSyncCommitteeSignatureCache_All(t *testing.T) {
+	store := NewStore()
+
+	msgs, err := store.AllSyncCommitteeMessages()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(msgs))
+
+	for slot := types.Slot(6); slot > 0; slot-- {
+		require.NoError(t, store.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{Slot: slot, Signature: []byte{'a'}}))
+	}
+
+	// Only the latest slots are kept in the queue, and messages are returned ordered by slot.
+	msgs, err = store.AllSyncCommitteeMessages()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, []*ethpb.SyncCommitteeMessage{
+		{Slot: 3, Signature: []byte{'a'}},
+		{Slot: 4, Signature: []byte{'a'}},
+		{Slot: 5, Signature: []byte{'a'}},
+		{Slot: 6, Signature: []byte{'a'}},
+	}, msgs)
+}
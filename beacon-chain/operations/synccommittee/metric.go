@@ -14,4 +14,24 @@ var (
 		Name: "saved_sync_committee_contribution_total",
 		Help: "The number of saved sync committee contribution total.",
 	})
+	syncSubnetMessagesReceived = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sync_subnet_messages_received",
+		Help: "The number of validators from which a sync committee message was received on the subnet, for the last reported slot.",
+	}, []string{"subnet"})
+	syncSubnetBestContributionParticipants = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sync_subnet_best_contribution_participants",
+		Help: "The number of participants of the best contribution seen for the subnet, for the last reported slot.",
+	}, []string{"subnet"})
+	syncSubnetLocalContributionParticipants = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sync_subnet_local_contribution_participants",
+		Help: "The number of participants of the best contribution of the local aggregators for the subnet, for the last reported slot.",
+	}, []string{"subnet"})
+	localSyncContributionSlotsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "local_sync_contribution_slots_total",
+		Help: "The number of slots and subnets for which a local aggregator submitted a contribution.",
+	})
+	localSyncContributionBestSlotsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "local_sync_contribution_best_slots_total",
+		Help: "The number of slots and subnets for which a local aggregator submitted a contribution as good as the best one seen.",
+	})
 )
@@ -15,10 +15,23 @@ type Pool interface {
 	// Methods for Sync Contributions.
 	SaveSyncCommitteeContribution(contr *ethpb.SyncCommitteeContribution) error
 	SyncCommitteeContributions(slot types.Slot) ([]*ethpb.SyncCommitteeContribution, error)
+	AllSyncCommitteeContributions() ([]*ethpb.SyncCommitteeContribution, error)
 
 	// Methods for Sync Committee Messages.
 	SaveSyncCommitteeMessage(sig *ethpb.SyncCommitteeMessage) error
 	SyncCommitteeMessages(slot types.Slot) ([]*ethpb.SyncCommitteeMessage, error)
+	AllSyncCommitteeMessages() ([]*ethpb.SyncCommitteeMessage, error)
+
+	// Methods for the aggregation of sync committee messages and contributions.
+	AggregateSyncCommitteeMessage(msg *ethpb.SyncCommitteeMessage, committeeIndices []types.CommitteeIndex) error
+	SyncCommitteeContribution(slot types.Slot, blockRoot [32]byte, subcommitteeIndex uint64) *ethpb.SyncCommitteeContribution
+	SyncAggregate(slot types.Slot, blockRoot [32]byte) (*ethpb.SyncAggregate, error)
+
+	// Methods for the aggregation quality of the sync committee subnets.
+	MarkSyncCommitteeMessageReceived(slot types.Slot, validatorIndex types.ValidatorIndex, subnet uint64)
+	MarkSyncCommitteeContributionAggregator(cont *ethpb.SyncCommitteeContribution, aggregatorIndex types.ValidatorIndex, local bool)
+	MarkSyncCommitteeContributionPacked(cont *ethpb.SyncCommitteeContribution)
+	SyncCommitteeAggregationQuality() []*AggregationQuality
 }
 
 // NewPool returns the sync committee store fulfilling the pool interface.
@@ -0,0 +1,208 @@
+package synccommittee
+
+import (
+	"bytes"
+	"math/bits"
+	"sort"
+	"strconv"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// AggregationQuality reports, for a slot and a sync subcommittee, how many sync committee messages
+// the node received compared to the participants of the best contribution it saw, along with the
+// aggregators of the best contribution and of the contribution packed into a block by the node.
+type AggregationQuality struct {
+	Slot              types.Slot
+	SubcommitteeIndex uint64
+	// ReceivedMessages is the number of validators from which a message was received on the subnet.
+	ReceivedMessages uint64
+	// BestParticipants is the number of participants of the best contribution.
+	BestParticipants uint64
+	BestAggregators  []types.ValidatorIndex
+	// LocalParticipants is the number of participants of the best contribution of the aggregators
+	// connected to the node, zero if there is none.
+	LocalParticipants uint64
+	// PackedParticipants is the number of participants of the contribution packed into a block by
+	// the node, zero if the node did not pack one.
+	PackedParticipants uint64
+	PackedAggregators  []types.ValidatorIndex
+}
+
+type qualityKey struct {
+	slot              types.Slot
+	subcommitteeIndex uint64
+}
+
+type trackedContribution struct {
+	aggregator types.ValidatorIndex
+	bits       []byte
+	local      bool
+}
+
+type subnetAggregation struct {
+	messages      map[types.ValidatorIndex]bool
+	contributions []*trackedContribution
+	packed        []byte
+}
+
+// MarkSyncCommitteeMessageReceived records that a sync committee message of the validator was received
+// on the subnet.
+func (s *Store) MarkSyncCommitteeMessageReceived(slot types.Slot, validatorIndex types.ValidatorIndex, subnet uint64) {
+	s.qualityLock.Lock()
+	defer s.qualityLock.Unlock()
+
+	a := s.subnetAggregation(slot, subnet)
+	if a == nil {
+		return
+	}
+	a.messages[validatorIndex] = true
+}
+
+// MarkSyncCommitteeContributionAggregator records the aggregator of a sync committee contribution, and
+// whether the aggregator submitted it through the node.
+func (s *Store) MarkSyncCommitteeContributionAggregator(cont *ethpb.SyncCommitteeContribution, aggregatorIndex types.ValidatorIndex, local bool) {
+	if cont == nil {
+		return
+	}
+	s.qualityLock.Lock()
+	defer s.qualityLock.Unlock()
+
+	a := s.subnetAggregation(cont.Slot, cont.SubcommitteeIndex)
+	if a == nil {
+		return
+	}
+	// The contributions broadcast by the node are received back from gossip.
+	for _, c := range a.contributions {
+		if c.aggregator == aggregatorIndex && bytes.Equal(c.bits, cont.AggregationBits) {
+			c.local = c.local || local
+			return
+		}
+	}
+	a.contributions = append(a.contributions, &trackedContribution{
+		aggregator: aggregatorIndex,
+		bits:       append([]byte{}, cont.AggregationBits...),
+		local:      local,
+	})
+}
+
+// MarkSyncCommitteeContributionPacked records the contribution packed into a block by the node, which
+// may be the aggregate of several contributions.
+func (s *Store) MarkSyncCommitteeContributionPacked(cont *ethpb.SyncCommitteeContribution) {
+	if cont == nil {
+		return
+	}
+	s.qualityLock.Lock()
+	defer s.qualityLock.Unlock()
+
+	a := s.subnetAggregation(cont.Slot, cont.SubcommitteeIndex)
+	if a == nil {
+		return
+	}
+	a.packed = append([]byte{}, cont.AggregationBits...)
+}
+
+// SyncCommitteeAggregationQuality returns the aggregation quality of the tracked slots and subnets,
+// sorted by slot and subcommittee index.
+func (s *Store) SyncCommitteeAggregationQuality() []*AggregationQuality {
+	s.qualityLock.RLock()
+	defer s.qualityLock.RUnlock()
+
+	qualities := make([]*AggregationQuality, 0, len(s.quality))
+	for k, a := range s.quality {
+		qualities = append(qualities, a.quality(k))
+	}
+	sort.Slice(qualities, func(i, j int) bool {
+		if qualities[i].Slot != qualities[j].Slot {
+			return qualities[i].Slot < qualities[j].Slot
+		}
+		return qualities[i].SubcommitteeIndex < qualities[j].SubcommitteeIndex
+	})
+	return qualities
+}
+
+// subnetAggregation returns the aggregation tracked for the slot and subnet, and creates it if needed.
+// Slots older than the last syncCommitteeMaxQueueSize tracked slots are pruned, after their quality is
+// reported to the metrics. It returns nil for such slots.
+func (s *Store) subnetAggregation(slot types.Slot, subnet uint64) *subnetAggregation {
+	if slot > s.highestQualitySlot {
+		s.highestQualitySlot = slot
+		for k, a := range s.quality {
+			if k.slot+syncCommitteeMaxQueueSize <= slot {
+				reportAggregationQuality(a.quality(k))
+				delete(s.quality, k)
+			}
+		}
+	}
+	if slot+syncCommitteeMaxQueueSize <= s.highestQualitySlot {
+		return nil
+	}
+	k := qualityKey{slot: slot, subcommitteeIndex: subnet}
+	a, ok := s.quality[k]
+	if !ok {
+		a = &subnetAggregation{messages: make(map[types.ValidatorIndex]bool)}
+		s.quality[k] = a
+	}
+	return a
+}
+
+func (a *subnetAggregation) quality(k qualityKey) *AggregationQuality {
+	q := &AggregationQuality{
+		Slot:              k.slot,
+		SubcommitteeIndex: k.subcommitteeIndex,
+		ReceivedMessages:  uint64(len(a.messages)),
+	}
+	for _, c := range a.contributions {
+		n := countBits(c.bits)
+		switch {
+		case n > q.BestParticipants:
+			q.BestParticipants = n
+			q.BestAggregators = []types.ValidatorIndex{c.aggregator}
+		case n == q.BestParticipants && n > 0:
+			q.BestAggregators = append(q.BestAggregators, c.aggregator)
+		}
+		if c.local && n > q.LocalParticipants {
+			q.LocalParticipants = n
+		}
+		if a.packed != nil && n > 0 && isSubset(c.bits, a.packed) {
+			q.PackedAggregators = append(q.PackedAggregators, c.aggregator)
+		}
+	}
+	q.PackedParticipants = countBits(a.packed)
+	return q
+}
+
+func reportAggregationQuality(q *AggregationQuality) {
+	subnet := strconv.FormatUint(q.SubcommitteeIndex, 10)
+	syncSubnetMessagesReceived.WithLabelValues(subnet).Set(float64(q.ReceivedMessages))
+	syncSubnetBestContributionParticipants.WithLabelValues(subnet).Set(float64(q.BestParticipants))
+	syncSubnetLocalContributionParticipants.WithLabelValues(subnet).Set(float64(q.LocalParticipants))
+	if q.LocalParticipants > 0 {
+		localSyncContributionSlotsTotal.Inc()
+		if q.LocalParticipants == q.BestParticipants {
+			localSyncContributionBestSlotsTotal.Inc()
+		}
+	}
+}
+
+func countBits(b []byte) uint64 {
+	var n uint64
+	for _, x := range b {
+		n += uint64(bits.OnesCount8(x))
+	}
+	return n
+}
+
+// isSubset returns true if all the bits set in a are set in b.
+func isSubset(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i]&^b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package synccommittee
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSyncCommitteeAggregationQuality(t *testing.T) {
+	store := NewStore()
+	store.MarkSyncCommitteeMessageReceived(1, 10, 0)
+	store.MarkSyncCommitteeMessageReceived(1, 11, 0)
+	store.MarkSyncCommitteeMessageReceived(1, 11, 0)
+	store.MarkSyncCommitteeMessageReceived(1, 12, 1)
+
+	best := &ethpb.SyncCommitteeContribution{Slot: 1, SubcommitteeIndex: 0, AggregationBits: []byte{0b0011}}
+	partial := &ethpb.SyncCommitteeContribution{Slot: 1, SubcommitteeIndex: 0, AggregationBits: []byte{0b0001}}
+	store.MarkSyncCommitteeContributionAggregator(best, 20, false)
+	store.MarkSyncCommitteeContributionAggregator(partial, 21, true)
+	// The local contribution is received back from gossip.
+	store.MarkSyncCommitteeContributionAggregator(partial, 21, false)
+	store.MarkSyncCommitteeContributionAggregator(&ethpb.SyncCommitteeContribution{Slot: 1, SubcommitteeIndex: 0, AggregationBits: []byte{0b0110}}, 22, false)
+	store.MarkSyncCommitteeContributionPacked(best)
+
+	qualities := store.SyncCommitteeAggregationQuality()
+	require.Equal(t, 2, len(qualities))
+	assert.DeepEqual(t, &AggregationQuality{
+		Slot:               1,
+		SubcommitteeIndex:  0,
+		ReceivedMessages:   2,
+		BestParticipants:   2,
+		BestAggregators:    []types.ValidatorIndex{20, 22},
+		LocalParticipants:  1,
+		PackedParticipants: 2,
+		PackedAggregators:  []types.ValidatorIndex{20, 21},
+	}, qualities[0])
+	assert.DeepEqual(t, &AggregationQuality{
+		Slot:              1,
+		SubcommitteeIndex: 1,
+		ReceivedMessages:  1,
+	}, qualities[1])
+}
+
+func TestSyncCommitteeAggregationQuality_PrunesOldSlots(t *testing.T) {
+	store := NewStore()
+	store.MarkSyncCommitteeMessageReceived(1, 10, 0)
+	store.MarkSyncCommitteeMessageReceived(1+syncCommitteeMaxQueueSize, 10, 0)
+	// Messages of pruned slots are no longer tracked.
+	store.MarkSyncCommitteeMessageReceived(1, 11, 0)
+	store.MarkSyncCommitteeContributionPacked(&ethpb.SyncCommitteeContribution{Slot: 1, AggregationBits: []byte{0b0001}})
+
+	qualities := store.SyncCommitteeAggregationQuality()
+	require.Equal(t, 1, len(qualities))
+	assert.Equal(t, types.Slot(1+syncCommitteeMaxQueueSize), qualities[0].Slot)
+}
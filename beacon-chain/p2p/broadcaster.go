@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
@@ -88,6 +89,12 @@ func (s *Service) BroadcastSyncCommitteeMessage(ctx context.Context, subnet uint
 	return nil
 }
 
+// attestationPeerSearchTimeout is the longest time the broadcast of an attestation is delayed while searching for
+// peers on its subnet, which is the interval between the production of attestations and their aggregation.
+func attestationPeerSearchTimeout() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+}
+
 func (s *Service) broadcastAttestation(ctx context.Context, subnet uint64, att *ethpb.Attestation, forkDigest [4]byte) {
 	ctx, span := trace.StartSpan(ctx, "p2p.broadcastAttestation")
 	defer span.End()
@@ -97,9 +104,9 @@ func (s *Service) broadcastAttestation(ctx context.Context, subnet uint64, att *
 	ctx, cancel := context.WithTimeout(ctx, oneEpoch)
 	defer cancel()
 
-	// Ensure we have peers with this subnet.
+	// Ensure we have mesh peers with this subnet.
 	s.subnetLocker(subnet).RLock()
-	hasPeer := s.hasPeerWithSubnet(attestationToTopic(subnet, forkDigest))
+	hasPeer := s.hasMeshPeerWithSubnet(attestationToTopic(subnet, forkDigest))
 	s.subnetLocker(subnet).RUnlock()
 
 	span.AddAttributes(
@@ -111,9 +118,17 @@ func (s *Service) broadcastAttestation(ctx context.Context, subnet uint64, att *
 	if !hasPeer {
 		attestationBroadcastAttempts.Inc()
 		if err := func() error {
+			// The broadcast is only delayed briefly while searching for peers, so that the attestation
+			// still reaches the aggregators of the subnet in time when no peer can be found.
+			searchCtx, cancel := context.WithTimeout(ctx, attestationPeerSearchTimeout())
+			defer cancel()
+			start := time.Now()
+			defer func() {
+				attestationPeerSearchDelay.Observe(time.Since(start).Seconds())
+			}()
 			s.subnetLocker(subnet).Lock()
 			defer s.subnetLocker(subnet).Unlock()
-			ok, err := s.FindPeersWithSubnet(ctx, attestationToTopic(subnet, forkDigest), subnet, 1)
+			ok, err := s.FindPeersWithSubnet(searchCtx, attestationToTopic(subnet, forkDigest), subnet, 1)
 			if err != nil {
 				return err
 			}
@@ -217,7 +232,12 @@ func (s *Service) broadcastObject(ctx context.Context, obj ssz.Marshaler, topic
 		iid := int64(id)
 		span.AddMessageSendEvent(iid, messageLen /*uncompressed*/, messageLen /*compressed*/)
 	}
-	if err := s.PublishToTopic(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
+	fullTopic := topic + s.Encoding().ProtocolSuffix()
+	if s.echoes != nil {
+		// Track the message before publishing it, as peers may echo it back right away.
+		s.echoes.track(MsgID(s.genesisValidatorsRoot, &pubsubpb.Message{Data: buf.Bytes(), Topic: &fullTopic}), topic)
+	}
+	if err := s.PublishToTopic(ctx, fullTopic, buf.Bytes()); err != nil {
 		err := errors.Wrap(err, "could not publish message")
 		tracing.AnnotateError(span, err)
 		return err
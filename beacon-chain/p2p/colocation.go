@@ -0,0 +1,207 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+)
+
+// Prefix lengths of the subnets over which peers are counted towards the subnet colocation limit.
+const (
+	colocationSubnetBitsIPv4 = 24
+	colocationSubnetBitsIPv6 = 64
+)
+
+// colocationLimits restricts the number of peers sharing the same ip address, subnet or
+// autonomous system, so that a single hosting provider cannot take over the peers of the node.
+// A zero limit disables the corresponding check.
+type colocationLimits struct {
+	maxPerIP     int
+	maxPerSubnet int
+	maxPerASN    int
+	asns         *asnTable
+	exempt       []*net.IPNet
+}
+
+// configureColocationLimits sets up the colocation limits from the config, returning nil when all
+// the limits are disabled.
+func configureColocationLimits(cfg *Config) (*colocationLimits, error) {
+	if cfg.MaxPeersPerIP == 0 && cfg.MaxPeersPerSubnet == 0 && cfg.MaxPeersPerASN == 0 {
+		return nil, nil
+	}
+	limits := &colocationLimits{
+		maxPerIP:     int(cfg.MaxPeersPerIP),
+		maxPerSubnet: int(cfg.MaxPeersPerSubnet),
+		maxPerASN:    int(cfg.MaxPeersPerASN),
+	}
+	for _, cidr := range cfg.ColocationExempt {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse colocation allow list entry %s", cidr)
+		}
+		limits.exempt = append(limits.exempt, ipnet)
+	}
+	if limits.maxPerASN > 0 {
+		if cfg.ASNDatabase == "" {
+			return nil, errors.New("a peer limit per autonomous system requires an ASN database")
+		}
+		asns, err := loadASNTable(cfg.ASNDatabase)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load ASN database")
+		}
+		limits.asns = asns
+	}
+	return limits, nil
+}
+
+// colocationRejection returns the reason for rejecting a connection with the given peer at the
+// given address because of the colocation limits, or an empty string if the connection is allowed.
+// Loopback addresses, addresses in the colocation allow list and trusted peers are never rejected.
+func (s *Service) colocationRejection(pid peer.ID, addr multiaddr.Multiaddr) string {
+	limits := s.colocation
+	if limits == nil || s.peers == nil {
+		return ""
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil || ip.IsLoopback() || limits.isExempt(ip) || s.peers.IsTrusted(pid) {
+		return ""
+	}
+	subnet := colocationSubnet(ip)
+	asn := limits.asns.lookup(ip)
+	var sameIP, sameSubnet, sameASN int
+	for _, other := range s.peers.Active() {
+		if other == pid {
+			continue
+		}
+		otherAddr, err := s.peers.Address(other)
+		if err != nil || otherAddr == nil {
+			continue
+		}
+		otherIP, err := manet.ToIP(otherAddr)
+		if err != nil {
+			continue
+		}
+		if otherIP.Equal(ip) {
+			sameIP++
+		}
+		if subnet.Contains(otherIP) {
+			sameSubnet++
+		}
+		if asn != 0 && limits.asns.lookup(otherIP) == asn {
+			sameASN++
+		}
+	}
+	switch {
+	case limits.maxPerIP > 0 && sameIP >= limits.maxPerIP:
+		return reasonIPColocation
+	case limits.maxPerSubnet > 0 && sameSubnet >= limits.maxPerSubnet:
+		return reasonSubnetColocation
+	case limits.maxPerASN > 0 && sameASN >= limits.maxPerASN:
+		return reasonASNColocation
+	}
+	return ""
+}
+
+func (c *colocationLimits) isExempt(ip net.IP) bool {
+	for _, ipnet := range c.exempt {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// colocationSubnet returns the /24 subnet of an IPv4 address, or the /64 subnet of an IPv6 address.
+func colocationSubnet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(colocationSubnetBitsIPv4, 8*net.IPv4len)
+		return &net.IPNet{IP: ip4.Mask(mask), Mask: mask}
+	}
+	mask := net.CIDRMask(colocationSubnetBitsIPv6, 8*net.IPv6len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// asnRange is a range of ip addresses announced by an autonomous system.
+type asnRange struct {
+	start net.IP
+	end   net.IP
+	asn   uint32
+}
+
+// asnTable maps ip addresses to the autonomous systems announcing them.
+type asnTable struct {
+	ranges []asnRange
+}
+
+// loadASNTable reads an ip to ASN database in the tab separated format published by iptoasn.com,
+// where each line holds the first and last addresses of a range followed by its AS number. Both
+// IPv4 and IPv6 ranges are supported, and ranges with an AS number of 0 are not routed.
+func loadASNTable(path string) (*asnTable, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close ASN database")
+		}
+	}()
+	table := &asnTable{}
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, errors.Errorf("line %d: expected at least 3 fields, got %d", lineNum, len(fields))
+		}
+		start, end := net.ParseIP(fields[0]), net.ParseIP(fields[1])
+		if start == nil || end == nil {
+			return nil, errors.Errorf("line %d: invalid ip range %s-%s", lineNum, fields[0], fields[1])
+		}
+		asn, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid AS number", lineNum)
+		}
+		if asn == 0 {
+			continue
+		}
+		table.ranges = append(table.ranges, asnRange{start: start.To16(), end: end.To16(), asn: uint32(asn)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(table.ranges, func(i, j int) bool {
+		return bytes.Compare(table.ranges[i].start, table.ranges[j].start) < 0
+	})
+	return table, nil
+}
+
+// lookup returns the AS number announcing the given ip address, or 0 if unknown.
+func (t *asnTable) lookup(ip net.IP) uint32 {
+	if t == nil {
+		return 0
+	}
+	ip = ip.To16()
+	// Index of the first range starting after the ip, the ip can only be in the previous range.
+	i := sort.Search(len(t.ranges), func(i int) bool {
+		return bytes.Compare(t.ranges[i].start, ip) > 0
+	})
+	if i == 0 || bytes.Compare(t.ranges[i-1].end, ip) < 0 {
+		return 0
+	}
+	return t.ranges[i-1].asn
+}
@@ -0,0 +1,157 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const testASNDatabase = "1.0.0.0\t1.0.0.255\t13335\tUS\tCLOUDFLARENET\n" +
+	"2.0.0.0\t2.0.255.255\t0\tNone\tNot routed\n" +
+	"# A comment.\n" +
+	"1.1.0.0\t1.1.255.255\t13335\tUS\tCLOUDFLARENET\n" +
+	"3.0.0.0\t3.255.255.255\t16509\tUS\tAMAZON-02\n" +
+	"2001:db8::\t2001:db8:ffff:ffff:ffff:ffff:ffff:ffff\t64500\tZZ\tDOCUMENTATION\n"
+
+func writeASNDatabase(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "ip2asn.tsv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func connectColocatedPeer(t *testing.T, p *peers.Status, addr string) peer.ID {
+	idBytes := make([]byte, 4)
+	_, err := rand.Read(idBytes)
+	require.NoError(t, err)
+	pid, err := peer.IDFromBytes(append([]byte{0x11, 0x04}, idBytes...))
+	require.NoError(t, err)
+	p.Add(nil, pid, ma.StringCast(addr), network.DirInbound)
+	p.SetConnectionState(pid, peers.PeerConnected)
+	return pid
+}
+
+func TestASNTable_Lookup(t *testing.T) {
+	table, err := loadASNTable(writeASNDatabase(t, testASNDatabase))
+	require.NoError(t, err)
+	tests := []struct {
+		ip  string
+		asn uint32
+	}{
+		{ip: "0.255.255.255", asn: 0},
+		{ip: "1.0.0.0", asn: 13335},
+		{ip: "1.0.0.255", asn: 13335},
+		{ip: "1.0.1.0", asn: 0},
+		{ip: "1.1.42.42", asn: 13335},
+		{ip: "2.0.0.1", asn: 0},
+		{ip: "3.120.0.1", asn: 16509},
+		{ip: "4.0.0.0", asn: 0},
+		{ip: "2001:db8::1", asn: 64500},
+		{ip: "2001:db9::1", asn: 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.asn, table.lookup(net.ParseIP(tt.ip)), tt.ip)
+	}
+
+	var nilTable *asnTable
+	assert.Equal(t, uint32(0), nilTable.lookup(net.ParseIP("1.0.0.0")))
+
+	_, err = loadASNTable(writeASNDatabase(t, "1.0.0.0\t1.0.0.255\n"))
+	assert.ErrorContains(t, "line 1: expected at least 3 fields", err)
+	_, err = loadASNTable(writeASNDatabase(t, "1.0.0.0\tfoo\t13335\n"))
+	assert.ErrorContains(t, "line 1: invalid ip range", err)
+}
+
+func TestConfigureColocationLimits(t *testing.T) {
+	limits, err := configureColocationLimits(&Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, limits == nil)
+
+	_, err = configureColocationLimits(&Config{MaxPeersPerIP: 1, ColocationExempt: []string{"10.0.0.0"}})
+	assert.ErrorContains(t, "could not parse colocation allow list entry", err)
+	_, err = configureColocationLimits(&Config{MaxPeersPerASN: 1})
+	assert.ErrorContains(t, "requires an ASN database", err)
+
+	limits, err = configureColocationLimits(&Config{
+		MaxPeersPerASN:   1,
+		ASNDatabase:      writeASNDatabase(t, testASNDatabase),
+		ColocationExempt: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(16509), limits.asns.lookup(net.ParseIP("3.0.0.1")))
+	assert.Equal(t, true, limits.isExempt(net.ParseIP("10.1.2.3")))
+	assert.Equal(t, false, limits.isExempt(net.ParseIP("11.1.2.3")))
+}
+
+func TestService_InterceptColocatedPeers(t *testing.T) {
+	trusted := connectColocatedPeer(t, peers.NewStatus(context.Background(), &peers.StatusConfig{
+		ScorerParams: &scorers.Config{},
+	}), "/ip4/212.67.10.1/tcp/13000")
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+			TrustedPeers: []peer.ID{trusted},
+		}),
+	}
+	var err error
+	s.colocation, err = configureColocationLimits(&Config{
+		MaxPeersPerIP:     2,
+		MaxPeersPerSubnet: 3,
+		MaxPeersPerASN:    4,
+		ASNDatabase:       writeASNDatabase(t, testASNDatabase),
+		ColocationExempt:  []string{"212.67.11.0/24"},
+	})
+	require.NoError(t, err)
+
+	connectColocatedPeer(t, s.peers, "/ip4/212.67.10.1/tcp/13000")
+	connectColocatedPeer(t, s.peers, "/ip4/212.67.10.1/tcp/13001")
+	connectColocatedPeer(t, s.peers, "/ip4/212.67.10.2/tcp/13000")
+	connectColocatedPeer(t, s.peers, "/ip4/3.0.0.1/tcp/13000")
+	connectColocatedPeer(t, s.peers, "/ip4/3.1.0.1/tcp/13000")
+	connectColocatedPeer(t, s.peers, "/ip4/3.2.0.1/tcp/13000")
+	connectColocatedPeer(t, s.peers, "/ip4/3.3.0.1/tcp/13000")
+
+	pid := connectColocatedPeer(t, peers.NewStatus(context.Background(), &peers.StatusConfig{
+		ScorerParams: &scorers.Config{},
+	}), "/ip4/1.0.0.1/tcp/13000")
+	tests := []struct {
+		name   string
+		pid    peer.ID
+		addr   string
+		reason string
+	}{
+		{name: "same ip", pid: pid, addr: "/ip4/212.67.10.1/tcp/13002", reason: reasonIPColocation},
+		{name: "same subnet", pid: pid, addr: "/ip4/212.67.10.3/tcp/13000", reason: reasonSubnetColocation},
+		{name: "same asn", pid: pid, addr: "/ip4/3.4.0.1/tcp/13000", reason: reasonASNColocation},
+		{name: "other subnet", pid: pid, addr: "/ip4/212.67.12.1/tcp/13000"},
+		{name: "exempt subnet", pid: pid, addr: "/ip4/212.67.11.1/tcp/13000"},
+		{name: "loopback", pid: pid, addr: "/ip4/127.0.0.1/tcp/13000"},
+		{name: "trusted peer", pid: trusted, addr: "/ip4/212.67.10.1/tcp/13002"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := ma.StringCast(tt.addr)
+			assert.Equal(t, tt.reason, s.colocationRejection(tt.pid, addr))
+			conn := &maEndpoints{raddr: addr}
+			assert.Equal(t, tt.reason == "", s.InterceptSecured(network.DirInbound, tt.pid, conn))
+			// Outbound connections are checked before dialing.
+			assert.Equal(t, true, s.InterceptSecured(network.DirOutbound, tt.pid, conn))
+		})
+	}
+
+	// A connected peer does not count towards its own limits.
+	connectColocatedPeer(t, s.peers, "/ip4/5.5.5.5/tcp/13000")
+	connected := connectColocatedPeer(t, s.peers, "/ip4/5.5.5.5/tcp/13001")
+	assert.Equal(t, "", s.colocationRejection(connected, ma.StringCast("/ip4/5.5.5.5/tcp/13001")))
+}
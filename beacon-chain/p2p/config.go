@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 )
@@ -11,7 +12,12 @@ type Config struct {
 	NoDiscovery         bool
 	EnableUPnP          bool
 	DisableDiscv5       bool
+	DisableIdentifyPush bool
+	EnableIdentifyDelta bool
+	UserAgent           string
 	StaticPeers         []string
+	SentryPeers         []string
+	TrustedPeers        []string
 	BootstrapNodeAddr   []string
 	Discv5BootStrapAddr []string
 	RelayNodeAddr       string
@@ -22,10 +28,17 @@ type Config struct {
 	DataDir             string
 	MetaDataDir         string
 	TCPPort             uint
+	QUICPort            uint
 	UDPPort             uint
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	MaxPeersPerIP       uint
+	MaxPeersPerSubnet   uint
+	MaxPeersPerASN      uint
+	ASNDatabase         string
+	ColocationExempt    []string
 	StateNotifier       statefeed.Notifier
+	OperationNotifier   operation.Notifier
 	DB                  db.ReadOnlyDatabase
 }
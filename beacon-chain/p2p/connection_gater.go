@@ -24,9 +24,22 @@ const (
 	highWatermarkBuffer = 10
 )
 
+// Reasons for rejecting a connection, used as labels of the rejected connections metric.
+const (
+	reasonDialLimit        = "dial_limit"
+	reasonPeerLimit        = "peer_limit"
+	reasonFiltered         = "filtered"
+	reasonBadPeer          = "bad_peer"
+	reasonNotSentry        = "not_sentry"
+	reasonIPColocation     = "ip_colocation"
+	reasonSubnetColocation = "subnet_colocation"
+	reasonASNColocation    = "asn_colocation"
+)
+
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// In sentry mode we only dial our sentries.
+	return s.allowedPeer(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -34,9 +47,20 @@ func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
 	// Disallow bad peers from dialing in.
 	if s.peers.IsBad(pid) {
+		rejectedConnections.WithLabelValues(network.DirOutbound.String(), reasonBadPeer).Inc()
+		return false
+	}
+	if !filterConnections(s.addrFilter, m) {
+		rejectedConnections.WithLabelValues(network.DirOutbound.String(), reasonFiltered).Inc()
+		return false
+	}
+	if reason := s.colocationRejection(pid, m); reason != "" {
+		log.WithFields(logrus.Fields{"peer": m,
+			"reason": reason}).Trace("Not dialing peer")
+		rejectedConnections.WithLabelValues(network.DirOutbound.String(), reason).Inc()
 		return false
 	}
-	return filterConnections(s.addrFilter, m)
+	return true
 }
 
 // InterceptAccept checks whether the incidental inbound connection is allowed.
@@ -47,19 +71,42 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 		runtime.Gosched()
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
+		rejectedConnections.WithLabelValues(network.DirInbound.String(), reasonDialLimit).Inc()
 		return false
 	}
 	if s.isPeerAtLimit(true /* inbound */) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+		rejectedConnections.WithLabelValues(network.DirInbound.String(), reasonPeerLimit).Inc()
 		return false
 	}
-	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
+	if !filterConnections(s.addrFilter, n.RemoteMultiaddr()) {
+		rejectedConnections.WithLabelValues(network.DirInbound.String(), reasonFiltered).Inc()
+		return false
+	}
+	return true
 }
 
 // InterceptSecured tests whether a given connection, now authenticated,
-// is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+// is allowed. The colocation limits of inbound connections are checked here,
+// once the identity of the peer is known, while those of outbound connections
+// are checked before dialing.
+func (s *Service) InterceptSecured(direction network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if !s.allowedPeer(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "not a sentry"}).Trace("Not accepting connection in sentry mode")
+		rejectedConnections.WithLabelValues(direction.String(), reasonNotSentry).Inc()
+		return false
+	}
+	if direction != network.DirInbound {
+		return true
+	}
+	if reason := s.colocationRejection(pid, n.RemoteMultiaddr()); reason != "" {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": reason}).Trace("Not accepting inbound connection")
+		rejectedConnections.WithLabelValues(direction.String(), reason).Inc()
+		return false
+	}
 	return true
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -471,3 +472,30 @@ func (c *maEndpoints) LocalMultiaddr() ma.Multiaddr {
 func (c *maEndpoints) RemoteMultiaddr() ma.Multiaddr {
 	return c.raddr
 }
+
+func TestService_InterceptSentryMode(t *testing.T) {
+	sentry, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	other, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/13000")
+	require.NoError(t, err)
+	conn := &maEndpoints{raddr: addr}
+
+	s := &Service{}
+	assert.Equal(t, true, s.InterceptPeerDial(other))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, other, conn))
+
+	infos, err := parseSentryPeers([]string{"/ip4/212.67.10.122/tcp/13000/p2p/" + sentry.String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(infos))
+	s.sentries = map[peer.ID]bool{infos[0].ID: true}
+	assert.Equal(t, true, s.InterceptPeerDial(sentry))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, sentry, conn))
+	assert.Equal(t, false, s.InterceptPeerDial(other))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, other, conn))
+	assert.Equal(t, false, s.InterceptSecured(network.DirOutbound, other, conn))
+
+	_, err = parseSentryPeers([]string{"/ip4/212.67.10.122/tcp/13000"})
+	assert.ErrorContains(t, "could not parse sentry peer", err)
+}
@@ -15,8 +15,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
-	"github.com/prysmaticlabs/prysm/config/params"
 	ecdsaprysm "github.com/prysmaticlabs/prysm/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/network/forks"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 )
@@ -42,6 +42,16 @@ func (s *Service) RefreshENR() {
 	if s.dv5Listener == nil || !s.isInitialized() {
 		return
 	}
+	// The ENR is refreshed every epoch and whenever the validators
+	// change their sync committee subscriptions.
+	s.enrLock.Lock()
+	defer s.enrLock.Unlock()
+	// Compare current epoch with our fork epochs
+	currEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	if err := s.updateBackboneSubnets(s.dv5Listener.Self().ID(), currEpoch); err != nil {
+		log.WithError(err).Error("Could not update backbone subnets")
+		return
+	}
 	bitV := bitfield.NewBitvector64()
 	committees := cache.SubnetIDs.GetAllSubnets()
 	for _, idx := range committees {
@@ -52,12 +62,9 @@ func (s *Service) RefreshENR() {
 		log.Errorf("Could not retrieve att bitfield: %v", err)
 		return
 	}
-	// Compare current epoch with our fork epochs
-	currEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
-	altairForkEpoch := params.BeaconConfig().AltairForkEpoch
 	switch {
 	// Altair Behaviour
-	case currEpoch >= altairForkEpoch:
+	case forks.IsActive(version.Altair, currEpoch):
 		// Retrieve sync subnets from application level
 		// cache.
 		bitS := bitfield.Bitvector4{byte(0x00)}
@@ -110,7 +117,7 @@ func (s *Service) listenForNewNodes() {
 			break
 		}
 		node := iterator.Node()
-		peerInfo, _, err := convertToAddrInfo(node)
+		peerInfo, err := s.dialAddrInfo(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to peer info")
 			continue
@@ -229,6 +236,9 @@ func (s *Service) createLocalNode(
 	localNode.Set(ipEntry)
 	localNode.Set(udpEntry)
 	localNode.Set(tcpEntry)
+	if s.cfg != nil && s.cfg.QUICPort != 0 {
+		localNode.Set(quicProtocol(s.cfg.QUICPort))
+	}
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
 
@@ -264,6 +274,9 @@ func (s *Service) startDiscoveryV5(
 // 5) Peer is ready to receive incoming connections.
 // 6) Peer's fork digest in their ENR matches that of
 // 	  our localnodes.
+// 7) Peers only partially matching our fork data are
+//    deprioritized, and only dialed while the local node
+//    is short of peers.
 func (s *Service) filterPeer(node *enode.Node) bool {
 	// Ignore nil node entries passed in.
 	if node == nil {
@@ -301,10 +314,14 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 	// Decide whether or not to connect to peer that does not
 	// match the proper fork ENR data with our local node.
 	if s.genesisValidatorsRoot != nil {
-		if err := s.compareForkENR(nodeENR); err != nil {
+		match, err := s.compareForkENR(nodeENR)
+		if err != nil {
 			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
 			return false
 		}
+		if match != forkMatchFull && !s.wantsPartialForkMatches() {
+			return false
+		}
 	}
 	// Add peer to peer handler.
 	s.peers.Add(nodeENR, peerData.ID, multiAddr, network.DirUnknown)
@@ -332,6 +349,13 @@ func (s *Service) isPeerAtLimit(inbound bool) bool {
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
 
+// wantsPartialForkMatches returns whether peers only partially matching our fork
+// data should be dialed, which is the case while the local node has less than
+// half of its maximum number of peers.
+func (s *Service) wantsPartialForkMatches() bool {
+	return len(s.Peers().Active()) < int(s.cfg.MaxPeers)/2
+}
+
 func parseBootStrapAddrs(addrs []string) (discv5Nodes []string) {
 	discv5Nodes, _ = parseGenericAddrs(addrs)
 	if len(discv5Nodes) == 0 {
@@ -37,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -171,6 +172,7 @@ func TestMultiAddrConversion_OK(t *testing.T) {
 func TestStaticPeering_PeersAreAdded(t *testing.T) {
 	cfg := &Config{
 		MaxPeers: 30,
+		DataDir:  t.TempDir(),
 	}
 	port := 6000
 	var staticPeers []string
@@ -367,11 +369,11 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 				assert.NoError(t, err)
 				s.dv5Listener = listener
 				s.metaData = wrapper.WrappedMetadataV0(new(ethpb.MetaDataV0))
-				s.updateSubnetRecordWithMetadata([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+				s.updateSubnetRecordWithMetadata(withBackboneSubnets(t, s, bitfield.NewBitvector64()))
 				return s
 			},
 			postValidation: func(t *testing.T, s *Service) {
-				assert.DeepEqual(t, bitfield.NewBitvector64(), s.metaData.AttnetsBitfield())
+				assert.DeepEqual(t, withBackboneSubnets(t, s, bitfield.NewBitvector64()), s.metaData.AttnetsBitfield())
 			},
 		},
 		{
@@ -393,7 +395,7 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 				return s
 			},
 			postValidation: func(t *testing.T, s *Service) {
-				assert.DeepEqual(t, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}, s.metaData.AttnetsBitfield())
+				assert.DeepEqual(t, withBackboneSubnets(t, s, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}), s.metaData.AttnetsBitfield())
 			},
 		},
 		{
@@ -424,7 +426,7 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 			postValidation: func(t *testing.T, s *Service) {
 				assert.Equal(t, version.Altair, s.metaData.Version())
 				assert.DeepEqual(t, bitfield.Bitvector4{0x00}, s.metaData.MetadataObjV1().Syncnets)
-				assert.DeepEqual(t, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}, s.metaData.AttnetsBitfield())
+				assert.DeepEqual(t, withBackboneSubnets(t, s, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}), s.metaData.AttnetsBitfield())
 			},
 		},
 		{
@@ -454,7 +456,7 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 			postValidation: func(t *testing.T, s *Service) {
 				assert.Equal(t, version.Altair, s.metaData.Version())
 				assert.DeepEqual(t, bitfield.Bitvector4{0x00}, s.metaData.MetadataObjV1().Syncnets)
-				assert.DeepEqual(t, bitfield.Bitvector64{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, s.metaData.AttnetsBitfield())
+				assert.DeepEqual(t, withBackboneSubnets(t, s, bitfield.NewBitvector64()), s.metaData.AttnetsBitfield())
 			},
 		},
 		{
@@ -486,7 +488,7 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 			postValidation: func(t *testing.T, s *Service) {
 				assert.Equal(t, version.Altair, s.metaData.Version())
 				assert.DeepEqual(t, bitfield.Bitvector4{0x03}, s.metaData.MetadataObjV1().Syncnets)
-				assert.DeepEqual(t, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}, s.metaData.AttnetsBitfield())
+				assert.DeepEqual(t, withBackboneSubnets(t, s, bitfield.Bitvector64{0xe, 0x0, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0}), s.metaData.AttnetsBitfield())
 			},
 		},
 	}
@@ -501,3 +503,15 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 		})
 	}
 }
+
+// withBackboneSubnets sets the bits of the node's backbone subnets at the current epoch.
+func withBackboneSubnets(t *testing.T, s *Service, bitV bitfield.Bitvector64) bitfield.Bitvector64 {
+	currEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	subnets, err := computeSubscribedSubnets(s.dv5Listener.Self().ID(), currEpoch)
+	require.NoError(t, err)
+	bitV = bytesutil.SafeCopyBytes(bitV)
+	for _, subnet := range subnets {
+		bitV.SetBitAt(subnet, true)
+	}
+	return bitV
+}
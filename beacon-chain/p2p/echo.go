@@ -0,0 +1,162 @@
+package p2p
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// Kinds of the own messages whose propagation is measured.
+const (
+	echoKindBlock        = "block"
+	echoKindAttestation  = "attestation"
+	echoKindAggregate    = "aggregate"
+	echoKindContribution = "contribution"
+)
+
+var (
+	ownMessagesBroadcast = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_own_messages_broadcast_total",
+		Help: "The number of blocks, attestations and contributions broadcast by this node.",
+	}, []string{"kind"})
+	ownMessagesNotEchoed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_own_messages_not_echoed_total",
+		Help: "The number of messages broadcast by this node that were not received back from any peer within an epoch.",
+	}, []string{"kind"})
+	ownMessageEchoLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "p2p_own_message_echo_latency_seconds",
+		Help:    "The time between the broadcast of a message by this node and its first echo from a peer.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8, 12},
+	}, []string{"kind"})
+)
+
+type ownMessage struct {
+	kind        string
+	topic       string
+	broadcastAt time.Time
+}
+
+// echoTracker detects when the messages broadcast by this node are first seen back from other
+// peers. Gossipsub drops the copies of a message it has already seen as duplicates, so the echoes
+// are observed through its raw tracer interface.
+type echoTracker struct {
+	self     peer.ID
+	notifier operation.Notifier
+	now      func() time.Time
+
+	lock    sync.Mutex
+	pending map[string]*ownMessage
+}
+
+var _ pubsub.RawTracer = (*echoTracker)(nil)
+
+func newEchoTracker(self peer.ID, notifier operation.Notifier) *echoTracker {
+	return &echoTracker{
+		self:     self,
+		notifier: notifier,
+		now:      time.Now,
+		pending:  make(map[string]*ownMessage),
+	}
+}
+
+// echoKind returns the kind of message broadcast on the topic, or an empty string if the
+// propagation of the topic's messages is not measured.
+func echoKind(topic string) string {
+	name := topic[strings.LastIndex(topic, "/")+1:]
+	switch {
+	case name == GossipBlockMessage:
+		return echoKindBlock
+	case strings.HasPrefix(name, GossipAttestationMessage+"_"):
+		return echoKindAttestation
+	case name == GossipAggregateAndProofMessage:
+		return echoKindAggregate
+	case name == GossipContributionAndProofMessage:
+		return echoKindContribution
+	default:
+		return ""
+	}
+}
+
+// track records a message about to be broadcast on the topic, keyed by its gossip message id.
+func (t *echoTracker) track(id, topic string) {
+	kind := echoKind(topic)
+	if kind == "" {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, ok := t.pending[id]; ok {
+		return
+	}
+	t.pending[id] = &ownMessage{kind: kind, topic: topic, broadcastAt: t.now()}
+	ownMessagesBroadcast.WithLabelValues(kind).Inc()
+}
+
+// prune drops the messages that were not echoed back within an epoch.
+func (t *echoTracker) prune() {
+	expiry := time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for id, msg := range t.pending {
+		if t.now().Sub(msg.broadcastAt) > expiry {
+			ownMessagesNotEchoed.WithLabelValues(msg.kind).Inc()
+			delete(t.pending, id)
+		}
+	}
+}
+
+// DuplicateMessage is invoked when gossipsub drops a message it has already seen, which is the
+// case of the own messages received back from peers.
+func (t *echoTracker) DuplicateMessage(msg *pubsub.Message) {
+	if msg.ReceivedFrom == t.self || msg.ID == "" {
+		return
+	}
+	t.lock.Lock()
+	own, ok := t.pending[msg.ID]
+	if ok {
+		delete(t.pending, msg.ID)
+	}
+	t.lock.Unlock()
+	if !ok {
+		return
+	}
+	latency := t.now().Sub(own.broadcastAt)
+	ownMessageEchoLatency.WithLabelValues(own.kind).Observe(latency.Seconds())
+	if t.notifier != nil {
+		t.notifier.OperationFeed().Send(&feed.Event{
+			Type: operation.OwnMessageEchoed,
+			Data: &operation.OwnMessageEchoedData{
+				Kind:    own.kind,
+				Topic:   own.topic,
+				Peer:    msg.ReceivedFrom,
+				Latency: latency,
+			},
+		})
+	}
+}
+
+// The remaining tracer events are not used.
+
+func (*echoTracker) AddPeer(peer.ID, protocol.ID)          {}
+func (*echoTracker) RemovePeer(peer.ID)                    {}
+func (*echoTracker) Join(string)                           {}
+func (*echoTracker) Leave(string)                          {}
+func (*echoTracker) Graft(peer.ID, string)                 {}
+func (*echoTracker) Prune(peer.ID, string)                 {}
+func (*echoTracker) ValidateMessage(*pubsub.Message)       {}
+func (*echoTracker) DeliverMessage(*pubsub.Message)        {}
+func (*echoTracker) RejectMessage(*pubsub.Message, string) {}
+func (*echoTracker) ThrottlePeer(peer.ID)                  {}
+func (*echoTracker) RecvRPC(*pubsub.RPC)                   {}
+func (*echoTracker) SendRPC(*pubsub.RPC, peer.ID)          {}
+func (*echoTracker) DropRPC(*pubsub.RPC, peer.ID)          {}
+func (*echoTracker) UndeliverableMessage(*pubsub.Message)  {}
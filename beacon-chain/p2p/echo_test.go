@@ -0,0 +1,85 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestEchoKind(t *testing.T) {
+	tests := map[string]string{
+		"/eth2/01020304/beacon_block":                          echoKindBlock,
+		"/eth2/01020304/beacon_attestation_12":                 echoKindAttestation,
+		"/eth2/01020304/beacon_aggregate_and_proof":            echoKindAggregate,
+		"/eth2/01020304/sync_committee_contribution_and_proof": echoKindContribution,
+		"/eth2/01020304/sync_committee_3":                      "",
+		"/eth2/01020304/voluntary_exit":                        "",
+	}
+	for topic, kind := range tests {
+		assert.Equal(t, kind, echoKind(topic), topic)
+	}
+}
+
+func TestEchoTracker_DuplicateMessage(t *testing.T) {
+	self := peer.ID("self")
+	remote := peer.ID("remote")
+	notifier := &mock.MockOperationNotifier{}
+	events := make(chan *feed.Event, 1)
+	sub := notifier.OperationFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	now := time.Now()
+	tracker := newEchoTracker(self, notifier)
+	tracker.now = func() time.Time { return now }
+	tracker.track("block", "/eth2/01020304/beacon_block")
+	tracker.track("exit", "/eth2/01020304/voluntary_exit")
+	require.Equal(t, 1, len(tracker.pending))
+
+	// Messages relayed by this node itself, or not published by it, are ignored.
+	tracker.DuplicateMessage(&pubsub.Message{ID: "block", ReceivedFrom: self})
+	tracker.DuplicateMessage(&pubsub.Message{ID: "other", ReceivedFrom: remote})
+	require.Equal(t, 1, len(tracker.pending))
+
+	now = now.Add(300 * time.Millisecond)
+	tracker.DuplicateMessage(&pubsub.Message{ID: "block", ReceivedFrom: remote})
+	require.Equal(t, 0, len(tracker.pending))
+	select {
+	case e := <-events:
+		assert.Equal(t, operation.OwnMessageEchoed, int(e.Type))
+		data, ok := e.Data.(*operation.OwnMessageEchoedData)
+		require.Equal(t, true, ok)
+		assert.Equal(t, echoKindBlock, data.Kind)
+		assert.Equal(t, remote, data.Peer)
+		assert.Equal(t, 300*time.Millisecond, data.Latency)
+	default:
+		t.Fatal("Expected an echo event")
+	}
+
+	// Only the first echo of a message is reported.
+	tracker.DuplicateMessage(&pubsub.Message{ID: "block", ReceivedFrom: remote})
+	assert.Equal(t, 0, len(events))
+}
+
+func TestEchoTracker_Prune(t *testing.T) {
+	now := time.Now()
+	tracker := newEchoTracker("self", nil)
+	tracker.now = func() time.Time { return now }
+	tracker.track("old", "/eth2/01020304/beacon_block")
+	now = now.Add(time.Hour)
+	tracker.track("new", "/eth2/01020304/beacon_block")
+	tracker.prune()
+	require.Equal(t, 1, len(tracker.pending))
+	_, ok := tracker.pending["new"]
+	assert.Equal(t, true, ok)
+
+	// Echoes are measured without an operation notifier.
+	tracker.DuplicateMessage(&pubsub.Message{ID: "new", ReceivedFrom: "remote"})
+	assert.Equal(t, 0, len(tracker.pending))
+}
@@ -0,0 +1,41 @@
+package encoder
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	payloadKindGossip = "gossip"
+	payloadKindRPC    = "rpc"
+)
+
+var (
+	compressionRatio = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_encoder_compression_ratio",
+			Help:    "The ratio of the decompressed size to the compressed size of the decoded payloads",
+			Buckets: []float64{1, 1.5, 2, 3, 4, 6, 8, 12, 16, 32},
+		},
+		[]string{
+			"kind",
+		},
+	)
+	oversizePayloadsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_encoder_oversize_payloads_total",
+			Help: "The number of payloads rejected for exceeding the maximum payload size",
+		},
+		[]string{
+			"kind",
+		},
+	)
+)
+
+// observeCompressionRatio records the compression ratio of a decoded payload of the given kind.
+func observeCompressionRatio(kind string, decompressed, compressed int) {
+	if compressed == 0 {
+		return
+	}
+	compressionRatio.WithLabelValues(kind).Observe(float64(decompressed) / float64(compressed))
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 
 	ssz "github.com/prysmaticlabs/fastssz"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 )
 
 // NetworkEncoding represents an encoder compatible with Ethereum consensus p2p.
@@ -13,11 +14,20 @@ type NetworkEncoding interface {
 	// DecodeWithMaxLength a bytes from a reader with a varint length prefix. The interface must be a pointer to the
 	// decoding destination. The length of the message should not be more than the provided limit.
 	DecodeWithMaxLength(io.Reader, ssz.Unmarshaler) error
+	// DecodeWithMaxLengthAtEpoch a bytes from a reader with a varint length prefix. The interface must be a pointer
+	// to the decoding destination. The length of the message should not be more than the limit of the fork at the epoch.
+	DecodeWithMaxLengthAtEpoch(io.Reader, ssz.Unmarshaler, types.Epoch) error
+	// DecodeWithLimit a bytes from a reader with a varint length prefix. The interface must be a pointer to the
+	// decoding destination. The length of the message should not be more than the provided limit.
+	DecodeWithLimit(io.Reader, ssz.Unmarshaler, uint64) error
 	// EncodeGossip an arbitrary gossip message to the provided writer. The interface must be a pointer object to encode.
 	EncodeGossip(io.Writer, ssz.Marshaler) (int, error)
 	// EncodeWithMaxLength an arbitrary message to the provided writer with a varint length prefix. The interface must be
 	// a pointer object to encode. The encoded message should not be bigger than the provided limit.
 	EncodeWithMaxLength(io.Writer, ssz.Marshaler) (int, error)
+	// EncodeWithLimit an arbitrary message to the provided writer with a varint length prefix. The interface must be
+	// a pointer object to encode. The encoded message should not be bigger than the provided limit.
+	EncodeWithLimit(io.Writer, ssz.Marshaler, uint64) (int, error)
 	// ProtocolSuffix returns the last part of the protocol ID to indicate the encoding scheme.
 	ProtocolSuffix() string
 }
@@ -1,6 +1,7 @@
 package encoder
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"sync"
@@ -10,7 +11,10 @@ import (
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/math"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 var _ NetworkEncoding = (*SszNetworkEncoder)(nil)
@@ -19,6 +23,10 @@ var _ NetworkEncoding = (*SszNetworkEncoder)(nil)
 var MaxGossipSize = params.BeaconNetworkConfig().GossipMaxSize // 1 Mib.
 var MaxChunkSize = params.BeaconNetworkConfig().MaxChunkSize   // 1 Mib.
 
+// Initial capacity of the buffer receiving a decompressed req/resp payload. The buffer grows with
+// the data actually received rather than the length announced by the peer.
+const initialDecodeBufferSize = 1 << 12
+
 // This pool defines the sync pool for our buffered snappy writers, so that they
 // can be constantly reused.
 var bufWriterPool = new(sync.Pool)
@@ -52,7 +60,13 @@ func (_ SszNetworkEncoder) EncodeGossip(w io.Writer, msg fastssz.Marshaler) (int
 
 // EncodeWithMaxLength the proto message to the io.Writer. This encoding prefixes the byte slice with a protobuf varint
 // to indicate the size of the message. This checks that the encoded message isn't larger than the provided max limit.
-func (_ SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshaler) (int, error) {
+func (e SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshaler) (int, error) {
+	return e.EncodeWithLimit(w, msg, MaxChunkSize)
+}
+
+// EncodeWithLimit the proto message to the io.Writer like EncodeWithMaxLength, checking that the encoded
+// message isn't larger than the provided limit instead of the max chunk size.
+func (_ SszNetworkEncoder) EncodeWithLimit(w io.Writer, msg fastssz.Marshaler, limit uint64) (int, error) {
 	if msg == nil {
 		return 0, nil
 	}
@@ -60,11 +74,11 @@ func (_ SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshale
 	if err != nil {
 		return 0, err
 	}
-	if uint64(len(b)) > MaxChunkSize {
+	if uint64(len(b)) > limit {
 		return 0, fmt.Errorf(
 			"size of encoded message is %d which is larger than the provided max limit of %d",
 			len(b),
-			MaxChunkSize,
+			limit,
 		)
 	}
 	// write varint first
@@ -95,46 +109,73 @@ func DecodeSnappy(msg []byte, maxSize uint64) ([]byte, error) {
 		return nil, err
 	}
 	if uint64(size) > maxSize {
+		oversizePayloadsCounter.WithLabelValues(payloadKindGossip).Inc()
 		return nil, errors.Errorf("snappy message exceeds max size: %d bytes > %d bytes", size, maxSize)
 	}
+	compressedLen := len(msg)
 	msg, err = snappy.Decode(nil /*dst*/, msg)
 	if err != nil {
 		return nil, err
 	}
+	observeCompressionRatio(payloadKindGossip, len(msg), compressedLen)
 	return msg, nil
 }
 
 // DecodeWithMaxLength the bytes from io.Reader to the protobuf message provided.
 // This checks that the decoded message isn't larger than the provided max limit.
 func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to fastssz.Unmarshaler) error {
+	return e.decodeWithLimit(r, to, MaxChunkSize)
+}
+
+// DecodeWithMaxLengthAtEpoch the bytes from io.Reader to the protobuf message provided.
+// This checks that the decoded message isn't larger than the max chunk size of the fork
+// active at the provided epoch.
+func (e SszNetworkEncoder) DecodeWithMaxLengthAtEpoch(r io.Reader, to fastssz.Unmarshaler, epoch types.Epoch) error {
+	return e.decodeWithLimit(r, to, MaxChunkSizeAtEpoch(epoch))
+}
+
+// DecodeWithLimit the bytes from io.Reader to the protobuf message provided.
+// This checks that the decoded message isn't larger than the provided limit.
+func (e SszNetworkEncoder) DecodeWithLimit(r io.Reader, to fastssz.Unmarshaler, limit uint64) error {
+	return e.decodeWithLimit(r, to, limit)
+}
+
+func (e SszNetworkEncoder) decodeWithLimit(r io.Reader, to fastssz.Unmarshaler, limit uint64) error {
 	msgLen, err := readVarint(r)
 	if err != nil {
 		return err
 	}
-	if msgLen > MaxChunkSize {
+	if msgLen > limit {
+		oversizePayloadsCounter.WithLabelValues(payloadKindRPC).Inc()
 		return fmt.Errorf(
 			"remaining bytes %d goes over the provided max limit of %d",
 			msgLen,
-			MaxChunkSize,
+			limit,
 		)
 	}
 	msgMax, err := e.MaxLength(msgLen)
 	if err != nil {
 		return err
 	}
-	limitedRdr := io.LimitReader(r, int64(msgMax))
-	r = newBufferedReader(limitedRdr)
-	defer bufReaderPool.Put(r)
+	// The compressed stream is cut at the max encoded length of the announced size, so that
+	// the decoding aborts as soon as a peer sends more data than a valid payload can take.
+	counter := &countingReader{r: io.LimitReader(r, int64(msgMax))}
+	bufR := newBufferedReader(counter)
+	defer bufReaderPool.Put(bufR)
 
-	buf := make([]byte, msgLen)
+	initialSize := msgLen
+	if initialSize > initialDecodeBufferSize {
+		initialSize = initialDecodeBufferSize
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, initialSize))
 	// Returns an error if less than msgLen bytes
 	// are read. This ensures we read exactly the
 	// required amount.
-	_, err = io.ReadFull(r, buf)
-	if err != nil {
+	if _, err := io.CopyN(buf, bufR, int64(msgLen)); err != nil {
 		return err
 	}
-	return doDecode(buf, to)
+	observeCompressionRatio(payloadKindRPC, buf.Len(), counter.n)
+	return doDecode(buf.Bytes(), to)
 }
 
 // ProtocolSuffix returns the appropriate suffix for protocol IDs.
@@ -201,6 +242,36 @@ func newBufferedWriter(w io.Writer) *snappy.Writer {
 	return bufW
 }
 
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+// MaxGossipSizeAtEpoch returns the max size of the gossip messages of the fork active at the
+// provided epoch.
+func MaxGossipSizeAtEpoch(epoch types.Epoch) uint64 {
+	if forks.IsActive(version.Bellatrix, epoch) {
+		return params.BeaconNetworkConfig().GossipMaxSizeBellatrix
+	}
+	return params.BeaconNetworkConfig().GossipMaxSize
+}
+
+// MaxChunkSizeAtEpoch returns the max size of the req/resp chunks of the fork active at the
+// provided epoch.
+func MaxChunkSizeAtEpoch(epoch types.Epoch) uint64 {
+	if forks.IsActive(version.Bellatrix, epoch) {
+		return params.BeaconNetworkConfig().MaxChunkSizeBellatrix
+	}
+	return params.BeaconNetworkConfig().MaxChunkSize
+}
+
 // SetMaxGossipSizeForBellatrix sets the MaxGossipSize to 10Mb.
 func SetMaxGossipSizeForBellatrix() {
 	MaxGossipSize = params.BeaconNetworkConfig().GossipMaxSizeBellatrix
@@ -10,6 +10,7 @@ import (
 
 	gogo "github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -105,6 +106,84 @@ func TestSszNetworkEncoder_DecodeWithMaxLength(t *testing.T) {
 	assert.ErrorContains(t, wanted, err)
 }
 
+func TestSszNetworkEncoder_DecodeWithMaxLengthAtEpoch(t *testing.T) {
+	buf := new(bytes.Buffer)
+	msg := &ethpb.Fork{
+		PreviousVersion: []byte("fooo"),
+		CurrentVersion:  []byte("barr"),
+		Epoch:           4242,
+	}
+	e := &encoder.SszNetworkEncoder{}
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 5
+	params.OverrideBeaconConfig(cfg)
+	c := params.BeaconNetworkConfig()
+	c.MaxChunkSize = 5
+	c.MaxChunkSizeBellatrix = 1 << 10
+	params.OverrideBeaconNetworkConfig(c)
+	encoder.MaxChunkSize = c.MaxChunkSizeBellatrix
+	_, err := e.EncodeWithMaxLength(buf, msg)
+	require.NoError(t, err)
+	encoded := buf.Bytes()
+
+	decoded := &ethpb.Fork{}
+	err = e.DecodeWithMaxLengthAtEpoch(bytes.NewReader(encoded), decoded, 4)
+	assert.ErrorContains(t, "goes over the provided max limit of 5", err)
+	require.NoError(t, e.DecodeWithMaxLengthAtEpoch(bytes.NewReader(encoded), decoded, 5))
+	assert.Equal(t, true, proto.Equal(msg, decoded))
+}
+
+func TestSszNetworkEncoder_EncodeDecodeWithLimit(t *testing.T) {
+	buf := new(bytes.Buffer)
+	st, _ := util.DeterministicGenesisState(t, 100)
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	msg := types.SSZBytes(enc)
+	e := &encoder.SszNetworkEncoder{}
+	require.Equal(t, true, uint64(len(enc)) > encoder.MaxChunkSize)
+
+	limit := uint64(len(enc))
+	_, err = e.EncodeWithLimit(buf, &msg, limit-1)
+	assert.ErrorContains(t, fmt.Sprintf("larger than the provided max limit of %d", limit-1), err)
+	_, err = e.EncodeWithLimit(buf, &msg, limit)
+	require.NoError(t, err)
+	encoded := buf.Bytes()
+
+	decoded := types.SSZBytes{}
+	err = e.DecodeWithLimit(bytes.NewReader(encoded), &decoded, limit-1)
+	assert.ErrorContains(t, fmt.Sprintf("goes over the provided max limit of %d", limit-1), err)
+	require.NoError(t, e.DecodeWithLimit(bytes.NewReader(encoded), &decoded, limit))
+	assert.DeepEqual(t, msg, decoded)
+}
+
+func TestSszNetworkEncoder_DecodeWithMaxLength_TruncatedPayload(t *testing.T) {
+	buf := new(bytes.Buffer)
+	st, _ := util.DeterministicGenesisState(t, 100)
+	e := &encoder.SszNetworkEncoder{}
+	params.SetupTestConfigCleanup(t)
+	encoder.MaxChunkSize = uint64(1 << 22)
+	_, err := e.EncodeWithMaxLength(buf, st.InnerStateUnsafe().(*ethpb.BeaconState))
+	require.NoError(t, err)
+
+	// The payload is cut short of the announced length.
+	decoded := new(ethpb.BeaconState)
+	err = e.DecodeWithMaxLength(bytes.NewReader(buf.Bytes()[:buf.Len()/2]), decoded)
+	assert.NotNil(t, err)
+}
+
+func TestMaxSizesAtEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 5
+	params.OverrideBeaconConfig(cfg)
+
+	assert.Equal(t, params.BeaconNetworkConfig().GossipMaxSize, encoder.MaxGossipSizeAtEpoch(4))
+	assert.Equal(t, params.BeaconNetworkConfig().GossipMaxSizeBellatrix, encoder.MaxGossipSizeAtEpoch(5))
+	assert.Equal(t, params.BeaconNetworkConfig().MaxChunkSize, encoder.MaxChunkSizeAtEpoch(4))
+	assert.Equal(t, params.BeaconNetworkConfig().MaxChunkSizeBellatrix, encoder.MaxChunkSizeAtEpoch(5))
+}
+
 func TestSszNetworkEncoder_DecodeWithMultipleFrames(t *testing.T) {
 	buf := new(bytes.Buffer)
 	st, _ := util.DeterministicGenesisState(t, 100)
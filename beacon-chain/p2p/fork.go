@@ -3,12 +3,14 @@ package p2p
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/network/forks"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
@@ -28,32 +30,66 @@ func (s *Service) currentForkDigest() ([4]byte, error) {
 	return forks.CreateForkDigest(s.genesisTime, s.genesisValidatorsRoot)
 }
 
+// forkMatch describes how closely the fork data in the ENR of a peer matches our own.
+type forkMatch uint8
+
+const (
+	// The peer is on a different network or fork schedule.
+	forkMismatch forkMatch = iota
+	// The peer has our current fork digest but a different next fork version or epoch.
+	forkMatchCurrentOnly
+	// The peer is on the other side of a fork boundary close to the current epoch, with fork
+	// data matching our fork schedule.
+	forkMatchBoundary
+	// The peer has our current fork digest, next fork version and next fork epoch.
+	forkMatchFull
+)
+
+// Number of epochs around a fork boundary during which peers that are on the other side of
+// the boundary are still considered for connection.
+const forkBoundaryEpochs = types.Epoch(2)
+
 // Compares fork ENRs between an incoming peer's record and our node's
-// local record values for current and next fork version/epoch.
-func (s *Service) compareForkENR(record *enr.Record) error {
+// local record values for current and next fork version/epoch. Peers
+// which are not on our fork are accepted around fork boundaries if their
+// fork data matches our fork schedule, in order to smooth peer churn
+// when a hard fork activates.
+func (s *Service) compareForkENR(record *enr.Record) (forkMatch, error) {
 	currentRecord := s.dv5Listener.LocalNode().Node().Record()
 	peerForkENR, err := forkEntry(record)
 	if err != nil {
-		return err
+		return forkMismatch, err
 	}
 	currentForkENR, err := forkEntry(currentRecord)
 	if err != nil {
-		return err
+		return forkMismatch, err
 	}
 	enrString, err := SerializeENR(record)
 	if err != nil {
-		return err
+		return forkMismatch, err
 	}
 	// Clients SHOULD connect to peers with current_fork_digest, next_fork_version,
 	// and next_fork_epoch that match local values.
 	if !bytes.Equal(peerForkENR.CurrentForkDigest, currentForkENR.CurrentForkDigest) {
-		return fmt.Errorf(
+		isBoundaryPeer, err := s.isForkBoundaryPeer(peerForkENR)
+		if err != nil {
+			return forkMismatch, err
+		}
+		if isBoundaryPeer {
+			log.WithFields(logrus.Fields{
+				"peerForkDigest": fmt.Sprintf("%#x", peerForkENR.CurrentForkDigest),
+				"peerENR":        enrString,
+			}).Trace("Peer fork digest does not match but peer is on the other side of a fork boundary")
+			return forkMatchBoundary, nil
+		}
+		return forkMismatch, fmt.Errorf(
 			"fork digest of peer with ENR %s: %v, does not match local value: %v",
 			enrString,
 			peerForkENR.CurrentForkDigest,
 			currentForkENR.CurrentForkDigest,
 		)
 	}
+	match := forkMatchFull
 	// Clients MAY connect to peers with the same current_fork_version but a
 	// different next_fork_version/next_fork_epoch. Unless ENRForkID is manually
 	// updated to matching prior to the earlier next_fork_epoch of the two clients,
@@ -64,14 +100,71 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 			"peerNextForkEpoch": peerForkENR.NextForkEpoch,
 			"peerENR":           enrString,
 		}).Trace("Peer matches fork digest but has different next fork epoch")
+		match = forkMatchCurrentOnly
 	}
 	if !bytes.Equal(peerForkENR.NextForkVersion, currentForkENR.NextForkVersion) {
 		log.WithFields(logrus.Fields{
 			"peerNextForkVersion": peerForkENR.NextForkVersion,
 			"peerENR":             enrString,
 		}).Trace("Peer matches fork digest but has different next fork version")
+		match = forkMatchCurrentOnly
+	}
+	return match, nil
+}
+
+// isForkBoundaryPeer checks whether the fork data of a peer is the one our node advertised
+// before the activation of our current fork, or will advertise after the activation of our
+// next fork, when the current epoch is within forkBoundaryEpochs of that fork.
+func (s *Service) isForkBoundaryPeer(peerForkENR *pb.ENRForkID) (bool, error) {
+	if s.genesisTime.IsZero() || prysmTime.Now().Before(s.genesisTime) {
+		return false, nil
+	}
+	currentEpoch := slots.ToEpoch(slots.Since(s.genesisTime))
+	currentFork, err := forks.Fork(currentEpoch)
+	if err != nil {
+		return false, err
+	}
+	_, nextForkEpoch, err := forks.NextForkData(currentEpoch)
+	if err != nil {
+		return false, err
+	}
+	var boundaryEpochs []types.Epoch
+	if currentFork.Epoch > 0 && currentEpoch-currentFork.Epoch < forkBoundaryEpochs {
+		boundaryEpochs = append(boundaryEpochs, currentFork.Epoch-1)
+	}
+	if nextForkEpoch != math.MaxUint64 && nextForkEpoch-currentEpoch <= forkBoundaryEpochs {
+		boundaryEpochs = append(boundaryEpochs, nextForkEpoch)
 	}
-	return nil
+	for _, epoch := range boundaryEpochs {
+		boundaryForkENR, err := forkIDAtEpoch(epoch, s.genesisValidatorsRoot)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(peerForkENR.CurrentForkDigest, boundaryForkENR.CurrentForkDigest) &&
+			bytes.Equal(peerForkENR.NextForkVersion, boundaryForkENR.NextForkVersion) &&
+			peerForkENR.NextForkEpoch == boundaryForkENR.NextForkEpoch {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// forkIDAtEpoch returns the fork entry advertised at the given epoch
+// by a node following our fork schedule.
+func forkIDAtEpoch(epoch types.Epoch, genesisValidatorsRoot []byte) (*pb.ENRForkID, error) {
+	digest, err := forks.DigestAtEpoch(epoch, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	nextForkVersion, nextForkEpoch, err := forks.NextForkData(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   nextForkVersion[:],
+		NextForkEpoch:     nextForkEpoch,
+	}, nil
 }
 
 // Adds a fork entry as an ENR record under the Ethereum consensus EnrKey for
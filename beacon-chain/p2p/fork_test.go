@@ -49,6 +49,7 @@ func TestStartDiscv5_DifferentForkDigests(t *testing.T) {
 		Discv5BootStrapAddr: []string{bootNode.String()},
 		UDPPort:             uint(port),
 		StateNotifier:       &mock.MockStateNotifier{},
+		DataDir:             t.TempDir(),
 	}
 
 	var listeners []*discover.UDPv5
@@ -135,6 +136,7 @@ func TestStartDiscv5_SameForkDigests_DifferentNextForkData(t *testing.T) {
 	cfg := &Config{
 		Discv5BootStrapAddr: []string{bootNode.String()},
 		UDPPort:             uint(port),
+		DataDir:             t.TempDir(),
 	}
 
 	var listeners []*discover.UDPv5
@@ -278,3 +280,78 @@ func TestAddForkEntry_Genesis(t *testing.T) {
 		params.BeaconConfig().GenesisForkVersion, forkEntry.NextForkVersion,
 		"Wanted Next Fork Version to be equal to genesis fork version")
 }
+
+func TestCompareForkENR(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.ForkVersionSchedule = map[[4]byte]types.Epoch{
+		bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion): 0,
+		{0, 0, 0, 1}: 10,
+		{0, 0, 0, 2}: 100,
+	}
+	params.OverrideBeaconConfig(c)
+	genesisValidatorsRoot := make([]byte, 32)
+	// Returns a genesis time such that the current time is in the middle of the given epoch.
+	genesisAtEpoch := func(epoch types.Epoch) time.Time {
+		slotsSinceGenesis := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))) + uint64(params.BeaconConfig().SlotsPerEpoch)/2
+		return time.Now().Add(-time.Duration(slotsSinceGenesis*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	}
+	peerRecord := func(forkID *pb.ENRForkID) *enr.Record {
+		enc, err := forkID.MarshalSSZ()
+		require.NoError(t, err)
+		_, pkey := createAddrAndPrivKey(t)
+		db, err := enode.OpenDB("")
+		require.NoError(t, err)
+		localNode := enode.NewLocalNode(db, pkey)
+		localNode.Set(enr.WithEntry(eth2ENRKey, enc))
+		return localNode.Node().Record()
+	}
+	forkIDAt := func(epoch types.Epoch) *pb.ENRForkID {
+		forkID, err := forkIDAtEpoch(epoch, genesisValidatorsRoot)
+		require.NoError(t, err)
+		return forkID
+	}
+	otherNetwork, err := forkIDAtEpoch(11, bytesutil.PadTo([]byte{'A'}, 32))
+	require.NoError(t, err)
+	differentNextFork := forkIDAt(11)
+	differentNextFork.NextForkEpoch = 101
+
+	tests := []struct {
+		name   string
+		epoch  types.Epoch
+		peer   *pb.ENRForkID
+		match  forkMatch
+		errMsg string
+	}{
+		{name: "same fork data", epoch: 11, peer: forkIDAt(11), match: forkMatchFull},
+		{name: "different next fork", epoch: 11, peer: differentNextFork, match: forkMatchCurrentOnly},
+		{name: "peer before activated fork", epoch: 11, peer: forkIDAt(9), match: forkMatchBoundary},
+		{name: "peer after upcoming fork", epoch: 98, peer: forkIDAt(100), match: forkMatchBoundary},
+		{name: "peer long before activated fork", epoch: 50, peer: forkIDAt(9), errMsg: "does not match local value"},
+		{name: "peer long after upcoming fork", epoch: 50, peer: forkIDAt(100), errMsg: "does not match local value"},
+		{name: "other network", epoch: 11, peer: otherNetwork, errMsg: "does not match local value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ipAddr, pkey := createAddrAndPrivKey(t)
+			s := &Service{
+				cfg:                   &Config{UDPPort: 2000},
+				genesisTime:           genesisAtEpoch(tt.epoch),
+				genesisValidatorsRoot: genesisValidatorsRoot,
+			}
+			listener, err := s.createListener(ipAddr, pkey)
+			require.NoError(t, err)
+			defer listener.Close()
+			s.dv5Listener = listener
+
+			match, err := s.compareForkENR(peerRecord(tt.peer))
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, tt.errMsg, err)
+				assert.Equal(t, forkMismatch, match)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.match, match)
+		})
+	}
+}
@@ -3,9 +3,10 @@ package p2p
 import (
 	"reflect"
 
-	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -20,16 +21,17 @@ var gossipTopicMappings = map[string]proto.Message{
 	AggregateAndProofSubnetTopicFormat:        &ethpb.SignedAggregateAttestationAndProof{},
 	SyncContributionAndProofSubnetTopicFormat: &ethpb.SignedContributionAndProof{},
 	SyncCommitteeSubnetTopicFormat:            &ethpb.SyncCommitteeMessage{},
+	BlsToExecutionChangeSubnetTopicFormat:     &ethpb.SignedBLSToExecutionChange{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
 // versioned by epoch.
 func GossipTopicMappings(topic string, epoch types.Epoch) proto.Message {
 	if topic == BlockSubnetTopicFormat {
-		if epoch >= params.BeaconConfig().BellatrixForkEpoch {
+		if forks.IsActive(version.Bellatrix, epoch) {
 			return &ethpb.SignedBeaconBlockBellatrix{}
 		}
-		if epoch >= params.BeaconConfig().AltairForkEpoch {
+		if forks.IsActive(version.Altair, epoch) {
 			return &ethpb.SignedBeaconBlockAltair{}
 		}
 	}
@@ -0,0 +1,90 @@
+package p2p
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// meshTracker keeps track of the gossipsub mesh of the topics this node is subscribed to. Gossipsub does not
+// expose its mesh, so it is rebuilt from the graft and prune events of its raw tracer interface.
+type meshTracker struct {
+	lock   sync.RWMutex
+	meshes map[string]map[peer.ID]bool
+}
+
+var _ pubsub.RawTracer = (*meshTracker)(nil)
+
+func newMeshTracker() *meshTracker {
+	return &meshTracker{
+		meshes: make(map[string]map[peer.ID]bool),
+	}
+}
+
+// meshPeers returns the number of peers in the mesh of a topic, and whether this node has joined the topic.
+// Messages published to topics which were not joined are sent to fanout peers, picked among all the peers
+// subscribed to the topic, rather than to mesh peers.
+func (t *meshTracker) meshPeers(topic string) (int, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	mesh, ok := t.meshes[topic]
+	return len(mesh), ok
+}
+
+// Join is called when this node joins the mesh of a topic.
+func (t *meshTracker) Join(topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, ok := t.meshes[topic]; !ok {
+		t.meshes[topic] = make(map[peer.ID]bool)
+	}
+}
+
+// Leave is called when this node leaves the mesh of a topic.
+func (t *meshTracker) Leave(topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.meshes, topic)
+}
+
+// Graft is called when a peer is added to the mesh of a topic.
+func (t *meshTracker) Graft(p peer.ID, topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	mesh, ok := t.meshes[topic]
+	if !ok {
+		return
+	}
+	mesh[p] = true
+}
+
+// Prune is called when a peer is removed from the mesh of a topic.
+func (t *meshTracker) Prune(p peer.ID, topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.meshes[topic], p)
+}
+
+// RemovePeer is called when a peer is disconnected, which removes it from all meshes.
+func (t *meshTracker) RemovePeer(p peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, mesh := range t.meshes {
+		delete(mesh, p)
+	}
+}
+
+// The remaining tracer events are not used.
+
+func (*meshTracker) AddPeer(peer.ID, protocol.ID)          {}
+func (*meshTracker) ValidateMessage(*pubsub.Message)       {}
+func (*meshTracker) DeliverMessage(*pubsub.Message)        {}
+func (*meshTracker) RejectMessage(*pubsub.Message, string) {}
+func (*meshTracker) DuplicateMessage(*pubsub.Message)      {}
+func (*meshTracker) ThrottlePeer(peer.ID)                  {}
+func (*meshTracker) RecvRPC(*pubsub.RPC)                   {}
+func (*meshTracker) SendRPC(*pubsub.RPC, peer.ID)          {}
+func (*meshTracker) DropRPC(*pubsub.RPC, peer.ID)          {}
+func (*meshTracker) UndeliverableMessage(*pubsub.Message)  {}
@@ -0,0 +1,50 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestMeshTracker(t *testing.T) {
+	topic := "/eth2/01020304/beacon_attestation_3/ssz_snappy"
+	tracker := newMeshTracker()
+
+	// Peers are only tracked in the mesh of joined topics.
+	tracker.Graft("a", topic)
+	n, joined := tracker.meshPeers(topic)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, false, joined)
+
+	tracker.Join(topic)
+	tracker.Graft("a", topic)
+	tracker.Graft("b", topic)
+	tracker.Graft("c", topic)
+	n, joined = tracker.meshPeers(topic)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, true, joined)
+
+	tracker.Prune("a", topic)
+	tracker.RemovePeer(peer.ID("b"))
+	n, _ = tracker.meshPeers(topic)
+	assert.Equal(t, 1, n)
+
+	tracker.Leave(topic)
+	_, joined = tracker.meshPeers(topic)
+	assert.Equal(t, false, joined)
+}
+
+func TestService_HasMeshPeerWithSubnet(t *testing.T) {
+	s := &Service{mesh: newMeshTracker()}
+	topic := attestationToTopic(3, [4]byte{1, 2, 3, 4})
+	fullTopic := topic + encoder.SszNetworkEncoder{}.ProtocolSuffix()
+
+	s.mesh.Join(fullTopic)
+	assert.Equal(t, false, s.hasMeshPeerWithSubnet(topic))
+	s.mesh.Graft("a", fullTopic)
+	assert.Equal(t, true, s.hasMeshPeerWithSubnet(topic))
+	s.mesh.Prune("a", fullTopic)
+	assert.Equal(t, false, s.hasMeshPeerWithSubnet(topic))
+}
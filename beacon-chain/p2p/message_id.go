@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/math"
 	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 // MsgID is a content addressable ID function.
@@ -46,7 +47,7 @@ func MsgID(genesisValidatorsRoot []byte, pmsg *pubsubpb.Message) string {
 		copy(msg, "invalid")
 		return string(msg)
 	}
-	if fEpoch >= params.BeaconConfig().AltairForkEpoch {
+	if forks.IsActive(version.Altair, fEpoch) {
 		return postAltairMsgID(pmsg, fEpoch)
 	}
 	decodedData, err := encoder.DecodeSnappy(pmsg.Data, params.BeaconNetworkConfig().GossipMaxSize)
@@ -77,10 +78,7 @@ func postAltairMsgID(pmsg *pubsubpb.Message, fEpoch types.Epoch) string {
 	topicLenBytes := bytesutil.Uint64ToBytesLittleEndian(uint64(topicLen)) // topicLen cannot be negative
 
 	// beyond Bellatrix epoch, allow 10 Mib gossip data size
-	gossipPubSubSize := params.BeaconNetworkConfig().GossipMaxSize
-	if fEpoch >= params.BeaconConfig().BellatrixForkEpoch {
-		gossipPubSubSize = params.BeaconNetworkConfig().GossipMaxSizeBellatrix
-	}
+	gossipPubSubSize := encoder.MaxGossipSizeAtEpoch(fEpoch)
 
 	decodedData, err := encoder.DecodeSnappy(pmsg.Data, gossipPubSubSize)
 	if err != nil {
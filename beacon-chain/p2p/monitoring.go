@@ -33,12 +33,22 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	attestationPeerSearchDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "p2p_attestation_subnet_peer_search_seconds",
+		Help:    "The time the broadcast of attestations to subnets without mesh peers was delayed while searching for peers.",
+		Buckets: []float64{0.1, 0.25, 0.5, 1, 2, 4},
+	})
 	savedSyncCommitteeBroadcasts = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_sync_committee_subnet_recovered_broadcasts",
 		Help: "The number of sync committee messages that were attempted to be broadcast with no peers on " +
 			"the subnet. The beacon node increments this counter when the broadcast is blocked " +
 			"until a subnet peer can be found.",
 	})
+	rejectedConnections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_rejected_connections_total",
+		Help: "The number of connections rejected by the connection gater, by direction and reason.",
+	},
+		[]string{"direction", "reason"})
 	syncCommitteeBroadcastAttempts = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_sync_committee_subnet_attempted_broadcasts",
 		Help: "The number of sync committee that were attempted to be broadcast.",
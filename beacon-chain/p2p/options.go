@@ -7,7 +7,9 @@ import (
 
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	noise "github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -44,10 +46,22 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	options := []libp2p.Option{
 		privKeyOption(priKey),
 		libp2p.ListenAddrs(listen),
-		libp2p.UserAgent(version.BuildData()),
+		libp2p.UserAgent(userAgent(cfg)),
 		libp2p.ConnectionGater(s),
+		libp2p.BandwidthReporter(s.bandwidth),
 		libp2p.Transport(tcp.NewTCPTransport),
 	}
+	if cfg.QUICPort != 0 {
+		listenIP := ip.String()
+		if cfg.LocalIP != "" {
+			listenIP = cfg.LocalIP
+		}
+		quicListen, err := quicMultiAddressBuilder(listenIP, cfg.QUICPort)
+		if err != nil {
+			log.Fatalf("Failed to p2p listen: %v", err)
+		}
+		options = append(options, libp2p.ListenAddrs(quicListen), libp2p.Transport(libp2pquic.NewTransport))
+	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 
@@ -68,6 +82,14 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 			} else {
 				addrs = append(addrs, external)
 			}
+			if cfg.QUICPort != 0 {
+				external, err := quicMultiAddressBuilder(cfg.HostAddress, cfg.QUICPort)
+				if err != nil {
+					log.WithError(err).Error("Unable to create external QUIC multiaddress")
+				} else {
+					addrs = append(addrs, external)
+				}
+			}
 			return addrs
 		}))
 	}
@@ -87,6 +109,27 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 	return options
 }
 
+// userAgent returns the agent string advertised to peers through identify, which defaults to the
+// version of the node.
+func userAgent(cfg *Config) string {
+	if cfg.UserAgent != "" {
+		return cfg.UserAgent
+	}
+	return version.BuildData()
+}
+
+// configureIdentify removes the identify handlers disabled in the configuration from the host. Peers
+// only send identify pushes and deltas to the node when it advertises the corresponding protocol,
+// which is not the case of the delta protocol unless it is enabled.
+func (s *Service) configureIdentify() {
+	if !s.cfg.EnableIdentifyDelta {
+		s.host.RemoveStreamHandler(identify.IDDelta)
+	}
+	if s.cfg.DisableIdentifyPush {
+		s.host.RemoveStreamHandler(identify.IDPush)
+	}
+}
+
 func multiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
 	parsedIP := net.ParseIP(ipAddr)
 	if parsedIP.To4() == nil && parsedIP.To16() == nil {
@@ -10,9 +10,13 @@ import (
 	gethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ecdsaprysm "github.com/prysmaticlabs/prysm/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
@@ -74,3 +78,44 @@ func TestIPV6Support(t *testing.T) {
 		t.Error("Multiaddress did not have ipv6 protocol")
 	}
 }
+
+func TestUserAgent(t *testing.T) {
+	assert.Equal(t, version.BuildData(), userAgent(&Config{}))
+	assert.Equal(t, "custom/v1.0.0", userAgent(&Config{UserAgent: "custom/v1.0.0"}))
+}
+
+func TestConfigureIdentify(t *testing.T) {
+	hasProtocol := func(h host.Host, id string) bool {
+		for _, p := range h.Mux().Protocols() {
+			if p == id {
+				return true
+			}
+		}
+		return false
+	}
+	tests := []struct {
+		name      string
+		cfg       *Config
+		wantPush  bool
+		wantDelta bool
+	}{
+		{name: "default", cfg: &Config{}, wantPush: true},
+		{name: "push disabled", cfg: &Config{DisableIdentifyPush: true}},
+		{name: "delta enabled", cfg: &Config{EnableIdentifyDelta: true}, wantPush: true, wantDelta: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := libp2p.New(libp2p.NoListenAddrs)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, h.Close())
+			}()
+			require.Equal(t, true, hasProtocol(h, identify.IDDelta))
+			s := &Service{cfg: tt.cfg, host: h}
+			s.configureIdentify()
+			assert.Equal(t, true, hasProtocol(h, identify.ID))
+			assert.Equal(t, tt.wantPush, hasProtocol(h, identify.IDPush))
+			assert.Equal(t, tt.wantDelta, hasProtocol(h, identify.IDDelta))
+		})
+	}
+}
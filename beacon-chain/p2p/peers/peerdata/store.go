@@ -51,6 +51,13 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// Goodbye messages received from and sent to the peer, counted by goodbye code.
+	GoodbyesReceived map[uint64]uint64
+	GoodbyesSent     map[uint64]uint64
+	// Latencies of the req/resp requests sent to the peer, until the first byte of their response.
+	Requests            uint64
+	RequestLatencyTotal time.Duration
+	RequestLatencyMax   time.Duration
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
@@ -74,6 +74,10 @@ const (
 	MinBackOffDuration = 100
 	// MaxBackOffDuration maximum amount (in milliseconds) to wait before peer is re-dialed.
 	MaxBackOffDuration = 5000
+
+	// maxGoodbyeCodes bounds the number of distinct goodbye codes counted per peer, as the codes of the
+	// received goodbye messages are chosen by the remote peer.
+	maxGoodbyeCodes = 16
 )
 
 // Status is the structure holding the peer status information.
@@ -82,6 +86,8 @@ type Status struct {
 	scorers   *scorers.Service
 	store     *peerdata.Store
 	ipTracker map[string]uint64
+	trusted   map[peer.ID]bool
+	protected map[peer.ID]bool
 	rand      *rand.Rand
 }
 
@@ -91,6 +97,10 @@ type StatusConfig struct {
 	PeerLimit int
 	// ScorerParams holds peer scorer configuration params.
 	ScorerParams *scorers.Config
+	// TrustedPeers are never considered bad, regardless of their scores or ip address.
+	TrustedPeers []peer.ID
+	// ProtectedPeers are never pruned to make room for other peers.
+	ProtectedPeers []peer.ID
 }
 
 // NewStatus creates a new status entity.
@@ -98,11 +108,21 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
+	trusted := make(map[peer.ID]bool, len(config.TrustedPeers))
+	for _, pid := range config.TrustedPeers {
+		trusted[pid] = true
+	}
+	protected := make(map[peer.ID]bool, len(config.ProtectedPeers))
+	for _, pid := range config.ProtectedPeers {
+		protected[pid] = true
+	}
 	return &Status{
 		ctx:       ctx,
 		store:     store,
 		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
 		ipTracker: map[string]uint64{},
+		trusted:   trusted,
+		protected: protected,
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
@@ -333,8 +353,16 @@ func (p *Status) IsBad(pid peer.ID) bool {
 	return p.isBad(pid)
 }
 
+// IsTrusted returns true if the peer is trusted, and thus never considered bad.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	return p.trusted[pid]
+}
+
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	if p.trusted[pid] {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
@@ -361,6 +389,92 @@ func (p *Status) SetNextValidTime(pid peer.ID, nextTime time.Time) {
 	peerData.NextValidTime = nextTime
 }
 
+// AddGoodbyeReceived records a goodbye message received from the peer, and returns
+// the number of goodbye messages with the same code received from the peer so far.
+// Once maxGoodbyeCodes distinct codes are counted, messages with other codes are not
+// recorded and 0 is returned.
+func (p *Status) AddGoodbyeReceived(pid peer.ID, code uint64) uint64 {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if peerData.GoodbyesReceived == nil {
+		peerData.GoodbyesReceived = make(map[uint64]uint64)
+	}
+	return addGoodbye(peerData.GoodbyesReceived, code)
+}
+
+// AddGoodbyeSent records a goodbye message sent to the peer, and returns
+// the number of goodbye messages with the same code sent to the peer so far.
+func (p *Status) AddGoodbyeSent(pid peer.ID, code uint64) uint64 {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if peerData.GoodbyesSent == nil {
+		peerData.GoodbyesSent = make(map[uint64]uint64)
+	}
+	return addGoodbye(peerData.GoodbyesSent, code)
+}
+
+func addGoodbye(counts map[uint64]uint64, code uint64) uint64 {
+	if _, ok := counts[code]; !ok && len(counts) >= maxGoodbyeCodes {
+		return 0
+	}
+	counts[code]++
+	return counts[code]
+}
+
+// Goodbyes returns the number of goodbye messages received from and sent to the peer, by goodbye code.
+func (p *Status) Goodbyes(pid peer.ID) (received, sent map[uint64]uint64, err error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return nil, nil, peerdata.ErrPeerUnknown
+	}
+	received = make(map[uint64]uint64, len(peerData.GoodbyesReceived))
+	for code, count := range peerData.GoodbyesReceived {
+		received[code] = count
+	}
+	sent = make(map[uint64]uint64, len(peerData.GoodbyesSent))
+	for code, count := range peerData.GoodbyesSent {
+		sent[code] = count
+	}
+	return received, sent, nil
+}
+
+// AddRequestLatency records the time the peer took to start responding to a req/resp request.
+func (p *Status) AddRequestLatency(pid peer.ID, latency time.Duration) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.Requests++
+	peerData.RequestLatencyTotal += latency
+	if latency > peerData.RequestLatencyMax {
+		peerData.RequestLatencyMax = latency
+	}
+}
+
+// RequestLatency returns the number of req/resp requests that the peer responded to, along with
+// the mean and maximum times it took to start responding.
+func (p *Status) RequestLatency(pid peer.ID) (requests uint64, mean, max time.Duration, err error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return 0, 0, 0, peerdata.ErrPeerUnknown
+	}
+	if peerData.Requests == 0 {
+		return 0, 0, 0, nil
+	}
+	mean = peerData.RequestLatencyTotal / time.Duration(peerData.Requests)
+	return peerData.Requests, mean, peerData.RequestLatencyMax, nil
+}
+
 // RandomizeBackOff adds extra backoff period during which peer will not be dialed.
 func (p *Status) RandomizeBackOff(pid peer.ID) {
 	p.store.Lock()
@@ -769,7 +883,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.protected[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.scorers.ScoreNoLock(pid),
@@ -835,7 +949,7 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.protected[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
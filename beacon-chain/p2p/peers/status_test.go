@@ -379,6 +379,27 @@ func TestPeerBadResponses(t *testing.T) {
 	assert.Equal(t, true, p.IsBad(id), "Peer not marked as bad when it should be")
 }
 
+func TestPeerTrusted_NeverBad(t *testing.T) {
+	id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+		TrustedPeers: []peer.ID{id},
+	})
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err, "Failed to create address")
+	p.Add(new(enr.Record), id, address, network.DirOutbound)
+	p.Scorers().BadResponsesScorer().Increment(id)
+	p.Scorers().BadResponsesScorer().Increment(id)
+	assert.Equal(t, false, p.IsBad(id), "Trusted peer marked as bad")
+}
+
 func TestAddMetaData(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -496,6 +517,74 @@ func TestPeerValidTime(t *testing.T) {
 	assert.Equal(t, numPeersConnected, len(p.Connected()), "Unexpected number of connected peers")
 }
 
+func TestPeerGoodbyes(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	id := addPeer(t, p, peers.PeerConnected)
+
+	received, sent, err := p.Goodbyes(id)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(received))
+	assert.Equal(t, 0, len(sent))
+
+	assert.Equal(t, uint64(1), p.AddGoodbyeReceived(id, 129))
+	assert.Equal(t, uint64(2), p.AddGoodbyeReceived(id, 129))
+	assert.Equal(t, uint64(1), p.AddGoodbyeReceived(id, 1))
+	assert.Equal(t, uint64(1), p.AddGoodbyeSent(id, 250))
+
+	received, sent, err = p.Goodbyes(id)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64]uint64{129: 2, 1: 1}, received)
+	assert.DeepEqual(t, map[uint64]uint64{250: 1}, sent)
+
+	// Returned counts are copies.
+	received[129] = 10
+	received, _, err = p.Goodbyes(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), received[129])
+
+	_, _, err = p.Goodbyes("unknown")
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+
+	// The number of codes counted per peer is bounded.
+	for code := uint64(1000); code < 1100; code++ {
+		p.AddGoodbyeReceived(id, code)
+	}
+	assert.Equal(t, uint64(3), p.AddGoodbyeReceived(id, 129))
+	assert.Equal(t, uint64(0), p.AddGoodbyeReceived(id, 2000))
+	received, _, err = p.Goodbyes(id)
+	require.NoError(t, err)
+	assert.Equal(t, 16, len(received))
+}
+
+func TestPeerRequestLatency(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	id := addPeer(t, p, peers.PeerConnected)
+
+	requests, mean, max, err := p.RequestLatency(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), requests)
+	assert.Equal(t, time.Duration(0), mean)
+	assert.Equal(t, time.Duration(0), max)
+
+	p.AddRequestLatency(id, 100*time.Millisecond)
+	p.AddRequestLatency(id, 300*time.Millisecond)
+	p.AddRequestLatency(id, 200*time.Millisecond)
+	requests, mean, max, err = p.RequestLatency(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), requests)
+	assert.Equal(t, 200*time.Millisecond, mean)
+	assert.Equal(t, 300*time.Millisecond, max)
+
+	_, _, _, err = p.RequestLatency("unknown")
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+}
+
 func TestPrune(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -755,6 +844,38 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_ProtectedPeers(t *testing.T) {
+	for _, enablePeerScorer := range []bool{false, true} {
+		resetCfg := features.InitWithReset(&features.Flags{
+			EnablePeerScorer: enablePeerScorer,
+		})
+		id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+		require.NoError(t, err)
+		p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 5,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 1,
+				},
+			},
+			ProtectedPeers: []peer.ID{id},
+		})
+		p.Add(new(enr.Record), id, nil, network.DirInbound)
+		p.SetConnectionState(id, peers.PeerConnected)
+		for i := 0; i < 8; i++ {
+			createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		}
+
+		// The protected peer is never selected, even though more peers than needed are pruned.
+		peersToPrune := p.PeersToPrune()
+		assert.Equal(t, 5, len(peersToPrune))
+		for _, pid := range peersToPrune {
+			assert.NotEqual(t, id, pid, "Protected peer selected for pruning")
+		}
+		resetCfg()
+	}
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
@@ -8,8 +8,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
-	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 var _ pubsub.SubscriptionFilter = (*Service)(nil)
@@ -42,12 +42,12 @@ func (s *Service) CanSubscribe(topic string) bool {
 		log.WithError(err).Error("Could not determine fork digest")
 		return false
 	}
-	altairForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().AltairForkEpoch, s.genesisValidatorsRoot)
+	altairForkDigest, err := forks.DigestOfVersion(version.Altair, s.genesisValidatorsRoot)
 	if err != nil {
 		log.WithError(err).Error("Could not determine altair fork digest")
 		return false
 	}
-	bellatrixForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().BellatrixForkEpoch, s.genesisValidatorsRoot)
+	bellatrixForkDigest, err := forks.DigestOfVersion(version.Bellatrix, s.genesisValidatorsRoot)
 	if err != nil {
 		log.WithError(err).Error("Could not determine Bellatrix fork digest")
 		return false
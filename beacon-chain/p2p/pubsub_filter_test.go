@@ -340,6 +340,7 @@ func TestService_MonitorsStateForkUpdates(t *testing.T) {
 	notifier := &mock.MockStateNotifier{}
 	s, err := NewService(ctx, &Config{
 		StateNotifier: notifier,
+		DataDir:       t.TempDir(),
 	})
 	require.NoError(t, err)
 
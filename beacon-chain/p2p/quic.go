@@ -0,0 +1,59 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+)
+
+// quicProtocol is the ENR entry advertising the UDP port of the QUIC transport of a node.
+type quicProtocol uint16
+
+// ENRKey of the QUIC port entry.
+func (quicProtocol) ENRKey() string { return "quic" }
+
+func quicMultiAddressBuilder(ipAddr string, port uint) (ma.Multiaddr, error) {
+	parsedIP := net.ParseIP(ipAddr)
+	if parsedIP.To4() == nil && parsedIP.To16() == nil {
+		return nil, errors.Errorf("invalid ip address provided: %s", ipAddr)
+	}
+	if parsedIP.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/quic", ipAddr, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/quic", ipAddr, port))
+}
+
+// convertToQUICMultiAddr returns the QUIC multiaddress of a node, or nil if the node does not
+// advertise a QUIC port in its ENR.
+func convertToQUICMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	var port quicProtocol
+	if err := node.Record().Load(&port); err != nil {
+		return nil, nil
+	}
+	return quicMultiAddressBuilder(node.IP().String(), uint(port))
+}
+
+// dialAddrInfo returns the addresses to dial a discovered node. The QUIC address of the node is
+// included when both the node and the local host support the QUIC transport, in which case it is
+// preferred over TCP by the dialer.
+func (s *Service) dialAddrInfo(node *enode.Node) (*peer.AddrInfo, error) {
+	info, _, err := convertToAddrInfo(node)
+	if err != nil {
+		return nil, err
+	}
+	if s.cfg.QUICPort == 0 {
+		return info, nil
+	}
+	quicAddr, err := convertToQUICMultiAddr(node)
+	if err != nil {
+		return nil, err
+	}
+	if quicAddr != nil {
+		info.Addrs = append([]ma.Multiaddr{quicAddr}, info.Addrs...)
+	}
+	return info, nil
+}
@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestQUIC_ENREntryAndDialAddrs(t *testing.T) {
+	pkey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ipAddr := net.ParseIP("192.168.0.1")
+
+	// Nodes without QUIC only advertise their TCP address.
+	s := &Service{
+		cfg:                   &Config{TCPPort: 3000, UDPPort: 4000},
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	localNode, err := s.createLocalNode(pkey, ipAddr, 4000, 3000)
+	require.NoError(t, err)
+	var port quicProtocol
+	require.NotNil(t, localNode.Node().Record().Load(&port))
+	quicAddr, err := convertToQUICMultiAddr(localNode.Node())
+	require.NoError(t, err)
+	assert.Equal(t, nil, quicAddr)
+
+	// Nodes with QUIC advertise their QUIC port in their ENR.
+	s.cfg.QUICPort = 5000
+	localNode, err = s.createLocalNode(pkey, ipAddr, 4000, 3000)
+	require.NoError(t, err)
+	require.NoError(t, localNode.Node().Record().Load(&port))
+	assert.Equal(t, quicProtocol(5000), port)
+
+	// The QUIC address is dialed first when both nodes support QUIC.
+	info, err := s.dialAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(info.Addrs))
+	assert.Equal(t, "/ip4/192.168.0.1/udp/5000/quic", info.Addrs[0].String())
+	assert.Equal(t, "/ip4/192.168.0.1/tcp/3000", info.Addrs[1].String())
+
+	// Only the TCP address is dialed when QUIC is disabled locally.
+	s.cfg.QUICPort = 0
+	info, err = s.dialAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(info.Addrs))
+	assert.Equal(t, "/ip4/192.168.0.1/tcp/3000", info.Addrs[0].String())
+}
@@ -5,9 +5,10 @@ import (
 
 	"github.com/pkg/errors"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
-	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/network/forks"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
 // SchemaVersionV1 specifies the schema version for our rpc protocol ID.
@@ -31,6 +32,15 @@ const BeaconBlocksByRangeMessageName = "/beacon_blocks_by_range"
 // BeaconBlocksByRootsMessageName specifies the name for the beacon blocks by root message topic.
 const BeaconBlocksByRootsMessageName = "/beacon_blocks_by_root"
 
+// BlobSidecarsByRangeMessageName specifies the name for the blob sidecars by range message topic.
+const BlobSidecarsByRangeMessageName = "/blob_sidecars_by_range"
+
+// BlobSidecarsByRootMessageName specifies the name for the blob sidecars by root message topic.
+const BlobSidecarsByRootMessageName = "/blob_sidecars_by_root"
+
+// BeaconStateByCheckpointMessageName specifies the name for the beacon state by checkpoint message topic.
+const BeaconStateByCheckpointMessageName = "/beacon_state_by_checkpoint"
+
 // PingMessageName Specifies the name for the ping message topic.
 const PingMessageName = "/ping"
 
@@ -51,6 +61,12 @@ const (
 	RPCPingTopicV1 = protocolPrefix + PingMessageName + SchemaVersionV1
 	// RPCMetaDataTopicV1 defines the v1 topic for the metadata rpc method.
 	RPCMetaDataTopicV1 = protocolPrefix + MetadataMessageName + SchemaVersionV1
+	// RPCBlobSidecarsByRangeTopicV1 defines the v1 topic for the blob sidecars by range rpc method.
+	RPCBlobSidecarsByRangeTopicV1 = protocolPrefix + BlobSidecarsByRangeMessageName + SchemaVersionV1
+	// RPCBlobSidecarsByRootTopicV1 defines the v1 topic for the blob sidecars by root rpc method.
+	RPCBlobSidecarsByRootTopicV1 = protocolPrefix + BlobSidecarsByRootMessageName + SchemaVersionV1
+	// RPCBeaconStateByCheckpointTopicV1 defines the v1 topic for the beacon state by checkpoint rpc method.
+	RPCBeaconStateByCheckpointTopicV1 = protocolPrefix + BeaconStateByCheckpointMessageName + SchemaVersionV1
 
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
@@ -83,6 +99,12 @@ var RPCTopicMappings = map[string]interface{}{
 	// RPC Metadata Message
 	RPCMetaDataTopicV1: new(interface{}),
 	RPCMetaDataTopicV2: new(interface{}),
+	// RPC Blob Sidecars By Range Message
+	RPCBlobSidecarsByRangeTopicV1: new(pb.BlobSidecarsByRangeRequest),
+	// RPC Blob Sidecars By Root Message
+	RPCBlobSidecarsByRootTopicV1: new(p2ptypes.BlobSidecarsByRootReq),
+	// RPC Beacon State By Checkpoint Message
+	RPCBeaconStateByCheckpointTopicV1: new(pb.Checkpoint),
 }
 
 // Maps all registered protocol prefixes.
@@ -93,12 +115,15 @@ var protocolMapping = map[string]bool{
 // Maps all the protocol message names for the different rpc
 // topics.
 var messageMapping = map[string]bool{
-	StatusMessageName:              true,
-	GoodbyeMessageName:             true,
-	BeaconBlocksByRangeMessageName: true,
-	BeaconBlocksByRootsMessageName: true,
-	PingMessageName:                true,
-	MetadataMessageName:            true,
+	StatusMessageName:                  true,
+	GoodbyeMessageName:                 true,
+	BeaconBlocksByRangeMessageName:     true,
+	BeaconBlocksByRootsMessageName:     true,
+	PingMessageName:                    true,
+	MetadataMessageName:                true,
+	BlobSidecarsByRangeMessageName:     true,
+	BlobSidecarsByRootMessageName:      true,
+	BeaconStateByCheckpointMessageName: true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
@@ -228,10 +253,10 @@ func TopicFromMessage(msg string, epoch types.Epoch) (string, error) {
 	if !messageMapping[msg] {
 		return "", errors.Errorf("%s: %s", invalidRPCMessageType, msg)
 	}
-	version := SchemaVersionV1
-	isAltair := epoch >= params.BeaconConfig().AltairForkEpoch
+	schemaVersion := SchemaVersionV1
+	isAltair := forks.IsActive(version.Altair, epoch)
 	if isAltair && altairMapping[msg] {
-		version = SchemaVersionV2
+		schemaVersion = SchemaVersionV2
 	}
-	return protocolPrefix + msg + version, nil
+	return protocolPrefix + msg + schemaVersion, nil
 }
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/kr/pretty"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -63,5 +65,27 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 		return nil, err
 	}
 
-	return stream, nil
+	return &timedStream{Stream: stream, sent: time.Now(), record: func(latency time.Duration) {
+		s.peers.AddRequestLatency(pid, latency)
+	}}, nil
+}
+
+// timedStream records the time elapsed between the request being sent and the first read of its
+// response, which includes the time the peer took to process the request.
+type timedStream struct {
+	network.Stream
+	sent   time.Time
+	once   sync.Once
+	record func(latency time.Duration)
+}
+
+// Read from the underlying stream, recording the response latency on the first read.
+func (s *timedStream) Read(b []byte) (int, error) {
+	n, err := s.Stream.Read(b)
+	if n > 0 {
+		s.once.Do(func() {
+			s.record(time.Since(s.sent))
+		})
+	}
+	return n, err
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -25,6 +27,10 @@ func TestService_Send(t *testing.T) {
 	svc := &Service{
 		host: p1.BHost,
 		cfg:  &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
 	}
 
 	msg := &ethpb.Fork{
@@ -60,4 +66,7 @@ func TestService_Send(t *testing.T) {
 	if !proto.Equal(rcvd, msg) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
+	requests, _, _, err := svc.peers.RequestLatency(p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), requests, "Expected the response latency to be recorded")
 }
@@ -0,0 +1,49 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+)
+
+// Sentry mode hides a beacon node behind a fixed set of trusted sentry beacon nodes. The node
+// does not run discovery and only accepts connections from, or dials, its sentries, which
+// relay all gossip and serve all sync requests on its behalf. Sentries should be run with
+// --subscribe-all-subnets, as the node can only receive the subnets its sentries are subscribed to.
+//
+// Gossip scoring implications: sentries are registered as gossipsub direct peers. Direct peers
+// are outside of the mesh, so the mesh delivery penalties of our topic scoring parameters never
+// apply to them, and messages are always exchanged with them regardless of their score. Their
+// score still accumulates invalid message penalties, but direct peers are exempt from the
+// graylist threshold, so a sentry relaying invalid messages, which it has to do for messages it
+// has not validated yet, can never be graylisted or pruned by the local node. For the same
+// reason sentries are trusted peers of the peer status store, and are never marked as bad by the
+// peer scorers.
+
+// parseSentryPeers parses the multiaddresses of the sentries, which must include their peer ids.
+func parseSentryPeers(addrs []string) ([]peer.AddrInfo, error) {
+	infos := make([]peer.AddrInfo, 0, len(addrs))
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+		info, err := MakePeer(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse sentry peer %s", addr)
+		}
+		infos = append(infos, *info)
+	}
+	return infos, nil
+}
+
+// sentryMode returns true if the node only connects to its configured sentries.
+func (s *Service) sentryMode() bool {
+	return len(s.sentries) > 0
+}
+
+// allowedPeer returns false if the node runs in sentry mode and the given peer is not one of its sentries.
+func (s *Service) allowedPeer(pid peer.ID) bool {
+	if !s.sentryMode() {
+		return true
+	}
+	return s.sentries[pid]
+}
@@ -14,12 +14,12 @@ import (
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
-	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/async"
@@ -30,8 +30,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/network/forks"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -68,6 +70,7 @@ type Service struct {
 	peers                 *peers.Status
 	addrFilter            *multiaddr.Filters
 	ipLimiter             *leakybucket.Collector
+	colocation            *colocationLimits
 	privKey               *ecdsa.PrivateKey
 	metaData              metadata.Metadata
 	pubsub                *pubsub.PubSub
@@ -76,6 +79,7 @@ type Service struct {
 	subnetsLock           map[uint64]*sync.RWMutex
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
 	initializationLock    sync.Mutex
+	enrLock               sync.Mutex // Serializes updates of the subnets in the ENR and metadata
 	dv5Listener           Listener
 	startupErr            error
 	stateNotifier         statefeed.Notifier
@@ -84,6 +88,10 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	sentries              map[peer.ID]bool
+	echoes                *echoTracker
+	mesh                  *meshTracker
+	bandwidth             *metrics.BandwidthCounter
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -101,6 +109,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		bandwidth:     metrics.NewBandwidthCounter(),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -123,7 +132,33 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		log.WithError(err).Error("Failed to create address filter")
 		return nil, err
 	}
+	s.colocation, err = configureColocationLimits(s.cfg)
+	if err != nil {
+		log.WithError(err).Error("Failed to configure colocation limits")
+		return nil, err
+	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
+	sentryInfos, err := parseSentryPeers(s.cfg.SentryPeers)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse sentry peers")
+		return nil, err
+	}
+	s.sentries = make(map[peer.ID]bool, len(sentryInfos))
+	sentryIDs := make([]peer.ID, 0, len(sentryInfos))
+	for _, info := range sentryInfos {
+		s.sentries[info.ID] = true
+		sentryIDs = append(sentryIDs, info.ID)
+	}
+	staticIDs, err := staticPeerIDs(s.cfg.StaticPeers)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse static peers")
+		return nil, err
+	}
+	trustedIDs, err := trustedPeerIDs(s.cfg.TrustedPeers)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse trusted peers")
+		return nil, err
+	}
 
 	opts := s.buildOptions(ipAddr, s.privKey)
 	h, err := libp2p.New(opts...)
@@ -133,7 +168,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	}
 
 	s.host = h
-	s.host.RemoveStreamHandler(identify.IDDelta)
+	s.echoes = newEchoTracker(h.ID(), s.cfg.OperationNotifier)
+	s.mesh = newMeshTracker()
+	s.configureIdentify()
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -150,6 +187,12 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithPeerScore(peerScoringParams()),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
+		pubsub.WithRawTracer(s.echoes),
+		pubsub.WithRawTracer(s.mesh),
+	}
+	if s.sentryMode() {
+		// Sentries are direct peers, see sentry.go for the gossip scoring implications.
+		psOpts = append(psOpts, pubsub.WithDirectPeers(sentryInfos))
 	}
 	// Set the pubsub global parameters that we require.
 	setPubSubParameters()
@@ -172,6 +215,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				DecayInterval: time.Hour,
 			},
 		},
+		TrustedPeers:   append(sentryIDs, trustedIDs...),
+		ProtectedPeers: staticIDs,
 	})
 
 	// Initialize Data maps.
@@ -200,7 +245,12 @@ func (s *Service) Start() {
 		}
 	}
 
-	if !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5 {
+	if s.sentryMode() {
+		log.WithField("sentries", len(s.sentries)).Info("Running in sentry mode, peer discovery is disabled")
+		peersToWatch = append(peersToWatch, s.cfg.SentryPeers...)
+	}
+
+	if !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5 && !s.sentryMode() {
 		ipAddr := ipAddr()
 		listener, err := s.startDiscoveryV5(
 			ipAddr,
@@ -229,6 +279,17 @@ func (s *Service) Start() {
 			log.Errorf("Could not connect to static peer: %v", err)
 		}
 		s.connectWithAllPeers(addrs)
+		// Static peers are reconnected whenever their connection drops.
+		for _, addr := range addrs {
+			peersToWatch = append(peersToWatch, addr.String())
+		}
+	}
+	if s.sentryMode() {
+		addrs, err := peersFromStringAddrs(s.cfg.SentryPeers)
+		if err != nil {
+			log.Errorf("Could not connect to sentry peer: %v", err)
+		}
+		s.connectWithAllPeers(addrs)
 	}
 	// Initialize metadata according to the
 	// current epoch.
@@ -243,6 +304,7 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, time.Minute, s.echoes.prune)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -322,6 +384,11 @@ func (s *Service) Host() host.Host {
 	return s.host
 }
 
+// BandwidthForPeer returns the bandwidth used by the traffic exchanged with the given peer.
+func (s *Service) BandwidthForPeer(pid peer.ID) metrics.Stats {
+	return s.bandwidth.GetBandwidthForPeer(pid)
+}
+
 // SetStreamHandler sets the protocol handler on the p2p host multiplexer.
 // This method is a pass through to libp2pcore.Host.SetStreamHandler.
 func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler) {
@@ -501,7 +568,7 @@ func (s *Service) isInitialized() bool {
 func (s *Service) increaseMaxMessageSizesForBellatrix() {
 	currentSlot := slots.Since(s.genesisTime)
 	currentEpoch := slots.ToEpoch(currentSlot)
-	if currentEpoch >= params.BeaconConfig().BellatrixForkEpoch {
+	if forks.IsActive(version.Bellatrix, currentEpoch) {
 		encoder.SetMaxGossipSizeForBellatrix()
 		encoder.SetMaxChunkSizeForBellatrix()
 	}
@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+)
+
+// Static peers are the peers the node stays connected to: they are dialed on start, reconnected
+// whenever their connection drops, and never pruned to make room for other peers. Trusted peers
+// are never considered bad by the peer status store, so they are not disconnected because of
+// their peer scores or the number of peers sharing their ip address.
+
+// staticPeerIDs returns the peer ids of the static peers, given as multiaddresses or ENRs.
+func staticPeerIDs(addrs []string) ([]peer.ID, error) {
+	multiAddrs, err := peersFromStringAddrs(addrs)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]peer.ID, 0, len(multiAddrs))
+	for _, addr := range multiAddrs {
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get peer id of static peer %s", addr)
+		}
+		ids = append(ids, info.ID)
+	}
+	return ids, nil
+}
+
+// trustedPeerIDs parses the trusted peers, given as peer ids or multiaddresses including their peer id.
+func trustedPeerIDs(peers []string) ([]peer.ID, error) {
+	ids := make([]peer.ID, 0, len(peers))
+	for _, p := range peers {
+		if p == "" {
+			continue
+		}
+		if id, err := peer.Decode(p); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+		info, err := MakePeer(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse trusted peer %s", p)
+		}
+		ids = append(ids, info.ID)
+	}
+	return ids, nil
+}
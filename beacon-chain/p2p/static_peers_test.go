@@ -0,0 +1,35 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStaticPeerIDs(t *testing.T) {
+	ids, err := staticPeerIDs([]string{"/ip4/127.0.0.1/tcp/5678/p2p/QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ids))
+	assert.Equal(t, "QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX", ids[0].Pretty())
+
+	_, err = staticPeerIDs([]string{"/ip4/127.0.0.1/tcp/5678"})
+	assert.ErrorContains(t, "could not get peer id of static peer", err)
+}
+
+func TestTrustedPeerIDs(t *testing.T) {
+	id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	ids, err := trustedPeerIDs([]string{
+		id.String(),
+		"/ip4/127.0.0.1/tcp/5678/p2p/QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ids))
+	assert.Equal(t, id, ids[0])
+	assert.Equal(t, "QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX", ids[1].Pretty())
+
+	_, err = trustedPeerIDs([]string{"not-a-peer"})
+	assert.ErrorContains(t, "could not parse trusted peer not-a-peer", err)
+}
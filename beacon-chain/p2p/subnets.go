@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"math/big"
 	"strings"
 	"sync"
 
@@ -9,9 +10,16 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	mathutil "github.com/prysmaticlabs/prysm/math"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"go.opencensus.io/trace"
 
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -70,7 +78,7 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 		}
 		nodes := enode.ReadNodes(iterator, int(params.BeaconNetworkConfig().MinimumPeersInSubnetSearch))
 		for _, node := range nodes {
-			info, _, err := convertToAddrInfo(node)
+			info, err := s.dialAddrInfo(node)
 			if err != nil {
 				continue
 			}
@@ -141,6 +149,18 @@ func (s *Service) hasPeerWithSubnet(topic string) bool {
 	return len(s.pubsub.ListPeers(topic+s.Encoding().ProtocolSuffix())) >= int(minPeers) // lint:ignore uintcast -- Min peers can be safely cast to int.
 }
 
+// hasMeshPeerWithSubnet checks whether a message published on a subnet topic would reach a peer. Messages are sent
+// to the mesh peers of the topics this node joined, and to fanout peers picked among the peers subscribed to the
+// topic otherwise.
+func (s *Service) hasMeshPeerWithSubnet(topic string) bool {
+	if s.mesh != nil {
+		if n, joined := s.mesh.meshPeers(topic + s.Encoding().ProtocolSuffix()); joined {
+			return n > 0
+		}
+	}
+	return s.hasPeerWithSubnet(topic)
+}
+
 // Updates the service's discv5 listener record's attestation subnet
 // with a new value for a bitfield of subnets tracked. It also updates
 // the node's metadata by increasing the sequence number and the
@@ -279,3 +299,83 @@ func byteCount(bitCount int) int {
 	}
 	return numOfBytes
 }
+
+// computeSubscribedSubnets returns the long-lived attestation subnets the node with the given id
+// subscribes to at the epoch, forming the attestation subnet backbone.
+// Spec pseudocode definition:
+//   def compute_subscribed_subnets(node_id: NodeID, epoch: Epoch) -> Sequence[SubnetID]:
+//    return [compute_subscribed_subnet(node_id, epoch, index) for index in range(SUBNETS_PER_NODE)]
+func computeSubscribedSubnets(nodeID enode.ID, epoch types.Epoch) ([]uint64, error) {
+	subnetsPerNode := params.BeaconNetworkConfig().SubnetsPerNode
+	subnets := make([]uint64, 0, subnetsPerNode)
+	for i := uint64(0); i < subnetsPerNode; i++ {
+		subnet, err := computeSubscribedSubnet(nodeID, epoch, i)
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+// Spec pseudocode definition:
+//   def compute_subscribed_subnet(node_id: NodeID, epoch: Epoch, index: int) -> SubnetID:
+//    node_id_prefix = node_id >> (NODE_ID_BITS - ATTESTATION_SUBNET_PREFIX_BITS)
+//    node_offset = node_id % EPOCHS_PER_SUBNET_SUBSCRIPTION
+//    permutation_seed = hash(uint_to_bytes(uint64((epoch + node_offset) // EPOCHS_PER_SUBNET_SUBSCRIPTION)))
+//    permutated_prefix = compute_shuffled_index(
+//        node_id_prefix,
+//        1 << ATTESTATION_SUBNET_PREFIX_BITS,
+//        permutation_seed,
+//    )
+//    return SubnetID((permutated_prefix + index) % ATTESTATION_SUBNET_COUNT)
+func computeSubscribedSubnet(nodeID enode.ID, epoch types.Epoch, index uint64) (uint64, error) {
+	cfg := params.BeaconNetworkConfig()
+	nodeIDPrefix := new(big.Int).Rsh(new(big.Int).SetBytes(nodeID[:]), uint(cfg.NodeIdBits-cfg.AttestationSubnetPrefixBits))
+	permutationSeed := hash.Hash(bytesutil.Bytes8((uint64(epoch) + nodeOffset(nodeID)) / cfg.EpochsPerSubnetSubscription))
+	permutatedPrefix, err := helpers.ShuffledIndex(
+		types.ValidatorIndex(nodeIDPrefix.Uint64()),
+		1<<cfg.AttestationSubnetPrefixBits,
+		permutationSeed,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return (uint64(permutatedPrefix) + index) % cfg.AttestationSubnetCount, nil
+}
+
+// nodeOffset staggers the rotation of the long-lived subnets of the nodes across epochs.
+func nodeOffset(nodeID enode.ID) uint64 {
+	period := new(big.Int).SetUint64(params.BeaconNetworkConfig().EpochsPerSubnetSubscription)
+	return new(big.Int).Mod(new(big.Int).SetBytes(nodeID[:]), period).Uint64()
+}
+
+// subnetsRotationEpoch returns the first epoch after the given one at which the long-lived subnets
+// of the node change.
+func subnetsRotationEpoch(nodeID enode.ID, epoch types.Epoch) types.Epoch {
+	period := params.BeaconNetworkConfig().EpochsPerSubnetSubscription
+	offset := nodeOffset(nodeID)
+	return types.Epoch(((uint64(epoch)+offset)/period+1)*period - offset)
+}
+
+// updateBackboneSubnets stores the long-lived subnets of the node at the epoch in the subnet
+// cache until their rotation, keyed by the node id. Like the persistent subnets of the attached
+// validators, they are then subscribed to by the sync service and advertised in the ENR and
+// metadata of the node.
+func (s *Service) updateBackboneSubnets(nodeID enode.ID, epoch types.Epoch) error {
+	_, ok, expTime := cache.SubnetIDs.GetPersistentSubnets(nodeID[:])
+	if ok && expTime.After(prysmTime.Now()) {
+		return nil
+	}
+	subnets, err := computeSubscribedSubnets(nodeID, epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not compute subscribed subnets")
+	}
+	rotationSlot, err := slots.EpochStart(subnetsRotationEpoch(nodeID, epoch))
+	if err != nil {
+		return err
+	}
+	rotationTime := slots.StartTime(uint64(s.genesisTime.Unix()), rotationSlot)
+	cache.SubnetIDs.AddPersistentCommittee(nodeID[:], subnets, rotationTime.Sub(prysmTime.Now()))
+	return nil
+}
@@ -18,11 +18,14 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/container/slice"
 	ecdsaprysm "github.com/prysmaticlabs/prysm/crypto/ecdsa"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/time/slots"
 )
 
 func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
@@ -495,3 +498,58 @@ func Test_SyncSubnets(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeSubscribedSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	nodeID := enode.ID{0b10101100}
+	nodeID[31] = 10
+
+	subnets, err := computeSubscribedSubnets(nodeID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int(params.BeaconNetworkConfig().SubnetsPerNode), len(subnets))
+	for _, subnet := range subnets {
+		assert.Equal(t, true, subnet < params.BeaconNetworkConfig().AttestationSubnetCount)
+	}
+	// The subnets of a node are consecutive.
+	assert.Equal(t, (subnets[0]+1)%params.BeaconNetworkConfig().AttestationSubnetCount, subnets[1])
+	// The subnets stay the same until their rotation.
+	sameSubnets, err := computeSubscribedSubnets(nodeID, subnetsRotationEpoch(nodeID, 0)-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, subnets, sameSubnets)
+
+	// Without shuffling, the subnets are given by the prefix of the node id.
+	cfg := params.BeaconConfig().Copy()
+	cfg.ShuffleRoundCount = 0
+	params.OverrideBeaconConfig(cfg)
+	subnets, err = computeSubscribedSubnets(nodeID, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{43, 44}, subnets)
+}
+
+func TestSubnetsRotationEpoch(t *testing.T) {
+	nodeID := enode.ID{}
+	nodeID[31] = 10
+	assert.Equal(t, types.Epoch(246), subnetsRotationEpoch(nodeID, 0))
+	assert.Equal(t, types.Epoch(246), subnetsRotationEpoch(nodeID, 245))
+	assert.Equal(t, types.Epoch(502), subnetsRotationEpoch(nodeID, 246))
+	assert.Equal(t, types.Epoch(256), subnetsRotationEpoch(enode.ID{}, 0))
+}
+
+func TestService_UpdateBackboneSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cache.SubnetIDs.EmptyAllCaches()
+	defer cache.SubnetIDs.EmptyAllCaches()
+	nodeID := enode.ID{0b10101100}
+	s := &Service{genesisTime: time.Now()}
+	require.NoError(t, s.updateBackboneSubnets(nodeID, 0))
+
+	subnets, err := computeSubscribedSubnets(nodeID, 0)
+	require.NoError(t, err)
+	cached, ok, expTime := cache.SubnetIDs.GetPersistentSubnets(nodeID[:])
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, subnets, cached)
+	rotationSlot, err := slots.EpochStart(subnetsRotationEpoch(nodeID, 0))
+	require.NoError(t, err)
+	assert.Equal(t, true, expTime.After(slots.StartTime(uint64(s.genesisTime.Unix()), rotationSlot).Add(-time.Second)))
+	assert.DeepEqual(t, slice.SetUint64(subnets), cache.SubnetIDs.GetAllSubnets())
+}
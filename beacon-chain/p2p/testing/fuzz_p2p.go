@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/control"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -123,6 +124,11 @@ func (_ *FakeP2P) Host() host.Host {
 	return nil
 }
 
+// BandwidthForPeer -- fake.
+func (_ *FakeP2P) BandwidthForPeer(_ peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
 // Disconnect -- fake.
 func (_ *FakeP2P) Disconnect(_ peer.ID) error {
 	return nil
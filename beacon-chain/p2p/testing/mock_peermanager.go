@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
 )
@@ -34,6 +35,11 @@ func (m *MockPeerManager) Host() host.Host {
 	return m.BHost
 }
 
+// BandwidthForPeer .
+func (_ *MockPeerManager) BandwidthForPeer(_ peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
 // ENR .
 func (m MockPeerManager) ENR() *enr.Record {
 	return m.Enr
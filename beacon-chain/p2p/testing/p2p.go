@@ -13,6 +13,7 @@ import (
 	core "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/control"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
@@ -77,6 +78,19 @@ func NewTestP2P(t *testing.T) *TestP2P {
 	}
 }
 
+// SetTrustedPeers resets the peer statuses of the test peer, the given peers being trusted ones.
+func (p *TestP2P) SetTrustedPeers(pids ...peer.ID) {
+	p.peers = peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 5,
+			},
+		},
+		TrustedPeers: pids,
+	})
+}
+
 // Connect two test peers together.
 func (p *TestP2P) Connect(b *TestP2P) {
 	if err := connect(p.BHost, b.BHost); err != nil {
@@ -251,6 +265,11 @@ func (p *TestP2P) Host() host.Host {
 	return p.BHost
 }
 
+// BandwidthForPeer returns empty bandwidth stats, as no traffic is accounted.
+func (_ *TestP2P) BandwidthForPeer(_ peer.ID) metrics.Stats {
+	return metrics.Stats{}
+}
+
 // ENR returns the enr of the local peer.
 func (_ *TestP2P) ENR() *enr.Record {
 	return new(enr.Record)
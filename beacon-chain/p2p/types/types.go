@@ -7,10 +7,14 @@ import (
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
 const rootLength = 32
 
+// blobIdentifierLength is the byte length of a serialized blob identifier, a block root and a blob index.
+const blobIdentifierLength = rootLength + 8
+
 const maxErrorLength = 256
 
 // SSZBytes is a bytes slice that satisfies the fast-ssz interface.
@@ -29,6 +33,28 @@ func (b *SSZBytes) HashTreeRootWith(hh *ssz.Hasher) error {
 	return nil
 }
 
+// MarshalSSZTo appends the bytes to the provided byte slice.
+func (b *SSZBytes) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, *b...), nil
+}
+
+// MarshalSSZ returns the bytes as is, as they are an already serialized object.
+func (b *SSZBytes) MarshalSSZ() ([]byte, error) {
+	return *b, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (b *SSZBytes) SizeSSZ() int {
+	return len(*b)
+}
+
+// UnmarshalSSZ copies the serialized object into the bytes.
+func (b *SSZBytes) UnmarshalSSZ(buf []byte) error {
+	*b = make([]byte, len(buf))
+	copy(*b, buf)
+	return nil
+}
+
 // BeaconBlockByRootsReq specifies the block by roots request type.
 type BeaconBlockByRootsReq [][rootLength]byte
 
@@ -80,6 +106,63 @@ func (r *BeaconBlockByRootsReq) UnmarshalSSZ(buf []byte) error {
 	return nil
 }
 
+// BlobSidecarsByRootReq specifies the blob sidecars by root request type.
+type BlobSidecarsByRootReq []*ethpb.BlobIdentifier
+
+// MarshalSSZTo marshals the blob sidecars by root request with the provided byte slice.
+func (r *BlobSidecarsByRootReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	marshalledObj, err := r.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, marshalledObj...), nil
+}
+
+// MarshalSSZ Marshals the blob sidecars by root request type into the serialized object.
+func (r *BlobSidecarsByRootReq) MarshalSSZ() ([]byte, error) {
+	if len(*r) > int(params.BeaconNetworkConfig().MaxRequestBlobSidecars) {
+		return nil, errors.Errorf("blob sidecars by root request exceeds max size: %d > %d", len(*r), params.BeaconNetworkConfig().MaxRequestBlobSidecars)
+	}
+	buf := make([]byte, 0, r.SizeSSZ())
+	for _, id := range *r {
+		var err error
+		buf, err = id.MarshalSSZTo(buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *BlobSidecarsByRootReq) SizeSSZ() int {
+	return len(*r) * blobIdentifierLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// blob sidecars by root request object.
+func (r *BlobSidecarsByRootReq) UnmarshalSSZ(buf []byte) error {
+	bufLen := len(buf)
+	maxLength := int(params.BeaconNetworkConfig().MaxRequestBlobSidecars * blobIdentifierLength)
+	if bufLen > maxLength {
+		return errors.Errorf("expected buffer with length of upto %d but received length %d", maxLength, bufLen)
+	}
+	if bufLen%blobIdentifierLength != 0 {
+		return ssz.ErrIncorrectByteSize
+	}
+	numOfIds := bufLen / blobIdentifierLength
+	ids := make([]*ethpb.BlobIdentifier, 0, numOfIds)
+	for i := 0; i < numOfIds; i++ {
+		id := &ethpb.BlobIdentifier{}
+		if err := id.UnmarshalSSZ(buf[i*blobIdentifierLength : (i+1)*blobIdentifierLength]); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	*r = ids
+	return nil
+}
+
 // ErrorMessage describes the error message type.
 type ErrorMessage []byte
 
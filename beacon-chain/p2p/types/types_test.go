@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
@@ -27,6 +29,26 @@ func TestBeaconBlockByRootsReq_Limit(t *testing.T) {
 	require.ErrorContains(t, "expected buffer with length of upto", req2.UnmarshalSSZ(buf))
 }
 
+func TestBlobSidecarsByRootReq_Limit(t *testing.T) {
+	ids := make([]*ethpb.BlobIdentifier, 0)
+	for i := uint64(0); i < params.BeaconNetworkConfig().MaxRequestBlobSidecars+1; i++ {
+		ids = append(ids, &ethpb.BlobIdentifier{BlockRoot: make([]byte, 32), Index: i})
+	}
+	req := BlobSidecarsByRootReq(ids)
+
+	_, err := req.MarshalSSZ()
+	require.ErrorContains(t, "blob sidecars by root request exceeds max size", err)
+
+	buf := make([]byte, 0)
+	for _, id := range ids {
+		buf, err = id.MarshalSSZTo(buf)
+		require.NoError(t, err)
+	}
+	req2 := BlobSidecarsByRootReq(nil)
+	require.ErrorContains(t, "expected buffer with length of upto", req2.UnmarshalSSZ(buf))
+	require.ErrorContains(t, "incorrect byte size", req2.UnmarshalSSZ(buf[:50]))
+}
+
 func TestErrorResponse_Limit(t *testing.T) {
 	errorMessage := make([]byte, 0)
 	// Provide a message of size 6400 bytes.
@@ -40,6 +62,7 @@ func TestErrorResponse_Limit(t *testing.T) {
 
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
+	roundTripTestBlobSidecarsByRootReq(t)
 	roundTripTestErrorMessage(t)
 }
 
@@ -58,6 +81,21 @@ func roundTripTestBlocksByRootReq(t *testing.T) {
 	assert.DeepEqual(t, [][32]byte(newVal), fixedRoots)
 }
 
+func roundTripTestBlobSidecarsByRootReq(t *testing.T) {
+	ids := make([]*ethpb.BlobIdentifier, 0)
+	for i := 0; i < 10; i++ {
+		ids = append(ids, &ethpb.BlobIdentifier{BlockRoot: bytesutil.PadTo([]byte{byte(i)}, 32), Index: uint64(i % 4)})
+	}
+	req := BlobSidecarsByRootReq(ids)
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	newVal := BlobSidecarsByRootReq(nil)
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepSSZEqual(t, []*ethpb.BlobIdentifier(newVal), ids)
+}
+
 func roundTripTestErrorMessage(t *testing.T) {
 	errMsg := []byte{'e', 'r', 'r', 'o', 'r'}
 	sszErr := make(ErrorMessage, len(errMsg))
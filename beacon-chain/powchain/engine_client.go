@@ -88,7 +88,14 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	if !ok {
 		return nil, errors.New("execution data must be an execution payload")
 	}
-	err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
+	cached, exists, err := s.payloadStatuses.get(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get cached payload status")
+	}
+	if exists {
+		return bytesutil.SafeCopyBytes(cached.latestValidHash), cached.err
+	}
+	err = s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
 	if err != nil {
 		return nil, handleRPCError(err)
 	}
@@ -99,8 +106,16 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 	case pb.PayloadStatus_ACCEPTED, pb.PayloadStatus_SYNCING:
 		return nil, ErrAcceptedSyncingPayloadStatus
 	case pb.PayloadStatus_INVALID:
+		// Only the final statuses of a payload are cached, as a syncing execution client may
+		// still verify the payload later on.
+		if err := s.payloadStatuses.put(payload, result.LatestValidHash, ErrInvalidPayloadStatus); err != nil {
+			return nil, errors.Wrap(err, "could not cache payload status")
+		}
 		return result.LatestValidHash, ErrInvalidPayloadStatus
 	case pb.PayloadStatus_VALID:
+		if err := s.payloadStatuses.put(payload, result.LatestValidHash, nil); err != nil {
+			return nil, errors.Wrap(err, "could not cache payload status")
+		}
 		return result.LatestValidHash, nil
 	default:
 		return nil, ErrUnknownPayloadStatus
@@ -118,6 +133,10 @@ func (s *Service) ForkchoiceUpdated(
 		forkchoiceUpdatedLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
 
+	s.forkchoiceStateLock.Lock()
+	s.lastForkchoiceState = state
+	s.forkchoiceStateLock.Unlock()
+
 	d := time.Now().Add(payloadAndForkchoiceUpdatedTimeout)
 	ctx, cancel := context.WithDeadline(ctx, d)
 	defer cancel()
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network"
 	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -403,6 +404,99 @@ func TestClient_HTTP(t *testing.T) {
 	})
 }
 
+func TestNewPayload_CachesFinalStatuses(t *testing.T) {
+	ctx := context.Background()
+	fix := fixtures()
+	tests := []struct {
+		name      string
+		status    string
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "VALID", status: "ValidPayloadStatus", wantCalls: 1},
+		{name: "INVALID", status: "InvalidStatus", wantErr: ErrInvalidPayloadStatus, wantCalls: 1},
+		{name: "SYNCING", status: "SyncingStatus", wantErr: ErrAcceptedSyncingPayloadStatus, wantCalls: 2},
+		{name: "INVALID_BLOCK_HASH", status: "InvalidBlockHashStatus", wantErr: ErrInvalidBlockHashPayloadStatus, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execPayload, ok := fix["ExecutionPayload"].(*pb.ExecutionPayload)
+			require.Equal(t, true, ok)
+			status, ok := fix[tt.status].(*pb.PayloadStatus)
+			require.Equal(t, true, ok)
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				defer func() {
+					require.NoError(t, r.Body.Close())
+				}()
+				calls++
+				resp := map[string]interface{}{
+					"jsonrpc": "2.0",
+					"id":      1,
+					"result":  status,
+				}
+				require.NoError(t, json.NewEncoder(w).Encode(resp))
+			}))
+			defer srv.Close()
+			rpcClient, err := rpc.DialHTTP(srv.URL)
+			require.NoError(t, err)
+			defer rpcClient.Close()
+			service := &Service{rpcClient: rpcClient, payloadStatuses: newPayloadStatusCache()}
+
+			wrappedPayload, err := wrapper.WrappedExecutionPayload(execPayload)
+			require.NoError(t, err)
+			for i := 0; i < 2; i++ {
+				resp, err := service.NewPayload(ctx, wrappedPayload)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+				if tt.wantCalls == 1 {
+					require.DeepEqual(t, status.LatestValidHash, resp)
+				}
+			}
+			require.Equal(t, tt.wantCalls, calls)
+
+			// A different payload with the same block hash is not served from the cache.
+			altered := proto.Clone(execPayload).(*pb.ExecutionPayload)
+			altered.GasUsed++
+			wrappedPayload, err = wrapper.WrappedExecutionPayload(altered)
+			require.NoError(t, err)
+			_, err = service.NewPayload(ctx, wrappedPayload)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+			require.Equal(t, tt.wantCalls+1, calls)
+		})
+	}
+}
+
+func TestNewPayload_ClearsStatusesOnEndpointChange(t *testing.T) {
+	execPayload, ok := fixtures()["ExecutionPayload"].(*pb.ExecutionPayload)
+	require.Equal(t, true, ok)
+	wrappedPayload, err := wrapper.WrappedExecutionPayload(execPayload)
+	require.NoError(t, err)
+	first, second := network.Endpoint{Url: "http://first"}, network.Endpoint{Url: "http://second"}
+	service := &Service{
+		cfg:             &config{httpEndpoints: []network.Endpoint{first, second}, currHttpEndpoint: first, beaconNodeStatsUpdater: &mockBSUpdater{}},
+		payloadStatuses: newPayloadStatusCache(),
+	}
+	require.NoError(t, service.payloadStatuses.put(wrappedPayload, []byte{'a'}, nil))
+
+	// Reconnecting to the same endpoint keeps the statuses.
+	service.updateCurrHttpEndpoint(first)
+	_, exists, err := service.payloadStatuses.get(wrappedPayload)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+
+	service.fallbackToNextEndpoint()
+	_, exists, err = service.payloadStatuses.get(wrappedPayload)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
 func TestReconstructFullBellatrixBlock(t *testing.T) {
 	ctx := context.Background()
 	t.Run("nil block", func(t *testing.T) {
@@ -769,6 +863,55 @@ func (c *dataError) ErrorData() interface{} {
 	return c.data
 }
 
+func TestReplayForkchoiceState(t *testing.T) {
+	ctx := context.Background()
+	fix := fixtures()
+	want, ok := fix["ForkchoiceUpdatedResponse"].(*ForkchoiceUpdatedResponse)
+	require.Equal(t, true, ok)
+	forkChoiceState := &pb.ForkchoiceState{
+		HeadBlockHash:      []byte("head"),
+		SafeBlockHash:      []byte("safe"),
+		FinalizedBlockHash: []byte("finalized"),
+	}
+	replayed := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		enc, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		replayed <- string(enc)
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result":  want,
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	// Nothing is replayed before a forkchoice state has been sent.
+	service := &Service{rpcClient: rpcClient}
+	service.replayForkchoiceState(ctx)
+	require.Equal(t, 0, len(replayed))
+
+	// The last forkchoice state sent to the previous endpoint is sent to the new one, without payload attributes.
+	previous := forkchoiceUpdateSetup(t, forkChoiceState, &pb.PayloadAttributes{}, want)
+	_, _, err = previous.ForkchoiceUpdated(ctx, forkChoiceState, &pb.PayloadAttributes{})
+	require.NoError(t, err)
+	previous.rpcClient = rpcClient
+	previous.replayForkchoiceState(ctx)
+	request := <-replayed
+	forkChoiceStateReq, err := json.Marshal(forkChoiceState)
+	require.NoError(t, err)
+	require.Equal(t, true, strings.Contains(request, ForkchoiceUpdatedMethod))
+	require.Equal(t, true, strings.Contains(request, string(forkChoiceStateReq)+",null"))
+}
+
 func Test_handleRPCError(t *testing.T) {
 	got := handleRPCError(nil)
 	require.Equal(t, true, got == nil)
@@ -35,4 +35,12 @@ var (
 		Name: "reconstructed_execution_payload_count",
 		Help: "Count the number of execution payloads that are reconstructed using JSON-RPC from payload headers",
 	})
+	payloadStatusCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "new_payload_status_cache_hit",
+		Help: "The number of newPayload calls whose status was found in the cache",
+	})
+	payloadStatusCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "new_payload_status_cache_miss",
+		Help: "The number of newPayload calls whose status was not found in the cache",
+	})
 )
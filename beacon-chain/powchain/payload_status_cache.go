@@ -0,0 +1,84 @@
+package powchain
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+// maxPayloadStatusCacheSize is the number of execution payload statuses kept in the cache,
+// which covers the payloads of a few epochs of blocks.
+const maxPayloadStatusCacheSize = 256
+
+// payloadStatus is the final outcome of the verification of an execution payload by the
+// execution client.
+type payloadStatus struct {
+	payloadRoot     [32]byte
+	latestValidHash []byte
+	err             error
+}
+
+// payloadStatusCache keeps the final statuses returned by engine_newPayload, keyed by the
+// block hash of the payload, so that payloads which were already verified, when blocks are
+// processed again on reorgs or state replays, are not sent again to the execution client.
+type payloadStatusCache struct {
+	cache *lru.Cache
+}
+
+func newPayloadStatusCache() *payloadStatusCache {
+	return &payloadStatusCache{cache: lruwrpr.New(maxPayloadStatusCacheSize)}
+}
+
+// get returns the status of the payload, if the payload was already verified. Statuses are only
+// returned for payloads which are identical to the verified one, and not only share its block hash.
+func (c *payloadStatusCache) get(payload interfaces.ExecutionData) (*payloadStatus, bool, error) {
+	if c == nil {
+		return nil, false, nil
+	}
+	item, exists := c.cache.Get(bytesutil.ToBytes32(payload.BlockHash()))
+	if !exists || item == nil {
+		payloadStatusCacheMiss.Inc()
+		return nil, false, nil
+	}
+	status, ok := item.(*payloadStatus)
+	if !ok {
+		payloadStatusCacheMiss.Inc()
+		return nil, false, nil
+	}
+	root, err := payload.HashTreeRoot()
+	if err != nil {
+		return nil, false, err
+	}
+	if root != status.payloadRoot {
+		payloadStatusCacheMiss.Inc()
+		return nil, false, nil
+	}
+	payloadStatusCacheHit.Inc()
+	return status, true, nil
+}
+
+// put saves the status returned by the execution client for the payload.
+func (c *payloadStatusCache) put(payload interfaces.ExecutionData, latestValidHash []byte, err error) error {
+	if c == nil {
+		return nil
+	}
+	root, rootErr := payload.HashTreeRoot()
+	if rootErr != nil {
+		return rootErr
+	}
+	c.cache.Add(bytesutil.ToBytes32(payload.BlockHash()), &payloadStatus{
+		payloadRoot:     root,
+		latestValidHash: bytesutil.SafeCopyBytes(latestValidHash),
+		err:             err,
+	})
+	return nil
+}
+
+// clear drops every cached status, as statuses returned by one execution client are not valid for another.
+func (c *payloadStatusCache) clear() {
+	if c == nil {
+		return
+	}
+	c.cache.Purge()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/config/params"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/io/logs"
 	"github.com/prysmaticlabs/prysm/network"
 	"github.com/prysmaticlabs/prysm/network/authorization"
@@ -41,9 +42,28 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	}
 	s.updateConnectedETH1(true)
 	s.runError = nil
+	s.replayForkchoiceState(ctx)
 	return nil
 }
 
+// Sends the last forkchoice state sent by the beacon node to the newly connected execution
+// client, so that an endpoint we fall back to follows the head of the beacon chain right away
+// instead of waiting for the next block.
+func (s *Service) replayForkchoiceState(ctx context.Context) {
+	s.forkchoiceStateLock.RLock()
+	state := s.lastForkchoiceState
+	s.forkchoiceStateLock.RUnlock()
+	if state == nil {
+		return
+	}
+	_, _, err := s.ForkchoiceUpdated(ctx, state, nil)
+	if err != nil && !errors.Is(err, ErrAcceptedSyncingPayloadStatus) {
+		log.WithError(err).Warn("Could not replay forkchoice state to execution client")
+		return
+	}
+	log.WithField("headBlockHash", fmt.Sprintf("%#x", bytesutil.Trunc(state.HeadBlockHash))).Debug("Replayed forkchoice state to execution client")
+}
+
 // Every N seconds, defined as a backoffPeriod, attempts to re-establish an execution client
 // connection and if this does not work, we fallback to the next endpoint if defined.
 func (s *Service) pollConnectionStatus(ctx context.Context) {
@@ -122,6 +142,7 @@ func (s *Service) checkDefaultEndpoint(ctx context.Context) {
 		currClient.Close()
 	}
 	s.updateCurrHttpEndpoint(primaryEndpoint)
+	log.Infof("Reconnected to primary execution endpoint: %s", logs.MaskCredentialsLogging(primaryEndpoint.Url))
 }
 
 // This is an inefficient way to search for the next endpoint, but given N is
@@ -143,7 +164,7 @@ func (s *Service) fallbackToNextEndpoint() {
 	}
 	s.updateCurrHttpEndpoint(s.cfg.httpEndpoints[nextIndex])
 	if nextIndex != currIndex {
-		log.Infof("Falling back to alternative endpoint: %s", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url))
+		log.Warnf("Falling back to alternative execution endpoint: %s", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url))
 	}
 }
 
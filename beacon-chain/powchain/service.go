@@ -4,6 +4,7 @@
 package powchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -31,14 +32,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	native "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/clientstats"
 	"github.com/prysmaticlabs/prysm/network"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
@@ -58,6 +58,10 @@ var (
 		Name: "powchain_missed_deposit_logs",
 		Help: "The number of times a missed deposit log is detected",
 	})
+	fallbackEndpointGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_fallback_endpoint_in_use",
+		Help: "Set to 1 when the beacon node uses a fallback execution endpoint rather than the primary one",
+	})
 )
 
 var (
@@ -89,6 +93,7 @@ type ChainInfoFetcher interface {
 	CurrentETH1ConnectionError() error
 	ETH1Endpoints() []string
 	ETH1ConnectionErrors() []error
+	LatestEth1Data() *ethpb.LatestETH1Data
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -161,6 +166,9 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	forkchoiceStateLock     sync.RWMutex
+	lastForkchoiceState     *enginev1.ForkchoiceState // last forkchoice state sent, replayed to newly connected endpoints.
+	payloadStatuses         *payloadStatusCache       // cache of the statuses of the verified execution payloads.
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -190,8 +198,9 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			BlockHash:          []byte{},
 			LastRequestedBlock: 0,
 		},
-		headerCache: newHeaderCache(),
-		depositTrie: depositTrie,
+		headerCache:     newHeaderCache(),
+		payloadStatuses: newPayloadStatusCache(),
+		depositTrie:     depositTrie,
 		chainStartData: &ethpb.ChainStartData{
 			Eth1Data:           &ethpb.Eth1Data{},
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
@@ -269,11 +278,7 @@ func (s *Service) Stop() error {
 // ClearPreGenesisData clears out the stored chainstart deposits and beacon state.
 func (s *Service) ClearPreGenesisData() {
 	s.chainStartData.ChainstartDeposits = []*ethpb.Deposit{}
-	if features.Get().EnableNativeState {
-		s.preGenesisState = &native.BeaconState{}
-	} else {
-		s.preGenesisState = &v1.BeaconState{}
-	}
+	s.preGenesisState = &native.BeaconState{}
 }
 
 // ChainStartEth1Data returns the eth1 data at chainstart.
@@ -313,7 +318,17 @@ func (s *Service) updateBeaconNodeStats() {
 }
 
 func (s *Service) updateCurrHttpEndpoint(endpoint network.Endpoint) {
+	if !endpoint.Equals(s.cfg.currHttpEndpoint) {
+		// The new execution client may not agree with the payload statuses returned by the previous one,
+		// if it follows a different head or is still syncing.
+		s.payloadStatuses.clear()
+	}
 	s.cfg.currHttpEndpoint = endpoint
+	if s.primaryConnected() {
+		fallbackEndpointGauge.Set(0)
+	} else {
+		fallbackEndpointGauge.Set(1)
+	}
 	s.updateBeaconNodeStats()
 }
 
@@ -346,6 +361,19 @@ func (s *Service) ETH1Endpoints() []string {
 	return eps
 }
 
+// LatestEth1Data returns a copy of the latest eth1 block processed by the service, and of the last
+// block its deposit logs were requested up to.
+func (s *Service) LatestEth1Data() *ethpb.LatestETH1Data {
+	s.latestEth1DataLock.RLock()
+	defer s.latestEth1DataLock.RUnlock()
+	return &ethpb.LatestETH1Data{
+		BlockHeight:        s.latestEth1Data.BlockHeight,
+		BlockTime:          s.latestEth1Data.BlockTime,
+		BlockHash:          bytesutil.SafeCopyBytes(s.latestEth1Data.BlockHash),
+		LastRequestedBlock: s.latestEth1Data.LastRequestedBlock,
+	}
+}
+
 // ETH1ConnectionErrors returns a slice of errors for each HTTP endpoint. An error
 // of nil means the connection was successful.
 func (s *Service) ETH1ConnectionErrors() []error {
@@ -382,7 +410,7 @@ func (s *Service) followedBlockHeight(ctx context.Context) (uint64, error) {
 	return blk.Number.Uint64(), nil
 }
 
-func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositContainer) error {
+func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) error {
 	if len(ctrs) == 0 {
 		return nil
 	}
@@ -420,7 +448,25 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		// to be included (rather than the last one to be processed). This was most likely
 		// done as the state cannot represent signed integers.
 		actualIndex := int64(currIndex) - 1 // lint:ignore uintcast -- deposit index will not exceed int64 in your lifetime.
-		s.cfg.depositCache.InsertFinalizedDeposits(ctx, actualIndex)
+		// The finalized deposits are restored from their snapshot when there is one. Nodes which
+		// saved their eth1 data before deposit snapshots existed rebuild the finalized deposits from
+		// the deposit containers instead, which are then saved as a snapshot with the eth1 data.
+		if snapshot != nil {
+			if err := s.cfg.depositCache.RestoreFinalizedDeposits(ctx, snapshot); err != nil {
+				log.WithError(err).Warn("Could not restore finalized deposits from snapshot, rebuilding them from deposits")
+			}
+		} else {
+			log.Info("No deposit snapshot found, migrating finalized deposits to a deposit snapshot")
+		}
+		// The deposits are finalized at the execution block of the finalized eth1 data, whose height is
+		// recorded in the snapshot. If it is not known yet, they are finalized with the next checkpoint.
+		executionHash := bytesutil.ToBytes32(fState.Eth1Data().BlockHash)
+		executionNumber, err := s.finalizedExecutionHeight(ctx, executionHash, snapshot)
+		if err != nil {
+			log.WithError(err).Debug("Could not get height of finalized execution block, deferring deposit finalization")
+		} else {
+			s.cfg.depositCache.InsertFinalizedDeposits(ctx, actualIndex, executionHash, executionNumber)
+		}
 
 		// Deposit proofs are only used during state transition and can be safely removed to save space.
 		if err = s.cfg.depositCache.PruneProofs(ctx, actualIndex); err != nil {
@@ -764,6 +810,29 @@ func (s *Service) determineEarliestVotingBlock(ctx context.Context, followBlock
 	return hdr.Number.Uint64(), nil
 }
 
+// finalizedExecutionHeight returns the height of the execution block with the given hash, from the deposit
+// snapshot if it was taken at that block, or from the execution client otherwise.
+func (s *Service) finalizedExecutionHeight(ctx context.Context, hash [32]byte, snapshot *ethpb.DepositSnapshot) (uint64, error) {
+	if snapshot != nil && bytes.Equal(snapshot.ExecutionHash, hash[:]) {
+		return snapshot.ExecutionDepth, nil
+	}
+	if s.eth1DataFetcher == nil {
+		exists, hdr, err := s.headerCache.HeaderInfoByHash(hash)
+		if err != nil || !exists {
+			return 0, errors.New("execution client is not connected")
+		}
+		return hdr.Number.Uint64(), nil
+	}
+	exists, height, err := s.BlockExists(ctx, hash)
+	if err != nil {
+		return 0, err
+	}
+	if !exists || height == nil {
+		return 0, fmt.Errorf("execution block %#x not found", hash)
+	}
+	return height.Uint64(), nil
+}
+
 // initializes our service from the provided eth1data object by initializing all the relevant
 // fields and data.
 func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ETH1ChainData) error {
@@ -779,7 +848,7 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	s.chainStartData = eth1DataInDB.ChainstartData
 	if !reflect.ValueOf(eth1DataInDB.BeaconState).IsZero() {
-		s.preGenesisState, err = v1.InitializeFromProto(eth1DataInDB.BeaconState)
+		s.preGenesisState, err = native.InitializeFromProtoPhase0(eth1DataInDB.BeaconState)
 		if err != nil {
 			return errors.Wrap(err, "Could not initialize state trie")
 		}
@@ -787,7 +856,7 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
-	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
+	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers, eth1DataInDB.DepositSnapshot); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
@@ -832,13 +901,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
 	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
-		var pbState *ethpb.BeaconState
-		var err error
-		if features.Get().EnableNativeState {
-			pbState, err = native.ProtobufBeaconStatePhase0(s.preGenesisState.InnerStateUnsafe())
-		} else {
-			pbState, err = v1.ProtobufBeaconState(s.preGenesisState.InnerStateUnsafe())
-		}
+		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.InnerStateUnsafe())
 		if err != nil {
 			return err
 		}
@@ -849,12 +912,17 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			Eth1Data:           genState.Eth1Data(),
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
 		}
+		depositSnapshot, err := s.cfg.depositCache.DepositSnapshot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get deposit snapshot")
+		}
 		eth1Data = &ethpb.ETH1ChainData{
 			CurrentEth1Data:   s.latestEth1Data,
 			ChainstartData:    s.chainStartData,
 			BeaconState:       pbState,
 			Trie:              s.depositTrie.ToProto(),
 			DepositContainers: s.cfg.depositCache.AllDepositContainers(ctx),
+			DepositSnapshot:   depositSnapshot,
 		}
 		return s.cfg.beaconDB.SavePowchainData(ctx, eth1Data)
 	}
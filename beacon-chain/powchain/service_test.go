@@ -22,6 +22,7 @@ import (
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/contracts/deposit/mock"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -398,13 +399,16 @@ func TestInitDepositCache_OK(t *testing.T) {
 		chainStartData:  &ethpb.ChainStartData{Chainstarted: false},
 		preGenesisState: gs,
 		cfg:             &config{beaconDB: beaconDB},
+		headerCache:     newHeaderCache(),
 	}
 	var err error
 	s.cfg.depositCache, err = depositcache.New()
 	require.NoError(t, err)
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 
 	require.Equal(t, 0, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
+	header := &gethTypes.Header{Number: big.NewInt(10)}
+	require.NoError(t, s.headerCache.AddHeader(header))
 
 	blockRootA := [32]byte{'a'}
 
@@ -413,7 +417,7 @@ func TestInitDepositCache_OK(t *testing.T) {
 	require.NoError(t, s.cfg.beaconDB.SaveGenesisBlockRoot(context.Background(), blockRootA))
 	require.NoError(t, s.cfg.beaconDB.SaveState(context.Background(), emptyState, blockRootA))
 	s.chainStartData.Chainstarted = true
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 	require.Equal(t, 3, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
 }
 
@@ -459,13 +463,16 @@ func TestInitDepositCacheWithFinalization_OK(t *testing.T) {
 		chainStartData:  &ethpb.ChainStartData{Chainstarted: false},
 		preGenesisState: gs,
 		cfg:             &config{beaconDB: beaconDB},
+		headerCache:     newHeaderCache(),
 	}
 	var err error
 	s.cfg.depositCache, err = depositcache.New()
 	require.NoError(t, err)
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 
 	require.Equal(t, 0, len(s.cfg.depositCache.PendingContainers(context.Background(), nil)))
+	header := &gethTypes.Header{Number: big.NewInt(10)}
+	require.NoError(t, s.headerCache.AddHeader(header))
 
 	headBlock := util.NewBeaconBlock()
 	headRoot, err := headBlock.Block.HashTreeRoot()
@@ -479,16 +486,121 @@ func TestInitDepositCacheWithFinalization_OK(t *testing.T) {
 	require.NoError(t, stateGen.SaveState(context.Background(), headRoot, emptyState))
 	s.cfg.stateGen = stateGen
 	require.NoError(t, emptyState.SetEth1DepositIndex(3))
+	require.NoError(t, emptyState.SetEth1Data(&ethpb.Eth1Data{BlockHash: header.Hash().Bytes()}))
 
 	ctx := context.Background()
 	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: slots.ToEpoch(0), Root: headRoot[:]}))
 	s.cfg.finalizedStateAtStartup = emptyState
 
 	s.chainStartData.Chainstarted = true
-	require.NoError(t, s.initDepositCaches(context.Background(), ctrs))
+	require.NoError(t, s.initDepositCaches(context.Background(), ctrs, nil))
 	fDeposits := s.cfg.depositCache.FinalizedDeposits(ctx)
 	deps := s.cfg.depositCache.NonFinalizedDeposits(context.Background(), fDeposits.MerkleTrieIndex, nil)
 	assert.Equal(t, 0, len(deps))
+	snapshot, err := s.cfg.depositCache.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, header.Hash().Bytes(), snapshot.ExecutionHash)
+	assert.Equal(t, uint64(10), snapshot.ExecutionDepth)
+}
+
+func TestInitDepositCacheWithFinalization_UnknownExecutionBlock(t *testing.T) {
+	ctx := context.Background()
+	ctrs := []*ethpb.DepositContainer{
+		{
+			Index:           0,
+			Eth1BlockHeight: 2,
+			Deposit: &ethpb.Deposit{
+				Data: &ethpb.Deposit_Data{
+					PublicKey:             bytesutil.PadTo([]byte{0}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					Signature:             make([]byte, 96),
+				},
+			},
+		},
+	}
+	beaconDB := dbutil.SetupDB(t)
+	headBlock := util.NewBeaconBlock()
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	fState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, headRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, fState, headRoot))
+	require.NoError(t, fState.SetEth1DepositIndex(1))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: headRoot[:]}))
+
+	s := &Service{
+		chainStartData: &ethpb.ChainStartData{Chainstarted: true},
+		cfg:            &config{beaconDB: beaconDB, finalizedStateAtStartup: fState},
+		headerCache:    newHeaderCache(),
+	}
+	s.cfg.depositCache, err = depositcache.New()
+	require.NoError(t, err)
+	require.NoError(t, s.initDepositCaches(ctx, ctrs, nil))
+
+	// The deposits are only finalized once the height of the execution block is known.
+	assert.Equal(t, int64(-1), s.cfg.depositCache.FinalizedDeposits(ctx).MerkleTrieIndex)
+	assert.Equal(t, 1, len(s.cfg.depositCache.AllDepositContainers(ctx)))
+}
+
+func TestInitDepositCacheWithFinalization_RestoresSnapshot(t *testing.T) {
+	ctx := context.Background()
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	ctrs := make([]*ethpb.DepositContainer, 3)
+	for i := range ctrs {
+		d := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		depHash, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, depositTrie.Insert(depHash[:], i))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		ctrs[i] = &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(2 * (i + 1)), Deposit: d, DepositRoot: root[:]}
+	}
+	// The snapshot holds the first two deposits, the finalized state the three of them.
+	oldCache, err := depositcache.New()
+	require.NoError(t, err)
+	oldCache.InsertDepositContainers(ctx, ctrs)
+	oldCache.InsertFinalizedDeposits(ctx, 1, [32]byte{}, 0)
+	snapshot, err := oldCache.DepositSnapshot(ctx)
+	require.NoError(t, err)
+
+	beaconDB := dbutil.SetupDB(t)
+	headBlock := util.NewBeaconBlock()
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	fState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, headRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, fState, headRoot))
+	require.NoError(t, fState.SetEth1DepositIndex(3))
+	header := &gethTypes.Header{Number: big.NewInt(10)}
+	require.NoError(t, fState.SetEth1Data(&ethpb.Eth1Data{BlockHash: header.Hash().Bytes()}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: headRoot[:]}))
+
+	for _, snapshot := range []*ethpb.DepositSnapshot{snapshot, nil, {DepositCount: 2}} {
+		s := &Service{
+			chainStartData: &ethpb.ChainStartData{Chainstarted: true},
+			cfg:            &config{beaconDB: beaconDB, finalizedStateAtStartup: fState},
+			headerCache:    newHeaderCache(),
+		}
+		require.NoError(t, s.headerCache.AddHeader(header))
+		s.cfg.depositCache, err = depositcache.New()
+		require.NoError(t, err)
+		require.NoError(t, s.initDepositCaches(ctx, ctrs, snapshot))
+
+		fDeposits := s.cfg.depositCache.FinalizedDeposits(ctx)
+		assert.Equal(t, int64(2), fDeposits.MerkleTrieIndex)
+		root, err := fDeposits.Deposits.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, ctrs[2].DepositRoot, root[:])
+	}
 }
 
 func TestNewService_EarliestVotingBlock(t *testing.T) {
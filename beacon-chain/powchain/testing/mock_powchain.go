@@ -36,6 +36,7 @@ type POWChain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	LatestETH1Data    *ethpb.LatestETH1Data
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -139,6 +140,10 @@ func (m *POWChain) ETH1ConnectionErrors() []error {
 	return m.Errors
 }
 
+func (m *POWChain) LatestEth1Data() *ethpb.LatestETH1Data {
+	return m.LatestETH1Data
+}
+
 // RPCClient defines the mock rpc client.
 type RPCClient struct {
 	Backend *backends.SimulatedBackend
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -25,7 +26,7 @@ const (
 )
 
 // match a number with optional decimals
-var priorityRegex = regexp.MustCompile(`q=(\d+(?:\.\d+)?)`)
+var priorityRegex = regexp.MustCompile(`^q=(\d+(?:\.\d+)?)$`)
 
 type sszConfig struct {
 	fileName     string
@@ -198,53 +199,53 @@ func handlePostSSZ(m *apimiddleware.ApiProxyMiddleware, endpoint apimiddleware.E
 	return true
 }
 
+// sszRequested tells whether the client prefers an SSZ response over a JSON one, based on the media ranges of all
+// Accept headers of the request. Wildcard ranges count as JSON, which remains the default representation.
 func sszRequested(req *http.Request) (bool, error) {
-	accept := req.Header.Values("Accept")
-	if len(accept) == 0 {
-		return false, nil
-	}
-	types := strings.Split(accept[0], ",")
 	currentType, currentPriority := "", 0.0
-	for _, t := range types {
-		values := strings.Split(t, ";")
-		name := values[0]
-		if name != jsonMediaType && name != octetStreamMediaType {
-			continue
-		}
-		// no params specified
-		if len(values) == 1 {
+	for _, accept := range req.Header.Values("Accept") {
+		for _, t := range strings.Split(accept, ",") {
+			values := strings.Split(t, ";")
+			name := strings.ToLower(strings.TrimSpace(values[0]))
+			switch name {
+			case jsonMediaType, octetStreamMediaType:
+			case "*/*", "application/*":
+				name = jsonMediaType
+			default:
+				continue
+			}
 			priority := 1.0
+			for _, param := range values[1:] {
+				match := priorityRegex.FindStringSubmatch(strings.TrimSpace(param))
+				if len(match) != 2 {
+					continue
+				}
+				p, err := strconv.ParseFloat(match[1], 32)
+				if err != nil {
+					return false, err
+				}
+				priority = p
+			}
 			if priority > currentPriority {
 				currentType, currentPriority = name, priority
 			}
-			continue
-		}
-		params := values[1]
-		match := priorityRegex.FindAllStringSubmatch(params, 1)
-		if len(match) != 1 {
-			continue
-		}
-		priority, err := strconv.ParseFloat(match[0][1], 32)
-		if err != nil {
-			return false, err
-		}
-		if priority > currentPriority {
-			currentType, currentPriority = name, priority
 		}
 	}
 
 	return currentType == octetStreamMediaType, nil
 }
 
+// sszPosted tells whether the body of the request is SSZ-serialized. Media type parameters are ignored.
 func sszPosted(req *http.Request) bool {
-	ct, ok := req.Header["Content-Type"]
-	if !ok {
+	ct := req.Header.Values("Content-Type")
+	if len(ct) != 1 {
 		return false
 	}
-	if len(ct) != 1 {
+	mediaType, _, err := mime.ParseMediaType(ct[0])
+	if err != nil {
 		return false
 	}
-	return ct[0] == octetStreamMediaType
+	return mediaType == octetStreamMediaType
 }
 
 func prepareSSZRequestForProxying(m *apimiddleware.ApiProxyMiddleware, endpoint apimiddleware.Endpoint, req *http.Request) apimiddleware.ErrorJson {
@@ -389,6 +390,8 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &signedContributionAndProofJson{}
+			case events.ProposerDutiesTopic:
+				data = &proposerDutiesResponseJson{}
 			case "error":
 				data = &eventErrorJson{}
 			default:
@@ -119,6 +119,69 @@ func TestSSZRequested(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, false, result)
 	})
+
+	t.Run("whitespace", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{fmt.Sprintf("%s;q=0.9, %s; q=1", jsonMediaType, octetStreamMediaType)}
+		result, err := sszRequested(request)
+		require.NoError(t, err)
+		assert.Equal(t, true, result)
+	})
+
+	t.Run("multiple_headers", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{fmt.Sprintf("%s;q=0.5", jsonMediaType), octetStreamMediaType}
+		result, err := sszRequested(request)
+		require.NoError(t, err)
+		assert.Equal(t, true, result)
+	})
+
+	t.Run("wildcard_preferred", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{fmt.Sprintf("*/*,%s;q=0.9", octetStreamMediaType)}
+		result, err := sszRequested(request)
+		require.NoError(t, err)
+		assert.Equal(t, false, result)
+	})
+
+	t.Run("params_without_priority", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.example", nil)
+		request.Header["Accept"] = []string{fmt.Sprintf("%s;otherparam=xyz,%s;q=0.9", octetStreamMediaType, jsonMediaType)}
+		result, err := sszRequested(request)
+		require.NoError(t, err)
+		assert.Equal(t, true, result)
+	})
+}
+
+func TestSSZPosted(t *testing.T) {
+	t.Run("ssz_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header["Content-Type"] = []string{octetStreamMediaType}
+		assert.Equal(t, true, sszPosted(request))
+	})
+
+	t.Run("media_type_params", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header["Content-Type"] = []string{"Application/Octet-Stream; charset=binary"}
+		assert.Equal(t, true, sszPosted(request))
+	})
+
+	t.Run("json_posted", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header["Content-Type"] = []string{jsonMediaType}
+		assert.Equal(t, false, sszPosted(request))
+	})
+
+	t.Run("no_header", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		assert.Equal(t, false, sszPosted(request))
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "http://foo.example", nil)
+		request.Header["Content-Type"] = []string{"This is Sparta!!!"}
+		assert.Equal(t, false, sszPosted(request))
+	})
 }
 
 func TestPrepareSSZRequestForProxying(t *testing.T) {
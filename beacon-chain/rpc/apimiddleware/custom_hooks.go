@@ -173,6 +173,28 @@ func wrapSyncCommitteeSignaturesArray(
 	return true, nil
 }
 
+// https://ethereum.github.io/beacon-APIs/#/Beacon/submitPoolBLSToExecutionChange expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'changes' field.
+func wrapBLSToExecutionChangesArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if _, ok := endpoint.PostRequest.(*submitBLSToExecutionChangesRequestJson); ok {
+		changes := make([]*signedBLSToExecutionChangeJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&changes); err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not decode body")
+		}
+		j := &submitBLSToExecutionChangesRequestJson{Changes: changes}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+	return true, nil
+}
+
 // https://ethereum.github.io/beacon-APIs/#/Validator/publishContributionAndProofs expects posting a top-level array.
 // We make it more proto-friendly by wrapping it in a struct with a 'data' field.
 func wrapSignedContributionAndProofsArray(
@@ -195,6 +217,56 @@ func wrapSignedContributionAndProofsArray(
 	return true, nil
 }
 
+// https://ethereum.github.io/beacon-APIs/?urls.primaryName=dev#/Validator/submitBeaconCommitteeSelections expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapBeaconCommitteeSelectionsArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if _, ok := endpoint.PostRequest.(*submitBeaconCommitteeSelectionsRequestJson); ok {
+		data := make([]*beaconCommitteeSelectionJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return false, &apimiddleware.DefaultErrorJson{
+				Message: errors.Wrap(err, "could not decode body").Error(),
+				Code:    http.StatusBadRequest,
+			}
+		}
+		j := &submitBeaconCommitteeSelectionsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+	return true, nil
+}
+
+// https://ethereum.github.io/beacon-APIs/?urls.primaryName=dev#/Validator/submitSyncCommitteeSelections expects posting a top-level array.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapSyncCommitteeSelectionsArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if _, ok := endpoint.PostRequest.(*submitSyncCommitteeSelectionsRequestJson); ok {
+		data := make([]*syncCommitteeSelectionJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&data); err != nil {
+			return false, &apimiddleware.DefaultErrorJson{
+				Message: errors.Wrap(err, "could not decode body").Error(),
+				Code:    http.StatusBadRequest,
+			}
+		}
+		j := &submitSyncCommitteeSelectionsRequestJson{Data: data}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(b))
+	}
+	return true, nil
+}
+
 type phase0PublishBlockRequestJson struct {
 	Phase0Block *beaconBlockJson `json:"phase0_block"`
 	Signature   string           `json:"signature" hex:"true"`
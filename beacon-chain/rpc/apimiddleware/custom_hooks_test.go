@@ -293,6 +293,56 @@ func TestWrapSyncCommitteeSignaturesArray(t *testing.T) {
 	})
 }
 
+func TestWrapBLSToExecutionChangesArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitBLSToExecutionChangesRequestJson{},
+		}
+		unwrappedChanges := []*signedBLSToExecutionChangeJson{{
+			Message: &blsToExecutionChangeJson{
+				ValidatorIndex:     "1",
+				FromBlsPubkey:      "pubkey",
+				ToExecutionAddress: "address",
+			},
+			Signature: "sig",
+		}}
+		unwrappedChangesJson, err := json.Marshal(unwrappedChanges)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedChangesJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapBLSToExecutionChangesArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrappedChanges := &submitBLSToExecutionChangesRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedChanges))
+		require.Equal(t, 1, len(wrappedChanges.Changes), "wrong number of wrapped items")
+		assert.Equal(t, "1", wrappedChanges.Changes[0].Message.ValidatorIndex)
+		assert.Equal(t, "pubkey", wrappedChanges.Changes[0].Message.FromBlsPubkey)
+		assert.Equal(t, "address", wrappedChanges.Changes[0].Message.ToExecutionAddress)
+		assert.Equal(t, "sig", wrappedChanges.Changes[0].Signature)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitBLSToExecutionChangesRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapBLSToExecutionChangesArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
 func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
@@ -362,6 +412,117 @@ func TestWrapSignedContributionAndProofsArray(t *testing.T) {
 	})
 }
 
+func TestWrapBeaconCommitteeSelectionsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitBeaconCommitteeSelectionsRequestJson{},
+		}
+		unwrapped := []*beaconCommitteeSelectionJson{
+			{
+				ValidatorIndex: "1",
+				Slot:           "2",
+				SelectionProof: "proof",
+			},
+			{
+				ValidatorIndex: "3",
+				Slot:           "4",
+				SelectionProof: "proof",
+			},
+		}
+		unwrappedJson, err := json.Marshal(unwrapped)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapBeaconCommitteeSelectionsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrapped := &submitBeaconCommitteeSelectionsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
+		require.Equal(t, 2, len(wrapped.Data), "wrong number of wrapped items")
+		assert.Equal(t, "1", wrapped.Data[0].ValidatorIndex)
+		assert.Equal(t, "2", wrapped.Data[0].Slot)
+		assert.Equal(t, "proof", wrapped.Data[0].SelectionProof)
+		assert.Equal(t, "3", wrapped.Data[1].ValidatorIndex)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitBeaconCommitteeSelectionsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapBeaconCommitteeSelectionsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusBadRequest, errJson.StatusCode())
+	})
+}
+
+func TestWrapSyncCommitteeSelectionsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitSyncCommitteeSelectionsRequestJson{},
+		}
+		unwrapped := []*syncCommitteeSelectionJson{
+			{
+				ValidatorIndex:    "1",
+				Slot:              "2",
+				SubcommitteeIndex: "3",
+				SelectionProof:    "proof",
+			},
+			{
+				ValidatorIndex:    "4",
+				Slot:              "5",
+				SubcommitteeIndex: "0",
+				SelectionProof:    "proof",
+			},
+		}
+		unwrappedJson, err := json.Marshal(unwrapped)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapSyncCommitteeSelectionsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrapped := &submitSyncCommitteeSelectionsRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrapped))
+		require.Equal(t, 2, len(wrapped.Data), "wrong number of wrapped items")
+		assert.Equal(t, "1", wrapped.Data[0].ValidatorIndex)
+		assert.Equal(t, "2", wrapped.Data[0].Slot)
+		assert.Equal(t, "3", wrapped.Data[0].SubcommitteeIndex)
+		assert.Equal(t, "proof", wrapped.Data[0].SelectionProof)
+		assert.Equal(t, "4", wrapped.Data[1].ValidatorIndex)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitSyncCommitteeSelectionsRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapSyncCommitteeSelectionsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusBadRequest, errJson.StatusCode())
+	})
+}
+
 func TestSetInitialPublishBlockPostRequest(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.BeaconConfig().Copy()
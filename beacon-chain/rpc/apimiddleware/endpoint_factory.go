@@ -33,12 +33,16 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v2/beacon/blocks/{block_id}",
 		"/eth/v1/beacon/blocks/{block_id}/root",
 		"/eth/v1/beacon/blocks/{block_id}/attestations",
+		"/eth/v1/beacon/blob_sidecars/{block_id}",
 		"/eth/v1/beacon/pool/attestations",
 		"/eth/v1/beacon/pool/attester_slashings",
 		"/eth/v1/beacon/pool/proposer_slashings",
 		"/eth/v1/beacon/pool/voluntary_exits",
 		"/eth/v1/beacon/pool/sync_committees",
+		"/eth/v1/beacon/pool/bls_to_execution_changes",
 		"/eth/v1/beacon/weak_subjectivity",
+		"/eth/v1/beacon/rewards/blocks/{block_id}",
+		"/eth/v1/beacon/rewards/attestations/{epoch}",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
 		"/eth/v1/node/peers/{peer_id}",
@@ -53,6 +57,7 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/config/fork_schedule",
 		"/eth/v1/config/deposit_contract",
 		"/eth/v1/config/spec",
+		"/eth/v1/builder/states/{state_id}/expected_withdrawals",
 		"/eth/v1/events",
 		"/eth/v1/validator/duties/attester/{epoch}",
 		"/eth/v1/validator/duties/proposer/{epoch}",
@@ -68,6 +73,9 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/validator/sync_committee_contribution",
 		"/eth/v1/validator/contribution_and_proofs",
 		"/eth/v1/validator/prepare_beacon_proposer",
+		"/eth/v1/validator/beacon_committee_selections",
+		"/eth/v1/validator/sync_committee_selections",
+		"/eth/v1/validator/liveness/{epoch}",
 	}
 }
 
@@ -92,7 +100,7 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "id", Hex: true}}
 		endpoint.GetResponse = &validatorBalancesResponseJson{}
 	case "/eth/v1/beacon/states/{state_id}/committees":
-		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "epoch"}, {Name: "index"}, {Name: "slot"}}
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "epoch"}, {Name: "index"}, {Name: "slot"}, {Name: "page_size"}, {Name: "page_token"}}
 		endpoint.GetResponse = &stateCommitteesResponseJson{}
 	case "/eth/v1/beacon/states/{state_id}/sync_committees":
 		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "epoch"}}
@@ -130,6 +138,9 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.GetResponse = &blockRootResponseJson{}
 	case "/eth/v1/beacon/blocks/{block_id}/attestations":
 		endpoint.GetResponse = &blockAttestationsResponseJson{}
+	case "/eth/v1/beacon/blob_sidecars/{block_id}":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "indices"}}
+		endpoint.GetResponse = &blobSidecarsResponseJson{}
 	case "/eth/v1/beacon/pool/attestations":
 		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "slot"}, {Name: "committee_index"}}
 		endpoint.GetResponse = &attestationsPoolResponseJson{}
@@ -153,8 +164,23 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapSyncCommitteeSignaturesArray,
 		}
+	case "/eth/v1/beacon/pool/bls_to_execution_changes":
+		endpoint.PostRequest = &submitBLSToExecutionChangesRequestJson{}
+		endpoint.Err = &indexedVerificationFailureErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapBLSToExecutionChangesArray,
+		}
 	case "/eth/v1/beacon/weak_subjectivity":
 		endpoint.GetResponse = &WeakSubjectivityResponse{}
+	case "/eth/v1/beacon/rewards/blocks/{block_id}":
+		endpoint.GetResponse = &blockRewardsResponseJson{}
+	case "/eth/v1/beacon/rewards/attestations/{epoch}":
+		endpoint.PostRequest = &dutiesRequestJson{}
+		endpoint.PostResponse = &attestationRewardsResponseJson{}
+		endpoint.RequestURLLiterals = []string{"epoch"}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
 	case "/eth/v1/node/identity":
 		endpoint.GetResponse = &identityResponseJson{}
 	case "/eth/v1/node/peers":
@@ -190,6 +216,8 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.GetResponse = &depositContractResponseJson{}
 	case "/eth/v1/config/spec":
 		endpoint.GetResponse = &specResponseJson{}
+	case "/eth/v1/builder/states/{state_id}/expected_withdrawals":
+		endpoint.GetResponse = &expectedWithdrawalsResponseJson{}
 	case "/eth/v1/events":
 		endpoint.CustomHandlers = []apimiddleware.CustomHandler{handleEvents}
 	case "/eth/v1/validator/duties/attester/{epoch}":
@@ -268,6 +296,27 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapFeeRecipientsArray,
 		}
+	case "/eth/v1/validator/beacon_committee_selections":
+		endpoint.PostRequest = &submitBeaconCommitteeSelectionsRequestJson{}
+		endpoint.PostResponse = &beaconCommitteeSelectionsResponseJson{}
+		endpoint.Err = &nodeSyncDetailsErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapBeaconCommitteeSelectionsArray,
+		}
+	case "/eth/v1/validator/sync_committee_selections":
+		endpoint.PostRequest = &submitSyncCommitteeSelectionsRequestJson{}
+		endpoint.PostResponse = &syncCommitteeSelectionsResponseJson{}
+		endpoint.Err = &nodeSyncDetailsErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapSyncCommitteeSelectionsArray,
+		}
+	case "/eth/v1/validator/liveness/{epoch}":
+		endpoint.PostRequest = &dutiesRequestJson{}
+		endpoint.PostResponse = &livenessResponseJson{}
+		endpoint.RequestURLLiterals = []string{"epoch"}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -78,6 +78,8 @@ type validatorBalancesResponseJson struct {
 type stateCommitteesResponseJson struct {
 	Data                []*committeeJson `json:"data"`
 	ExecutionOptimistic bool             `json:"execution_optimistic"`
+	NextPageToken       string           `json:"next_page_token,omitempty"`
+	TotalSize           int32            `json:"total_size,omitempty"`
 }
 
 type syncCommitteesResponseJson struct {
@@ -115,6 +117,10 @@ type blockAttestationsResponseJson struct {
 	ExecutionOptimistic bool               `json:"execution_optimistic"`
 }
 
+type blobSidecarsResponseJson struct {
+	Data []*blobSidecarJson `json:"data"`
+}
+
 type attestationsPoolResponseJson struct {
 	Data []*attestationJson `json:"data"`
 }
@@ -200,6 +206,21 @@ type specResponseJson struct {
 	Data interface{} `json:"data"`
 }
 
+type expectedWithdrawalsResponseJson struct {
+	Data                []*withdrawalJson `json:"data"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+}
+
+type blockRewardsResponseJson struct {
+	Data                *blockRewardsJson `json:"data"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+}
+
+type attestationRewardsResponseJson struct {
+	Data                *attestationRewardsJson `json:"data"`
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+}
+
 type dutiesRequestJson struct {
 	Index []string `json:"index"`
 }
@@ -277,6 +298,44 @@ type submitContributionAndProofsRequestJson struct {
 	Data []*signedContributionAndProofJson `json:"data"`
 }
 
+type submitBeaconCommitteeSelectionsRequestJson struct {
+	Data []*beaconCommitteeSelectionJson `json:"data"`
+}
+
+type beaconCommitteeSelectionsResponseJson struct {
+	Data []*beaconCommitteeSelectionJson `json:"data"`
+}
+
+type beaconCommitteeSelectionJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Slot           string `json:"slot"`
+	SelectionProof string `json:"selection_proof" hex:"true"`
+}
+
+type submitSyncCommitteeSelectionsRequestJson struct {
+	Data []*syncCommitteeSelectionJson `json:"data"`
+}
+
+type syncCommitteeSelectionsResponseJson struct {
+	Data []*syncCommitteeSelectionJson `json:"data"`
+}
+
+type syncCommitteeSelectionJson struct {
+	ValidatorIndex    string `json:"validator_index"`
+	Slot              string `json:"slot"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	SelectionProof    string `json:"selection_proof" hex:"true"`
+}
+
+type livenessResponseJson struct {
+	Data []*livenessJson `json:"data"`
+}
+
+type livenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -506,6 +565,17 @@ type attestationJson struct {
 	Signature       string               `json:"signature" hex:"true"`
 }
 
+type blobSidecarJson struct {
+	BlockRoot       string `json:"block_root" hex:"true"`
+	Index           string `json:"index"`
+	Slot            string `json:"slot"`
+	BlockParentRoot string `json:"block_parent_root" hex:"true"`
+	ProposerIndex   string `json:"proposer_index"`
+	Blob            string `json:"blob" hex:"true"`
+	KzgCommitment   string `json:"kzg_commitment" hex:"true"`
+	KzgProof        string `json:"kzg_proof" hex:"true"`
+}
+
 type attestationDataJson struct {
 	Slot            string          `json:"slot"`
 	CommitteeIndex  string          `json:"index"`
@@ -536,6 +606,21 @@ type voluntaryExitJson struct {
 	ValidatorIndex string `json:"validator_index"`
 }
 
+type submitBLSToExecutionChangesRequestJson struct {
+	Changes []*signedBLSToExecutionChangeJson `json:"changes"`
+}
+
+type signedBLSToExecutionChangeJson struct {
+	Message   *blsToExecutionChangeJson `json:"message"`
+	Signature string                    `json:"signature" hex:"true"`
+}
+
+type blsToExecutionChangeJson struct {
+	ValidatorIndex     string `json:"validator_index"`
+	FromBlsPubkey      string `json:"from_bls_pubkey" hex:"true"`
+	ToExecutionAddress string `json:"to_execution_address" hex:"true"`
+}
+
 type syncCommitteeMessageJson struct {
 	Slot            string `json:"slot"`
 	BeaconBlockRoot string `json:"beacon_block_root" hex:"true"`
@@ -721,6 +806,43 @@ type depositContractJson struct {
 	Address string `json:"address"`
 }
 
+type blockRewardsJson struct {
+	ProposerIndex     string `json:"proposer_index"`
+	Total             string `json:"total"`
+	Attestations      string `json:"attestations"`
+	SyncAggregate     string `json:"sync_aggregate"`
+	ProposerSlashings string `json:"proposer_slashings"`
+	AttesterSlashings string `json:"attester_slashings"`
+}
+
+type attestationRewardsJson struct {
+	IdealRewards []*idealAttestationRewardsJson `json:"ideal_rewards"`
+	TotalRewards []*totalAttestationRewardsJson `json:"total_rewards"`
+}
+
+type idealAttestationRewardsJson struct {
+	EffectiveBalance string `json:"effective_balance"`
+	Head             string `json:"head"`
+	Target           string `json:"target"`
+	Source           string `json:"source"`
+	Inactivity       string `json:"inactivity"`
+}
+
+type totalAttestationRewardsJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Inactivity     string `json:"inactivity"`
+}
+
+type withdrawalJson struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address" hex:"true"`
+	Amount         string `json:"amount"`
+}
+
 type attesterDutyJson struct {
 	Pubkey                  string `json:"pubkey" hex:"true"`
 	ValidatorIndex          string `json:"validator_index"`
@@ -0,0 +1,44 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlobSidecars retrieves the blob sidecars of the block with given 'block_id', restricted to the requested indices
+// if any are provided. No sidecars are returned for blocks without blobs or whose sidecars were pruned.
+func (bs *Server) GetBlobSidecars(ctx context.Context, req *ethpbv1.BlobSidecarsRequest) (*ethpbv1.BlobSidecarsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetBlobSidecars")
+	defer span.End()
+
+	for _, i := range req.Indices {
+		if i >= fieldparams.MaxBlobsPerBlock {
+			return nil, status.Errorf(codes.InvalidArgument, "Blob index %d is not below the maximum of %d blobs per block", i, fieldparams.MaxBlobsPerBlock)
+		}
+	}
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	if err := handleGetBlockError(blk, err); err != nil {
+		return nil, err
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+	}
+	sidecars, err := bs.BeaconDB.BlobSidecars(ctx, root, req.Indices...)
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "Could not get blob sidecars: %v", err)
+	}
+	data := make([]*ethpbv1.BlobSidecar, len(sidecars))
+	for i, sc := range sidecars {
+		data[i] = migration.V1Alpha1BlobSidecarToV1(sc)
+	}
+	return &ethpbv1.BlobSidecarsResponse{Data: data}, nil
+}
@@ -0,0 +1,80 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestGetBlobSidecars(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 3
+	blk.Block.ProposerIndex = 5
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	sidecars := make([]*ethpbalpha.BlobSidecar, 2)
+	for i := range sidecars {
+		blob := make([]byte, fieldparams.BlobLength)
+		blob[0] = byte(i)
+		sidecars[i] = &ethpbalpha.BlobSidecar{
+			BlockRoot:       root[:],
+			Index:           uint64(i),
+			Slot:            blk.Block.Slot,
+			BlockParentRoot: make([]byte, 32),
+			ProposerIndex:   blk.Block.ProposerIndex,
+			Blob:            blob,
+			KzgCommitment:   make([]byte, 48),
+			KzgProof:        make([]byte, 48),
+		}
+	}
+	require.NoError(t, beaconDB.SaveBlobSidecars(ctx, sidecars))
+	bs := &Server{BeaconDB: beaconDB}
+
+	t.Run("all sidecars", func(t *testing.T) {
+		resp, err := bs.GetBlobSidecars(ctx, &ethpbv1.BlobSidecarsRequest{BlockId: root[:]})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		for i, sc := range resp.Data {
+			assert.Equal(t, uint64(i), sc.Index)
+			assert.Equal(t, blk.Block.Slot, sc.Slot)
+			assert.Equal(t, blk.Block.ProposerIndex, sc.ProposerIndex)
+			assert.DeepEqual(t, root[:], sc.BlockRoot)
+			assert.DeepEqual(t, sidecars[i].Blob, sc.Blob)
+		}
+	})
+	t.Run("requested indices", func(t *testing.T) {
+		resp, err := bs.GetBlobSidecars(ctx, &ethpbv1.BlobSidecarsRequest{BlockId: root[:], Indices: []uint64{1}})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, uint64(1), resp.Data[0].Index)
+	})
+	t.Run("block without sidecars", func(t *testing.T) {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = 4
+		util.SaveBlock(t, ctx, beaconDB, b)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		resp, err := bs.GetBlobSidecars(ctx, &ethpbv1.BlobSidecarsRequest{BlockId: r[:]})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := bs.GetBlobSidecars(ctx, &ethpbv1.BlobSidecarsRequest{BlockId: root[:], Indices: []uint64{fieldparams.MaxBlobsPerBlock}})
+		assert.ErrorContains(t, "is not below the maximum", err)
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		_, err := bs.GetBlobSidecars(ctx, &ethpbv1.BlobSidecarsRequest{BlockId: make([]byte, 32)})
+		assert.ErrorContains(t, "Could not find requested block", err)
+	})
+}
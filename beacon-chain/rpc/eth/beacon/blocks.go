@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -151,6 +152,9 @@ func (bs *Server) ListBlockHeaders(ctx context.Context, req *ethpbv1.BlockHeader
 		}
 	} else {
 		slot := bs.ChainInfoFetcher.HeadSlot()
+		if snapshot := blockchain.HeadSnapshotFromContext(ctx); snapshot != nil {
+			slot = snapshot.Slot()
+		}
 		if req.Slot != nil {
 			slot = *req.Slot
 		}
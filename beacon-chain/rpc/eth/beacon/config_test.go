@@ -135,7 +135,7 @@ func TestGetSpec(t *testing.T) {
 	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	assert.Equal(t, 101, len(resp.Data))
+	assert.Equal(t, 106, len(resp.Data))
 	for k, v := range resp.Data {
 		switch k {
 		case "CONFIG_NAME":
@@ -212,6 +212,8 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "1000", v)
 		case "BLS_WITHDRAWAL_PREFIX":
 			assert.Equal(t, "0x62", v)
+		case "ETH1_ADDRESS_WITHDRAWAL_PREFIX":
+			assert.Equal(t, "0x01", v)
 		case "GENESIS_DELAY":
 			assert.Equal(t, "24", v)
 		case "SECONDS_PER_SLOT":
@@ -270,6 +272,12 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "51", v)
 		case "MAX_VOLUNTARY_EXITS":
 			assert.Equal(t, "52", v)
+		case "MAX_BLS_TO_EXECUTION_CHANGES":
+			assert.Equal(t, "16", v)
+		case "MAX_WITHDRAWALS_PER_PAYLOAD":
+			assert.Equal(t, "16", v)
+		case "MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP":
+			assert.Equal(t, "16384", v)
 		case "TIMELY_HEAD_FLAG_INDEX":
 			assert.Equal(t, "0x35", v)
 		case "TIMELY_SOURCE_FLAG_INDEX":
@@ -326,6 +334,8 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "0x30303037", v)
 		case "DOMAIN_APPLICATION_MASK":
 			assert.Equal(t, "0x31303030", v)
+		case "DOMAIN_BLS_TO_EXECUTION_CHANGE":
+			assert.Equal(t, "0x0a000000", v)
 		case "DOMAIN_SYNC_COMMITTEE":
 			assert.Equal(t, "0x07000000", v)
 		case "DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF":
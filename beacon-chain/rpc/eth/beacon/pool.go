@@ -287,3 +287,65 @@ func (bs *Server) SubmitVoluntaryExit(ctx context.Context, req *ethpbv1.SignedVo
 
 	return &emptypb.Empty{}, nil
 }
+
+// SubmitSignedBLSToExecutionChanges submits said object to the node's pool
+// if it passes validation the node must broadcast it to the network.
+func (bs *Server) SubmitSignedBLSToExecutionChanges(ctx context.Context, req *ethpbv1.SubmitBLSToExecutionChangesRequest) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.SubmitSignedBLSToExecutionChanges")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	var failures []*helpers.SingleIndexedVerificationFailure
+	broadcastFailed := false
+	for i, sourceChange := range req.Changes {
+		change := migration.V1SignedBLSToExecutionChangeToV1Alpha1(sourceChange)
+		if _, err := blocks.ValidateBLSToExecutionChange(headState, change); err != nil {
+			failures = append(failures, &helpers.SingleIndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate SignedBLSToExecutionChange: " + err.Error(),
+			})
+			continue
+		}
+		if err := blocks.VerifyBLSChangeSignature(headState, change); err != nil {
+			failures = append(failures, &helpers.SingleIndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate signature: " + err.Error(),
+			})
+			continue
+		}
+
+		bs.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.BLSToExecutionChangeReceived,
+			Data: &operation.BLSToExecutionChangeReceivedData{
+				Change: change,
+			},
+		})
+		bs.BLSToExecPool.InsertBLSToExecChange(change)
+		if err := bs.Broadcaster.Broadcast(ctx, change); err != nil {
+			broadcastFailed = true
+		}
+	}
+
+	if len(failures) > 0 {
+		failuresContainer := &helpers.IndexedVerificationFailure{Failures: failures}
+		err := grpc.AppendCustomErrorHeader(ctx, failuresContainer)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"One or more BLSToExecutionChange failed validation. Could not prepare BLSToExecutionChange failure information: %v",
+				err,
+			)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "One or more BLSToExecutionChange failed validation")
+	}
+	if broadcastFailed {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Could not broadcast one or more BLSToExecutionChange. Some changes could be broadcast successfully.")
+	}
+	return &emptypb.Empty{}, nil
+}
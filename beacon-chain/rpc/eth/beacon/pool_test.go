@@ -12,12 +12,14 @@ import (
 	blockchainmock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	slashingsmock "github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings/mock"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits/mock"
 	p2pMock "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/config/params"
 	eth2types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
@@ -979,3 +981,64 @@ func TestServer_SubmitAttestations_InvalidAttestationGRPCHeader(t *testing.T) {
 		v,
 	)
 }
+
+func TestSubmitSignedBLSToExecutionChanges(t *testing.T) {
+	ctx := context.Background()
+
+	_, keys, err := util.DeterministicDepositsAndKeys(2)
+	require.NoError(t, err)
+	validators := make([]*ethpbv1alpha1.Validator, len(keys))
+	for i, key := range keys {
+		cred := hash.Hash(key.PublicKey().Marshal())
+		cred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		validators[i] = &ethpbv1alpha1.Validator{
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			PublicKey:             key.PublicKey().Marshal(),
+			WithdrawalCredentials: cred[:],
+		}
+	}
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = validators
+		return nil
+	})
+	require.NoError(t, err)
+
+	changes := make([]*ethpbv1.SignedBLSToExecutionChange, len(keys))
+	for i, key := range keys {
+		message := &ethpbv1alpha1.BLSToExecutionChange{
+			ValidatorIndex:     eth2types.ValidatorIndex(i),
+			FromBlsPubkey:      key.PublicKey().Marshal(),
+			ToExecutionAddress: bytesutil.PadTo([]byte{byte(i)}, 20),
+		}
+		c := params.BeaconConfig()
+		domain, err := signing.ComputeDomain(c.DomainBLSToExecutionChange, c.GenesisForkVersion, bs.GenesisValidatorsRoot())
+		require.NoError(t, err)
+		root, err := signing.ComputeSigningRoot(message, domain)
+		require.NoError(t, err)
+		changes[i] = &ethpbv1.SignedBLSToExecutionChange{
+			Message: &ethpbv1.BLSToExecutionChange{
+				ValidatorIndex:     message.ValidatorIndex,
+				FromBlsPubkey:      message.FromBlsPubkey,
+				ToExecutionAddress: message.ToExecutionAddress,
+			},
+			Signature: key.Sign(root[:]).Marshal(),
+		}
+	}
+	// The second change is signed by the key of the first validator.
+	changes[1].Signature = changes[0].Signature
+
+	broadcaster := &p2pMock.MockBroadcaster{}
+	s := &Server{
+		ChainInfoFetcher:  &blockchainmock.ChainService{State: bs},
+		BLSToExecPool:     blstoexec.NewPool(),
+		Broadcaster:       broadcaster,
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+	}
+
+	_, err = s.SubmitSignedBLSToExecutionChanges(ctx, &ethpbv1.SubmitBLSToExecutionChangesRequest{Changes: changes})
+	assert.ErrorContains(t, "One or more BLSToExecutionChange failed validation", err)
+	pending := s.BLSToExecPool.PendingBLSToExecChanges()
+	require.Equal(t, 1, len(pending))
+	assert.DeepEqual(t, migration.V1SignedBLSToExecutionChangeToV1Alpha1(changes[0]), pending[0])
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+}
@@ -0,0 +1,271 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	corehelpers "github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockRewards returns the rewards the proposer of the block with given 'block_id' received for the attestations,
+// sync aggregate and slashings it included. The rewards are computed by applying the operations of the block to the
+// pre-state of the block, so they are only available for blocks whose parent state can be regenerated.
+func (bs *Server) GetBlockRewards(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetBlockRewards")
+	defer span.End()
+
+	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
+	if err := handleGetBlockError(blk, err); err != nil {
+		return nil, err
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block rewards are not available for blocks before the Altair fork")
+	}
+	if blk.Block().Slot() == params.BeaconConfig().GenesisSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "Block rewards are not available for the genesis block")
+	}
+
+	st, err := bs.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get parent state of block: %v", err)
+	}
+	st, err = transition.ProcessSlots(ctx, st, blk.Block().Slot())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process slots: %v", err)
+	}
+	rewards, err := blockRewards(ctx, st, blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block rewards: %v", err)
+	}
+
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
+	}
+	isOptimistic, err := bs.OptimisticModeFetcher.IsOptimisticForRoot(ctx, blkRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if block is optimistic: %v", err)
+	}
+	return &ethpb.BlockRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
+// blockRewards applies the slashings and attestations of the block to the state, which is the pre-state of the block
+// advanced to its slot, and records the balance increase of the proposer after each operation. The sync aggregate
+// reward is computed from the number of participants, as the proposer may also be a member of the sync committee.
+func blockRewards(ctx context.Context, st state.BeaconState, blk interfaces.SignedBeaconBlock) (*ethpb.BlockRewards, error) {
+	proposerIndex := blk.Block().ProposerIndex()
+	body := blk.Block().Body()
+	syncAggregate, err := body.SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	activeBalance, err := corehelpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	proposerSyncReward, _, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync rewards")
+	}
+
+	balance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer balance")
+	}
+	// balanceIncrease returns the increase of the proposer balance since the previous call.
+	balanceIncrease := func() (uint64, error) {
+		b, err := st.BalanceAtIndex(proposerIndex)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get proposer balance")
+		}
+		increase := uint64(0)
+		if b > balance {
+			increase = b - balance
+		}
+		balance = b
+		return increase, nil
+	}
+
+	rewards := &ethpb.BlockRewards{
+		ProposerIndex: proposerIndex,
+		SyncAggregate: proposerSyncReward * syncAggregate.SyncCommitteeBits.Count(),
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, body.ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process proposer slashings")
+	}
+	if rewards.ProposerSlashings, err = balanceIncrease(); err != nil {
+		return nil, err
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, body.AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attester slashings")
+	}
+	if rewards.AttesterSlashings, err = balanceIncrease(); err != nil {
+		return nil, err
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attestations")
+	}
+	if rewards.Attestations, err = balanceIncrease(); err != nil {
+		return nil, err
+	}
+	rewards.Total = rewards.Attestations + rewards.SyncAggregate + rewards.ProposerSlashings + rewards.AttesterSlashings
+	return rewards, nil
+}
+
+// GetAttestationRewards returns the rewards and penalties the requested validators received for their attestations
+// in the given epoch, along with the rewards of ideal attestations for every effective balance. The rewards of an
+// epoch are applied at the end of the next epoch, so they are only available once that epoch has completed.
+func (bs *Server) GetAttestationRewards(ctx context.Context, req *ethpb.AttestationRewardsRequest) (*ethpb.AttestationRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetAttestationRewards")
+	defer span.End()
+
+	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch+1 >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Attestation rewards for epoch %d are not available until the end of epoch %d, current epoch is %d",
+			req.Epoch,
+			req.Epoch+1,
+			currentEpoch,
+		)
+	}
+	// The last state of the next epoch is the state to which the rewards of the requested epoch are applied.
+	slot, err := slots.EpochEnd(req.Epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch: %v", err)
+	}
+	st, err := bs.attestationRewardsState(ctx, req.Epoch+1, slot)
+	if err != nil {
+		return nil, helpers.PrepareStateFetchGRPCError(err)
+	}
+	if st.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Attestation rewards are not available for epochs before the Altair fork")
+	}
+	for _, index := range req.Index {
+		if uint64(index) >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	}
+	// The state is modified by the epoch processing, so it is hashed for the optimistic status beforehand.
+	rewards, err := attestationRewards(ctx, st, req.Index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute attestation rewards: %v", err)
+	}
+	return &ethpb.AttestationRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
+// attestationRewardsState returns the last state of the epoch, replaying the canonical blocks up to its last slot
+// only if the state is not cached yet.
+func (bs *Server) attestationRewardsState(ctx context.Context, epoch types.Epoch, lastSlot types.Slot) (state.BeaconState, error) {
+	if bs.RewardsStateCache == nil || bs.CanonicalHistory == nil {
+		return bs.StateFetcher.StateBySlot(ctx, lastSlot)
+	}
+	// The last state of the epoch is determined by the last canonical block up to the last slot of the epoch.
+	blockRoot, err := bs.CanonicalHistory.BlockRootForSlot(ctx, lastSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get canonical block root at slot %d", lastSlot)
+	}
+	if st := bs.RewardsStateCache.Get(blockRoot, epoch); st != nil {
+		return st, nil
+	}
+	st, err := bs.StateFetcher.StateBySlot(ctx, lastSlot)
+	if err != nil {
+		return nil, err
+	}
+	bs.RewardsStateCache.Add(blockRoot, epoch, st)
+	return st, nil
+}
+
+// attestationRewards runs the epoch processing of the state up to the rewards and penalties, which depend on the
+// justification and inactivity score updates, and returns the attestation deltas of the previous epoch of the state
+// for the given validators, or for all validators if none is given.
+func attestationRewards(ctx context.Context, st state.BeaconState, indices []types.ValidatorIndex) (*ethpb.AttestationRewards, error) {
+	vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize precompute validators")
+	}
+	vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process epoch participation")
+	}
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bal)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+	st, vals, err = altair.ProcessInactivityScores(ctx, st, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process inactivity updates")
+	}
+	deltas, err := altair.AttestationDeltas(st, bal, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation deltas")
+	}
+
+	cfg := params.BeaconConfig()
+	rewards := &ethpb.AttestationRewards{
+		IdealRewards: make([]*ethpb.IdealAttestationRewards, 0, cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement),
+	}
+	for effectiveBalance := cfg.EffectiveBalanceIncrement; effectiveBalance <= cfg.MaxEffectiveBalance; effectiveBalance += cfg.EffectiveBalanceIncrement {
+		d, err := altair.IdealAttestationDelta(st, bal, effectiveBalance)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute ideal attestation delta")
+		}
+		rewards.IdealRewards = append(rewards.IdealRewards, &ethpb.IdealAttestationRewards{
+			EffectiveBalance: effectiveBalance,
+			Head:             int64(d.HeadReward),
+			Target:           int64(d.TargetReward) - int64(d.TargetPenalty),
+			Source:           int64(d.SourceReward) - int64(d.SourcePenalty),
+			Inactivity:       -int64(d.InactivityPenalty),
+		})
+	}
+
+	if len(indices) == 0 {
+		indices = make([]types.ValidatorIndex, len(deltas))
+		for i := range indices {
+			indices[i] = types.ValidatorIndex(i)
+		}
+	}
+	rewards.TotalRewards = make([]*ethpb.TotalAttestationRewards, len(indices))
+	for i, index := range indices {
+		d := deltas[index]
+		rewards.TotalRewards[i] = &ethpb.TotalAttestationRewards{
+			ValidatorIndex: index,
+			Head:           int64(d.HeadReward),
+			Target:         int64(d.TargetReward) - int64(d.TargetPenalty),
+			Source:         int64(d.SourceReward) - int64(d.SourcePenalty),
+			Inactivity:     -int64(d.InactivityPenalty),
+		}
+	}
+	return rewards, nil
+}
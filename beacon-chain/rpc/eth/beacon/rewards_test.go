@@ -0,0 +1,125 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+func TestGetBlockRewards(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, keys := util.DeterministicGenesisStateAltair(t, 64)
+	committee, err := altair.NextSyncCommittee(ctx, st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	blk, err := util.GenerateFullBlockAltair(st, keys, &util.BlockGenConfig{NumAttestations: 1, NumProposerSlashings: 1}, 1)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(0))
+	util.SaveBlock(t, ctx, beaconDB, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	stateGen := mockstategen.NewMockService()
+	stateGen.StatesByRoot[bytesutil.ToBytes32(blk.Block.ParentRoot)] = st
+	bs := &Server{
+		BeaconDB:              beaconDB,
+		StateGenService:       stateGen,
+		OptimisticModeFetcher: &chainMock.ChainService{},
+	}
+
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	proposerReward, _, err := altair.SyncRewards(activeBalance)
+	require.NoError(t, err)
+
+	resp, err := bs.GetBlockRewards(ctx, &ethpbv1.BlockRequest{BlockId: root[:]})
+	require.NoError(t, err)
+	rewards := resp.Data
+	assert.Equal(t, blk.Block.ProposerIndex, rewards.ProposerIndex)
+	assert.Equal(t, proposerReward*blk.Block.Body.SyncAggregate.SyncCommitteeBits.Count(), rewards.SyncAggregate)
+	assert.Equal(t, true, rewards.Attestations > 0)
+	assert.Equal(t, true, rewards.ProposerSlashings > 0)
+	assert.Equal(t, uint64(0), rewards.AttesterSlashings)
+	assert.Equal(t, rewards.Attestations+rewards.SyncAggregate+rewards.ProposerSlashings, rewards.Total)
+
+	t.Run("phase 0 block", func(t *testing.T) {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = 1
+		util.SaveBlock(t, ctx, beaconDB, b)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		_, err = bs.GetBlockRewards(ctx, &ethpbv1.BlockRequest{BlockId: r[:]})
+		assert.ErrorContains(t, "not available for blocks before the Altair fork", err)
+	})
+}
+
+func TestGetAttestationRewards(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	slot, err := slots.EpochEnd(1)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	// The first half of the validators voted timely for the source, target and head in epoch 0.
+	participation := make([]byte, st.NumValidators())
+	for i := 0; i < len(participation)/2; i++ {
+		participation[i] = 0b111
+	}
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+
+	currentSlot := slot + 1
+	bs := &Server{
+		GenesisTimeFetcher:    &chainMock.ChainService{Slot: &currentSlot},
+		StateFetcher:          &testutil.MockFetcher{BeaconState: st},
+		OptimisticModeFetcher: &chainMock.ChainService{},
+	}
+
+	resp, err := bs.GetAttestationRewards(ctx, &ethpbv1.AttestationRewardsRequest{Epoch: 0})
+	require.NoError(t, err)
+	rewards := resp.Data
+	cfg := params.BeaconConfig()
+	require.Equal(t, int(cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement), len(rewards.IdealRewards))
+	require.Equal(t, st.NumValidators(), len(rewards.TotalRewards))
+	ideal := rewards.IdealRewards[len(rewards.IdealRewards)-1]
+	assert.Equal(t, cfg.MaxEffectiveBalance, ideal.EffectiveBalance)
+
+	attester := rewards.TotalRewards[0]
+	assert.Equal(t, types.ValidatorIndex(0), attester.ValidatorIndex)
+	assert.Equal(t, ideal.Head, attester.Head)
+	assert.Equal(t, ideal.Target, attester.Target)
+	assert.Equal(t, ideal.Source, attester.Source)
+	assert.Equal(t, true, attester.Head > 0 && attester.Target > 0 && attester.Source > 0)
+	absent := rewards.TotalRewards[len(rewards.TotalRewards)-1]
+	assert.Equal(t, int64(0), absent.Head)
+	assert.Equal(t, true, absent.Target < 0 && absent.Source < 0)
+
+	t.Run("requested validators", func(t *testing.T) {
+		resp, err := bs.GetAttestationRewards(ctx, &ethpbv1.AttestationRewardsRequest{Epoch: 0, Index: []types.ValidatorIndex{63, 0}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data.TotalRewards))
+		assert.DeepEqual(t, absent, resp.Data.TotalRewards[0])
+		assert.DeepEqual(t, attester, resp.Data.TotalRewards[1])
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		_, err := bs.GetAttestationRewards(ctx, &ethpbv1.AttestationRewardsRequest{Epoch: 0, Index: []types.ValidatorIndex{64}})
+		assert.ErrorContains(t, "Invalid validator index 64", err)
+	})
+	t.Run("epoch not completed", func(t *testing.T) {
+		_, err := bs.GetAttestationRewards(ctx, &ethpbv1.AttestationRewardsRequest{Epoch: 1})
+		assert.ErrorContains(t, "not available until the end of epoch 2", err)
+	})
+}
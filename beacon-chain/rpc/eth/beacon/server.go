@@ -5,10 +5,12 @@ package beacon
 
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -32,6 +34,7 @@ type Server struct {
 	AttestationsPool              attestations.Pool
 	SlashingsPool                 slashings.PoolManager
 	VoluntaryExitsPool            voluntaryexits.PoolManager
+	BLSToExecPool                 blstoexec.PoolManager
 	StateGenService               stategen.StateManager
 	StateFetcher                  statefetcher.Fetcher
 	HeadFetcher                   blockchain.HeadFetcher
@@ -41,4 +44,6 @@ type Server struct {
 	CanonicalHistory              *stategen.CanonicalHistory
 	HeadUpdater                   blockchain.HeadUpdater
 	ExecutionPayloadReconstructor powchain.ExecutionPayloadReconstructor
+	CommitteesCache               *cache.EpochCommitteesCache
+	RewardsStateCache             *cache.EpochBoundaryStateCache
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
@@ -148,6 +150,45 @@ func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRe
 	}, nil
 }
 
+// GetExpectedWithdrawals returns the withdrawals the next withdrawals sweep would make from the state with
+// given 'stateId'. Withdrawals are only processed from the Capella fork, so states of earlier forks are rejected.
+// The state does not track the position of the sweep yet, so the sweep starts from the first validator and
+// withdrawal index, as it does right after the upgrade to Capella.
+func (bs *Server) GetExpectedWithdrawals(ctx context.Context, req *ethpb.StateRequest) (*ethpb.ExpectedWithdrawalsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetExpectedWithdrawals")
+	defer span.End()
+
+	st, err := bs.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		return nil, helpers.PrepareStateFetchGRPCError(err)
+	}
+	withdrawals, err := blocks.ExpectedWithdrawals(st, 0, 0)
+	if errors.Is(err, blocks.ErrWithdrawalsBeforeCapella) {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get expected withdrawals: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get expected withdrawals: %v", err)
+	}
+	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	}
+
+	data := make([]*ethpb.Withdrawal, len(withdrawals))
+	for i, w := range withdrawals {
+		data[i] = &ethpb.Withdrawal{
+			Index:          w.WithdrawalIndex,
+			ValidatorIndex: w.ValidatorIndex,
+			Address:        w.ExecutionAddress,
+			Amount:         w.Amount,
+		}
+	}
+	return &ethpb.ExpectedWithdrawalsResponse{
+		Data:                data,
+		ExecutionOptimistic: isOptimistic,
+	}, nil
+}
+
 func (bs *Server) stateFromRequest(ctx context.Context, req *stateRequest) (state.BeaconState, error) {
 	if req.epoch != nil {
 		slot, err := slots.EpochStart(*req.epoch)
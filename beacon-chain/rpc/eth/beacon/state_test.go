@@ -9,6 +9,7 @@ import (
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -255,3 +256,52 @@ func TestGetFinalityCheckpoints(t *testing.T) {
 		assert.DeepEqual(t, true, resp.ExecutionOptimistic)
 	})
 }
+
+func TestGetExpectedWithdrawals(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cred := make([]byte, 32)
+	cred[0] = cfg.ETH1AddressWithdrawalPrefixByte
+	cred[12] = 'a'
+	st, err := util.NewBeaconState(func(state *ethpb.BeaconState) error {
+		state.Fork = &ethpb.Fork{
+			PreviousVersion: cfg.BellatrixForkVersion,
+			CurrentVersion:  cfg.CapellaForkVersion,
+		}
+		state.Validators = []*ethpb.Validator{
+			{WithdrawalCredentials: cred, EffectiveBalance: cfg.MaxEffectiveBalance, WithdrawableEpoch: cfg.FarFutureEpoch},
+		}
+		state.Balances = []uint64{cfg.MaxEffectiveBalance + 1}
+		return nil
+	})
+	require.NoError(t, err)
+	chainService := &chainMock.ChainService{Optimistic: true}
+	server := &Server{
+		StateFetcher: &testutil.MockFetcher{
+			BeaconState: st,
+		},
+		OptimisticModeFetcher: chainService,
+	}
+
+	resp, err := server.GetExpectedWithdrawals(ctx, &eth.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(0), resp.Data[0].Index)
+	assert.Equal(t, types.ValidatorIndex(0), resp.Data[0].ValidatorIndex)
+	assert.DeepEqual(t, cred[12:], resp.Data[0].Address)
+	assert.Equal(t, uint64(1), resp.Data[0].Amount)
+	assert.Equal(t, true, resp.ExecutionOptimistic)
+
+	t.Run("before capella", func(t *testing.T) {
+		st, err := util.NewBeaconStateBellatrix()
+		require.NoError(t, err)
+		server := &Server{
+			StateFetcher: &testutil.MockFetcher{
+				BeaconState: st,
+			},
+		}
+		_, err = server.GetExpectedWithdrawals(ctx, &eth.StateRequest{StateId: []byte("head")})
+		assert.ErrorContains(t, "not processed before the Capella fork", err)
+	})
+}
@@ -76,12 +76,18 @@ func (bs *Server) ListSyncCommittees(ctx context.Context, req *ethpbv2.StateSync
 	if requestNextCommittee {
 		// Get the next sync committee and sync committee indices from the state.
 		committeeIndices, committee, err = nextCommitteeIndicesFromState(st)
+		if errors.Is(err, state.ErrSyncCommitteesNotSupported) {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not get next sync committee indices: %v", err)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get next sync committee indices: %v", err)
 		}
 	} else {
 		// Get the current sync committee and sync committee indices from the state.
 		committeeIndices, committee, err = currentCommitteeIndicesFromState(st)
+		if errors.Is(err, state.ErrSyncCommitteesNotSupported) {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not get current sync committee indices: %v", err)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get current sync committee indices: %v", err)
 		}
@@ -121,7 +127,11 @@ func committeeIndicesFromState(st state.BeaconState, committee *ethpbalpha.SyncC
 }
 
 func currentCommitteeIndicesFromState(st state.BeaconState) ([]types.ValidatorIndex, *ethpbalpha.SyncCommittee, error) {
-	committee, err := st.CurrentSyncCommittee()
+	scs, err := st.SyncCommitteeState()
+	if err != nil {
+		return nil, nil, err
+	}
+	committee, err := scs.CurrentSyncCommittee()
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"could not get sync committee: %v", err,
@@ -132,7 +142,11 @@ func currentCommitteeIndicesFromState(st state.BeaconState) ([]types.ValidatorIn
 }
 
 func nextCommitteeIndicesFromState(st state.BeaconState) ([]types.ValidatorIndex, *ethpbalpha.SyncCommittee, error) {
-	committee, err := st.NextSyncCommittee()
+	scs, err := st.SyncCommitteeState()
+	if err != nil {
+		return nil, nil, err
+	}
+	committee, err := scs.NextSyncCommittee()
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"could not get sync committee: %v", err,
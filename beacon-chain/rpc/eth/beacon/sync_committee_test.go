@@ -400,3 +400,11 @@ func TestValidateSyncCommitteeMessage(t *testing.T) {
 		assert.ErrorContains(t, "invalid signature length", err)
 	})
 }
+
+func Test_committeeIndicesFromState_Phase0(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 8)
+	_, _, err := currentCommitteeIndicesFromState(st)
+	require.ErrorIs(t, err, state.ErrSyncCommitteesNotSupported)
+	_, _, err = nextCommitteeIndicesFromState(st)
+	require.ErrorIs(t, err, state.ErrSyncCommitteesNotSupported)
+}
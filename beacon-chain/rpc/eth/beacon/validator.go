@@ -5,10 +5,13 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/pagination"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	corehelpers "github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -101,7 +104,7 @@ func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidators
 	epoch := slots.ToEpoch(st.Slot())
 	filteredVals := make([]*ethpb.ValidatorContainer, 0, len(valContainers))
 	for _, vc := range valContainers {
-		readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(vc.Validator))
+		readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(vc.Validator))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not convert validator: %v", err)
 		}
@@ -153,10 +156,16 @@ func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.Validato
 
 // ListCommittees retrieves the committees for the given state at the given epoch.
 // If the requested slot and index are defined, only those committees are returned.
+// The committees are paginated when a page size or a page token is specified.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon.ListCommittees")
 	defer span.End()
 
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+
 	st, err := bs.StateFetcher.State(ctx, req.StateId)
 	if err != nil {
 		return nil, helpers.PrepareStateFetchGRPCError(err)
@@ -166,11 +175,71 @@ func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommittees
 	if req.Epoch != nil {
 		epoch = *req.Epoch
 	}
+	epochCommittees, err := bs.epochCommittees(ctx, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	committees := make([]*ethpb.Committee, 0)
+	for i, slotCommittees := range epochCommittees.Committees {
+		slot := epochCommittees.StartSlot + types.Slot(i)
+		if req.Slot != nil && slot != *req.Slot {
+			continue
+		}
+		for index, committee := range slotCommittees {
+			if req.Index != nil && types.CommitteeIndex(index) != *req.Index {
+				continue
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      types.CommitteeIndex(index),
+				Slot:       slot,
+				Validators: committee,
+			})
+		}
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	}
+
+	res := &ethpb.StateCommitteesResponse{
+		Data:                committees,
+		ExecutionOptimistic: isOptimistic,
+		TotalSize:           int32(len(committees)),
+	}
+	if (req.PageSize == 0 && req.PageToken == "") || len(committees) == 0 {
+		return res, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(committees))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate committees: %v", err)
+	}
+	res.Data = committees[start:end]
+	res.NextPageToken = nextPageToken
+	return res, nil
+}
+
+// epochCommittees returns the committees of every slot of the epoch computed from the given state.
+// The listing is cached by state root and epoch, so that the shuffling of an epoch is not computed
+// again by subsequent requests.
+func (bs *Server) epochCommittees(ctx context.Context, st state.BeaconState, epoch types.Epoch) (*cache.EpochCommittees, error) {
+	var stateRoot [32]byte
+	if bs.CommitteesCache != nil {
+		var err error
+		stateRoot, err = st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get state root: %v", err)
+		}
+		if committees := bs.CommitteesCache.Get(stateRoot, epoch); committees != nil {
+			return committees, nil
+		}
+	}
+
 	activeCount, err := corehelpers.ActiveValidatorCount(ctx, st, epoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
 	}
-
 	startSlot, err := slots.EpochStart(epoch)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
@@ -180,34 +249,26 @@ func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommittees
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
 	}
 	committeesPerSlot := corehelpers.SlotCommitteeCount(activeCount)
-	committees := make([]*ethpb.Committee, 0)
+	committees := &cache.EpochCommittees{
+		StartSlot:  startSlot,
+		Committees: make([][][]types.ValidatorIndex, 0, endSlot-startSlot+1),
+	}
 	for slot := startSlot; slot <= endSlot; slot++ {
-		if req.Slot != nil && slot != *req.Slot {
-			continue
-		}
+		slotCommittees := make([][]types.ValidatorIndex, committeesPerSlot)
 		for index := types.CommitteeIndex(0); index < types.CommitteeIndex(committeesPerSlot); index++ {
-			if req.Index != nil && index != *req.Index {
-				continue
-			}
 			committee, err := corehelpers.BeaconCommitteeFromState(ctx, st, slot, index)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
 			}
-			committeeContainer := &ethpb.Committee{
-				Index:      index,
-				Slot:       slot,
-				Validators: committee,
-			}
-			committees = append(committees, committeeContainer)
+			slotCommittees[index] = committee
 		}
+		committees.Committees = append(committees.Committees, slotCommittees)
 	}
 
-	isOptimistic, err := helpers.IsOptimistic(ctx, st, bs.OptimisticModeFetcher)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+	if bs.CommitteesCache != nil {
+		bs.CommitteesCache.Add(stateRoot, epoch, committees)
 	}
-
-	return &ethpb.StateCommitteesResponse{Data: committees, ExecutionOptimistic: isOptimistic}, nil
+	return committees, nil
 }
 
 // This function returns the validator object based on the passed in ID. The validator ID could be its public key,
@@ -220,7 +281,7 @@ func valContainersByRequestIds(state state.BeaconState, validatorIds [][]byte) (
 		allValidators := state.Validators()
 		valContainers = make([]*ethpb.ValidatorContainer, len(allValidators))
 		for i, validator := range allValidators {
-			readOnlyVal, err := v1.NewValidator(validator)
+			readOnlyVal, err := statenative.NewValidator(validator)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not convert validator: %v", err)
 			}
@@ -255,7 +316,7 @@ func valContainersByRequestIds(state state.BeaconState, validatorIds [][]byte) (
 				valIndex = types.ValidatorIndex(index)
 			}
 			validator, err := state.ValidatorAtIndex(valIndex)
-			if _, ok := err.(*v1.ValidatorIndexOutOfRangeError); ok {
+			if _, ok := err.(*statenative.ValidatorIndexOutOfRangeError); ok {
 				// Ignore well-formed yet unknown indexes.
 				continue
 			}
@@ -263,7 +324,7 @@ func valContainersByRequestIds(state state.BeaconState, validatorIds [][]byte) (
 				return nil, errors.Wrap(err, "could not get validator")
 			}
 			v1Validator := migration.V1Alpha1ValidatorToV1(validator)
-			readOnlyVal, err := v1.NewValidator(validator)
+			readOnlyVal, err := statenative.NewValidator(validator)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not convert validator: %v", err)
 			}
@@ -284,7 +345,7 @@ func valContainersByRequestIds(state state.BeaconState, validatorIds [][]byte) (
 }
 
 func handleValContainerErr(err error) error {
-	if outOfRangeErr, ok := err.(*v1.ValidatorIndexOutOfRangeError); ok {
+	if outOfRangeErr, ok := err.(*statenative.ValidatorIndexOutOfRangeError); ok {
 		return status.Errorf(codes.InvalidArgument, "Invalid validator ID: %v", outOfRangeErr)
 	}
 	if invalidIdErr, ok := err.(*invalidValidatorIdError); ok {
@@ -3,16 +3,19 @@ package beacon
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"strings"
 	"testing"
 
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	rpchelpers "github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
@@ -381,7 +384,7 @@ func TestListValidators_Status(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, len(resp.Data), 8192+2 /* 2 active */)
 		for _, datum := range resp.Data {
-			readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
+			readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
 			require.NoError(t, err)
 			status, err := rpchelpers.ValidatorStatus(readOnlyVal, 0)
 			require.NoError(t, err)
@@ -418,7 +421,7 @@ func TestListValidators_Status(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, len(resp.Data), 8192+1 /* 1 active_ongoing */)
 		for _, datum := range resp.Data {
-			readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
+			readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
 			require.NoError(t, err)
 			status, err := rpchelpers.ValidatorSubStatus(readOnlyVal, 0)
 			require.NoError(t, err)
@@ -454,7 +457,7 @@ func TestListValidators_Status(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 4 /* 4 exited */, len(resp.Data))
 		for _, datum := range resp.Data {
-			readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
+			readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
 			require.NoError(t, err)
 			status, err := rpchelpers.ValidatorStatus(readOnlyVal, 35)
 			require.NoError(t, err)
@@ -489,7 +492,7 @@ func TestListValidators_Status(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 4 /* 4 exited */, len(resp.Data))
 		for _, datum := range resp.Data {
-			readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
+			readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
 			require.NoError(t, err)
 			status, err := rpchelpers.ValidatorSubStatus(readOnlyVal, 35)
 			require.NoError(t, err)
@@ -524,7 +527,7 @@ func TestListValidators_Status(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 2 /* 1 pending, 1 exited */, len(resp.Data))
 		for _, datum := range resp.Data {
-			readOnlyVal, err := v1.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
+			readOnlyVal, err := statenative.NewValidator(migration.V1ValidatorToV1Alpha1(datum.Validator))
 			require.NoError(t, err)
 			status, err := rpchelpers.ValidatorStatus(readOnlyVal, 35)
 			require.NoError(t, err)
@@ -794,6 +797,84 @@ func TestListCommittees(t *testing.T) {
 		}
 	})
 
+	t.Run("Paginated Committees", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			StateFetcher: &testutil.MockFetcher{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			BeaconDB:              db,
+		}
+
+		total := int(params.BeaconConfig().SlotsPerEpoch) * 2
+		resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId:  []byte("head"),
+			PageSize: 8,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 8, len(resp.Data))
+		assert.Equal(t, int32(total), resp.TotalSize)
+		assert.Equal(t, "1", resp.NextPageToken)
+		assert.Equal(t, types.Slot(2), resp.Data[4].Slot)
+		assert.Equal(t, types.CommitteeIndex(0), resp.Data[4].Index)
+
+		resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId:   []byte("head"),
+			PageSize:  8,
+			PageToken: strconv.Itoa(total/8 - 1),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 8, len(resp.Data))
+		assert.Equal(t, "", resp.NextPageToken)
+
+		_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId:   []byte("head"),
+			PageSize:  8,
+			PageToken: strconv.Itoa(total / 8),
+		})
+		assert.ErrorContains(t, "Could not paginate committees", err)
+
+		_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId:  []byte("head"),
+			PageSize: int32(cmd.Get().MaxRPCPageSize + 1),
+		})
+		assert.ErrorContains(t, "can not be greater than max size", err)
+	})
+
+	t.Run("Cached Committees", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			StateFetcher: &testutil.MockFetcher{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			BeaconDB:              db,
+			CommitteesCache:       cache.NewEpochCommitteesCache(),
+		}
+		stateRoot, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+
+		want, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId: []byte("head"),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, s.CommitteesCache.Get(stateRoot, epoch))
+
+		index := types.CommitteeIndex(1)
+		resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{
+			StateId: []byte("head"),
+			Index:   &index,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(resp.Data))
+		for i, datum := range resp.Data {
+			assert.DeepEqual(t, want.Data[2*i+1], datum)
+		}
+	})
+
 	t.Run("execution optimistic", func(t *testing.T) {
 		parentRoot := [32]byte{'a'}
 		blk := util.NewBeaconBlock()
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"bytes"
 	"strings"
 
 	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
@@ -12,6 +13,7 @@ import (
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -33,6 +35,8 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// ProposerDutiesTopic represents a change of the proposer duties of the head epoch event topic.
+	ProposerDutiesTopic = "proposer_duties"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +47,7 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	ProposerDutiesTopic:            true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -80,6 +85,9 @@ func (s *Server) StreamEvents(
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
 
+	// The dependent root of the last proposer duties streamed.
+	var proposerDutiesRoot []byte
+
 	// Handle each event received and context cancelation.
 	for {
 		select {
@@ -95,6 +103,11 @@ func (s *Server) StreamEvents(
 			if err := handleStateEvents(stream, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle state event: %v", err)
 			}
+			root, err := s.handleProposerDutiesEvents(stream, requestedTopics, event, proposerDutiesRoot)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not handle proposer duties event: %v", err)
+			}
+			proposerDutiesRoot = root
 		case <-s.Ctx.Done():
 			return status.Errorf(codes.Canceled, "Context canceled")
 		case <-stream.Context().Done():
@@ -219,6 +232,33 @@ func handleStateEvents(
 	}
 }
 
+// handleProposerDutiesEvents streams the proposer duties of the epoch of a new head, whenever the head
+// changes the block root the duties depend on. It returns the dependent root of the last duties streamed.
+func (s *Server) handleProposerDutiesEvents(
+	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event, lastDependentRoot []byte,
+) ([]byte, error) {
+	if event.Type != statefeed.NewHead {
+		return lastDependentRoot, nil
+	}
+	if _, ok := requestedTopics[ProposerDutiesTopic]; !ok || s.ProposerDutiesFetcher == nil {
+		return lastDependentRoot, nil
+	}
+	head, ok := event.Data.(*ethpb.EventHead)
+	if !ok || bytes.Equal(head.CurrentDutyDependentRoot, lastDependentRoot) {
+		return lastDependentRoot, nil
+	}
+	duties, err := s.ProposerDutiesFetcher.GetProposerDuties(stream.Context(), &ethpb.ProposerDutiesRequest{
+		Epoch: slots.ToEpoch(head.Slot),
+	})
+	if err != nil {
+		return lastDependentRoot, err
+	}
+	if err := streamData(stream, ProposerDutiesTopic, duties); err != nil {
+		return lastDependentRoot, err
+	}
+	return head.CurrentDutyDependentRoot, nil
+}
+
 func streamData(stream ethpbservice.Events_StreamEventsServer, name string, data proto.Message) error {
 	returnData, err := anypb.New(data)
 	if err != nil {
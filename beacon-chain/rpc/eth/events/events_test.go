@@ -335,6 +335,41 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(ProposerDutiesTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedDuties := &ethpb.ProposerDutiesResponse{
+			DependentRoot: make([]byte, 32),
+			Data: []*ethpb.ProposerDuty{
+				{Pubkey: make([]byte, 48), ValidatorIndex: 1, Slot: 8},
+			},
+		}
+		srv.ProposerDutiesFetcher = &mockProposerDutiesFetcher{duties: wantedDuties}
+		genericResponse, err := anypb.New(wantedDuties)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: ProposerDutiesTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{ProposerDutiesTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.NewHead,
+				Data: &ethpb.EventHead{
+					Slot:                     8,
+					CurrentDutyDependentRoot: make([]byte, 32),
+				},
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
@@ -436,3 +471,11 @@ func assertFeedSendAndReceive(ctx context.Context, args *assertFeedArgs) {
 	}
 	<-exitRoutine
 }
+
+type mockProposerDutiesFetcher struct {
+	duties *ethpb.ProposerDutiesResponse
+}
+
+func (m *mockProposerDutiesFetcher) GetProposerDuties(_ context.Context, _ *ethpb.ProposerDutiesRequest) (*ethpb.ProposerDutiesResponse, error) {
+	return m.duties, nil
+}
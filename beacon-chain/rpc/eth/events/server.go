@@ -9,6 +9,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
 )
 
 // Server defines a server implementation of the gRPC events service,
@@ -18,4 +19,11 @@ type Server struct {
 	StateNotifier     statefeed.Notifier
 	BlockNotifier     blockfeed.Notifier
 	OperationNotifier opfeed.Notifier
+	// ProposerDutiesFetcher computes the proposer duties streamed on the proposer duties topic.
+	ProposerDutiesFetcher ProposerDutiesFetcher
+}
+
+// ProposerDutiesFetcher computes the proposer duties of an epoch.
+type ProposerDutiesFetcher interface {
+	GetProposerDuties(ctx context.Context, req *ethpb.ProposerDutiesRequest) (*ethpb.ProposerDutiesResponse, error)
 }
@@ -13,19 +13,23 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	rpchelpers "github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -63,7 +67,7 @@ func (vs *Server) GetAttesterDuties(ctx context.Context, req *ethpbv1.AttesterDu
 		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
 	}
 
-	s, err = advanceState(ctx, s, req.Epoch, currentEpoch)
+	s, err = vs.advanceState(ctx, s, req.Epoch, currentEpoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not advance state to requested epoch start slot: %v", err)
 	}
@@ -147,7 +151,7 @@ func (vs *Server) GetProposerDuties(ctx context.Context, req *ethpbv1.ProposerDu
 		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
 	}
 
-	s, err = advanceState(ctx, s, req.Epoch, currentEpoch)
+	s, err = vs.advanceState(ctx, s, req.Epoch, currentEpoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not advance state to requested epoch start slot: %v", err)
 	}
@@ -227,6 +231,10 @@ func (vs *Server) GetSyncCommitteeDuties(ctx context.Context, req *ethpbv2.SyncC
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get sync committee state: %v", err)
 	}
+	scs, err := st.SyncCommitteeState()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get sync committees: %v", err)
+	}
 
 	currentSyncCommitteeFirstEpoch, err := slots.SyncCommitteePeriodStartEpoch(requestedEpoch)
 	if err != nil {
@@ -235,12 +243,12 @@ func (vs *Server) GetSyncCommitteeDuties(ctx context.Context, req *ethpbv2.SyncC
 	nextSyncCommitteeFirstEpoch := currentSyncCommitteeFirstEpoch + params.BeaconConfig().EpochsPerSyncCommitteePeriod
 	var committee *ethpbalpha.SyncCommittee
 	if req.Epoch >= nextSyncCommitteeFirstEpoch {
-		committee, err = st.NextSyncCommittee()
+		committee, err = scs.NextSyncCommittee()
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get sync committee: %v", err)
 		}
 	} else {
-		committee, err = st.CurrentSyncCommittee()
+		committee, err = scs.CurrentSyncCommittee()
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not get sync committee: %v", err)
 		}
@@ -269,6 +277,60 @@ func (vs *Server) GetSyncCommitteeDuties(ctx context.Context, req *ethpbv2.SyncC
 	}, nil
 }
 
+// GetLiveness requests the beacon node to indicate whether the given validators were observed to be live in the given
+// epoch. A validator is live if any of its attestations for the epoch was included in the chain, which is determined
+// from the participation flags of the head state for the current and previous epochs. Attestations of an epoch can be
+// included until the end of the next epoch, so earlier epochs use the state at the end of the next epoch.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpbv2.LivenessRequest) (*ethpbv2.LivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetLiveness")
+	defer span.End()
+
+	headSt, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := slots.ToEpoch(headSt.Slot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch %d is in the future, current epoch is %d", req.Epoch, currentEpoch)
+	}
+
+	st := headSt
+	if req.Epoch+1 < currentEpoch {
+		slot, err := slots.EpochEnd(req.Epoch + 1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get end slot of epoch: %v", err)
+		}
+		st, err = vs.StateFetcher.StateBySlot(ctx, slot)
+		if err != nil {
+			return nil, rpchelpers.PrepareStateFetchGRPCError(err)
+		}
+	}
+	if st.Version() == version.Phase0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Liveness is not available for epochs before the Altair fork")
+	}
+	var participation []byte
+	if req.Epoch+1 == slots.ToEpoch(st.Slot()) {
+		participation, err = st.PreviousEpochParticipation()
+	} else {
+		participation, err = st.CurrentEpochParticipation()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get epoch participation: %v", err)
+	}
+
+	data := make([]*ethpbv2.ValidatorLiveness, len(req.Index))
+	for i, index := range req.Index {
+		if uint64(index) >= uint64(len(participation)) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", index)
+		}
+		data[i] = &ethpbv2.ValidatorLiveness{
+			Index:  index,
+			IsLive: participation[index] != 0,
+		}
+	}
+	return &ethpbv2.LivenessResponse{Data: data}, nil
+}
+
 // ProduceBlock requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
 func (vs *Server) ProduceBlock(ctx context.Context, req *ethpbv1.ProduceBlockRequest) (*ethpbv1.ProduceBlockResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.ProduceBlock")
@@ -703,7 +765,7 @@ func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *et
 	validators := make([]state.ReadOnlyValidator, len(req.Data))
 	for i, sub := range req.Data {
 		val, err := s.ValidatorAtIndexReadOnly(sub.ValidatorIndex)
-		if outOfRangeErr, ok := err.(*statev1.ValidatorIndexOutOfRangeError); ok {
+		if outOfRangeErr, ok := err.(*statenative.ValidatorIndexOutOfRangeError); ok {
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator ID: %v", outOfRangeErr)
 		}
 		validators[i] = val
@@ -833,26 +895,19 @@ func (vs *Server) ProduceSyncCommitteeContribution(
 	ctx context.Context,
 	req *ethpbv2.ProduceSyncCommitteeContributionRequest,
 ) (*ethpbv2.ProduceSyncCommitteeContributionResponse, error) {
-	ctx, span := trace.StartSpan(ctx, "validator.ProduceSyncCommitteeContribution")
+	_, span := trace.StartSpan(ctx, "validator.ProduceSyncCommitteeContribution")
 	defer span.End()
 
-	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(req.Slot)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee messages: %v", err)
-	}
-	if msgs == nil {
+	c := vs.SyncCommitteePool.SyncCommitteeContribution(req.Slot, bytesutil.ToBytes32(req.BeaconBlockRoot), req.SubcommitteeIndex)
+	if c == nil {
 		return nil, status.Errorf(codes.NotFound, "No subcommittee messages found")
 	}
-	aggregatedSig, bits, err := vs.V1Alpha1Server.AggregatedSigAndAggregationBits(ctx, msgs, req.Slot, req.SubcommitteeIndex, req.BeaconBlockRoot)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get contribution data: %v", err)
-	}
 	contribution := &ethpbv2.SyncCommitteeContribution{
-		Slot:              req.Slot,
-		BeaconBlockRoot:   req.BeaconBlockRoot,
-		SubcommitteeIndex: req.SubcommitteeIndex,
-		AggregationBits:   bits,
-		Signature:         aggregatedSig,
+		Slot:              c.Slot,
+		BeaconBlockRoot:   c.BlockRoot,
+		SubcommitteeIndex: c.SubcommitteeIndex,
+		AggregationBits:   c.AggregationBits,
+		Signature:         c.Signature,
 	}
 
 	return &ethpbv2.ProduceSyncCommitteeContributionResponse{
@@ -890,6 +945,266 @@ func (vs *Server) SubmitContributionAndProofs(ctx context.Context, req *ethpbv2.
 	return &empty.Empty{}, nil
 }
 
+// SubmitBeaconCommitteeSelections aggregates the partial attestation aggregation selection proofs of
+// distributed validators, verifies the aggregated proofs and returns them. Proofs submitted for the same
+// validator and slot are signed with additive shares of the validator key by the members of the cluster.
+// Validators selected to aggregate have their subnets registered for aggregation, the same way as
+// SubmitBeaconCommitteeSubscription does for aggregator subscriptions.
+func (vs *Server) SubmitBeaconCommitteeSelections(
+	ctx context.Context,
+	req *ethpbv1.BeaconCommitteeSelectionsRequest,
+) (*ethpbv1.BeaconCommitteeSelectionsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitBeaconCommitteeSelections")
+	defer span.End()
+
+	if err := rpchelpers.ValidateSync(ctx, vs.SyncChecker, vs.HeadFetcher, vs.TimeFetcher, vs.OptimisticModeFetcher); err != nil {
+		// We simply return the error because it's already a gRPC error.
+		return nil, err
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No selections provided")
+	}
+
+	type selectionKey struct {
+		validatorIndex types.ValidatorIndex
+		slot           types.Slot
+	}
+	selections := make([]*ethpbv1.BeaconCommitteeSelection, 0, len(req.Data))
+	partialProofs := make(map[selectionKey][][]byte)
+	for i, sel := range req.Data {
+		if sel == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection at index %d can't be nil", i)
+		}
+		if err := validateSelectionProof(sel.SelectionProof); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid selection proof at index %d: %v", i, err)
+		}
+		key := selectionKey{validatorIndex: sel.ValidatorIndex, slot: sel.Slot}
+		if _, ok := partialProofs[key]; !ok {
+			selections = append(selections, &ethpbv1.BeaconCommitteeSelection{ValidatorIndex: sel.ValidatorIndex, Slot: sel.Slot})
+		}
+		partialProofs[key] = append(partialProofs[key], sel.SelectionProof)
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+
+	type aggregatorSubnet struct {
+		slot   types.Slot
+		subnet uint64
+	}
+	aggregatorSubnets := make([]aggregatorSubnet, 0, len(selections))
+	assignments := make(map[types.Epoch]map[types.ValidatorIndex]*helpers.CommitteeAssignmentContainer)
+	activeValidatorCounts := make(map[types.Epoch]uint64)
+	for _, sel := range selections {
+		proof, err := aggregateSelectionProofs(partialProofs[selectionKey{validatorIndex: sel.ValidatorIndex, slot: sel.Slot}])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not aggregate selection proofs of validator %d at slot %d: %v", sel.ValidatorIndex, sel.Slot, err)
+		}
+		sel.SelectionProof = proof
+
+		epoch := slots.ToEpoch(sel.Slot)
+		if epoch > currentEpoch+1 {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection slot %d of validator %d can not be later than next epoch %d", sel.Slot, sel.ValidatorIndex, currentEpoch+1)
+		}
+		if epoch+1 < currentEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection slot %d of validator %d can not be earlier than previous epoch %d", sel.Slot, sel.ValidatorIndex, currentEpoch-1)
+		}
+		if _, err := s.ValidatorAtIndexReadOnly(sel.ValidatorIndex); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d: %v", sel.ValidatorIndex, err)
+		}
+		slotMsg := types.SSZUint64(sel.Slot)
+		if err := signing.ComputeDomainVerifySigningRoot(s, sel.ValidatorIndex, epoch, &slotMsg, params.BeaconConfig().DomainSelectionProof, sel.SelectionProof); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection proof at slot %d is not a valid signature of validator %d: %v", sel.Slot, sel.ValidatorIndex, err)
+		}
+
+		committees, ok := assignments[epoch]
+		if !ok {
+			epochState, err := vs.advanceState(ctx, s.Copy(), epoch, currentEpoch)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not advance state to requested epoch start slot: %v", err)
+			}
+			committees, _, err = helpers.CommitteeAssignments(ctx, epochState, epoch)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+			}
+			activeValidatorCount, err := helpers.ActiveValidatorCount(ctx, epochState, epoch)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+			}
+			assignments[epoch] = committees
+			activeValidatorCounts[epoch] = activeValidatorCount
+		}
+		assignment := committees[sel.ValidatorIndex]
+		if assignment == nil || assignment.AttesterSlot != sel.Slot {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not scheduled to attest at slot %d", sel.ValidatorIndex, sel.Slot)
+		}
+		isAggregator, err := helpers.IsAggregator(uint64(len(assignment.Committee)), sel.SelectionProof)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not check if validator %d is an aggregator: %v", sel.ValidatorIndex, err)
+		}
+		if isAggregator {
+			aggregatorSubnets = append(aggregatorSubnets, aggregatorSubnet{
+				slot:   sel.Slot,
+				subnet: helpers.ComputeSubnetFromCommitteeAndSlot(activeValidatorCounts[epoch], assignment.CommitteeIndex, sel.Slot),
+			})
+		}
+	}
+
+	for _, a := range aggregatorSubnets {
+		cache.SubnetIDs.AddAggregatorSubnetID(a.slot, a.subnet)
+	}
+
+	return &ethpbv1.BeaconCommitteeSelectionsResponse{Data: selections}, nil
+}
+
+// SubmitSyncCommitteeSelections aggregates the partial sync committee aggregation selection proofs of
+// distributed validators, verifies the aggregated proofs and returns them. Proofs submitted for the same
+// validator, slot and subcommittee index are signed with additive shares of the validator key by the
+// members of the cluster.
+func (vs *Server) SubmitSyncCommitteeSelections(
+	ctx context.Context,
+	req *ethpbv2.SyncCommitteeSelectionsRequest,
+) (*ethpbv2.SyncCommitteeSelectionsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitSyncCommitteeSelections")
+	defer span.End()
+
+	if err := rpchelpers.ValidateSync(ctx, vs.SyncChecker, vs.HeadFetcher, vs.TimeFetcher, vs.OptimisticModeFetcher); err != nil {
+		// We simply return the error because it's already a gRPC error.
+		return nil, err
+	}
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No selections provided")
+	}
+
+	type selectionKey struct {
+		validatorIndex    types.ValidatorIndex
+		slot              types.Slot
+		subcommitteeIndex uint64
+	}
+	selections := make([]*ethpbv2.SyncCommitteeSelection, 0, len(req.Data))
+	partialProofs := make(map[selectionKey][][]byte)
+	for i, sel := range req.Data {
+		if sel == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection at index %d can't be nil", i)
+		}
+		if err := validateSelectionProof(sel.SelectionProof); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid selection proof at index %d: %v", i, err)
+		}
+		if sel.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid subcommittee index %d at index %d", sel.SubcommitteeIndex, i)
+		}
+		key := selectionKey{validatorIndex: sel.ValidatorIndex, slot: sel.Slot, subcommitteeIndex: sel.SubcommitteeIndex}
+		if _, ok := partialProofs[key]; !ok {
+			selections = append(selections, &ethpbv2.SyncCommitteeSelection{
+				ValidatorIndex:    sel.ValidatorIndex,
+				Slot:              sel.Slot,
+				SubcommitteeIndex: sel.SubcommitteeIndex,
+			})
+		}
+		partialProofs[key] = append(partialProofs[key], sel.SelectionProof)
+	}
+
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	scs, err := s.SyncCommitteeState()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Sync committee selections are not supported before Altair")
+	}
+	headPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(s.Slot()))
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+
+	for _, sel := range selections {
+		proof, err := aggregateSelectionProofs(partialProofs[selectionKey{
+			validatorIndex:    sel.ValidatorIndex,
+			slot:              sel.Slot,
+			subcommitteeIndex: sel.SubcommitteeIndex,
+		}])
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not aggregate selection proofs of validator %d at slot %d: %v", sel.ValidatorIndex, sel.Slot, err)
+		}
+		sel.SelectionProof = proof
+
+		val, err := s.ValidatorAtIndexReadOnly(sel.ValidatorIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d: %v", sel.ValidatorIndex, err)
+		}
+
+		epoch := slots.ToEpoch(sel.Slot)
+		if epoch+1 < currentEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection slot %d of validator %d can not be earlier than previous epoch %d", sel.Slot, sel.ValidatorIndex, currentEpoch-1)
+		}
+		var committee *ethpbalpha.SyncCommittee
+		switch slots.SyncCommitteePeriod(epoch) {
+		case headPeriod:
+			committee, err = scs.CurrentSyncCommittee()
+		case headPeriod + 1:
+			committee, err = scs.NextSyncCommittee()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Selection slot %d of validator %d is not in the current or next sync committee period", sel.Slot, sel.ValidatorIndex)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get sync committee: %v", err)
+		}
+		subCommitteePubkeys, err := altair.SyncSubCommitteePubkeys(committee, types.CommitteeIndex(sel.SubcommitteeIndex))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee pubkeys: %v", err)
+		}
+		pubkey := val.PublicKey()
+		isMember := false
+		for _, pk := range subCommitteePubkeys {
+			if bytes.Equal(pk, pubkey[:]) {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not a member of sync subcommittee %d", sel.ValidatorIndex, sel.SubcommitteeIndex)
+		}
+
+		selectionData := &ethpbalpha.SyncAggregatorSelectionData{Slot: sel.Slot, SubcommitteeIndex: sel.SubcommitteeIndex}
+		if err := signing.ComputeDomainVerifySigningRoot(s, sel.ValidatorIndex, epoch, selectionData, params.BeaconConfig().DomainSyncCommitteeSelectionProof, sel.SelectionProof); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Selection proof at slot %d is not a valid signature of validator %d: %v", sel.Slot, sel.ValidatorIndex, err)
+		}
+	}
+
+	return &ethpbv2.SyncCommitteeSelectionsResponse{Data: selections}, nil
+}
+
+// validateSelectionProof checks the selection proof is a non-empty signature of the expected length.
+func validateSelectionProof(proof []byte) error {
+	if len(proof) != fieldparams.BLSSignatureLength {
+		return fmt.Errorf("incorrect signature length, expected %d bytes", fieldparams.BLSSignatureLength)
+	}
+	if bytes.Equal(proof, make([]byte, fieldparams.BLSSignatureLength)) {
+		return errors.New("signature can't be zero hash")
+	}
+	return nil
+}
+
+// aggregateSelectionProofs aggregates the partial selection proofs of the members of a distributed validator
+// cluster into the selection proof of the validator. A single proof is returned as is.
+func aggregateSelectionProofs(proofs [][]byte) ([]byte, error) {
+	if len(proofs) == 1 {
+		return proofs[0], nil
+	}
+	sigs := make([]bls.Signature, len(proofs))
+	for i, proof := range proofs {
+		sig, err := bls.SignatureFromBytes(proof)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return bls.AggregateSignatures(sigs).Marshal(), nil
+}
+
 // attestationDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1)
 // or the genesis block root in the case of underflow.
 func attestationDependentRoot(s state.BeaconState, epoch types.Epoch) ([]byte, error) {
@@ -944,25 +1259,36 @@ func (vs *Server) proposalDependentRoot(ctx context.Context, s state.BeaconState
 // advanceState advances state with empty transitions up to the requested epoch start slot.
 // In case 1 epoch ahead was requested, we take the start slot of the current epoch.
 // Taking the start slot of the next epoch would result in an error inside transition.ProcessSlots.
-func advanceState(ctx context.Context, s state.BeaconState, requestedEpoch, currentEpoch types.Epoch) (state.BeaconState, error) {
-	var epochStartSlot types.Slot
-	var err error
+// The head state is only advanced if the state of that epoch start slot is not cached yet.
+func (vs *Server) advanceState(ctx context.Context, s state.BeaconState, requestedEpoch, currentEpoch types.Epoch) (state.BeaconState, error) {
+	epoch := requestedEpoch
 	if requestedEpoch == currentEpoch+1 {
-		epochStartSlot, err = slots.EpochStart(requestedEpoch.Sub(1))
+		epoch = requestedEpoch.Sub(1)
+	}
+	epochStartSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not obtain epoch's start slot")
+	}
+
+	var headRoot [32]byte
+	cacheable := vs.BoundaryStateCache != nil && s.Slot() < epochStartSlot
+	if cacheable {
+		r, err := vs.HeadFetcher.HeadRoot(ctx)
 		if err != nil {
-			return nil, errors.Wrap(err, "Could not obtain epoch's start slot")
+			return nil, errors.Wrap(err, "Could not get head root")
 		}
-	} else {
-		epochStartSlot, err = slots.EpochStart(requestedEpoch)
-		if err != nil {
-			return nil, errors.Wrap(err, "Could not obtain epoch's start slot")
+		headRoot = bytesutil.ToBytes32(r)
+		if st := vs.BoundaryStateCache.Get(headRoot, epoch); st != nil {
+			return st, nil
 		}
 	}
 	s, err = transition.ProcessSlotsIfPossible(ctx, s, epochStartSlot)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Could not process slots up to %d", epochStartSlot)
 	}
-
+	if cacheable {
+		vs.BoundaryStateCache.Add(headRoot, epoch, s)
+	}
 	return s, nil
 }
 
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
@@ -655,6 +657,62 @@ func TestSyncCommitteeDutiesLastValidEpoch(t *testing.T) {
 	})
 }
 
+func TestGetLiveness(t *testing.T) {
+	ctx := context.Background()
+	headSt, _ := util.DeterministicGenesisStateAltair(t, 4)
+	require.NoError(t, headSt.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+	require.NoError(t, headSt.SetPreviousParticipationBits([]byte{0, 1, 0, 0}))
+	require.NoError(t, headSt.SetCurrentParticipationBits([]byte{0, 0, 1, 0}))
+	oldSt, _ := util.DeterministicGenesisStateAltair(t, 4)
+	require.NoError(t, oldSt.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+	require.NoError(t, oldSt.SetPreviousParticipationBits([]byte{0, 0, 0, 7}))
+	require.NoError(t, oldSt.SetCurrentParticipationBits([]byte{7, 0, 0, 0}))
+
+	vs := &Server{
+		HeadFetcher:  &mockChain.ChainService{State: headSt},
+		StateFetcher: &testutil.MockFetcher{BeaconState: oldSt},
+	}
+	indices := []types.ValidatorIndex{0, 1, 2, 3}
+	live := func(resp *ethpbv2.LivenessResponse) []bool {
+		l := make([]bool, len(resp.Data))
+		for i, d := range resp.Data {
+			assert.Equal(t, indices[i], d.Index)
+			l[i] = d.IsLive
+		}
+		return l
+	}
+
+	t.Run("current epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 3, Index: indices})
+		require.NoError(t, err)
+		assert.DeepEqual(t, []bool{false, false, true, false}, live(resp))
+	})
+	t.Run("previous epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 2, Index: indices})
+		require.NoError(t, err)
+		assert.DeepEqual(t, []bool{false, true, false, false}, live(resp))
+	})
+	t.Run("earlier epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 0, Index: indices})
+		require.NoError(t, err)
+		assert.DeepEqual(t, []bool{false, false, false, true}, live(resp))
+	})
+	t.Run("future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 4, Index: indices})
+		assert.ErrorContains(t, "Requested epoch 4 is in the future", err)
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 3, Index: []types.ValidatorIndex{4}})
+		assert.ErrorContains(t, "Invalid validator index 4", err)
+	})
+	t.Run("phase 0", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisState(t, 4)
+		vs := &Server{HeadFetcher: &mockChain.ChainService{State: st}}
+		_, err := vs.GetLiveness(ctx, &ethpbv2.LivenessRequest{Epoch: 0, Index: indices})
+		assert.ErrorContains(t, "not available for epochs before the Altair fork", err)
+	})
+}
+
 func TestProduceBlock(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -3497,7 +3555,7 @@ func TestProduceSyncCommitteeContribution(t *testing.T) {
 		Signature:      sig,
 	}
 	syncCommitteePool := synccommittee.NewStore()
-	require.NoError(t, syncCommitteePool.SaveSyncCommitteeMessage(messsage))
+	require.NoError(t, syncCommitteePool.AggregateSyncCommitteeMessage(messsage, []types.CommitteeIndex{0}))
 	v1Server := &v1alpha1validator.Server{
 		SyncCommitteePool: syncCommitteePool,
 		HeadFetcher: &mockChain.ChainService{
@@ -3657,6 +3715,383 @@ func TestSubmitContributionAndProofs(t *testing.T) {
 	})
 }
 
+func TestSubmitBeaconCommitteeSelections(t *testing.T) {
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 64)
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{
+		State: bs, Slot: &chainSlot,
+	}
+	vs := &Server{
+		HeadFetcher:           chain,
+		TimeFetcher:           chain,
+		OptimisticModeFetcher: chain,
+		SyncChecker:           &mockSync.Sync{IsSyncing: false},
+	}
+
+	assignments, _, err := helpers.CommitteeAssignments(ctx, bs, 0)
+	require.NoError(t, err)
+	valIdx := types.ValidatorIndex(1)
+	slot := assignments[valIdx].AttesterSlot
+	selectionProof := func(idx types.ValidatorIndex, s types.Slot) []byte {
+		slotMsg := types.SSZUint64(s)
+		proof, err := signing.ComputeDomainAndSign(bs, 0, &slotMsg, params.BeaconConfig().DomainSelectionProof, keys[idx])
+		require.NoError(t, err)
+		return proof
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		sel := &ethpbv1.BeaconCommitteeSelection{
+			ValidatorIndex: valIdx,
+			Slot:           slot,
+			SelectionProof: selectionProof(valIdx, slot),
+		}
+		resp, err := vs.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{sel},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, sel, resp.Data[0])
+		// Committees are small enough for every member to be selected as an aggregator.
+		assert.Equal(t, 1, len(cache.SubnetIDs.GetAggregatorSubnetIDs(slot)))
+	})
+
+	t.Run("Partial proofs", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		// The validator key is split into additive shares between the members of the cluster.
+		share1, err := bls.RandKey()
+		require.NoError(t, err)
+		share2, err := bls.RandKey()
+		require.NoError(t, err)
+		dvState := bs.Copy()
+		val, err := dvState.ValidatorAtIndex(valIdx)
+		require.NoError(t, err)
+		val.PublicKey = bls.AggregateMultiplePubkeys([]bls.PublicKey{share1.PublicKey(), share2.PublicKey()}).Marshal()
+		require.NoError(t, dvState.UpdateValidatorAtIndex(valIdx, val))
+		dvChain := &mockChain.ChainService{State: dvState, Slot: &chainSlot}
+		server := &Server{
+			HeadFetcher:           dvChain,
+			TimeFetcher:           dvChain,
+			OptimisticModeFetcher: dvChain,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		}
+		slotMsg := types.SSZUint64(slot)
+		partial1, err := signing.ComputeDomainAndSign(dvState, 0, &slotMsg, params.BeaconConfig().DomainSelectionProof, share1)
+		require.NoError(t, err)
+		partial2, err := signing.ComputeDomainAndSign(dvState, 0, &slotMsg, params.BeaconConfig().DomainSelectionProof, share2)
+		require.NoError(t, err)
+
+		resp, err := server.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{
+				{ValidatorIndex: valIdx, Slot: slot, SelectionProof: partial1},
+				{ValidatorIndex: valIdx, Slot: slot, SelectionProof: partial2},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		sig1, err := bls.SignatureFromBytes(partial1)
+		require.NoError(t, err)
+		sig2, err := bls.SignatureFromBytes(partial2)
+		require.NoError(t, err)
+		assert.Equal(t, valIdx, resp.Data[0].ValidatorIndex)
+		assert.Equal(t, slot, resp.Data[0].Slot)
+		assert.DeepEqual(t, bls.AggregateSignatures([]bls.Signature{sig1, sig2}).Marshal(), resp.Data[0].SelectionProof)
+		assert.Equal(t, 1, len(cache.SubnetIDs.GetAggregatorSubnetIDs(slot)))
+
+		// A single partial proof is not a valid selection proof of the validator.
+		_, err = server.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{{ValidatorIndex: valIdx, Slot: slot, SelectionProof: partial1}},
+		})
+		assert.ErrorContains(t, "is not a valid signature of validator 1", err)
+	})
+
+	t.Run("Slot before previous epoch", func(t *testing.T) {
+		laterSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+		laterChain := &mockChain.ChainService{State: bs, Slot: &laterSlot}
+		server := &Server{
+			HeadFetcher:           laterChain,
+			TimeFetcher:           laterChain,
+			OptimisticModeFetcher: laterChain,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		}
+		_, err := server.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{
+				{
+					ValidatorIndex: valIdx,
+					Slot:           slot,
+					SelectionProof: selectionProof(valIdx, slot),
+				},
+			},
+		})
+		assert.ErrorContains(t, "can not be earlier than previous epoch 2", err)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		cache.SubnetIDs.EmptyAllCaches()
+		_, err := vs.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{
+				{
+					ValidatorIndex: valIdx,
+					Slot:           slot,
+					SelectionProof: selectionProof(valIdx+1, slot),
+				},
+			},
+		})
+		assert.ErrorContains(t, "is not a valid signature of validator 1", err)
+		assert.Equal(t, 0, len(cache.SubnetIDs.GetAggregatorSubnetIDs(slot)))
+	})
+
+	t.Run("Not scheduled to attest", func(t *testing.T) {
+		otherSlot := slot + 1
+		if otherSlot >= params.BeaconConfig().SlotsPerEpoch {
+			otherSlot = slot - 1
+		}
+		_, err := vs.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{
+				{
+					ValidatorIndex: valIdx,
+					Slot:           otherSlot,
+					SelectionProof: selectionProof(valIdx, otherSlot),
+				},
+			},
+		})
+		assert.ErrorContains(t, fmt.Sprintf("Validator 1 is not scheduled to attest at slot %d", otherSlot), err)
+	})
+
+	t.Run("Zero selection proof", func(t *testing.T) {
+		_, err := vs.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: []*ethpbv1.BeaconCommitteeSelection{
+				{
+					ValidatorIndex: valIdx,
+					Slot:           slot,
+					SelectionProof: make([]byte, fieldparams.BLSSignatureLength),
+				},
+			},
+		})
+		assert.ErrorContains(t, "signature can't be zero hash", err)
+	})
+
+	t.Run("No selections", func(t *testing.T) {
+		_, err := vs.SubmitBeaconCommitteeSelections(ctx, &ethpbv1.BeaconCommitteeSelectionsRequest{
+			Data: make([]*ethpbv1.BeaconCommitteeSelection, 0),
+		})
+		assert.ErrorContains(t, "No selections provided", err)
+	})
+}
+
+func TestSubmitBeaconCommitteeSelections_SyncNotReady(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	chainService := &mockChain.ChainService{State: st}
+	vs := &Server{
+		SyncChecker:           &mockSync.Sync{IsSyncing: true},
+		HeadFetcher:           chainService,
+		TimeFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+	}
+	_, err = vs.SubmitBeaconCommitteeSelections(context.Background(), &ethpbv1.BeaconCommitteeSelectionsRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
+func TestSubmitSyncCommitteeSelections(t *testing.T) {
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisStateAltair(t, 64)
+	syncCommittee, err := altair.NextSyncCommittee(ctx, bs)
+	require.NoError(t, err)
+	require.NoError(t, bs.SetCurrentSyncCommittee(syncCommittee))
+	require.NoError(t, bs.SetNextSyncCommittee(syncCommittee))
+	chainSlot := types.Slot(0)
+	chain := &mockChain.ChainService{
+		State: bs, Slot: &chainSlot,
+	}
+	vs := &Server{
+		HeadFetcher:           chain,
+		TimeFetcher:           chain,
+		OptimisticModeFetcher: chain,
+		SyncChecker:           &mockSync.Sync{IsSyncing: false},
+	}
+
+	committee, err := bs.CurrentSyncCommittee()
+	require.NoError(t, err)
+	idx, ok := bs.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[0]))
+	require.Equal(t, true, ok)
+	selectionProof := func(key bls.SecretKey, slot types.Slot, subcommitteeIndex uint64) []byte {
+		data := &ethpbalpha.SyncAggregatorSelectionData{Slot: slot, SubcommitteeIndex: subcommitteeIndex}
+		proof, err := signing.ComputeDomainAndSign(bs, 0, data, params.BeaconConfig().DomainSyncCommitteeSelectionProof, key)
+		require.NoError(t, err)
+		return proof
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		sel := &ethpbv2.SyncCommitteeSelection{
+			ValidatorIndex:    idx,
+			Slot:              1,
+			SubcommitteeIndex: 0,
+			SelectionProof:    selectionProof(keys[idx], 1, 0),
+		}
+		resp, err := vs.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{sel},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, sel, resp.Data[0])
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		_, err := vs.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    idx,
+					Slot:              1,
+					SubcommitteeIndex: 0,
+					SelectionProof:    selectionProof(keys[idx], 2, 0),
+				},
+			},
+		})
+		assert.ErrorContains(t, "is not a valid signature of validator", err)
+	})
+
+	t.Run("Partial proofs", func(t *testing.T) {
+		// The validator key is split into additive shares between the members of the cluster.
+		share1, err := bls.RandKey()
+		require.NoError(t, err)
+		share2, err := bls.RandKey()
+		require.NoError(t, err)
+		dvState := bs.Copy()
+		val, err := dvState.ValidatorAtIndex(idx)
+		require.NoError(t, err)
+		oldPubkey := val.PublicKey
+		val.PublicKey = bls.AggregateMultiplePubkeys([]bls.PublicKey{share1.PublicKey(), share2.PublicKey()}).Marshal()
+		require.NoError(t, dvState.UpdateValidatorAtIndex(idx, val))
+		dvCommittee := &ethpbalpha.SyncCommittee{
+			Pubkeys:         make([][]byte, len(syncCommittee.Pubkeys)),
+			AggregatePubkey: syncCommittee.AggregatePubkey,
+		}
+		for i, pk := range syncCommittee.Pubkeys {
+			dvCommittee.Pubkeys[i] = pk
+			if bytes.Equal(pk, oldPubkey) {
+				dvCommittee.Pubkeys[i] = val.PublicKey
+			}
+		}
+		require.NoError(t, dvState.SetCurrentSyncCommittee(dvCommittee))
+		dvChain := &mockChain.ChainService{State: dvState, Slot: &chainSlot}
+		server := &Server{
+			HeadFetcher:           dvChain,
+			TimeFetcher:           dvChain,
+			OptimisticModeFetcher: dvChain,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		}
+		partial1 := selectionProof(share1, 1, 0)
+		partial2 := selectionProof(share2, 1, 0)
+
+		resp, err := server.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{ValidatorIndex: idx, Slot: 1, SubcommitteeIndex: 0, SelectionProof: partial1},
+				{ValidatorIndex: idx, Slot: 1, SubcommitteeIndex: 0, SelectionProof: partial2},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		sig1, err := bls.SignatureFromBytes(partial1)
+		require.NoError(t, err)
+		sig2, err := bls.SignatureFromBytes(partial2)
+		require.NoError(t, err)
+		assert.Equal(t, idx, resp.Data[0].ValidatorIndex)
+		assert.Equal(t, types.Slot(1), resp.Data[0].Slot)
+		assert.DeepEqual(t, bls.AggregateSignatures([]bls.Signature{sig1, sig2}).Marshal(), resp.Data[0].SelectionProof)
+	})
+
+	t.Run("Slot before previous epoch", func(t *testing.T) {
+		laterSlot := 3 * params.BeaconConfig().SlotsPerEpoch
+		laterChain := &mockChain.ChainService{State: bs, Slot: &laterSlot}
+		server := &Server{
+			HeadFetcher:           laterChain,
+			TimeFetcher:           laterChain,
+			OptimisticModeFetcher: laterChain,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		}
+		_, err := server.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    idx,
+					Slot:              1,
+					SubcommitteeIndex: 0,
+					SelectionProof:    selectionProof(keys[idx], 1, 0),
+				},
+			},
+		})
+		assert.ErrorContains(t, "can not be earlier than previous epoch 2", err)
+	})
+
+	t.Run("Invalid subcommittee index", func(t *testing.T) {
+		subnetCount := params.BeaconConfig().SyncCommitteeSubnetCount
+		_, err := vs.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    idx,
+					Slot:              1,
+					SubcommitteeIndex: subnetCount,
+					SelectionProof:    selectionProof(keys[idx], 1, subnetCount),
+				},
+			},
+		})
+		assert.ErrorContains(t, "Invalid subcommittee index", err)
+	})
+
+	t.Run("Slot outside of known sync committee periods", func(t *testing.T) {
+		slot, err := slots.EpochStart(2 * params.BeaconConfig().EpochsPerSyncCommitteePeriod)
+		require.NoError(t, err)
+		_, err = vs.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    idx,
+					Slot:              slot,
+					SubcommitteeIndex: 0,
+					SelectionProof:    selectionProof(keys[idx], slot, 0),
+				},
+			},
+		})
+		assert.ErrorContains(t, "is not in the current or next sync committee period", err)
+	})
+
+	t.Run("Phase 0 state", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisState(t, 64)
+		phase0Chain := &mockChain.ChainService{State: st, Slot: &chainSlot}
+		server := &Server{
+			HeadFetcher:           phase0Chain,
+			TimeFetcher:           phase0Chain,
+			OptimisticModeFetcher: phase0Chain,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		}
+		_, err := server.SubmitSyncCommitteeSelections(ctx, &ethpbv2.SyncCommitteeSelectionsRequest{
+			Data: []*ethpbv2.SyncCommitteeSelection{
+				{
+					ValidatorIndex:    idx,
+					Slot:              1,
+					SubcommitteeIndex: 0,
+					SelectionProof:    selectionProof(keys[idx], 1, 0),
+				},
+			},
+		})
+		assert.ErrorContains(t, "Sync committee selections are not supported before Altair", err)
+	})
+}
+
+func TestSubmitSyncCommitteeSelections_SyncNotReady(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	chainService := &mockChain.ChainService{State: st}
+	vs := &Server{
+		SyncChecker:           &mockSync.Sync{IsSyncing: true},
+		HeadFetcher:           chainService,
+		TimeFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+	}
+	_, err = vs.SubmitSyncCommitteeSelections(context.Background(), &ethpbv2.SyncCommitteeSelectionsRequest{})
+	assert.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+}
+
 func TestPrepareBeaconProposer(t *testing.T) {
 	type args struct {
 		request *ethpbv1.PrepareBeaconProposerRequest
@@ -156,7 +156,7 @@ func (bs *Server) ListIndexedAttestations(
 	mappedAttestations := mapAttestationsByTargetRoot(attsArray)
 	indexedAtts := make([]*ethpb.IndexedAttestation, 0, numAttestations)
 	for targetRoot, atts := range mappedAttestations {
-		attState, err := bs.StateGen.StateByRoot(ctx, targetRoot)
+		attState, err := bs.StateGen.ReadOnlyStateByRoot(ctx, targetRoot)
 		if err != nil && strings.Contains(err.Error(), "unknown state summary") {
 			// We shouldn't stop the request if we encounter an attestation we don't have the state for.
 			log.Debugf("Could not get state for attestation target root %#x", targetRoot)
@@ -198,6 +198,81 @@ func (bs *Server) ListIndexedAttestations(
 	}, nil
 }
 
+// GetAttestationInclusions retrieves the canonical blocks including an attestation of the validator for
+// the target epoch. As attestations are included at most an epoch after their target epoch, only the
+// blocks of the target epoch and of the next one are scanned, for the attestations of the committee of
+// the validator which have its aggregation bit set.
+func (bs *Server) GetAttestationInclusions(
+	ctx context.Context, req *ethpb.AttestationInclusionsRequest,
+) (*ethpb.AttestationInclusionsResponse, error) {
+	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, errEpoch, currentEpoch, req.Epoch)
+	}
+
+	startSlot, err := slots.EpochStart(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	requestedState, err := bs.ReplayerBuilder.ReplayerForSlot(startSlot).ReplayBlocks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not replay blocks up to slot %d: %v", startSlot, err)
+	}
+	if uint64(req.ValidatorIndex) >= uint64(requestedState.NumValidators()) {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator with index %d", req.ValidatorIndex)
+	}
+	committeeAssignments, _, err := helpers.CommitteeAssignments(ctx, requestedState, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+	}
+	inclusions := make([]*ethpb.AttestationInclusionsResponse_Inclusion, 0)
+	assignment, ok := committeeAssignments[req.ValidatorIndex]
+	if !ok {
+		// The validator was not active during the epoch.
+		return &ethpb.AttestationInclusionsResponse{Inclusions: inclusions}, nil
+	}
+	var bitIndex uint64
+	for i, idx := range assignment.Committee {
+		if idx == req.ValidatorIndex {
+			bitIndex = uint64(i)
+			break
+		}
+	}
+
+	blocks, roots, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(req.Epoch).SetEndEpoch(req.Epoch+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch blocks: %v", err)
+	}
+	for i, b := range blocks {
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, roots[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		if !canonical {
+			continue
+		}
+		for j, att := range b.Block().Body().Attestations() {
+			if att.Data.Slot != assignment.AttesterSlot || att.Data.CommitteeIndex != assignment.CommitteeIndex {
+				continue
+			}
+			if bitIndex >= att.AggregationBits.Len() || !att.AggregationBits.BitAt(bitIndex) {
+				continue
+			}
+			inclusions = append(inclusions, &ethpb.AttestationInclusionsResponse_Inclusion{
+				BlockRoot:           bytesutil.SafeCopyBytes(roots[i][:]),
+				BlockSlot:           b.Block().Slot(),
+				AttestationIndex:    uint64(j),
+				AggregationBitIndex: bitIndex,
+				Data:                att.Data,
+			})
+		}
+	}
+	sort.SliceStable(inclusions, func(i, j int) bool {
+		return inclusions[i].BlockSlot < inclusions[j].BlockSlot
+	})
+	return &ethpb.AttestationInclusionsResponse{Inclusions: inclusions}, nil
+}
+
 // StreamAttestations to clients at the end of every slot. This method retrieves the
 // aggregated attestations currently in the pool at the start of a slot and sends
 // them over a gRPC stream.
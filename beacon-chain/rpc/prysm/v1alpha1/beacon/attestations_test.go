@@ -17,8 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
 	"github.com/prysmaticlabs/prysm/cmd"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -42,7 +43,7 @@ func TestServer_ListAttestations_NoResults(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	ctx := context.Background()
 
-	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Slot: 0,
 	})
 	require.NoError(t, err)
@@ -70,7 +71,7 @@ func TestServer_ListAttestations_Genesis(t *testing.T) {
 	db := dbTest.SetupDB(t)
 	ctx := context.Background()
 
-	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Slot: 0,
 	})
 	require.NoError(t, err)
@@ -686,6 +687,89 @@ func TestServer_ListIndexedAttestations_OldEpoch(t *testing.T) {
 	require.DeepEqual(t, indexedAtts, res.IndexedAttestations, "Incorrect list indexed attestations response")
 }
 
+func TestServer_GetAttestationInclusions(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	committeeAssignments, _, err := helpers.CommitteeAssignments(ctx, st.Copy(), 0)
+	require.NoError(t, err)
+	validatorIndex := types.ValidatorIndex(5)
+	assignment := committeeAssignments[validatorIndex]
+	require.NotNil(t, assignment)
+	var bitIndex uint64
+	for i, idx := range assignment.Committee {
+		if idx == validatorIndex {
+			bitIndex = uint64(i)
+		}
+	}
+
+	newAtt := func(slot types.Slot, withValidator bool) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(assignment.Committee)))
+		for i := uint64(0); i < bits.Len(); i++ {
+			bits.SetBitAt(i, (i == bitIndex) == withValidator)
+		}
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				CommitteeIndex:  assignment.CommitteeIndex,
+				BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+			},
+			AggregationBits: bits,
+			Signature:       make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	canonicalRoots := make(map[[32]byte]bool)
+	saveBlock := func(slot types.Slot, canonical bool, atts ...*ethpb.Attestation) [32]byte {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.Body.Attestations = atts
+		util.SaveBlock(t, ctx, db, b)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if canonical {
+			canonicalRoots[root] = true
+		}
+		return root
+	}
+	attesterSlot := assignment.AttesterSlot
+	// The attestation of another slot is not an attestation of the validator.
+	root1 := saveBlock(attesterSlot+1, true, newAtt(attesterSlot+1, true), newAtt(attesterSlot, true))
+	// Attestations without the bit of the validator set are skipped.
+	saveBlock(attesterSlot+2, true, newAtt(attesterSlot, false))
+	// Inclusions in orphaned blocks are skipped.
+	saveBlock(attesterSlot+3, false, newAtt(attesterSlot, true))
+	// Inclusions in the next epoch are reported.
+	nextEpochSlot := params.BeaconConfig().SlotsPerEpoch + 1
+	root2 := saveBlock(nextEpochSlot, true, newAtt(attesterSlot, true))
+
+	bs := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &chainMock.ChainService{Genesis: time.Now()},
+		ReplayerBuilder:    mockstategen.NewMockReplayerBuilder(mockstategen.WithMockState(st)),
+		CanonicalFetcher:   &chainMock.ChainService{CanonicalRoots: canonicalRoots},
+	}
+	res, err := bs.GetAttestationInclusions(ctx, &ethpb.AttestationInclusionsRequest{ValidatorIndex: validatorIndex, Epoch: 0})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Inclusions))
+	assert.DeepEqual(t, root1[:], res.Inclusions[0].BlockRoot)
+	assert.Equal(t, attesterSlot+1, res.Inclusions[0].BlockSlot)
+	assert.Equal(t, uint64(1), res.Inclusions[0].AttestationIndex)
+	assert.Equal(t, bitIndex, res.Inclusions[0].AggregationBitIndex)
+	assert.DeepEqual(t, newAtt(attesterSlot, true).Data, res.Inclusions[0].Data)
+	assert.DeepEqual(t, root2[:], res.Inclusions[1].BlockRoot)
+	assert.Equal(t, nextEpochSlot, res.Inclusions[1].BlockSlot)
+	assert.Equal(t, uint64(0), res.Inclusions[1].AttestationIndex)
+
+	_, err = bs.GetAttestationInclusions(ctx, &ethpb.AttestationInclusionsRequest{ValidatorIndex: validatorIndex, Epoch: 1})
+	require.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+	_, err = bs.GetAttestationInclusions(ctx, &ethpb.AttestationInclusionsRequest{ValidatorIndex: 64, Epoch: 0})
+	require.ErrorContains(t, "Could not find validator with index 64", err)
+}
+
 func TestServer_AttestationPool_Pagination_ExceedsMaxPageSize(t *testing.T) {
 	ctx := context.Background()
 	bs := &Server{}
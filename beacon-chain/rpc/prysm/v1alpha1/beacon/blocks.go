@@ -13,6 +13,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -478,3 +479,47 @@ func (bs *Server) chainHeadRetrieval(ctx context.Context) (*ethpb.ChainHead, err
 		OptimisticStatus:           optimisticStatus,
 	}, nil
 }
+
+// GetBlockAncestor retrieves the root of the ancestor of a block at the requested slot. When the slot was
+// skipped, the ancestor is the most recent block before it.
+func (bs *Server) GetBlockAncestor(ctx context.Context, req *ethpb.BlockAncestorRequest) (*ethpb.BlockAncestorResponse, error) {
+	root, err := bs.knownBlockRoot(ctx, req.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	ancestorRoot, err := bs.AncestryFetcher.AncestorAtSlot(ctx, root, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve ancestor of block %#x at slot %d: %v", root, req.Slot, err)
+	}
+	return &ethpb.BlockAncestorResponse{AncestorRoot: ancestorRoot[:]}, nil
+}
+
+// IsBlockAncestor checks whether the block of the ancestor root is an ancestor of the requested block,
+// or the same block.
+func (bs *Server) IsBlockAncestor(ctx context.Context, req *ethpb.IsBlockAncestorRequest) (*ethpb.IsBlockAncestorResponse, error) {
+	ancestorRoot, err := bs.knownBlockRoot(ctx, req.AncestorRoot)
+	if err != nil {
+		return nil, err
+	}
+	root, err := bs.knownBlockRoot(ctx, req.BlockRoot)
+	if err != nil {
+		return nil, err
+	}
+	isAncestor, err := bs.AncestryFetcher.IsAncestor(ctx, ancestorRoot, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine if block %#x is an ancestor of block %#x: %v", ancestorRoot, root, err)
+	}
+	return &ethpb.IsBlockAncestorResponse{IsAncestor: isAncestor}, nil
+}
+
+// knownBlockRoot validates a requested block root and checks that its block is known to the node.
+func (bs *Server) knownBlockRoot(ctx context.Context, root []byte) ([32]byte, error) {
+	if len(root) != fieldparams.RootLength {
+		return [32]byte{}, status.Errorf(codes.InvalidArgument, "Block root must be %d bytes, received %d", fieldparams.RootLength, len(root))
+	}
+	r := bytesutil.ToBytes32(root)
+	if !bs.BeaconDB.HasBlock(ctx, r) {
+		return [32]byte{}, status.Errorf(codes.NotFound, "Could not find block %#x", r)
+	}
+	return r, nil
+}
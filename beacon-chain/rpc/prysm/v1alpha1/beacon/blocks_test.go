@@ -12,7 +12,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
@@ -502,7 +502,7 @@ func TestServer_GetChainHead(t *testing.T) {
 	pjRoot, err := prevJustifiedBlock.Block.HashTreeRoot()
 	require.NoError(t, err)
 
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Slot:                        1,
 		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 3, Root: pjRoot[:]},
 		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 2, Root: jRoot[:]},
@@ -597,7 +597,7 @@ func TestServer_StreamChainHead_OnHeadUpdated(t *testing.T) {
 	pjRoot, err := prevJustifiedBlock.Block.HashTreeRoot()
 	require.NoError(t, err)
 
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Slot:                        1,
 		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 3, Root: pjRoot[:]},
 		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 2, Root: jRoot[:]},
@@ -1236,3 +1236,54 @@ func TestServer_ListBeaconBlocks_Errors(t *testing.T) {
 	assert.Equal(t, 0, len(res.BlockContainers), "Wanted empty list")
 	assert.Equal(t, int32(0), res.TotalSize, "Wanted total size 0")
 }
+
+func TestServer_BlockAncestry(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	// Blocks at slots 1, 2 and 4, with slot 3 skipped, and a fork block at slot 2.
+	roots := make(map[types.Slot][32]byte)
+	parent := [32]byte{'a'}
+	for _, slot := range []types.Slot{1, 2, 4} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		util.SaveBlock(t, ctx, db, b)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = r
+		parent = r
+	}
+	forkParent := roots[1]
+	fork := util.NewBeaconBlock()
+	fork.Block.Slot = 2
+	fork.Block.ParentRoot = forkParent[:]
+	fork.Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+	util.SaveBlock(t, ctx, db, fork)
+	forkRoot, err := fork.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	bs := &Server{
+		BeaconDB:        db,
+		AncestryFetcher: &chainMock.ChainService{DB: db},
+	}
+
+	r4 := roots[4]
+	res, err := bs.GetBlockAncestor(ctx, &ethpb.BlockAncestorRequest{BlockRoot: r4[:], Slot: 3})
+	require.NoError(t, err)
+	r2 := roots[2]
+	assert.DeepEqual(t, r2[:], res.AncestorRoot)
+
+	isAncestor, err := bs.IsBlockAncestor(ctx, &ethpb.IsBlockAncestorRequest{AncestorRoot: r2[:], BlockRoot: r4[:]})
+	require.NoError(t, err)
+	assert.Equal(t, true, isAncestor.IsAncestor)
+	isAncestor, err = bs.IsBlockAncestor(ctx, &ethpb.IsBlockAncestorRequest{AncestorRoot: forkRoot[:], BlockRoot: r4[:]})
+	require.NoError(t, err)
+	assert.Equal(t, false, isAncestor.IsAncestor)
+
+	_, err = bs.GetBlockAncestor(ctx, &ethpb.BlockAncestorRequest{BlockRoot: []byte{'a'}})
+	assert.ErrorContains(t, "Block root must be 32 bytes", err)
+	unknown := [32]byte{'b'}
+	_, err = bs.IsBlockAncestor(ctx, &ethpb.IsBlockAncestorRequest{AncestorRoot: r2[:], BlockRoot: unknown[:]})
+	assert.ErrorContains(t, "Could not find block", err)
+}
@@ -0,0 +1,100 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of slots whose blocks are read from the database at once when streaming canonical blocks.
+const canonicalBlocksBatchSlots = types.Slot(256)
+
+// StreamCanonicalBlocks streams the blocks of the canonical chain in the requested slot range in slot
+// order, skipping the slots without a block. The range is truncated at the head slot, and blocks before
+// the origin of a checkpoint synced node are only available once backfilled.
+func (bs *Server) StreamCanonicalBlocks(req *ethpb.CanonicalBlocksRequest, stream ethpb.BeaconChain_StreamCanonicalBlocksServer) error {
+	if req.StartSlot > req.EndSlot {
+		return status.Errorf(codes.InvalidArgument, "Start slot %d is after end slot %d", req.StartSlot, req.EndSlot)
+	}
+	ctx := stream.Context()
+	end := req.EndSlot
+	if headSlot := bs.HeadFetcher.HeadSlot(); end > headSlot {
+		end = headSlot
+	}
+	for start := req.StartSlot; start <= end; start += canonicalBlocksBatchSlots {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		batchEnd := end
+		if end-start >= canonicalBlocksBatchSlots {
+			batchEnd = start + canonicalBlocksBatchSlots - 1
+		}
+		batch, err := bs.canonicalBlocks(ctx, start, batchEnd)
+		if err != nil {
+			return err
+		}
+		for _, blk := range batch {
+			if err := stream.Send(blk); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		}
+		if batchEnd == end {
+			break
+		}
+	}
+	return nil
+}
+
+// canonicalBlocks retrieves the canonical blocks from the start to the end slot, in slot order.
+func (bs *Server) canonicalBlocks(ctx context.Context, start, end types.Slot) ([]*ethpb.CanonicalBlock, error) {
+	blks, roots, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks from slot %d to %d: %v", start, end, err)
+	}
+	canonical := make([]*ethpb.CanonicalBlock, 0, len(blks))
+	for i, blk := range blks {
+		isCanonical, err := bs.CanonicalFetcher.IsCanonical(ctx, roots[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		if !isCanonical {
+			continue
+		}
+		executionBlockHash, err := executionBlockHash(blk)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get execution block hash of block %#x: %v", roots[i], err)
+		}
+		root := roots[i]
+		canonical = append(canonical, &ethpb.CanonicalBlock{
+			Slot:               blk.Block().Slot(),
+			BlockRoot:          root[:],
+			ExecutionBlockHash: executionBlockHash,
+			ProposerIndex:      blk.Block().ProposerIndex(),
+		})
+	}
+	sort.Slice(canonical, func(i, j int) bool {
+		return canonical[i].Slot < canonical[j].Slot
+	})
+	return canonical, nil
+}
+
+// executionBlockHash returns the hash of the execution block included in a block, or nil for blocks
+// before the merge.
+func executionBlockHash(blk interfaces.SignedBeaconBlock) ([]byte, error) {
+	isExecutionBlock, err := blocks.IsExecutionBlock(blk.Block().Body())
+	if err != nil || !isExecutionBlock {
+		return nil, err
+	}
+	payload, err := blk.Block().Body().Execution()
+	if err != nil {
+		return nil, err
+	}
+	return payload.BlockHash(), nil
+}
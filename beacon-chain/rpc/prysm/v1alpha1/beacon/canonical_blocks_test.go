@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/mock"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_StreamCanonicalBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+
+	canonicalRoots := make(map[[32]byte]bool)
+	var expected []*ethpb.CanonicalBlock
+	for _, slot := range []types.Slot{1, 2, 3, 5} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = types.ValidatorIndex(slot + 10)
+		util.SaveBlock(t, ctx, db, b)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		canonicalRoots[root] = true
+		expected = append(expected, &ethpb.CanonicalBlock{Slot: slot, BlockRoot: root[:], ProposerIndex: b.Block.ProposerIndex})
+	}
+	for _, slot := range []types.Slot{6, 7, 8} {
+		b := util.NewBeaconBlockBellatrix()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = types.ValidatorIndex(slot + 10)
+		b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{byte(slot)}, 32)
+		util.SaveBlock(t, ctx, db, b)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		canonicalRoots[root] = true
+		expected = append(expected, &ethpb.CanonicalBlock{
+			Slot:               slot,
+			BlockRoot:          root[:],
+			ExecutionBlockHash: b.Block.Body.ExecutionPayload.BlockHash,
+			ProposerIndex:      b.Block.ProposerIndex,
+		})
+	}
+	// An orphaned block is not streamed.
+	orphaned := util.NewBeaconBlock()
+	orphaned.Block.Slot = 3
+	orphaned.Block.ProposerIndex = 42
+	util.SaveBlock(t, ctx, db, orphaned)
+
+	headState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(7))
+	server := &Server{
+		BeaconDB:         db,
+		HeadFetcher:      &chainMock.ChainService{State: headState},
+		CanonicalFetcher: &chainMock.ChainService{CanonicalRoots: canonicalRoots},
+	}
+
+	tests := []struct {
+		name     string
+		start    types.Slot
+		end      types.Slot
+		expected []*ethpb.CanonicalBlock
+	}{
+		{name: "range truncated at head", start: 2, end: 100, expected: expected[1:6]},
+		{name: "single slot", start: 3, end: 3, expected: expected[2:3]},
+		{name: "skipped slot", start: 4, end: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var streamed []*ethpb.CanonicalBlock
+			mockStream := mock.NewMockBeaconChain_StreamCanonicalBlocksServer(ctrl)
+			mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+			mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(b *ethpb.CanonicalBlock) error {
+				streamed = append(streamed, b)
+				return nil
+			}).AnyTimes()
+			require.NoError(t, server.StreamCanonicalBlocks(&ethpb.CanonicalBlocksRequest{StartSlot: tt.start, EndSlot: tt.end}, mockStream))
+			require.Equal(t, len(tt.expected), len(streamed))
+			for i := range tt.expected {
+				assert.DeepEqual(t, tt.expected[i], streamed[i])
+			}
+		})
+	}
+}
+
+func TestServer_StreamCanonicalBlocks_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChain_StreamCanonicalBlocksServer(ctrl)
+	server := &Server{}
+	err := server.StreamCanonicalBlocks(&ethpb.CanonicalBlocksRequest{StartSlot: 2, EndSlot: 1}, mockStream)
+	assert.ErrorContains(t, "Start slot 2 is after end slot 1", err)
+}
@@ -106,7 +106,7 @@ func (bs *Server) retrieveCommitteesForRoot(
 	ctx context.Context,
 	root []byte,
 ) (SlotToCommiteesMap, []types.ValidatorIndex, error) {
-	requestedState, err := bs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(root))
+	requestedState, err := bs.StateGen.ReadOnlyStateByRoot(ctx, bytesutil.ToBytes32(root))
 	if err != nil {
 		return nil, nil, status.Error(codes.Internal, fmt.Sprintf("Could not get state: %v", err))
 	}
@@ -94,3 +94,15 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 
 	return &pbrpc.InclusionSlotResponse{Slot: inclusionSlot}, nil
 }
+
+// GetProposalAudit returns the audit trail of the block proposal made through the beacon node for the requested slot.
+func (ds *Server) GetProposalAudit(ctx context.Context, req *pbrpc.ProposalAuditRequest) (*pbrpc.ProposalAudit, error) {
+	audit, err := ds.BeaconDB.ProposalAudit(ctx, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve proposal audit: %v", err)
+	}
+	if audit == nil {
+		return nil, status.Errorf(codes.NotFound, "No block proposal was made through the beacon node at slot %d", req.Slot)
+	}
+	return audit, nil
+}
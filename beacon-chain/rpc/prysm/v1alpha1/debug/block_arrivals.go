@@ -0,0 +1,30 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockArrivals returns the recent blocks received over gossip by the beacon node, with the time they
+// arrived at relative to the start of their slot.
+func (ds *Server) GetBlockArrivals(_ context.Context, _ *empty.Empty) (*pbrpc.BlockArrivalsResponse, error) {
+	if ds.BlockArrivals == nil {
+		return nil, status.Error(codes.Unavailable, "Block arrivals are not available")
+	}
+	recent := ds.BlockArrivals.Recent()
+	arrivals := make([]*pbrpc.BlockArrival, len(recent))
+	for i, a := range recent {
+		root := a.Root
+		arrivals[i] = &pbrpc.BlockArrival{
+			BlockRoot:     root[:],
+			Slot:          a.Slot,
+			ArrivalMs:     a.Delay.Milliseconds(),
+			ProposerIndex: a.ProposerIndex,
+		}
+	}
+	return &pbrpc.BlockArrivalsResponse{Arrivals: arrivals}, nil
+}
@@ -0,0 +1,30 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestServer_GetBlockArrivals(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetBlockArrivals(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Block arrivals are not available", err)
+
+	ds.BlockArrivals = cache.NewBlockArrivalCache()
+	ds.BlockArrivals.Add(cache.BlockArrival{Root: [32]byte{'a'}, Slot: 5, ProposerIndex: 3, Delay: 1500 * time.Millisecond})
+	ds.BlockArrivals.Add(cache.BlockArrival{Root: [32]byte{'b'}, Slot: 6, ProposerIndex: 4, Delay: -200 * time.Millisecond})
+	res, err := ds.GetBlockArrivals(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Arrivals))
+	root := [32]byte{'a'}
+	assert.DeepEqual(t, &ethpb.BlockArrival{BlockRoot: root[:], Slot: 5, ArrivalMs: 1500, ProposerIndex: 3}, res.Arrivals[0])
+	assert.Equal(t, int64(-200), res.Arrivals[1].ArrivalMs)
+}
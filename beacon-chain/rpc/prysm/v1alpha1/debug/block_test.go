@@ -84,3 +84,20 @@ func TestServer_GetAttestationInclusionSlot(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, params.BeaconConfig().FarFutureSlot, res.Slot)
 }
+
+func TestServer_GetProposalAudit(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{
+		BeaconDB: db,
+	}
+
+	_, err := bs.GetProposalAudit(ctx, &ethpb.ProposalAuditRequest{Slot: 5})
+	assert.ErrorContains(t, "No block proposal was made through the beacon node at slot 5", err)
+
+	audit := &ethpb.ProposalAudit{Slot: 5, ProposerIndex: 1, BlockRoot: make([]byte, 32)}
+	require.NoError(t, db.SaveProposalAudit(ctx, audit))
+	res, err := bs.GetProposalAudit(ctx, &ethpb.ProposalAuditRequest{Slot: 5})
+	require.NoError(t, err)
+	assert.DeepEqual(t, audit, res)
+}
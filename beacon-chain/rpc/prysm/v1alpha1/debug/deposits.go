@@ -0,0 +1,46 @@
+package debug
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositCache returns the latest eth1 block processed by the beacon node, the number and tree root
+// of the deposits cached up to the last block whose deposit logs were requested, and the cached deposits
+// which the head state has not included yet.
+func (ds *Server) GetDepositCache(ctx context.Context, _ *empty.Empty) (*pbrpc.DepositCacheResponse, error) {
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil || headState.IsNil() {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+	eth1Data := ds.POWChainInfoFetcher.LatestEth1Data()
+	if eth1Data == nil {
+		return nil, status.Error(codes.Internal, "Latest eth1 data is nil")
+	}
+	count, root := ds.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, new(big.Int).SetUint64(eth1Data.LastRequestedBlock))
+
+	// Pending deposits are only pruned from the cache on finalization, so deposits which were
+	// included since the last finalized checkpoint are filtered out against the head state.
+	included := headState.Eth1DepositIndex()
+	pending := make([]*pbrpc.DepositContainer, 0)
+	for _, ctr := range ds.PendingDepositFetcher.PendingContainers(ctx, nil) {
+		if ctr.Index >= 0 && uint64(ctr.Index) >= included {
+			pending = append(pending, ctr)
+		}
+	}
+	return &pbrpc.DepositCacheResponse{
+		LatestEth1Data:   eth1Data,
+		DepositCount:     count,
+		DepositRoot:      root[:],
+		Eth1DepositIndex: included,
+		PendingDeposits:  pending,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_GetDepositCache(t *testing.T) {
+	ctx := context.Background()
+	dc, err := depositcache.New()
+	require.NoError(t, err)
+	heights := []uint64{10, 11, 20}
+	for i, height := range heights {
+		d := &pbrpc.Deposit{
+			Data: &pbrpc.Deposit_Data{
+				PublicKey: bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength),
+			},
+		}
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		require.NoError(t, dc.InsertDeposit(ctx, d, height, int64(i), root))
+		dc.InsertPendingDeposit(ctx, d, height, int64(i), root)
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetEth1DepositIndex(1))
+	eth1Data := &pbrpc.LatestETH1Data{BlockHeight: 30, LastRequestedBlock: 15}
+
+	ds := &Server{
+		HeadFetcher:           &mock.ChainService{State: st},
+		DepositFetcher:        dc,
+		PendingDepositFetcher: dc,
+		POWChainInfoFetcher:   &testutil.MockPOWChainInfoFetcher{Eth1Data: eth1Data},
+	}
+	res, err := ds.GetDepositCache(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, eth1Data, res.LatestEth1Data)
+	assert.Equal(t, uint64(2), res.DepositCount)
+	wantRoot := bytesutil.ToBytes32([]byte{2})
+	assert.DeepEqual(t, wantRoot[:], res.DepositRoot)
+	assert.Equal(t, uint64(1), res.Eth1DepositIndex)
+	require.Equal(t, 2, len(res.PendingDeposits))
+	assert.Equal(t, int64(1), res.PendingDeposits[0].Index)
+	assert.Equal(t, int64(2), res.PendingDeposits[1].Index)
+}
+
+func TestServer_GetDepositCache_NilEth1Data(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	ds := &Server{
+		HeadFetcher:         &mock.ChainService{State: st},
+		POWChainInfoFetcher: &testutil.MockPOWChainInfoFetcher{},
+	}
+	_, err = ds.GetDepositCache(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Latest eth1 data is nil", err)
+}
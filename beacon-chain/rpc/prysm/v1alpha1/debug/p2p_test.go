@@ -19,6 +19,8 @@ func TestDebugServer_GetPeer(t *testing.T) {
 		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
 	}
 	firstPeer := peersProvider.Peers().All()[0]
+	peersProvider.Peers().AddGoodbyeReceived(firstPeer, 129)
+	peersProvider.Peers().AddGoodbyeSent(firstPeer, 250)
 
 	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
 	require.NoError(t, err)
@@ -26,6 +28,8 @@ func TestDebugServer_GetPeer(t *testing.T) {
 
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Direction), "Expected 1st peer to be an inbound connection")
 	assert.Equal(t, ethpb.ConnectionState_CONNECTED, res.ConnectionState, "Expected peer to be connected")
+	assert.DeepEqual(t, map[uint64]uint64{129: 1}, res.GoodbyeInfo.Received)
+	assert.DeepEqual(t, map[uint64]uint64{250: 1}, res.GoodbyeInfo.Sent)
 }
 
 func TestDebugServer_ListPeers(t *testing.T) {
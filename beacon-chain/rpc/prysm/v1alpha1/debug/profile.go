@@ -0,0 +1,24 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/monitoring/profiler"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CaptureProfileSnapshot captures heap and goroutine profiles of the beacon node to its profile
+// snapshot directory, and returns the paths of the written files.
+func (ds *Server) CaptureProfileSnapshot(_ context.Context, _ *empty.Empty) (*pbrpc.ProfileSnapshotResponse, error) {
+	if ds.ProfileSnapshotter == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Profiler is not enabled, a profile snapshot directory must be specified")
+	}
+	files, err := ds.ProfileSnapshotter.Snapshot(profiler.ReasonManual)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not capture profile snapshot: %v", err)
+	}
+	return &pbrpc.ProfileSnapshotResponse{Files: files}, nil
+}
@@ -0,0 +1,30 @@
+package debug
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/monitoring/profiler"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestServer_CaptureProfileSnapshot(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.CaptureProfileSnapshot(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Profiler is not enabled", err)
+
+	dir := filepath.Join(t.TempDir(), "profiles")
+	svc, err := profiler.NewService(ctx, &profiler.Config{Dir: dir})
+	require.NoError(t, err)
+	ds.ProfileSnapshotter = svc
+	res, err := ds.CaptureProfileSnapshot(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Files))
+	for _, f := range res.Files {
+		assert.Equal(t, dir, filepath.Dir(f))
+	}
+}
@@ -11,9 +11,14 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/monitoring/profiler"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -24,14 +29,20 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	ForkFetcher        blockchain.ForkFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
-	ReplayerBuilder    stategen.ReplayerBuilder
+	BeaconDB              db.NoHeadAccessDatabase
+	GenesisTimeFetcher    blockchain.TimeFetcher
+	StateGen              *stategen.State
+	HeadFetcher           blockchain.HeadFetcher
+	ForkFetcher           blockchain.ForkFetcher
+	PeerManager           p2p.PeerManager
+	PeersFetcher          p2p.PeersProvider
+	ReplayerBuilder       stategen.ReplayerBuilder
+	DepositFetcher        depositcache.DepositFetcher
+	PendingDepositFetcher depositcache.PendingDepositsFetcher
+	POWChainInfoFetcher   powchain.ChainInfoFetcher
+	ProfileSnapshotter    profiler.Snapshotter
+	SyncCommitteePool     synccommittee.Pool
+	BlockArrivals         *cache.BlockArrivalCache
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,6 +19,74 @@ func (ds *Server) GetBeaconState(
 	ctx context.Context,
 	req *pbrpc.BeaconStateRequest,
 ) (*pbrpc.SSZResponse, error) {
+	st, err := ds.beaconState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
+	}
+	return &pbrpc.SSZResponse{
+		Encoded: encoded,
+	}, nil
+}
+
+// GetBeaconStateDiff compares a beacon state of the beacon node, by either a slot or block root, with the
+// given ssz-encoded beacon state field by field.
+func (ds *Server) GetBeaconStateDiff(
+	ctx context.Context,
+	req *pbrpc.BeaconStateDiffRequest,
+) (*pbrpc.BeaconStateDiffResponse, error) {
+	if req.State == nil {
+		return nil, status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to request state")
+	}
+	unmarshaler, err := detect.FromState(req.Encoded)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not detect the fork of the given state: %v", err)
+	}
+	other, err := unmarshaler.UnmarshalBeaconState(req.Encoded)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not unmarshal the given state: %v", err)
+	}
+	roState, err := ds.beaconState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := roState.(state.BeaconState)
+	if !ok {
+		return nil, status.Error(codes.Internal, "Could not compute state root of beacon state")
+	}
+	diffs, err := statediff.Diff(st, other)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not compare beacon states: %v", err)
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root of beacon state: %v", err)
+	}
+	otherRoot, err := other.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root of the given state: %v", err)
+	}
+	res := &pbrpc.BeaconStateDiffResponse{
+		StateRoot:      root[:],
+		OtherStateRoot: otherRoot[:],
+		Differences:    make([]*pbrpc.BeaconStateFieldDiff, len(diffs)),
+	}
+	for i, d := range diffs {
+		res.Differences[i] = &pbrpc.BeaconStateFieldDiff{
+			Path:             d.Path,
+			GeneralizedIndex: d.GeneralizedIndex,
+			Value:            d.A,
+			OtherValue:       d.B,
+		}
+	}
+	return res, nil
+}
+
+// beaconState retrieves a beacon state from the beacon node by either a slot or block root.
+func (ds *Server) beaconState(ctx context.Context, req *pbrpc.BeaconStateRequest) (state.ReadOnlyBeaconState, error) {
 	switch q := req.QueryFilter.(type) {
 	case *pbrpc.BeaconStateRequest_Slot:
 		currentSlot := ds.GenesisTimeFetcher.CurrentSlot()
@@ -33,26 +104,13 @@ func (ds *Server) GetBeaconState(
 		if err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("error replaying blocks for state at slot %d: %v", q.Slot, err))
 		}
-
-		encoded, err := st.MarshalSSZ()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
-		}
-		return &pbrpc.SSZResponse{
-			Encoded: encoded,
-		}, nil
+		return st, nil
 	case *pbrpc.BeaconStateRequest_BlockRoot:
-		st, err := ds.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(q.BlockRoot))
+		st, err := ds.StateGen.ReadOnlyStateByRoot(ctx, bytesutil.ToBytes32(q.BlockRoot))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not compute state by block root: %v", err)
 		}
-		encoded, err := st.MarshalSSZ()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
-		}
-		return &pbrpc.SSZResponse{
-			Encoded: encoded,
-		}, nil
+		return st, nil
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to request state")
 	}
@@ -105,3 +105,45 @@ func TestServer_GetBeaconState_RequestFutureSlot(t *testing.T) {
 	_, err := ds.GetBeaconState(context.Background(), req)
 	assert.ErrorContains(t, wanted, err)
 }
+
+func TestServer_GetBeaconStateDiff(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 8)
+	b := util.NewBeaconBlock()
+	util.SaveBlock(t, ctx, db, b)
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(db)
+	require.NoError(t, gen.SaveState(ctx, gRoot, st))
+	bs := &Server{
+		StateGen:           gen,
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+	stateReq := &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{
+			BlockRoot: gRoot[:],
+		},
+	}
+
+	other := st.Copy()
+	require.NoError(t, other.UpdateBalancesAtIndex(2, 1))
+	encoded, err := other.MarshalSSZ()
+	require.NoError(t, err)
+	res, err := bs.GetBeaconStateDiff(ctx, &pbrpc.BeaconStateDiffRequest{State: stateReq, Encoded: encoded})
+	require.NoError(t, err)
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	otherRoot, err := other.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], res.StateRoot)
+	assert.DeepEqual(t, otherRoot[:], res.OtherStateRoot)
+	require.Equal(t, 1, len(res.Differences))
+	assert.Equal(t, "balances[2]", res.Differences[0].Path)
+	assert.Equal(t, "1", res.Differences[0].OtherValue)
+
+	_, err = bs.GetBeaconStateDiff(ctx, &pbrpc.BeaconStateDiffRequest{Encoded: encoded})
+	assert.ErrorContains(t, "Need to specify either a block root or slot to request state", err)
+	_, err = bs.GetBeaconStateDiff(ctx, &pbrpc.BeaconStateDiffRequest{State: stateReq, Encoded: []byte{1, 2}})
+	assert.ErrorContains(t, "Could not detect the fork of the given state", err)
+}
@@ -0,0 +1,74 @@
+package debug
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/execution"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DryRunStateUpgrade performs the state upgrade of the upcoming fork against a copy of the head state,
+// and reports the time and memory it took, so that the cost of processing the fork epoch can be
+// anticipated on the current validator registry. The upgraded state is discarded.
+func (ds *Server) DryRunStateUpgrade(ctx context.Context, _ *empty.Empty) (*pbrpc.StateUpgradeDryRunResponse, error) {
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil || headState.IsNil() {
+		return nil, status.Error(codes.Internal, "Head state is nil")
+	}
+
+	var forkEpoch types.Epoch
+	var upgrade func(state.BeaconState) (state.BeaconState, error)
+	switch headState.Version() {
+	case version.Phase0:
+		forkEpoch = params.BeaconConfig().AltairForkEpoch
+		upgrade = func(st state.BeaconState) (state.BeaconState, error) {
+			return altair.UpgradeToAltair(ctx, st)
+		}
+	case version.Altair:
+		forkEpoch = params.BeaconConfig().BellatrixForkEpoch
+		upgrade = execution.UpgradeToBellatrix
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "No state upgrade is known after the %s fork", version.String(headState.Version()))
+	}
+
+	// The upgrade must not share any field with the head state.
+	st := headState.Copy()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	upgraded, err := upgrade(st)
+	upgradeTime := time.Since(start)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not upgrade state: %v", err)
+	}
+	start = time.Now()
+	if _, err := upgraded.HashTreeRoot(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute upgraded state root: %v", err)
+	}
+	hashTreeRootTime := time.Since(start)
+
+	return &pbrpc.StateUpgradeDryRunResponse{
+		Fork:               version.String(upgraded.Version()),
+		ForkEpoch:          forkEpoch,
+		StateSlot:          headState.Slot(),
+		ValidatorCount:     uint64(upgraded.NumValidators()),
+		UpgradeTimeMs:      uint64(upgradeTime.Milliseconds()),
+		HashTreeRootTimeMs: uint64(hashTreeRootTime.Milliseconds()),
+		AllocatedBytes:     after.TotalAlloc - before.TotalAlloc,
+		HeapAllocBytes:     after.HeapAlloc,
+	}, nil
+}
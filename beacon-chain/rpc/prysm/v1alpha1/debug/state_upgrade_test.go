@@ -0,0 +1,43 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_DryRunStateUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(5))
+	ds := &Server{HeadFetcher: &mock.ChainService{State: st}}
+	res, err := ds.DryRunStateUpgrade(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "altair", res.Fork)
+	assert.Equal(t, params.BeaconConfig().AltairForkEpoch, res.ForkEpoch)
+	assert.Equal(t, types.Slot(5), res.StateSlot)
+	assert.Equal(t, uint64(64), res.ValidatorCount)
+	// The head state is left untouched.
+	assert.Equal(t, version.Phase0, st.Version())
+
+	altairState, _ := util.DeterministicGenesisStateAltair(t, 64)
+	ds = &Server{HeadFetcher: &mock.ChainService{State: altairState}}
+	res, err = ds.DryRunStateUpgrade(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "bellatrix", res.Fork)
+	assert.Equal(t, params.BeaconConfig().BellatrixForkEpoch, res.ForkEpoch)
+
+	bellatrixState, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+	ds = &Server{HeadFetcher: &mock.ChainService{State: bellatrixState}}
+	_, err = ds.DryRunStateUpgrade(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No state upgrade is known after the bellatrix fork", err)
+}
@@ -0,0 +1,34 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSyncAggregationQuality returns, for the recent slots and the sync committee subnets, the number
+// of sync committee messages received by the beacon node compared to the participants of the best
+// contribution, along with the aggregators of the best and of the packed contributions.
+func (ds *Server) GetSyncAggregationQuality(_ context.Context, _ *empty.Empty) (*pbrpc.SyncAggregationQualityResponse, error) {
+	if ds.SyncCommitteePool == nil {
+		return nil, status.Error(codes.Unavailable, "Sync committee pool is not available")
+	}
+	qualities := ds.SyncCommitteePool.SyncCommitteeAggregationQuality()
+	subnets := make([]*pbrpc.SyncSubnetAggregationQuality, len(qualities))
+	for i, q := range qualities {
+		subnets[i] = &pbrpc.SyncSubnetAggregationQuality{
+			Slot:               q.Slot,
+			SubcommitteeIndex:  q.SubcommitteeIndex,
+			ReceivedMessages:   q.ReceivedMessages,
+			BestParticipants:   q.BestParticipants,
+			BestAggregators:    q.BestAggregators,
+			LocalParticipants:  q.LocalParticipants,
+			PackedParticipants: q.PackedParticipants,
+			PackedAggregators:  q.PackedAggregators,
+		}
+	}
+	return &pbrpc.SyncAggregationQualityResponse{Subnets: subnets}, nil
+}
@@ -0,0 +1,40 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestServer_GetSyncAggregationQuality(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.GetSyncAggregationQuality(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Sync committee pool is not available", err)
+
+	pool := synccommittee.NewStore()
+	pool.MarkSyncCommitteeMessageReceived(5, 1, 2)
+	cont := &ethpb.SyncCommitteeContribution{Slot: 5, SubcommitteeIndex: 2, AggregationBits: []byte{0b0001}}
+	pool.MarkSyncCommitteeContributionAggregator(cont, 3, true)
+	pool.MarkSyncCommitteeContributionPacked(cont)
+	ds.SyncCommitteePool = pool
+	res, err := ds.GetSyncAggregationQuality(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Subnets))
+	assert.DeepEqual(t, &ethpb.SyncSubnetAggregationQuality{
+		Slot:               5,
+		SubcommitteeIndex:  2,
+		ReceivedMessages:   1,
+		BestParticipants:   1,
+		BestAggregators:    []types.ValidatorIndex{3},
+		LocalParticipants:  1,
+		PackedParticipants: 1,
+		PackedAggregators:  []types.ValidatorIndex{3},
+	}, res.Subnets[0])
+}
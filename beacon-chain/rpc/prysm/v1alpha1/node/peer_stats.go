@@ -0,0 +1,100 @@
+package node
+
+import (
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Clients recognized in the agent strings of the peers, matched on the start of the agent.
+// Lodestar used to advertise the agent of its libp2p implementation.
+var agentClients = []struct {
+	prefix string
+	client string
+}{
+	{prefix: "prysm", client: "prysm"},
+	{prefix: "lighthouse", client: "lighthouse"},
+	{prefix: "teku", client: "teku"},
+	{prefix: "nimbus", client: "nimbus"},
+	{prefix: "lodestar", client: "lodestar"},
+	{prefix: "js-libp2p", client: "lodestar"},
+	{prefix: "grandine", client: "grandine"},
+	{prefix: "erigon", client: "erigon"},
+}
+
+// peerStats gathers the scores, request latency, bandwidth usage and agent of a connected peer.
+func (ns *Server) peerStats(pid peer.ID) *ethpb.PeerStats {
+	peers := ns.PeersFetcher.Peers()
+	scorers := peers.Scorers()
+	stats := &ethpb.PeerStats{
+		Score:              scorers.Score(pid),
+		BlockProviderScore: scorers.BlockProviderScorer().Score(pid),
+	}
+	if gossipScore, penalty, _, err := scorers.GossipScorer().GossipData(pid); err == nil {
+		stats.GossipScore = gossipScore
+		stats.BehaviourPenalty = penalty
+	}
+	if badResponses, err := scorers.BadResponsesScorer().Count(pid); err == nil {
+		stats.BadResponses = uint64(badResponses)
+	}
+	if requests, mean, max, err := peers.RequestLatency(pid); err == nil {
+		stats.Requests = requests
+		stats.MeanRequestLatencyMs = uint64(mean.Milliseconds())
+		stats.MaxRequestLatencyMs = uint64(max.Milliseconds())
+	}
+	if ns.PeerManager == nil {
+		return stats
+	}
+	bandwidth := ns.PeerManager.BandwidthForPeer(pid)
+	stats.BytesIn = uint64(bandwidth.TotalIn)
+	stats.BytesOut = uint64(bandwidth.TotalOut)
+	stats.RateIn = bandwidth.RateIn
+	stats.RateOut = bandwidth.RateOut
+	host := ns.PeerManager.Host()
+	if host == nil {
+		return stats
+	}
+	peerStore := host.Peerstore()
+	if protocols, err := peerStore.GetProtocols(pid); err == nil {
+		stats.Protocols = protocols
+	}
+	stats.PingLatencyMs = uint64(peerStore.LatencyEWMA(pid).Milliseconds())
+	if rawAgent, err := peerStore.Get(pid, "AgentVersion"); err == nil {
+		if agent, ok := rawAgent.(string); ok {
+			stats.AgentVersion = agent
+		}
+	}
+	stats.Client, stats.ClientVersion = parseAgent(stats.AgentVersion)
+	return stats
+}
+
+// parseAgent extracts the client name and version from the agent string of a peer, for example
+// Lighthouse/v2.3.1-5d8a7b8/x86_64-linux. The client is unknown when not recognized, and the
+// version is empty when no part of the agent looks like a version.
+func parseAgent(agent string) (string, string) {
+	lowerAgent := strings.ToLower(agent)
+	client := ""
+	for _, c := range agentClients {
+		if strings.HasPrefix(lowerAgent, c.prefix) {
+			client = c.client
+			break
+		}
+	}
+	if client == "" {
+		return "unknown", ""
+	}
+	parts := strings.Split(agent, "/")
+	for _, part := range parts[1:] {
+		if isVersion(part) {
+			return client, part
+		}
+	}
+	return client, ""
+}
+
+// isVersion returns true if the given string is a version number, with or without a v prefix.
+func isVersion(s string) bool {
+	s = strings.TrimPrefix(strings.ToLower(s), "v")
+	return len(s) > 0 && s[0] >= '0' && s[0] <= '9'
+}
@@ -0,0 +1,33 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestParseAgent(t *testing.T) {
+	tests := []struct {
+		agent   string
+		client  string
+		version string
+	}{
+		{agent: "Prysm/v2.1.0/a1b2c3d4", client: "prysm", version: "v2.1.0"},
+		{agent: "Lighthouse/v2.3.1-5d8a7b8/x86_64-linux", client: "lighthouse", version: "v2.3.1-5d8a7b8"},
+		{agent: "teku/teku/v22.5.1/linux-x86_64/-privatebuild-openjdk64bitservervm-java-17", client: "teku", version: "v22.5.1"},
+		{agent: "nimbus", client: "nimbus", version: ""},
+		{agent: "Lodestar/v0.37.0/2c1c7b2", client: "lodestar", version: "v0.37.0"},
+		{agent: "js-libp2p/0.36.2", client: "lodestar", version: "0.36.2"},
+		{agent: "Grandine/0.2.0/x86_64-linux", client: "grandine", version: "0.2.0"},
+		{agent: "erigon/caplin", client: "erigon", version: ""},
+		{agent: "rust-libp2p/0.44.0", client: "unknown", version: ""},
+		{agent: "", client: "unknown", version: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.agent, func(t *testing.T) {
+			client, version := parseAgent(tt.agent)
+			assert.Equal(t, tt.client, client)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
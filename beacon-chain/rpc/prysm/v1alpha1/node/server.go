@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/io/logs"
@@ -38,6 +39,7 @@ type Server struct {
 	BeaconDB             db.ReadOnlyDatabase
 	PeersFetcher         p2p.PeersProvider
 	PeerManager          p2p.PeerManager
+	MetadataProvider     p2p.MetadataProvider
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
 	POWChainInfoFetcher  powchain.ChainInfoFetcher
@@ -116,11 +118,33 @@ func (ns *Server) GetHost(_ context.Context, _ *empty.Empty) (*ethpb.HostData, e
 		}
 	}
 
-	return &ethpb.HostData{
+	host := &ethpb.HostData{
 		Addresses: stringAddr,
 		PeerId:    ns.PeerManager.PeerID().String(),
 		Enr:       enr,
-	}, nil
+	}
+	if record != nil {
+		host.EnrSeqNumber = record.Seq()
+	}
+	if ns.MetadataProvider != nil {
+		// Read the metadata once so that the sequence number and the subnets
+		// describe the same version of the node's metadata.
+		md := ns.MetadataProvider.Metadata()
+		if md != nil && !md.IsNil() {
+			host.MetadataSeqNumber = md.SequenceNumber()
+			host.AttnetsSubnets = subnetIndices(md.AttnetsBitfield().BitIndices())
+			host.SyncnetsSubnets = subnetIndices(md.SyncnetsBitfield().BitIndices())
+		}
+	}
+	return host, nil
+}
+
+func subnetIndices(bits []int) []uint64 {
+	indices := make([]uint64, len(bits))
+	for i, b := range bits {
+		indices[i] = uint64(b)
+	}
+	return indices
 }
 
 // GetPeer returns the data known about the peer defined by the provided peer id.
@@ -159,20 +183,25 @@ func (ns *Server) GetPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*ethpb
 			return nil, status.Errorf(codes.Internal, "Unable to serialize enr: %v", err)
 		}
 	}
+	var stats *ethpb.PeerStats
+	if connState == peers.PeerConnected {
+		stats = ns.peerStats(pid)
+	}
 	return &ethpb.Peer{
 		Address:         addr.String(),
 		Direction:       pbDirection,
 		ConnectionState: ethpb.ConnectionState(connState),
 		PeerId:          peerReq.PeerId,
 		Enr:             enr,
+		Stats:           stats,
 	}, nil
 }
 
 // ListPeers lists the peers connected to this node.
 func (ns *Server) ListPeers(ctx context.Context, _ *empty.Empty) (*ethpb.Peers, error) {
-	peers := ns.PeersFetcher.Peers().Connected()
-	res := make([]*ethpb.Peer, 0, len(peers))
-	for _, pid := range peers {
+	connected := ns.PeersFetcher.Peers().Connected()
+	res := make([]*ethpb.Peer, 0, len(connected))
+	for _, pid := range connected {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
@@ -213,6 +242,7 @@ func (ns *Server) ListPeers(ctx context.Context, _ *empty.Empty) (*ethpb.Peers,
 			ConnectionState: ethpb.ConnectionState_CONNECTED,
 			PeerId:          pid.String(),
 			Enr:             enr,
+			Stats:           ns.peerStats(pid),
 		})
 	}
 
@@ -9,12 +9,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -105,9 +107,20 @@ func TestNodeServer_GetHost(t *testing.T) {
 	record := lNode.Node().Record()
 	stringENR, err := p2p.SerializeENR(record)
 	require.NoError(t, err)
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(5, true)
+	attnets.SetBitAt(63, true)
+	syncnets := bitfield.NewBitvector4()
+	syncnets.SetBitAt(0, true)
+	syncnets.SetBitAt(3, true)
 	ns := &Server{
 		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost, Enr: record, PID: mP2P.BHost.ID()},
 		PeersFetcher: peersProvider,
+		MetadataProvider: &mockP2p.MockMetadataProvider{Data: wrapper.WrappedMetadataV1(&ethpb.MetaDataV1{
+			SeqNumber: 4,
+			Attnets:   attnets,
+			Syncnets:  syncnets,
+		})},
 	}
 	ethpb.RegisterNodeServer(server, ns)
 	reflection.Register(server)
@@ -115,6 +128,25 @@ func TestNodeServer_GetHost(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, mP2P.PeerID().String(), h.PeerId)
 	assert.Equal(t, stringENR, h.Enr)
+	assert.Equal(t, record.Seq(), h.EnrSeqNumber)
+	assert.Equal(t, uint64(4), h.MetadataSeqNumber)
+	assert.DeepEqual(t, []uint64{5, 63}, h.AttnetsSubnets)
+	assert.DeepEqual(t, []uint64{0, 3}, h.SyncnetsSubnets)
+}
+
+func TestNodeServer_GetHost_Phase0Metadata(t *testing.T) {
+	mP2P := mockP2p.NewTestP2P(t)
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(1, true)
+	ns := &Server{
+		PeerManager:      &mockP2p.MockPeerManager{BHost: mP2P.BHost, PID: mP2P.BHost.ID()},
+		MetadataProvider: &mockP2p.MockMetadataProvider{Data: wrapper.WrappedMetadataV0(&ethpb.MetaDataV0{SeqNumber: 1, Attnets: attnets})},
+	}
+	h, err := ns.GetHost(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), h.MetadataSeqNumber)
+	assert.DeepEqual(t, []uint64{1}, h.AttnetsSubnets)
+	assert.DeepEqual(t, []uint64{}, h.SyncnetsSubnets)
 }
 
 func TestNodeServer_GetPeer(t *testing.T) {
@@ -132,6 +164,32 @@ func TestNodeServer_GetPeer(t *testing.T) {
 	assert.Equal(t, firstPeer.String(), res.PeerId, "Unexpected peer ID")
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Direction), "Expected 1st peer to be an inbound connection")
 	assert.Equal(t, ethpb.ConnectionState_CONNECTED, res.ConnectionState, "Expected peer to be connected")
+	assert.NotNil(t, res.Stats, "Expected stats of connected peer")
+}
+
+func TestNodeServer_GetPeer_Stats(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	ns := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	firstPeer := peersProvider.Peers().All()[0]
+	require.NoError(t, mP2P.BHost.Peerstore().Put(firstPeer, "AgentVersion", "Lighthouse/v2.3.1-5d8a7b8/x86_64-linux"))
+	require.NoError(t, mP2P.BHost.Peerstore().AddProtocols(firstPeer, "/eth2/beacon_chain/req/status/1/ssz_snappy"))
+	peersProvider.Peers().AddRequestLatency(firstPeer, 100*time.Millisecond)
+	peersProvider.Peers().AddRequestLatency(firstPeer, 300*time.Millisecond)
+
+	res, err := ns.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	require.NotNil(t, res.Stats)
+	assert.Equal(t, "Lighthouse/v2.3.1-5d8a7b8/x86_64-linux", res.Stats.AgentVersion)
+	assert.Equal(t, "lighthouse", res.Stats.Client)
+	assert.Equal(t, "v2.3.1-5d8a7b8", res.Stats.ClientVersion)
+	assert.DeepEqual(t, []string{"/eth2/beacon_chain/req/status/1/ssz_snappy"}, res.Stats.Protocols)
+	assert.Equal(t, uint64(2), res.Stats.Requests)
+	assert.Equal(t, uint64(200), res.Stats.MeanRequestLatencyMs)
+	assert.Equal(t, uint64(300), res.Stats.MaxRequestLatencyMs)
 }
 
 func TestNodeServer_ListPeers(t *testing.T) {
@@ -148,6 +206,9 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, 2, len(res.Peers))
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Peers[0].Direction))
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
+	for _, p := range res.Peers {
+		assert.NotNil(t, p.Stats, "Expected stats of connected peer")
+	}
 }
 
 func TestNodeServer_GetETH1ConnectionStatus(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
@@ -12,6 +13,7 @@ import (
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	beaconState "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/rand"
@@ -105,6 +107,75 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 	}
 }
 
+// StreamProposerDuties returns the proposer duties of the current epoch for a list of validators
+// specified in the request object via a server-side stream. The stream sends out new duties at the
+// start of every epoch, and whenever a new head changes the block root the duties depend on.
+func (vs *Server) StreamProposerDuties(req *ethpb.ProposerDutiesRequest, stream ethpb.BeaconNodeValidator_StreamProposerDutiesServer) error {
+	if vs.SyncChecker.Syncing() {
+		return status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	genesisTime := vs.TimeFetcher.GenesisTime()
+	if genesisTime.IsZero() {
+		return status.Error(codes.Unavailable, "genesis time is not set")
+	}
+
+	// Subscribe before computing the first duties, so that no head change is missed.
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := vs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	var last *ethpb.ProposerDutiesResponse
+	sendDuties := func() error {
+		epoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+		res, err := vs.proposerDuties(stream.Context(), req, epoch)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not compute proposer duties: %v", err)
+		}
+		// Only changes of the duties are sent.
+		if last != nil && last.Epoch == res.Epoch && bytes.Equal(last.DependentRoot, res.DependentRoot) {
+			return nil
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Errorf(codes.Internal, "Could not send response over stream: %v", err)
+		}
+		last = res
+		return nil
+	}
+	if err := sendDuties(); err != nil {
+		return err
+	}
+
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	epochTicker := slots.NewSlotTicker(genesisTime, secondsPerEpoch)
+	defer epochTicker.Done()
+	for {
+		select {
+		case <-epochTicker.C():
+			if err := sendDuties(); err != nil {
+				return err
+			}
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.NewHead {
+				continue
+			}
+			head, ok := ev.Data.(*ethpbv1.EventHead)
+			if !ok {
+				return status.Errorf(codes.Internal, "Received incorrect data type over new head feed: %v", ev.Data)
+			}
+			if last != nil && bytes.Equal(head.CurrentDutyDependentRoot, last.DependentRoot) {
+				continue
+			}
+			if err := sendDuties(); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream context canceled")
+		case <-vs.Ctx.Done():
+			return status.Error(codes.Canceled, "RPC context canceled")
+		}
+	}
+}
+
 // Compute the validator duties from the head state's corresponding epoch
 // for validators public key / indices requested.
 func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
@@ -128,7 +199,7 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
 		}
-		s, err = transition.ProcessSlotsUsingNextSlotCache(ctx, s, headRoot, epochStartSlot)
+		s, err = vs.epochBoundaryState(ctx, s, headRoot, req.Epoch)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
 		}
@@ -145,7 +216,14 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 
 	validatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	nextValidatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
-	for _, pubKey := range req.PublicKeys {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	// Resolve the validator indices in a single batch, as the requests of validator clients with
+	// large key sets are issued every epoch.
+	indices := vs.HeadFetcher.HeadPublicKeysToValidatorIndices(pubKeys)
+	for i, pubKey := range req.PublicKeys {
 		if ctx.Err() != nil {
 			return nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
 		}
@@ -155,7 +233,7 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 		nextAssignment := &ethpb.DutiesResponse_Duty{
 			PublicKey: pubKey,
 		}
-		idx, ok := s.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		idx, ok := indices[pubKeys[i]]
 		if ok {
 			s := assignmentStatus(s, idx)
 
@@ -208,6 +286,12 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 				}
 			}
 			nextAssignment.IsSyncCommittee = assignment.IsSyncCommittee
+			// The next period sync committee is known for the whole current period, which lets
+			// validator clients warn their operators ahead of upcoming sync committee duty.
+			assignment.IsNextPeriodSyncCommittee, err = helpers.IsNextPeriodSyncCommittee(s, idx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not determine next period sync committee: %v", err)
+			}
 
 			// Next epoch sync committee duty is assigned with next period sync committee only during
 			// sync period epoch boundary (ie. EPOCHS_PER_SYNC_COMMITTEE_PERIOD - 1). Else wise
@@ -241,6 +325,98 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 	}, nil
 }
 
+// Compute the proposer duties of the epoch from the head state for the validators of the request,
+// along with the block root they depend on.
+func (vs *Server) proposerDuties(ctx context.Context, req *ethpb.ProposerDutiesRequest, epoch types.Epoch) (*ethpb.ProposerDutiesResponse, error) {
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	epochStartSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if s.Slot() < epochStartSlot {
+		s, err = vs.epochBoundaryState(ctx, s, headRoot, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", epochStartSlot)
+		}
+	}
+
+	// The proposer duties depend on the block root of the last slot of the previous epoch, or on the
+	// genesis block root during the first epoch.
+	dependentRootSlot := epochStartSlot
+	if epochStartSlot > 0 {
+		dependentRootSlot = epochStartSlot - 1
+	}
+	dependentRoot := headRoot
+	if dependentRootSlot < s.Slot() {
+		dependentRoot, err = helpers.BlockRootAtSlot(s, dependentRootSlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get dependent root")
+		}
+	}
+
+	// Computing the assignments advances the slot of the state, so it comes last.
+	_, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, s, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute committee assignments")
+	}
+
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	indices := vs.HeadFetcher.HeadPublicKeysToValidatorIndices(pubKeys)
+	duties := make([]*ethpb.ProposerDutiesResponse_Duty, 0, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		idx, ok := indices[pubKeys[i]]
+		if !ok {
+			continue
+		}
+		for _, slot := range proposerIndexToSlots[idx] {
+			vs.ProposerSlotIndexCache.SetProposerAndPayloadIDs(slot, idx, [8]byte{} /* payloadID */)
+		}
+		duties = append(duties, &ethpb.ProposerDutiesResponse_Duty{
+			PublicKey:      pubKey,
+			ValidatorIndex: idx,
+			ProposerSlots:  proposerIndexToSlots[idx],
+		})
+	}
+	return &ethpb.ProposerDutiesResponse{
+		Epoch:         epoch,
+		DependentRoot: bytesutil.SafeCopyBytes(dependentRoot),
+		Duties:        duties,
+	}, nil
+}
+
+// epochBoundaryState returns the state at the start slot of the epoch built on top of the block of the given
+// root, processing the empty slots up to it from the given state only if it is not cached yet.
+func (vs *Server) epochBoundaryState(ctx context.Context, s beaconState.BeaconState, blockRoot []byte, epoch types.Epoch) (beaconState.BeaconState, error) {
+	root := bytesutil.ToBytes32(blockRoot)
+	if vs.BoundaryStateCache != nil {
+		if st := vs.BoundaryStateCache.Get(root, epoch); st != nil {
+			return st, nil
+		}
+	}
+	epochStartSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	s, err = transition.ProcessSlotsUsingNextSlotCache(ctx, s, blockRoot, epochStartSlot)
+	if err != nil {
+		return nil, err
+	}
+	if vs.BoundaryStateCache != nil {
+		vs.BoundaryStateCache.Add(root, epoch, s)
+	}
+	return s, nil
+}
+
 // AssignValidatorToSubnet checks the status and pubkey of a particular validator
 // to discern whether persistent subnets need to be registered for them.
 func (vs *Server) AssignValidatorToSubnet(pubkey []byte, status ethpb.ValidatorStatus) {
@@ -267,7 +443,7 @@ func (vs *Server) AssignValidatorToSubnet(pubkey []byte, status ethpb.ValidatorS
 	cache.SubnetIDs.AddPersistentCommittee(pubkey, assignedIdxs, totalDuration*time.Second)
 }
 
-func registerSyncSubnetCurrentPeriod(s beaconState.BeaconState, epoch types.Epoch, pubKey []byte, status ethpb.ValidatorStatus) error {
+func registerSyncSubnetCurrentPeriod(s beaconState.SyncCommitteeState, epoch types.Epoch, pubKey []byte, status ethpb.ValidatorStatus) error {
 	committee, err := s.CurrentSyncCommittee()
 	if err != nil {
 		return err
@@ -277,7 +453,7 @@ func registerSyncSubnetCurrentPeriod(s beaconState.BeaconState, epoch types.Epoc
 	return nil
 }
 
-func registerSyncSubnetNextPeriod(s beaconState.BeaconState, epoch types.Epoch, pubKey []byte, status ethpb.ValidatorStatus) error {
+func registerSyncSubnetNextPeriod(s beaconState.SyncCommitteeState, epoch types.Epoch, pubKey []byte, status ethpb.ValidatorStatus) error {
 	committee, err := s.NextSyncCommittee()
 	if err != nil {
 		return err
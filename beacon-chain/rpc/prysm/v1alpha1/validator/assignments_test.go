@@ -101,6 +101,42 @@ func TestGetDuties_OK(t *testing.T) {
 	}
 }
 
+func TestGetDuties_NextEpoch_CachesBoundaryState(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	genesis := util.NewBeaconBlock()
+	bs, _ := util.DeterministicGenesisState(t, 64)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+
+	chain := &mockChain.ChainService{
+		State: bs, Root: genesisRoot[:], Genesis: time.Now(),
+	}
+	vs := &Server{
+		HeadFetcher:            chain,
+		TimeFetcher:            chain,
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
+		BoundaryStateCache:     cache.NewEpochBoundaryStateCache(),
+	}
+
+	pubKey := bs.PubkeyAtIndex(0)
+	req := &ethpb.DutiesRequest{
+		PublicKeys: [][]byte{pubKey[:]},
+		Epoch:      1,
+	}
+	res, err := vs.GetDuties(context.Background(), req)
+	require.NoError(t, err, "Could not call epoch committee assignment")
+	st := vs.BoundaryStateCache.Get(genesisRoot, 1)
+	require.NotNil(t, st)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, st.Slot())
+
+	// The duties are computed from the cached state for the following requests.
+	cached, err := vs.GetDuties(context.Background(), req)
+	require.NoError(t, err, "Could not call epoch committee assignment")
+	assert.DeepEqual(t, res.CurrentEpochDuties, cached.CurrentEpochDuties)
+}
+
 func TestGetAltairDuties_SyncCommitteeOK(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.MainnetConfig().Copy()
@@ -200,6 +236,8 @@ func TestGetAltairDuties_SyncCommitteeOK(t *testing.T) {
 	require.NoError(t, err, "Could not call epoch committee assignment")
 	for i := 0; i < len(res.CurrentEpochDuties); i++ {
 		require.NotEqual(t, res.CurrentEpochDuties[i].IsSyncCommittee, res.NextEpochDuties[i].IsSyncCommittee)
+		// The next period sync committee membership is known ahead of the boundary.
+		require.Equal(t, res.NextEpochDuties[i].IsSyncCommittee, res.CurrentEpochDuties[i].IsNextPeriodSyncCommittee)
 	}
 }
 
@@ -605,6 +643,76 @@ func TestStreamDuties_OK_ChainReorg(t *testing.T) {
 	cancel()
 }
 
+func TestStreamProposerDuties_OK(t *testing.T) {
+	bs, _ := util.DeterministicGenesisState(t, 64)
+	// The duties of the first epoch depend on the genesis block root.
+	require.NoError(t, bs.SetSlot(1))
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, bs.UpdateBlockRootAtIndex(0, genesisRoot))
+	reorgedState := bs.Copy()
+	reorgedRoot := [32]byte{'b'}
+	require.NoError(t, reorgedState.UpdateBlockRootAtIndex(0, reorgedRoot))
+	pubKeys := make([][]byte, bs.NumValidators())
+	for i := range pubKeys {
+		pk := bs.PubkeyAtIndex(types.ValidatorIndex(i))
+		pubKeys[i] = pk[:]
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	headFetcher := &mockChain.ChainService{State: bs, Root: genesisRoot[:]}
+	vs := &Server{
+		Ctx:                    ctx,
+		HeadFetcher:            headFetcher,
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+		TimeFetcher:            &mockChain.ChainService{Genesis: time.Now()},
+		StateNotifier:          &mockChain.MockStateNotifier{},
+		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
+	}
+
+	req := &ethpb.ProposerDutiesRequest{PublicKeys: pubKeys}
+	wantedRes, err := vs.proposerDuties(ctx, req, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genesisRoot[:], wantedRes.DependentRoot)
+	require.Equal(t, len(pubKeys), len(wantedRes.Duties))
+	var proposals int
+	for _, duty := range wantedRes.Duties {
+		proposals += len(duty.ProposerSlots)
+	}
+	assert.Equal(t, true, proposals > 0)
+
+	// A new head changing the dependent root of the duties triggers a new response.
+	reorgedRes := &ethpb.ProposerDutiesResponse{
+		Epoch:         wantedRes.Epoch,
+		DependentRoot: reorgedRoot[:],
+		Duties:        wantedRes.Duties,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	exitRoutine := make(chan bool)
+	mockStream := mock.NewMockBeaconNodeValidator_StreamProposerDutiesServer(ctrl)
+	mockStream.EXPECT().Send(wantedRes).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Send(reorgedRes).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	go func(tt *testing.T) {
+		assert.ErrorContains(tt, "context canceled", vs.StreamProposerDuties(req, mockStream))
+	}(t)
+	<-exitRoutine
+
+	headFetcher.State = reorgedState
+	for sent := 0; sent == 0; {
+		sent = vs.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.NewHead,
+			Data: &ethpbv1.EventHead{CurrentDutyDependentRoot: reorgedRoot[:]},
+		})
+	}
+	<-exitRoutine
+	cancel()
+}
+
 func TestAssignValidatorToSubnet(t *testing.T) {
 	k := pubKey(3)
 
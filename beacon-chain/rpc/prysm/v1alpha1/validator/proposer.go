@@ -13,12 +13,15 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -60,6 +63,32 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 	return vs.getBellatrixBeaconBlock(ctx, req)
 }
 
+// GetBeaconBlockWithPayload is called by a proposer building its own execution payload to request
+// a block to sign embedding it. A full payload is embedded in a Bellatrix block, and a payload header
+// in a blinded Bellatrix block. The execution data is validated against the state the block is built
+// upon, and the signed block is then proposed through ProposeBeaconBlock.
+func (vs *Server) GetBeaconBlockWithPayload(ctx context.Context, req *ethpb.BlockWithPayloadRequest) (*ethpb.GenericBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.GetBeaconBlockWithPayload")
+	defer span.End()
+	if req.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "Missing block request")
+	}
+	if (req.ExecutionPayload == nil) == (req.ExecutionPayloadHeader == nil) {
+		return nil, status.Error(codes.InvalidArgument, "Exactly one of the execution payload and the execution payload header must be provided")
+	}
+	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Request.Slot)))
+	if slots.ToEpoch(req.Request.Slot) < params.BeaconConfig().BellatrixForkEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot embed an execution payload in a block before the Bellatrix fork epoch %d", params.BeaconConfig().BellatrixForkEpoch)
+	}
+
+	// An optimistic validator MUST NOT produce a block (i.e., sign across the DOMAIN_BEACON_PROPOSER domain).
+	if err := vs.optimisticStatus(ctx); err != nil {
+		return nil, err
+	}
+
+	return vs.getBellatrixBeaconBlockWithPayload(ctx, req)
+}
+
 // GetBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot.
 //
@@ -128,7 +157,7 @@ func (vs *Server) PrepareBeaconProposer(
 	return &emptypb.Empty{}, nil
 }
 
-func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.SignedBeaconBlock) (resp *ethpb.ProposeResponse, err error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.proposeGenericBeaconBlock")
 	defer span.End()
 	root, err := blk.Block().HashTreeRoot()
@@ -136,10 +165,37 @@ func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.
 		return nil, fmt.Errorf("could not tree hash block: %v", err)
 	}
 
+	// The audit trail of the proposal is saved once the block is published or the proposal failed.
+	slot := blk.Block().Slot()
+	audit := &ethpb.ProposalAudit{
+		ProposerIndex: blk.Block().ProposerIndex(),
+		BlockRoot:     root[:],
+	}
+	defer func() {
+		if err != nil {
+			audit.Error = err.Error()
+		}
+		vs.auditProposal(ctx, slot, audit)
+	}()
+
+	blinded := blk.Version() == version.BellatrixBlind
+	if blinded {
+		audit.SignedHeaderRoot = root[:]
+		audit.HeaderSignature = blk.Signature()
+		audit.HeaderSignedTimeMs = nowMilli()
+	}
 	blk, err = vs.unblindBuilderBlock(ctx, blk)
 	if err != nil {
 		return nil, err
 	}
+	if blinded && blk.Version() != version.BellatrixBlind {
+		payload, err := blk.Block().Body().Execution()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get execution payload")
+		}
+		audit.RevealedPayloadHash = payload.BlockHash()
+		audit.PayloadRevealedTimeMs = nowMilli()
+	}
 
 	// Do not block proposal critical path with debug logging or block feed updates.
 	defer func() {
@@ -152,9 +208,11 @@ func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk interfaces.
 	}()
 
 	// Broadcast the new block to the network.
+	audit.BroadcastStartTimeMs = nowMilli()
 	if err := vs.P2P.Broadcast(ctx, blk.Proto()); err != nil {
 		return nil, fmt.Errorf("could not broadcast block: %v", err)
 	}
+	audit.BroadcastEndTimeMs = nowMilli()
 	log.WithFields(logrus.Fields{
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
@@ -201,3 +259,29 @@ func (vs *Server) SubmitValidatorRegistration(ctx context.Context, reg *ethpb.Si
 
 	return &emptypb.Empty{}, nil
 }
+
+// GetValidatorRegistrations returns the latest signed validator registrations stored for the requested
+// validators. Validators without a registration, or unknown to the beacon node, are skipped.
+func (vs *Server) GetValidatorRegistrations(ctx context.Context, req *ethpb.ValidatorRegistrationsRequest) (*ethpb.SignedValidatorRegistrationsV1, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	indices := vs.HeadFetcher.HeadPublicKeysToValidatorIndices(pubKeys)
+	regs := make([]*ethpb.SignedValidatorRegistrationV1, 0, len(indices))
+	for _, pubKey := range pubKeys {
+		idx, ok := indices[pubKey]
+		if !ok {
+			continue
+		}
+		reg, err := vs.BeaconDB.SignedRegistrationByValidatorID(ctx, idx)
+		switch {
+		case errors.Is(err, kv.ErrNotFoundRegistration):
+			continue
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "Could not get validator registration: %v", err)
+		}
+		regs = append(regs, reg)
+	}
+	return &ethpb.SignedValidatorRegistrationsV1{Messages: regs}, nil
+}
@@ -8,10 +8,8 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
-	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	synccontribution "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation/sync_contribution"
 	"go.opencensus.io/trace"
 )
 
@@ -75,65 +73,11 @@ func (vs *Server) getAltairBeaconBlock(ctx context.Context, req *ethpb.BlockRequ
 	return blk, nil
 }
 
-// getSyncAggregate retrieves the sync contributions from the pool to construct the sync aggregate object.
-// The contributions are filtered based on matching of the input root and slot then profitability.
+// getSyncAggregate retrieves the sync aggregate of the input slot and root from the pool, built out of
+// the best contribution of each sync subcommittee.
 func (vs *Server) getSyncAggregate(ctx context.Context, slot types.Slot, root [32]byte) (*ethpb.SyncAggregate, error) {
-	ctx, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
+	_, span := trace.StartSpan(ctx, "ProposerServer.getSyncAggregate")
 	defer span.End()
 
-	// Contributions have to match the input root
-	contributions, err := vs.SyncCommitteePool.SyncCommitteeContributions(slot)
-	if err != nil {
-		return nil, err
-	}
-	proposerContributions := proposerSyncContributions(contributions).filterByBlockRoot(root)
-
-	// Each sync subcommittee is 128 bits and the sync committee is 512 bits for mainnet.
-	var bitsHolder [][]byte
-	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
-		bitsHolder = append(bitsHolder, ethpb.NewSyncCommitteeAggregationBits())
-	}
-	sigsHolder := make([]bls.Signature, 0, params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount)
-
-	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
-		cs := proposerContributions.filterBySubIndex(i)
-		aggregates, err := synccontribution.Aggregate(cs)
-		if err != nil {
-			return nil, err
-		}
-
-		// Retrieve the most profitable contribution
-		deduped, err := proposerSyncContributions(aggregates).dedup()
-		if err != nil {
-			return nil, err
-		}
-		c := deduped.mostProfitable()
-		if c == nil {
-			continue
-		}
-		bitsHolder[i] = c.AggregationBits
-		sig, err := bls.SignatureFromBytes(c.Signature)
-		if err != nil {
-			return nil, err
-		}
-		sigsHolder = append(sigsHolder, sig)
-	}
-
-	// Aggregate all the contribution bits and signatures.
-	var syncBits []byte
-	for _, b := range bitsHolder {
-		syncBits = append(syncBits, b...)
-	}
-	syncSig := bls.AggregateSignatures(sigsHolder)
-	var syncSigBytes [96]byte
-	if syncSig == nil {
-		syncSigBytes = [96]byte{0xC0} // Infinity signature if itself is nil.
-	} else {
-		syncSigBytes = bytesutil.ToBytes96(syncSig.Marshal())
-	}
-
-	return &ethpb.SyncAggregate{
-		SyncCommitteeBits:      syncBits,
-		SyncCommitteeSignature: syncSigBytes[:],
-	}, nil
+	return vs.SyncCommitteePool.SyncAggregate(slot, root)
 }
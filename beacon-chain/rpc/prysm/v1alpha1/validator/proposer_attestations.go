@@ -10,12 +10,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation"
 	attaggregation "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -69,6 +71,18 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
+	if features.Get().EnableBlockPackingOptimizer {
+		packed, reward, err := deduped.packByReward(ctx, latestState)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not pack attestations")
+		}
+		log.WithFields(logrus.Fields{
+			"candidates":   len(deduped),
+			"attestations": len(packed),
+			"rewardGwei":   reward,
+		}).Debug("Packed attestations by proposer reward")
+		return packed, nil
+	}
 	sorted, err := deduped.sortByProfitability()
 	if err != nil {
 		return nil, err
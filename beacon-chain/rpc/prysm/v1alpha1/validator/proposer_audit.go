@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+)
+
+// updateProposalAudit applies the update to the stored audit trail of the block proposal of the slot, creating it
+// if none is stored. Failures are only logged, as the audit must never fail a proposal.
+func (vs *Server) updateProposalAudit(ctx context.Context, slot types.Slot, update func(audit *ethpb.ProposalAudit)) {
+	if vs.BeaconDB == nil {
+		return
+	}
+	audit, err := vs.BeaconDB.ProposalAudit(ctx, slot)
+	if err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Could not retrieve proposal audit")
+		return
+	}
+	if audit == nil {
+		audit = &ethpb.ProposalAudit{Slot: slot}
+	}
+	update(audit)
+	if err := vs.BeaconDB.SaveProposalAudit(ctx, audit); err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Could not save proposal audit")
+	}
+}
+
+// auditBuilderBid appends the bid received from the builder to the audit trail of the proposal of the slot.
+func (vs *Server) auditBuilderBid(ctx context.Context, slot types.Slot, idx types.ValidatorIndex, bid *ethpb.SignedBuilderBid, received time.Time) {
+	vs.updateProposalAudit(ctx, slot, func(audit *ethpb.ProposalAudit) {
+		audit.ProposerIndex = idx
+		audit.Bids = append(audit.Bids, &ethpb.BuilderBidAudit{
+			BuilderPubkey:  bid.Message.Pubkey,
+			Value:          bid.Message.Value,
+			BlockHash:      bid.Message.Header.BlockHash,
+			Signature:      bid.Signature,
+			ReceivedTimeMs: unixMilli(received),
+		})
+	})
+}
+
+// auditSelectedBid marks the last bid received for the slot as the one whose header was used for the block given
+// to the proposer.
+func (vs *Server) auditSelectedBid(ctx context.Context, slot types.Slot) {
+	vs.updateProposalAudit(ctx, slot, func(audit *ethpb.ProposalAudit) {
+		if len(audit.Bids) == 0 {
+			log.WithField("slot", slot).Warn("No builder bid to mark as selected in proposal audit")
+			return
+		}
+		audit.Bids[len(audit.Bids)-1].Selected = true
+	})
+}
+
+// auditProposal records the publication steps of the proposal, which replace the ones of any previous
+// publication of a block at the same slot.
+func (vs *Server) auditProposal(ctx context.Context, slot types.Slot, proposal *ethpb.ProposalAudit) {
+	vs.updateProposalAudit(ctx, slot, func(audit *ethpb.ProposalAudit) {
+		audit.ProposerIndex = proposal.ProposerIndex
+		audit.SignedHeaderRoot = proposal.SignedHeaderRoot
+		audit.HeaderSignature = proposal.HeaderSignature
+		audit.HeaderSignedTimeMs = proposal.HeaderSignedTimeMs
+		audit.RevealedPayloadHash = proposal.RevealedPayloadHash
+		audit.PayloadRevealedTimeMs = proposal.PayloadRevealedTimeMs
+		audit.BlockRoot = proposal.BlockRoot
+		audit.BroadcastStartTimeMs = proposal.BroadcastStartTimeMs
+		audit.BroadcastEndTimeMs = proposal.BroadcastEndTimeMs
+		audit.Error = proposal.Error
+	})
+}
+
+func unixMilli(t time.Time) uint64 {
+	return uint64(t.UnixMilli())
+}
+
+func nowMilli() uint64 {
+	return unixMilli(prysmTime.Now())
+}
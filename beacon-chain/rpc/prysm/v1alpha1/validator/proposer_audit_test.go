@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/beacon-chain/builder/testing"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_auditBuilderBid(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	vs := &Server{BeaconDB: db}
+
+	bid := func(value byte) *ethpb.SignedBuilderBid {
+		return &ethpb.SignedBuilderBid{
+			Message: &ethpb.BuilderBid{
+				Header: &enginev1.ExecutionPayloadHeader{BlockHash: bytesutil.PadTo([]byte{value}, 32)},
+				Value:  bytesutil.PadTo([]byte{value}, 32),
+				Pubkey: bytesutil.PadTo([]byte{value}, fieldparams.BLSPubkeyLength),
+			},
+			Signature: bytesutil.PadTo([]byte{value}, fieldparams.BLSSignatureLength),
+		}
+	}
+	received := time.Unix(100, 0)
+	vs.auditBuilderBid(ctx, 5, 3, bid(1), received)
+	vs.auditBuilderBid(ctx, 5, 3, bid(2), received.Add(time.Second))
+	vs.auditSelectedBid(ctx, 5)
+
+	audit, err := db.ProposalAudit(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 3, int(audit.ProposerIndex))
+	require.Equal(t, 2, len(audit.Bids))
+	assert.DeepEqual(t, bid(1).Message.Pubkey, audit.Bids[0].BuilderPubkey)
+	assert.DeepEqual(t, bid(1).Message.Header.BlockHash, audit.Bids[0].BlockHash)
+	assert.Equal(t, uint64(100000), audit.Bids[0].ReceivedTimeMs)
+	assert.Equal(t, false, audit.Bids[0].Selected)
+	assert.DeepEqual(t, bid(2).Message.Value, audit.Bids[1].Value)
+	assert.DeepEqual(t, bid(2).Signature, audit.Bids[1].Signature)
+	assert.Equal(t, uint64(101000), audit.Bids[1].ReceivedTimeMs)
+	assert.Equal(t, true, audit.Bids[1].Selected)
+}
+
+func TestProposer_ProposeBlock_AuditsBlindedProposal(t *testing.T) {
+	ctx := context.Background()
+	payload := &enginev1.ExecutionPayload{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength),
+	}
+	blk := util.NewBlindedBeaconBlockBellatrix()
+	blk.Block.Slot = 5
+	blk.Block.ProposerIndex = 2
+	blk.Signature = bytesutil.PadTo([]byte{'b'}, fieldparams.BLSSignatureLength)
+	blindedRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	db := dbutil.SetupDB(t)
+	beaconState, _ := util.DeterministicGenesisStateBellatrix(t, 64)
+	newServer := func(builder *builderTest.MockBuilderService) *Server {
+		c := &mock.ChainService{Root: blk.Block.ParentRoot, State: beaconState}
+		return &Server{
+			BeaconDB:      db,
+			BlockBuilder:  builder,
+			BlockReceiver: c,
+			BlockNotifier: c.BlockNotifier(),
+			P2P:           mockp2p.NewTestP2P(t),
+		}
+	}
+	req := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}}
+
+	vs := newServer(&builderTest.MockBuilderService{HasConfigured: true, Payload: payload})
+	res, err := vs.ProposeBeaconBlock(ctx, req)
+	require.NoError(t, err)
+	audit, err := vs.BeaconDB.ProposalAudit(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 2, int(audit.ProposerIndex))
+	assert.DeepEqual(t, blindedRoot[:], audit.SignedHeaderRoot)
+	assert.DeepEqual(t, blk.Signature, audit.HeaderSignature)
+	assert.DeepEqual(t, payload.BlockHash, audit.RevealedPayloadHash)
+	assert.DeepEqual(t, res.BlockRoot, audit.BlockRoot)
+	assert.Equal(t, true, audit.HeaderSignedTimeMs > 0)
+	assert.Equal(t, true, audit.PayloadRevealedTimeMs >= audit.HeaderSignedTimeMs)
+	assert.Equal(t, true, audit.BroadcastStartTimeMs >= audit.PayloadRevealedTimeMs)
+	assert.Equal(t, true, audit.BroadcastEndTimeMs >= audit.BroadcastStartTimeMs)
+	assert.Equal(t, "", audit.Error)
+
+	t.Run("payload not revealed", func(t *testing.T) {
+		blk := util.NewBlindedBeaconBlockBellatrix()
+		blk.Block.Slot = 6
+		req := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}}
+		vs := newServer(&builderTest.MockBuilderService{HasConfigured: true, ErrSubmitBlindedBlock: errors.New("bad")})
+		_, err := vs.ProposeBeaconBlock(ctx, req)
+		require.ErrorContains(t, "bad", err)
+		audit, err := vs.BeaconDB.ProposalAudit(ctx, 6)
+		require.NoError(t, err)
+		blindedRoot, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, blindedRoot[:], audit.SignedHeaderRoot)
+		assert.Equal(t, 0, len(audit.RevealedPayloadHash))
+		assert.Equal(t, uint64(0), audit.BroadcastStartTimeMs)
+		assert.Equal(t, true, audit.Error != "")
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition/interop"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -20,7 +22,10 @@ import (
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // builderGetPayloadMissCount tracks the number of misses when validator tries to get a payload from builder
@@ -29,6 +34,13 @@ var builderGetPayloadMissCount = promauto.NewCounter(prometheus.CounterOpts{
 	Help: "The number of get payload misses for validator requests to builder",
 })
 
+// builderGasLimitMismatchCount tracks the number of builder headers with a gas limit that does not follow the gas
+// limit registered by the proposer.
+var builderGasLimitMismatchCount = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "builder_gas_limit_mismatch_count",
+	Help: "The number of builder headers with a gas limit that does not follow the registered gas limit",
+})
+
 // blockBuilderTimeout is the maximum amount of time allowed for a block builder to respond to a
 // block request. This value is known as `BUILDER_PROPOSAL_DELAY_TOLERANCE` in builder spec.
 const blockBuilderTimeout = 1 * time.Second
@@ -48,6 +60,7 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 				"back to local execution client")
 			builderGetPayloadMissCount.Inc()
 		} else if builderReady {
+			vs.auditSelectedBid(ctx, req.Slot)
 			return b, nil
 		}
 	} else if err != nil {
@@ -56,11 +69,82 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 			"validatorIndex": altairBlk.ProposerIndex,
 		}).Errorf("Could not determine validator has registered. Default to local execution client: %v", err)
 	}
-	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex)
+	payload, err := vs.getExecutionPayload(ctx, req.Slot, altairBlk.ProposerIndex, bytesutil.ToBytes32(altairBlk.ParentRoot))
 	if err != nil {
 		return nil, err
 	}
+	return vs.buildPayloadBlock(ctx, altairBlk, payload)
+}
+
+// This function constructs the block given the input altair block and the execution payload provided
+// by the validator client, after validating the payload against the state the block is built upon.
+func (vs *Server) getBellatrixBeaconBlockWithPayload(ctx context.Context, req *ethpb.BlockWithPayloadRequest) (*ethpb.GenericBeaconBlock, error) {
+	altairBlk, err := vs.buildAltairBeaconBlock(ctx, req.Request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build block: %v", err)
+	}
 
+	var data interfaces.ExecutionData
+	if req.ExecutionPayload != nil {
+		data, err = wrapper.WrappedExecutionPayload(req.ExecutionPayload)
+	} else {
+		data, err = wrapper.WrappedExecutionPayloadHeader(req.ExecutionPayloadHeader)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid execution data: %v", err)
+	}
+	if err := vs.validateProvidedExecutionData(ctx, altairBlk, data); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid execution data: %v", err)
+	}
+
+	var blk *ethpb.GenericBeaconBlock
+	if req.ExecutionPayload != nil {
+		blk, err = vs.buildPayloadBlock(ctx, altairBlk, req.ExecutionPayload)
+	} else {
+		blk, err = vs.buildHeaderBlock(ctx, altairBlk, req.ExecutionPayloadHeader)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not build block: %v", err)
+	}
+	return blk, nil
+}
+
+// This function validates the execution data provided by the validator client against the parent state of
+// the input altair block advanced to its slot. Before the merge transition completes, only an empty execution
+// data is accepted as the merge transition block is left to the execution client.
+func (vs *Server) validateProvidedExecutionData(ctx context.Context, b *ethpb.BeaconBlockAltair, data interfaces.ExecutionData) error {
+	parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+	st, err := vs.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve parent state")
+	}
+	st, err = transition.ProcessSlotsUsingNextSlotCache(ctx, st, parentRoot[:], b.Slot)
+	if err != nil {
+		return errors.Wrap(err, "could not advance parent state")
+	}
+	complete, err := blocks.IsMergeTransitionComplete(st)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		isEmpty, err := wrapper.IsEmptyExecutionData(data)
+		if err != nil {
+			return err
+		}
+		if !isEmpty {
+			return errors.New("merge transition is not complete, execution data must be empty")
+		}
+		return nil
+	}
+	if err := blocks.ValidatePayloadWhenMergeCompletes(st, data); err != nil {
+		return err
+	}
+	return blocks.ValidatePayload(st, data)
+}
+
+// This function constructs the block given the input altair block and the execution payload. It returns a generic
+// beacon block for signing.
+func (vs *Server) buildPayloadBlock(ctx context.Context, altairBlk *ethpb.BeaconBlockAltair, payload *enginev1.ExecutionPayload) (*ethpb.GenericBeaconBlock, error) {
 	blk := &ethpb.BeaconBlockBellatrix{
 		Slot:          altairBlk.Slot,
 		ProposerIndex: altairBlk.ProposerIndex,
@@ -95,10 +179,10 @@ func (vs *Server) getBellatrixBeaconBlock(ctx context.Context, req *ethpb.BlockR
 	return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
 }
 
-// This function retrieves the payload header given the slot number and the validator index.
-// It's a no-op if the latest head block is not versioned bellatrix.
-func (vs *Server) getPayloadHeader(ctx context.Context, slot types.Slot, idx types.ValidatorIndex) (*enginev1.ExecutionPayloadHeader, error) {
-	b, err := vs.HeadFetcher.HeadBlock(ctx)
+// This function retrieves the payload header given the slot number, the validator index and the root of the
+// block the proposal is built on. It's a no-op if that block is not versioned bellatrix.
+func (vs *Server) getPayloadHeader(ctx context.Context, slot types.Slot, idx types.ValidatorIndex, parentRoot [32]byte) (*enginev1.ExecutionPayloadHeader, error) {
+	b, err := vs.proposalParentBlock(ctx, parentRoot)
 	if err != nil {
 		return nil, err
 	}
@@ -117,14 +201,44 @@ func (vs *Server) getPayloadHeader(ctx context.Context, slot types.Slot, idx typ
 	if err != nil {
 		return nil, err
 	}
+	vs.auditBuilderBid(ctx, slot, idx, bid, prysmTime.Now())
 	log.WithFields(logrus.Fields{
 		"bid":           bytesutil.BytesToUint64BigEndian(bid.Message.Value),
 		"builderPubKey": fmt.Sprintf("%#x", bid.Message.Pubkey),
 		"blockHash":     fmt.Sprintf("%#x", bid.Message.Header.BlockHash),
+		"gasLimit":      bid.Message.Header.GasLimit,
 	}).Info("Received header with bid")
+	vs.checkBuilderGasLimit(ctx, idx, h.GasLimit(), bid.Message.Header.GasLimit)
 	return bid.Message.Header, nil
 }
 
+// checkBuilderGasLimit compares the gas limit of a builder header with the gas limit registered by the proposer.
+// The gas limit of a block may only move from the gas limit of its parent towards the registered gas limit by a
+// bounded amount, so the header is expected to carry the gas limit given by that adjustment. A mismatch is reported
+// but does not reject the header, as the registered gas limit is a preference of the proposer.
+func (vs *Server) checkBuilderGasLimit(ctx context.Context, idx types.ValidatorIndex, parentGasLimit, gasLimit uint64) {
+	if vs.BeaconDB == nil {
+		return
+	}
+	reg, err := vs.BeaconDB.RegistrationByValidatorID(ctx, idx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get validator registration to check builder gas limit")
+		return
+	}
+	expected := core.CalcGasLimit(parentGasLimit, reg.GasLimit)
+	if gasLimit == expected {
+		return
+	}
+	builderGasLimitMismatchCount.Inc()
+	log.WithFields(logrus.Fields{
+		"validatorIndex":    idx,
+		"gasLimit":          gasLimit,
+		"expectedGasLimit":  expected,
+		"preferredGasLimit": reg.GasLimit,
+		"parentGasLimit":    parentGasLimit,
+	}).Warn("Builder header gas limit does not follow the registered gas limit")
+}
+
 // This function constructs the builder block given the input altair block and the header. It returns a generic beacon block for signing
 func (vs *Server) buildHeaderBlock(ctx context.Context, b *ethpb.BeaconBlockAltair, h *enginev1.ExecutionPayloadHeader) (*ethpb.GenericBeaconBlock, error) {
 	if b == nil || b.Body == nil {
@@ -294,7 +408,7 @@ func (vs *Server) getAndBuildHeaderBlock(ctx context.Context, b *ethpb.BeaconBlo
 	if !ready {
 		return false, nil, nil
 	}
-	h, err := vs.getPayloadHeader(ctx, b.Slot, b.ProposerIndex)
+	h, err := vs.getPayloadHeader(ctx, b.Slot, b.ProposerIndex, bytesutil.ToBytes32(b.ParentRoot))
 	if err != nil {
 		return false, nil, errors.Wrap(err, "could not get payload header")
 	}
@@ -35,6 +35,8 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/util"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_buildHeaderBlock(t *testing.T) {
@@ -150,7 +152,7 @@ func TestServer_getPayloadHeader(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			vs := &Server{BlockBuilder: tc.mock, HeadFetcher: tc.fetcher}
-			h, err := vs.getPayloadHeader(context.Background(), 0, 0)
+			h, err := vs.getPayloadHeader(context.Background(), 0, 0, [32]byte{})
 			if err != nil {
 				require.ErrorContains(t, tc.err, err)
 			} else {
@@ -160,6 +162,33 @@ func TestServer_getPayloadHeader(t *testing.T) {
 	}
 }
 
+func TestServer_checkBuilderGasLimit(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+	vs := &Server{BeaconDB: db}
+	require.NoError(t, db.SaveRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{1}, []*ethpb.ValidatorRegistrationV1{{
+		FeeRecipient: make([]byte, fieldparams.FeeRecipientLength),
+		GasLimit:     36000000,
+		Pubkey:       make([]byte, fieldparams.BLSPubkeyLength),
+	}}))
+
+	// The gas limit may move by parentGasLimit/1024-1 towards the registered gas limit.
+	vs.checkBuilderGasLimit(ctx, 1, 30000000, 30029295)
+	require.LogsDoNotContain(t, hook, "does not follow the registered gas limit")
+	vs.checkBuilderGasLimit(ctx, 1, 35990000, 36000000)
+	require.LogsDoNotContain(t, hook, "does not follow the registered gas limit")
+
+	vs.checkBuilderGasLimit(ctx, 1, 30000000, 30000000)
+	require.LogsContain(t, hook, "does not follow the registered gas limit")
+	require.LogsContain(t, hook, "expectedGasLimit=30029295")
+	hook.Reset()
+
+	// Validators without a registration are not checked.
+	vs.checkBuilderGasLimit(ctx, 2, 30000000, 1)
+	require.LogsDoNotContain(t, hook, "does not follow the registered gas limit")
+}
+
 func TestServer_getBuilderBlock(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -368,6 +397,7 @@ func TestServer_getAndBuildHeaderBlock(t *testing.T) {
 	}
 
 	vs.StateGen = stategen.New(vs.BeaconDB)
+	vs.HeadFetcher = &blockchainTest.ChainService{Block: wb1, Root: r[:]}
 	vs.BlockBuilder = &builderTest.MockBuilderService{HasConfigured: true, Bid: &ethpb.SignedBuilderBid{Message: &ethpb.BuilderBid{Header: h}}}
 	ready, builtBlk, err := vs.getAndBuildHeaderBlock(ctx, altairBlk.Block)
 	require.NoError(t, err)
@@ -631,3 +661,146 @@ func TestServer_validatorRegistered(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, true, reg)
 }
+
+func TestServer_GetBeaconBlockWithPayload(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	beaconState, privKeys := util.DeterministicGenesisStateBellatrix(t, 64)
+	syncCommittee, err := altair.NextSyncCommittee(ctx, beaconState)
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetCurrentSyncCommittee(syncCommittee))
+	latestHeader := &v1.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, fieldparams.RootLength),
+		FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:        make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+		LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:       make([]byte, fieldparams.RootLength),
+		BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+		BlockHash:        bytesutil.PadTo([]byte{'a'}, fieldparams.RootLength),
+		TransactionsRoot: make([]byte, fieldparams.RootLength),
+	}
+	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(latestHeader)
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetLatestExecutionPayloadHeader(wrappedHeader))
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	genesis := util.NewBeaconBlockBellatrix()
+	genesis.Block.StateRoot = stateRoot[:]
+	wsb, err := wrapper.WrappedSignedBeaconBlock(genesis)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot))
+
+	proposerServer := &Server{
+		TimeFetcher:           &blockchainTest.ChainService{Genesis: time.Now()},
+		SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:         &blockchainTest.ChainService{},
+		HeadUpdater:           &blockchainTest.ChainService{},
+		ChainStartFetcher:     &mockPOW.POWChain{},
+		Eth1InfoFetcher:       &mockPOW.POWChain{},
+		MockEth1Votes:         true,
+		AttPool:               attestations.NewPool(),
+		SlashingsPool:         slashings.NewPool(),
+		ExitPool:              voluntaryexits.NewPool(),
+		StateGen:              stategen.New(db),
+		SyncCommitteePool:     synccommittee.NewStore(),
+		BeaconDB:              db,
+		OptimisticModeFetcher: &blockchainTest.ChainService{},
+	}
+
+	getBlock := func(req *ethpb.BlockWithPayloadRequest) (*ethpb.GenericBeaconBlock, error) {
+		// The head state is advanced in place when building the block.
+		proposerServer.HeadFetcher = &blockchainTest.ChainService{State: beaconState.Copy(), Root: parentRoot[:]}
+		return proposerServer.GetBeaconBlockWithPayload(ctx, req)
+	}
+
+	randaoReveal, err := util.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	blockReq := &ethpb.BlockRequest{Slot: 1, RandaoReveal: randaoReveal}
+	randaoMix, err := helpers.RandaoMix(beaconState, 0)
+	require.NoError(t, err)
+	payload := &v1.ExecutionPayload{
+		ParentHash:    latestHeader.BlockHash,
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    randaoMix,
+		BlockNumber:   1,
+		Timestamp:     beaconState.GenesisTime() + params.BeaconConfig().SecondsPerSlot,
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     bytesutil.PadTo([]byte{'b'}, fieldparams.RootLength),
+	}
+	wp, err := wrapper.WrappedExecutionPayload(payload)
+	require.NoError(t, err)
+	header, err := wrapper.PayloadToHeader(wp)
+	require.NoError(t, err)
+
+	t.Run("missing execution data", func(t *testing.T) {
+		_, err := getBlock(&ethpb.BlockWithPayloadRequest{Request: blockReq})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = getBlock(&ethpb.BlockWithPayloadRequest{
+			Request:                blockReq,
+			ExecutionPayload:       payload,
+			ExecutionPayloadHeader: header,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("wrong parent hash", func(t *testing.T) {
+		p := ethpb.CopyExecutionPayload(payload)
+		p.ParentHash = make([]byte, fieldparams.RootLength)
+		_, err := getBlock(&ethpb.BlockWithPayloadRequest{Request: blockReq, ExecutionPayload: p})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, b.ErrInvalidPayloadBlockHash.Error(), err)
+	})
+	t.Run("wrong timestamp", func(t *testing.T) {
+		h := ethpb.CopyExecutionPayloadHeader(header)
+		h.Timestamp++
+		_, err := getBlock(&ethpb.BlockWithPayloadRequest{Request: blockReq, ExecutionPayloadHeader: h})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, b.ErrInvalidPayloadTimeStamp.Error(), err)
+	})
+	t.Run("payload", func(t *testing.T) {
+		blk, err := getBlock(&ethpb.BlockWithPayloadRequest{Request: blockReq, ExecutionPayload: payload})
+		require.NoError(t, err)
+		bellatrixBlk, ok := blk.GetBlock().(*ethpb.GenericBeaconBlock_Bellatrix)
+		require.Equal(t, true, ok)
+		require.DeepEqual(t, payload, bellatrixBlk.Bellatrix.Body.ExecutionPayload)
+		require.DeepNotEqual(t, params.BeaconConfig().ZeroHash[:], bellatrixBlk.Bellatrix.StateRoot)
+	})
+	t.Run("payload header", func(t *testing.T) {
+		blk, err := getBlock(&ethpb.BlockWithPayloadRequest{Request: blockReq, ExecutionPayloadHeader: header})
+		require.NoError(t, err)
+		blindedBlk, ok := blk.GetBlock().(*ethpb.GenericBeaconBlock_BlindedBellatrix)
+		require.Equal(t, true, ok)
+		require.DeepEqual(t, header, blindedBlk.BlindedBellatrix.Body.ExecutionPayloadHeader)
+		require.DeepNotEqual(t, params.BeaconConfig().ZeroHash[:], blindedBlk.BlindedBellatrix.StateRoot)
+	})
+}
+
+func TestServer_GetBeaconBlockWithPayload_PreBellatrix(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.BellatrixForkEpoch = 2
+	params.OverrideBeaconConfig(cfg)
+
+	proposerServer := &Server{}
+	_, err := proposerServer.GetBeaconBlockWithPayload(context.Background(), &ethpb.BlockWithPayloadRequest{
+		Request:          &ethpb.BlockRequest{Slot: 1},
+		ExecutionPayload: &v1.ExecutionPayload{},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, "before the Bellatrix fork epoch", err)
+}
@@ -1,11 +1,13 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -21,14 +23,16 @@ import (
 // an algorithm called Voting with the Majority. The algorithm works as follows:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
 //  - Determine the earliest and latest timestamps that a valid block can have.
-//  - Determine the first block not before the earliest timestamp. This block is the lower bound.
 //  - Determine the last block not after the latest timestamp. This block is the upper bound.
 //  - If the last block is too early, use current eth1data from the beacon state.
-//  - Filter out votes on unknown blocks and blocks which are outside of the range determined by the lower and upper bounds.
-//  - If no blocks are left after filtering votes, use eth1data from the latest valid block.
+//  - Filter out votes on unknown blocks, blocks which are outside of the range determined by the earliest
+//    timestamp and the upper bound, and votes which do not match the deposits of their block.
+//  - If no votes are left after filtering, use eth1data from the latest valid block.
 //  - Otherwise:
-//    - Determine the vote with the highest count. Prefer the vote with the highest eth1 block height in the event of a tie.
+//    - Determine the vote with the highest count. Prefer the vote cast first in the event of a tie.
 //    - This vote's block is the eth1 block to use for the block proposal.
+// The upper bound and the validity of the votes do not change during a voting period, so they are
+// cached for the whole period.
 func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState state.BeaconState) (*ethpb.Eth1Data, error) {
 	ctx, cancel := context.WithTimeout(ctx, eth1dataTimeout)
 	defer cancel()
@@ -44,37 +48,133 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState state.Be
 	}
 	eth1DataNotification = false
 
-	eth1FollowDistance := params.BeaconConfig().Eth1FollowDistance
-	earliestValidTime := votingPeriodStartTime - 2*params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
-	latestValidTime := votingPeriodStartTime - params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance
-
-	lastBlockByLatestValidTime, err := vs.Eth1BlockFetcher.BlockByTimestamp(ctx, latestValidTime)
+	candidates, err := vs.eth1VoteCandidates(ctx, votingPeriodStartTime)
 	if err != nil {
-		log.WithError(err).Error("Could not get last block by latest valid time")
+		log.WithError(err).Error("Could not determine eth1 vote candidates")
 		return vs.randomETH1DataVote(ctx)
 	}
-	if lastBlockByLatestValidTime.Time < earliestValidTime {
+	if candidates.LastBlock == nil {
 		return vs.HeadFetcher.HeadETH1Data(), nil
 	}
-
-	lastBlockDepositCount, lastBlockDepositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, lastBlockByLatestValidTime.Number)
-	if lastBlockDepositCount == 0 {
+	if candidates.LastBlockEth1Data.DepositCount == 0 {
 		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
 	}
 
-	if lastBlockDepositCount >= vs.HeadFetcher.HeadETH1Data().DepositCount {
+	currentDepositCount := vs.HeadFetcher.HeadETH1Data().DepositCount
+	votes := beaconState.Eth1DataVotes()
+	validVotes := make([]*ethpb.Eth1Data, 0, len(votes))
+	voteRoots := make([][32]byte, 0, len(votes))
+	voteCounts := make(map[[32]byte]uint64, len(votes))
+	for _, vote := range votes {
+		// Votes going back in the deposit contract would revert the deposits processed by the beacon chain.
+		if vote.DepositCount < currentDepositCount {
+			continue
+		}
+		root, valid := vs.isValidEth1Vote(ctx, candidates, vote)
+		if !valid {
+			continue
+		}
+		validVotes = append(validVotes, vote)
+		voteRoots = append(voteRoots, root)
+		voteCounts[root]++
+	}
+	if len(validVotes) == 0 {
+		if candidates.LastBlockEth1Data.DepositCount >= currentDepositCount {
+			return candidates.LastBlockEth1Data, nil
+		}
+		return vs.HeadFetcher.HeadETH1Data(), nil
+	}
+
+	chosen, chosenCount := validVotes[0], voteCounts[voteRoots[0]]
+	for i, vote := range validVotes {
+		if voteCounts[voteRoots[i]] > chosenCount {
+			chosen, chosenCount = vote, voteCounts[voteRoots[i]]
+		}
+	}
+	return chosen, nil
+}
+
+// eth1VoteCandidates returns the eth1 vote candidates of the voting period starting at the given time,
+// querying the execution chain only if they are not cached yet.
+func (vs *Server) eth1VoteCandidates(ctx context.Context, votingPeriodStartTime uint64) (*cache.Eth1VoteCandidates, error) {
+	if vs.Eth1VoteCache != nil {
+		if candidates := vs.Eth1VoteCache.Candidates(votingPeriodStartTime); candidates != nil {
+			return candidates, nil
+		}
+	}
+
+	earliestValidTime, latestValidTime := eth1VoteValidTimes(votingPeriodStartTime)
+	lastBlockByLatestValidTime, err := vs.Eth1BlockFetcher.BlockByTimestamp(ctx, latestValidTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last block by latest valid time")
+	}
+	candidates := cache.NewEth1VoteCandidates(votingPeriodStartTime, nil, nil)
+	if lastBlockByLatestValidTime.Time >= earliestValidTime {
+		depositCount, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, lastBlockByLatestValidTime.Number)
 		h, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, lastBlockByLatestValidTime.Number)
 		if err != nil {
-			log.WithError(err).Error("Could not get hash of last block by latest valid time")
-			return vs.randomETH1DataVote(ctx)
+			return nil, errors.Wrap(err, "could not get hash of last block by latest valid time")
 		}
-		return &ethpb.Eth1Data{
+		candidates = cache.NewEth1VoteCandidates(votingPeriodStartTime, lastBlockByLatestValidTime.Number, &ethpb.Eth1Data{
 			BlockHash:    h.Bytes(),
-			DepositCount: lastBlockDepositCount,
-			DepositRoot:  lastBlockDepositRoot[:],
-		}, nil
+			DepositCount: depositCount,
+			DepositRoot:  depositRoot[:],
+		})
+	}
+
+	// Only cache the candidates once the deposits of all candidate blocks have been processed, as
+	// the deposit cache would otherwise lag behind the execution chain.
+	latest := vs.Eth1InfoFetcher.LatestEth1Data()
+	if vs.Eth1VoteCache != nil && latest != nil && latest.BlockTime >= latestValidTime &&
+		(candidates.LastBlock == nil || latest.LastRequestedBlock >= candidates.LastBlock.Uint64()) {
+		vs.Eth1VoteCache.SetCandidates(candidates)
+	}
+	return candidates, nil
+}
+
+// isValidEth1Vote returns the root of the vote and whether it is for a candidate block of the voting
+// period, with the deposits of that block. Votes are only checked once per voting period.
+func (vs *Server) isValidEth1Vote(ctx context.Context, candidates *cache.Eth1VoteCandidates, vote *ethpb.Eth1Data) ([32]byte, bool) {
+	root, err := vote.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not hash eth1 data vote")
+		return [32]byte{}, false
+	}
+	if valid, ok := candidates.VoteValidity(root); ok {
+		return root, valid
 	}
-	return vs.HeadFetcher.HeadETH1Data(), nil
+
+	// Errors are not cached as they may be transient.
+	exists, height, err := vs.Eth1BlockFetcher.BlockExists(ctx, bytesutil.ToBytes32(vote.BlockHash))
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch eth1 block of eth1 data vote")
+		return root, false
+	}
+	if !exists || height.Cmp(candidates.LastBlock) > 0 {
+		candidates.SetVoteValidity(root, false)
+		return root, false
+	}
+	blockTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, height)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch time of eth1 block of eth1 data vote")
+		return root, false
+	}
+	earliestValidTime, _ := eth1VoteValidTimes(candidates.PeriodStart)
+	if blockTime < earliestValidTime {
+		candidates.SetVoteValidity(root, false)
+		return root, false
+	}
+	depositCount, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, height)
+	valid := depositCount == vote.DepositCount && bytes.Equal(depositRoot[:], vote.DepositRoot)
+	candidates.SetVoteValidity(root, valid)
+	return root, valid
+}
+
+// eth1VoteValidTimes returns the earliest and latest timestamps of the blocks which can be voted for
+// during the voting period starting at the given time.
+func eth1VoteValidTimes(votingPeriodStartTime uint64) (uint64, uint64) {
+	followTime := params.BeaconConfig().SecondsPerETH1Block * params.BeaconConfig().Eth1FollowDistance
+	return votingPeriodStartTime - 2*followTime, votingPeriodStartTime - followTime
 }
 
 func (vs *Server) slotStartTime(slot types.Slot) uint64 {
@@ -39,9 +39,14 @@ var (
 
 // This returns the execution payload of a given slot. The function has full awareness of pre and post merge.
 // The payload is computed given the respected time of merge.
-func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot, vIdx types.ValidatorIndex) (*enginev1.ExecutionPayload, error) {
+func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot, vIdx types.ValidatorIndex, parentRoot [32]byte) (*enginev1.ExecutionPayload, error) {
+	// Cached payloads are prepared on top of the canonical head, so they can't be used when orphaning a late head block.
+	onHead, err := vs.isHeadRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, err
+	}
 	proposerID, payloadId, ok := vs.ProposerSlotIndexCache.GetProposerPayloadIDs(slot)
-	if ok && proposerID == vIdx && payloadId != [8]byte{} { // Payload ID is cache hit. Return the cached payload ID.
+	if onHead && ok && proposerID == vIdx && payloadId != [8]byte{} { // Payload ID is cache hit. Return the cached payload ID.
 		var pid [8]byte
 		copy(pid[:], payloadId[:])
 		payloadIDCacheHit.Inc()
@@ -49,7 +54,7 @@ func (vs *Server) getExecutionPayload(ctx context.Context, slot types.Slot, vIdx
 	}
 	payloadIDCacheMiss.Inc()
 
-	st, err := vs.HeadFetcher.HeadState(ctx)
+	st, err := vs.proposalParentState(ctx, parentRoot)
 	if err != nil {
 		return nil, err
 	}
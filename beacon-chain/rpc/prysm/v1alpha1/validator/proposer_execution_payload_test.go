@@ -12,6 +12,7 @@ import (
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	powtesting "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -124,7 +125,7 @@ func TestServer_getExecutionPayload(t *testing.T) {
 				ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
 			}
 			vs.ProposerSlotIndexCache.SetProposerAndPayloadIDs(tt.st.Slot(), 100, [8]byte{100})
-			_, err := vs.getExecutionPayload(context.Background(), tt.st.Slot(), tt.validatorIndx)
+			_, err := vs.getExecutionPayload(context.Background(), tt.st.Slot(), tt.validatorIndx, [32]byte{})
 			if tt.errString != "" {
 				require.ErrorContains(t, tt.errString, err)
 			} else {
@@ -134,6 +135,30 @@ func TestServer_getExecutionPayload(t *testing.T) {
 	}
 }
 
+func TestServer_getExecutionPayload_OrphanedHead(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	headSt, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	parentSt, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(&pb.ExecutionPayloadHeader{BlockNumber: 1})
+	require.NoError(t, err)
+	require.NoError(t, parentSt.SetLatestExecutionPayloadHeader(wrappedHeader))
+	parentRoot := [32]byte{'b'}
+	sg := mockstategen.NewMockService()
+	sg.AddStateForRoot(parentSt, parentRoot)
+
+	vs := &Server{
+		ExecutionEngineCaller:  &powtesting.EngineClient{ErrForkchoiceUpdated: errors.New("fork choice error")},
+		HeadFetcher:            &chainMock.ChainService{State: headSt, Root: bytesutil.PadTo([]byte{'a'}, 32)},
+		StateGen:               sg,
+		BeaconDB:               beaconDB,
+		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
+	}
+	vs.ProposerSlotIndexCache.SetProposerAndPayloadIDs(parentSt.Slot(), 0, [8]byte{100})
+	// The cached payload is built on the orphaned head, so a payload is prepared on the parent state instead.
+	_, err = vs.getExecutionPayload(context.Background(), parentSt.Slot(), 0, parentRoot)
+	require.ErrorContains(t, "could not prepare payload", err)
+}
+
 func TestServer_getExecutionPayload_UnexpectedFeeRecipient(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB := dbTest.SetupDB(t)
@@ -175,7 +200,7 @@ func TestServer_getExecutionPayload_UnexpectedFeeRecipient(t *testing.T) {
 		BeaconDB:               beaconDB,
 		ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache(),
 	}
-	gotPayload, err := vs.getExecutionPayload(context.Background(), transitionSt.Slot(), 0)
+	gotPayload, err := vs.getExecutionPayload(context.Background(), transitionSt.Slot(), 0, [32]byte{})
 	require.NoError(t, err)
 	require.NotNil(t, gotPayload)
 
@@ -187,7 +212,7 @@ func TestServer_getExecutionPayload_UnexpectedFeeRecipient(t *testing.T) {
 	payload.FeeRecipient = evilRecipientAddress[:]
 	vs.ProposerSlotIndexCache = cache.NewProposerPayloadIDsCache()
 
-	gotPayload, err = vs.getExecutionPayload(context.Background(), transitionSt.Slot(), 0)
+	gotPayload, err = vs.getExecutionPayload(context.Background(), transitionSt.Slot(), 0, [32]byte{})
 	require.NoError(t, err)
 	require.NotNil(t, gotPayload)
 
@@ -0,0 +1,348 @@
+package validator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/container/slice"
+	"github.com/prysmaticlabs/prysm/math"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+// attestationCandidate is an attestation considered for inclusion into a proposed block, along
+// with the validators it credits and the participation flags it sets for them.
+type attestationCandidate struct {
+	att     *ethpb.Attestation
+	epoch   types.Epoch
+	indices []uint64
+	flags   map[uint8]bool
+	// gain is the last computed marginal reward of the candidate, in Gwei. Marginal rewards only
+	// decrease as attestations are selected, so a stale gain is an upper bound.
+	gain uint64
+}
+
+// attestationPacker selects attestations for a proposed block by the reward they bring to the
+// proposer. Only the participation which is not already credited on chain, or by the previously
+// selected attestations, is rewarded.
+type attestationPacker struct {
+	st           state.BeaconState
+	totalBalance uint64
+	// credited holds, per target epoch, the participation flags of the validators in Altair and
+	// later, and whether the validators are already included in phase 0.
+	credited map[types.Epoch][]byte
+}
+
+func newAttestationPacker(ctx context.Context, st state.BeaconState) (*attestationPacker, error) {
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, err
+	}
+	p := &attestationPacker{
+		st:           st,
+		totalBalance: totalBalance,
+		credited:     make(map[types.Epoch][]byte, 2),
+	}
+	// The previous epoch is the current epoch at genesis, the current epoch is set last so that it
+	// takes precedence.
+	currentEpoch := time.CurrentEpoch(st)
+	previousEpoch := time.PrevEpoch(st)
+	if st.Version() == version.Phase0 {
+		previous, err := st.PreviousEpochAttestations()
+		if err != nil {
+			return nil, err
+		}
+		if p.credited[previousEpoch], err = p.includedValidators(ctx, previous); err != nil {
+			return nil, err
+		}
+		current, err := st.CurrentEpochAttestations()
+		if err != nil {
+			return nil, err
+		}
+		if p.credited[currentEpoch], err = p.includedValidators(ctx, current); err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	previous, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	current, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	// The participation is copied, as it is updated while attestations are selected.
+	p.credited[previousEpoch] = append([]byte{}, previous...)
+	p.credited[currentEpoch] = append([]byte{}, current...)
+	return p, nil
+}
+
+// includedValidators marks the validators attesting in the pending attestations of a phase 0 state.
+func (p *attestationPacker) includedValidators(ctx context.Context, pending []*ethpb.PendingAttestation) ([]byte, error) {
+	included := make([]byte, p.st.NumValidators())
+	for _, a := range pending {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, p.st, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := attestation.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range indices {
+			if i < uint64(len(included)) {
+				included[i] = 1
+			}
+		}
+	}
+	return included, nil
+}
+
+// candidate computes the validators credited by the attestation and the flags it sets for them.
+func (p *attestationPacker) candidate(ctx context.Context, att *ethpb.Attestation) (*attestationCandidate, error) {
+	committee, err := helpers.BeaconCommitteeFromState(ctx, p.st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, err
+	}
+	c := &attestationCandidate{att: att, epoch: att.Data.Target.Epoch, indices: indices}
+	if p.st.Version() != version.Phase0 {
+		c.flags, err = altair.AttestationParticipationFlagIndices(p.st, att.Data, p.st.Slot()-att.Data.Slot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// marginalReward returns the reward of the proposer for including the candidate, given the
+// participation already credited.
+func (p *attestationPacker) marginalReward(c *attestationCandidate) (uint64, error) {
+	credited, ok := p.credited[c.epoch]
+	if !ok {
+		return 0, nil
+	}
+	cfg := params.BeaconConfig()
+	var reward uint64
+	if p.st.Version() == version.Phase0 {
+		sqrtBalance := math.IntegerSquareRoot(p.totalBalance)
+		if sqrtBalance == 0 {
+			return 0, nil
+		}
+		for _, i := range c.indices {
+			if i >= uint64(len(credited)) || credited[i] != 0 {
+				continue
+			}
+			v, err := p.st.ValidatorAtIndexReadOnly(types.ValidatorIndex(i))
+			if err != nil {
+				return 0, err
+			}
+			br := v.EffectiveBalance() * cfg.BaseRewardFactor / sqrtBalance / cfg.BaseRewardsPerEpoch
+			reward += br / cfg.ProposerRewardQuotient
+		}
+		return reward, nil
+	}
+
+	weights := map[uint8]uint64{
+		cfg.TimelySourceFlagIndex: cfg.TimelySourceWeight,
+		cfg.TimelyTargetFlagIndex: cfg.TimelyTargetWeight,
+		cfg.TimelyHeadFlagIndex:   cfg.TimelyHeadWeight,
+	}
+	var numerator uint64
+	for _, i := range c.indices {
+		if i >= uint64(len(credited)) {
+			continue
+		}
+		var weight uint64
+		for flag := range c.flags {
+			has, err := altair.HasValidatorFlag(credited[i], flag)
+			if err != nil {
+				return 0, err
+			}
+			if !has {
+				weight += weights[flag]
+			}
+		}
+		if weight == 0 {
+			continue
+		}
+		br, err := altair.BaseRewardWithTotalBalance(p.st, types.ValidatorIndex(i), p.totalBalance)
+		if err != nil {
+			return 0, err
+		}
+		numerator += br * weight
+	}
+	reward = numerator / ((cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight)
+	return reward, nil
+}
+
+// credit records the participation of the selected candidate.
+func (p *attestationPacker) credit(c *attestationCandidate) error {
+	credited, ok := p.credited[c.epoch]
+	if !ok {
+		return nil
+	}
+	for _, i := range c.indices {
+		if i >= uint64(len(credited)) {
+			continue
+		}
+		if p.st.Version() == version.Phase0 {
+			credited[i] = 1
+			continue
+		}
+		for flag := range c.flags {
+			f, err := altair.AddValidatorFlag(credited[i], flag)
+			if err != nil {
+				return err
+			}
+			credited[i] = f
+		}
+	}
+	return nil
+}
+
+// packByReward selects up to the maximum number of attestations per block by greedily picking the
+// attestation with the highest marginal reward. Attestations which would not reward the proposer,
+// because their participation is already credited, are left out.
+func (a proposerAtts) packByReward(ctx context.Context, st state.BeaconState) (proposerAtts, uint64, error) {
+	p, err := newAttestationPacker(ctx, st)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not initialize attestation packer")
+	}
+	candidates := make([]*attestationCandidate, 0, len(a))
+	for _, att := range a {
+		c, err := p.candidate(ctx, att)
+		if err != nil {
+			log.WithError(err).Debug("Could not score attestation for packing")
+			continue
+		}
+		if c.gain, err = p.marginalReward(c); err != nil {
+			return nil, 0, err
+		}
+		if c.gain > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+
+	var total uint64
+	maxAtts := params.BeaconConfig().MaxAttestations
+	packed := make(proposerAtts, 0, maxAtts)
+	for uint64(len(packed)) < maxAtts && len(candidates) > 0 {
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].gain > candidates[j].gain
+		})
+		// The gains are upper bounds, the best candidate is final once its gain is up to date and
+		// still not lower than the gain of the next candidate.
+		best := candidates[0]
+		if best.gain, err = p.marginalReward(best); err != nil {
+			return nil, 0, err
+		}
+		if best.gain == 0 {
+			candidates = candidates[1:]
+			continue
+		}
+		if len(candidates) > 1 && best.gain < candidates[1].gain {
+			continue
+		}
+		if err := p.credit(best); err != nil {
+			return nil, 0, err
+		}
+		total += best.gain
+		packed = append(packed, best.att)
+		candidates = candidates[1:]
+	}
+	return packed, total, nil
+}
+
+// packSlashings selects the slashings of a proposed block by the whistleblower reward they bring to
+// the proposer, which is also the whistleblower. Slashings which would not slash any validator not
+// already slashed by the previously selected slashings are left out, as they would make the block
+// invalid.
+func packSlashings(
+	st state.ReadOnlyBeaconState,
+	proposerSlashings []*ethpb.ProposerSlashing,
+	attesterSlashings []*ethpb.AttesterSlashing,
+) ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing) {
+	cfg := params.BeaconConfig()
+	epoch := time.CurrentEpoch(st)
+	slashed := make(map[types.ValidatorIndex]bool)
+	reward := func(indices []uint64) uint64 {
+		var r uint64
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			if slashed[idx] {
+				continue
+			}
+			v, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil || !helpers.IsSlashableValidatorUsingTrie(v, epoch) {
+				continue
+			}
+			r += v.EffectiveBalance() / cfg.WhistleBlowerRewardQuotient
+		}
+		return r
+	}
+	markSlashed := func(indices []uint64) {
+		for _, i := range indices {
+			slashed[types.ValidatorIndex(i)] = true
+		}
+	}
+
+	// Proposer slashings slash a single validator each, they are sorted by reward.
+	sort.SliceStable(proposerSlashings, func(i, j int) bool {
+		return reward([]uint64{uint64(proposerSlashings[i].Header_1.Header.ProposerIndex)}) >
+			reward([]uint64{uint64(proposerSlashings[j].Header_1.Header.ProposerIndex)})
+	})
+	packedProposerSlashings := make([]*ethpb.ProposerSlashing, 0, cfg.MaxProposerSlashings)
+	for _, s := range proposerSlashings {
+		if uint64(len(packedProposerSlashings)) >= cfg.MaxProposerSlashings {
+			break
+		}
+		indices := []uint64{uint64(s.Header_1.Header.ProposerIndex)}
+		if reward(indices) == 0 {
+			continue
+		}
+		markSlashed(indices)
+		packedProposerSlashings = append(packedProposerSlashings, s)
+	}
+
+	// Attester slashings may slash overlapping sets of validators, they are greedily picked by
+	// marginal reward.
+	candidates := make([][]uint64, len(attesterSlashings))
+	for i, s := range attesterSlashings {
+		candidates[i] = slice.IntersectionUint64(s.Attestation_1.AttestingIndices, s.Attestation_2.AttestingIndices)
+	}
+	packedAttesterSlashings := make([]*ethpb.AttesterSlashing, 0, cfg.MaxAttesterSlashings)
+	for uint64(len(packedAttesterSlashings)) < cfg.MaxAttesterSlashings {
+		best, bestReward := -1, uint64(0)
+		for i, indices := range candidates {
+			if indices == nil {
+				continue
+			}
+			if r := reward(indices); r > bestReward {
+				best, bestReward = i, r
+			}
+		}
+		if best < 0 {
+			break
+		}
+		markSlashed(candidates[best])
+		candidates[best] = nil
+		packedAttesterSlashings = append(packedAttesterSlashings, attesterSlashings[best])
+	}
+	return packedProposerSlashings, packedAttesterSlashings
+}
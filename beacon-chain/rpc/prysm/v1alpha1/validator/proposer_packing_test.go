@@ -0,0 +1,160 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// packingAtts returns attestations of the committee of slot 0, with the given committee positions
+// set, along with the validator indices of the committee.
+func packingAtts(t *testing.T, st state.BeaconState, positions ...[]uint64) (proposerAtts, []types.ValidatorIndex) {
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, 0, 0)
+	require.NoError(t, err)
+	atts := make(proposerAtts, len(positions))
+	for i, ps := range positions {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		for _, p := range ps {
+			bits.SetBitAt(p, true)
+		}
+		atts[i] = util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+	}
+	return atts, committee
+}
+
+// packedReward returns the reward of the proposer for including the attestations in order.
+func packedReward(t *testing.T, st state.BeaconState, atts proposerAtts) uint64 {
+	ctx := context.Background()
+	p, err := newAttestationPacker(ctx, st)
+	require.NoError(t, err)
+	var total uint64
+	for _, att := range atts {
+		c, err := p.candidate(ctx, att)
+		require.NoError(t, err)
+		r, err := p.marginalReward(c)
+		require.NoError(t, err)
+		require.NoError(t, p.credit(c))
+		total += r
+	}
+	return total
+}
+
+func TestProposer_ProposerAtts_packByReward(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	ctx := context.Background()
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	atts, committee := packingAtts(t, st,
+		[]uint64{0, 1, 2, 3, 4, 5},
+		[]uint64{5, 6, 7},
+		[]uint64{0, 1},
+	)
+	// The participation of the first four members of the committee is already credited on chain.
+	participation := make([]byte, st.NumValidators())
+	for _, idx := range committee[:4] {
+		participation[idx] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+	packed, reward, err := atts.packByReward(ctx, st)
+	require.NoError(t, err)
+	// The second attestation credits the most validators, the first one only credits the fifth
+	// member afterwards and the last one does not credit anyone.
+	require.DeepEqual(t, proposerAtts{atts[1], atts[0]}, packed)
+	assert.Equal(t, packedReward(t, st, packed), reward)
+	assert.NotEqual(t, uint64(0), reward)
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 1
+	params.OverrideBeaconConfig(cfg)
+	packed, reward, err = atts.packByReward(ctx, st)
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{atts[1]}, packed)
+	sorted, err := atts.sortByProfitability()
+	require.NoError(t, err)
+	assert.Equal(t, true, reward > packedReward(t, st, sorted.limitToMaxAttestations()))
+}
+
+func TestProposer_ProposerAtts_packByReward_Phase0(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	ctx := context.Background()
+
+	st, _ := util.DeterministicGenesisState(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	atts, _ := packingAtts(t, st,
+		[]uint64{0, 1, 2, 3, 4, 5},
+		[]uint64{5, 6, 7},
+		[]uint64{0, 1},
+	)
+	// The first four members of the committee are already included on chain.
+	included, _ := packingAtts(t, st, []uint64{0, 1, 2, 3})
+	require.NoError(t, st.AppendCurrentEpochAttestations(&ethpb.PendingAttestation{
+		AggregationBits: included[0].AggregationBits,
+		Data:            included[0].Data,
+		InclusionDelay:  1,
+	}))
+
+	packed, reward, err := atts.packByReward(ctx, st)
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{atts[1], atts[0]}, packed)
+	assert.Equal(t, packedReward(t, st, packed), reward)
+	assert.NotEqual(t, uint64(0), reward)
+}
+
+func TestProposer_packSlashings(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MinimalSpecConfig().Copy()
+	cfg.MaxAttesterSlashings = 2
+	params.OverrideBeaconConfig(cfg)
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+
+	proposerSlashing := func(idx types.ValidatorIndex) *ethpb.ProposerSlashing {
+		return &ethpb.ProposerSlashing{
+			Header_1: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: idx}},
+			Header_2: &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{ProposerIndex: idx}},
+		}
+	}
+	attesterSlashing := func(indices ...uint64) *ethpb.AttesterSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: indices},
+			Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: indices},
+		}
+	}
+	proposerSlashings := []*ethpb.ProposerSlashing{
+		proposerSlashing(1),
+		proposerSlashing(3),
+		proposerSlashing(2),
+		proposerSlashing(1),
+	}
+	attesterSlashings := []*ethpb.AttesterSlashing{
+		attesterSlashing(1, 4),
+		attesterSlashing(4, 5),
+		attesterSlashing(4, 5, 6),
+		attesterSlashing(3),
+		attesterSlashing(7),
+	}
+
+	packedProposerSlashings, packedAttesterSlashings := packSlashings(st, proposerSlashings, attesterSlashings)
+	// The slashing of an already slashed validator and the duplicate slashing are left out.
+	require.DeepEqual(t, []*ethpb.ProposerSlashing{proposerSlashing(1), proposerSlashing(2)}, packedProposerSlashings)
+	// The attester slashing slashing the most validators is picked first, after which the other
+	// slashings of validators 4 and 5 would not slash any new validator.
+	require.DeepEqual(t, []*ethpb.AttesterSlashing{attesterSlashing(4, 5, 6), attesterSlashing(7)}, packedAttesterSlashings)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition/interop"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -97,6 +98,11 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		return nil, fmt.Errorf("could not get head state %v", err)
 	}
 
+	parentRoot, head, err = vs.getProposerHead(ctx, req.Slot, parentRoot, head)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer head: %v", err)
+	}
+
 	head, err = transition.ProcessSlotsUsingNextSlotCache(ctx, head, parentRoot, req.Slot)
 	if err != nil {
 		return nil, fmt.Errorf("could not advance slots to calculate proposer index: %v", err)
@@ -120,7 +126,17 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		return nil, fmt.Errorf("could not calculate proposer index %v", err)
 	}
 
-	proposerSlashings := vs.SlashingsPool.PendingProposerSlashings(ctx, head, false /*noLimit*/)
+	// The block packing optimizer considers all the pending slashings and exits, and selects them by
+	// reward up to the block limits.
+	optimizePacking := features.Get().EnableBlockPackingOptimizer
+	proposerSlashings := vs.SlashingsPool.PendingProposerSlashings(ctx, head, optimizePacking /*noLimit*/)
+	var attSlashings []*ethpb.AttesterSlashing
+	if optimizePacking {
+		// The attester slashings are fetched before the proposer slashings are processed, so that
+		// both are selected against the same state.
+		attSlashings = vs.SlashingsPool.PendingAttesterSlashings(ctx, head, true /*noLimit*/)
+		proposerSlashings, attSlashings = packSlashings(head, proposerSlashings, attSlashings)
+	}
 	validProposerSlashings := make([]*ethpb.ProposerSlashing, 0, len(proposerSlashings))
 	for _, slashing := range proposerSlashings {
 		_, err := blocks.ProcessProposerSlashing(ctx, head, slashing, v.SlashValidator)
@@ -131,7 +147,9 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		validProposerSlashings = append(validProposerSlashings, slashing)
 	}
 
-	attSlashings := vs.SlashingsPool.PendingAttesterSlashings(ctx, head, false /*noLimit*/)
+	if !optimizePacking {
+		attSlashings = vs.SlashingsPool.PendingAttesterSlashings(ctx, head, false /*noLimit*/)
+	}
 	validAttSlashings := make([]*ethpb.AttesterSlashing, 0, len(attSlashings))
 	for _, slashing := range attSlashings {
 		_, err := blocks.ProcessAttesterSlashing(ctx, head, slashing, v.SlashValidator)
@@ -141,7 +159,7 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		}
 		validAttSlashings = append(validAttSlashings, slashing)
 	}
-	exits := vs.ExitPool.PendingExits(head, req.Slot, false /*noLimit*/)
+	exits := vs.ExitPool.PendingExits(head, req.Slot, optimizePacking /*noLimit*/)
 	validExits := make([]*ethpb.SignedVoluntaryExit, 0, len(exits))
 	for _, exit := range exits {
 		val, err := head.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
@@ -154,6 +172,11 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 			continue
 		}
 		validExits = append(validExits, exit)
+		// The exits which are not valid anymore, such as the exits of the validators slashed in
+		// the block, leave room for other exits.
+		if optimizePacking && uint64(len(validExits)) >= params.BeaconConfig().MaxVoluntaryExits {
+			break
+		}
 	}
 
 	return &blockData{
@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// getProposerHead returns the block root and state a block proposed at the given slot should be built on.
+// This is the canonical head, unless late block reorgs are enabled and fork choice considers the head a
+// late block which is safe to orphan, in which case the parent of the head is returned instead. From
+// Bellatrix onwards, the execution payload of the proposal is then built on the payload of that parent.
+func (vs *Server) getProposerHead(
+	ctx context.Context,
+	slot types.Slot,
+	headRoot []byte,
+	headState state.BeaconState,
+) ([]byte, state.BeaconState, error) {
+	if !features.Get().EnableReorgLateBlocks || vs.ForkFetcher == nil {
+		return headRoot, headState, nil
+	}
+	fc := vs.ForkFetcher.ForkChoicer()
+	if fc == nil {
+		return headRoot, headState, nil
+	}
+	proposerHead := fc.GetProposerHead()
+	if proposerHead == [32]byte{} || proposerHead == bytesutil.ToBytes32(headRoot) {
+		return headRoot, headState, nil
+	}
+	parentState, err := vs.StateGen.StateByRoot(ctx, proposerHead)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get proposer head state")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":         slot,
+		"orphanedRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot)),
+		"parentRoot":   fmt.Sprintf("%#x", bytesutil.Trunc(proposerHead[:])),
+	}).Info("Proposing on top of the parent of a late head block")
+	return proposerHead[:], parentState, nil
+}
+
+// isHeadRoot returns true if the root is the root of the canonical head block.
+func (vs *Server) isHeadRoot(ctx context.Context, root [32]byte) (bool, error) {
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get head root")
+	}
+	return bytesutil.ToBytes32(headRoot) == root, nil
+}
+
+// proposalParentState returns the state of the block a proposal is built on, which is the head state
+// unless the proposal orphans a late head block.
+func (vs *Server) proposalParentState(ctx context.Context, parentRoot [32]byte) (state.BeaconState, error) {
+	onHead, err := vs.isHeadRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, err
+	}
+	if onHead {
+		return vs.HeadFetcher.HeadState(ctx)
+	}
+	st, err := vs.StateGen.StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal parent state")
+	}
+	return st, nil
+}
+
+// proposalParentBlock returns the block a proposal is built on, which is the head block unless the
+// proposal orphans a late head block.
+func (vs *Server) proposalParentBlock(ctx context.Context, parentRoot [32]byte) (interfaces.SignedBeaconBlock, error) {
+	onHead, err := vs.isHeadRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, err
+	}
+	if onHead {
+		return vs.HeadFetcher.HeadBlock(ctx)
+	}
+	b, err := vs.BeaconDB.Block(ctx, parentRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal parent block")
+	}
+	if err := wrapper.BeaconBlockIsNil(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
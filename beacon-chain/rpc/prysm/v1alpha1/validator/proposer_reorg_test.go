@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
+	mockstategen "github.com/prysmaticlabs/prysm/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type mockProposerHeadForkChoicer struct {
+	forkchoice.ForkChoicer
+	proposerHead [32]byte
+}
+
+func (m *mockProposerHeadForkChoicer) GetProposerHead() [32]byte {
+	return m.proposerHead
+}
+
+func TestServer_getProposerHead(t *testing.T) {
+	ctx := context.Background()
+	headRoot := [32]byte{'a'}
+	parentRoot := [32]byte{'b'}
+	headState, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(2))
+	parentState, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, parentState.SetSlot(1))
+	sg := mockstategen.NewMockService()
+	sg.AddStateForRoot(parentState, parentRoot)
+	vs := &Server{
+		ForkFetcher: &mockChain.ChainService{ForkChoiceStore: &mockProposerHeadForkChoicer{proposerHead: parentRoot}},
+		StateGen:    sg,
+	}
+
+	t.Run("feature disabled", func(t *testing.T) {
+		root, st, err := vs.getProposerHead(ctx, 3, headRoot[:], headState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, headRoot[:], root)
+		assert.Equal(t, headState.Slot(), st.Slot())
+	})
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableReorgLateBlocks: true})
+	defer resetCfg()
+
+	t.Run("late head orphaned", func(t *testing.T) {
+		root, st, err := vs.getProposerHead(ctx, 3, headRoot[:], headState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, parentRoot[:], root)
+		assert.Equal(t, parentState.Slot(), st.Slot())
+	})
+
+	t.Run("head kept", func(t *testing.T) {
+		server := &Server{
+			ForkFetcher: &mockChain.ChainService{ForkChoiceStore: &mockProposerHeadForkChoicer{proposerHead: headRoot}},
+			StateGen:    sg,
+		}
+		root, st, err := server.getProposerHead(ctx, 3, headRoot[:], headState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, headRoot[:], root)
+		assert.Equal(t, headState.Slot(), st.Slot())
+	})
+
+	t.Run("bellatrix head orphaned", func(t *testing.T) {
+		bellatrixState, err := util.NewBeaconStateBellatrix()
+		require.NoError(t, err)
+		require.NoError(t, bellatrixState.SetSlot(2))
+		bellatrixParentState, err := util.NewBeaconStateBellatrix()
+		require.NoError(t, err)
+		require.NoError(t, bellatrixParentState.SetSlot(1))
+		bellatrixSg := mockstategen.NewMockService()
+		bellatrixSg.AddStateForRoot(bellatrixParentState, parentRoot)
+		server := &Server{
+			ForkFetcher: &mockChain.ChainService{ForkChoiceStore: &mockProposerHeadForkChoicer{proposerHead: parentRoot}},
+			StateGen:    bellatrixSg,
+		}
+		root, st, err := server.getProposerHead(ctx, 3, headRoot[:], bellatrixState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, parentRoot[:], root)
+		assert.Equal(t, bellatrixParentState.Slot(), st.Slot())
+	})
+}
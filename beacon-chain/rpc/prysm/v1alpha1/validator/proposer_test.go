@@ -25,9 +25,10 @@ import (
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -121,6 +122,73 @@ func TestProposer_GetBlock_OK(t *testing.T) {
 	assert.DeepEqual(t, attSlashings, block.Body.AttesterSlashings)
 }
 
+func TestProposer_GetBlock_BlockPackingOptimizer(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBlockPackingOptimizer: true})
+	defer resetCfg()
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+
+	beaconState, parentRoot, privKeys := util.DeterministicGenesisStateWithGenesisBlock(t, ctx, db, 64)
+
+	proposerServer := &Server{
+		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:     &mock.ChainService{},
+		HeadUpdater:       &mock.ChainService{},
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashings.NewPool(),
+		ExitPool:          voluntaryexits.NewPool(),
+		StateGen:          stategen.New(db),
+	}
+
+	randaoReveal, err := util.RandaoReveal(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	req := &ethpb.BlockRequest{
+		Slot:         1,
+		RandaoReveal: randaoReveal,
+		Graffiti:     make([]byte, 32),
+	}
+
+	// More slashings than fit into a block are pending, and the first attester slashing slashes a
+	// validator which is also slashed by a proposer slashing.
+	maxProposerSlashings := params.BeaconConfig().MaxProposerSlashings
+	for i := types.ValidatorIndex(0); uint64(i) < maxProposerSlashings+2; i++ {
+		proposerSlashing, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[i], i)
+		require.NoError(t, err)
+		require.NoError(t, proposerServer.SlashingsPool.InsertProposerSlashing(ctx, beaconState, proposerSlashing))
+	}
+	attesterSlashed := []types.ValidatorIndex{0}
+	for i := uint64(0); i < params.BeaconConfig().MaxAttesterSlashings; i++ {
+		attesterSlashed = append(attesterSlashed, types.ValidatorIndex(maxProposerSlashings+2+i))
+	}
+	for _, idx := range attesterSlashed {
+		attesterSlashing, err := util.GenerateAttesterSlashingForValidator(beaconState, privKeys[idx], idx)
+		require.NoError(t, err)
+		require.NoError(t, proposerServer.SlashingsPool.InsertAttesterSlashing(ctx, beaconState, attesterSlashing))
+	}
+
+	block, err := proposerServer.GetBlock(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, maxProposerSlashings, uint64(len(block.Body.ProposerSlashings)))
+	require.Equal(t, params.BeaconConfig().MaxAttesterSlashings, uint64(len(block.Body.AttesterSlashings)))
+	slashed := make(map[types.ValidatorIndex]bool)
+	for _, s := range block.Body.ProposerSlashings {
+		slashed[s.Header_1.Header.ProposerIndex] = true
+	}
+	for _, s := range block.Body.AttesterSlashings {
+		for _, idx := range s.Attestation_1.AttestingIndices {
+			assert.Equal(t, false, slashed[types.ValidatorIndex(idx)], "Validator %d is slashed twice", idx)
+		}
+	}
+}
+
 func TestProposer_GetBlock_AddsUnaggregatedAtts(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -400,7 +468,7 @@ func TestProposer_PendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 		},
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:   bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot: make([]byte, 32),
@@ -532,7 +600,7 @@ func TestProposer_PendingDeposits_FollowsCorrectEth1Block(t *testing.T) {
 		votes = append(votes, vote)
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:    []byte("0x0"),
 			DepositRoot:  make([]byte, 32),
@@ -751,7 +819,7 @@ func TestProposer_PendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 		},
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:    bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot:  make([]byte, 32),
@@ -849,7 +917,7 @@ func TestProposer_PendingDeposits_CantReturnMoreThanDepositCount(t *testing.T) {
 		},
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:    bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot:  make([]byte, 32),
@@ -947,7 +1015,7 @@ func TestProposer_DepositTrie_UtilizesCachedFinalizedDeposits(t *testing.T) {
 		},
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:    bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot:  make([]byte, 32),
@@ -1063,7 +1131,7 @@ func TestProposer_DepositTrie_RebuildTrie(t *testing.T) {
 		},
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:    bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot:  make([]byte, 32),
@@ -1155,7 +1223,7 @@ func TestProposer_DepositTrie_RebuildTrie(t *testing.T) {
 	// Mutate it since its a pointer
 	d[0].Deposit.Data.WithdrawalCredentials = junkCreds[:]
 	// Insert junk to corrupt trie.
-	depositCache.InsertFinalizedDeposits(ctx, 2)
+	depositCache.InsertFinalizedDeposits(ctx, 2, [32]byte{}, 0)
 
 	// Add original back
 	d[0].Deposit = origDeposit
@@ -1278,19 +1346,18 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 	assert.NoError(t, depositCache.InsertDeposit(context.Background(), dc.Deposit, dc.Eth1BlockHeight, dc.Index, root))
 
 	t.Run("choose highest count", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("first"), DepositCount: 1},
-				{BlockHash: []byte("first"), DepositCount: 1},
-				{BlockHash: []byte("second"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("second"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1310,23 +1377,22 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("first")
+		expectedHash := bytesutil.PadTo([]byte("first"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count at earliest valid time - choose highest count", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("earliest"), DepositCount: 1},
-				{BlockHash: []byte("earliest"), DepositCount: 1},
-				{BlockHash: []byte("second"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("earliest"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("earliest"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("second"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1346,23 +1412,22 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("earliest")
+		expectedHash := bytesutil.PadTo([]byte("earliest"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count at latest valid time - choose highest count", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("first"), DepositCount: 1},
-				{BlockHash: []byte("latest"), DepositCount: 1},
-				{BlockHash: []byte("latest"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("latest"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("latest"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1382,24 +1447,23 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("latest")
+		expectedHash := bytesutil.PadTo([]byte("latest"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count before range - choose highest count within range", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(49, earliestValidTime-1, []byte("before_range")).
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("before_range"), DepositCount: 1},
-				{BlockHash: []byte("before_range"), DepositCount: 1},
-				{BlockHash: []byte("first"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("before_range"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("before_range"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1419,24 +1483,23 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("first")
+		expectedHash := bytesutil.PadTo([]byte("first"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count after range - choose highest count within range", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(100, latestValidTime, []byte("latest")).
 			InsertBlock(101, latestValidTime+1, []byte("after_range"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("first"), DepositCount: 1},
-				{BlockHash: []byte("after_range"), DepositCount: 1},
-				{BlockHash: []byte("after_range"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("after_range"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("after_range"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1456,24 +1519,23 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("first")
+		expectedHash := bytesutil.PadTo([]byte("first"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count on unknown block - choose known block with highest count", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("unknown"), DepositCount: 1},
-				{BlockHash: []byte("unknown"), DepositCount: 1},
-				{BlockHash: []byte("first"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("unknown"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("unknown"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1493,7 +1555,7 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("first")
+		expectedHash := bytesutil.PadTo([]byte("first"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
@@ -1502,7 +1564,7 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 			InsertBlock(49, earliestValidTime-1, []byte("before_range")).
 			InsertBlock(101, latestValidTime+1, []byte("after_range"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 		})
 		require.NoError(t, err)
@@ -1534,11 +1596,11 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(101, latestValidTime+1, []byte("after_range"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("before_range"), DepositCount: 1},
-				{BlockHash: []byte("after_range"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("before_range"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("after_range"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1568,7 +1630,7 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot:          slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{}})
 		require.NoError(t, err)
@@ -1598,7 +1660,7 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 		})
 		require.NoError(t, err)
@@ -1624,19 +1686,18 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
-	t.Run("same count - choose more recent block", func(t *testing.T) {
-		t.Skip()
+	t.Run("same count - choose first vote", func(t *testing.T) {
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("first"), DepositCount: 1},
-				{BlockHash: []byte("second"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+				{BlockHash: bytesutil.PadTo([]byte("second"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1656,24 +1717,23 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("second")
+		expectedHash := bytesutil.PadTo([]byte("first"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("highest count on block with less deposits - choose another block", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().
 			InsertBlock(50, earliestValidTime, []byte("earliest")).
 			InsertBlock(51, earliestValidTime+1, []byte("first")).
 			InsertBlock(52, earliestValidTime+2, []byte("second")).
 			InsertBlock(100, latestValidTime, []byte("latest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
 				{BlockHash: []byte("no_new_deposits"), DepositCount: 0},
 				{BlockHash: []byte("no_new_deposits"), DepositCount: 0},
-				{BlockHash: []byte("second"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("second"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1693,18 +1753,17 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("second")
+		expectedHash := bytesutil.PadTo([]byte("second"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
 	t.Run("only one block at earliest valid time - choose this block", func(t *testing.T) {
-		t.Skip()
 		p := mockPOW.NewPOWChain().InsertBlock(50, earliestValidTime, []byte("earliest"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("earliest"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("earliest"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1724,7 +1783,7 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 
 		hash := majorityVoteEth1Data.BlockHash
 
-		expectedHash := []byte("earliest")
+		expectedHash := bytesutil.PadTo([]byte("earliest"), 32)
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
@@ -1735,10 +1794,10 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 			// because of earliest block increment in the algorithm.
 			InsertBlock(50, earliestValidTime+1, []byte("first"))
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("before_range"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("before_range"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1774,10 +1833,10 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 		depositCache, err := depositcache.New()
 		require.NoError(t, err)
 
-		beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 			Slot: slot,
 			Eth1DataVotes: []*ethpb.Eth1Data{
-				{BlockHash: []byte("earliest"), DepositCount: 1},
+				{BlockHash: bytesutil.PadTo([]byte("earliest"), 32), DepositCount: 1, DepositRoot: root[:]},
 			},
 		})
 		require.NoError(t, err)
@@ -1800,6 +1859,44 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 		expectedHash := []byte("eth1data")
 		assert.DeepEqual(t, expectedHash, hash)
 	})
+
+	t.Run("cached candidates - execution chain not queried again in the voting period", func(t *testing.T) {
+		p := mockPOW.NewPOWChain().
+			InsertBlock(50, earliestValidTime, []byte("earliest")).
+			InsertBlock(51, earliestValidTime+1, []byte("first")).
+			InsertBlock(100, latestValidTime, []byte("latest"))
+		p.LatestETH1Data = &ethpb.LatestETH1Data{BlockHeight: 100, BlockTime: latestValidTime, LastRequestedBlock: 100}
+
+		beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+			Slot: slot,
+			Eth1DataVotes: []*ethpb.Eth1Data{
+				{BlockHash: bytesutil.PadTo([]byte("first"), 32), DepositCount: 1, DepositRoot: root[:]},
+			},
+		})
+		require.NoError(t, err)
+
+		ps := &Server{
+			ChainStartFetcher: p,
+			Eth1InfoFetcher:   p,
+			Eth1BlockFetcher:  p,
+			BlockFetcher:      p,
+			DepositFetcher:    depositCache,
+			HeadFetcher:       &mock.ChainService{ETH1Data: &ethpb.Eth1Data{DepositCount: 1}},
+			Eth1VoteCache:     cache.NewEth1VoteCache(),
+		}
+
+		ctx := context.Background()
+		majorityVoteEth1Data, err := ps.eth1DataMajorityVote(ctx, beaconState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte("first"), 32), majorityVoteEth1Data.BlockHash)
+
+		// The execution chain forgets about its blocks, the vote is still chosen from the cached candidates.
+		p.BlockNumberByTime = make(map[uint64]*big.Int)
+		p.HashesByHeight = make(map[int][]byte)
+		majorityVoteEth1Data, err = ps.eth1DataMajorityVote(ctx, beaconState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte("first"), 32), majorityVoteEth1Data.BlockHash)
+	})
 }
 
 func TestProposer_FilterAttestation(t *testing.T) {
@@ -1917,7 +2014,7 @@ func TestProposer_Deposits_ReturnsEmptyList_IfLatestEth1DataEqGenesisEth1Block(t
 		GenesisEth1Block: height,
 	}
 
-	beaconState, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	beaconState, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Eth1Data: &ethpb.Eth1Data{
 			BlockHash:   bytesutil.PadTo([]byte("0x0"), 32),
 			DepositRoot: make([]byte, 32),
@@ -2523,6 +2620,38 @@ func TestProposer_SubmitValidatorRegistration(t *testing.T) {
 	require.ErrorContains(t, "bad", err)
 }
 
+func TestProposer_GetValidatorRegistrations(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	beaconState, _ := util.DeterministicGenesisState(t, 4)
+	proposerServer := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mock.ChainService{State: beaconState},
+	}
+	pubKey := func(idx types.ValidatorIndex) []byte {
+		pk := beaconState.PubkeyAtIndex(idx)
+		return pk[:]
+	}
+	reg := &ethpb.SignedValidatorRegistrationV1{
+		Message: &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: make([]byte, 20),
+			GasLimit:     30000000,
+			Timestamp:    1,
+			Pubkey:       pubKey(1),
+		},
+		Signature: make([]byte, 96),
+	}
+	require.NoError(t, db.SaveSignedRegistrationsByValidatorIDs(ctx, []types.ValidatorIndex{1}, []*ethpb.SignedValidatorRegistrationV1{reg}))
+
+	// Validators without a registration and unknown validators are skipped.
+	res, err := proposerServer.GetValidatorRegistrations(ctx, &ethpb.ValidatorRegistrationsRequest{
+		PublicKeys: [][]byte{pubKey(0), pubKey(1), bytesutil.PadTo([]byte{'a'}, 48)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Messages))
+	assert.DeepEqual(t, reg, res.Messages[0])
+}
+
 func majorityVoteBoundaryTime(slot types.Slot) (uint64, uint64) {
 	s := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod))
 	slotStartTime := uint64(mockPOW.GenesisTime) + uint64((slot - (slot % (s))).Mul(params.BeaconConfig().SecondsPerSlot))
@@ -43,6 +43,8 @@ type Server struct {
 	Ctx                    context.Context
 	AttestationCache       *cache.AttestationCache
 	ProposerSlotIndexCache *cache.ProposerPayloadIDsCache
+	Eth1VoteCache          *cache.Eth1VoteCache
+	BoundaryStateCache     *cache.EpochBoundaryStateCache
 	HeadFetcher            blockchain.HeadFetcher
 	HeadUpdater            blockchain.HeadUpdater
 	ForkFetcher            blockchain.ForkFetcher
@@ -57,6 +59,7 @@ type Server struct {
 	StateNotifier          statefeed.Notifier
 	BlockNotifier          blockfeed.Notifier
 	P2P                    p2p.Broadcaster
+	PeerManager            p2p.PeerManager
 	AttPool                attestations.Pool
 	SlashingsPool          slashings.PoolManager
 	ExitPool               voluntaryexits.PoolManager
@@ -1,17 +1,21 @@
 package validator
 
 import (
-	"bytes"
 	"context"
+	"reflect"
+	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/config/params"
-	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
-	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/bls/common"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -55,11 +59,15 @@ func (vs *Server) SubmitSyncMessage(ctx context.Context, msg *ethpb.SyncCommitte
 		errs.Go(func() error {
 			return vs.P2P.BroadcastSyncCommitteeMessage(ctx, subnet, msg)
 		})
+		vs.SyncCommitteePool.MarkSyncCommitteeMessageReceived(msg.Slot, msg.ValidatorIndex, subnet)
 	}
 
 	if err := vs.SyncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
 		return &emptypb.Empty{}, err
 	}
+	if err := vs.SyncCommitteePool.AggregateSyncCommitteeMessage(msg, headSyncCommitteeIndices); err != nil {
+		return &emptypb.Empty{}, err
+	}
 
 	// Wait for p2p broadcast to complete and return the first error (if any)
 	err = errs.Wait()
@@ -93,24 +101,21 @@ func (vs *Server) GetSyncCommitteeContribution(
 		return nil, err
 	}
 
-	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(req.Slot)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee messages: %v", err)
-	}
 	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
 	}
-	aggregatedSig, bits, err := vs.AggregatedSigAndAggregationBits(ctx, msgs, req.Slot, req.SubnetId, headRoot)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get contribution data: %v", err)
-	}
-	contribution := &ethpb.SyncCommitteeContribution{
-		Slot:              req.Slot,
-		BlockRoot:         headRoot,
-		SubcommitteeIndex: req.SubnetId,
-		AggregationBits:   bits,
-		Signature:         aggregatedSig,
+	contribution := vs.SyncCommitteePool.SyncCommitteeContribution(req.Slot, bytesutil.ToBytes32(headRoot), req.SubnetId)
+	if contribution == nil {
+		// No message was received for the subcommittee, the contribution is empty.
+		sig := common.InfiniteSignature
+		contribution = &ethpb.SyncCommitteeContribution{
+			Slot:              req.Slot,
+			BlockRoot:         headRoot,
+			SubcommitteeIndex: req.SubnetId,
+			AggregationBits:   ethpb.NewSyncCommitteeAggregationBits(),
+			Signature:         sig[:],
+		}
 	}
 
 	return contribution, nil
@@ -128,6 +133,7 @@ func (vs *Server) SubmitSignedContributionAndProof(
 		return vs.P2P.Broadcast(ctx, s)
 	})
 
+	vs.SyncCommitteePool.MarkSyncCommitteeContributionAggregator(s.Message.Contribution, s.Message.AggregatorIndex, true /* local */)
 	if err := vs.SyncCommitteePool.SaveSyncCommitteeContribution(s.Message.Contribution); err != nil {
 		return nil, err
 	}
@@ -147,44 +153,70 @@ func (vs *Server) SubmitSignedContributionAndProof(
 	return &emptypb.Empty{}, err
 }
 
-// AggregatedSigAndAggregationBits returns the aggregated signature and aggregation bits
-// associated with a particular set of sync committee messages.
-func (vs *Server) AggregatedSigAndAggregationBits(
-	ctx context.Context,
-	msgs []*ethpb.SyncCommitteeMessage,
-	slot types.Slot,
-	subnetId uint64,
-	blockRoot []byte,
-) ([]byte, []byte, error) {
-	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
-	sigs := make([][]byte, 0, subCommitteeSize)
-	bits := ethpb.NewSyncCommitteeAggregationBits()
-	for _, msg := range msgs {
-		if bytes.Equal(blockRoot, msg.BlockRoot) {
-			headSyncCommitteeIndices, err := vs.HeadFetcher.HeadSyncCommitteeIndices(ctx, msg.ValidatorIndex, slot)
-			if err != nil {
-				return []byte{}, nil, errors.Wrapf(err, "could not get sync subcommittee index")
-			}
-			for _, index := range headSyncCommitteeIndices {
-				i := uint64(index)
-				subnetIndex := i / subCommitteeSize
-				indexMod := i % subCommitteeSize
-				if subnetIndex == subnetId && !bits.BitAt(indexMod) {
-					bits.SetBitAt(indexMod, true)
-					sigs = append(sigs, msg.Signature)
-				}
+// PrepareSyncCommitteeSubnets is called by a validator client to subscribe the beacon node
+// to the sync committee subnets of its validators until the requested epochs. The subnet
+// subscriptions of all the validators are aggregated, and the syncnets bits of the node's
+// ENR and metadata are updated accordingly.
+func (vs *Server) PrepareSyncCommitteeSubnets(
+	ctx context.Context, req *ethpb.PrepareSyncCommitteeSubnetsRequest,
+) (*emptypb.Empty, error) {
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	scs, err := s.SyncCommitteeState()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Sync committees are not available: %v", err)
+	}
+	currEpoch := coreTime.CurrentEpoch(s)
+	currPeriod := slots.SyncCommitteePeriod(currEpoch)
+	prevSubnets := cache.SyncSubnetIDs.GetAllSubnets(currEpoch)
+	for _, sub := range req.Subscriptions {
+		if sub.UntilEpoch <= currEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "Subscription of validator %d ends at epoch %d, before current epoch %d", sub.ValidatorIndex, sub.UntilEpoch, currEpoch)
+		}
+		// The until epoch is exclusive.
+		untilPeriod := slots.SyncCommitteePeriod(sub.UntilEpoch - 1)
+		if untilPeriod > currPeriod+1 {
+			return nil, status.Errorf(codes.InvalidArgument, "Subscription of validator %d ends at epoch %d, beyond the next sync committee period", sub.ValidatorIndex, sub.UntilEpoch)
+		}
+		if uint64(sub.ValidatorIndex) >= uint64(s.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown validator index %d", sub.ValidatorIndex)
+		}
+		vStatus := assignmentStatus(s, sub.ValidatorIndex)
+		if vStatus != ethpb.ValidatorStatus_ACTIVE && vStatus != ethpb.ValidatorStatus_EXITING {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not active", sub.ValidatorIndex)
+		}
+		pubKey := s.PubkeyAtIndex(sub.ValidatorIndex)
+		inCurrent, err := helpers.IsCurrentPeriodSyncCommittee(s, sub.ValidatorIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine current period sync committee: %v", err)
+		}
+		if inCurrent {
+			if err := registerSyncSubnetCurrentPeriod(scs, currEpoch, pubKey[:], vStatus); err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not register current period sync subnets: %v", err)
 			}
 		}
-	}
-	aggregatedSig := make([]byte, 96)
-	aggregatedSig[0] = 0xC0
-	if len(sigs) != 0 {
-		uncompressedSigs, err := bls.MultipleSignaturesFromBytes(sigs)
+		if untilPeriod == currPeriod {
+			continue
+		}
+		inNext, err := helpers.IsNextPeriodSyncCommittee(s, sub.ValidatorIndex)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "could not decompress signatures")
+			return nil, status.Errorf(codes.Internal, "Could not determine next period sync committee: %v", err)
+		}
+		if inNext {
+			if err := registerSyncSubnetNextPeriod(scs, currEpoch, pubKey[:], vStatus); err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not register next period sync subnets: %v", err)
+			}
 		}
-		aggregatedSig = bls.AggregateSignatures(uncompressedSigs).Marshal()
 	}
-
-	return aggregatedSig, bits, nil
+	// The syncnets subscriber joins the newly registered subnets on its next slot tick, the
+	// ENR is refreshed right away for the node to be discoverable on them.
+	currSubnets := cache.SyncSubnetIDs.GetAllSubnets(currEpoch)
+	sort.Slice(prevSubnets, func(i, j int) bool { return prevSubnets[i] < prevSubnets[j] })
+	sort.Slice(currSubnets, func(i, j int) bool { return currSubnets[i] < currSubnets[j] })
+	if vs.PeerManager != nil && !reflect.DeepEqual(prevSubnets, currSubnets) {
+		vs.PeerManager.RefreshENR()
+	}
+	return &emptypb.Empty{}, nil
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
@@ -15,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -198,3 +201,80 @@ func TestSubmitSignedContributionAndProof_Notification(t *testing.T) {
 		}
 	}
 }
+
+type refreshCountingPeerManager struct {
+	mockp2p.MockPeerManager
+	refreshes int
+}
+
+func (m *refreshCountingPeerManager) RefreshENR() {
+	m.refreshes++
+}
+
+func TestPrepareSyncCommitteeSubnets(t *testing.T) {
+	cache.SyncSubnetIDs.EmptyAllCaches()
+	t.Cleanup(cache.SyncSubnetIDs.EmptyAllCaches)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	committee, err := altair.NextSyncCommittee(context.Background(), st)
+	require.NoError(t, err)
+	require.NoError(t, st.SetCurrentSyncCommittee(committee))
+	require.NoError(t, st.SetNextSyncCommittee(committee))
+	peerManager := &refreshCountingPeerManager{}
+	server := &Server{
+		HeadFetcher: &mock.ChainService{State: st},
+		PeerManager: peerManager,
+	}
+	periodEnd := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+
+	_, err = server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{{ValidatorIndex: 1, UntilEpoch: 0}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{{ValidatorIndex: 1, UntilEpoch: 2*periodEnd + 1}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{{ValidatorIndex: 64, UntilEpoch: 1}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 0, peerManager.refreshes)
+
+	first, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[0]))
+	require.Equal(t, true, ok)
+	last, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[len(committee.Pubkeys)-1]))
+	require.Equal(t, true, ok)
+	_, err = server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{
+			{ValidatorIndex: first, UntilEpoch: periodEnd},
+			{ValidatorIndex: last, UntilEpoch: 2 * periodEnd},
+		},
+	})
+	require.NoError(t, err)
+	for _, idx := range []types.ValidatorIndex{first, last} {
+		pubKey := st.PubkeyAtIndex(idx)
+		subnets, _, ok, _ := cache.SyncSubnetIDs.GetSyncCommitteeSubnets(pubKey[:], 0)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, subnetsFromCommittee(pubKey[:], committee), subnets)
+	}
+	assert.Equal(t, 1, peerManager.refreshes)
+
+	// The ENR is not refreshed again when the subnets do not change.
+	_, err = server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{{ValidatorIndex: first, UntilEpoch: periodEnd}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, peerManager.refreshes)
+}
+
+func TestPrepareSyncCommitteeSubnets_Phase0(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	server := &Server{
+		HeadFetcher: &mock.ChainService{State: st},
+	}
+	_, err := server.PrepareSyncCommitteeSubnets(context.Background(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{{ValidatorIndex: 1, UntilEpoch: 1}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
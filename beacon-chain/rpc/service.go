@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -21,7 +22,9 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -43,6 +46,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/io/logs"
+	"github.com/prysmaticlabs/prysm/monitoring/profiler"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpbv1alpha1 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -56,6 +60,21 @@ import (
 
 const attestationBufferSize = 100
 
+// Prefixes of the methods never deferred under memory pressure: the validator duties are served
+// regardless, since missing them costs rewards, as well as health checks.
+var memoryExemptServices = []string{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/",
+	"/ethereum.eth.service.BeaconValidator/",
+	"/ethereum.eth.v1alpha1.Health/",
+}
+
+// headSnapshotServices are the services whose requests are served from a snapshot of the head captured when the
+// request is received.
+var headSnapshotServices = []string{
+	"/ethereum.eth.service.BeaconChain/",
+	"/ethereum.eth.service.BeaconDebug/",
+}
+
 // Service defining an RPC server for a beacon node.
 type Service struct {
 	cfg                  *Config
@@ -82,7 +101,9 @@ type Config struct {
 	ChainInfoFetcher              blockchain.ChainInfoFetcher
 	HeadUpdater                   blockchain.HeadUpdater
 	HeadFetcher                   blockchain.HeadFetcher
+	HeadSnapshotFetcher           blockchain.HeadSnapshotFetcher
 	CanonicalFetcher              blockchain.CanonicalFetcher
+	AncestryFetcher               blockchain.AncestryFetcher
 	ForkFetcher                   blockchain.ForkFetcher
 	FinalizationFetcher           blockchain.FinalizationFetcher
 	AttestationReceiver           blockchain.AttestationReceiver
@@ -96,6 +117,7 @@ type Config struct {
 	MockEth1Votes                 bool
 	AttestationsPool              attestations.Pool
 	ExitPool                      voluntaryexits.PoolManager
+	BLSToExecPool                 blstoexec.PoolManager
 	SlashingsPool                 slashings.PoolManager
 	SlashingChecker               slasherservice.SlashingChecker
 	SyncCommitteeObjectPool       synccommittee.Pool
@@ -113,8 +135,11 @@ type Config struct {
 	MaxMsgSize                    int
 	ExecutionEngineCaller         powchain.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
+	BlockArrivals                 *cache.BlockArrivalCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
 	BlockBuilder                  builder.BlockBuilder
+	ProfileSnapshotter            profiler.Snapshotter
+	MemoryGovernor                *memory.Service
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -146,6 +171,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			grpcprometheus.StreamServerInterceptor,
 			grpcopentracing.StreamServerInterceptor(),
 			s.validatorStreamConnectionInterceptor,
+			s.cfg.MemoryGovernor.StreamServerInterceptor(memoryExemptServices...),
 		)),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
 			recovery.UnaryServerInterceptor(
@@ -154,6 +180,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			grpcprometheus.UnaryServerInterceptor,
 			grpcopentracing.UnaryServerInterceptor(),
 			s.validatorUnaryConnectionInterceptor,
+			s.cfg.MemoryGovernor.UnaryServerInterceptor(memoryExemptServices...),
+			s.headSnapshotUnaryInterceptor,
 		)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
@@ -187,10 +215,14 @@ func (s *Service) Start() {
 	}
 	withCache := stategen.WithCache(stateCache)
 	ch := stategen.NewCanonicalHistory(s.cfg.BeaconDB, s.cfg.ChainInfoFetcher, s.cfg.ChainInfoFetcher, withCache)
+	// The duty endpoints of both APIs advance the head state to the same epoch start slots.
+	dutiesStateCache := cache.NewEpochBoundaryStateCache()
 
 	validatorServer := &validatorv1alpha1.Server{
 		Ctx:                    s.ctx,
 		AttestationCache:       cache.NewAttestationCache(),
+		Eth1VoteCache:          cache.NewEth1VoteCache(),
+		BoundaryStateCache:     dutiesStateCache,
 		AttPool:                s.cfg.AttestationsPool,
 		ExitPool:               s.cfg.ExitPool,
 		HeadFetcher:            s.cfg.HeadFetcher,
@@ -208,6 +240,7 @@ func (s *Service) Start() {
 		BlockNotifier:          s.cfg.BlockNotifier,
 		OperationNotifier:      s.cfg.OperationNotifier,
 		P2P:                    s.cfg.Broadcaster,
+		PeerManager:            s.cfg.PeerManager,
 		BlockReceiver:          s.cfg.BlockReceiver,
 		MockEth1Votes:          s.cfg.MockEth1Votes,
 		Eth1BlockFetcher:       s.cfg.POWChainService,
@@ -238,7 +271,8 @@ func (s *Service) Start() {
 			StateGenService:    s.cfg.StateGen,
 			ReplayerBuilder:    ch,
 		},
-		SyncCommitteePool: s.cfg.SyncCommitteeObjectPool,
+		SyncCommitteePool:  s.cfg.SyncCommitteeObjectPool,
+		BoundaryStateCache: dutiesStateCache,
 	}
 
 	nodeServer := &nodev1alpha1.Server{
@@ -250,6 +284,7 @@ func (s *Service) Start() {
 		GenesisTimeFetcher:   s.cfg.GenesisTimeFetcher,
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
+		MetadataProvider:     s.cfg.MetadataProvider,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		POWChainInfoFetcher:  s.cfg.POWChainInfoFetcher,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
@@ -277,6 +312,7 @@ func (s *Service) Start() {
 		HeadFetcher:                 s.cfg.HeadFetcher,
 		FinalizationFetcher:         s.cfg.FinalizationFetcher,
 		CanonicalFetcher:            s.cfg.CanonicalFetcher,
+		AncestryFetcher:             s.cfg.AncestryFetcher,
 		ChainStartFetcher:           s.cfg.ChainStartFetcher,
 		DepositFetcher:              s.cfg.DepositFetcher,
 		BlockFetcher:                s.cfg.POWChainService,
@@ -313,9 +349,12 @@ func (s *Service) Start() {
 		OptimisticModeFetcher:         s.cfg.OptimisticModeFetcher,
 		HeadFetcher:                   s.cfg.HeadFetcher,
 		VoluntaryExitsPool:            s.cfg.ExitPool,
+		BLSToExecPool:                 s.cfg.BLSToExecPool,
 		V1Alpha1ValidatorServer:       validatorServer,
 		SyncChecker:                   s.cfg.SyncService,
 		ExecutionPayloadReconstructor: s.cfg.ExecutionPayloadReconstructor,
+		CommitteesCache:               cache.NewEpochCommitteesCache(),
+		RewardsStateCache:             cache.NewEpochBoundaryStateCache(),
 	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
@@ -323,22 +362,29 @@ func (s *Service) Start() {
 	ethpbv1alpha1.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbservice.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	ethpbservice.RegisterEventsServer(s.grpcServer, &events.Server{
-		Ctx:               s.ctx,
-		StateNotifier:     s.cfg.StateNotifier,
-		BlockNotifier:     s.cfg.BlockNotifier,
-		OperationNotifier: s.cfg.OperationNotifier,
+		Ctx:                   s.ctx,
+		StateNotifier:         s.cfg.StateNotifier,
+		BlockNotifier:         s.cfg.BlockNotifier,
+		OperationNotifier:     s.cfg.OperationNotifier,
+		ProposerDutiesFetcher: validatorServerV1,
 	})
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			ForkFetcher:        s.cfg.ForkFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
-			ReplayerBuilder:    ch,
+			GenesisTimeFetcher:    s.cfg.GenesisTimeFetcher,
+			BeaconDB:              s.cfg.BeaconDB,
+			StateGen:              s.cfg.StateGen,
+			HeadFetcher:           s.cfg.HeadFetcher,
+			ForkFetcher:           s.cfg.ForkFetcher,
+			PeerManager:           s.cfg.PeerManager,
+			PeersFetcher:          s.cfg.PeersFetcher,
+			ReplayerBuilder:       ch,
+			DepositFetcher:        s.cfg.DepositFetcher,
+			PendingDepositFetcher: s.cfg.PendingDepositFetcher,
+			POWChainInfoFetcher:   s.cfg.POWChainInfoFetcher,
+			ProfileSnapshotter:    s.cfg.ProfileSnapshotter,
+			SyncCommitteePool:     s.cfg.SyncCommitteeObjectPool,
+			BlockArrivals:         s.cfg.BlockArrivals,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
@@ -412,6 +458,35 @@ func (s *Service) validatorUnaryConnectionInterceptor(
 	return handler(ctx, req)
 }
 
+// Unary interceptor capturing a snapshot of the head for the requests of the Beacon API, so that the head block,
+// head state and fork choice view read while serving a request stay consistent even if a new head is set.
+func (s *Service) headSnapshotUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if s.cfg.HeadSnapshotFetcher == nil || !hasAnyPrefix(info.FullMethod, headSnapshotServices) {
+		return handler(ctx, req)
+	}
+	snapshot, err := s.cfg.HeadSnapshotFetcher.HeadSnapshot(ctx)
+	if err != nil {
+		// The head is not set yet, the request reads the head from the database.
+		log.WithError(err).Debug("Could not capture head snapshot")
+		return handler(ctx, req)
+	}
+	return handler(blockchain.ContextWithHeadSnapshot(ctx, snapshot), req)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) logNewClientConnection(ctx context.Context) {
 	if features.Get().DisableGRPCConnectionLogs {
 		return
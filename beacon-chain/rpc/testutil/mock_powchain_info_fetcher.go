@@ -2,6 +2,8 @@ package testutil
 
 import (
 	"math/big"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
 // MockPOWChainInfoFetcher is a fake implementation of the powchain.ChainInfoFetcher
@@ -10,6 +12,7 @@ type MockPOWChainInfoFetcher struct {
 	CurrError    error
 	Endpoints    []string
 	Errors       []error
+	Eth1Data     *ethpb.LatestETH1Data
 }
 
 func (*MockPOWChainInfoFetcher) Eth2GenesisPowchainInfo() (uint64, *big.Int) {
@@ -35,3 +38,7 @@ func (m *MockPOWChainInfoFetcher) ETH1Endpoints() []string {
 func (m *MockPOWChainInfoFetcher) ETH1ConnectionErrors() []error {
 	return m.Errors
 }
+
+func (m *MockPOWChainInfoFetcher) LatestEth1Data() *ethpb.LatestETH1Data {
+	return m.Eth1Data
+}
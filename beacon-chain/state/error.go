@@ -6,4 +6,7 @@ var (
 	// ErrNilValidatorsInState returns when accessing validators in the state while the state has a
 	// nil slice for the validators field.
 	ErrNilValidatorsInState = errors.New("state has nil validator slice")
+	// ErrSyncCommitteesNotSupported returns when accessing the sync committees of a state from
+	// before the Altair fork.
+	ErrSyncCommitteesNotSupported = errors.New("sync committees are not supported before Altair")
 )
@@ -63,6 +63,16 @@ type ReadOnlyBeaconState interface {
 	IsNil() bool
 	Version() int
 	LatestExecutionPayloadHeader() (*enginev1.ExecutionPayloadHeader, error)
+	SyncCommitteeState() (SyncCommitteeState, error)
+}
+
+// SyncCommitteeState defines the sync committee access of beacon states since the Altair fork.
+// It is obtained through ReadOnlyBeaconState.SyncCommitteeState, which returns
+// ErrSyncCommitteesNotSupported for earlier states, so that callers do not need to check the
+// version of the state beforehand.
+type SyncCommitteeState interface {
+	CurrentSyncCommittee() (*ethpb.SyncCommittee, error)
+	NextSyncCommittee() (*ethpb.SyncCommittee, error)
 }
 
 // WriteOnlyBeaconState defines a struct which only has write access to beacon state methods.
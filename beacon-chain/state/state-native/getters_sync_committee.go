@@ -1,11 +1,22 @@
 package state_native
 
 import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
 )
 
+// SyncCommitteeState returns the beacon state itself if it has sync committees, that is if it is not
+// a phase 0 state.
+func (b *BeaconState) SyncCommitteeState() (state.SyncCommitteeState, error) {
+	if b.version == version.Phase0 {
+		return nil, errors.Wrapf(state.ErrSyncCommitteesNotSupported, "%s beacon state", version.String(b.version))
+	}
+	return b, nil
+}
+
 // CurrentSyncCommittee of the current sync committee in beacon chain state.
 func (b *BeaconState) CurrentSyncCommittee() (*ethpb.SyncCommittee, error) {
 	b.lock.RLock()
@@ -5,7 +5,10 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	testtmpl "github.com/prysmaticlabs/prysm/beacon-chain/state/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
 )
 
 func TestBeaconState_SlotDataRace_Phase0(t *testing.T) {
@@ -97,3 +100,22 @@ func TestBeaconState_ValidatorByPubkey_Bellatrix(t *testing.T) {
 		return InitializeFromProtoBellatrix(&ethpb.BeaconStateBellatrix{})
 	})
 }
+
+func TestBeaconState_SyncCommitteeState(t *testing.T) {
+	phase0, err := InitializeFromProtoPhase0(&ethpb.BeaconState{})
+	require.NoError(t, err)
+	_, err = phase0.SyncCommitteeState()
+	require.ErrorIs(t, err, state.ErrSyncCommitteesNotSupported)
+
+	committee := &ethpb.SyncCommittee{
+		Pubkeys:         [][]byte{bytesutil.PadTo([]byte{'a'}, fieldparams.BLSPubkeyLength)},
+		AggregatePubkey: bytesutil.PadTo([]byte{'b'}, fieldparams.BLSPubkeyLength),
+	}
+	altair, err := InitializeFromProtoAltair(&ethpb.BeaconStateAltair{CurrentSyncCommittee: committee})
+	require.NoError(t, err)
+	scs, err := altair.SyncCommitteeState()
+	require.NoError(t, err)
+	got, err := scs.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepEqual(t, committee, got)
+}
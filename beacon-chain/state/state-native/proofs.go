@@ -83,10 +83,6 @@ func (b *BeaconState) FinalizedRootProof(ctx context.Context) ([][]byte, error)
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	if b.version == version.Phase0 {
-		return nil, errNotSupported("FinalizedRootProof", b.version)
-	}
-
 	if err := b.initializeMerkleLayers(ctx); err != nil {
 		return nil, err
 	}
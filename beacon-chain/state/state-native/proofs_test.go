@@ -17,11 +17,11 @@ func TestBeaconStateMerkleProofs(t *testing.T) {
 	require.NoError(t, err)
 	t.Run("current sync committee", func(t *testing.T) {
 		_, err := st.CurrentSyncCommitteeProof(ctx)
-		require.ErrorContains(t, "unsupported", err)
+		require.ErrorContains(t, "not supported", err)
 	})
 	t.Run("next sync committee", func(t *testing.T) {
 		_, err := st.NextSyncCommitteeProof(ctx)
-		require.ErrorContains(t, "unsupported", err)
+		require.ErrorContains(t, "not supported", err)
 	})
 	t.Run("finalized root", func(t *testing.T) {
 		finalizedRoot := st.FinalizedCheckpoint().Root
@@ -102,6 +102,29 @@ func TestStateReferenceSharing_Finalizer_Bellatrix(t *testing.T) {
 	}
 }
 
+func TestStateReferenceSharing_AllSharedFieldsTracked(t *testing.T) {
+	phase0, err := InitializeFromProtoUnsafePhase0(&ethpb.BeaconState{})
+	require.NoError(t, err)
+	altair, err := InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{})
+	require.NoError(t, err)
+	bellatrix, err := InitializeFromProtoUnsafeBellatrix(&ethpb.BeaconStateBellatrix{})
+	require.NoError(t, err)
+
+	for _, s := range []state.BeaconState{phase0, altair, bellatrix} {
+		a, ok := s.(*BeaconState)
+		require.Equal(t, true, ok)
+		fields := sharedFieldsForVersion(a.version)
+		require.Equal(t, len(fields), len(a.sharedFieldReferences))
+
+		b, ok := a.Copy().(*BeaconState)
+		require.Equal(t, true, ok)
+		for _, f := range fields {
+			assert.Equal(t, uint(2), a.sharedFieldReferences[f].Refs(), "Expected 2 references to %s", f.String(a.version))
+			assert.Equal(t, a.sharedFieldReferences[f], b.sharedFieldReferences[f])
+		}
+	}
+}
+
 func TestStateReferenceCopy_NoUnexpectedRootsMutation_Phase0(t *testing.T) {
 	root1, root2 := bytesutil.ToBytes32([]byte("foo")), bytesutil.ToBytes32([]byte("bar"))
 	s, err := InitializeFromProtoUnsafePhase0(&ethpb.BeaconState{
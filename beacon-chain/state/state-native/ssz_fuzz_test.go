@@ -0,0 +1,85 @@
+//go:build go1.18
+
+package state_native_test
+
+import (
+	"context"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	native "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type sszProto interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// fuzzStateSSZ checks that any state decoded from SSZ is encoded back to the same bytes once converted to
+// a native state, and that its root is the one of a copy made before any hashing.
+func fuzzStateSSZ(
+	f *testing.F,
+	seed state.BeaconState,
+	newPb func() sszProto,
+	initialize func(sszProto) (state.BeaconState, error),
+) {
+	enc, err := seed.MarshalSSZ()
+	require.NoError(f, err)
+	f.Add(enc)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		pb := newPb()
+		if err := pb.UnmarshalSSZ(b); err != nil {
+			return
+		}
+		st, err := initialize(pb)
+		if err != nil {
+			return
+		}
+		wantEnc, wantErr := pb.MarshalSSZ()
+		enc, err := st.MarshalSSZ()
+		if wantErr == nil && err == nil {
+			require.DeepEqual(t, wantEnc, enc)
+		}
+		wantRoot, wantErr := st.Copy().HashTreeRoot(context.Background())
+		root, err := st.HashTreeRoot(context.Background())
+		if wantErr == nil && err == nil {
+			require.Equal(t, wantRoot, root)
+		}
+	})
+}
+
+func FuzzStateSSZ_Phase0(f *testing.F) {
+	seed, _ := util.DeterministicGenesisState(f, 64)
+	fuzzStateSSZ(f, seed,
+		func() sszProto { return &ethpb.BeaconState{} },
+		func(pb sszProto) (state.BeaconState, error) {
+			return native.InitializeFromProtoPhase0(pb.(*ethpb.BeaconState))
+		},
+	)
+}
+
+func FuzzStateSSZ_Altair(f *testing.F) {
+	seed, _ := util.DeterministicGenesisStateAltair(f, 64)
+	fuzzStateSSZ(f, seed,
+		func() sszProto { return &ethpb.BeaconStateAltair{} },
+		func(pb sszProto) (state.BeaconState, error) {
+			return native.InitializeFromProtoAltair(pb.(*ethpb.BeaconStateAltair))
+		},
+	)
+}
+
+func FuzzStateSSZ_Bellatrix(f *testing.F) {
+	seed, _ := util.DeterministicGenesisStateBellatrix(f, 64)
+	fuzzStateSSZ(f, seed,
+		func() sszProto { return &ethpb.BeaconStateBellatrix{} },
+		func(pb sszProto) (state.BeaconState, error) {
+			return native.InitializeFromProtoBellatrix(pb.(*ethpb.BeaconStateBellatrix))
+		},
+	)
+}
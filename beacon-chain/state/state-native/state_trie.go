@@ -103,6 +103,87 @@ var bellatrixFields = []nativetypes.FieldIndex{
 	nativetypes.LatestExecutionPayloadHeader,
 }
 
+// phase0SharedFields are the fields of a phase 0 state whose underlying data
+// is shared between copies until one of the copies mutates it.
+var phase0SharedFields = []nativetypes.FieldIndex{
+	nativetypes.BlockRoots,
+	nativetypes.StateRoots,
+	nativetypes.HistoricalRoots,
+	nativetypes.Eth1DataVotes,
+	nativetypes.Validators,
+	nativetypes.Balances,
+	nativetypes.RandaoMixes,
+	nativetypes.Slashings,
+	nativetypes.PreviousEpochAttestations,
+	nativetypes.CurrentEpochAttestations,
+}
+
+var altairSharedFields = []nativetypes.FieldIndex{
+	nativetypes.BlockRoots,
+	nativetypes.StateRoots,
+	nativetypes.HistoricalRoots,
+	nativetypes.Eth1DataVotes,
+	nativetypes.Validators,
+	nativetypes.Balances,
+	nativetypes.RandaoMixes,
+	nativetypes.Slashings,
+	nativetypes.PreviousEpochParticipationBits,
+	nativetypes.CurrentEpochParticipationBits,
+	nativetypes.InactivityScores,
+}
+
+var bellatrixSharedFields = []nativetypes.FieldIndex{
+	nativetypes.BlockRoots,
+	nativetypes.StateRoots,
+	nativetypes.HistoricalRoots,
+	nativetypes.Eth1DataVotes,
+	nativetypes.Validators,
+	nativetypes.Balances,
+	nativetypes.RandaoMixes,
+	nativetypes.Slashings,
+	nativetypes.PreviousEpochParticipationBits,
+	nativetypes.CurrentEpochParticipationBits,
+	nativetypes.InactivityScores,
+	nativetypes.LatestExecutionPayloadHeader,
+}
+
+// fieldCountForVersion returns the number of top level fields of a state of the given version.
+func fieldCountForVersion(v int) int {
+	switch v {
+	case version.Phase0:
+		return params.BeaconConfig().BeaconStateFieldCount
+	case version.Altair:
+		return params.BeaconConfig().BeaconStateAltairFieldCount
+	case version.Bellatrix:
+		return params.BeaconConfig().BeaconStateBellatrixFieldCount
+	default:
+		return 0
+	}
+}
+
+// sharedFieldsForVersion returns the fields whose data is shared between copies of a state of the given version.
+func sharedFieldsForVersion(v int) []nativetypes.FieldIndex {
+	switch v {
+	case version.Phase0:
+		return phase0SharedFields
+	case version.Altair:
+		return altairSharedFields
+	case version.Bellatrix:
+		return bellatrixSharedFields
+	default:
+		return nil
+	}
+}
+
+// initSharedFieldReferences starts reference tracking for every shared field of the state.
+func (b *BeaconState) initSharedFieldReferences() {
+	fields := sharedFieldsForVersion(b.version)
+	b.sharedFieldReferences = make(map[nativetypes.FieldIndex]*stateutil.Reference, len(fields))
+	for _, f := range fields {
+		b.sharedFieldReferences[f] = stateutil.NewRef(1)
+	}
+}
+
 // InitializeFromProtoPhase0 the beacon state from a protobuf representation.
 func InitializeFromProtoPhase0(st *ethpb.BeaconState) (state.BeaconState, error) {
 	return InitializeFromProtoUnsafePhase0(proto.Clone(st).(*ethpb.BeaconState))
@@ -142,7 +223,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		copy(mixes[i][:], m)
 	}
 
-	fieldCount := params.BeaconConfig().BeaconStateFieldCount
+	fieldCount := fieldCountForVersion(version.Phase0)
 	b := &BeaconState{
 		version:                     version.Phase0,
 		genesisTime:                 st.GenesisTime,
@@ -167,12 +248,11 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		currentJustifiedCheckpoint:  st.CurrentJustifiedCheckpoint,
 		finalizedCheckpoint:         st.FinalizedCheckpoint,
 
-		dirtyFields:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		dirtyIndices:          make(map[nativetypes.FieldIndex][]uint64, fieldCount),
-		stateFieldLeaves:      make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
-		sharedFieldReferences: make(map[nativetypes.FieldIndex]*stateutil.Reference, 10),
-		rebuildTrie:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		valMapHandler:         stateutil.NewValMapHandler(st.Validators),
+		dirtyFields:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		dirtyIndices:     make(map[nativetypes.FieldIndex][]uint64, fieldCount),
+		stateFieldLeaves: make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
+		rebuildTrie:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		valMapHandler:    stateutil.NewValMapHandler(st.Validators),
 	}
 
 	for _, f := range phase0Fields {
@@ -187,16 +267,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 	}
 
 	// Initialize field reference tracking for shared data.
-	b.sharedFieldReferences[nativetypes.BlockRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.StateRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.HistoricalRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Validators] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Balances] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.RandaoMixes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Slashings] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.PreviousEpochAttestations] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.CurrentEpochAttestations] = stateutil.NewRef(1)
+	b.initSharedFieldReferences()
 
 	state.StateCount.Inc()
 	// Finalizer runs when dst is being destroyed in garbage collection.
@@ -228,7 +299,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		mixes[i] = bytesutil.ToBytes32(m)
 	}
 
-	fieldCount := params.BeaconConfig().BeaconStateAltairFieldCount
+	fieldCount := fieldCountForVersion(version.Altair)
 	b := &BeaconState{
 		version:                     version.Altair,
 		genesisTime:                 st.GenesisTime,
@@ -256,12 +327,11 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		currentSyncCommittee:        st.CurrentSyncCommittee,
 		nextSyncCommittee:           st.NextSyncCommittee,
 
-		dirtyFields:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		dirtyIndices:          make(map[nativetypes.FieldIndex][]uint64, fieldCount),
-		stateFieldLeaves:      make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
-		sharedFieldReferences: make(map[nativetypes.FieldIndex]*stateutil.Reference, 11),
-		rebuildTrie:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		valMapHandler:         stateutil.NewValMapHandler(st.Validators),
+		dirtyFields:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		dirtyIndices:     make(map[nativetypes.FieldIndex][]uint64, fieldCount),
+		stateFieldLeaves: make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
+		rebuildTrie:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		valMapHandler:    stateutil.NewValMapHandler(st.Validators),
 	}
 
 	for _, f := range altairFields {
@@ -276,17 +346,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 	}
 
 	// Initialize field reference tracking for shared data.
-	b.sharedFieldReferences[nativetypes.BlockRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.StateRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.HistoricalRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Validators] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Balances] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.RandaoMixes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Slashings] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.PreviousEpochParticipationBits] = stateutil.NewRef(1) // New in Altair.
-	b.sharedFieldReferences[nativetypes.CurrentEpochParticipationBits] = stateutil.NewRef(1)  // New in Altair.
-	b.sharedFieldReferences[nativetypes.InactivityScores] = stateutil.NewRef(1)               // New in Altair.
+	b.initSharedFieldReferences()
 
 	state.StateCount.Inc()
 	// Finalizer runs when dst is being destroyed in garbage collection.
@@ -318,7 +378,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		mixes[i] = bytesutil.ToBytes32(m)
 	}
 
-	fieldCount := params.BeaconConfig().BeaconStateBellatrixFieldCount
+	fieldCount := fieldCountForVersion(version.Bellatrix)
 	b := &BeaconState{
 		version:                      version.Bellatrix,
 		genesisTime:                  st.GenesisTime,
@@ -347,12 +407,11 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		nextSyncCommittee:            st.NextSyncCommittee,
 		latestExecutionPayloadHeader: st.LatestExecutionPayloadHeader,
 
-		dirtyFields:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		dirtyIndices:          make(map[nativetypes.FieldIndex][]uint64, fieldCount),
-		stateFieldLeaves:      make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
-		sharedFieldReferences: make(map[nativetypes.FieldIndex]*stateutil.Reference, 11),
-		rebuildTrie:           make(map[nativetypes.FieldIndex]bool, fieldCount),
-		valMapHandler:         stateutil.NewValMapHandler(st.Validators),
+		dirtyFields:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		dirtyIndices:     make(map[nativetypes.FieldIndex][]uint64, fieldCount),
+		stateFieldLeaves: make(map[nativetypes.FieldIndex]*fieldtrie.FieldTrie, fieldCount),
+		rebuildTrie:      make(map[nativetypes.FieldIndex]bool, fieldCount),
+		valMapHandler:    stateutil.NewValMapHandler(st.Validators),
 	}
 
 	for _, f := range bellatrixFields {
@@ -367,18 +426,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 	}
 
 	// Initialize field reference tracking for shared data.
-	b.sharedFieldReferences[nativetypes.BlockRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.StateRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.HistoricalRoots] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Eth1DataVotes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Validators] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Balances] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.RandaoMixes] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.Slashings] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.PreviousEpochParticipationBits] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.CurrentEpochParticipationBits] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.InactivityScores] = stateutil.NewRef(1)
-	b.sharedFieldReferences[nativetypes.LatestExecutionPayloadHeader] = stateutil.NewRef(1) // New in Bellatrix.
+	b.initSharedFieldReferences()
 
 	state.StateCount.Inc()
 	// Finalizer runs when dst is being destroyed in garbage collection.
@@ -391,15 +439,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	var fieldCount int
-	switch b.version {
-	case version.Phase0:
-		fieldCount = params.BeaconConfig().BeaconStateFieldCount
-	case version.Altair:
-		fieldCount = params.BeaconConfig().BeaconStateAltairFieldCount
-	case version.Bellatrix:
-		fieldCount = params.BeaconConfig().BeaconStateBellatrixFieldCount
-	}
+	fieldCount := fieldCountForVersion(b.version)
 
 	dst := &BeaconState{
 		version: b.version,
@@ -448,15 +488,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 		valMapHandler: b.valMapHandler,
 	}
 
-	switch b.version {
-	case version.Phase0:
-		dst.sharedFieldReferences = make(map[nativetypes.FieldIndex]*stateutil.Reference, 10)
-	case version.Altair:
-		dst.sharedFieldReferences = make(map[nativetypes.FieldIndex]*stateutil.Reference, 11)
-	case version.Bellatrix:
-		dst.sharedFieldReferences = make(map[nativetypes.FieldIndex]*stateutil.Reference, 11)
-	}
-
+	dst.sharedFieldReferences = make(map[nativetypes.FieldIndex]*stateutil.Reference, len(b.sharedFieldReferences))
 	for field, ref := range b.sharedFieldReferences {
 		ref.AddRef()
 		dst.sharedFieldReferences[field] = ref
@@ -535,14 +567,7 @@ func (b *BeaconState) initializeMerkleLayers(ctx context.Context) error {
 	}
 	layers := stateutil.Merkleize(fieldRoots)
 	b.merkleLayers = layers
-	switch b.version {
-	case version.Phase0:
-		b.dirtyFields = make(map[nativetypes.FieldIndex]bool, params.BeaconConfig().BeaconStateFieldCount)
-	case version.Altair:
-		b.dirtyFields = make(map[nativetypes.FieldIndex]bool, params.BeaconConfig().BeaconStateAltairFieldCount)
-	case version.Bellatrix:
-		b.dirtyFields = make(map[nativetypes.FieldIndex]bool, params.BeaconConfig().BeaconStateBellatrixFieldCount)
-	}
+	b.dirtyFields = make(map[nativetypes.FieldIndex]bool, fieldCountForVersion(b.version))
 
 	return nil
 }
@@ -653,7 +678,7 @@ func (b *BeaconState) rootSelector(ctx context.Context, field nativetypes.FieldI
 		return b.recomputeFieldTrie(field, b.eth1DataVotes)
 	case nativetypes.Validators:
 		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.validators, fieldparams.ValidatorRegistryLimit)
+			err := b.resetValidatorsTrie()
 			if err != nil {
 				return [32]byte{}, err
 			}
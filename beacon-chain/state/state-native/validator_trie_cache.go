@@ -0,0 +1,198 @@
+package state_native
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/fieldtrie"
+	nativetypes "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native/types"
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+const (
+	// maxValidatorTrieSnapshots is the number of epochs for which a snapshot of the
+	// validators trie is kept.
+	maxValidatorTrieSnapshots = 2
+	// maxDirtyValidatorsRatio is the inverse of the fraction of the registry above which
+	// the validators trie is fully rebuilt instead of being derived from a snapshot.
+	maxDirtyValidatorsRatio = 2
+)
+
+var (
+	validatorTrieCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_trie_cache_hit",
+		Help: "The number of validators tries which were derived from a snapshot.",
+	})
+	validatorTrieCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_trie_cache_miss",
+		Help: "The number of validators tries which had to be fully rebuilt.",
+	})
+)
+
+// validatorTries holds the snapshots of the validators trie shared by all beacon states.
+var validatorTries = &validatorTrieCache{}
+
+// validatorLeaf is a copy of the hashed fields of a validator, used to find out which
+// validators changed since a snapshot without hashing them.
+type validatorLeaf struct {
+	publicKey                  [fieldparams.BLSPubkeyLength]byte
+	withdrawalCredentials      [32]byte
+	effectiveBalance           uint64
+	slashed                    bool
+	activationEligibilityEpoch types.Epoch
+	activationEpoch            types.Epoch
+	exitEpoch                  types.Epoch
+	withdrawableEpoch          types.Epoch
+}
+
+func newValidatorLeaf(v *ethpb.Validator) validatorLeaf {
+	leaf := validatorLeaf{
+		effectiveBalance:           v.EffectiveBalance,
+		slashed:                    v.Slashed,
+		activationEligibilityEpoch: v.ActivationEligibilityEpoch,
+		activationEpoch:            v.ActivationEpoch,
+		exitEpoch:                  v.ExitEpoch,
+		withdrawableEpoch:          v.WithdrawableEpoch,
+	}
+	copy(leaf.publicKey[:], v.PublicKey)
+	copy(leaf.withdrawalCredentials[:], v.WithdrawalCredentials)
+	return leaf
+}
+
+// validatorTrieSnapshot is the validators trie of a state at an epoch, along with the
+// validators the trie was computed from. Snapshots are never modified once created.
+type validatorTrieSnapshot struct {
+	epoch  types.Epoch
+	trie   *fieldtrie.FieldTrie
+	leaves []validatorLeaf
+}
+
+// dirtyIndices returns the sorted indices of the validators which differ from the
+// snapshot, including the ones which were appended since.
+func (s *validatorTrieSnapshot) dirtyIndices(validators []*ethpb.Validator) []uint64 {
+	var dirty []uint64
+	for i, v := range validators {
+		if v == nil || i >= len(s.leaves) || newValidatorLeaf(v) != s.leaves[i] {
+			dirty = append(dirty, uint64(i))
+		}
+	}
+	return dirty
+}
+
+// validatorTrieCache keeps per-epoch snapshots of the validators trie, so that the trie
+// of a state whose registry was mostly rewritten, as it is on epoch transitions, can be
+// derived from a previous one by only rehashing the validators which changed.
+type validatorTrieCache struct {
+	lock      sync.RWMutex
+	snapshots []*validatorTrieSnapshot
+}
+
+// trieFor returns a validators trie for the given validators, derived from the latest
+// snapshot taken no later than the epoch. It returns nil when there is no such snapshot
+// or when too many validators changed since it for the snapshot to be worth using.
+func (c *validatorTrieCache) trieFor(epoch types.Epoch, validators []*ethpb.Validator) (*fieldtrie.FieldTrie, error) {
+	c.lock.RLock()
+	var snapshot *validatorTrieSnapshot
+	for _, s := range c.snapshots {
+		if s.epoch <= epoch && len(s.leaves) <= len(validators) {
+			snapshot = s
+		}
+	}
+	c.lock.RUnlock()
+	if snapshot == nil {
+		validatorTrieCacheMiss.Inc()
+		return nil, nil
+	}
+	dirty := snapshot.dirtyIndices(validators)
+	if len(dirty) > len(validators)/maxDirtyValidatorsRatio {
+		validatorTrieCacheMiss.Inc()
+		return nil, nil
+	}
+	fTrie := snapshot.trie.CopyTrie()
+	if _, err := fTrie.RecomputeTrie(dirty, validators); err != nil {
+		return nil, err
+	}
+	validatorTrieCacheHit.Inc()
+	return fTrie, nil
+}
+
+// save takes a snapshot of the validators trie at the epoch, unless there already is
+// one, and evicts the oldest snapshots.
+func (c *validatorTrieCache) save(epoch types.Epoch, fTrie *fieldtrie.FieldTrie, validators []*ethpb.Validator) {
+	if c.has(epoch) {
+		return
+	}
+	leaves := make([]validatorLeaf, len(validators))
+	for i, v := range validators {
+		if v != nil {
+			leaves[i] = newValidatorLeaf(v)
+		}
+	}
+	fTrie.RLock()
+	snapshot := &validatorTrieSnapshot{epoch: epoch, trie: fTrie.CopyTrie(), leaves: leaves}
+	fTrie.RUnlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, s := range c.snapshots {
+		if s.epoch == epoch {
+			return
+		}
+	}
+	c.snapshots = append(c.snapshots, snapshot)
+	sort.Slice(c.snapshots, func(i, j int) bool {
+		return c.snapshots[i].epoch < c.snapshots[j].epoch
+	})
+	if len(c.snapshots) > maxValidatorTrieSnapshots {
+		c.snapshots = c.snapshots[len(c.snapshots)-maxValidatorTrieSnapshots:]
+	}
+}
+
+func (c *validatorTrieCache) has(epoch types.Epoch) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, s := range c.snapshots {
+		if s.epoch == epoch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *validatorTrieCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.snapshots = nil
+}
+
+// resetValidatorsTrie rebuilds the validators trie of the state. When the validator trie
+// cache is enabled, the trie is derived from a snapshot whenever possible, and a snapshot
+// of it is taken if there is none yet for the epoch of the state.
+//
+// WARNING: Caller must acquire the mutex before using.
+func (b *BeaconState) resetValidatorsTrie() error {
+	if !features.Get().EnableValidatorTrieCache || len(b.validators) == 0 {
+		return b.resetFieldTrie(nativetypes.Validators, b.validators, fieldparams.ValidatorRegistryLimit)
+	}
+	epoch := slots.ToEpoch(b.slot)
+	fTrie, err := validatorTries.trieFor(epoch, b.validators)
+	if err != nil {
+		return err
+	}
+	if fTrie == nil {
+		if err := b.resetFieldTrie(nativetypes.Validators, b.validators, fieldparams.ValidatorRegistryLimit); err != nil {
+			return err
+		}
+	} else {
+		b.stateFieldLeaves[nativetypes.Validators] = fTrie
+		b.dirtyIndices[nativetypes.Validators] = []uint64{}
+	}
+	validatorTries.save(epoch, b.stateFieldLeaves[nativetypes.Validators], b.validators)
+	return nil
+}
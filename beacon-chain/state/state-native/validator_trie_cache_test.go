@@ -0,0 +1,162 @@
+package state_native
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	nativetypes "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestValidatorTrieCache_MatchesFullRebuild(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableValidatorTrieCache: true})
+	defer resetCfg()
+	validatorTries.clear()
+	defer validatorTries.clear()
+
+	vals := testValidators(100)
+	st := stateWithValidators(t, 0, vals)
+	assertValidatorsRoot(t, st)
+	require.Equal(t, true, validatorTries.has(0))
+
+	// The next epoch modifies a few validators and appends new ones.
+	updated := make([]*ethpb.Validator, 0, 110)
+	for i, v := range vals {
+		if i%10 == 0 {
+			v = ethpb.CopyValidator(v)
+			v.EffectiveBalance -= params.BeaconConfig().EffectiveBalanceIncrement
+			v.ExitEpoch = 5
+		}
+		updated = append(updated, v)
+	}
+	updated = append(updated, testValidators(10)...)
+	next := st.Copy().(*BeaconState)
+	require.NoError(t, next.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, next.SetValidators(updated))
+	assertValidatorsRoot(t, next)
+	require.Equal(t, true, validatorTries.has(1))
+
+	// Later updates of the state do not alter the snapshots.
+	require.NoError(t, next.UpdateValidatorAtIndex(3, &ethpb.Validator{
+		PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
+		WithdrawalCredentials: make([]byte, 32),
+	}))
+	assertValidatorsRoot(t, next)
+	later := stateWithValidators(t, 2*params.BeaconConfig().SlotsPerEpoch, updated)
+	assertValidatorsRoot(t, later)
+
+	// States whose registry mostly changed are fully rebuilt.
+	changed := make([]*ethpb.Validator, len(updated))
+	for i, v := range updated {
+		changed[i] = ethpb.CopyValidator(v)
+		changed[i].Slashed = true
+	}
+	fTrie, err := validatorTries.trieFor(2, changed)
+	require.NoError(t, err)
+	assert.Equal(t, true, fTrie == nil)
+	assertValidatorsRoot(t, stateWithValidators(t, 2*params.BeaconConfig().SlotsPerEpoch, changed))
+
+	// States older than all the snapshots are fully rebuilt.
+	fTrie, err = validatorTries.trieFor(0, updated)
+	require.NoError(t, err)
+	assert.Equal(t, true, fTrie == nil)
+}
+
+func TestValidatorTrieCache_EvictsOldestSnapshots(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableValidatorTrieCache: true})
+	defer resetCfg()
+	validatorTries.clear()
+	defer validatorTries.clear()
+
+	vals := testValidators(10)
+	for _, epoch := range []types.Epoch{3, 1, 2} {
+		st := stateWithValidators(t, params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch)), vals)
+		assertValidatorsRoot(t, st)
+	}
+	assert.Equal(t, false, validatorTries.has(1))
+	assert.Equal(t, true, validatorTries.has(2))
+	assert.Equal(t, true, validatorTries.has(3))
+}
+
+func assertValidatorsRoot(t *testing.T, st *BeaconState) {
+	_, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	wanted, err := stateutil.ValidatorRegistryRoot(st.Validators())
+	require.NoError(t, err)
+	assert.Equal(t, wanted, bytesutil.ToBytes32(st.merkleLayers[0][nativetypes.Validators]))
+}
+
+func testValidators(count int) []*ethpb.Validator {
+	vals := make([]*ethpb.Validator, count)
+	for i := range vals {
+		key := make([]byte, fieldparams.BLSPubkeyLength)
+		key[0], key[1] = byte(i), byte(count)
+		vals[i] = &ethpb.Validator{
+			PublicKey:                  key,
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+			ActivationEligibilityEpoch: 1,
+			ActivationEpoch:            1,
+			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	return vals
+}
+
+// stateWithValidators returns a state whose validators trie is built by its next hash tree root, as the
+// first root of a state is computed without its field tries.
+func stateWithValidators(t *testing.T, slot types.Slot, vals []*ethpb.Validator) *BeaconState {
+	zeroHash := params.BeaconConfig().ZeroHash
+	roots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range roots {
+		roots[i] = zeroHash[:]
+	}
+	mixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := range mixes {
+		mixes[i] = zeroHash[:]
+	}
+	st, err := InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Slot:                  slot,
+		GenesisValidatorsRoot: make([]byte, 32),
+		Fork: &ethpb.Fork{
+			PreviousVersion: make([]byte, 4),
+			CurrentVersion:  make([]byte, 4),
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		},
+		Validators: vals,
+		Balances:   make([]uint64, len(vals)),
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		BlockRoots:                  roots,
+		StateRoots:                  roots,
+		RandaoMixes:                 mixes,
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+		Slashings:                   make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+	})
+	require.NoError(t, err)
+	native, ok := st.(*BeaconState)
+	require.Equal(t, true, ok)
+	_, err = native.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	native.markFieldAsDirty(nativetypes.Validators)
+	return native
+}
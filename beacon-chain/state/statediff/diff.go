@@ -0,0 +1,212 @@
+// Package statediff compares two beacon states field by field, to find out why their state roots diverge.
+// Differences are reported down to the elements of lists and vectors and to the fields of the containers they
+// hold, such as the validators, along with the generalized index of the mismatching node of the state tree.
+package statediff
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"google.golang.org/protobuf/proto"
+)
+
+// bytesPerChunk is the size of the chunks of the SSZ merkleization.
+const bytesPerChunk = 32
+
+// Difference is a field of the beacon state whose value differs between the compared states.
+type Difference struct {
+	// Path of the field, such as validators[12].effective_balance.
+	Path string `json:"path"`
+	// GeneralizedIndex of the node of the state tree which differs. Elements of lists of basic types, such as
+	// balances, are packed several to a chunk, so the index is the one of the chunk holding the element.
+	// The length of a list is mixed into its root at the index following its data root.
+	GeneralizedIndex uint64 `json:"generalized_index"`
+	A                string `json:"a"`
+	B                string `json:"b"`
+}
+
+// Diff returns the differences between the fields of the two beacon states, in the order of the fields of
+// the states. States of different forks cannot be compared.
+func Diff(a, b state.ReadOnlyBeaconState) ([]*Difference, error) {
+	if a == nil || a.IsNil() || b == nil || b.IsNil() {
+		return nil, errors.New("nil beacon state")
+	}
+	if a.Version() != b.Version() {
+		return nil, fmt.Errorf("cannot compare a %s state with a %s state", version.String(a.Version()), version.String(b.Version()))
+	}
+	d := &differ{}
+	d.value("", 1, reflect.ValueOf(a.InnerStateUnsafe()), reflect.ValueOf(b.InnerStateUnsafe()), "")
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.diffs, nil
+}
+
+type differ struct {
+	diffs []*Difference
+	err   error
+}
+
+func (d *differ) add(path string, gindex uint64, a, b string) {
+	d.diffs = append(d.diffs, &Difference{Path: path, GeneralizedIndex: gindex, A: a, B: b})
+}
+
+// value compares the values of a field, of the given tag, at the generalized index.
+func (d *differ) value(path string, gindex uint64, a, b reflect.Value, tag reflect.StructTag) {
+	if d.err != nil {
+		return
+	}
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(path, gindex, format(a), format(b))
+			}
+			return
+		}
+		am, aok := a.Interface().(proto.Message)
+		bm, bok := b.Interface().(proto.Message)
+		if aok && bok && proto.Equal(am, bm) {
+			return
+		}
+		d.container(path, gindex, a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Type().Elem().Kind() == reflect.Uint8 && !isByteList(tag) {
+			if !bytes.Equal(a.Bytes(), b.Bytes()) {
+				d.add(path, gindex, format(a), format(b))
+			}
+			return
+		}
+		d.sequence(path, gindex, a, b, tag)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if a.Uint() != b.Uint() {
+			d.add(path, gindex, format(a), format(b))
+		}
+	case reflect.Bool:
+		if a.Bool() != b.Bool() {
+			d.add(path, gindex, format(a), format(b))
+		}
+	default:
+		d.err = fmt.Errorf("unsupported type %s of field %s", a.Type(), path)
+	}
+}
+
+// container compares the fields of two SSZ containers.
+func (d *differ) container(path string, gindex uint64, a, b reflect.Value) {
+	if a.Kind() != reflect.Struct {
+		d.err = fmt.Errorf("unsupported type %s of field %s", a.Type(), path)
+		return
+	}
+	var fields []reflect.StructField
+	for i := 0; i < a.NumField(); i++ {
+		f := a.Type().Field(i)
+		if f.IsExported() && f.Tag.Get("protobuf") != "" {
+			fields = append(fields, f)
+		}
+	}
+	leaves := nextPowerOf2(uint64(len(fields)))
+	for i, f := range fields {
+		d.value(joinPath(path, fieldName(f)), gindex*leaves+uint64(i), a.FieldByIndex(f.Index), b.FieldByIndex(f.Index), f.Tag)
+	}
+}
+
+// sequence compares the elements of two SSZ lists or vectors.
+func (d *differ) sequence(path string, gindex uint64, a, b reflect.Value, tag reflect.StructTag) {
+	limit, isList, err := sszLimit(tag)
+	if err != nil {
+		d.err = errors.Wrapf(err, "could not read size of field %s", path)
+		return
+	}
+	perChunk := uint64(1)
+	switch a.Type().Elem().Kind() {
+	case reflect.Uint8:
+		perChunk = bytesPerChunk
+	case reflect.Uint64:
+		perChunk = bytesPerChunk / 8
+	}
+	base := gindex
+	if isList {
+		// The data root of a list is mixed in with its length.
+		base = 2 * gindex
+	}
+	if a.Len() != b.Len() {
+		lengthIndex := gindex
+		if isList {
+			lengthIndex = base + 1
+		}
+		d.add(path+".length", lengthIndex, strconv.Itoa(a.Len()), strconv.Itoa(b.Len()))
+	}
+	leaves := nextPowerOf2((limit + perChunk - 1) / perChunk)
+	n := a.Len()
+	if b.Len() < n {
+		n = b.Len()
+	}
+	for i := 0; i < n; i++ {
+		d.value(fmt.Sprintf("%s[%d]", path, i), base*leaves+uint64(i)/perChunk, a.Index(i), b.Index(i), "")
+	}
+}
+
+// sszLimit returns the maximum length of a list, or the length of a vector, from the SSZ tags of a field.
+func sszLimit(tag reflect.StructTag) (uint64, bool, error) {
+	if max := tag.Get("ssz-max"); max != "" {
+		limit, err := strconv.ParseUint(strings.Split(max, ",")[0], 10, 64)
+		return limit, true, err
+	}
+	if size := tag.Get("ssz-size"); size != "" {
+		limit, err := strconv.ParseUint(strings.Split(size, ",")[0], 10, 64)
+		return limit, false, err
+	}
+	return 0, false, errors.New("no ssz size")
+}
+
+// isByteList returns true if the byte slice field is a list of uint8 values, such as the participation flags,
+// rather than a fixed size byte vector or a bitfield.
+func isByteList(tag reflect.StructTag) bool {
+	return tag.Get("ssz-max") != "" && tag.Get("cast-type") == ""
+}
+
+func fieldName(f reflect.StructField) string {
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return strings.TrimPrefix(opt, "name=")
+		}
+	}
+	return f.Name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func format(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "nil"
+		}
+		return v.Type().Elem().Name()
+	case reflect.Slice:
+		return fmt.Sprintf("%#x", v.Bytes())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+func nextPowerOf2(n uint64) uint64 {
+	p := uint64(1)
+	for p < n {
+		p *= 2
+	}
+	return p
+}
@@ -0,0 +1,90 @@
+package statediff
+
+import (
+	"testing"
+
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestDiff(t *testing.T) {
+	a, _ := util.DeterministicGenesisStateAltair(t, 64)
+	b := a.Copy()
+
+	diffs, err := Diff(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diffs))
+
+	require.NoError(t, b.SetSlot(3))
+	require.NoError(t, b.UpdateBlockRootAtIndex(2, [32]byte{'a'}))
+	require.NoError(t, b.UpdateBalancesAtIndex(5, 1))
+	val, err := b.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.EffectiveBalance = 2
+	require.NoError(t, b.UpdateValidatorAtIndex(3, val))
+	require.NoError(t, b.SetFinalizedCheckpoint(&ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'b'}, 32)}))
+	participation, err := b.CurrentEpochParticipation()
+	require.NoError(t, err)
+	participation[40] = 7
+	require.NoError(t, b.SetCurrentParticipationBits(participation))
+
+	diffs, err = Diff(a, b)
+	require.NoError(t, err)
+	// An Altair state has 24 fields, which are the leaves of a tree of depth 5.
+	const (
+		slotIndex          = 32 + 2
+		blockRootsIndex    = 32 + 5
+		validatorsIndex    = 32 + 11
+		balancesIndex      = 32 + 12
+		participationIndex = 32 + 16
+	)
+	registryLimit := uint64(1099511627776)
+	want := []*Difference{
+		{Path: "slot", GeneralizedIndex: slotIndex, A: "0", B: "3"},
+		{
+			Path:             "block_roots[2]",
+			GeneralizedIndex: blockRootsIndex*8192 + 2,
+			A:                "0x0000000000000000000000000000000000000000000000000000000000000000",
+			B:                "0x6100000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			Path:             "validators[3].effective_balance",
+			GeneralizedIndex: (2*validatorsIndex*registryLimit+3)*8 + 2,
+			A:                "32000000000",
+			B:                "2",
+		},
+		// Balances are packed four to a chunk.
+		{Path: "balances[5]", GeneralizedIndex: 2*balancesIndex*registryLimit/4 + 1, A: "32000000000", B: "1"},
+		{Path: "current_epoch_participation[40]", GeneralizedIndex: 2*participationIndex*registryLimit/32 + 1, A: "0", B: "7"},
+		{
+			Path:             "finalized_checkpoint.root",
+			GeneralizedIndex: statenative.FinalizedRootGeneralizedIndex(),
+			A:                "0x0000000000000000000000000000000000000000000000000000000000000000",
+			B:                "0x6200000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+	assert.DeepEqual(t, want, diffs)
+}
+
+func TestDiff_ListLength(t *testing.T) {
+	a, _ := util.DeterministicGenesisState(t, 8)
+	b := a.Copy()
+	require.NoError(t, b.AppendBalance(5))
+
+	diffs, err := Diff(a, b)
+	require.NoError(t, err)
+	// A Phase 0 state has 21 fields, balances are the 13th.
+	balancesIndex := uint64(32 + 12)
+	assert.DeepEqual(t, []*Difference{{Path: "balances.length", GeneralizedIndex: 2*balancesIndex + 1, A: "8", B: "9"}}, diffs)
+}
+
+func TestDiff_DifferentForks(t *testing.T) {
+	a, _ := util.DeterministicGenesisState(t, 8)
+	b, _ := util.DeterministicGenesisStateAltair(t, 8)
+	_, err := Diff(a, b)
+	require.ErrorContains(t, "cannot compare a phase0 state with a altair state", err)
+}
@@ -105,6 +105,25 @@ func (e *epochBoundaryState) getByBlockRootLockFree(r [32]byte) (*rootStateInfo,
 	}, true, nil
 }
 
+// getByBlockRootWithoutCopy returns the epoch boundary state of the block root as is, without copying it.
+func (e *epochBoundaryState) getByBlockRootWithoutCopy(r [32]byte) (*rootStateInfo, bool, error) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	obj, exists, err := e.rootStateCache.GetByKey(string(r[:]))
+	if err != nil {
+		return nil, false, err
+	}
+	if !exists {
+		return nil, false, nil
+	}
+	s, ok := obj.(*rootStateInfo)
+	if !ok {
+		return nil, false, errNotRootStateInfo
+	}
+	return s, true, nil
+}
+
 // get epoch boundary state by its slot. Returns copied state in state info object if exists. Otherwise returns nil.
 func (e *epochBoundaryState) getBySlot(s types.Slot) (*rootStateInfo, bool, error) {
 	e.lock.RLock()
@@ -32,6 +32,9 @@ func (s *State) HasStateInCache(_ context.Context, blockRoot [32]byte) (bool, er
 	if s.hotStateCache.has(blockRoot) {
 		return true, nil
 	}
+	if s.hotStateSnapshots.has(blockRoot) {
+		return true, nil
+	}
 	_, has, err := s.epochBoundaryStateCache.getByBlockRoot(blockRoot)
 	if err != nil {
 		return false, err
@@ -149,6 +152,7 @@ func (s *State) RecoverStateSummary(ctx context.Context, blockRoot [32]byte) (*e
 // DeleteStateFromCaches deletes the state from the caches.
 func (s *State) DeleteStateFromCaches(_ context.Context, blockRoot [32]byte) error {
 	s.hotStateCache.delete(blockRoot)
+	s.hotStateSnapshots.delete(blockRoot)
 	return s.epochBoundaryStateCache.delete(blockRoot)
 }
 
@@ -172,6 +176,11 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 		return cachedInfo.state, nil
 	}
 
+	// Third, it checks if the state was snapshotted.
+	if snapshot := s.hotStateSnapshots.get(blockRoot); snapshot != nil && !snapshot.IsNil() {
+		return snapshot, nil
+	}
+
 	// Short circuit if the state is already in the DB.
 	if s.beaconDB.HasState(ctx, blockRoot) {
 		return s.beaconDB.State(ctx, blockRoot)
@@ -214,7 +223,8 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 // There's three ways to derive block parent state:
 // 1) block parent state is the last finalized state
 // 2) block parent state is the epoch boundary state and exists in epoch boundary cache
-// 3) block parent state is in DB
+// 3) block parent state is a hot state snapshot
+// 4) block parent state is in DB
 func (s *State) LastAncestorState(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.LastAncestorState")
 	defer span.End()
@@ -266,6 +276,11 @@ func (s *State) LastAncestorState(ctx context.Context, blockRoot [32]byte) (stat
 			return cachedInfo.state, nil
 		}
 
+		// Does the state exist in the hot state snapshots.
+		if snapshot := s.hotStateSnapshots.get(parentRoot); snapshot != nil && !snapshot.IsNil() {
+			return snapshot, nil
+		}
+
 		// Does the state exists in DB.
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
@@ -289,6 +304,9 @@ func (s *State) CombinedCache() *CombinedCache {
 	if s.epochBoundaryStateCache != nil {
 		getters = append(getters, s.epochBoundaryStateCache)
 	}
+	if s.hotStateSnapshots != nil {
+		getters = append(getters, s.hotStateSnapshots)
+	}
 	return &CombinedCache{getters: getters}
 }
 
@@ -8,8 +8,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/config/params"
 )
 
+// Estimated number of bytes held by each validator of a state: its validator record, balance,
+// inactivity score and participation flags.
+const stateBytesPerValidator = 121 + 8 + 8 + 2
+
 var (
 	// hotStateCacheSize defines the max number of hot state this can cache.
 	hotStateCacheSize = 32
@@ -26,8 +31,9 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point.
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache     *lru.Cache
+	lock      sync.RWMutex
+	stateSize uint64
 }
 
 // newHotStateCache initializes the map and underlying cache.
@@ -78,6 +84,7 @@ func (c *hotStateCache) put(blockRoot [32]byte, state state.BeaconState) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	c.cache.Add(blockRoot, state)
+	c.stateSize = estimatedStateSize(state)
 }
 
 // has returns true if the key exists in the cache.
@@ -93,3 +100,32 @@ func (c *hotStateCache) delete(blockRoot [32]byte) bool {
 	defer c.lock.Unlock()
 	return c.cache.Remove(blockRoot)
 }
+
+// EstimatedMemory returns the estimated number of bytes held by the cached states, based on the
+// size of the last cached state.
+func (c *hotStateCache) EstimatedMemory() uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return uint64(c.cache.Len()) * c.stateSize
+}
+
+// Shrink evicts the least recently used states until the given number of bytes is released or
+// the cache is empty. Evicted states are regenerated from the DB when requested again.
+func (c *hotStateCache) Shrink(bytes uint64) uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var released uint64
+	for released < bytes && c.cache.Len() > 0 {
+		c.cache.RemoveOldest()
+		released += c.stateSize
+	}
+	return released
+}
+
+// estimatedStateSize estimates the number of bytes held by a state from its number of
+// validators and the lengths of its historical vectors.
+func estimatedStateSize(st state.ReadOnlyBeaconState) uint64 {
+	cfg := params.BeaconConfig()
+	vectors := 2*uint64(cfg.SlotsPerHistoricalRoot)*32 + uint64(cfg.EpochsPerHistoricalVector)*32 + uint64(cfg.EpochsPerSlashingsVector)*8
+	return vectors + uint64(st.NumValidators())*stateBytesPerValidator
+}
@@ -4,7 +4,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -17,7 +17,7 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	assert.Equal(t, state.BeaconState(nil), s)
 	assert.Equal(t, false, c.has(root), "Empty cache has an object")
 
-	s, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Slot: 10,
 	})
 	require.NoError(t, err)
@@ -32,3 +32,27 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_Shrink(t *testing.T) {
+	c := newHotStateCache()
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators: []*ethpb.Validator{{}, {}},
+	})
+	require.NoError(t, err)
+	for i := byte(0); i < 4; i++ {
+		c.put([32]byte{i}, st)
+	}
+	stateSize := estimatedStateSize(st)
+	assert.Equal(t, 4*stateSize, c.EstimatedMemory())
+
+	// The least recently used states are evicted first.
+	c.get([32]byte{0})
+	assert.Equal(t, 2*stateSize, c.Shrink(stateSize+1))
+	assert.Equal(t, true, c.has([32]byte{0}))
+	assert.Equal(t, false, c.has([32]byte{1}))
+	assert.Equal(t, false, c.has([32]byte{2}))
+	assert.Equal(t, true, c.has([32]byte{3}))
+
+	assert.Equal(t, 2*stateSize, c.Shrink(10*stateSize))
+	assert.Equal(t, uint64(0), c.EstimatedMemory())
+}
@@ -0,0 +1,126 @@
+package stategen
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+var (
+	// hotStateSnapshotCacheSize defines the max number of hot state snapshots this can cache. Each snapshot
+	// is a full state, so the bound is kept small.
+	hotStateSnapshotCacheSize = 8
+	// Metrics
+	hotStateSnapshotHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_snapshot_hit",
+		Help: "The total number of hits on the hot state snapshot cache.",
+	})
+	hotStateSnapshotMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_snapshot_miss",
+		Help: "The total number of misses on the hot state snapshot cache.",
+	})
+)
+
+// hotStateSnapshots keeps full copies of hot states taken at a fixed slot interval.
+// Unlike the hot state cache, which holds the most recently processed states, snapshots
+// are spread evenly across the unfinalized section of the chain so that regenerating
+// a recent hot state never has to replay more than one snapshot interval of blocks.
+// Snapshots are disabled unless an interval is configured, as every snapshot is a full state.
+type hotStateSnapshots struct {
+	cache    *lru.Cache
+	interval types.Slot
+	lock     sync.RWMutex
+}
+
+// newHotStateSnapshots initializes the snapshot cache with the given slot interval.
+func newHotStateSnapshots(interval types.Slot) *hotStateSnapshots {
+	return &hotStateSnapshots{
+		cache:    lruwrpr.New(hotStateSnapshotCacheSize),
+		interval: interval,
+	}
+}
+
+// shouldSnapshot returns true if a state at the input slot should be snapshotted.
+func (c *hotStateSnapshots) shouldSnapshot(slot types.Slot) bool {
+	if c.interval == 0 {
+		return false
+	}
+	return slot.Mod(uint64(c.interval)) == 0
+}
+
+// get returns a copy of the snapshot for the input block root, if any.
+func (c *hotStateSnapshots) get(blockRoot [32]byte) state.BeaconState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(blockRoot)
+	if exists && item != nil {
+		hotStateSnapshotHit.Inc()
+		return item.(state.BeaconState).Copy()
+	}
+	hotStateSnapshotMiss.Inc()
+	return nil
+}
+
+// getWithoutCopy returns the snapshot of the block root as is, without copying it.
+func (c *hotStateSnapshots) getWithoutCopy(blockRoot [32]byte) state.BeaconState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(blockRoot)
+	if exists && item != nil {
+		hotStateSnapshotHit.Inc()
+		return item.(state.BeaconState)
+	}
+	hotStateSnapshotMiss.Inc()
+	return nil
+}
+
+// ByBlockRoot satisfies the CachedGetter interface.
+func (c *hotStateSnapshots) ByBlockRoot(r [32]byte) (state.BeaconState, error) {
+	st := c.get(r)
+	if st == nil {
+		return nil, ErrNotInCache
+	}
+	return st, nil
+}
+
+// put stores a copy of the input state in the snapshot cache.
+func (c *hotStateSnapshots) put(blockRoot [32]byte, st state.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(blockRoot, st.Copy())
+}
+
+// has returns true if a snapshot exists for the input block root.
+func (c *hotStateSnapshots) has(blockRoot [32]byte) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.cache.Contains(blockRoot)
+}
+
+// delete removes the snapshot of the input block root.
+func (c *hotStateSnapshots) delete(blockRoot [32]byte) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.cache.Remove(blockRoot)
+}
+
+// prune removes every snapshot whose slot is below the input finalized slot,
+// as those states are no longer part of the hot section.
+func (c *hotStateSnapshots) prune(finalizedSlot types.Slot) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, k := range c.cache.Keys() {
+		item, ok := c.cache.Peek(k)
+		if !ok || item == nil {
+			continue
+		}
+		if item.(state.BeaconState).Slot() < finalizedSlot {
+			c.cache.Remove(k)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestHotStateSnapshots_RoundTrip(t *testing.T) {
+	c := newHotStateSnapshots(8)
+	root := [32]byte{'A'}
+	assert.Equal(t, state.BeaconState(nil), c.get(root))
+	assert.Equal(t, false, c.has(root), "Empty cache has an object")
+
+	s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Slot: 8,
+	})
+	require.NoError(t, err)
+
+	c.put(root, s)
+	assert.Equal(t, true, c.has(root), "Cache does not have an object")
+
+	res, err := c.ByBlockRoot(root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, res.CloneInnerState(), s.CloneInnerState(), "Expected equal protos to return from cache")
+
+	c.delete(root)
+	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
+	_, err = c.ByBlockRoot(root)
+	require.ErrorIs(t, err, ErrNotInCache)
+}
+
+func TestHotStateSnapshots_ShouldSnapshot(t *testing.T) {
+	c := newHotStateSnapshots(8)
+	assert.Equal(t, true, c.shouldSnapshot(0))
+	assert.Equal(t, false, c.shouldSnapshot(7))
+	assert.Equal(t, true, c.shouldSnapshot(16))
+
+	c = newHotStateSnapshots(0)
+	assert.Equal(t, false, c.shouldSnapshot(16), "Disabled snapshots should never snapshot")
+}
+
+func TestHotStateSnapshots_Prune(t *testing.T) {
+	c := newHotStateSnapshots(8)
+	for i, slot := range []uint64{8, 16, 24} {
+		s, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{Slot: types.Slot(8 * (i + 1))})
+		require.NoError(t, err)
+		require.Equal(t, slot, uint64(s.Slot()))
+		c.put([32]byte{byte(i)}, s)
+	}
+
+	c.prune(16)
+	assert.Equal(t, false, c.has([32]byte{0}), "Snapshot below finalized slot not pruned")
+	assert.Equal(t, true, c.has([32]byte{1}))
+	assert.Equal(t, true, c.has([32]byte{2}))
+}
+
+func TestSaveState_SnapshotSlotSaved(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, WithHotStateSnapshotInterval(8))
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(8))
+
+	r := [32]byte{'a'}
+	require.NoError(t, service.SaveState(ctx, r, beaconState))
+	assert.Equal(t, true, service.hotStateSnapshots.has(r), "Should have snapshotted the state")
+
+	// Evicting the state from the hot state cache should still allow a lookup through the snapshot.
+	service.hotStateCache.delete(r)
+	has, err := service.HasStateInCache(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, true, has)
+	loaded, err := service.StateByRoot(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, beaconState.Slot(), loaded.Slot())
+
+	require.NoError(t, service.DeleteStateFromCaches(ctx, r))
+	assert.Equal(t, false, service.hotStateSnapshots.has(r), "Should have deleted the snapshot")
+}
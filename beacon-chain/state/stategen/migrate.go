@@ -5,7 +5,12 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -38,7 +43,8 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			return ctx.Err()
 		}
 
-		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
+		// Archived states are not kept when only the latest finalized state is retained.
+		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 && s.stateRetention != db.MinimalStateRetention {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
 				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
@@ -112,5 +118,95 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		s.SaveFinalizedState(fSlot, fRoot, fInfo.state)
 	}
 
+	// Snapshots below the finalized slot are no longer hot.
+	s.hotStateSnapshots.prune(fSlot)
+
+	// Prune the states of the newly finalized section which are not kept by the retention mode.
+	if s.pruneStates {
+		pruned, err := s.beaconDB.PruneStates(ctx, s.stateRetention, s.slotsPerArchivedPoint, oldFSlot)
+		if err != nil {
+			return errors.Wrap(err, "could not prune finalized states")
+		}
+		if pruned > 0 {
+			log.WithFields(logrus.Fields{
+				"count":     pruned,
+				"retention": s.stateRetention,
+			}).Debug("Pruned finalized states from DB")
+		}
+	}
+
+	s.freezeFinalizedErasInBackground(fSlot)
+
 	return nil
 }
+
+// freezeFinalizedErasInBackground moves the eras ending before the finalized slot to the freezer in the
+// background, as each era requires replaying the state at its end. Only one run is in progress at a time,
+// eras which are not frozen by that run are picked up at a later finalization.
+func (s *State) freezeFinalizedErasInBackground(fSlot types.Slot) {
+	if !s.beaconDB.FreezerEnabled() {
+		return
+	}
+	s.freezeLock.Lock()
+	defer s.freezeLock.Unlock()
+	if s.freezing {
+		return
+	}
+	s.freezing = true
+	go func() {
+		defer func() {
+			s.freezeLock.Lock()
+			s.freezing = false
+			s.freezeLock.Unlock()
+		}()
+		// Freezing eras is not critical to the migration, it is retried at the next finalization.
+		if err := s.freezeFinalizedEras(context.Background(), fSlot); err != nil {
+			log.WithError(err).Warn("Could not move finalized era to the freezer")
+		}
+	}()
+}
+
+// freezeFinalizedEras moves the eras ending before the finalized slot to the freezer, using the state at
+// the last slot of each era.
+func (s *State) freezeFinalizedEras(ctx context.Context, fSlot types.Slot) error {
+	era, err := s.beaconDB.NextEraToFreeze(ctx)
+	if err != nil {
+		return err
+	}
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	for ; types.Slot(era)*sphr < fSlot; era++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		st, err := s.EraState(ctx, era)
+		if err != nil {
+			return errors.Wrapf(err, "could not get state at the end of era %d", era)
+		}
+		if err := s.beaconDB.FreezeEra(ctx, st); err != nil {
+			return errors.Wrapf(err, "could not freeze era %d", era)
+		}
+		log.WithField("era", era).Info("Moved finalized era to the freezer")
+	}
+	return nil
+}
+
+// EraState returns the state at the end of the given era, at the era boundary slot before the block at that
+// slot is applied. This is the state stored with the era in an era file.
+func (s *State) EraState(ctx context.Context, era uint64) (state.BeaconState, error) {
+	slot := types.Slot(era) * params.BeaconConfig().SlotsPerHistoricalRoot
+	if slot == 0 {
+		return s.beaconDB.GenesisState(ctx)
+	}
+	_, roots, err := s.beaconDB.HighestRootsBelowSlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, errUnknownBlock
+	}
+	st, err := s.StateByRoot(ctx, roots[0])
+	if err != nil {
+		return nil, err
+	}
+	return transition.ProcessSlots(ctx, st.Copy(), slot)
+}
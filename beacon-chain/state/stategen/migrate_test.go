@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -134,3 +136,57 @@ func TestMigrateToCold_StateExistsInDB(t *testing.T) {
 	assert.DeepEqual(t, [][32]byte{{1}, {2}, {3}, {4}}, service.saveHotStateDB.blockRootsOfSavedStates)
 	assert.LogsDoNotContain(t, hook, "Saved state in DB")
 }
+
+func TestMigrateToCold_PrunesStatesOnlyWithStateRetention(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		opts   []StateGenOption
+		pruned bool
+	}{
+		{
+			name:   "state retention not set",
+			pruned: false,
+		},
+		{
+			name:   "default state retention",
+			opts:   []StateGenOption{WithStateRetention(db.DefaultStateRetention)},
+			pruned: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beaconDB := testDB.SetupDB(t)
+			service := New(beaconDB, tt.opts...)
+			genesis := util.NewBeaconBlock()
+			gRoot, err := genesis.Block.HashTreeRoot()
+			require.NoError(t, err)
+			util.SaveBlock(t, ctx, beaconDB, genesis)
+			require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+			// A finalized state which is neither on an epoch boundary nor on an archive point.
+			beaconState, _ := util.DeterministicGenesisState(t, 32)
+			require.NoError(t, beaconState.SetSlot(1))
+			b := util.NewBeaconBlock()
+			b.Block.Slot = 1
+			b.Block.ParentRoot = gRoot[:]
+			r, err := b.Block.HashTreeRoot()
+			require.NoError(t, err)
+			util.SaveBlock(t, ctx, beaconDB, b)
+			require.NoError(t, beaconDB.SaveState(ctx, beaconState, r))
+
+			fSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+			fBlock := util.NewBeaconBlock()
+			fBlock.Block.Slot = fSlot
+			fBlock.Block.ParentRoot = r[:]
+			fRoot, err := fBlock.Block.HashTreeRoot()
+			require.NoError(t, err)
+			util.SaveBlock(t, ctx, beaconDB, fBlock)
+			require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: fSlot, Root: fRoot[:]}))
+			require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: fRoot[:]}))
+
+			require.NoError(t, service.MigrateToCold(ctx, fRoot))
+			assert.Equal(t, !tt.pruned, beaconDB.HasState(ctx, r))
+		})
+	}
+}
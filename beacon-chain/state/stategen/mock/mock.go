@@ -28,6 +28,11 @@ func (_ *MockStateManager) StateByRootIfCachedNoCopy(_ [32]byte) state.BeaconSta
 	panic("implement me")
 }
 
+// ReadOnlyStateByRoot --
+func (m *MockStateManager) ReadOnlyStateByRoot(ctx context.Context, blockRoot [32]byte) (state.ReadOnlyBeaconState, error) {
+	return m.StateByRoot(ctx, blockRoot)
+}
+
 // Resume --
 func (_ *MockStateManager) Resume(_ context.Context, _ state.BeaconState) (state.BeaconState, error) {
 	panic("implement me")
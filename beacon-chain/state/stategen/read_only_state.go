@@ -0,0 +1,310 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// ErrReadOnlyState is the panic value of the writes to a state returned by ReadOnlyStateByRoot.
+var ErrReadOnlyState = errors.New("cannot write to a read-only beacon state")
+
+// ReadOnlyStateByRoot retrieves the state of the input block root like StateByRoot, but without copying
+// it when it is cached. The returned state is shared with the caches: it panics when written to, and
+// WritableCopy has to be used to get a copy of it which can be modified.
+func (s *State) ReadOnlyStateByRoot(ctx context.Context, blockRoot [32]byte) (state.ReadOnlyBeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.ReadOnlyStateByRoot")
+	defer span.End()
+
+	var st state.BeaconState
+	var err error
+	if blockRoot == params.BeaconConfig().ZeroHash {
+		st, err = s.beaconDB.GenesisState(ctx)
+	} else {
+		st, err = s.loadStateByRootWithoutCopy(ctx, blockRoot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || st.IsNil() {
+		return nil, errUnknownState
+	}
+	return &readOnlyState{ReadOnlyBeaconState: st, st: st}, nil
+}
+
+// WritableCopy returns a copy of the state which can be modified, the state being either a state returned
+// by ReadOnlyStateByRoot or a regular beacon state.
+func WritableCopy(st state.ReadOnlyBeaconState) (state.BeaconState, error) {
+	switch s := st.(type) {
+	case *readOnlyState:
+		return s.st.Copy(), nil
+	case state.BeaconState:
+		return s.Copy(), nil
+	default:
+		return nil, errors.Errorf("cannot copy beacon state of type %T", st)
+	}
+}
+
+// loadStateByRootWithoutCopy returns the cached state of the block root as is, and falls back to
+// loadStateByRoot for states which are not cached.
+func (s *State) loadStateByRootWithoutCopy(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	if st := s.hotStateCache.getWithoutCopy(blockRoot); st != nil && !st.IsNil() {
+		return st, nil
+	}
+	cachedInfo, ok, err := s.epochBoundaryStateCache.getByBlockRootWithoutCopy(blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return cachedInfo.state, nil
+	}
+	if snapshot := s.hotStateSnapshots.getWithoutCopy(blockRoot); snapshot != nil && !snapshot.IsNil() {
+		return snapshot, nil
+	}
+	return s.loadStateByRoot(ctx, blockRoot)
+}
+
+// readOnlyState shares a beacon state for reads. It implements the whole of state.BeaconState so that
+// asserting it back to a writable state does not allow to bypass its guards: its writes panic with
+// ErrReadOnlyState, and its copies are regular writable states.
+type readOnlyState struct {
+	state.ReadOnlyBeaconState
+	st state.BeaconState
+}
+
+var _ state.BeaconState = (*readOnlyState)(nil)
+
+func (r *readOnlyState) Copy() state.BeaconState {
+	return r.st.Copy()
+}
+
+func (r *readOnlyState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
+	return r.st.HashTreeRoot(ctx)
+}
+
+func (r *readOnlyState) InactivityPenaltyQuotient() (uint64, error) {
+	return r.st.InactivityPenaltyQuotient()
+}
+
+func (r *readOnlyState) ProportionalSlashingMultiplier() (uint64, error) {
+	return r.st.ProportionalSlashingMultiplier()
+}
+
+func (r *readOnlyState) FinalizedRootProof(ctx context.Context) ([][]byte, error) {
+	return r.st.FinalizedRootProof(ctx)
+}
+
+func (r *readOnlyState) CurrentSyncCommitteeProof(ctx context.Context) ([][]byte, error) {
+	return r.st.CurrentSyncCommitteeProof(ctx)
+}
+
+func (r *readOnlyState) NextSyncCommitteeProof(ctx context.Context) ([][]byte, error) {
+	return r.st.NextSyncCommitteeProof(ctx)
+}
+
+func (r *readOnlyState) CurrentEpochParticipation() ([]byte, error) {
+	return r.st.CurrentEpochParticipation()
+}
+
+func (r *readOnlyState) PreviousEpochParticipation() ([]byte, error) {
+	return r.st.PreviousEpochParticipation()
+}
+
+func (r *readOnlyState) UnrealizedCheckpointBalances() (uint64, uint64, uint64, error) {
+	return r.st.UnrealizedCheckpointBalances()
+}
+
+func (r *readOnlyState) InactivityScores() ([]uint64, error) {
+	return r.st.InactivityScores()
+}
+
+func (r *readOnlyState) CurrentSyncCommittee() (*ethpb.SyncCommittee, error) {
+	return r.st.CurrentSyncCommittee()
+}
+
+func (r *readOnlyState) NextSyncCommittee() (*ethpb.SyncCommittee, error) {
+	return r.st.NextSyncCommittee()
+}
+
+func (*readOnlyState) SetBlockRoots(_ [][]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateBlockRootAtIndex(_ uint64, _ [32]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetStateRoots(_ [][]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateStateRootAtIndex(_ uint64, _ [32]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetRandaoMixes(_ [][]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateRandaoMixesAtIndex(_ uint64, _ []byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetEth1Data(_ *ethpb.Eth1Data) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetEth1DataVotes(_ []*ethpb.Eth1Data) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendEth1DataVotes(_ *ethpb.Eth1Data) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetEth1DepositIndex(_ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetValidators(_ []*ethpb.Validator) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) ApplyToEveryValidator(_ func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateValidatorAtIndex(_ types.ValidatorIndex, _ *ethpb.Validator) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendValidator(_ *ethpb.Validator) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetBalances(_ []uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateBalancesAtIndex(_ types.ValidatorIndex, _ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendBalance(_ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetFinalizedCheckpoint(_ *ethpb.Checkpoint) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetPreviousJustifiedCheckpoint(_ *ethpb.Checkpoint) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetCurrentJustifiedCheckpoint(_ *ethpb.Checkpoint) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetJustificationBits(_ bitfield.Bitvector4) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendCurrentEpochAttestations(_ *ethpb.PendingAttestation) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendPreviousEpochAttestations(_ *ethpb.PendingAttestation) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) RotateAttestations() error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetGenesisTime(_ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetGenesisValidatorsRoot(_ []byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetSlot(_ types.Slot) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetFork(_ *ethpb.Fork) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetLatestBlockHeader(_ *ethpb.BeaconBlockHeader) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetHistoricalRoots(_ [][]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetSlashings(_ []uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) UpdateSlashingsAtIndex(_, _ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendHistoricalRoots(_ [32]byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetLatestExecutionPayloadHeader(_ interfaces.ExecutionData) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendCurrentParticipationBits(_ byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendPreviousParticipationBits(_ byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) AppendInactivityScore(_ uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetInactivityScores(_ []uint64) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetCurrentSyncCommittee(_ *ethpb.SyncCommittee) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetNextSyncCommittee(_ *ethpb.SyncCommittee) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetPreviousParticipationBits(_ []byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) SetCurrentParticipationBits(_ []byte) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) ModifyCurrentParticipationBits(_ func(val []byte) ([]byte, error)) error {
+	panic(ErrReadOnlyState)
+}
+
+func (*readOnlyState) ModifyPreviousParticipationBits(_ func(val []byte) ([]byte, error)) error {
+	panic(ErrReadOnlyState)
+}
@@ -0,0 +1,93 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestReadOnlyStateByRoot_CachedStatesAreNotCopied(t *testing.T) {
+	ctx := context.Background()
+	service := New(testDB.SetupDB(t))
+
+	hotState, _ := util.DeterministicGenesisState(t, 32)
+	boundaryState, _ := util.DeterministicGenesisState(t, 32)
+	snapshot, _ := util.DeterministicGenesisState(t, 32)
+	hotRoot, boundaryRoot, snapshotRoot := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	service.hotStateCache.put(hotRoot, hotState)
+	require.NoError(t, service.epochBoundaryStateCache.put(boundaryRoot, boundaryState))
+	service.hotStateSnapshots.put(snapshotRoot, snapshot)
+
+	// Successive reads share the same cached state.
+	for _, root := range [][32]byte{hotRoot, boundaryRoot, snapshotRoot} {
+		st1, err := service.ReadOnlyStateByRoot(ctx, root)
+		require.NoError(t, err)
+		st2, err := service.ReadOnlyStateByRoot(ctx, root)
+		require.NoError(t, err)
+		ro1, ok := st1.(*readOnlyState)
+		require.Equal(t, true, ok)
+		ro2, ok := st2.(*readOnlyState)
+		require.Equal(t, true, ok)
+		assert.Equal(t, true, ro1.st == ro2.st)
+	}
+	ro, err := service.ReadOnlyStateByRoot(ctx, hotRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, state.BeaconState(hotState) == ro.(*readOnlyState).st)
+}
+
+func TestReadOnlyStateByRoot_FromDB(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(1))
+	r := [32]byte{'a'}
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, r))
+
+	st, err := service.ReadOnlyStateByRoot(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), st.Slot())
+}
+
+func TestReadOnlyState_WritesPanic(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	r := [32]byte{'a'}
+	service.hotStateCache.put(r, beaconState)
+
+	st, err := service.ReadOnlyStateByRoot(context.Background(), r)
+	require.NoError(t, err)
+	writable, ok := st.(state.BeaconState)
+	require.Equal(t, true, ok)
+	assertPanics(t, func() { _ = writable.SetSlot(10) })
+	assertPanics(t, func() { _ = writable.UpdateBalancesAtIndex(0, 0) })
+	assertPanics(t, func() { _ = writable.AppendValidator(&ethpb.Validator{}) })
+
+	// Copies are writable, and do not modify the cached state.
+	copied, err := WritableCopy(st)
+	require.NoError(t, err)
+	require.NoError(t, copied.SetSlot(10))
+	assert.Equal(t, types.Slot(10), copied.Slot())
+	assert.Equal(t, types.Slot(0), beaconState.Slot())
+	assert.Equal(t, types.Slot(0), st.Slot())
+
+	copied, err = WritableCopy(beaconState)
+	require.NoError(t, err)
+	require.NoError(t, copied.SetSlot(11))
+	assert.Equal(t, types.Slot(0), beaconState.Slot())
+}
+
+func assertPanics(t *testing.T, f func()) {
+	defer func() {
+		assert.Equal(t, ErrReadOnlyState, recover())
+	}()
+	f()
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -33,6 +34,7 @@ type StateManager interface {
 	HasStateInCache(ctx context.Context, blockRoot [32]byte) (bool, error)
 	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 	StateByRootIfCachedNoCopy(blockRoot [32]byte) state.BeaconState
+	ReadOnlyStateByRoot(ctx context.Context, blockRoot [32]byte) (state.ReadOnlyBeaconState, error)
 	StateByRootInitialSync(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 	RecoverStateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	SaveState(ctx context.Context, blockRoot [32]byte, st state.BeaconState) error
@@ -49,8 +51,13 @@ type State struct {
 	hotStateCache           *hotStateCache
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
+	hotStateSnapshots       *hotStateSnapshots
 	saveHotStateDB          *saveHotStateDbConfig
 	backfillStatus          *backfill.Status
+	stateRetention          db.StateRetention
+	pruneStates             bool
+	freezeLock              sync.Mutex
+	freezing                bool
 }
 
 // This tracks the config in the event of long non-finality,
@@ -81,6 +88,31 @@ func WithBackfillStatus(bfs *backfill.Status) StateGenOption {
 	}
 }
 
+// WithStateRetention sets the retention mode applied to the finalized states saved in the DB.
+// The finalized states which are not kept by the mode are pruned as they are migrated to the cold section.
+func WithStateRetention(retention db.StateRetention) StateGenOption {
+	return func(sg *State) {
+		sg.stateRetention = retention
+		sg.pruneStates = true
+	}
+}
+
+// WithHotStateSnapshotInterval sets the number of slots between two hot state snapshots.
+// Hot state snapshots are disabled by default, or with an interval of 0.
+func WithHotStateSnapshotInterval(interval types.Slot) StateGenOption {
+	return func(sg *State) {
+		sg.hotStateSnapshots = newHotStateSnapshots(interval)
+	}
+}
+
+// WithMemoryGovernor registers the hot state cache with the memory governor, which evicts
+// cached states under memory pressure.
+func WithMemoryGovernor(governor *memory.Service) StateGenOption {
+	return func(sg *State) {
+		governor.Register("hot_states", sg.hotStateCache)
+	}
+}
+
 // New returns a new state management object.
 func New(beaconDB db.NoHeadAccessDatabase, opts ...StateGenOption) *State {
 	s := &State{
@@ -89,6 +121,7 @@ func New(beaconDB db.NoHeadAccessDatabase, opts ...StateGenOption) *State {
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
 		epochBoundaryStateCache: newBoundaryStateCache(),
+		hotStateSnapshots:       newHotStateSnapshots(0),
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
@@ -75,10 +75,14 @@ func (s *State) saveStateByRoot(ctx context.Context, blockRoot [32]byte, st stat
 	}
 
 	// Only on an epoch boundary slot, save epoch boundary state in epoch boundary root state cache.
+	// On a snapshot slot in between epoch boundaries, keep a full snapshot of the state so
+	// regenerating nearby hot states only needs to replay a few blocks.
 	if slots.IsEpochStart(st.Slot()) {
 		if err := s.epochBoundaryStateCache.put(blockRoot, st); err != nil {
 			return err
 		}
+	} else if s.hotStateSnapshots.shouldSnapshot(st.Slot()) {
+		s.hotStateSnapshots.put(blockRoot, st)
 	}
 
 	// On an intermediate slot, save state summary.
@@ -1,338 +0,0 @@
-package v1_test
-
-import (
-	"bytes"
-	"context"
-	"testing"
-
-	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	"github.com/prysmaticlabs/prysm/config/params"
-	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
-	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	"github.com/prysmaticlabs/prysm/testing/assert"
-	"github.com/prysmaticlabs/prysm/testing/require"
-	"github.com/prysmaticlabs/prysm/testing/util"
-)
-
-func TestInitializeFromProto(t *testing.T) {
-	testState, _ := util.DeterministicGenesisState(t, 64)
-	pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-	require.NoError(t, err)
-	type test struct {
-		name  string
-		state *ethpb.BeaconState
-		error string
-	}
-	initTests := []test{
-		{
-			name:  "nil state",
-			state: nil,
-			error: "received nil state",
-		},
-		{
-			name: "nil validators",
-			state: &ethpb.BeaconState{
-				Slot:       4,
-				Validators: nil,
-			},
-		},
-		{
-			name:  "empty state",
-			state: &ethpb.BeaconState{},
-		},
-		{
-			name:  "full state",
-			state: pbState,
-		},
-	}
-	for _, tt := range initTests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := v1.InitializeFromProto(tt.state)
-			if tt.error != "" {
-				assert.ErrorContains(t, tt.error, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestInitializeFromProtoUnsafe(t *testing.T) {
-	testState, _ := util.DeterministicGenesisState(t, 64)
-	pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-	require.NoError(t, err)
-	type test struct {
-		name  string
-		state *ethpb.BeaconState
-		error string
-	}
-	initTests := []test{
-		{
-			name:  "nil state",
-			state: nil,
-			error: "received nil state",
-		},
-		{
-			name: "nil validators",
-			state: &ethpb.BeaconState{
-				Slot:       4,
-				Validators: nil,
-			},
-		},
-		{
-			name:  "empty state",
-			state: &ethpb.BeaconState{},
-		},
-		{
-			name:  "full state",
-			state: pbState,
-		},
-	}
-	for _, tt := range initTests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, err := v1.InitializeFromProtoUnsafe(tt.state)
-			if tt.error != "" {
-				assert.ErrorContains(t, tt.error, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestBeaconState_HashTreeRoot(t *testing.T) {
-	testState, _ := util.DeterministicGenesisState(t, 64)
-
-	type test struct {
-		name        string
-		stateModify func(beaconState state.BeaconState) (state.BeaconState, error)
-		error       string
-	}
-	initTests := []test{
-		{
-			name: "unchanged state",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				return beaconState, nil
-			},
-			error: "",
-		},
-		{
-			name: "different slot",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				if err := beaconState.SetSlot(5); err != nil {
-					return nil, err
-				}
-				return beaconState, nil
-			},
-			error: "",
-		},
-		{
-			name: "different validator balance",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				val, err := beaconState.ValidatorAtIndex(5)
-				if err != nil {
-					return nil, err
-				}
-				val.EffectiveBalance = params.BeaconConfig().MaxEffectiveBalance - params.BeaconConfig().EffectiveBalanceIncrement
-				if err := beaconState.UpdateValidatorAtIndex(5, val); err != nil {
-					return nil, err
-				}
-				return beaconState, nil
-			},
-			error: "",
-		},
-	}
-
-	var err error
-	var oldHTR []byte
-	for _, tt := range initTests {
-		t.Run(tt.name, func(t *testing.T) {
-			testState, err = tt.stateModify(testState)
-			assert.NoError(t, err)
-			root, err := testState.HashTreeRoot(context.Background())
-			if err == nil && tt.error != "" {
-				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
-			}
-			pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-			require.NoError(t, err)
-			genericHTR, err := pbState.HashTreeRoot()
-			if err == nil && tt.error != "" {
-				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
-			}
-			assert.DeepNotEqual(t, []byte{}, root[:], "Received empty hash tree root")
-			assert.DeepEqual(t, genericHTR[:], root[:], "Expected hash tree root to match generic")
-			if len(oldHTR) != 0 && bytes.Equal(root[:], oldHTR) {
-				t.Errorf("Expected HTR to change, received %#x == old %#x", root, oldHTR)
-			}
-			oldHTR = root[:]
-		})
-	}
-}
-
-func BenchmarkBeaconState(b *testing.B) {
-	testState, _ := util.DeterministicGenesisState(b, 16000)
-	pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-	require.NoError(b, err)
-
-	b.Run("Vectorized SHA256", func(b *testing.B) {
-		st, err := v1.InitializeFromProtoUnsafe(pbState)
-		require.NoError(b, err)
-		_, err = st.HashTreeRoot(context.Background())
-		assert.NoError(b, err)
-	})
-
-	b.Run("Current SHA256", func(b *testing.B) {
-		_, err := pbState.HashTreeRoot()
-		require.NoError(b, err)
-	})
-}
-
-func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
-	testState, _ := util.DeterministicGenesisState(t, 64)
-
-	type test struct {
-		name        string
-		stateModify func(state.BeaconState) (state.BeaconState, error)
-		error       string
-	}
-	initTests := []test{
-		{
-			name: "unchanged state",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				return beaconState, nil
-			},
-			error: "",
-		},
-		{
-			name: "different slot",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				if err := beaconState.SetSlot(5); err != nil {
-					return nil, err
-				}
-				return beaconState, nil
-			},
-			error: "",
-		},
-		{
-			name: "different validator balance",
-			stateModify: func(beaconState state.BeaconState) (state.BeaconState, error) {
-				val, err := beaconState.ValidatorAtIndex(5)
-				if err != nil {
-					return nil, err
-				}
-				val.EffectiveBalance = params.BeaconConfig().MaxEffectiveBalance - params.BeaconConfig().EffectiveBalanceIncrement
-				if err := beaconState.UpdateValidatorAtIndex(5, val); err != nil {
-					return nil, err
-				}
-				return beaconState, nil
-			},
-			error: "",
-		},
-	}
-
-	var err error
-	var oldHTR []byte
-	for _, tt := range initTests {
-		t.Run(tt.name, func(t *testing.T) {
-			testState, err = tt.stateModify(testState)
-			assert.NoError(t, err)
-			root, err := testState.HashTreeRoot(context.Background())
-			if err == nil && tt.error != "" {
-				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
-			}
-			pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-			require.NoError(t, err)
-			genericHTR, err := pbState.HashTreeRoot()
-			if err == nil && tt.error != "" {
-				t.Errorf("Expected error, expected %v, recevied %v", tt.error, err)
-			}
-			assert.DeepNotEqual(t, []byte{}, root[:], "Received empty hash tree root")
-			assert.DeepEqual(t, genericHTR[:], root[:], "Expected hash tree root to match generic")
-			if len(oldHTR) != 0 && bytes.Equal(root[:], oldHTR) {
-				t.Errorf("Expected HTR to change, received %#x == old %#x", root, oldHTR)
-			}
-			oldHTR = root[:]
-		})
-	}
-}
-
-func TestBeaconState_AppendValidator_DoesntMutateCopy(t *testing.T) {
-	st0, err := util.NewBeaconState()
-	require.NoError(t, err)
-	st1 := st0.Copy()
-	originalCount := st1.NumValidators()
-
-	val := &ethpb.Validator{Slashed: true}
-	assert.NoError(t, st0.AppendValidator(val))
-	assert.Equal(t, originalCount, st1.NumValidators(), "st1 NumValidators mutated")
-	_, ok := st1.ValidatorIndexByPubkey(bytesutil.ToBytes48(val.PublicKey))
-	assert.Equal(t, false, ok, "Expected no validator index to be present in st1 for the newly inserted pubkey")
-}
-
-func TestBeaconState_ValidatorMutation_Phase0(t *testing.T) {
-	testState, _ := util.DeterministicGenesisState(t, 400)
-	pbState, err := v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-	require.NoError(t, err)
-	testState, err = v1.InitializeFromProto(pbState)
-	require.NoError(t, err)
-
-	_, err = testState.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-
-	// Reset tries
-	require.NoError(t, testState.UpdateValidatorAtIndex(200, new(ethpb.Validator)))
-	_, err = testState.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-
-	newState1 := testState.Copy()
-	_ = testState.Copy()
-
-	require.NoError(t, testState.UpdateValidatorAtIndex(15, &ethpb.Validator{
-		PublicKey:                  make([]byte, 48),
-		WithdrawalCredentials:      make([]byte, 32),
-		EffectiveBalance:           1111,
-		Slashed:                    false,
-		ActivationEligibilityEpoch: 1112,
-		ActivationEpoch:            1114,
-		ExitEpoch:                  1116,
-		WithdrawableEpoch:          1117,
-	}))
-
-	rt, err := testState.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-	pbState, err = v1.ProtobufBeaconState(testState.InnerStateUnsafe())
-	require.NoError(t, err)
-
-	copiedTestState, err := v1.InitializeFromProto(pbState)
-	require.NoError(t, err)
-
-	rt2, err := copiedTestState.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-
-	assert.Equal(t, rt, rt2)
-
-	require.NoError(t, newState1.UpdateValidatorAtIndex(150, &ethpb.Validator{
-		PublicKey:                  make([]byte, 48),
-		WithdrawalCredentials:      make([]byte, 32),
-		EffectiveBalance:           2111,
-		Slashed:                    false,
-		ActivationEligibilityEpoch: 2112,
-		ActivationEpoch:            2114,
-		ExitEpoch:                  2116,
-		WithdrawableEpoch:          2117,
-	}))
-
-	rt, err = newState1.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-	pbState, err = v1.ProtobufBeaconState(newState1.InnerStateUnsafe())
-	require.NoError(t, err)
-
-	copiedTestState, err = v1.InitializeFromProto(pbState)
-	require.NoError(t, err)
-
-	rt2, err = copiedTestState.HashTreeRoot(context.Background())
-	require.NoError(t, err)
-
-	assert.Equal(t, rt, rt2)
-}
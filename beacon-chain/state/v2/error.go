@@ -1,5 +0,0 @@
-package v2
-
-import "errors"
-
-var ErrNilParticipation = errors.New("nil epoch participation in state")
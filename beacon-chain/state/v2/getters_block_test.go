@@ -1,45 +0,0 @@
-package v2
-
-import (
-	"testing"
-
-	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	testtmpl "github.com/prysmaticlabs/prysm/beacon-chain/state/testing"
-	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-)
-
-func TestBeaconState_LatestBlockHeader(t *testing.T) {
-	testtmpl.VerifyBeaconStateLatestBlockHeader(
-		t,
-		func() (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{})
-		},
-		func(BH *ethpb.BeaconBlockHeader) (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{LatestBlockHeader: BH})
-		},
-	)
-}
-
-func TestBeaconState_BlockRoots(t *testing.T) {
-	testtmpl.VerifyBeaconStateBlockRoots(
-		t,
-		func() (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{})
-		},
-		func(BR [][]byte) (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{BlockRoots: BR})
-		},
-	)
-}
-
-func TestBeaconState_BlockRootAtIndex(t *testing.T) {
-	testtmpl.VerifyBeaconStateBlockRootAtIndex(
-		t,
-		func() (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{})
-		},
-		func(BR [][]byte) (state.BeaconState, error) {
-			return InitializeFromProto(&ethpb.BeaconStateAltair{BlockRoots: BR})
-		},
-	)
-}
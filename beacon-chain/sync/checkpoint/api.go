@@ -1,7 +1,9 @@
 package checkpoint
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/api/client/beacon"
@@ -42,5 +44,9 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
+	remote, local := od.Config().GenesisForkVersion, params.BeaconConfig().GenesisForkVersion
+	if !bytes.Equal(remote, local) {
+		return fmt.Errorf("checkpoint sync data is from a different network, remote genesis fork version=%#x, local=%#x", remote, local)
+	}
 	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
 }
@@ -52,14 +52,19 @@ func readContextFromStream(stream network.Stream, chain blockchain.ChainInfoFetc
 
 // retrieve expected context depending on rpc topic schema version.
 func rpcContext(stream network.Stream, chain blockchain.ChainInfoFetcher) ([]byte, error) {
-	_, _, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
+	_, message, version, err := p2p.TopicDeconstructor(string(stream.Protocol()))
 	if err != nil {
 		return nil, err
 	}
 	switch version {
 	case p2p.SchemaVersionV1:
-		// Return empty context for a v1 method.
-		return []byte{}, nil
+		// Blob sidecar and beacon state methods carry a context from their first version.
+		if message != p2p.BlobSidecarsByRangeMessageName && message != p2p.BlobSidecarsByRootMessageName &&
+			message != p2p.BeaconStateByCheckpointMessageName {
+			// Return empty context for a v1 method.
+			return []byte{}, nil
+		}
+		fallthrough
 	case p2p.SchemaVersionV2:
 		currFork := chain.CurrentFork()
 		genRoot := chain.GenesisValidatorsRoot()
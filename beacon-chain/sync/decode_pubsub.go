@@ -54,9 +54,13 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 			return nil, err
 		}
 	}
-	if err := s.cfg.p2p.Encoding().DecodeGossip(msg.Data, m); err != nil {
+	data, err := prefilterGossipMessage(topic, msg.Data)
+	if err != nil {
 		return nil, err
 	}
+	if err := m.UnmarshalSSZ(data); err != nil {
+		return nil, withReason(reasonInvalidMessage, err)
+	}
 	return m, nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 )
 
@@ -49,26 +50,16 @@ func (s *Service) registerForUpcomingFork(currEpoch types.Epoch) error {
 	// will subscribe the new topics in advance.
 	if isNextForkEpoch {
 		nextEpoch := currEpoch + 1
-		switch nextEpoch {
-		case params.BeaconConfig().AltairForkEpoch:
-			digest, err := forks.ForkDigestFromEpoch(nextEpoch, genRoot[:])
-			if err != nil {
-				return errors.Wrap(err, "Could not retrieve fork digest")
-			}
-			if s.subHandler.digestExists(digest) {
-				return nil
-			}
-			s.registerSubscribers(nextEpoch, digest)
+		digest, err := forks.DigestAtEpoch(nextEpoch, genRoot[:])
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve fork digest")
+		}
+		if s.subHandler.digestExists(digest) {
+			return nil
+		}
+		s.registerSubscribers(nextEpoch, digest)
+		if forks.ActivatesAt(version.Altair, nextEpoch) {
 			s.registerRPCHandlersAltair()
-		case params.BeaconConfig().BellatrixForkEpoch:
-			digest, err := forks.ForkDigestFromEpoch(nextEpoch, genRoot[:])
-			if err != nil {
-				return errors.Wrap(err, "could not retrieve fork digest")
-			}
-			if s.subHandler.digestExists(digest) {
-				return nil
-			}
-			s.registerSubscribers(nextEpoch, digest)
 		}
 	}
 	return nil
@@ -95,7 +86,7 @@ func (s *Service) deregisterFromPastFork(currEpoch types.Epoch) error {
 	if epochAfterFork == currEpoch {
 		// Look at the previous fork's digest.
 		epochBeforeFork := currFork.Epoch - 1
-		prevDigest, err := forks.ForkDigestFromEpoch(epochBeforeFork, genRoot[:])
+		prevDigest, err := forks.DigestAtEpoch(epochBeforeFork, genRoot[:])
 		if err != nil {
 			return errors.Wrap(err, "Failed to determine previous epoch fork digest")
 		}
@@ -85,7 +85,7 @@ func TestService_CheckForNextEpochFork(t *testing.T) {
 			wantErr:   false,
 			postSvcCheck: func(t *testing.T, s *Service) {
 				genRoot := s.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(5, genRoot[:])
+				digest, err := forks.DigestAtEpoch(5, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, true, s.subHandler.digestExists(digest))
 				rpcMap := make(map[string]bool)
@@ -129,7 +129,7 @@ func TestService_CheckForNextEpochFork(t *testing.T) {
 			wantErr:   false,
 			postSvcCheck: func(t *testing.T, s *Service) {
 				genRoot := s.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(5, genRoot[:])
+				digest, err := forks.DigestAtEpoch(5, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, true, s.subHandler.digestExists(digest))
 				rpcMap := make(map[string]bool)
@@ -233,12 +233,12 @@ func TestService_CheckForPreviousEpochFork(t *testing.T) {
 				r.registerRPCHandlersAltair()
 
 				genRoot := r.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(0, genRoot[:])
+				digest, err := forks.DigestAtEpoch(0, genRoot[:])
 				assert.NoError(t, err)
 				r.registerSubscribers(0, digest)
 				assert.Equal(t, true, r.subHandler.digestExists(digest))
 
-				digest, err = forks.ForkDigestFromEpoch(3, genRoot[:])
+				digest, err = forks.DigestAtEpoch(3, genRoot[:])
 				assert.NoError(t, err)
 				r.registerSubscribers(3, digest)
 				assert.Equal(t, true, r.subHandler.digestExists(digest))
@@ -249,10 +249,10 @@ func TestService_CheckForPreviousEpochFork(t *testing.T) {
 			wantErr:   false,
 			postSvcCheck: func(t *testing.T, s *Service) {
 				genRoot := s.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(0, genRoot[:])
+				digest, err := forks.DigestAtEpoch(0, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, false, s.subHandler.digestExists(digest))
-				digest, err = forks.ForkDigestFromEpoch(3, genRoot[:])
+				digest, err = forks.DigestAtEpoch(3, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, true, s.subHandler.digestExists(digest))
 
@@ -300,12 +300,12 @@ func TestService_CheckForPreviousEpochFork(t *testing.T) {
 					subHandler:   newSubTopicHandler(),
 				}
 				genRoot := r.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(1, genRoot[:])
+				digest, err := forks.DigestAtEpoch(1, genRoot[:])
 				assert.NoError(t, err)
 				r.registerSubscribers(1, digest)
 				assert.Equal(t, true, r.subHandler.digestExists(digest))
 
-				digest, err = forks.ForkDigestFromEpoch(3, genRoot[:])
+				digest, err = forks.DigestAtEpoch(3, genRoot[:])
 				assert.NoError(t, err)
 				r.registerSubscribers(3, digest)
 				assert.Equal(t, true, r.subHandler.digestExists(digest))
@@ -316,10 +316,10 @@ func TestService_CheckForPreviousEpochFork(t *testing.T) {
 			wantErr:   false,
 			postSvcCheck: func(t *testing.T, s *Service) {
 				genRoot := s.cfg.chain.GenesisValidatorsRoot()
-				digest, err := forks.ForkDigestFromEpoch(1, genRoot[:])
+				digest, err := forks.DigestAtEpoch(1, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, false, s.subHandler.digestExists(digest))
-				digest, err = forks.ForkDigestFromEpoch(3, genRoot[:])
+				digest, err = forks.DigestAtEpoch(3, genRoot[:])
 				assert.NoError(t, err)
 				assert.Equal(t, true, s.subHandler.digestExists(digest))
 			},
@@ -0,0 +1,133 @@
+package sync
+
+import (
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const (
+	sszOffsetLength = 4
+	// signedEnvelopeFixedSize is the size of the fixed part of a signed container whose message is variable
+	// in size: the offset of the message followed by the signature.
+	signedEnvelopeFixedSize = sszOffsetLength + fieldparams.BLSSignatureLength
+)
+
+// Reasons reported when a gossip message is rejected before it is decoded.
+const (
+	prefilterReasonCompressedSize = "compressed_size"
+	prefilterReasonSnappy         = "invalid_snappy"
+	prefilterReasonTooSmall       = "too_small"
+	prefilterReasonTooLarge       = "too_large"
+	prefilterReasonOffset         = "invalid_offset"
+)
+
+var errGossipPrefilter = errors.New("gossip message rejected before decoding")
+
+// gossipMessageShape bounds the SSZ encoding of the messages of a gossip topic.
+type gossipMessageShape struct {
+	minSize uint64
+	maxSize uint64
+	// firstOffset is the expected value of the offset at the start of variable size messages, whose first field
+	// is variable in size, which is the size of their fixed part. It is 0 for fixed size messages.
+	firstOffset uint32
+}
+
+// gossipMessageShapeForTopic returns the bounds of the messages of the given topic format, or false if the
+// topic is unknown. The bounds are derived from the configuration as the maximum gossip size changes at
+// the Bellatrix fork.
+func gossipMessageShapeForTopic(topic string) (gossipMessageShape, bool) {
+	maxValidatorsPerCommittee := params.BeaconConfig().MaxValidatorsPerCommittee
+	attestationFixedSize := uint64((&ethpb.Attestation{}).SizeSSZ())
+	// The aggregation bits hold one bit per committee member and the bitlist length bit.
+	aggregationBitsMaxSize := maxValidatorsPerCommittee/8 + 1
+
+	switch topic {
+	case p2p.BlockSubnetTopicFormat:
+		return gossipMessageShape{
+			minSize:     signedEnvelopeFixedSize,
+			maxSize:     encoder.MaxGossipSize,
+			firstOffset: signedEnvelopeFixedSize,
+		}, true
+	case p2p.AttestationSubnetTopicFormat:
+		return gossipMessageShape{
+			minSize:     attestationFixedSize,
+			maxSize:     attestationFixedSize + aggregationBitsMaxSize,
+			firstOffset: uint32(attestationFixedSize),
+		}, true
+	case p2p.AggregateAndProofSubnetTopicFormat:
+		minSize := signedEnvelopeFixedSize + uint64((&ethpb.AggregateAttestationAndProof{}).SizeSSZ())
+		return gossipMessageShape{
+			minSize:     minSize,
+			maxSize:     minSize + aggregationBitsMaxSize,
+			firstOffset: signedEnvelopeFixedSize,
+		}, true
+	case p2p.AttesterSlashingSubnetTopicFormat:
+		minSize := 2*sszOffsetLength + 2*uint64((&ethpb.IndexedAttestation{}).SizeSSZ())
+		return gossipMessageShape{
+			minSize:     minSize,
+			maxSize:     minSize + 2*8*maxValidatorsPerCommittee,
+			firstOffset: 2 * sszOffsetLength,
+		}, true
+	case p2p.ExitSubnetTopicFormat:
+		return fixedGossipMessageShape(uint64((&ethpb.SignedVoluntaryExit{}).SizeSSZ())), true
+	case p2p.ProposerSlashingSubnetTopicFormat:
+		return fixedGossipMessageShape(uint64((&ethpb.ProposerSlashing{}).SizeSSZ())), true
+	case p2p.SyncCommitteeSubnetTopicFormat:
+		return fixedGossipMessageShape(uint64((&ethpb.SyncCommitteeMessage{}).SizeSSZ())), true
+	case p2p.SyncContributionAndProofSubnetTopicFormat:
+		return fixedGossipMessageShape(uint64((&ethpb.SignedContributionAndProof{}).SizeSSZ())), true
+	case p2p.BlsToExecutionChangeSubnetTopicFormat:
+		return fixedGossipMessageShape(uint64((&ethpb.SignedBLSToExecutionChange{}).SizeSSZ())), true
+	default:
+		return gossipMessageShape{}, false
+	}
+}
+
+func fixedGossipMessageShape(size uint64) gossipMessageShape {
+	return gossipMessageShape{minSize: size, maxSize: size}
+}
+
+// prefilterGossipMessage runs cheap sanity checks on the snappy compressed SSZ data of a gossip message of the
+// given topic format before it is decoded, so that malformed messages are rejected without spending the
+// allocations of a full decode. It returns the decompressed data on success.
+func prefilterGossipMessage(topic string, data []byte) ([]byte, error) {
+	shape, ok := gossipMessageShapeForTopic(topic)
+	if !ok {
+		shape = gossipMessageShape{maxSize: encoder.MaxGossipSize}
+	}
+	if uint64(len(data)) > uint64(snappy.MaxEncodedLen(int(shape.maxSize))) {
+		return nil, rejectGossipMessage(topic, prefilterReasonCompressedSize, "compressed size %d is too large", len(data))
+	}
+	size, err := snappy.DecodedLen(data)
+	if err != nil {
+		return nil, rejectGossipMessage(topic, prefilterReasonSnappy, "could not read snappy header: %v", err)
+	}
+	if uint64(size) < shape.minSize {
+		return nil, rejectGossipMessage(topic, prefilterReasonTooSmall, "size %d is below the minimum of %d", size, shape.minSize)
+	}
+	if uint64(size) > shape.maxSize {
+		return nil, rejectGossipMessage(topic, prefilterReasonTooLarge, "size %d is above the maximum of %d", size, shape.maxSize)
+	}
+	decoded, err := snappy.Decode(nil /*dst*/, data)
+	if err != nil {
+		return nil, rejectGossipMessage(topic, prefilterReasonSnappy, "could not decompress: %v", err)
+	}
+	if shape.firstOffset != 0 {
+		if offset := binary.LittleEndian.Uint32(decoded[:sszOffsetLength]); offset != shape.firstOffset {
+			return nil, rejectGossipMessage(topic, prefilterReasonOffset, "first offset %d is not %d", offset, shape.firstOffset)
+		}
+	}
+	return decoded, nil
+}
+
+func rejectGossipMessage(topic, reason, format string, args ...interface{}) error {
+	gossipPrefilterRejectedCounter.WithLabelValues(topic, reason).Inc()
+	return errors.Wrapf(errGossipPrefilter, format, args...)
+}
@@ -0,0 +1,116 @@
+package sync
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/golang/snappy"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestPrefilterGossipMessage_ValidMessages(t *testing.T) {
+	att := util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(params.BeaconConfig().MaxValidatorsPerCommittee),
+	})
+	tests := []struct {
+		topic string
+		msg   ssz.Marshaler
+	}{
+		{topic: p2p.BlockSubnetTopicFormat, msg: util.NewBeaconBlock()},
+		{topic: p2p.BlockSubnetTopicFormat, msg: util.NewBeaconBlockBellatrix()},
+		{topic: p2p.AttestationSubnetTopicFormat, msg: att},
+		{topic: p2p.AggregateAndProofSubnetTopicFormat, msg: &ethpb.SignedAggregateAttestationAndProof{
+			Message: &ethpb.AggregateAttestationAndProof{
+				Aggregate:      att,
+				SelectionProof: make([]byte, 96),
+			},
+			Signature: make([]byte, 96),
+		}},
+		{topic: p2p.AttesterSlashingSubnetTopicFormat, msg: &ethpb.AttesterSlashing{
+			Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1, 2}}),
+			Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		}},
+		{topic: p2p.ExitSubnetTopicFormat, msg: &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{},
+			Signature: make([]byte, 96),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			enc, err := tt.msg.MarshalSSZ()
+			require.NoError(t, err)
+			decoded, err := prefilterGossipMessage(tt.topic, snappy.Encode(nil, enc))
+			require.NoError(t, err)
+			assert.DeepEqual(t, enc, decoded)
+		})
+	}
+}
+
+func TestPrefilterGossipMessage_Rejects(t *testing.T) {
+	exit, err := (&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{}, Signature: make([]byte, 96)}).MarshalSSZ()
+	require.NoError(t, err)
+	att, err := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(8)}).MarshalSSZ()
+	require.NoError(t, err)
+	badOffset := make([]byte, len(att))
+	copy(badOffset, att)
+	binary.LittleEndian.PutUint32(badOffset, 4)
+
+	tests := []struct {
+		name  string
+		topic string
+		data  []byte
+		want  string
+	}{
+		{
+			name:  "invalid snappy",
+			topic: p2p.ExitSubnetTopicFormat,
+			data:  []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			want:  "could not read snappy header",
+		},
+		{
+			name:  "compressed data too large",
+			topic: p2p.ExitSubnetTopicFormat,
+			data:  make([]byte, snappy.MaxEncodedLen(len(exit))+1),
+			want:  "compressed size",
+		},
+		{
+			name:  "fixed size message too small",
+			topic: p2p.ExitSubnetTopicFormat,
+			data:  snappy.Encode(nil, exit[:len(exit)-1]),
+			want:  "below the minimum",
+		},
+		{
+			name:  "fixed size message too large",
+			topic: p2p.ExitSubnetTopicFormat,
+			data:  snappy.Encode(nil, append(exit, 0)),
+			want:  "above the maximum",
+		},
+		{
+			name:  "block above the gossip size",
+			topic: p2p.BlockSubnetTopicFormat,
+			data:  snappy.Encode(nil, make([]byte, encoder.MaxGossipSize+1)),
+			want:  "above the maximum",
+		},
+		{
+			name:  "invalid first offset",
+			topic: p2p.AttestationSubnetTopicFormat,
+			data:  snappy.Encode(nil, badOffset),
+			want:  "first offset 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := prefilterGossipMessage(tt.topic, tt.data)
+			require.ErrorIs(t, err, errGossipPrefilter)
+			assert.ErrorContains(t, tt.want, err)
+		})
+	}
+}
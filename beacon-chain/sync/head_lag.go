@@ -0,0 +1,99 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// headLagInterval is the interval at which the head is compared against the wall clock.
+const headLagInterval = time.Second
+
+const (
+	// headLagCauseLocal is reported when peers have a head ahead of ours, so the node itself is behind.
+	headLagCauseLocal = "local"
+	// headLagCauseNetwork is reported when no peers are ahead of us, so the whole network is stalled.
+	headLagCauseNetwork = "network"
+)
+
+// monitorHeadLag reports how far the head block is behind the wall clock, and raises an alert once
+// the head falls more than the configured number of slots behind the current slot. The alert is raised
+// again only if its cause changes or after the head has caught up.
+func (s *Service) monitorHeadLag() {
+	genesis := s.cfg.chain.GenesisTime()
+	if genesis.IsZero() {
+		return
+	}
+	headSlot := s.cfg.chain.HeadSlot()
+	currentSlot := s.cfg.chain.CurrentSlot()
+	headSlotDelaySeconds.Set(prysmTime.Now().Sub(slots.StartTime(uint64(genesis.Unix()), headSlot)).Seconds())
+	distance := types.Slot(0)
+	if currentSlot > headSlot {
+		distance = currentSlot - headSlot
+	}
+	headSlotDistance.Set(float64(distance))
+
+	threshold := flags.Get().HeadLagAlertThreshold
+	syncing := s.cfg.initialSync != nil && s.cfg.initialSync.Syncing()
+	if threshold <= 0 || syncing || distance <= types.Slot(threshold) {
+		if s.headLagCause != "" && !syncing {
+			log.WithFields(logrus.Fields{
+				"headSlot":    headSlot,
+				"currentSlot": currentSlot,
+			}).Info("Head caught up with the current slot")
+		}
+		s.headLagCause = ""
+		return
+	}
+
+	peersAhead := s.countPeersAhead(headSlot)
+	cause := headLagCauseNetwork
+	if peersAhead >= minPeersAheadForLocalLag() {
+		cause = headLagCauseLocal
+	}
+	if cause == s.headLagCause {
+		return
+	}
+	s.headLagCause = cause
+	headLagAlertsCounter.WithLabelValues(cause).Inc()
+
+	logger := log.WithFields(logrus.Fields{
+		"headSlot":    headSlot,
+		"currentSlot": currentSlot,
+		"distance":    distance,
+		"peersAhead":  peersAhead,
+	})
+	if cause == headLagCauseLocal {
+		logger.Warn("Head is lagging behind the current slot while peers are ahead, the node may have a local problem")
+	} else {
+		logger.Warn("Head is lagging behind the current slot and peers are not ahead, the network may be stalled")
+	}
+}
+
+// countPeersAhead returns the number of connected peers whose last known head slot is ahead of the given slot.
+func (s *Service) countPeersAhead(headSlot types.Slot) int {
+	count := 0
+	for _, pid := range s.cfg.p2p.Peers().Connected() {
+		st, err := s.cfg.p2p.Peers().ChainState(pid)
+		if err != nil || st == nil {
+			continue
+		}
+		if st.HeadSlot > headSlot {
+			count++
+		}
+	}
+	return count
+}
+
+// minPeersAheadForLocalLag is the number of peers which must be ahead of the node to attribute a lagging head
+// to the node itself rather than to the network.
+func minPeersAheadForLocalLag() int {
+	if min := flags.Get().MinimumSyncPeers; min > 1 {
+		return min
+	}
+	return 1
+}
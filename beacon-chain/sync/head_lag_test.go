@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestService_monitorHeadLag(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{HeadLagAlertThreshold: 4, MinimumSyncPeers: 1})
+	defer flags.Init(resetFlags)
+
+	headState, _ := util.DeterministicGenesisState(t, 16)
+	require.NoError(t, headState.SetSlot(10))
+	currentSlot := types.Slot(12)
+	p := p2ptest.NewTestP2P(t)
+	syncChecker := &mockSync.Sync{}
+	s := &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			p2p: p,
+			chain: &mock.ChainService{
+				State:   headState,
+				Genesis: time.Now().Add(-time.Hour),
+				Slot:    &currentSlot,
+			},
+			initialSync: syncChecker,
+		},
+	}
+
+	// The head is within the threshold.
+	s.monitorHeadLag()
+	require.Equal(t, "", s.headLagCause)
+
+	// No peers are ahead of the head.
+	currentSlot = 20
+	s.monitorHeadLag()
+	require.Equal(t, headLagCauseNetwork, s.headLagCause)
+
+	// A peer reports a head ahead of ours.
+	p2 := p2ptest.NewTestP2P(t)
+	p.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p.Peers().SetChainState(p2.PeerID(), &ethpb.Status{HeadSlot: 19})
+	s.monitorHeadLag()
+	require.Equal(t, headLagCauseLocal, s.headLagCause)
+
+	// No alert is raised while syncing.
+	syncChecker.IsSyncing = true
+	s.monitorHeadLag()
+	require.Equal(t, "", s.headLagCause)
+
+	// The alert is cleared once the head catches up.
+	syncChecker.IsSyncing = false
+	s.monitorHeadLag()
+	require.Equal(t, headLagCauseLocal, s.headLagCause)
+	require.NoError(t, headState.SetSlot(19))
+	s.monitorHeadLag()
+	require.Equal(t, "", s.headLagCause)
+}
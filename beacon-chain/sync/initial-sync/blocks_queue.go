@@ -7,6 +7,7 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
@@ -66,6 +67,7 @@ type blocksQueueConfig struct {
 	p2p                 p2p.P2P
 	db                  db.ReadOnlyDatabase
 	mode                syncMode
+	governor            *memory.Service
 }
 
 // blocksQueue is a priority queue that serves as a intermediary between block fetchers (producers)
@@ -78,6 +80,7 @@ type blocksQueue struct {
 	chain               blockchainService
 	highestExpectedSlot types.Slot
 	mode                syncMode
+	governor            *memory.Service
 	exitConditions      struct {
 		noRequiredPeersErrRetries int
 	}
@@ -123,6 +126,7 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 		blocksFetcher:       blocksFetcher,
 		chain:               cfg.chain,
 		mode:                cfg.mode,
+		governor:            cfg.governor,
 		fetchedData:         make(chan *blocksQueueFetchedData, 1),
 		quit:                make(chan struct{}),
 		staleEpochs:         make(map[types.Epoch]uint8),
@@ -295,6 +299,12 @@ func (q *blocksQueue) onScheduleEvent(ctx context.Context) eventHandlerFn {
 			return m.state, errSlotIsTooHigh
 		}
 		blocksPerRequest := q.blocksFetcher.blocksPerSecond
+		// Under memory pressure, only the machines closest to the head are scheduled, bounding
+		// the number of fetched blocks held in memory.
+		lookahead := q.governor.ScaleBatchSize(lookaheadSteps)
+		if m.start >= q.chain.HeadSlot().Add(blocksPerRequest*lookahead) {
+			return m.state, nil
+		}
 		if err := q.blocksFetcher.scheduleRequest(ctx, m.start, blocksPerRequest); err != nil {
 			return m.state, err
 		}
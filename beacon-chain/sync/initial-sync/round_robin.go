@@ -77,6 +77,7 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		chain:               s.cfg.Chain,
 		highestExpectedSlot: highestFinalizedSlot,
 		mode:                modeStopOnFinalizedEpoch,
+		governor:            s.cfg.Governor,
 	})
 	if err := queue.start(); err != nil {
 		return err
@@ -106,6 +107,7 @@ func (s *Service) syncToNonFinalizedEpoch(ctx context.Context, genesis time.Time
 		chain:               s.cfg.Chain,
 		highestExpectedSlot: slots.Since(genesis),
 		mode:                modeNonConstrained,
+		governor:            s.cfg.Governor,
 	})
 	if err := queue.start(); err != nil {
 		return err
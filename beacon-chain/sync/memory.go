@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"sort"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+)
+
+// pendingBlocksConsumer reports the memory held by the pending blocks queue to the memory
+// governor.
+type pendingBlocksConsumer struct {
+	s *Service
+}
+
+// EstimatedMemory returns the total size of the pending blocks.
+func (c *pendingBlocksConsumer) EstimatedMemory() uint64 {
+	c.s.pendingQueueLock.RLock()
+	defer c.s.pendingQueueLock.RUnlock()
+	var size uint64
+	for k := range c.s.slotToPendingBlocks.Items() {
+		for _, b := range c.s.pendingBlocksInCache(cacheKeyToSlot(k)) {
+			size += uint64(b.SizeSSZ())
+		}
+	}
+	return size
+}
+
+// Shrink drops the pending blocks of the highest slots until the given number of bytes is
+// released. These blocks are the furthest from being processed, and are requested again from
+// peers once their ancestors are imported.
+func (c *pendingBlocksConsumer) Shrink(bytes uint64) uint64 {
+	c.s.pendingQueueLock.Lock()
+	defer c.s.pendingQueueLock.Unlock()
+	items := c.s.slotToPendingBlocks.Items()
+	ss := make([]types.Slot, 0, len(items))
+	for k := range items {
+		ss = append(ss, cacheKeyToSlot(k))
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] > ss[j]
+	})
+	var released uint64
+	for _, slot := range ss {
+		if released >= bytes {
+			break
+		}
+		for _, b := range c.s.pendingBlocksInCache(slot) {
+			released += uint64(b.SizeSSZ())
+			root, err := b.Block().HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Debug("Could not compute pending block root")
+				continue
+			}
+			delete(c.s.seenPendingBlocks, root)
+		}
+		c.s.slotToPendingBlocks.Delete(slotToCacheKey(slot))
+	}
+	return released
+}
+
+// pendingAttsConsumer reports the memory held by the pending attestations queue to the memory
+// governor.
+type pendingAttsConsumer struct {
+	s *Service
+}
+
+// EstimatedMemory returns the total size of the pending aggregates.
+func (c *pendingAttsConsumer) EstimatedMemory() uint64 {
+	c.s.pendingAttsLock.RLock()
+	defer c.s.pendingAttsLock.RUnlock()
+	var size uint64
+	for _, atts := range c.s.blkRootToPendingAtts {
+		for _, att := range atts {
+			size += uint64(att.SizeSSZ())
+		}
+	}
+	return size
+}
+
+// Shrink drops the pending aggregates voting for a missing block, in no particular order, until
+// the given number of bytes is released.
+func (c *pendingAttsConsumer) Shrink(bytes uint64) uint64 {
+	c.s.pendingAttsLock.Lock()
+	defer c.s.pendingAttsLock.Unlock()
+	var released uint64
+	for root, atts := range c.s.blkRootToPendingAtts {
+		if released >= bytes {
+			break
+		}
+		for _, att := range atts {
+			released += uint64(att.SizeSSZ())
+		}
+		delete(c.s.blkRootToPendingAtts, root)
+	}
+	return released
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	gcache "github.com/patrickmn/go-cache"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestPendingBlocksConsumer_Shrink(t *testing.T) {
+	r := &Service{
+		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+	}
+	c := &pendingBlocksConsumer{s: r}
+	assert.Equal(t, uint64(0), c.EstimatedMemory())
+
+	roots := make(map[types.Slot][32]byte)
+	var blockSize uint64
+	for _, slot := range []types.Slot{1, 2, 3} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+		require.NoError(t, err)
+		r.pendingQueueLock.Lock()
+		require.NoError(t, r.insertBlockToPendingQueue(slot, wsb, root))
+		r.pendingQueueLock.Unlock()
+		roots[slot] = root
+		blockSize = uint64(wsb.SizeSSZ())
+	}
+	assert.Equal(t, 3*blockSize, c.EstimatedMemory())
+
+	// The blocks of the highest slots are dropped first.
+	assert.Equal(t, 2*blockSize, c.Shrink(blockSize+1))
+	assert.Equal(t, blockSize, c.EstimatedMemory())
+	assert.Equal(t, 1, len(r.pendingBlocksInCache(1)))
+	assert.Equal(t, 0, len(r.pendingBlocksInCache(2)))
+	assert.Equal(t, true, r.seenPendingBlocks[roots[1]])
+	assert.Equal(t, false, r.seenPendingBlocks[roots[2]])
+	assert.Equal(t, false, r.seenPendingBlocks[roots[3]])
+}
+
+func TestPendingAttsConsumer_Shrink(t *testing.T) {
+	r := &Service{
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+	}
+	c := &pendingAttsConsumer{s: r}
+	var attSize uint64
+	for i := byte(0); i < 3; i++ {
+		att := &ethpb.SignedAggregateAttestationAndProof{
+			Message: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: types.ValidatorIndex(i),
+				Aggregate: util.HydrateAttestation(&ethpb.Attestation{
+					Data: &ethpb.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte{i % 2}, 32)},
+				}),
+				SelectionProof: make([]byte, 96),
+			},
+			Signature: make([]byte, 96),
+		}
+		r.savePendingAtt(att)
+		attSize = uint64(att.SizeSSZ())
+	}
+	assert.Equal(t, 3*attSize, c.EstimatedMemory())
+
+	released := c.Shrink(1)
+	assert.Equal(t, 3*attSize-released, c.EstimatedMemory())
+	assert.Equal(t, 1, len(r.blkRootToPendingAtts))
+	assert.Equal(t, 3*attSize, c.Shrink(3*attSize)+released)
+	assert.Equal(t, 0, len(r.blkRootToPendingAtts))
+}
@@ -28,6 +28,24 @@ var (
 			Help: "The number of peers subscribed to topics that a host node is also subscribed to.",
 		}, []string{"topic"},
 	)
+	seenCacheEntries = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "p2p_seen_cache_entries",
+			Help: "The number of entries of the caches of gossip objects already seen.",
+		}, []string{"cache"},
+	)
+	seenCacheCapacity = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "p2p_seen_cache_capacity",
+			Help: "The maximum number of entries of the caches of gossip objects already seen.",
+		}, []string{"cache"},
+	)
+	seenCacheEvictions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_seen_cache_evictions_total",
+			Help: "Count of entries evicted from the full caches of gossip objects already seen.",
+		}, []string{"cache"},
+	)
 	messageReceivedCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_received_total",
@@ -49,6 +67,28 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageRejectedReasonCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_rejected_reason_total",
+			Help: "Count of messages rejected in validation, by topic and reason.",
+		},
+		[]string{"topic", "reason"},
+	)
+	messageIgnoredReasonCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_ignored_reason_total",
+			Help: "Count of messages ignored in validation, by topic and reason.",
+		},
+		[]string{"topic", "reason"},
+	)
+	messageValidationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_message_validation_latency_milliseconds",
+			Help:    "Captures the time spent validating gossip messages in a milliseconds distribution, by topic.",
+			Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2000},
+		},
+		[]string{"topic"},
+	)
 	messageFailedProcessingCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_failed_processing_total",
@@ -56,6 +96,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	gossipPrefilterRejectedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_gossip_prefilter_rejected_total",
+			Help: "Count of gossip messages rejected before decoding, by topic and reason.",
+		},
+		[]string{"topic", "reason"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -82,6 +129,39 @@ var (
 			Buckets: []float64{250, 500, 1000, 1500, 2000, 3000, 4000, 10000},
 		},
 	)
+	goodbyeReceivedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_goodbye_received_total",
+			Help: "Count of goodbye messages received from peers, by reason.",
+		},
+		[]string{"reason"},
+	)
+	goodbyeSentCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_goodbye_sent_total",
+			Help: "Count of goodbye messages sent to peers, by reason.",
+		},
+		[]string{"reason"},
+	)
+	headSlotDelaySeconds = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "beacon_head_slot_delay_seconds",
+			Help: "Seconds elapsed since the start of the slot of the head block.",
+		},
+	)
+	headSlotDistance = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "beacon_head_slot_distance",
+			Help: "Number of slots between the current wall clock slot and the slot of the head block.",
+		},
+	)
+	headLagAlertsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "beacon_head_lag_alerts_total",
+			Help: "Count of lagging head alerts, by cause: local when peers are ahead of the node, network when they are not.",
+		},
+		[]string{"cause"},
+	)
 	arrivalBlockPropagationHistogram = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "block_arrival_latency_milliseconds",
@@ -2,11 +2,14 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/memory"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -52,6 +55,13 @@ func WithExitPool(exitPool voluntaryexits.PoolManager) Option {
 	}
 }
 
+func WithBLSToExecPool(blsToExecPool blstoexec.PoolManager) Option {
+	return func(s *Service) error {
+		s.cfg.blsToExecPool = blsToExecPool
+		return nil
+	}
+}
+
 func WithSlashingPool(slashingPool slashings.PoolManager) Option {
 	return func(s *Service) error {
 		s.cfg.slashingPool = slashingPool
@@ -128,3 +138,21 @@ func WithExecutionPayloadReconstructor(r powchain.ExecutionPayloadReconstructor)
 		return nil
 	}
 }
+
+// WithBlockArrivalCache records in the cache the arrival of the blocks received over gossip.
+func WithBlockArrivalCache(c *cache.BlockArrivalCache) Option {
+	return func(s *Service) error {
+		s.cfg.blockArrivals = c
+		return nil
+	}
+}
+
+// WithMemoryGovernor registers the pending blocks and attestations queues with the memory
+// governor, which drops queued items under memory pressure.
+func WithMemoryGovernor(governor *memory.Service) Option {
+	return func(s *Service) error {
+		governor.Register("pending_blocks", &pendingBlocksConsumer{s: s})
+		governor.Register("pending_attestations", &pendingAttsConsumer{s: s})
+		return nil
+	}
+}
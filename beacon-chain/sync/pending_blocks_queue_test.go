@@ -248,7 +248,7 @@ func TestRegularSyncBeaconBlockSubscriber_ExecutionEngineTimesOut(t *testing.T)
 	assert.Equal(t, 1, len(r.slotToPendingBlocks.Items()), "Incorrect size for slot to pending blocks cache")
 	assert.Equal(t, 2, len(r.seenPendingBlocks), "Incorrect size for seen pending block")
 	require.Equal(t, 1, len(r.badBlockCache.Keys())) // Account for the bad block above
-	require.Equal(t, 0, len(r.seenBlockCache.Keys()))
+	require.Equal(t, 0, r.seenBlockCache.Len())
 }
 
 func TestRegularSync_InsertDuplicateBlocks(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
 )
@@ -61,6 +62,17 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockCollector
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
 
+	// Use a single collector for blob sidecar requests, allowing the blobs of a batch of blocks per second.
+	blobCollector := leakybucket.NewCollector(allowedBlocksPerSecond*fieldparams.MaxBlobsPerBlock,
+		allowedBlocksBurst*fieldparams.MaxBlobsPerBlock, false /* deleteEmptyBuckets */)
+
+	// BlobSidecarsByRange and BlobSidecarsByRoot requests
+	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
+	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = blobCollector
+
+	// BeaconStateByCheckpoint requests, each of which is a full beacon state.
+	topicMap[addEncoding(p2p.RPCBeaconStateByCheckpointTopicV1)] = leakybucket.NewCollector(1.0/60, 2, false /* deleteEmptyBuckets */)
+
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, false /* deleteEmptyBuckets */)
 
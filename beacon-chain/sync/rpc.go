@@ -12,8 +12,11 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"go.opencensus.io/trace"
@@ -34,9 +37,15 @@ type rpcHandler func(context.Context, interface{}, libp2pcore.Stream) error
 
 // registerRPCHandlers for p2p RPC.
 func (s *Service) registerRPCHandlers() {
+	if features.Get().EnableCheckpointStateRPC {
+		s.registerRPC(
+			p2p.RPCBeaconStateByCheckpointTopicV1,
+			s.beaconStateByCheckpointRPCHandler,
+		)
+	}
 	currEpoch := slots.ToEpoch(s.cfg.chain.CurrentSlot())
 	// Register V2 handlers if we are past altair fork epoch.
-	if currEpoch >= params.BeaconConfig().AltairForkEpoch {
+	if forks.IsActive(version.Altair, currEpoch) {
 		s.registerRPC(
 			p2p.RPCStatusTopicV1,
 			s.statusRPCHandler,
@@ -92,6 +101,14 @@ func (s *Service) registerRPCHandlersAltair() {
 		p2p.RPCMetaDataTopicV2,
 		s.metaDataHandler,
 	)
+	s.registerRPC(
+		p2p.RPCBlobSidecarsByRangeTopicV1,
+		s.blobSidecarsByRangeRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCBlobSidecarsByRootTopicV1,
+		s.blobSidecarsByRootRPCHandler,
+	)
 }
 
 // Remove all v1 Stream handlers that are no longer supported
@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// checkpointStateRespTimeout is the maximum time for the transfer of a beacon state, which is much
+// larger than the other req/resp payloads.
+const checkpointStateRespTimeout = 5 * time.Minute
+
+// beaconStateByCheckpointRPCHandler serves the post-state of the block of a finalized checkpoint, for the
+// requesting peer to checkpoint sync from it. States are only served to trusted peers.
+func (s *Service) beaconStateByCheckpointRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.BeaconStateByCheckpointHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, checkpointStateRespTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "beacon_state_by_checkpoint")
+
+	cp, ok := msg.(*pb.Checkpoint)
+	if !ok {
+		return errors.New("message is not type *pb.Checkpoint")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+	if !s.cfg.p2p.Peers().IsTrusted(stream.Conn().RemotePeer()) {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "beacon states are only served to trusted peers", stream)
+		return errors.New("beacon state requested by an untrusted peer")
+	}
+	finalized, err := s.isFinalizedCheckpoint(ctx, cp)
+	if err != nil {
+		log.WithError(err).Debug("Could not check the requested checkpoint")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	if !finalized {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "checkpoint is not finalized", stream)
+		return errors.New("requested checkpoint is not finalized")
+	}
+
+	st, err := s.cfg.stateGen.ReadOnlyStateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch checkpoint state")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	SetStreamWriteDeadline(stream, checkpointStateRespTimeout)
+	if err := WriteBeaconStateChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), st); err != nil {
+		log.WithError(err).Debug("Could not send checkpoint state")
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// isFinalizedCheckpoint checks that the block of the checkpoint is finalized, and is not later than the
+// start of the epoch of the checkpoint.
+func (s *Service) isFinalizedCheckpoint(ctx context.Context, cp *pb.Checkpoint) (bool, error) {
+	finalized := s.cfg.chain.FinalizedCheckpt()
+	if finalized == nil || cp.Epoch > finalized.Epoch {
+		return false, nil
+	}
+	root := bytesutil.ToBytes32(cp.Root)
+	if cp.Epoch == finalized.Epoch {
+		return root == bytesutil.ToBytes32(finalized.Root), nil
+	}
+	if !s.cfg.beaconDB.IsFinalizedBlock(ctx, root) {
+		return false, nil
+	}
+	blk, err := s.cfg.beaconDB.Block(ctx, root)
+	if err != nil {
+		return false, err
+	}
+	if blk == nil || blk.IsNil() {
+		return false, nil
+	}
+	startSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return false, err
+	}
+	return blk.Block().Slot() <= startSlot, nil
+}
@@ -0,0 +1,143 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestBeaconStateByCheckpoint_ServesFinalizedState(t *testing.T) {
+	ctx := context.Background()
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.SetTrustedPeers(p2.PeerID())
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	st, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, st.SetSlot(1))
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       1,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, wsb))
+	require.NoError(t, d.SaveState(ctx, st, root))
+
+	chain := &mock.ChainService{
+		Genesis:             time.Now(),
+		Fork:                &ethpb.Fork{CurrentVersion: params.BeaconConfig().GenesisForkVersion},
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1, Root: root[:]},
+	}
+	r := &Service{
+		cfg:         &config{p2p: p1, beaconDB: d, chain: chain, stateGen: stategen.New(d)},
+		rateLimiter: newRateLimiter(p1),
+	}
+	topic := p2p.RPCBeaconStateByCheckpointTopicV1 + p1.Encoding().ProtocolSuffix()
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+	p1.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
+		req := &ethpb.Checkpoint{}
+		assert.NoError(t, p1.Encoding().DecodeWithMaxLength(stream, req))
+		assert.NoError(t, r.beaconStateByCheckpointRPCHandler(ctx, req, stream))
+	})
+
+	received, err := SendBeaconStateByCheckpointRequest(ctx, chain, p2, p1.PeerID(), &ethpb.Checkpoint{Epoch: 1, Root: root[:]})
+	require.NoError(t, err)
+	receivedRoot, err := received.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, stateRoot, receivedRoot)
+}
+
+func TestBeaconStateByCheckpoint_RejectsRequests(t *testing.T) {
+	finalizedRoot := [32]byte{'a'}
+	tests := []struct {
+		name    string
+		trusted bool
+		req     *ethpb.Checkpoint
+		errMsg  string
+	}{
+		{
+			name:    "untrusted peer",
+			trusted: false,
+			req:     &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]},
+			errMsg:  "beacon states are only served to trusted peers",
+		},
+		{
+			name:    "checkpoint later than the finalized one",
+			trusted: true,
+			req:     &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]},
+			errMsg:  "checkpoint is not finalized",
+		},
+		{
+			name:    "unknown root at the finalized epoch",
+			trusted: true,
+			req:     &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			errMsg:  "checkpoint is not finalized",
+		},
+		{
+			name:    "non finalized block",
+			trusted: true,
+			req:     &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			errMsg:  "checkpoint is not finalized",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := p2ptest.NewTestP2P(t)
+			p2 := p2ptest.NewTestP2P(t)
+			if tt.trusted {
+				p1.SetTrustedPeers(p2.PeerID())
+			}
+			p1.Connect(p2)
+			d := db.SetupDB(t)
+			chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}}
+			r := &Service{
+				cfg:         &config{p2p: p1, beaconDB: d, chain: chain, stateGen: stategen.New(d)},
+				rateLimiter: newRateLimiter(p1),
+			}
+			pcl := protocol.ID(p2p.RPCBeaconStateByCheckpointTopicV1)
+			r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+				defer wg.Done()
+				expectFailure(t, responseCodeInvalidRequest, tt.errMsg, stream)
+			})
+			stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+			require.NoError(t, err)
+			assert.NotNil(t, r.beaconStateByCheckpointRPCHandler(context.Background(), tt.req, stream))
+			if util.WaitTimeout(&wg, 1*time.Second) {
+				t.Fatal("Did not receive stream within 1 sec")
+			}
+		})
+	}
+}
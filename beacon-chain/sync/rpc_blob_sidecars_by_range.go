@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// blobSidecarsByRangeRPCHandler looks up the blob sidecars of the canonical blocks in the requested slot range
+// and writes them to the stream, ordered by slot and index. Slots before the blob retention period are skipped.
+func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.BlobSidecarsByRangeHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "blob_sidecars_by_range")
+
+	m, ok := msg.(*pb.BlobSidecarsByRangeRequest)
+	if !ok {
+		return errors.New("message is not type *pb.BlobSidecarsByRangeRequest")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if err := s.validateBlobSidecarsByRangeRequest(m); err != nil {
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	startSlot := m.StartSlot
+	if minSlot := s.minimumBlobSidecarSlot(); startSlot < minSlot {
+		startSlot = minSlot
+	}
+	endSlot := m.StartSlot.Add(m.Count - 1)
+	span.AddAttributes(
+		trace.Int64Attribute("start", int64(startSlot)), // lint:ignore uintcast -- This conversion is OK for tracing.
+		trace.Int64Attribute("end", int64(endSlot)),     // lint:ignore uintcast -- This conversion is OK for tracing.
+		trace.StringAttribute("peer", stream.Conn().RemotePeer().Pretty()),
+	)
+
+	maxSidecars := params.BeaconNetworkConfig().MaxRequestBlobSidecars
+	var written uint64
+	for slot := startSlot; slot <= endSlot && written < maxSidecars; slot++ {
+		_, roots, err := s.cfg.beaconDB.BlockRootsBySlot(ctx, slot)
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve block roots")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		for _, root := range roots {
+			canonical, err := s.cfg.chain.IsCanonical(ctx, root)
+			if err != nil {
+				log.WithError(err).Debug("Could not check if block is canonical")
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			if !canonical {
+				continue
+			}
+			sidecars, err := s.cfg.beaconDB.BlobSidecars(ctx, root)
+			if errors.Is(err, db.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				log.WithError(err).Debug("Could not retrieve blob sidecars")
+				s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			for _, sc := range sidecars {
+				if written == maxSidecars {
+					break
+				}
+				if err := s.chunkBlobSidecarWriter(stream, sc); err != nil {
+					log.WithError(err).Error("Could not send a chunked response")
+					s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+					tracing.AnnotateError(span, err)
+					return err
+				}
+				written++
+			}
+		}
+	}
+	s.rateLimiter.add(stream, int64(written+1))
+	closeStream(stream, log)
+	return nil
+}
+
+// validateBlobSidecarsByRangeRequest checks that the requested slot range is not empty, does not span more than
+// MAX_REQUEST_BLOCKS slots and does not start in the future.
+func (s *Service) validateBlobSidecarsByRangeRequest(r *pb.BlobSidecarsByRangeRequest) error {
+	if r.Count == 0 || r.Count > params.BeaconNetworkConfig().MaxRequestBlocks {
+		return p2ptypes.ErrInvalidRequest
+	}
+	if r.StartSlot > s.cfg.chain.CurrentSlot() {
+		return p2ptypes.ErrInvalidRequest
+	}
+	return nil
+}
+
+// minimumBlobSidecarSlot returns the first slot of the blob retention period, blob sidecars before it are not
+// served to peers.
+func (s *Service) minimumBlobSidecarSlot() types.Slot {
+	currentEpoch := slots.ToEpoch(s.cfg.chain.CurrentSlot())
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobSidecarsRequest
+	if currentEpoch <= retention {
+		return 0
+	}
+	start, err := slots.EpochStart(currentEpoch - retention)
+	if err != nil {
+		return 0
+	}
+	return start
+}
+
+func (s *Service) chunkBlobSidecarWriter(stream libp2pcore.Stream, sidecar *pb.BlobSidecar) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	return WriteBlobSidecarChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), sidecar)
+}
@@ -0,0 +1,148 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// saveBlockWithBlobSidecars saves a block at the slot along with the given number of blob sidecars.
+func saveBlockWithBlobSidecars(t *testing.T, d db2.NoHeadAccessDatabase, slot types.Slot, graffiti byte, count int) ([32]byte, []*ethpb.BlobSidecar) {
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.Body.Graffiti = make([]byte, 32)
+	blk.Block.Body.Graffiti[0] = graffiti
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), d, blk)
+	sidecars := make([]*ethpb.BlobSidecar, count)
+	for i := range sidecars {
+		sidecars[i] = &ethpb.BlobSidecar{
+			BlockRoot:       root[:],
+			Index:           uint64(i),
+			Slot:            slot,
+			BlockParentRoot: make([]byte, 32),
+			Blob:            make([]byte, fieldparams.BlobLength),
+			KzgCommitment:   make([]byte, 48),
+			KzgProof:        make([]byte, 48),
+		}
+	}
+	require.NoError(t, d.SaveBlobSidecars(context.Background(), sidecars))
+	return root, sidecars
+}
+
+func TestBlobSidecarsByRangeRPCHandler_ReturnsCanonicalSidecars(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	root1, scs1 := saveBlockWithBlobSidecars(t, d, 1, 0, 2)
+	// The sidecars of blocks which are not canonical are not returned.
+	saveBlockWithBlobSidecars(t, d, 2, 1, 1)
+	root3, scs3 := saveBlockWithBlobSidecars(t, d, 3, 0, 1)
+	// Sidecars outside of the requested range are not returned.
+	saveBlockWithBlobSidecars(t, d, 5, 0, 1)
+	want := append(scs1, scs3...)
+
+	currentSlot := types.Slot(10)
+	chain := &mock.ChainService{
+		Slot:           &currentSlot,
+		Fork:           &ethpb.Fork{CurrentVersion: params.BeaconConfig().GenesisForkVersion},
+		CanonicalRoots: map[[32]byte]bool{root1: true, root3: true},
+	}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRangeTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, sc := range want {
+			res, err := ReadChunkedBlobSidecar(stream, chain, p2)
+			require.NoError(t, err)
+			assert.DeepSSZEqual(t, sc, res)
+		}
+		_, err := ReadChunkedBlobSidecar(stream, chain, p2)
+		assert.NotNil(t, err, "Expected the stream to be closed")
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &ethpb.BlobSidecarsByRangeRequest{StartSlot: 1, Count: 4}
+	require.NoError(t, r.blobSidecarsByRangeRPCHandler(context.Background(), req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestBlobSidecarsByRangeRPCHandler_InvalidRequest(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	currentSlot := types.Slot(10)
+	chain := &mock.ChainService{Slot: &currentSlot}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRangeTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+	tests := []struct {
+		name string
+		req  *ethpb.BlobSidecarsByRangeRequest
+	}{
+		{name: "zero count", req: &ethpb.BlobSidecarsByRangeRequest{StartSlot: 1, Count: 0}},
+		{name: "count over limit", req: &ethpb.BlobSidecarsByRangeRequest{StartSlot: 1, Count: params.BeaconNetworkConfig().MaxRequestBlocks + 1}},
+		{name: "future start slot", req: &ethpb.BlobSidecarsByRangeRequest{StartSlot: 11, Count: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+				defer wg.Done()
+				expectFailure(t, responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+			})
+			stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+			require.NoError(t, err)
+			err = r.blobSidecarsByRangeRPCHandler(context.Background(), tt.req, stream1)
+			require.ErrorIs(t, err, p2ptypes.ErrInvalidRequest)
+			if util.WaitTimeout(&wg, 1*time.Second) {
+				t.Fatal("Did not receive stream within 1 sec")
+			}
+		})
+	}
+}
+
+func TestService_minimumBlobSidecarSlot(t *testing.T) {
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobSidecarsRequest
+	currentSlot := types.Slot(10)
+	r := &Service{cfg: &config{chain: &mock.ChainService{Slot: &currentSlot}}}
+	assert.Equal(t, types.Slot(0), r.minimumBlobSidecarSlot())
+
+	currentSlot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(retention + 5))
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(5), r.minimumBlobSidecarSlot())
+}
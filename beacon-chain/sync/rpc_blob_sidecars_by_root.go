@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+// blobSidecarsByRootRPCHandler looks up the requested blob sidecars from the database by block root and index.
+// Sidecars which are not stored or fell out of the blob retention period are skipped.
+func (s *Service) blobSidecarsByRootRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, ttfbTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "blob_sidecars_by_root")
+
+	rawMsg, ok := msg.(*types.BlobSidecarsByRootReq)
+	if !ok {
+		return errors.New("message is not type BlobSidecarsByRootReq")
+	}
+	ids := *rawMsg
+	if err := s.rateLimiter.validateRequest(stream, uint64(len(ids))); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		// Add to rate limiter in the event no
+		// identifiers are requested.
+		s.rateLimiter.add(stream, 1)
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "no blob identifiers provided in request", stream)
+		return errors.New("no blob identifiers provided")
+	}
+	if uint64(len(ids)) > params.BeaconNetworkConfig().MaxRequestBlobSidecars {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "requested more than the max blob sidecar limit", stream)
+		return errors.New("requested more than the max blob sidecar limit")
+	}
+	s.rateLimiter.add(stream, int64(len(ids)))
+
+	minSlot := s.minimumBlobSidecarSlot()
+	for _, id := range ids {
+		sidecars, err := s.cfg.beaconDB.BlobSidecars(ctx, bytesutil.ToBytes32(id.BlockRoot), id.Index)
+		if errors.Is(err, db.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			log.WithError(err).Debug("Could not fetch blob sidecars")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			return err
+		}
+		for _, sc := range sidecars {
+			if sc.Slot < minSlot {
+				continue
+			}
+			if err := s.chunkBlobSidecarWriter(stream, sc); err != nil {
+				return err
+			}
+		}
+	}
+
+	closeStream(stream, log)
+	return nil
+}
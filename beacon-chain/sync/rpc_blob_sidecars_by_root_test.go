@@ -0,0 +1,97 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestBlobSidecarsByRootRPCHandler_ReturnsSidecars(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	root1, scs1 := saveBlockWithBlobSidecars(t, d, 1, 0, 3)
+	root2, scs2 := saveBlockWithBlobSidecars(t, d, 2, 0, 1)
+	req := p2ptypes.BlobSidecarsByRootReq{
+		{BlockRoot: root1[:], Index: 2},
+		// Unknown blocks and indices are skipped.
+		{BlockRoot: make([]byte, 32), Index: 0},
+		{BlockRoot: root2[:], Index: 1},
+		{BlockRoot: root2[:], Index: 0},
+		{BlockRoot: root1[:], Index: 0},
+	}
+	want := []*ethpb.BlobSidecar{scs1[2], scs2[0], scs1[0]}
+
+	currentSlot := types.Slot(10)
+	chain := &mock.ChainService{Slot: &currentSlot, Fork: &ethpb.Fork{CurrentVersion: params.BeaconConfig().GenesisForkVersion}}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, sc := range want {
+			res, err := ReadChunkedBlobSidecar(stream, chain, p2)
+			require.NoError(t, err)
+			assert.DeepSSZEqual(t, sc, res)
+		}
+		_, err := ReadChunkedBlobSidecar(stream, chain, p2)
+		assert.NotNil(t, err, "Expected the stream to be closed")
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.blobSidecarsByRootRPCHandler(context.Background(), &req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestBlobSidecarsByRootRPCHandler_EmptyRequest(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: &mock.ChainService{}}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, "no blob identifiers provided in request", stream)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.BlobSidecarsByRootReq{}
+	require.ErrorContains(t, "no blob identifiers provided", r.blobSidecarsByRootRPCHandler(context.Background(), &req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"bytes"
+
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
@@ -8,11 +10,16 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	eth2types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
 )
 
 // chunkBlockWriter writes the given message as a chunked response to the given network
@@ -32,23 +39,9 @@ func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher
 	var obtainedCtx []byte
 
 	switch blk.Version() {
-	case version.Phase0:
-		valRoot := chain.GenesisValidatorsRoot()
-		digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().GenesisEpoch, valRoot[:])
-		if err != nil {
-			return err
-		}
-		obtainedCtx = digest[:]
-	case version.Altair:
+	case version.Phase0, version.Altair, version.Bellatrix, version.BellatrixBlind:
 		valRoot := chain.GenesisValidatorsRoot()
-		digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().AltairForkEpoch, valRoot[:])
-		if err != nil {
-			return err
-		}
-		obtainedCtx = digest[:]
-	case version.Bellatrix, version.BellatrixBlind:
-		valRoot := chain.GenesisValidatorsRoot()
-		digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().BellatrixForkEpoch, valRoot[:])
+		digest, err := forks.DigestOfVersion(blk.Version(), valRoot[:])
 		if err != nil {
 			return err
 		}
@@ -62,6 +55,118 @@ func WriteBlockChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher
 	return err
 }
 
+// WriteBlobSidecarChunk writes blob sidecar chunk object to stream, with the fork digest of the epoch of the
+// sidecar's slot as context.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func WriteBlobSidecarChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, sidecar *ethpb.BlobSidecar) error {
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	valRoot := chain.GenesisValidatorsRoot()
+	digest, err := forks.DigestAtEpoch(slots.ToEpoch(sidecar.Slot), valRoot[:])
+	if err != nil {
+		return err
+	}
+	if err := writeContextToStream(digest[:], stream, chain); err != nil {
+		return err
+	}
+	_, err = encoding.EncodeWithMaxLength(stream, sidecar)
+	return err
+}
+
+// ReadChunkedBlobSidecar reads a blob sidecar response chunk sent by the peer. The context of the chunk
+// has to be the fork digest of the epoch of the sidecar's slot.
+func ReadChunkedBlobSidecar(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P) (*ethpb.BlobSidecar, error) {
+	SetStreamReadDeadline(stream, respTimeout)
+	code, errMsg, err := readStatusCodeNoDeadline(stream, p2p.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return nil, errors.New(errMsg)
+	}
+	rpcCtx, err := readContextFromStream(stream, chain)
+	if err != nil {
+		return nil, err
+	}
+	sidecar := &ethpb.BlobSidecar{}
+	if err := p2p.Encoding().DecodeWithMaxLength(stream, sidecar); err != nil {
+		return nil, err
+	}
+	valRoot := chain.GenesisValidatorsRoot()
+	digest, err := forks.DigestAtEpoch(slots.ToEpoch(sidecar.Slot), valRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(digest[:], rpcCtx) {
+		return nil, errors.Errorf("blob sidecar context %#x does not match fork digest %#x of slot %d", rpcCtx, digest, sidecar.Slot)
+	}
+	return sidecar, nil
+}
+
+// WriteBeaconStateChunk writes beacon state chunk object to stream, with the fork digest of the epoch of the
+// state's slot as context. The size of the state is bounded by the max checkpoint state size, rather than by
+// the max chunk size.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func WriteBeaconStateChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, encoding encoder.NetworkEncoding, st state.ReadOnlyBeaconState) error {
+	valRoot := chain.GenesisValidatorsRoot()
+	digest, err := forks.DigestAtEpoch(slots.ToEpoch(st.Slot()), valRoot[:])
+	if err != nil {
+		return err
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	if err := writeContextToStream(digest[:], stream, chain); err != nil {
+		return err
+	}
+	b := types.SSZBytes(enc)
+	_, err = encoding.EncodeWithLimit(stream, &b, params.BeaconNetworkConfig().MaxCheckpointStateSize)
+	return err
+}
+
+// ReadChunkedBeaconState reads a beacon state response chunk sent by the peer. The context of the chunk
+// has to be the fork digest of the epoch of the state's slot.
+func ReadChunkedBeaconState(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P) (state.BeaconState, error) {
+	SetStreamReadDeadline(stream, checkpointStateRespTimeout)
+	code, errMsg, err := readStatusCodeNoDeadline(stream, p2p.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return nil, errors.New(errMsg)
+	}
+	rpcCtx, err := readContextFromStream(stream, chain)
+	if err != nil {
+		return nil, err
+	}
+	enc := types.SSZBytes{}
+	if err := p2p.Encoding().DecodeWithLimit(stream, &enc, params.BeaconNetworkConfig().MaxCheckpointStateSize); err != nil {
+		return nil, err
+	}
+	unmarshaler, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect the fork of the beacon state")
+	}
+	st, err := unmarshaler.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, err
+	}
+	valRoot := chain.GenesisValidatorsRoot()
+	digest, err := forks.DigestAtEpoch(slots.ToEpoch(st.Slot()), valRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(digest[:], rpcCtx) {
+		return nil, errors.Errorf("beacon state context %#x does not match fork digest %#x of slot %d", rpcCtx, digest, st.Slot())
+	}
+	return st, nil
+}
+
 // ReadChunkedBlock handles each response chunk that is sent by the
 // peer and converts it into a beacon block.
 func ReadChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoFetcher, p2p p2p.P2P, isFirstChunk bool) (interfaces.SignedBeaconBlock, error) {
@@ -91,7 +196,11 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, chain blockchain.ChainInfoF
 	if err != nil {
 		return nil, err
 	}
-	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
+	epoch, err := extractForkEpoch(rpcCtx, chain)
+	if err != nil {
+		return nil, err
+	}
+	err = p2p.Encoding().DecodeWithMaxLengthAtEpoch(stream, blk, epoch)
 	return blk, err
 }
 
@@ -115,10 +224,28 @@ func readResponseChunk(stream libp2pcore.Stream, chain blockchain.ChainInfoFetch
 	if err != nil {
 		return nil, err
 	}
-	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
+	epoch, err := extractForkEpoch(rpcCtx, chain)
+	if err != nil {
+		return nil, err
+	}
+	err = p2p.Encoding().DecodeWithMaxLengthAtEpoch(stream, blk, epoch)
 	return blk, err
 }
 
+// extractForkEpoch returns the epoch of the fork of the given context bytes, which bounds the
+// size of the response chunk.
+func extractForkEpoch(digest []byte, chain blockchain.ChainInfoFetcher) (eth2types.Epoch, error) {
+	if len(digest) == 0 {
+		return params.BeaconConfig().GenesisEpoch, nil
+	}
+	vRoot := chain.GenesisValidatorsRoot()
+	_, epoch, err := forks.RetrieveForkDataFromDigest(bytesutil.ToBytes4(digest), vRoot[:])
+	if err != nil {
+		return 0, err
+	}
+	return epoch, nil
+}
+
 func extractBlockDataType(digest []byte, chain blockchain.ChainInfoFetcher) (interfaces.SignedBeaconBlock, error) {
 	if len(digest) == 0 {
 		bFunc, ok := types.BlockMap[bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion)]
@@ -118,3 +118,24 @@ func TestExtractBlockDataType(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractForkEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 5
+	cfg.BellatrixForkEpoch = 10
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+	chain := &mock.ChainService{ValidatorsRoot: [32]byte{}}
+
+	epoch, err := extractForkEpoch([]byte{}, chain)
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().GenesisEpoch, epoch)
+	bellatrixDigest, err := signing.ComputeForkDigest(params.BeaconConfig().BellatrixForkVersion, params.BeaconConfig().ZeroHash[:])
+	require.NoError(t, err)
+	epoch, err = extractForkEpoch(bellatrixDigest[:], chain)
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().BellatrixForkEpoch, epoch)
+	_, err = extractForkEpoch([]byte{'A', 'B', 'C', 'D'}, chain)
+	require.ErrorContains(t, "no fork exists", err)
+}
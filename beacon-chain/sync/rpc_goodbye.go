@@ -16,21 +16,42 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var backOffTime = map[types.SSZUint64]time.Duration{
+// goodbyeReconnectPolicy determines how long to wait before dialing a peer again, after
+// a goodbye message with a given code was exchanged with the peer.
+type goodbyeReconnectPolicy struct {
+	// backoff is the time to wait before dialing the peer again.
+	backoff time.Duration
+	// maxBackoff caps the backoff, which doubles every time the same goodbye code is
+	// exchanged with the peer again. A zero value means the backoff never increases.
+	maxBackoff time.Duration
+}
+
+// receivedGoodbyePolicies are applied when a peer says goodbye to us.
+var receivedGoodbyePolicies = map[p2ptypes.RPCGoodbyeCode]goodbyeReconnectPolicy{
 	// Do not dial peers which are from a different/unverifiable
 	// network.
-	p2ptypes.GoodbyeCodeWrongNetwork:          24 * time.Hour,
-	p2ptypes.GoodbyeCodeUnableToVerifyNetwork: 24 * time.Hour,
+	p2ptypes.GoodbyeCodeWrongNetwork:          {backoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeUnableToVerifyNetwork: {backoff: 24 * time.Hour},
 	// If local peer is banned, we back off for
 	// 2 hours to let the remote peer score us
-	// back up again.
-	p2ptypes.GoodbyeCodeBadScore:       2 * time.Hour,
-	p2ptypes.GoodbyeCodeBanned:         2 * time.Hour,
-	p2ptypes.GoodbyeCodeClientShutdown: 1 * time.Hour,
+	// back up again, and longer if it keeps banning us.
+	p2ptypes.GoodbyeCodeBadScore:       {backoff: 2 * time.Hour, maxBackoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeBanned:         {backoff: 2 * time.Hour, maxBackoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeClientShutdown: {backoff: 1 * time.Hour},
 	// Wait 5 minutes before dialing a peer who is
-	// 'full'
-	p2ptypes.GoodbyeCodeTooManyPeers: 5 * time.Minute,
-	p2ptypes.GoodbyeCodeGenericError: 2 * time.Minute,
+	// 'full', and longer if it stays full.
+	p2ptypes.GoodbyeCodeTooManyPeers: {backoff: 5 * time.Minute, maxBackoff: 1 * time.Hour},
+	p2ptypes.GoodbyeCodeGenericError: {backoff: 2 * time.Minute, maxBackoff: 30 * time.Minute},
+}
+
+// sentGoodbyePolicies are applied when we say goodbye to a peer, so that we do not dial
+// again peers which we have just disconnected for being unusable.
+var sentGoodbyePolicies = map[p2ptypes.RPCGoodbyeCode]goodbyeReconnectPolicy{
+	p2ptypes.GoodbyeCodeWrongNetwork:          {backoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeUnableToVerifyNetwork: {backoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeBadScore:              {backoff: 1 * time.Hour, maxBackoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeBanned:                {backoff: 1 * time.Hour, maxBackoff: 24 * time.Hour},
+	p2ptypes.GoodbyeCodeGenericError:          {backoff: 2 * time.Minute, maxBackoff: 30 * time.Minute},
 }
 
 // goodbyeRPCHandler reads the incoming goodbye rpc message from the peer.
@@ -45,9 +66,19 @@ func (s *Service) goodbyeRPCHandler(_ context.Context, msg interface{}, stream l
 		return err
 	}
 	s.rateLimiter.add(stream, 1)
+	pid := stream.Conn().RemotePeer()
+	// Only known codes are counted, as they are the only ones with a reconnect policy.
+	count := uint64(0)
+	if _, ok := p2ptypes.GoodbyeCodeMessages[*m]; ok {
+		count = s.cfg.p2p.Peers().AddGoodbyeReceived(pid, uint64(*m))
+	}
+	goodbyeReceivedCounter.WithLabelValues(goodbyeReason(*m)).Inc()
 	log := log.WithField("Reason", goodbyeMessage(*m))
-	log.WithField("peer", stream.Conn().RemotePeer()).Debug("Peer has sent a goodbye message")
-	s.cfg.p2p.Peers().SetNextValidTime(stream.Conn().RemotePeer(), goodByeBackoff(*m))
+	log.WithFields(logrus.Fields{
+		"peer":  pid,
+		"count": count,
+	}).Debug("Peer has sent a goodbye message")
+	s.extendBackoff(pid, goodByeBackoff(receivedGoodbyePolicies, *m, count))
 	// closes all streams with the peer
 	return s.cfg.p2p.Disconnect(stream.Conn().RemotePeer())
 }
@@ -89,6 +120,9 @@ func (s *Service) sendGoodByeAndDisconnect(ctx context.Context, code p2ptypes.RP
 			"peer":  id,
 		}).Debug("Could not send goodbye message to peer")
 	}
+	count := s.cfg.p2p.Peers().AddGoodbyeSent(id, uint64(code))
+	goodbyeSentCounter.WithLabelValues(goodbyeReason(code)).Inc()
+	s.extendBackoff(id, goodByeBackoff(sentGoodbyePolicies, code, count))
 	return s.cfg.p2p.Disconnect(id)
 }
 
@@ -131,12 +165,41 @@ func goodbyeMessage(num p2ptypes.RPCGoodbyeCode) string {
 	return fmt.Sprintf("unknown goodbye value of %d received", num)
 }
 
-// determines which backoff time to use depending on the
-// goodbye code provided.
-func goodByeBackoff(num p2ptypes.RPCGoodbyeCode) time.Time {
-	duration, ok := backOffTime[num]
+// goodbyeReason returns the reason of the goodbye code used as a metric label. Unknown codes, which are
+// chosen by the remote peer, share a single label so they cannot create an unbounded number of series.
+func goodbyeReason(num p2ptypes.RPCGoodbyeCode) string {
+	reason, ok := p2ptypes.GoodbyeCodeMessages[num]
+	if ok {
+		return reason
+	}
+	return "unknown"
+}
+
+// goodByeBackoff determines until when to wait before dialing a peer again, using the reconnect
+// policy of the given goodbye code and the number of times this code was exchanged with the peer.
+func goodByeBackoff(policies map[p2ptypes.RPCGoodbyeCode]goodbyeReconnectPolicy, num p2ptypes.RPCGoodbyeCode, count uint64) time.Time {
+	policy, ok := policies[num]
 	if !ok {
 		return time.Time{}
 	}
+	duration := policy.backoff
+	for i := uint64(1); i < count && duration < policy.maxBackoff; i++ {
+		duration *= 2
+	}
+	if policy.maxBackoff > 0 && duration > policy.maxBackoff {
+		duration = policy.maxBackoff
+	}
 	return time.Now().Add(duration)
 }
+
+// extendBackoff sets the earliest time to dial the peer again, unless the peer is already backed off for longer.
+func (s *Service) extendBackoff(pid peer.ID, until time.Time) {
+	if until.IsZero() {
+		return
+	}
+	current, err := s.cfg.p2p.Peers().NextValidTime(pid)
+	if err == nil && current.After(until) {
+		return
+	}
+	s.cfg.p2p.Peers().SetNextValidTime(pid, until)
+}
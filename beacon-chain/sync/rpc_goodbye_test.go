@@ -112,7 +112,7 @@ func TestGoodByeRPCHandler_BackOffPeer(t *testing.T) {
 	}
 	valTime, err := p1.Peers().NextValidTime(p2.BHost.ID())
 	require.NoError(t, err)
-	expectedTime := time.Now().Add(backOffTime[failureCode])
+	expectedTime := time.Now().Add(receivedGoodbyePolicies[failureCode].backoff)
 	diff := expectedTime.Sub(valTime)
 	// Add a little bit of allowance
 	require.Equal(t, true, diff.Seconds() <= 1)
@@ -139,7 +139,7 @@ func TestGoodByeRPCHandler_BackOffPeer(t *testing.T) {
 	}
 	valTime, err = p1.Peers().NextValidTime(p3.BHost.ID())
 	require.NoError(t, err)
-	expectedTime = time.Now().Add(backOffTime[failureCode])
+	expectedTime = time.Now().Add(receivedGoodbyePolicies[failureCode].backoff)
 	diff = expectedTime.Sub(valTime)
 	// Add a little bit of allowance
 	require.Equal(t, true, diff.Seconds() <= 1)
@@ -233,3 +233,93 @@ func TestSendGoodbye_DisconnectWithPeer(t *testing.T) {
 	}
 
 }
+
+func TestGoodByeBackoff_Escalates(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies map[p2ptypes.RPCGoodbyeCode]goodbyeReconnectPolicy
+		code     p2ptypes.RPCGoodbyeCode
+		count    uint64
+		want     time.Duration
+	}{
+		{
+			name:     "first too many peers",
+			policies: receivedGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeTooManyPeers,
+			count:    1,
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "repeated too many peers",
+			policies: receivedGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeTooManyPeers,
+			count:    3,
+			want:     20 * time.Minute,
+		},
+		{
+			name:     "too many peers capped",
+			policies: receivedGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeTooManyPeers,
+			count:    100,
+			want:     1 * time.Hour,
+		},
+		{
+			name:     "repeated wrong network does not escalate",
+			policies: receivedGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeWrongNetwork,
+			count:    5,
+			want:     24 * time.Hour,
+		},
+		{
+			name:     "sent banned",
+			policies: sentGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeBanned,
+			count:    2,
+			want:     2 * time.Hour,
+		},
+		{
+			name:     "sent too many peers",
+			policies: sentGoodbyePolicies,
+			code:     p2ptypes.GoodbyeCodeTooManyPeers,
+			count:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := goodByeBackoff(tt.policies, tt.code, tt.count)
+			if tt.want == 0 {
+				require.Equal(t, true, got.IsZero())
+				return
+			}
+			diff := time.Now().Add(tt.want).Sub(got)
+			require.Equal(t, true, diff >= 0 && diff.Seconds() <= 1, "unexpected backoff %v", got)
+		})
+	}
+}
+
+func TestSendGoodbye_RecordsGoodbye(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			chain:    &mock.ChainService{ValidatorsRoot: [32]byte{}, Genesis: time.Now()},
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	require.NoError(t, r.sendGoodByeAndDisconnect(context.Background(), p2ptypes.GoodbyeCodeBanned, p2.BHost.ID()))
+	_, sent, err := p1.Peers().Goodbyes(p2.BHost.ID())
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64]uint64{uint64(p2ptypes.GoodbyeCodeBanned): 1}, sent)
+	assert.Equal(t, false, p1.Peers().IsReadyToDial(p2.BHost.ID()))
+}
+
+func TestGoodbyeReason(t *testing.T) {
+	assert.Equal(t, p2ptypes.GoodbyeCodeMessages[p2ptypes.GoodbyeCodeBanned], goodbyeReason(p2ptypes.GoodbyeCodeBanned))
+	// Unknown codes share a single metric label.
+	assert.Equal(t, "unknown", goodbyeReason(1000))
+	assert.Equal(t, "unknown", goodbyeReason(1001))
+}
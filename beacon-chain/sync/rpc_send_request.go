@@ -9,9 +9,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/time/slots"
 )
@@ -129,3 +131,42 @@ func SendBeaconBlocksByRootRequest(
 	}
 	return blocks, nil
 }
+
+// SendBeaconStateByCheckpointRequest sends BeaconStateByCheckpoint and returns the fetched state, which is
+// checked to be the post-state of the block of the checkpoint.
+func SendBeaconStateByCheckpointRequest(
+	ctx context.Context, chain blockchain.ChainInfoFetcher, p2pProvider p2p.P2P, pid peer.ID,
+	req *pb.Checkpoint,
+) (state.BeaconState, error) {
+	topic, err := p2p.TopicFromMessage(p2p.BeaconStateByCheckpointMessageName, slots.ToEpoch(chain.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	st, err := ReadChunkedBeaconState(stream, chain, p2pProvider)
+	if err != nil {
+		return nil, err
+	}
+	// The latest block header of the post-state of a block does not have its state root filled in yet.
+	header := st.LatestBlockHeader()
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if blockRoot != bytesutil.ToBytes32(req.Root) {
+		return nil, ErrInvalidFetchedData
+	}
+	return st, nil
+}
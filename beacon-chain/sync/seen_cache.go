@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+)
+
+// seenCache records the gossip objects the node has already seen, so that their duplicates are ignored.
+// It holds a bounded number of entries, evicting the least recently used ones once full. With a non zero
+// TTL an object is only considered seen for the TTL after it was marked, so that the cache does not have
+// to be sized to remember objects which are no longer relevant.
+type seenCache struct {
+	name  string
+	ttl   time.Duration
+	cache *lru.Cache
+}
+
+// newSeenCache creates a seen cache of the given size and TTL, reporting its occupancy under the given name.
+func newSeenCache(name string, size int, ttl time.Duration) *seenCache {
+	seenCacheCapacity.WithLabelValues(name).Set(float64(size))
+	seenCacheEntries.WithLabelValues(name).Set(0)
+	return &seenCache{
+		name: name,
+		ttl:  ttl,
+		cache: lruwrpr.NewWithEvict(size, func(_ interface{}, _ interface{}) {
+			seenCacheEvictions.WithLabelValues(name).Inc()
+		}),
+	}
+}
+
+// newConfiguredSeenCache creates a seen cache of the given default size scaled by the multiplier of
+// --seen-cache-size-multiplier, and the TTL of --seen-cache-ttl.
+func newConfiguredSeenCache(name string, defaultSize int) *seenCache {
+	multiplier := flags.Get().SeenCacheSizeMultiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	return newSeenCache(name, defaultSize*multiplier, flags.Get().SeenCacheTTL)
+}
+
+// Seen returns whether the object with the given key was marked as seen, within the TTL of the cache.
+func (c *seenCache) Seen(key interface{}) bool {
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return false
+	}
+	if c.ttl == 0 {
+		return true
+	}
+	markedAt, ok := v.(time.Time)
+	return ok && prysmTime.Since(markedAt) < c.ttl
+}
+
+// Add marks the object with the given key as seen.
+func (c *seenCache) Add(key interface{}) {
+	c.cache.Add(key, prysmTime.Now())
+	seenCacheEntries.WithLabelValues(c.name).Set(float64(c.cache.Len()))
+}
+
+// Len returns the number of entries of the cache, including the expired ones which were not evicted yet.
+func (c *seenCache) Len() int {
+	return c.cache.Len()
+}
@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := newSeenCache("test", 2, 0)
+	assert.Equal(t, false, c.Seen("a"))
+	c.Add("a")
+	c.Add("b")
+	assert.Equal(t, true, c.Seen("a"))
+	c.Add("c")
+	// "b" is the least recently used entry.
+	assert.Equal(t, false, c.Seen("b"))
+	assert.Equal(t, true, c.Seen("a"))
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestSeenCache_TTL(t *testing.T) {
+	c := newSeenCache("test", 10, 50*time.Millisecond)
+	c.Add("a")
+	assert.Equal(t, true, c.Seen("a"))
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, false, c.Seen("a"))
+	c.Add("a")
+	assert.Equal(t, true, c.Seen("a"))
+}
+
+func TestNewConfiguredSeenCache(t *testing.T) {
+	resetCfg := flags.Get()
+	defer flags.Init(resetCfg)
+
+	flags.Init(&flags.GlobalFlags{})
+	c := newConfiguredSeenCache("test", 1)
+	c.Add("a")
+	c.Add("b")
+	require.Equal(t, 1, c.Len())
+
+	flags.Init(&flags.GlobalFlags{SeenCacheSizeMultiplier: 3, SeenCacheTTL: time.Minute})
+	c = newConfiguredSeenCache("test", 1)
+	c.Add("a")
+	c.Add("b")
+	c.Add("c")
+	require.Equal(t, 3, c.Len())
+	assert.Equal(t, time.Minute, c.ttl)
+}
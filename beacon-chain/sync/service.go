@@ -19,12 +19,14 @@ import (
 	"github.com/prysmaticlabs/prysm/async/abool"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -74,6 +76,7 @@ type config struct {
 	beaconDB                      db.NoHeadAccessDatabase
 	attPool                       attestations.Pool
 	exitPool                      voluntaryexits.PoolManager
+	blsToExecPool                 blstoexec.PoolManager
 	slashingPool                  slashings.PoolManager
 	syncCommsPool                 synccommittee.Pool
 	chain                         blockchainService
@@ -85,6 +88,7 @@ type config struct {
 	stateGen                      *stategen.State
 	slasherAttestationsFeed       *event.Feed
 	slasherBlockHeadersFeed       *event.Feed
+	blockArrivals                 *cache.BlockArrivalCache
 }
 
 // This defines the interface for interacting with block chain service
@@ -117,26 +121,27 @@ type Service struct {
 	validateBlockLock                sync.RWMutex
 	rateLimiter                      *limiter
 	seenBlockLock                    sync.RWMutex
-	seenBlockCache                   *lru.Cache
+	seenBlockCache                   *seenCache
 	seenAggregatedAttestationLock    sync.RWMutex
-	seenAggregatedAttestationCache   *lru.Cache
+	seenAggregatedAttestationCache   *seenCache
 	seenUnAggregatedAttestationLock  sync.RWMutex
-	seenUnAggregatedAttestationCache *lru.Cache
+	seenUnAggregatedAttestationCache *seenCache
 	seenExitLock                     sync.RWMutex
-	seenExitCache                    *lru.Cache
+	seenExitCache                    *seenCache
 	seenProposerSlashingLock         sync.RWMutex
-	seenProposerSlashingCache        *lru.Cache
+	seenProposerSlashingCache        *seenCache
 	seenAttesterSlashingLock         sync.RWMutex
 	seenAttesterSlashingCache        map[uint64]bool
 	seenSyncMessageLock              sync.RWMutex
-	seenSyncMessageCache             *lru.Cache
+	seenSyncMessageCache             *seenCache
 	seenSyncContributionLock         sync.RWMutex
-	seenSyncContributionCache        *lru.Cache
+	seenSyncContributionCache        *seenCache
 	badBlockCache                    *lru.Cache
 	badBlockLock                     sync.RWMutex
 	syncContributionBitsOverlapLock  sync.RWMutex
 	syncContributionBitsOverlapCache *lru.Cache
 	signatureChan                    chan *signatureVerifier
+	headLagCause                     string
 }
 
 // NewService initializes new regular sync service.
@@ -185,6 +190,7 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	async.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+	async.RunEvery(s.ctx, headLagInterval, s.monitorHeadLag)
 }
 
 // Stop the regular sync service.
@@ -220,15 +226,15 @@ func (s *Service) Status() error {
 // This initializes the caches to update seen beacon objects coming in from the wire
 // and prevent DoS.
 func (s *Service) initCaches() {
-	s.seenBlockCache = lruwrpr.New(seenBlockSize)
-	s.seenAggregatedAttestationCache = lruwrpr.New(seenAggregatedAttSize)
-	s.seenUnAggregatedAttestationCache = lruwrpr.New(seenUnaggregatedAttSize)
-	s.seenSyncMessageCache = lruwrpr.New(seenSyncMsgSize)
-	s.seenSyncContributionCache = lruwrpr.New(seenSyncContributionSize)
+	s.seenBlockCache = newConfiguredSeenCache("block", seenBlockSize)
+	s.seenAggregatedAttestationCache = newConfiguredSeenCache("aggregated_attestation", seenAggregatedAttSize)
+	s.seenUnAggregatedAttestationCache = newConfiguredSeenCache("unaggregated_attestation", seenUnaggregatedAttSize)
+	s.seenSyncMessageCache = newConfiguredSeenCache("sync_committee_message", seenSyncMsgSize)
+	s.seenSyncContributionCache = newConfiguredSeenCache("sync_contribution", seenSyncContributionSize)
 	s.syncContributionBitsOverlapCache = lruwrpr.New(seenSyncContributionSize)
-	s.seenExitCache = lruwrpr.New(seenExitSize)
+	s.seenExitCache = newConfiguredSeenCache("voluntary_exit", seenExitSize)
 	s.seenAttesterSlashingCache = make(map[uint64]bool)
-	s.seenProposerSlashingCache = lruwrpr.New(seenProposerSlashingSize)
+	s.seenProposerSlashingCache = newConfiguredSeenCache("proposer_slashing", seenProposerSlashingSize)
 	s.badBlockCache = lruwrpr.New(badBlockSize)
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/messagehandler"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -95,7 +96,7 @@ func (s *Service) registerSubscribers(epoch types.Epoch, digest [4]byte) {
 		)
 	}
 	// Altair Fork Version
-	if epoch >= params.BeaconConfig().AltairForkEpoch {
+	if forks.IsActive(version.Altair, epoch) {
 		s.subscribe(
 			p2p.SyncContributionAndProofSubnetTopicFormat,
 			s.validateSyncContributionAndProof,
@@ -118,6 +119,15 @@ func (s *Service) registerSubscribers(epoch types.Epoch, digest [4]byte) {
 			)
 		}
 	}
+	// Capella Fork Version
+	if forks.IsActive(version.Capella, epoch) {
+		s.subscribe(
+			p2p.BlsToExecutionChangeSubnetTopicFormat,
+			s.validateBlsToExecutionChange,
+			s.blsToExecutionChangeSubscriber,
+			digest,
+		)
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
@@ -234,11 +244,13 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 		if msg.Topic == nil {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			messageRejectedReasonCounter.WithLabelValues(topic, reasonInvalidMessage).Inc()
 			return pubsub.ValidationReject
 		}
 		// Ignore any messages received before chainstart.
 		if s.chainStarted.IsNotSet() {
 			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
+			messageIgnoredReasonCounter.WithLabelValues(topic, reasonNotSynced).Inc()
 			return pubsub.ValidationIgnore
 		}
 		retDigest, err := p2p.ExtractGossipDigest(topic)
@@ -255,7 +267,10 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 			log.WithField("topic", topic).Debugf("Received message from outdated fork digest %#x", retDigest)
 			return pubsub.ValidationIgnore
 		}
+		start := time.Now()
 		b, err := v(ctx, pid, msg)
+		messageValidationLatency.WithLabelValues(topic).Observe(float64(time.Since(start).Milliseconds()))
+		reason, err := validationReason(err)
 		if b == pubsub.ValidationReject {
 			log.WithError(err).WithFields(logrus.Fields{
 				"topic":        topic,
@@ -263,8 +278,10 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 				"peer id":      pid.String(),
 				"agent":        agentString(pid, s.cfg.p2p.Host()),
 				"gossip score": s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid),
+				"reason":       reason,
 			}).Debugf("Gossip message was rejected")
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
+			messageRejectedReasonCounter.WithLabelValues(topic, reason).Inc()
 		}
 		if b == pubsub.ValidationIgnore {
 			if err != nil {
@@ -274,9 +291,11 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 					"peer id":      pid.String(),
 					"agent":        agentString(pid, s.cfg.p2p.Host()),
 					"gossip score": s.cfg.p2p.Peers().Scorers().GossipScorer().Score(pid),
+					"reason":       reason,
 				}).Debugf("Gossip message was ignored")
 			}
 			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
+			messageIgnoredReasonCounter.WithLabelValues(topic, reason).Inc()
 		}
 		return b
 	}
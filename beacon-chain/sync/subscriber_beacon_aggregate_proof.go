@@ -3,29 +3,31 @@ package sync
 import (
 	"context"
 	"errors"
-	"fmt"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/consensus-types/attestation"
 	"google.golang.org/protobuf/proto"
 )
 
 // beaconAggregateProofSubscriber forwards the incoming validated aggregated attestation and proof to the
 // attestation pool for processing.
 func (s *Service) beaconAggregateProofSubscriber(_ context.Context, msg proto.Message) error {
-	a, ok := msg.(*ethpb.SignedAggregateAttestationAndProof)
-	if !ok {
-		return fmt.Errorf("message was not type *eth.SignedAggregateAttestationAndProof, type=%T", msg)
+	a, err := attestation.NewSignedAggregateAttestationAndProof(msg)
+	if err != nil {
+		return err
 	}
-
-	if a.Message.Aggregate == nil || a.Message.Aggregate.Data == nil {
+	if a.IsNil() {
 		return errors.New("nil aggregate")
 	}
+	aggregate := a.Message().Aggregate()
+	att, err := aggregate.PbPhase0Attestation()
+	if err != nil {
+		return err
+	}
 
 	// An unaggregated attestation can make it here. It’s valid, the aggregator it just itself, although it means poor performance for the subnet.
-	if !helpers.IsAggregated(a.Message.Aggregate) {
-		return s.cfg.attPool.SaveUnaggregatedAttestation(a.Message.Aggregate)
+	if !aggregate.IsAggregated() {
+		return s.cfg.attPool.SaveUnaggregatedAttestation(att)
 	}
 
-	return s.cfg.attPool.SaveAggregatedAttestation(a.Message.Aggregate)
+	return s.cfg.attPool.SaveAggregatedAttestation(att)
 }
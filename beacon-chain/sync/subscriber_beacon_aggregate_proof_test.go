@@ -7,7 +7,6 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -21,7 +20,7 @@ func TestBeaconAggregateProofSubscriber_CanSaveAggregatedAttestation(t *testing.
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenUnAggregatedAttestationCache: lruwrpr.New(10),
+		seenUnAggregatedAttestationCache: newSeenCache("test", 10, 0),
 	}
 
 	a := &ethpb.SignedAggregateAttestationAndProof{
@@ -43,7 +42,7 @@ func TestBeaconAggregateProofSubscriber_CanSaveUnaggregatedAttestation(t *testin
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenUnAggregatedAttestationCache: lruwrpr.New(10),
+		seenUnAggregatedAttestationCache: newSeenCache("test", 10, 0),
 	}
 
 	a := &ethpb.SignedAggregateAttestationAndProof{
@@ -120,12 +120,12 @@ func TestService_BeaconBlockSubscribe_ExecutionEngineTimesOut(t *testing.T) {
 				ReceiveBlockMockErr: powchain.ErrHTTPTimeout,
 			},
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 	require.ErrorIs(t, powchain.ErrHTTPTimeout, s.beaconBlockSubscriber(context.Background(), util.NewBeaconBlock()))
 	require.Equal(t, 0, len(s.badBlockCache.Keys()))
-	require.Equal(t, 1, len(s.seenBlockCache.Keys()))
+	require.Equal(t, 1, s.seenBlockCache.Len())
 }
 
 func TestService_BeaconBlockSubscribe_UndefinedEeError(t *testing.T) {
@@ -138,10 +138,10 @@ func TestService_BeaconBlockSubscribe_UndefinedEeError(t *testing.T) {
 				ReceiveBlockMockErr: err,
 			},
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 	require.ErrorIs(t, s.beaconBlockSubscriber(context.Background(), util.NewBeaconBlock()), blockchain.ErrUndefinedExecutionEngineError)
 	require.Equal(t, 0, len(s.badBlockCache.Keys()))
-	require.Equal(t, 1, len(s.seenBlockCache.Keys()))
+	require.Equal(t, 1, s.seenBlockCache.Len())
 }
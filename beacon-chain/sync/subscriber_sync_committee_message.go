@@ -10,7 +10,7 @@ import (
 )
 
 // skipcq: SCC-U1000
-func (s *Service) syncCommitteeMessageSubscriber(_ context.Context, msg proto.Message) error {
+func (s *Service) syncCommitteeMessageSubscriber(ctx context.Context, msg proto.Message) error {
 	m, ok := msg.(*ethpb.SyncCommitteeMessage)
 	if !ok {
 		return fmt.Errorf("message was not type *eth.SyncCommitteeMessage, type=%T", msg)
@@ -20,5 +20,12 @@ func (s *Service) syncCommitteeMessageSubscriber(_ context.Context, msg proto.Me
 		return errors.New("nil sync committee message")
 	}
 
-	return s.cfg.syncCommsPool.SaveSyncCommitteeMessage(m)
+	if err := s.cfg.syncCommsPool.SaveSyncCommitteeMessage(m); err != nil {
+		return err
+	}
+	committeeIndices, err := s.cfg.chain.HeadSyncCommitteeIndices(ctx, m.ValidatorIndex, m.Slot)
+	if err != nil {
+		return errors.Wrap(err, "could not get sync committee indices")
+	}
+	return s.cfg.syncCommsPool.AggregateSyncCommitteeMessage(m, committeeIndices)
 }
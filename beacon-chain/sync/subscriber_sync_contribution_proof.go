@@ -22,5 +22,6 @@ func (s *Service) syncContributionAndProofSubscriber(_ context.Context, msg prot
 		return errors.New("nil contribution")
 	}
 
+	s.cfg.syncCommsPool.MarkSyncCommitteeContributionAggregator(sContr.Message.Contribution, sContr.Message.AggregatorIndex, false /* local */)
 	return s.cfg.syncCommsPool.SaveSyncCommitteeContribution(sContr.Message.Contribution)
 }
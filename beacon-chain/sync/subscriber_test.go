@@ -21,7 +21,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -188,7 +187,7 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 			chain:        chainService,
 			beaconDB:     d,
 		},
-		seenProposerSlashingCache: lruwrpr.New(10),
+		seenProposerSlashingCache: newSeenCache("test", 10, 0),
 		chainStarted:              abool.New(),
 		subHandler:                newSubTopicHandler(),
 	}
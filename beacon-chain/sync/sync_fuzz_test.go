@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,15 +14,23 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pb "github.com/libp2p/go-libp2p-pubsub/pb"
 	gcache "github.com/patrickmn/go-cache"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -69,7 +78,7 @@ func FuzzValidateBeaconBlockPubSub_Phase0(f *testing.F) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -150,7 +159,7 @@ func FuzzValidateBeaconBlockPubSub_Altair(f *testing.F) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -231,7 +240,7 @@ func FuzzValidateBeaconBlockPubSub_Bellatrix(f *testing.F) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -271,3 +280,149 @@ func FuzzValidateBeaconBlockPubSub_Bellatrix(f *testing.F) {
 		_ = err
 	})
 }
+
+func FuzzValidateGossipOperations(f *testing.F) {
+	db := dbtest.SetupDB(f)
+	p := p2ptest.NewFuzzTestP2P()
+	ctx := context.Background()
+	beaconState, _ := util.DeterministicGenesisStateAltair(f, 100)
+	parentBlock := util.NewBeaconBlockAltair()
+	util.SaveBlock(f, ctx, db, parentBlock)
+	bRoot, err := parentBlock.Block.HashTreeRoot()
+	require.NoError(f, err)
+	require.NoError(f, db.SaveState(ctx, beaconState, bRoot))
+	require.NoError(f, db.SaveStateSummary(ctx, &ethpb.StateSummary{Root: bRoot[:]}))
+
+	chainService := &mock.ChainService{
+		Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
+		State:   beaconState,
+		Root:    bRoot[:],
+		FinalizedCheckPoint: &ethpb.Checkpoint{
+			Epoch: 0,
+			Root:  make([]byte, 32),
+		},
+		ValidatorsRoot: [32]byte{'A'},
+		DB:             db,
+	}
+	r := &Service{
+		cfg: &config{
+			beaconDB:            db,
+			p2p:                 p,
+			initialSync:         &mockSync.Sync{IsSyncing: false},
+			chain:               chainService,
+			attPool:             attestations.NewPool(),
+			exitPool:            voluntaryexits.NewPool(),
+			slashingPool:        slashings.NewPool(),
+			syncCommsPool:       synccommittee.NewStore(),
+			blsToExecPool:       blstoexec.NewPool(),
+			attestationNotifier: chainService.OperationNotifier(),
+			operationNotifier:   chainService.OperationNotifier(),
+			stateGen:            stategen.New(db),
+		},
+		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+	}
+	r.initCaches()
+	digest, err := r.currentForkDigest()
+	require.NoError(f, err)
+
+	// Every gossip validator of operations is fed arbitrary bytes on its own topic, with an encoded
+	// message of the expected type as seed.
+	validators := []struct {
+		seed     ssz.Marshaler
+		validate func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error)
+	}{
+		{
+			seed: &ethpb.SignedAggregateAttestationAndProof{
+				Message: &ethpb.AggregateAttestationAndProof{
+					Aggregate:      util.HydrateAttestation(&ethpb.Attestation{}),
+					SelectionProof: make([]byte, fieldparams.BLSSignatureLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+			validate: r.validateAggregateAndProof,
+		},
+		{
+			seed:     util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11}}),
+			validate: r.validateCommitteeIndexBeaconAttestation,
+		},
+		{
+			seed: &ethpb.SignedVoluntaryExit{
+				Exit:      &ethpb.VoluntaryExit{},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+			validate: r.validateVoluntaryExit,
+		},
+		{
+			seed: &ethpb.ProposerSlashing{
+				Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+				Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+			},
+			validate: r.validateProposerSlashing,
+		},
+		{
+			seed: &ethpb.AttesterSlashing{
+				Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+				Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{}),
+			},
+			validate: r.validateAttesterSlashing,
+		},
+		{
+			seed: &ethpb.SyncCommitteeMessage{
+				BlockRoot: make([]byte, fieldparams.RootLength),
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+			validate: r.validateSyncCommitteeMessage,
+		},
+		{
+			seed: &ethpb.SignedContributionAndProof{
+				Message: &ethpb.ContributionAndProof{
+					Contribution: &ethpb.SyncCommitteeContribution{
+						BlockRoot:       make([]byte, fieldparams.RootLength),
+						AggregationBits: bitfield.NewBitvector128(),
+						Signature:       make([]byte, fieldparams.BLSSignatureLength),
+					},
+					SelectionProof: make([]byte, fieldparams.BLSSignatureLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+			validate: r.validateSyncContributionAndProof,
+		},
+		{
+			seed: &ethpb.SignedBLSToExecutionChange{
+				Message: &ethpb.BLSToExecutionChange{
+					FromBlsPubkey:      make([]byte, fieldparams.BLSPubkeyLength),
+					ToExecutionAddress: make([]byte, fieldparams.FeeRecipientLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+			validate: r.validateBlsToExecutionChange,
+		},
+	}
+	topics := make([]string, len(validators))
+	for i, v := range validators {
+		topic := p2p.GossipTypeMapping[reflect.TypeOf(v.seed)]
+		if strings.Count(topic, "%") > 1 {
+			topics[i] = r.addDigestAndIndexToTopic(topic, digest, 0)
+		} else {
+			topics[i] = r.addDigestToTopic(topic, digest)
+		}
+		topics[i] += p.Encoding().ProtocolSuffix()
+		buf := new(bytes.Buffer)
+		_, err := p.Encoding().EncodeGossip(buf, v.seed)
+		require.NoError(f, err)
+		f.Add(uint8(i), "junk", buf.Bytes())
+	}
+
+	f.Fuzz(func(t *testing.T, index uint8, pid string, data []byte) {
+		i := int(index) % len(validators)
+		topic := topics[i]
+		msg := &pubsub.Message{
+			Message: &pb.Message{
+				Data:  data,
+				Topic: &topic,
+			},
+		}
+		_, err := validators[i].validate(ctx, peer.ID(pid), msg)
+		_ = err
+	})
+}
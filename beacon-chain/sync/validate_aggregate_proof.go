@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/attestation"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -37,7 +38,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	// To process the following it requires the recent blocks to be present in the database, so we'll skip
 	// validating or processing aggregated attestations until fully synced.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -47,7 +48,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	raw, err := s.decodePubsubMessage(msg)
@@ -55,15 +56,16 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationReject, err
 	}
-	m, ok := raw.(*ethpb.SignedAggregateAttestationAndProof)
-	if !ok {
-		return pubsub.ValidationReject, errors.Errorf("invalid message type: %T", raw)
+	signed, err := attestation.NewSignedAggregateAttestationAndProof(raw)
+	if err != nil {
+		return pubsub.ValidationReject, errors.Wrap(err, "invalid message")
 	}
-	if m.Message == nil {
-		return pubsub.ValidationReject, errNilMessage
+	m, err := signed.PbPhase0SignedAggregateAttestationAndProof()
+	if err != nil {
+		return pubsub.ValidationReject, err
 	}
 	if err := helpers.ValidateNilAttestation(m.Message.Aggregate); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, err)
 	}
 	// Do not process slot 0 aggregates.
 	if m.Message.Aggregate.Data.Slot == 0 {
@@ -80,7 +82,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	})
 
 	if err := helpers.ValidateSlotTargetEpoch(m.Message.Aggregate.Data); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, err)
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
@@ -88,18 +90,18 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	if err := helpers.ValidateAttestationTime(m.Message.Aggregate.Data.Slot, s.cfg.chain.GenesisTime(),
 		earlyAttestationProcessingTolerance); err != nil {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(s.slotTimeReason(m.Message.Aggregate.Data.Slot), err)
 	}
 
 	// Verify this is the first aggregate received from the aggregator with index and slot.
 	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 	// Check that the block being voted on isn't invalid.
 	if s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Source.Root)) {
-		return pubsub.ValidationReject, errors.New("bad block referenced in attestation data")
+		return pubsub.ValidationReject, withReason(reasonBadBlock, errors.New("bad block referenced in attestation data"))
 	}
 
 	// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
@@ -109,10 +111,10 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore, err
 	}
 	if seen {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 	if !s.validateBlockInAttestation(ctx, m) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonUnknownBlock, nil)
 	}
 
 	validationRes, err := s.validateAggregatedAtt(ctx, m)
@@ -170,7 +172,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 	if err := validateIndexInCommittee(ctx, bs, signed.Message.Aggregate, signed.Message.AggregatorIndex); err != nil {
 		wrappedErr := errors.Wrapf(err, "Could not validate index in committee")
 		tracing.AnnotateError(span, wrappedErr)
-		return pubsub.ValidationReject, wrappedErr
+		return pubsub.ValidationReject, withReason(reasonInvalidCommittee, wrappedErr)
 	}
 
 	// Verify selection proof reflects to the right validator.
@@ -178,7 +180,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 	if err != nil {
 		wrappedErr := errors.Wrapf(err, "Could not validate selection for validator %d", signed.Message.AggregatorIndex)
 		tracing.AnnotateError(span, wrappedErr)
-		return pubsub.ValidationReject, wrappedErr
+		return pubsub.ValidationReject, withReason(reasonNotAggregator, wrappedErr)
 	}
 
 	// Verify selection signature, aggregator signature and attestation signature are valid.
@@ -218,7 +220,7 @@ func (s *Service) hasSeenAggregatorIndexEpoch(epoch types.Epoch, aggregatorIndex
 	s.seenAggregatedAttestationLock.RLock()
 	defer s.seenAggregatedAttestationLock.RUnlock()
 	b := append(bytesutil.Bytes32(uint64(epoch)), bytesutil.Bytes32(uint64(aggregatorIndex))...)
-	_, seen := s.seenAggregatedAttestationCache.Get(string(b))
+	seen := s.seenAggregatedAttestationCache.Seen(string(b))
 	return seen
 }
 
@@ -227,7 +229,7 @@ func (s *Service) setAggregatorIndexEpochSeen(epoch types.Epoch, aggregatorIndex
 	s.seenAggregatedAttestationLock.Lock()
 	defer s.seenAggregatedAttestationLock.Unlock()
 	b := append(bytesutil.Bytes32(uint64(epoch)), bytesutil.Bytes32(uint64(aggregatorIndex))...)
-	s.seenAggregatedAttestationCache.Add(string(b), true)
+	s.seenAggregatedAttestationCache.Add(string(b))
 }
 
 // This validates the aggregator's index in state is within the beacon committee.
@@ -235,6 +237,14 @@ func validateIndexInCommittee(ctx context.Context, bs state.ReadOnlyBeaconState,
 	ctx, span := trace.StartSpan(ctx, "sync.validateIndexInCommittee")
 	defer span.End()
 
+	// Use the assignments of the epoch when they were already computed for the validator duties.
+	if assignment, ok := helpers.CachedCommitteeAssignment(bs, a.Data.Slot, validatorIndex); ok {
+		if assignment == nil || assignment.AttesterSlot != a.Data.Slot || assignment.CommitteeIndex != a.Data.CommitteeIndex {
+			return fmt.Errorf("validator index %d is not within the committee of slot %d and index %d",
+				validatorIndex, a.Data.Slot, a.Data.CommitteeIndex)
+		}
+		return nil
+	}
 	committee, err := helpers.BeaconCommitteeFromState(ctx, bs, a.Data.Slot, a.Data.CommitteeIndex)
 	if err != nil {
 		return err
@@ -18,7 +18,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -79,6 +78,35 @@ func TestVerifyIndexInCommittee_ExistsInBeaconCommittee(t *testing.T) {
 	assert.ErrorContains(t, wanted, validateIndexInCommittee(ctx, s, att, 1000))
 }
 
+func TestVerifyIndexInCommittee_CachedAssignments(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+
+	s, _ := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, s.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	for i := uint64(0); i < uint64(s.Slot()); i++ {
+		require.NoError(t, s.UpdateBlockRootAtIndex(i, bytesutil.ToBytes32(bytesutil.Bytes8(i+1))))
+	}
+	committees, _, err := helpers.CommitteeAssignments(ctx, s.Copy(), 1)
+	require.NoError(t, err)
+	assignment := committees[0]
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{
+		Slot:           assignment.AttesterSlot,
+		CommitteeIndex: assignment.CommitteeIndex,
+		Target:         &ethpb.Checkpoint{Epoch: 1}},
+	}
+	require.NoError(t, validateIndexInCommittee(ctx, s, att, 0))
+
+	wanted := "validator index 1000 is not within the committee"
+	assert.ErrorContains(t, wanted, validateIndexInCommittee(ctx, s, att, 1000))
+	att.Data.CommitteeIndex++
+	wanted = "validator index 0 is not within the committee"
+	assert.ErrorContains(t, wanted, validateIndexInCommittee(ctx, s, att, 0))
+}
+
 func TestVerifySelection_NotAnAggregator(t *testing.T) {
 	ctx := context.Background()
 	params.SetupTestConfigCleanup(t)
@@ -112,7 +140,7 @@ func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
 	}
 	signedAggregateAndProof := &ethpb.SignedAggregateAttestationAndProof{Message: aggregateAndProof, Signature: make([]byte, fieldparams.BLSSignatureLength)}
 
-	c := lruwrpr.New(10)
+	c := newSeenCache("test", 10, 0)
 	r := &Service{
 		cfg: &config{
 			p2p:         p,
@@ -192,7 +220,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 	}
 	r.initCaches()
 
@@ -273,7 +301,7 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 				State: beaconState},
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 		blkRootToPendingAtts:           make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 	}
 	r.initCaches()
@@ -372,7 +400,7 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 		signatureChan:                  make(chan *signatureVerifier, verifierLimit),
 	}
 	r.initCaches()
@@ -474,7 +502,7 @@ func TestVerifyIndexInCommittee_SeenAggregatorEpoch(t *testing.T) {
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 		signatureChan:                  make(chan *signatureVerifier, verifierLimit),
 	}
 	r.initCaches()
@@ -587,7 +615,7 @@ func TestValidateAggregateAndProof_BadBlock(t *testing.T) {
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 	}
 	r.initCaches()
 	// Set beacon block as bad.
@@ -678,7 +706,7 @@ func TestValidateAggregateAndProof_RejectWhenAttEpochDoesntEqualTargetEpoch(t *t
 			attPool:             attestations.NewPool(),
 			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenAggregatedAttestationCache: lruwrpr.New(10),
+		seenAggregatedAttestationCache: newSeenCache("test", 10, 0),
 	}
 	r.initCaches()
 
@@ -725,7 +753,9 @@ func TestValidateAggregateAndProof_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateAggregateAndProof(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Validation should have ignored the message")
 }
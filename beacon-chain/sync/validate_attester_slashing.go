@@ -23,7 +23,7 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 
 	// The head state will be too far away to validate any slashing.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -33,7 +33,7 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateAttesterSlashing")
@@ -53,7 +53,7 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationReject, errNilMessage
 	}
 	if s.hasSeenAttesterSlashingIndices(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 
 	headState, err := s.cfg.chain.HeadState(ctx)
@@ -61,7 +61,7 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationIgnore, err
 	}
 	if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidOperation, err)
 	}
 
 	s.cfg.chain.ReceiveAttesterSlashing(ctx, slashing)
@@ -320,7 +320,9 @@ func TestValidateAttesterSlashing_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateAttesterSlashing(ctx, "foobar", msg)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Should have ignore this message")
 }
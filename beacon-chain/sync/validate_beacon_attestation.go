@@ -38,7 +38,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	// Attestation processing requires the target block to be present in the database, so we'll skip
 	// validating or processing attestations until fully synced.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -48,7 +48,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeIndexBeaconAttestation")
@@ -70,7 +70,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	if err := helpers.ValidateNilAttestation(att); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, err)
 	}
 	// Do not process slot 0 attestations.
 	if att.Data.Slot == 0 {
@@ -90,10 +90,10 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err := helpers.ValidateAttestationTime(att.Data.Slot, s.cfg.chain.GenesisTime(),
 		earlyAttestationProcessingTolerance); err != nil {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(s.slotTimeReason(att.Data.Slot), err)
 	}
 	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, err)
 	}
 
 	if features.Get().EnableSlasher {
@@ -127,14 +127,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 
 	// Reject an attestation if it references an invalid block.
 	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
-		return pubsub.ValidationReject, errors.New("attestation data references bad block root")
+		return pubsub.ValidationReject, withReason(reasonBadBlock, errors.New("attestation data references bad block root"))
 	}
 
 	// Verify the block being voted and the processed state is in beaconDB and the block has passed validation if it's in the beaconDB.
@@ -142,7 +142,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if !s.hasBlockAndState(ctx, blockRoot) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
 		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonUnknownBlock, nil)
 	}
 
 	if err := s.cfg.chain.VerifyFinalizedConsistency(ctx, att.Data.BeaconBlockRoot); err != nil {
@@ -189,7 +189,7 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 	}
 	count := helpers.SlotCommitteeCount(valCount)
 	if uint64(a.Data.CommitteeIndex) > count {
-		return pubsub.ValidationReject, errors.Errorf("committee index %d > %d", a.Data.CommitteeIndex, count)
+		return pubsub.ValidationReject, withReason(reasonInvalidCommittee, errors.Errorf("committee index %d > %d", a.Data.CommitteeIndex, count))
 	}
 	subnet := helpers.ComputeSubnetForAttestation(valCount, a)
 	format := p2p.GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]
@@ -199,7 +199,7 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 		return pubsub.ValidationIgnore, err
 	}
 	if !strings.HasPrefix(t, fmt.Sprintf(format, digest, subnet)) {
-		return pubsub.ValidationReject, errors.New("attestation's subnet does not match with pubsub topic")
+		return pubsub.ValidationReject, withReason(reasonInvalidSubnet, errors.New("attestation's subnet does not match with pubsub topic"))
 	}
 
 	return pubsub.ValidationAccept, nil
@@ -219,14 +219,14 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 
 	// Verify number of aggregation bits matches the committee size.
 	if err := helpers.VerifyBitfieldLength(a.AggregationBits, uint64(len(committee))); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, err)
 	}
 
 	// Attestation must be unaggregated and the bit index must exist in the range of committee indices.
 	// Note: The Ethereum Beacon chain spec suggests (len(get_attesting_indices(state, attestation.data, attestation.aggregation_bits)) == 1)
 	// however this validation can be achieved without use of get_attesting_indices which is an O(n) lookup.
 	if a.AggregationBits.Count() != 1 || a.AggregationBits.BitIndices()[0] >= len(committee) {
-		return pubsub.ValidationReject, errors.New("attestation bitfield is invalid")
+		return pubsub.ValidationReject, withReason(reasonInvalidMessage, errors.New("attestation bitfield is invalid"))
 	}
 
 	set, err := blocks.AttestationSignatureBatch(ctx, bs, []*eth.Attestation{a})
@@ -243,7 +243,7 @@ func (s *Service) hasSeenCommitteeIndicesSlot(slot types.Slot, committeeID types
 	defer s.seenUnAggregatedAttestationLock.RUnlock()
 	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(committeeID))...)
 	b = append(b, aggregateBits...)
-	_, seen := s.seenUnAggregatedAttestationCache.Get(string(b))
+	seen := s.seenUnAggregatedAttestationCache.Seen(string(b))
 	return seen
 }
 
@@ -253,7 +253,7 @@ func (s *Service) setSeenCommitteeIndicesSlot(slot types.Slot, committeeID types
 	defer s.seenUnAggregatedAttestationLock.Unlock()
 	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(committeeID))...)
 	b = append(b, bytesutil.SafeCopyBytes(aggregateBits)...)
-	s.seenUnAggregatedAttestationCache.Add(string(b), true)
+	s.seenUnAggregatedAttestationCache.Add(string(b))
 }
 
 // hasBlockAndState returns true if the beacon node knows about a block and associated state in the
@@ -18,7 +18,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
@@ -51,7 +50,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 			attestationNotifier: (&mockChain.ChainService{}).OperationNotifier(),
 		},
 		blkRootToPendingAtts:             make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
-		seenUnAggregatedAttestationCache: lruwrpr.New(10),
+		seenUnAggregatedAttestationCache: newSeenCache("test", 10, 0),
 		signatureChan:                    make(chan *signatureVerifier, verifierLimit),
 	}
 	s.initCaches()
@@ -332,7 +331,9 @@ func TestServiceValidateCommitteeIndexBeaconAttestation_Optimistic(t *testing.T)
 		},
 	}
 	res, err := r.validateCommitteeIndexBeaconAttestation(ctx, "foobar", msg)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Should have ignore this message")
 }
@@ -8,12 +8,14 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
@@ -44,7 +46,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	// We should not attempt to process blocks until fully synced, but propagation is OK.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconBlockPubSub")
@@ -81,7 +83,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		// Feed the block header to slasher if enabled. This action
 		// is done in the background to avoid adding more load to this critical code path.
 		go func() {
-			blockHeader, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+			blockHeader, err := blk.Header()
 			if err != nil {
 				log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Could not extract block header")
 			}
@@ -91,7 +93,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	// Verify the block is the first block received for the proposer for the slot.
 	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 
 	blockRoot, err := blk.Block().HashTreeRoot()
@@ -100,20 +102,20 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore, nil
 	}
 	if s.cfg.beaconDB.HasBlock(ctx, blockRoot) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 	// Check if parent is a bad block and then reject the block.
 	if s.hasBadBlock(bytesutil.ToBytes32(blk.Block().ParentRoot())) {
 		s.setBadBlock(ctx, blockRoot)
 		err := fmt.Errorf("received block with root %#x that has an invalid parent %#x", blockRoot, blk.Block().ParentRoot())
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Received block with an invalid parent")
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonBadBlock, err)
 	}
 
 	s.pendingQueueLock.RLock()
 	if s.seenPendingBlocks[blockRoot] {
 		s.pendingQueueLock.RUnlock()
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 	s.pendingQueueLock.RUnlock()
 
@@ -123,7 +125,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	genesisTime := uint64(s.cfg.chain.GenesisTime().Unix())
 	if err := slots.VerifyTime(genesisTime, blk.Block().Slot(), earlyBlockProcessingTolerance); err != nil {
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not verify slot time")
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(s.slotTimeReason(blk.Block().Slot()), nil)
 	}
 
 	// Add metrics for block arrival time subtracts slot start time.
@@ -141,7 +143,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	if startSlot >= blk.Block().Slot() {
 		err := fmt.Errorf("finalized slot %d greater or equal to block slot %d", startSlot, blk.Block().Slot())
 		log.WithFields(getBlockFields(blk)).Debug(err)
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(reasonPastSlot, err)
 	}
 
 	// Process the block if the clock jitter is less than MAXIMUM_GOSSIP_CLOCK_DISPARITY.
@@ -156,7 +158,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		s.pendingQueueLock.Unlock()
 		err := fmt.Errorf("early block, with current slot %d < block slot %d", s.cfg.chain.CurrentSlot(), blk.Block().Slot())
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not process early block")
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(reasonFutureSlot, err)
 	}
 
 	// Handle block when the parent is unknown.
@@ -170,7 +172,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		s.pendingQueueLock.Unlock()
 		err := errors.Errorf("unknown parent for block with slot %d and parent root %#x", blk.Block().Slot(), blk.Block().ParentRoot())
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not identify parent for block")
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(reasonUnknownBlock, err)
 	}
 
 	err = s.validateBeaconBlock(ctx, blk, blockRoot)
@@ -192,6 +194,14 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		return pubsub.ValidationIgnore, err
 	}
+	if s.cfg.blockArrivals != nil {
+		s.cfg.blockArrivals.Add(cache.BlockArrival{
+			Root:          blockRoot,
+			Slot:          blk.Block().Slot(),
+			ProposerIndex: blk.Block().ProposerIndex(),
+			Delay:         receivedTime.Sub(startTime),
+		})
+	}
 	log.WithFields(logrus.Fields{
 		"blockSlot":          blk.Block().Slot(),
 		"sinceSlotStartTime": receivedTime.Sub(startTime),
@@ -217,18 +227,29 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk interfaces.Signed
 			return err
 		}
 	}
-	parentState, err := s.cfg.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot()))
+	roParentState, err := s.cfg.stateGen.ReadOnlyStateByRoot(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot()))
 	if err != nil {
 		return err
 	}
 
-	if err := blocks.VerifyBlockSignatureUsingCurrentFork(parentState, blk); err != nil {
+	if err := blocks.VerifyBlockSignatureUsingCurrentFork(roParentState, blk); err != nil {
 		s.setBadBlock(ctx, blockRoot)
 		return err
 	}
 	// In the event the block is more than an epoch ahead from its
-	// parent state, we have to advance the state forward.
-	parentState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, parentState, blk.Block().ParentRoot(), blk.Block().Slot())
+	// parent state, we have to advance the state forward. The parent
+	// state is only copied when the next slot cache does not hold it.
+	parentState, err := transition.NextSlotState(ctx, blk.Block().ParentRoot())
+	if err != nil {
+		return err
+	}
+	if parentState == nil || parentState.IsNil() {
+		parentState, err = stategen.WritableCopy(roParentState)
+		if err != nil {
+			return err
+		}
+	}
+	parentState, err = transition.ProcessSlotsIfPossible(ctx, parentState, blk.Block().Slot())
 	if err != nil {
 		return err
 	}
@@ -310,7 +331,7 @@ func (s *Service) hasSeenBlockIndexSlot(slot types.Slot, proposerIdx types.Valid
 	s.seenBlockLock.RLock()
 	defer s.seenBlockLock.RUnlock()
 	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(proposerIdx))...)
-	_, seen := s.seenBlockCache.Get(string(b))
+	seen := s.seenBlockCache.Seen(string(b))
 	return seen
 }
 
@@ -319,7 +340,7 @@ func (s *Service) setSeenBlockIndexSlot(slot types.Slot, proposerIdx types.Valid
 	s.seenBlockLock.Lock()
 	defer s.seenBlockLock.Unlock()
 	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(proposerIdx))...)
-	s.seenBlockCache.Add(string(b), true)
+	s.seenBlockCache.Add(string(b))
 }
 
 // Returns true if the block is marked as a bad block.
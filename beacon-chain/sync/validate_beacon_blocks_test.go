@@ -14,6 +14,7 @@ import (
 	gcache "github.com/patrickmn/go-cache"
 	"github.com/prysmaticlabs/prysm/async/abool"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
@@ -81,7 +82,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -123,7 +124,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -142,7 +143,9 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 		},
 	}
 	res, err := r.validateBeaconBlockPubSub(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonDuplicate, reason)
 	assert.Equal(t, res, pubsub.ValidationIgnore, "block present in DB should be ignored")
 }
 
@@ -185,7 +188,7 @@ func TestValidateBeaconBlockPubSub_CanRecoverStateSummary(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -250,7 +253,7 @@ func TestValidateBeaconBlockPubSub_IsInCache(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -314,8 +317,9 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
+			blockArrivals: cache.NewBlockArrivalCache(),
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -338,6 +342,12 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	result := res == pubsub.ValidationAccept
 	assert.Equal(t, true, result)
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+	root, err := msg.Block.HashTreeRoot()
+	require.NoError(t, err)
+	arrival, ok := r.cfg.blockArrivals.Get(root)
+	require.Equal(t, true, ok)
+	assert.Equal(t, proposerIdx, arrival.ProposerIndex)
+	assert.Equal(t, types.Slot(1), arrival.Slot)
 }
 
 func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
@@ -382,7 +392,7 @@ func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -450,7 +460,7 @@ func TestValidateBeaconBlockPubSub_AdvanceEpochsForState(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -512,7 +522,9 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 		},
 	}
 	res, err := r.validateBeaconBlockPubSub(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	assert.Equal(t, res, pubsub.ValidationIgnore, "block is ignored until fully synced")
 }
 
@@ -556,7 +568,7 @@ func TestValidateBeaconBlockPubSub_IgnoreAndQueueBlocksFromNearFuture(t *testing
 			stateGen:      stateGen,
 		},
 		chainStarted:        abool.New(),
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -606,7 +618,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 		},
 		chainStarted:        abool.New(),
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -626,7 +638,9 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 		},
 	}
 	res, err := r.validateBeaconBlockPubSub(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonFutureSlot, reason)
 	assert.Equal(t, res, pubsub.ValidationIgnore, "block from the future should be ignored")
 }
 
@@ -658,7 +672,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -715,7 +729,7 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 			chain:         chainService,
 			blockNotifier: chainService.BlockNotifier(),
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -737,7 +751,9 @@ func TestValidateBeaconBlockPubSub_SeenProposerSlot(t *testing.T) {
 	r.setSeenBlockIndexSlot(msg.Block.Slot, msg.Block.ProposerIndex)
 	time.Sleep(10 * time.Millisecond) // Wait for cached value to pass through buffers.
 	res, err := r.validateBeaconBlockPubSub(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonDuplicate, reason)
 	assert.Equal(t, res, pubsub.ValidationIgnore, "seen proposer block should be ignored")
 }
 
@@ -766,7 +782,7 @@ func TestValidateBeaconBlockPubSub_FilterByFinalizedEpoch(t *testing.T) {
 			attPool:       attestations.NewPool(),
 			initialSync:   &mockSync.Sync{IsSyncing: false},
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -803,7 +819,9 @@ func TestValidateBeaconBlockPubSub_FilterByFinalizedEpoch(t *testing.T) {
 	}
 
 	res, err = r.validateBeaconBlockPubSub(context.Background(), "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonFutureSlot, reason)
 	assert.Equal(t, pubsub.ValidationIgnore, res)
 }
 
@@ -848,7 +866,7 @@ func TestValidateBeaconBlockPubSub_ParentNotFinalizedDescendant(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -913,7 +931,7 @@ func TestValidateBeaconBlockPubSub_InvalidParentBlock(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -1022,7 +1040,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromBadParent(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache:      lruwrpr.New(10),
+		seenBlockCache:      newSeenCache("test", 10, 0),
 		badBlockCache:       lruwrpr.New(10),
 		slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
 		seenPendingBlocks:   make(map[[32]byte]bool),
@@ -1124,7 +1142,7 @@ func TestValidateBeaconBlockPubSub_ValidExecutionPayload(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -1196,7 +1214,7 @@ func TestValidateBeaconBlockPubSub_InvalidPayloadTimestamp(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -1240,7 +1258,7 @@ func Test_validateBellatrixBeaconBlock(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -1298,7 +1316,7 @@ func Test_validateBellatrixBeaconBlockParentValidation(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -1355,7 +1373,7 @@ func Test_validateBeaconBlockProcessingWhenParentIsOptimistic(t *testing.T) {
 			blockNotifier: chainService.BlockNotifier(),
 			stateGen:      stateGen,
 		},
-		seenBlockCache: lruwrpr.New(10),
+		seenBlockCache: newSeenCache("test", 10, 0),
 		badBlockCache:  lruwrpr.New(10),
 	}
 
@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Clients who receive a BLS to execution change on this topic MUST validate the conditions within
+// process_bls_to_execution_change before forwarding it across the network. The first valid change of a
+// validator is the only one forwarded.
+func (s *Service) validateBlsToExecutionChange(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// The head state will be too far away to validate any BLS to execution change.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateBlsToExecutionChange")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	change, ok := m.(*ethpb.SignedBLSToExecutionChange)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if change.Message == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if s.cfg.blsToExecPool.ValidatorExists(change.Message.ValidatorIndex) {
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
+	}
+
+	headState, err := s.cfg.chain.HeadState(ctx)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	if _, err := blocks.ValidateBLSToExecutionChange(headState, change); err != nil {
+		return pubsub.ValidationReject, withReason(reasonInvalidOperation, err)
+	}
+	if err := blocks.VerifyBLSChangeSignature(headState, change); err != nil {
+		return pubsub.ValidationReject, withReason(reasonInvalidOperation, err)
+	}
+
+	msg.ValidatorData = change // Used in downstream subscriber
+
+	// Broadcast the change on a feed to notify other services in the beacon node
+	// of a received BLS to execution change.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.BLSToExecutionChangeReceived,
+		Data: &opfeed.BLSToExecutionChangeReceivedData{
+			Change: change,
+		},
+	})
+
+	return pubsub.ValidationAccept, nil
+}
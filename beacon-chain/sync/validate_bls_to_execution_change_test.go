@@ -0,0 +1,133 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func setupValidBlsToExecutionChange(t *testing.T) (*ethpb.SignedBLSToExecutionChange, state.BeaconState) {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	change := &ethpb.BLSToExecutionChange{
+		ValidatorIndex:     0,
+		FromBlsPubkey:      priv.PublicKey().Marshal(),
+		ToExecutionAddress: make([]byte, 20),
+	}
+	cred := hash.Hash(change.FromBlsPubkey)
+	cred[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
+		Validators:            []*ethpb.Validator{{WithdrawalCredentials: cred[:]}},
+		GenesisValidatorsRoot: make([]byte, 32),
+		Fork: &ethpb.Fork{
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		},
+	})
+	require.NoError(t, err)
+
+	c := params.BeaconConfig()
+	domain, err := signing.ComputeDomain(c.DomainBLSToExecutionChange, c.GenesisForkVersion, st.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(change, domain)
+	require.NoError(t, err)
+	return &ethpb.SignedBLSToExecutionChange{Message: change, Signature: priv.Sign(root[:]).Marshal()}, st
+}
+
+func TestValidateBlsToExecutionChange(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	change, s := setupValidBlsToExecutionChange(t)
+
+	tests := []struct {
+		name   string
+		change func() *ethpb.SignedBLSToExecutionChange
+		inPool bool
+		want   pubsub.ValidationResult
+	}{
+		{
+			name:   "valid",
+			change: func() *ethpb.SignedBLSToExecutionChange { return change },
+			want:   pubsub.ValidationAccept,
+		},
+		{
+			name:   "validator already in pool",
+			change: func() *ethpb.SignedBLSToExecutionChange { return change },
+			inPool: true,
+			want:   pubsub.ValidationIgnore,
+		},
+		{
+			name: "bad signature",
+			change: func() *ethpb.SignedBLSToExecutionChange {
+				c := ethpb.CopySignedBLSToExecutionChange(change)
+				c.Message.ToExecutionAddress = bytes.Repeat([]byte{1}, 20)
+				return c
+			},
+			want: pubsub.ValidationReject,
+		},
+		{
+			name: "unknown validator",
+			change: func() *ethpb.SignedBLSToExecutionChange {
+				c := ethpb.CopySignedBLSToExecutionChange(change)
+				c.Message.ValidatorIndex = 1
+				return c
+			},
+			want: pubsub.ValidationReject,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := blstoexec.NewPool()
+			if tt.inPool {
+				pool.InsertBLSToExecChange(change)
+			}
+			r := &Service{
+				cfg: &config{
+					p2p: p,
+					chain: &mock.ChainService{
+						State:   s,
+						Genesis: time.Now(),
+					},
+					initialSync:       &mockSync.Sync{IsSyncing: false},
+					operationNotifier: (&mock.ChainService{}).OperationNotifier(),
+					blsToExecPool:     pool,
+				},
+			}
+			msg := tt.change()
+			buf := new(bytes.Buffer)
+			_, err := p.Encoding().EncodeGossip(buf, msg)
+			require.NoError(t, err)
+			topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+			d, err := r.currentForkDigest()
+			require.NoError(t, err)
+			topic = r.addDigestToTopic(topic, d)
+			m := &pubsub.Message{
+				Message: &pubsubpb.Message{
+					Data:  buf.Bytes(),
+					Topic: &topic,
+				},
+			}
+			res, _ := r.validateBlsToExecutionChange(ctx, "", m)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -23,7 +23,7 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 
 	// The head state will be too far away to validate any slashing.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -33,7 +33,7 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateProposerSlashing")
@@ -54,7 +54,7 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationReject, errNilMessage
 	}
 	if s.hasSeenProposerSlashingIndex(slashing.Header_1.Header.ProposerIndex) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 
 	headState, err := s.cfg.chain.HeadState(ctx)
@@ -62,7 +62,7 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationIgnore, err
 	}
 	if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidOperation, err)
 	}
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
@@ -73,7 +73,7 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 func (s *Service) hasSeenProposerSlashingIndex(i types.ValidatorIndex) bool {
 	s.seenProposerSlashingLock.RLock()
 	defer s.seenProposerSlashingLock.RUnlock()
-	_, seen := s.seenProposerSlashingCache.Get(i)
+	seen := s.seenProposerSlashingCache.Seen(i)
 	return seen
 }
 
@@ -81,5 +81,5 @@ func (s *Service) hasSeenProposerSlashingIndex(i types.ValidatorIndex) bool {
 func (s *Service) setProposerSlashingIndexSeen(i types.ValidatorIndex) {
 	s.seenProposerSlashingLock.Lock()
 	defer s.seenProposerSlashingLock.Unlock()
-	s.seenProposerSlashingCache.Add(i, true)
+	s.seenProposerSlashingCache.Add(i)
 }
@@ -17,9 +17,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
@@ -45,7 +44,7 @@ func setupValidProposerSlashing(t *testing.T) (*ethpb.ProposerSlashing, state.Be
 	}
 
 	currentSlot := types.Slot(0)
-	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Validators: validators,
 		Slot:       currentSlot,
 		Balances:   validatorBalances,
@@ -119,7 +118,7 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 			chain:       &mock.ChainService{State: s, Genesis: time.Now()},
 			initialSync: &mockSync.Sync{IsSyncing: false},
 		},
-		seenProposerSlashingCache: lruwrpr.New(10),
+		seenProposerSlashingCache: newSeenCache("test", 10, 0),
 	}
 
 	buf := new(bytes.Buffer)
@@ -161,7 +160,7 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 			chain:       &mock.ChainService{State: st},
 			initialSync: &mockSync.Sync{IsSyncing: false},
 		},
-		seenProposerSlashingCache: lruwrpr.New(10),
+		seenProposerSlashingCache: newSeenCache("test", 10, 0),
 	}
 
 	buf := new(bytes.Buffer)
@@ -235,7 +234,9 @@ func TestValidateProposerSlashing_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateProposerSlashing(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Did not ignore the message")
 }
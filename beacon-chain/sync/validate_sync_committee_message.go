@@ -54,7 +54,7 @@ func (s *Service) validateSyncCommitteeMessage(
 
 	// Basic validations before proceeding.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -64,7 +64,7 @@ func (s *Service) validateSyncCommitteeMessage(
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	if msg.Topic == nil {
@@ -86,7 +86,7 @@ func (s *Service) validateSyncCommitteeMessage(
 		params.BeaconNetworkConfig().MaximumGossipClockDisparity,
 	); err != nil {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(s.slotTimeReason(m.Slot), err)
 	}
 
 	committeeIndices, err := s.cfg.chain.HeadSyncCommitteeIndices(ctx, m.ValidatorIndex, m.Slot)
@@ -128,12 +128,16 @@ func (s *Service) readSyncCommitteeMessage(msg *pubsub.Message) (*ethpb.SyncComm
 	return m, nil
 }
 
-// Mark all a slot and validator index as seen for every index in a committee and subnet.
+// Mark all a slot and validator index as seen for every index in a committee and subnet, and record
+// the message as received on these subnets for the aggregation quality of the sync committee pool.
 func (s *Service) markSyncCommitteeMessagesSeen(committeeIndices []types.CommitteeIndex, m *ethpb.SyncCommitteeMessage) {
 	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
 	for _, idx := range committeeIndices {
 		subnet := uint64(idx) / subCommitteeSize
 		s.setSeenSyncMessageIndexSlot(m.Slot, m.ValidatorIndex, subnet)
+		if s.cfg.syncCommsPool != nil {
+			s.cfg.syncCommsPool.MarkSyncCommitteeMessageReceived(m.Slot, m.ValidatorIndex, subnet)
+		}
 	}
 }
 
@@ -141,7 +145,7 @@ func (s *Service) markSyncCommitteeMessagesSeen(committeeIndices []types.Committ
 func (s *Service) hasSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.ValidatorIndex, subCommitteeIndex uint64) bool {
 	s.seenSyncMessageLock.RLock()
 	defer s.seenSyncMessageLock.RUnlock()
-	_, seen := s.seenSyncMessageCache.Get(seenSyncCommitteeKey(slot, valIndex, subCommitteeIndex))
+	seen := s.seenSyncMessageCache.Seen(seenSyncCommitteeKey(slot, valIndex, subCommitteeIndex))
 	return seen
 }
 
@@ -150,7 +154,7 @@ func (s *Service) setSeenSyncMessageIndexSlot(slot types.Slot, valIndex types.Va
 	s.seenSyncMessageLock.Lock()
 	defer s.seenSyncMessageLock.Unlock()
 	key := seenSyncCommitteeKey(slot, valIndex, subCommitteeIndex)
-	s.seenSyncMessageCache.Add(key, true)
+	s.seenSyncMessageCache.Add(key)
 }
 
 // The `subnet_id` is valid for the given validator. This implies the validator is part of the broader
@@ -185,7 +189,7 @@ func (s *Service) rejectIncorrectSyncCommittee(
 			}
 		}
 		if !isValid {
-			return pubsub.ValidationReject, errors.New("sync committee message references a different subnet")
+			return pubsub.ValidationReject, withReason(reasonInvalidSubnet, errors.New("sync committee message references a different subnet"))
 		}
 		return pubsub.ValidationAccept, nil
 	}
@@ -208,7 +212,7 @@ func (s *Service) ignoreHasSeenSyncMsg(
 			}
 		}
 		if !isValid {
-			return pubsub.ValidationIgnore, nil
+			return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 		}
 		return pubsub.ValidationAccept, nil
 	}
@@ -264,7 +268,7 @@ func (s *Service) rejectInvalidSyncCommitteeSignature(m *ethpb.SyncCommitteeMess
 func ignoreEmptyCommittee(indices []types.CommitteeIndex) validationFn {
 	return func(ctx context.Context) (pubsub.ValidationResult, error) {
 		if len(indices) == 0 {
-			return pubsub.ValidationIgnore, nil
+			return pubsub.ValidationIgnore, withReason(reasonInvalidCommittee, nil)
 		}
 		return pubsub.ValidationAccept, nil
 	}
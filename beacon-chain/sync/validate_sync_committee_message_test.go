@@ -589,7 +589,9 @@ func TestValidateSyncCommitteeMessage_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateCommitteeIndexBeaconAttestation(ctx, "foobar", msg)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Should have ignore this message")
 }
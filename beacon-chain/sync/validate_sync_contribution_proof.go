@@ -49,7 +49,7 @@ func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer
 
 	// Ignore the sync committee contribution if the beacon node is syncing.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -59,7 +59,7 @@ func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	m, err := s.readSyncContributionMessage(msg)
@@ -71,7 +71,7 @@ func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer
 	// The contribution's slot is for the current slot (with a `MAXIMUM_GOSSIP_CLOCK_DISPARITY` allowance).
 	if err := altair.ValidateSyncMessageTime(m.Message.Contribution.Slot, s.cfg.chain.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return pubsub.ValidationIgnore, withReason(s.slotTimeReason(m.Message.Contribution.Slot), err)
 	}
 	// Validate the message's data according to the p2p specification.
 	if result, err := validationPipeline(
@@ -132,7 +132,7 @@ func rejectIncorrectSubcommitteeIndex(
 		defer span.End()
 		// The subcommittee index is in the allowed range, i.e. `contribution.subcommittee_index < SYNC_COMMITTEE_SUBNET_COUNT`.
 		if m.Message.Contribution.SubcommitteeIndex >= params.BeaconConfig().SyncCommitteeSubnetCount {
-			return pubsub.ValidationReject, errors.New("subcommittee index is invalid")
+			return pubsub.ValidationReject, withReason(reasonInvalidCommittee, errors.New("subcommittee index is invalid"))
 		}
 
 		return pubsub.ValidationAccept, nil
@@ -159,11 +159,11 @@ func (s *Service) ignoreSeenSyncContribution(m *ethpb.SignedContributionAndProof
 			return pubsub.ValidationIgnore, err
 		}
 		if seen {
-			return pubsub.ValidationIgnore, nil
+			return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 		}
 		seen = s.hasSeenSyncContributionIndexSlot(c.Slot, m.Message.AggregatorIndex, types.CommitteeIndex(c.SubcommitteeIndex))
 		if seen {
-			return pubsub.ValidationIgnore, nil
+			return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 		}
 		return pubsub.ValidationAccept, nil
 	}
@@ -173,7 +173,7 @@ func rejectInvalidAggregator(m *ethpb.SignedContributionAndProof) validationFn {
 	return func(ctx context.Context) (pubsub.ValidationResult, error) {
 		// The `contribution_and_proof.selection_proof` selects the validator as an aggregator for the slot.
 		if isAggregator, err := altair.IsSyncCommitteeAggregator(m.Message.SelectionProof); err != nil || !isAggregator {
-			return pubsub.ValidationReject, err
+			return pubsub.ValidationReject, withReason(reasonNotAggregator, err)
 		}
 		return pubsub.ValidationAccept, nil
 	}
@@ -202,7 +202,7 @@ func (s *Service) rejectInvalidIndexInSubCommittee(m *ethpb.SignedContributionAn
 			}
 		}
 		if !isValid {
-			return pubsub.ValidationReject, errors.New("invalid subcommittee index")
+			return pubsub.ValidationReject, withReason(reasonInvalidCommittee, errors.New("invalid subcommittee index"))
 		}
 		return pubsub.ValidationAccept, nil
 	}
@@ -317,7 +317,7 @@ func (s *Service) hasSeenSyncContributionIndexSlot(slot types.Slot, aggregatorIn
 
 	b := append(bytesutil.Bytes32(uint64(aggregatorIndex)), bytesutil.Bytes32(uint64(slot))...)
 	b = append(b, bytesutil.Bytes32(uint64(subComIdx))...)
-	_, seen := s.seenSyncContributionCache.Get(string(b))
+	seen := s.seenSyncContributionCache.Seen(string(b))
 	return seen
 }
 
@@ -327,7 +327,7 @@ func (s *Service) setSyncContributionIndexSlotSeen(slot types.Slot, aggregatorIn
 	defer s.seenSyncContributionLock.Unlock()
 	b := append(bytesutil.Bytes32(uint64(aggregatorIndex)), bytesutil.Bytes32(uint64(slot))...)
 	b = append(b, bytesutil.Bytes32(uint64(subComIdx))...)
-	s.seenSyncContributionCache.Add(string(b), true)
+	s.seenSyncContributionCache.Add(string(b))
 }
 
 // Set sync contribution's slot, root, committee index and bits.
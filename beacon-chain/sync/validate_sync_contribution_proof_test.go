@@ -1055,7 +1055,9 @@ func TestValidateSyncContributionAndProof_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateCommitteeIndexBeaconAttestation(ctx, "foobar", msg)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Should have ignore this message")
 }
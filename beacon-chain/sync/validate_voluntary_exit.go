@@ -26,7 +26,7 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 
 	// The head state will be too far away to validate any voluntary exit.
 	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	// We should not attempt to process this message if the node is running in optimistic mode.
@@ -36,7 +36,7 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return pubsub.ValidationReject, err
 	}
 	if optimistic {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonNotSynced, nil)
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateVoluntaryExit")
@@ -57,7 +57,7 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return pubsub.ValidationReject, errNilMessage
 	}
 	if s.hasSeenExitIndex(exit.Exit.ValidatorIndex) {
-		return pubsub.ValidationIgnore, nil
+		return pubsub.ValidationIgnore, withReason(reasonDuplicate, nil)
 	}
 
 	headState, err := s.cfg.chain.HeadState(ctx)
@@ -73,7 +73,7 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return pubsub.ValidationIgnore, err
 	}
 	if err := blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorsRoot()); err != nil {
-		return pubsub.ValidationReject, err
+		return pubsub.ValidationReject, withReason(reasonInvalidOperation, err)
 	}
 
 	msg.ValidatorData = exit // Used in downstream subscriber
@@ -94,7 +94,7 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 func (s *Service) hasSeenExitIndex(i types.ValidatorIndex) bool {
 	s.seenExitLock.RLock()
 	defer s.seenExitLock.RUnlock()
-	_, seen := s.seenExitCache.Get(i)
+	seen := s.seenExitCache.Seen(i)
 	return seen
 }
 
@@ -102,5 +102,5 @@ func (s *Service) hasSeenExitIndex(i types.ValidatorIndex) bool {
 func (s *Service) setExitIndexSeen(i types.ValidatorIndex) {
 	s.seenExitLock.Lock()
 	defer s.seenExitLock.Unlock()
-	s.seenExitCache.Add(i, true)
+	s.seenExitCache.Add(i)
 }
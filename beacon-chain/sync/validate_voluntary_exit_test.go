@@ -18,9 +18,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
-	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -41,7 +40,7 @@ func setupValidExit(t *testing.T) (*ethpb.SignedVoluntaryExit, state.BeaconState
 			ActivationEpoch: 0,
 		},
 	}
-	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+	st, err := statenative.InitializeFromProtoPhase0(&ethpb.BeaconState{
 		Validators: registry,
 		Fork: &ethpb.Fork{
 			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
@@ -86,7 +85,7 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 			initialSync:       &mockSync.Sync{IsSyncing: false},
 			operationNotifier: (&mock.ChainService{}).OperationNotifier(),
 		},
-		seenExitCache: lruwrpr.New(10),
+		seenExitCache: newSeenCache("test", 10, 0),
 	}
 
 	buf := new(bytes.Buffer)
@@ -146,7 +145,7 @@ func TestValidateVoluntaryExit_InvalidExitSlot(t *testing.T) {
 			},
 			initialSync: &mockSync.Sync{IsSyncing: false},
 		},
-		seenExitCache: lruwrpr.New(10),
+		seenExitCache: newSeenCache("test", 10, 0),
 	}
 
 	buf := new(bytes.Buffer)
@@ -223,7 +222,9 @@ func TestValidateVoluntaryExit_Optimistic(t *testing.T) {
 		},
 	}
 	res, err := r.validateVoluntaryExit(ctx, "", m)
+	reason, err := validationReason(err)
 	assert.NoError(t, err)
+	assert.Equal(t, reasonNotSynced, reason)
 	valid := res == pubsub.ValidationIgnore
 	assert.Equal(t, true, valid, "Validation should have ignored the message")
 }
@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// Reasons reported when a gossip message is rejected or ignored by its validator.
+const (
+	reasonInvalidMessage   = "invalid_message"
+	reasonBadSignature     = "bad_signature"
+	reasonFutureSlot       = "future_slot"
+	reasonPastSlot         = "past_slot"
+	reasonUnknownBlock     = "unknown_block"
+	reasonBadBlock         = "bad_block"
+	reasonDuplicate        = "duplicate"
+	reasonNotSynced        = "not_synced"
+	reasonInvalidCommittee = "invalid_committee"
+	reasonInvalidSubnet    = "invalid_subnet"
+	reasonNotAggregator    = "not_aggregator"
+	reasonInvalidOperation = "invalid_operation"
+	reasonOther            = "other"
+)
+
+// validationError labels the error of a gossip validator with the reason of the failure, which is
+// reported in the validation metrics.
+type validationError struct {
+	reason string
+	err    error
+}
+
+func (e *validationError) Error() string {
+	if e.err == nil {
+		return e.reason
+	}
+	return e.err.Error()
+}
+
+func (e *validationError) Unwrap() error {
+	return e.err
+}
+
+// withReason labels the given error with the reason of a validation failure. The error may be nil for
+// the expected failures which are not worth logging, as duplicate messages.
+func withReason(reason string, err error) error {
+	return &validationError{reason: reason, err: err}
+}
+
+// validationReason returns the reason of the validation failure of the given error, and the error to
+// log if any.
+func validationReason(err error) (string, error) {
+	if err == nil {
+		return reasonOther, nil
+	}
+	var vErr *validationError
+	if errors.As(err, &vErr) {
+		if vErr.err == nil {
+			return vErr.reason, nil
+		}
+		return vErr.reason, err
+	}
+	switch {
+	case errors.Is(err, errGossipPrefilter), errors.Is(err, errNilPubsubMessage), errors.Is(err, errInvalidTopic),
+		errors.Is(err, errWrongMessage), errors.Is(err, errNilMessage), errors.Is(err, p2p.ErrMessageNotMapped):
+		return reasonInvalidMessage, err
+	case errors.Is(err, signing.ErrSigFailedToVerify):
+		return reasonBadSignature, err
+	}
+	return reasonOther, err
+}
+
+// slotTimeReason returns the reason of a message of the given slot failing its time checks.
+func (s *Service) slotTimeReason(slot types.Slot) string {
+	if slot > slots.CurrentSlot(uint64(s.cfg.chain.GenesisTime().Unix())) {
+		return reasonFutureSlot
+	}
+	return reasonPastSlot
+}
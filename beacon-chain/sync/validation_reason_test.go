@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestValidationReason(t *testing.T) {
+	wantedErr := errors.New("bad")
+	tests := []struct {
+		name       string
+		err        error
+		wantReason string
+		wantErr    error
+	}{
+		{
+			name:       "nil error",
+			err:        nil,
+			wantReason: reasonOther,
+		},
+		{
+			name:       "reason without error",
+			err:        withReason(reasonDuplicate, nil),
+			wantReason: reasonDuplicate,
+		},
+		{
+			name:       "wrapped reason",
+			err:        errors.Wrap(withReason(reasonUnknownBlock, wantedErr), "could not validate"),
+			wantReason: reasonUnknownBlock,
+			wantErr:    wantedErr,
+		},
+		{
+			name:       "invalid message",
+			err:        errors.Wrap(errNilPubsubMessage, "could not decode"),
+			wantReason: reasonInvalidMessage,
+			wantErr:    errNilPubsubMessage,
+		},
+		{
+			name:       "bad signature",
+			err:        signing.ErrSigFailedToVerify,
+			wantReason: reasonBadSignature,
+			wantErr:    signing.ErrSigFailedToVerify,
+		},
+		{
+			name:       "unlabeled error",
+			err:        wantedErr,
+			wantReason: reasonOther,
+			wantErr:    wantedErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := validationReason(tt.err)
+			assert.Equal(t, tt.wantReason, reason)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, true, errors.Is(err, tt.wantErr))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package flags
 
 import (
 	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/urfave/cli/v2"
@@ -16,12 +17,26 @@ var (
 		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
 		Value: "",
 	}
+	// BuilderRegistrationRefreshInterval defines how often the validator registrations are resubmitted to the MEV relay.
+	BuilderRegistrationRefreshInterval = &cli.DurationFlag{
+		Name: "builder-registration-refresh-interval",
+		Usage: "How often the validator registrations stored by the beacon node are resubmitted to the MEV relay, " +
+			"so that the relay keeps them across restarts. 0 disables the refresh.",
+		Value: 384 * time.Second,
+	}
 	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
 		Name:  "http-web3provider",
 		Usage: "A mainchain web3 provider string http endpoint. Can contain auth header as well in the format --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Basic xxx\" for project secret (base64 encoded) and --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Bearer xxx\" for jwt use",
 		Value: "http://localhost:8545",
 	}
+	// ExecutionEndpointFlag provides the HTTP access endpoints of execution clients, in order of preference.
+	ExecutionEndpointFlag = &cli.StringSliceFlag{
+		Name: "execution-endpoint",
+		Usage: "An execution client http endpoint, this flag may be used multiple times. The first endpoint is the primary " +
+			"one, the beacon node falls back to the next endpoints when it is unhealthy and returns to it once it recovers. " +
+			"Takes precedence over --http-web3provider and --fallback-web3provider",
+	}
 	// ExecutionJWTSecretFlag provides a path to a file containing a hex-encoded string representing a 32 byte secret
 	// used to authenticate with an execution node via HTTP. This is required if using an HTTP connection, otherwise all requests
 	// to execution nodes for consensus-related calls will fail. This is not required if using an IPC connection.
@@ -96,6 +111,23 @@ var (
 		Usage: "The port on which the gateway server runs on",
 		Value: 3500,
 	}
+	// GRPCGatewaySocket specifies a unix domain socket to serve the gRPC gateway on.
+	GRPCGatewaySocket = &cli.StringFlag{
+		Name: "grpc-gateway-socket",
+		Usage: "Path of a unix domain socket on which the gateway server runs on, instead of " +
+			"--grpc-gateway-host and --grpc-gateway-port",
+	}
+	// GRPCGatewaySocketActivation serves the gRPC gateway on a socket passed by the service manager.
+	GRPCGatewaySocketActivation = &cli.BoolFlag{
+		Name: "grpc-gateway-socket-activation",
+		Usage: "Run the gateway server on the socket passed by the service manager, such as a systemd " +
+			"socket unit, instead of --grpc-gateway-host and --grpc-gateway-port",
+	}
+	// GRPCGatewayPathPrefix specifies a path prefix under which the gRPC gateway endpoints are served.
+	GRPCGatewayPathPrefix = &cli.StringFlag{
+		Name:  "grpc-gateway-path-prefix",
+		Usage: "Path prefix under which all the gateway endpoints are served, for example /beacon behind a reverse proxy",
+	}
 	// GPRCGatewayCorsDomain serves preflight requests when serving gRPC JSON gateway.
 	GPRCGatewayCorsDomain = &cli.StringFlag{
 		Name: "grpc-gateway-corsdomain",
@@ -110,6 +142,24 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// HeadLagAlertThreshold specifies how many slots the head may fall behind the wall clock before raising an alert.
+	HeadLagAlertThreshold = &cli.IntFlag{
+		Name:  "head-lag-alert-threshold",
+		Usage: "The number of slots the head block may fall behind the current slot before the node raises a lagging head alert. 0 disables the alert.",
+		Value: 4,
+	}
+	// SeenCacheSizeMultiplier scales the sizes of the caches of gossip objects already seen by the node.
+	SeenCacheSizeMultiplier = &cli.IntFlag{
+		Name: "seen-cache-size-multiplier",
+		Usage: "Multiplies the sizes of the caches of gossip objects already seen by the node. Nodes subscribed to " +
+			"many subnets or serving many validators can raise it, so that duplicates are not mistaken for unseen objects.",
+		Value: 1,
+	}
+	// SeenCacheTTL bounds how long a gossip object is remembered as seen.
+	SeenCacheTTL = &cli.DurationFlag{
+		Name:  "seen-cache-ttl",
+		Usage: "How long a gossip object is remembered as seen by the node, in addition to the size bound of its cache. 0 disables the expiry.",
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = &cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -142,6 +192,35 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// StateRetention specifies which finalized states are kept in the beaconDB.
+	StateRetention = &cli.StringFlag{
+		Name: "state-retention",
+		Usage: "Finalized states kept in the beaconDB: archival (epoch boundary and archive point states), " +
+			"default (canonical epoch boundary and archive point states) or minimal (only the latest finalized state). " +
+			"Finalized states are only pruned from the beaconDB when this flag is set.",
+		Value: "default",
+	}
+	// HotStateSnapshotInterval specifies the number of slots between two snapshots of the hot states kept in memory.
+	HotStateSnapshotInterval = &cli.Uint64Flag{
+		Name: "hot-state-snapshot-interval",
+		Usage: "The number of slots between two full copies of the unfinalized states kept in memory to shorten " +
+			"the replay of recent states, at most 8 are kept. Disabled if 0.",
+		Value: 0,
+	}
+	// FreezerDir specifies the directory of the freezer holding finalized blocks and states in era files.
+	FreezerDir = &cli.StringFlag{
+		Name: "freezer-dir",
+		Usage: "Directory of the era files holding finalized blocks and states moved out of the beaconDB. " +
+			"Finalized blocks are kept in the beaconDB if empty.",
+		Value: "",
+	}
+	// BlobRetentionEpochs specifies the number of epochs blob sidecars are kept in the beaconDB.
+	BlobRetentionEpochs = &cli.Uint64Flag{
+		Name: "blob-retention-epochs",
+		Usage: "The number of epochs blob sidecars are kept in the beaconDB before being pruned. " +
+			"Must be at least MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS.",
+		Value: uint64(params.BeaconNetworkConfig().MinEpochsForBlobSidecarsRequest),
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -175,11 +254,44 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation and sync subnets.",
 	}
+	// EnableInvariantChecks runs a background service checking the consistency of the node's view of the chain.
+	EnableInvariantChecks = &cli.BoolFlag{
+		Name:  "enable-invariant-checks",
+		Usage: "Periodically checks chain invariants such as finalized checkpoint monotonicity and head consistency between caches and DB, reporting violations through logs and metrics.",
+	}
+	// ProfileSnapshotDir enables the profiler, capturing heap and goroutine profiles to the given directory.
+	ProfileSnapshotDir = &cli.StringFlag{
+		Name:  "profile-snapshot-dir",
+		Usage: "Watches the memory and goroutine counts of the beacon node, and captures heap and goroutine profiles to this directory when they cross their thresholds. Snapshots can also be triggered through the debug RPC endpoints.",
+	}
+	// ProfileHeapThreshold specifies the heap size triggering a profile snapshot.
+	ProfileHeapThreshold = &cli.Uint64Flag{
+		Name:  "profile-heap-threshold-mb",
+		Usage: "The size of the allocated heap, in megabytes, above which a profile snapshot is captured. Zero disables the check.",
+	}
+	// ProfileGoroutineThreshold specifies the number of goroutines triggering a profile snapshot.
+	ProfileGoroutineThreshold = &cli.IntFlag{
+		Name:  "profile-goroutine-threshold",
+		Usage: "The number of goroutines above which a profile snapshot is captured. Zero disables the check.",
+	}
+	// MaxMemory specifies the memory budget enforced by the memory governor.
+	MaxMemory = &cli.Uint64Flag{
+		Name:  "max-memory",
+		Usage: "The memory budget of the beacon node, in megabytes. When the allocated heap approaches the budget, caches are shrunk, sync batches are throttled and API requests are deferred. Zero disables the memory governor.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
 		Usage: "Enables required flags for serving historical data to a slasher client. Results in additional storage usage",
 	}
+	// SlasherDBBackend specifies the key-value engine of the slasher database.
+	SlasherDBBackend = &cli.StringFlag{
+		Name: "slasher-db-backend",
+		Usage: "The key-value engine storing the slasher database, either bolt or leveldb. The leveldb log-structured merge tree " +
+			"handles the write-heavy min and max span updates of large validator sets better than the bolt B+tree. " +
+			"Switching engines starts from an empty slasher database",
+		Value: "bolt",
+	}
 	// ChainID defines a flag to set the chain id. If none is set, it derives this value from NetworkConfig
 	ChainID = &cli.Uint64Flag{
 		Name:  "chain-id",
@@ -190,6 +302,21 @@ var (
 		Name:  "network-id",
 		Usage: "Sets the network id of the beacon chain.",
 	}
+	// AltairForkEpoch overrides the altair fork epoch of the chain config.
+	AltairForkEpoch = &cli.Uint64Flag{
+		Name:  "altair-fork-epoch",
+		Usage: "Overrides the epoch of the altair fork set in the chain config.",
+	}
+	// BellatrixForkEpoch overrides the bellatrix fork epoch of the chain config.
+	BellatrixForkEpoch = &cli.Uint64Flag{
+		Name:  "bellatrix-fork-epoch",
+		Usage: "Overrides the epoch of the bellatrix fork set in the chain config.",
+	}
+	// CapellaForkEpoch overrides the capella fork epoch of the chain config.
+	CapellaForkEpoch = &cli.Uint64Flag{
+		Name:  "capella-fork-epoch",
+		Usage: "Overrides the epoch of the capella fork set in the chain config.",
+	}
 	// Eth1HeaderReqLimit defines a flag to set the maximum number of headers that a deposit log query can fetch. If none is set, 1000 will be the limit.
 	Eth1HeaderReqLimit = &cli.Uint64Flag{
 		Name:  "eth1-header-req-limit",
@@ -1,7 +1,10 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/container/slice"
 	"github.com/urfave/cli/v2"
 )
 
@@ -14,8 +17,11 @@ type GlobalFlags struct {
 	SubscribeToAllSubnets      bool
 	MinimumSyncPeers           int
 	MinimumPeersPerSubnet      int
+	HeadLagAlertThreshold      int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	SeenCacheSizeMultiplier    int
+	SeenCacheTTL               time.Duration
 }
 
 var globalConfig *GlobalFlags
@@ -53,6 +59,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
+	cfg.HeadLagAlertThreshold = ctx.Int(HeadLagAlertThreshold.Name)
+	cfg.SeenCacheSizeMultiplier = ctx.Int(SeenCacheSizeMultiplier.Name)
+	cfg.SeenCacheTTL = ctx.Duration(SeenCacheTTL.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -65,4 +74,10 @@ func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 		log.Warnf("Changing Minimum Sync Peers to %d", maxPeers)
 		cfg.MinimumSyncPeers = maxPeers
 	}
+	// In sentry mode the node can only sync from its sentries.
+	sentries := len(slice.SplitCommaSeparated(ctx.StringSlice(cmd.SentryPeers.Name)))
+	if sentries > 0 && cfg.MinimumSyncPeers > sentries {
+		log.Warnf("Changing Minimum Sync Peers to %d, the number of sentry peers", sentries)
+		cfg.MinimumSyncPeers = sentries
+	}
 }
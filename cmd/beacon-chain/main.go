@@ -38,6 +38,7 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.ExecutionEndpointFlag,
 	flags.ExecutionJWTSecretFlag,
 	flags.FallbackWeb3ProviderFlag,
 	flags.RPCHost,
@@ -48,8 +49,14 @@ var appFlags = []cli.Flag{
 	flags.DisableGRPCGateway,
 	flags.GRPCGatewayHost,
 	flags.GRPCGatewayPort,
+	flags.GRPCGatewaySocket,
+	flags.GRPCGatewaySocketActivation,
+	flags.GRPCGatewayPathPrefix,
 	flags.GPRCGatewayCorsDomain,
 	flags.MinSyncPeers,
+	flags.HeadLagAlertThreshold,
+	flags.SeenCacheSizeMultiplier,
+	flags.SeenCacheTTL,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.HeadSync,
@@ -62,11 +69,24 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateRetention,
+	flags.HotStateSnapshotInterval,
+	flags.FreezerDir,
+	flags.BlobRetentionEpochs,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
+	flags.SlasherDBBackend,
+	flags.EnableInvariantChecks,
+	flags.ProfileSnapshotDir,
+	flags.ProfileHeapThreshold,
+	flags.ProfileGoroutineThreshold,
+	flags.MaxMemory,
 	flags.ChainID,
 	flags.NetworkID,
+	flags.AltairForkEpoch,
+	flags.BellatrixForkEpoch,
+	flags.CapellaForkEpoch,
 	flags.WeakSubjectivityCheckpoint,
 	flags.Eth1HeaderReqLimit,
 	flags.MinPeersPerSubnet,
@@ -75,6 +95,7 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.BuilderRegistrationRefreshInterval,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -83,9 +104,13 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.SentryPeers,
+	cmd.P2PStaticPeers,
+	cmd.P2PTrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
+	cmd.P2PQUICPort,
 	cmd.P2PIP,
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
@@ -94,6 +119,14 @@ var appFlags = []cli.Flag{
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
+	cmd.P2PMaxPeersPerIP,
+	cmd.P2PMaxPeersPerSubnet,
+	cmd.P2PMaxPeersPerASN,
+	cmd.P2PASNDatabase,
+	cmd.P2PColocationAllowList,
+	cmd.P2PDisableIdentifyPush,
+	cmd.P2PEnableIdentifyDelta,
+	cmd.P2PUserAgent,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -125,6 +158,7 @@ var appFlags = []cli.Flag{
 	cmd.RestoreTargetDirFlag,
 	cmd.BoltMMapInitialSizeFlag,
 	cmd.ValidatorMonitorIndicesFlag,
+	cmd.ValidatorMonitorWebhookFlag,
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
@@ -194,6 +228,9 @@ func main() {
 		if err := cmd.ExpandWeb3EndpointsIfFile(ctx, flags.FallbackWeb3ProviderFlag); err != nil {
 			return err
 		}
+		if err := cmd.ExpandWeb3EndpointsIfFile(ctx, flags.ExecutionEndpointFlag); err != nil {
+			return err
+		}
 		if ctx.IsSet(flags.SetGCPercent.Name) {
 			runtimeDebug.SetGCPercent(ctx.Int(flags.SetGCPercent.Name))
 		}
@@ -64,6 +64,9 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 }
 
 func parsePowchainEndpoints(c *cli.Context) []string {
+	if endpoints := c.StringSlice(flags.ExecutionEndpointFlag.Name); len(endpoints) > 0 {
+		return endpoints
+	}
 	if c.String(flags.HTTPWeb3ProviderFlag.Name) == "" && len(c.StringSlice(flags.FallbackWeb3ProviderFlag.Name)) == 0 {
 		log.Error(
 			"No ETH1 node specified to run with the beacon node. " +
@@ -72,7 +75,7 @@ func parsePowchainEndpoints(c *cli.Context) []string {
 				"https://docs.prylabs.network/docs/prysm-usage/setup-eth1 for more information",
 		)
 		log.Error(
-			"You will need to specify --http-web3provider and/or --fallback-web3provider to attach " +
+			"You will need to specify --execution-endpoint, or --http-web3provider and/or --fallback-web3provider to attach " +
 				"an eth1 node to the prysm node. Without an eth1 node block proposals for your " +
 				"validator will be affected and the beacon node will not be able to initialize the genesis state",
 		)
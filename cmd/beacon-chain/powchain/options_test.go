@@ -31,6 +31,20 @@ func TestPowchainCmd(t *testing.T) {
 	assert.DeepEqual(t, []string{"primary", "fallback1", "fallback2"}, endpoints)
 }
 
+func TestPowchainCmd_ExecutionEndpoints(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.HTTPWeb3ProviderFlag.Name, "primary", "")
+	executionEndpoints := cli.StringSlice{}
+	require.NoError(t, executionEndpoints.Set("execution1"))
+	require.NoError(t, executionEndpoints.Set("execution2"))
+	set.Var(&executionEndpoints, flags.ExecutionEndpointFlag.Name, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	endpoints := parsePowchainEndpoints(ctx)
+	assert.DeepEqual(t, []string{"execution1", "execution2"}, endpoints)
+}
+
 func Test_parseJWTSecretFromFile(t *testing.T) {
 	t.Run("no flag value specified leads to nil secret", func(t *testing.T) {
 		app := cli.App{}
@@ -54,6 +54,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PUDPPort,
 			cmd.P2PTCPPort,
+			cmd.P2PQUICPort,
 			cmd.DataDirFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
@@ -76,6 +77,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreTargetDirFlag,
 			cmd.BoltMMapInitialSizeFlag,
 			cmd.ValidatorMonitorIndicesFlag,
+			cmd.ValidatorMonitorWebhookFlag,
 			cmd.ApiTimeoutFlag,
 		},
 	},
@@ -107,26 +109,47 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisableGRPCGateway,
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
+			flags.GRPCGatewaySocket,
+			flags.GRPCGatewaySocketActivation,
+			flags.GRPCGatewayPathPrefix,
 			flags.GPRCGatewayCorsDomain,
 			flags.HTTPWeb3ProviderFlag,
+			flags.ExecutionEndpointFlag,
 			flags.ExecutionJWTSecretFlag,
 			flags.FallbackWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.StateRetention,
+			flags.HotStateSnapshotInterval,
+			flags.FreezerDir,
+			flags.BlobRetentionEpochs,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.HeadLagAlertThreshold,
+			flags.SeenCacheSizeMultiplier,
+			flags.SeenCacheTTL,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
+			flags.SlasherDBBackend,
+			flags.EnableInvariantChecks,
+			flags.ProfileSnapshotDir,
+			flags.ProfileHeapThreshold,
+			flags.ProfileGoroutineThreshold,
+			flags.MaxMemory,
 			flags.ChainID,
 			flags.NetworkID,
+			flags.AltairForkEpoch,
+			flags.BellatrixForkEpoch,
+			flags.CapellaForkEpoch,
 			flags.WeakSubjectivityCheckpoint,
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.BuilderRegistrationRefreshInterval,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
@@ -154,7 +177,18 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
+			cmd.P2PMaxPeersPerIP,
+			cmd.P2PMaxPeersPerSubnet,
+			cmd.P2PMaxPeersPerASN,
+			cmd.P2PASNDatabase,
+			cmd.P2PColocationAllowList,
+			cmd.P2PDisableIdentifyPush,
+			cmd.P2PEnableIdentifyDelta,
+			cmd.P2PUserAgent,
 			cmd.StaticPeers,
+			cmd.SentryPeers,
+			cmd.P2PStaticPeers,
+			cmd.P2PTrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
 		},
@@ -94,6 +94,26 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// SentryPeers specifies the trusted sentry nodes to connect to exclusively.
+	SentryPeers = &cli.StringSliceFlag{
+		Name: "sentry-peer",
+		Usage: "Connect exclusively to this trusted sentry beacon node, which relays all gossip for this node. " +
+			"Peer discovery is disabled and connections from other peers are rejected. The multiaddress must include " +
+			"the peer id of the sentry, and sentries should subscribe to all subnets. This flag may be used multiple times.",
+	}
+	// P2PStaticPeers specifies the peers to stay connected to.
+	P2PStaticPeers = &cli.StringSliceFlag{
+		Name: "p2p-static-peers",
+		Usage: "Stay connected to these peers, given as multiaddresses including their peer id. Static peers are " +
+			"reconnected whenever their connection drops and are never pruned to make room for other peers. " +
+			"This flag may be used multiple times.",
+	}
+	// P2PTrustedPeers specifies the peers exempt from scoring-based disconnects.
+	P2PTrustedPeers = &cli.StringSliceFlag{
+		Name: "p2p-trusted-peers",
+		Usage: "Trust these peers, given as peer ids or multiaddresses including their peer id. Trusted peers are " +
+			"never disconnected because of their peer score. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
@@ -119,6 +139,11 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 13000,
 	}
+	// P2PQUICPort defines the UDP port to be used by the QUIC transport of libp2p.
+	P2PQUICPort = &cli.IntFlag{
+		Name:  "p2p-quic-port",
+		Usage: "The UDP port used by the QUIC transport of libp2p. QUIC is disabled when unset, and is preferred over TCP to dial the peers supporting it.",
+	}
 	// P2PIP defines the local IP to be used by libp2p.
 	P2PIP = &cli.StringFlag{
 		Name:  "p2p-local-ip",
@@ -171,6 +196,53 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PMaxPeersPerIP defines the max number of peers connected from the same ip address.
+	P2PMaxPeersPerIP = &cli.UintFlag{
+		Name:  "p2p-max-peers-per-ip",
+		Usage: "The max number of p2p peers connected from the same ip address. 0 disables the limit.",
+		Value: 5,
+	}
+	// P2PMaxPeersPerSubnet defines the max number of peers connected from the same subnet.
+	P2PMaxPeersPerSubnet = &cli.UintFlag{
+		Name: "p2p-max-peers-per-subnet",
+		Usage: "The max number of p2p peers connected from the same /24 IPv4 or /64 IPv6 subnet. " +
+			"0 disables the limit.",
+	}
+	// P2PMaxPeersPerASN defines the max number of peers connected from the same autonomous system.
+	P2PMaxPeersPerASN = &cli.UintFlag{
+		Name: "p2p-max-peers-per-asn",
+		Usage: "The max number of p2p peers connected from the same autonomous system, such as a " +
+			"hosting provider. Requires --p2p-asn-db. 0 disables the limit.",
+	}
+	// P2PASNDatabase defines the path to a database mapping ip addresses to autonomous systems.
+	P2PASNDatabase = &cli.StringFlag{
+		Name: "p2p-asn-db",
+		Usage: "Path to a tab separated ip to ASN database, in the format published by iptoasn.com, " +
+			"used to enforce --p2p-max-peers-per-asn.",
+	}
+	// P2PColocationAllowList defines a list of CIDR subnets exempted from the colocation limits.
+	P2PColocationAllowList = &cli.StringSliceFlag{
+		Name: "p2p-colocation-allowlist",
+		Usage: "The CIDR subnets exempted from the peer limits per ip address, subnet and autonomous " +
+			"system. Example: 10.0.0.0/8 would allow any number of peers on a private cluster network.",
+	}
+	// P2PDisableIdentifyPush defines a flag to stop accepting identify pushes from peers.
+	P2PDisableIdentifyPush = &cli.BoolFlag{
+		Name: "p2p-disable-identify-push",
+		Usage: "Do not accept identify push messages from peers. The push protocol is no longer advertised, so " +
+			"peers stop sending identify updates to the node.",
+	}
+	// P2PEnableIdentifyDelta defines a flag to accept identify deltas from peers.
+	P2PEnableIdentifyDelta = &cli.BoolFlag{
+		Name: "p2p-enable-identify-delta",
+		Usage: "Accept identify delta messages from peers, which announce changes of their supported protocols. " +
+			"The delta protocol is disabled by default, as some peers flood it.",
+	}
+	// P2PUserAgent defines the agent string advertised to peers.
+	P2PUserAgent = &cli.StringFlag{
+		Name:  "p2p-user-agent",
+		Usage: "The agent string advertised to peers through identify. Defaults to the version of the node.",
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -227,8 +299,17 @@ var (
 	// ValidatorMonitorIndicesFlag specifies a list of validator indices to
 	// track for performance updates
 	ValidatorMonitorIndicesFlag = &cli.IntSliceFlag{
-		Name:  "monitor-indices",
-		Usage: "List of validator indices to track performance",
+		Name:    "monitor-indices",
+		Aliases: []string{"monitor-validators"},
+		Usage: "List of validator indices to track performance. Their attestation inclusion, missed attestations, " +
+			"proposals, sync committee contributions and balances are logged and exported as metrics",
+	}
+	// ValidatorMonitorWebhookFlag specifies a URL to which notifications about the
+	// validators tracked by the monitor are posted.
+	ValidatorMonitorWebhookFlag = &cli.StringFlag{
+		Name: "monitor-webhook-url",
+		Usage: "URL to which notifications about the tracked validators, such as their upcoming sync committee " +
+			"duty, are posted as JSON",
 	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
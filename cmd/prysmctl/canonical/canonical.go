@@ -0,0 +1,13 @@
+package canonical
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "canonical",
+		Usage: "commands for querying the canonical chain of a running beacon node",
+		Subcommands: []*cli.Command{
+			exportCmd,
+		},
+	},
+}
@@ -0,0 +1,124 @@
+package canonical
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/api/client/beacon"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	StartSlot      uint64
+	EndSlot        uint64
+	Output         string
+}{}
+
+var exportCmd = &cli.Command{
+	Name: "export",
+	Usage: "Write the slot, block root, execution block hash and proposer index of the canonical blocks in a slot " +
+		"range as CSV, streaming them from a beacon node.",
+	Action: cliActionExport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query, serving the Prysm API",
+			Destination: &exportFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for the whole export (uses duration format, ex: 2m31s), 0 for no timeout",
+			Destination: &exportFlags.Timeout,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot to export",
+			Destination: &exportFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot to export, slots after the head of the beacon node are ignored",
+			Destination: &exportFlags.EndSlot,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the CSV to, defaults to the standard output",
+			Destination: &exportFlags.Output,
+		},
+	},
+}
+
+// Columns of the exported CSV, hex values being 0x prefixed. The execution block hash is empty for
+// blocks before the merge.
+var csvHeader = []string{"slot", "block_root", "execution_block_hash", "proposer_index"}
+
+func cliActionExport(_ *cli.Context) error {
+	ctx := context.Background()
+	f := exportFlags
+	if f.StartSlot > f.EndSlot {
+		return fmt.Errorf("start slot %d is after the end slot %d", f.StartSlot, f.EndSlot)
+	}
+
+	client, err := beacon.NewClient(f.BeaconNodeHost, beacon.WithTimeout(f.Timeout))
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if f.Output != "" {
+		file, err := os.Create(f.Output)
+		if err != nil {
+			return errors.Wrapf(err, "could not create %s", f.Output)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.WithError(err).Errorf("Could not close %s", f.Output)
+			}
+		}()
+		out = file
+	}
+	w := csv.NewWriter(out)
+	if err := w.Write(csvHeader); err != nil {
+		return err
+	}
+	count := 0
+	err = client.StreamCanonicalBlocks(ctx, types.Slot(f.StartSlot), types.Slot(f.EndSlot), func(b *ethpb.CanonicalBlock) error {
+		count++
+		return w.Write(csvRecord(b))
+	})
+	w.Flush()
+	if err != nil {
+		return errors.Wrap(err, "could not export canonical blocks")
+	}
+	if err := w.Error(); err != nil {
+		return err
+	}
+	log.Printf("exported %d canonical blocks from slot %d to %d", count, f.StartSlot, f.EndSlot)
+	return nil
+}
+
+func csvRecord(b *ethpb.CanonicalBlock) []string {
+	executionBlockHash := ""
+	if len(b.ExecutionBlockHash) > 0 {
+		executionBlockHash = fmt.Sprintf("%#x", b.ExecutionBlockHash)
+	}
+	return []string{
+		strconv.FormatUint(uint64(b.Slot), 10),
+		fmt.Sprintf("%#x", b.BlockRoot),
+		executionBlockHash,
+		strconv.FormatUint(uint64(b.ProposerIndex), 10),
+	}
+}
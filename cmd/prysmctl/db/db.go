@@ -0,0 +1,14 @@
+package db
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "db",
+		Usage: "commands for managing the beacon node database",
+		Subcommands: []*cli.Command{
+			pruneStatesCmd,
+			rollbackCmd,
+		},
+	},
+}
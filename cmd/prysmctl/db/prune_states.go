@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var pruneStatesFlags = struct {
+	DataDir               string
+	StateRetention        string
+	SlotsPerArchivedPoint uint64
+}{}
+
+var pruneStatesCmd = &cli.Command{
+	Name:   "prune-states",
+	Usage:  "Delete the finalized states which are not kept by a state retention mode from a stopped beacon node database.",
+	Action: cliActionPruneStates,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node",
+			Destination: &pruneStatesFlags.DataDir,
+			Value:       cmd.DefaultDataDir(),
+		},
+		&cli.StringFlag{
+			Name:        "state-retention",
+			Usage:       "finalized states to keep: archival, default or minimal",
+			Destination: &pruneStatesFlags.StateRetention,
+			Value:       "default",
+		},
+		&cli.Uint64Flag{
+			Name:        "slots-per-archive-point",
+			Usage:       "slot interval of the archived states, as configured on the beacon node",
+			Destination: &pruneStatesFlags.SlotsPerArchivedPoint,
+			Value:       uint64(params.BeaconConfig().SlotsPerArchivedPoint),
+		},
+	},
+}
+
+func cliActionPruneStates(_ *cli.Context) error {
+	ctx := context.Background()
+	f := pruneStatesFlags
+
+	retention, err := db.ParseStateRetention(f.StateRetention)
+	if err != nil {
+		return err
+	}
+	dbPath := filepath.Join(f.DataDir, kv.BeaconNodeDbDirName)
+	if !file.FileExists(filepath.Join(dbPath, kv.DatabaseFileName)) {
+		return fmt.Errorf("no beacon node database found in %s", dbPath)
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	pruned, err := d.PruneStates(ctx, retention, types.Slot(f.SlotsPerArchivedPoint), 0)
+	if err != nil {
+		return err
+	}
+	log.Printf("pruned %d states from %s using the %s state retention mode", pruned, dbPath, retention)
+	return nil
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var rollbackFlags = struct {
+	DataDir       string
+	SchemaVersion uint64
+}{}
+
+var rollbackCmd = &cli.Command{
+	Name:   "rollback",
+	Usage:  "Revert the schema migrations of a stopped beacon node database, so that it can be used by an older release.",
+	Action: cliActionRollback,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node",
+			Destination: &rollbackFlags.DataDir,
+			Value:       cmd.DefaultDataDir(),
+		},
+		&cli.Uint64Flag{
+			Name:        "schema-version",
+			Usage:       "database schema version to roll back to",
+			Destination: &rollbackFlags.SchemaVersion,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionRollback(_ *cli.Context) error {
+	ctx := context.Background()
+	f := rollbackFlags
+
+	dbPath := filepath.Join(f.DataDir, kv.BeaconNodeDbDirName)
+	if !file.FileExists(filepath.Join(dbPath, kv.DatabaseFileName)) {
+		return fmt.Errorf("no beacon node database found in %s", dbPath)
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	if err := d.RollbackMigrations(ctx, f.SchemaVersion); err != nil {
+		return err
+	}
+	version, err := d.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("database schema of %s is at version %d", dbPath, version)
+	return nil
+}
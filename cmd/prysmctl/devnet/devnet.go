@@ -0,0 +1,13 @@
+package devnet
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "devnet",
+		Usage: "commands for running a local devnet of beacon nodes and validators",
+		Subcommands: []*cli.Command{
+			startCmd,
+		},
+	},
+}
@@ -0,0 +1,384 @@
+package devnet
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/testnet"
+	validatorflags "github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// genesisStateFlagName is the name of the beacon node flag loading a genesis state from a file. It is
+// not referenced from its package, which would link the whole beacon node into prysmctl.
+const genesisStateFlagName = "genesis-state"
+
+// Each beacon node and its validator client take portsPerNode consecutive ports from the base port.
+const portsPerNode = 10
+
+// shutdownTimeout is how long processes are given to exit after an interrupt before they are killed.
+const shutdownTimeout = 30 * time.Second
+
+var startFlags = struct {
+	BeaconChainBinary string
+	ValidatorBinary   string
+	DataDir           string
+	ChainConfigFile   string
+	ConfigName        string
+	NumNodes          uint64
+	NumValidators     uint64
+	GenesisDelay      time.Duration
+	BasePort          uint64
+	ExecutionEndpoint string
+	JWTSecret         string
+}{}
+
+var startCmd = &cli.Command{
+	Name: "start",
+	Usage: "Start a local devnet of beacon nodes, each with a validator client, from a generated genesis state. " +
+		"The nodes peer with the first node, and all processes are stopped on interrupt.",
+	Action: cliActionStart,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-chain-binary",
+			Usage:       "path to the beacon-chain binary",
+			Destination: &startFlags.BeaconChainBinary,
+			Value:       "beacon-chain",
+		},
+		&cli.StringFlag{
+			Name:        "validator-binary",
+			Usage:       "path to the validator binary",
+			Destination: &startFlags.ValidatorBinary,
+			Value:       "validator",
+		},
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "directory holding the genesis, the data directories and the logs of the devnet, cleared on start",
+			Destination: &startFlags.DataDir,
+			Value:       filepath.Join(os.TempDir(), "prysm-devnet"),
+		},
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "chain config YAML file of the devnet, instead of a named config",
+			Destination: &startFlags.ChainConfigFile,
+		},
+		&cli.StringFlag{
+			Name:        "config-name",
+			Usage:       "name of the config of the devnet, when no chain config file is given",
+			Destination: &startFlags.ConfigName,
+			Value:       params.MainnetName,
+		},
+		&cli.Uint64Flag{
+			Name:        "num-nodes",
+			Usage:       "number of beacon nodes, each running with a validator client",
+			Destination: &startFlags.NumNodes,
+			Value:       2,
+		},
+		&cli.Uint64Flag{
+			Name:        "num-validators",
+			Usage:       "number of genesis validators, split between the validator clients",
+			Destination: &startFlags.NumValidators,
+			Value:       64,
+		},
+		&cli.DurationFlag{
+			Name:        "genesis-delay",
+			Usage:       "delay from now to the genesis of the devnet, leaving time for the nodes to start and peer",
+			Destination: &startFlags.GenesisDelay,
+			Value:       30 * time.Second,
+		},
+		&cli.Uint64Flag{
+			Name:        "base-port",
+			Usage:       fmt.Sprintf("first port of the devnet, each node takes %d consecutive ports", portsPerNode),
+			Destination: &startFlags.BasePort,
+			Value:       14000,
+		},
+		&cli.StringFlag{
+			Name: "execution-endpoint",
+			Usage: "endpoint of a running execution client shared by the beacon nodes. Without it, the nodes run " +
+				"without an execution client and vote on mocked eth1 data",
+			Destination: &startFlags.ExecutionEndpoint,
+		},
+		&cli.StringFlag{
+			Name:        "jwt-secret",
+			Usage:       "path to the JWT secret of the execution client",
+			Destination: &startFlags.JWTSecret,
+		},
+	},
+}
+
+// devnet is the layout of a local devnet: the files shared by its nodes, and the ports and directories
+// of each node.
+type devnet struct {
+	dataDir           string
+	genesisPath       string
+	chainConfigPath   string
+	numNodes          uint64
+	numValidators     uint64
+	basePort          uint64
+	bootnodeKeyPath   string
+	bootnodeAddr      string
+	executionEndpoint string
+	jwtSecret         string
+}
+
+// nodePorts are the ports of a beacon node and its validator client.
+type nodePorts struct {
+	rpc, gateway, tcp, udp, monitoring, validatorGateway, validatorMonitoring uint64
+}
+
+func (d *devnet) ports(i uint64) nodePorts {
+	base := d.basePort + i*portsPerNode
+	return nodePorts{
+		rpc:                 base,
+		gateway:             base + 1,
+		tcp:                 base + 2,
+		udp:                 base + 3,
+		monitoring:          base + 4,
+		validatorGateway:    base + 5,
+		validatorMonitoring: base + 6,
+	}
+}
+
+// validatorRange returns the start index and the number of the interop validators run by the validator
+// client of the i-th node. The remainder of an uneven split goes to the last node.
+func (d *devnet) validatorRange(i uint64) (uint64, uint64) {
+	n := d.numValidators / d.numNodes
+	if i == d.numNodes-1 {
+		return i * n, d.numValidators - i*n
+	}
+	return i * n, n
+}
+
+func (d *devnet) nodeDir(i uint64) string {
+	return filepath.Join(d.dataDir, fmt.Sprintf("node-%d", i))
+}
+
+func (d *devnet) beaconNodeArgs(i uint64) []string {
+	p := d.ports(i)
+	args := []string{
+		fmt.Sprintf("--%s=%s", cmd.DataDirFlag.Name, filepath.Join(d.nodeDir(i), "beacon")),
+		fmt.Sprintf("--%s=%s", genesisStateFlagName, d.genesisPath),
+		fmt.Sprintf("--%s=%s", cmd.ChainConfigFileFlag.Name, d.chainConfigPath),
+		fmt.Sprintf("--%s=%d", flags.RPCPort.Name, p.rpc),
+		fmt.Sprintf("--%s=%d", flags.GRPCGatewayPort.Name, p.gateway),
+		fmt.Sprintf("--%s=%d", cmd.P2PTCPPort.Name, p.tcp),
+		fmt.Sprintf("--%s=%d", cmd.P2PUDPPort.Name, p.udp),
+		fmt.Sprintf("--%s=%d", flags.MonitoringPortFlag.Name, p.monitoring),
+		fmt.Sprintf("--%s=%d", flags.MinSyncPeers.Name, 0),
+		fmt.Sprintf("--%s=%d", flags.MinPeersPerSubnet.Name, 0),
+		"--" + cmd.NoDiscovery.Name,
+		"--" + cmd.AcceptTosFlag.Name,
+		"--" + cmd.ForceClearDB.Name,
+	}
+	if i == 0 {
+		args = append(args, fmt.Sprintf("--%s=%s", cmd.P2PPrivKey.Name, d.bootnodeKeyPath))
+	} else {
+		args = append(args, fmt.Sprintf("--%s=%s", cmd.StaticPeers.Name, d.bootnodeAddr))
+	}
+	if d.executionEndpoint != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", flags.ExecutionEndpointFlag.Name, d.executionEndpoint))
+		if d.jwtSecret != "" {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.ExecutionJWTSecretFlag.Name, d.jwtSecret))
+		}
+	} else {
+		args = append(args, "--"+flags.InteropMockEth1DataVotesFlag.Name)
+	}
+	return args
+}
+
+func (d *devnet) validatorArgs(i uint64) []string {
+	p := d.ports(i)
+	start, n := d.validatorRange(i)
+	return []string{
+		fmt.Sprintf("--%s=%s", cmd.DataDirFlag.Name, filepath.Join(d.nodeDir(i), "validator")),
+		fmt.Sprintf("--%s=%s", cmd.ChainConfigFileFlag.Name, d.chainConfigPath),
+		fmt.Sprintf("--%s=localhost:%d", validatorflags.BeaconRPCProviderFlag.Name, p.rpc),
+		fmt.Sprintf("--%s=%d", validatorflags.GRPCGatewayPort.Name, p.validatorGateway),
+		fmt.Sprintf("--%s=%d", validatorflags.MonitoringPortFlag.Name, p.validatorMonitoring),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropStartIndex.Name, start),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropNumValidators.Name, n),
+		"--" + cmd.AcceptTosFlag.Name,
+		"--" + cmd.ForceClearDB.Name,
+	}
+}
+
+func cliActionStart(_ *cli.Context) error {
+	f := startFlags
+	if f.NumNodes == 0 {
+		return errors.New("--num-nodes must be at least 1")
+	}
+	if f.NumValidators < f.NumNodes {
+		return errors.New("--num-validators must be at least --num-nodes")
+	}
+	d := &devnet{
+		dataDir:           f.DataDir,
+		genesisPath:       filepath.Join(f.DataDir, "genesis.ssz"),
+		chainConfigPath:   filepath.Join(f.DataDir, "config.yaml"),
+		numNodes:          f.NumNodes,
+		numValidators:     f.NumValidators,
+		basePort:          f.BasePort,
+		bootnodeKeyPath:   filepath.Join(f.DataDir, "bootnode.key"),
+		executionEndpoint: f.ExecutionEndpoint,
+		jwtSecret:         f.JWTSecret,
+	}
+	if err := os.RemoveAll(d.dataDir); err != nil {
+		return errors.Wrapf(err, "could not clear %s", d.dataDir)
+	}
+	if err := file.MkdirAll(d.dataDir); err != nil {
+		return err
+	}
+	if err := d.writeGenesis(context.Background(), uint64(time.Now().Add(f.GenesisDelay).Unix()), f.ChainConfigFile, f.ConfigName); err != nil {
+		return err
+	}
+	if err := d.writeBootnodeKey(); err != nil {
+		return err
+	}
+	return d.run(f.BeaconChainBinary, f.ValidatorBinary)
+}
+
+// writeGenesis writes the phase0 genesis state and the chain config of the devnet, which the beacon
+// nodes load at startup.
+func (d *devnet) writeGenesis(ctx context.Context, genesisTime uint64, chainConfigFile, configName string) error {
+	fork := version.String(version.Phase0)
+	if err := testnet.SetGenesisConfig(chainConfigFile, configName, fork); err != nil {
+		return err
+	}
+	st, err := testnet.GenerateGenesis(ctx, fork, genesisTime, d.numValidators, nil)
+	if err != nil {
+		return err
+	}
+	b, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal genesis state")
+	}
+	if err := file.WriteFile(d.genesisPath, b); err != nil {
+		return errors.Wrap(err, "could not write genesis state")
+	}
+	if err := file.WriteFile(d.chainConfigPath, params.ConfigToYaml(params.BeaconConfig())); err != nil {
+		return errors.Wrap(err, "could not write chain config")
+	}
+	log.WithField("genesisTime", time.Unix(int64(genesisTime), 0)).Info("Wrote devnet genesis")
+	return nil
+}
+
+// writeBootnodeKey writes a new p2p key for the first beacon node, from which the address the other
+// beacon nodes peer with is derived.
+func (d *devnet) writeBootnodeKey() error {
+	priv, _, err := crypto.GenerateSecp256k1Key(nil)
+	if err != nil {
+		return errors.Wrap(err, "could not generate bootnode key")
+	}
+	raw, err := priv.Raw()
+	if err != nil {
+		return err
+	}
+	if err := file.WriteFile(d.bootnodeKeyPath, []byte(hex.EncodeToString(raw))); err != nil {
+		return errors.Wrap(err, "could not write bootnode key")
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return errors.Wrap(err, "could not derive bootnode peer id")
+	}
+	d.bootnodeAddr = fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", d.ports(0).tcp, id)
+	return nil
+}
+
+// run starts the processes of the devnet and waits until one of them exits or an interrupt is
+// received, then stops all of them.
+func (d *devnet) run(beaconChainBinary, validatorBinary string) error {
+	logDir := filepath.Join(d.dataDir, "logs")
+	if err := file.MkdirAll(logDir); err != nil {
+		return err
+	}
+	var procs []*exec.Cmd
+	exited := make(chan error, 2*d.numNodes)
+	var wg sync.WaitGroup
+	start := func(name, binary string, args []string) error {
+		logFile, err := os.Create(filepath.Join(logDir, name+".log")) // #nosec G304
+		if err != nil {
+			return err
+		}
+		c := exec.Command(binary, args...) // #nosec G204 -- The binaries are given by the user.
+		c.Stdout = logFile
+		c.Stderr = logFile
+		if err := c.Start(); err != nil {
+			return errors.Wrapf(err, "could not start %s", name)
+		}
+		log.WithField("pid", c.Process.Pid).WithField("log", logFile.Name()).Infof("Started %s", name)
+		procs = append(procs, c)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := c.Wait()
+			if cerr := logFile.Close(); cerr != nil {
+				log.WithError(cerr).Error("Could not close log file")
+			}
+			if err == nil {
+				err = errors.New("exited")
+			}
+			exited <- errors.Wrap(err, name)
+		}()
+		return nil
+	}
+
+	var err error
+	for i := uint64(0); i < d.numNodes; i++ {
+		if err = start(fmt.Sprintf("beacon-node-%d", i), beaconChainBinary, d.beaconNodeArgs(i)); err != nil {
+			break
+		}
+		if err = start(fmt.Sprintf("validator-%d", i), validatorBinary, d.validatorArgs(i)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sig)
+		select {
+		case s := <-sig:
+			log.WithField("signal", s).Info("Stopping devnet")
+		case err = <-exited:
+			log.WithError(err).Error("Devnet process exited, stopping devnet")
+		}
+	}
+
+	for _, c := range procs {
+		if err := c.Process.Signal(syscall.SIGINT); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			log.WithError(err).Error("Could not interrupt process")
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		log.Warn("Devnet processes did not stop in time, killing them")
+		for _, c := range procs {
+			if err := c.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+				log.WithError(err).Error("Could not kill process")
+			}
+		}
+		<-done
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func testDevnet(t *testing.T) *devnet {
+	dir := t.TempDir()
+	return &devnet{
+		dataDir:         dir,
+		genesisPath:     filepath.Join(dir, "genesis.ssz"),
+		chainConfigPath: filepath.Join(dir, "config.yaml"),
+		numNodes:        3,
+		numValidators:   64,
+		basePort:        14000,
+		bootnodeKeyPath: filepath.Join(dir, "bootnode.key"),
+	}
+}
+
+func TestDevnet_ValidatorRange(t *testing.T) {
+	d := testDevnet(t)
+	var total uint64
+	for i := uint64(0); i < d.numNodes; i++ {
+		start, n := d.validatorRange(i)
+		assert.Equal(t, total, start)
+		total += n
+	}
+	assert.Equal(t, d.numValidators, total)
+	_, n := d.validatorRange(d.numNodes - 1)
+	assert.Equal(t, uint64(22), n)
+}
+
+func TestDevnet_Args(t *testing.T) {
+	d := testDevnet(t)
+	require.NoError(t, d.writeBootnodeKey())
+	assert.Equal(t, true, strings.HasPrefix(d.bootnodeAddr, "/ip4/127.0.0.1/tcp/14002/p2p/"))
+
+	bootnode := strings.Join(d.beaconNodeArgs(0), " ")
+	assert.Equal(t, true, strings.Contains(bootnode, "--p2p-priv-key="+d.bootnodeKeyPath))
+	assert.Equal(t, false, strings.Contains(bootnode, "--peer="))
+	assert.Equal(t, true, strings.Contains(bootnode, "--interop-eth1data-votes"))
+
+	d.executionEndpoint = "http://localhost:8551"
+	d.jwtSecret = "jwt.hex"
+	node := strings.Join(d.beaconNodeArgs(1), " ")
+	assert.Equal(t, true, strings.Contains(node, "--peer="+d.bootnodeAddr))
+	assert.Equal(t, true, strings.Contains(node, "--rpc-port=14010"))
+	assert.Equal(t, true, strings.Contains(node, "--execution-endpoint=http://localhost:8551"))
+	assert.Equal(t, true, strings.Contains(node, "--jwt-secret=jwt.hex"))
+	assert.Equal(t, false, strings.Contains(node, "--interop-eth1data-votes"))
+
+	validator := strings.Join(d.validatorArgs(2), " ")
+	assert.Equal(t, true, strings.Contains(validator, "--beacon-rpc-provider=localhost:14020"))
+	assert.Equal(t, true, strings.Contains(validator, "--interop-start-index=42"))
+	assert.Equal(t, true, strings.Contains(validator, "--interop-num-validators=22"))
+}
+
+func TestDevnet_WriteGenesis(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	d := testDevnet(t)
+	require.NoError(t, d.writeGenesis(context.Background(), 1000, "", params.MainnetName))
+	info, err := os.Stat(d.genesisPath)
+	require.NoError(t, err)
+	assert.Equal(t, true, info.Size() > 0)
+	config, err := os.ReadFile(d.chainConfigPath)
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(string(config), fmt.Sprintf("CONFIG_NAME: '%s'", params.MainnetName)))
+}
@@ -0,0 +1,38 @@
+package duties
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+	{
+		Name:  "duties",
+		Usage: "commands for reporting the duties a validator performed, from the database of a stopped beacon node",
+		Subcommands: []*cli.Command{
+			exportCmd,
+		},
+	},
+}
+
+// openDB opens the database of a stopped beacon node in the given data directory, reading frozen blocks from
+// the given freezer directory when it is set.
+func openDB(ctx context.Context, dataDir, freezerDir string) (*kv.Store, error) {
+	dbPath := filepath.Join(dataDir, kv.BeaconNodeDbDirName)
+	if !file.FileExists(filepath.Join(dbPath, kv.DatabaseFileName)) {
+		return nil, fmt.Errorf("no beacon node database found in %s", dbPath)
+	}
+	return kv.NewKVStore(ctx, dbPath, &kv.Config{FreezerPath: freezerDir})
+}
+
+func closeDB(d *kv.Store) {
+	if err := d.Close(); err != nil {
+		log.WithError(err).Error("Could not close database")
+	}
+}
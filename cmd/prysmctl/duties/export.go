@@ -0,0 +1,525 @@
+package duties
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	DataDir        string
+	FreezerDir     string
+	ValidatorIndex uint64
+	StartEpoch     uint64
+	EndEpoch       uint64
+	Output         string
+}{}
+
+var exportCmd = &cli.Command{
+	Name: "export",
+	Usage: "Write a JSON report of the blocks proposed, the attestations included with their inclusion proofs and " +
+		"the sync committee memberships of a validator in an epoch range, from the canonical chain of a stopped beacon node.",
+	Action: cliActionExport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node",
+			Destination: &exportFlags.DataDir,
+			Value:       cmd.DefaultDataDir(),
+		},
+		&cli.StringFlag{
+			Name:        "freezer-dir",
+			Usage:       "freezer directory of the beacon node, if it was started with --freezer-dir",
+			Destination: &exportFlags.FreezerDir,
+		},
+		&cli.Uint64Flag{
+			Name:        "validator-index",
+			Usage:       "index of the validator to report on",
+			Destination: &exportFlags.ValidatorIndex,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-epoch",
+			Usage:       "first epoch of the report",
+			Destination: &exportFlags.StartEpoch,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-epoch",
+			Usage:       "last epoch of the report, epochs after the head of the beacon node are ignored",
+			Destination: &exportFlags.EndEpoch,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the report to, defaults to the standard output",
+			Destination: &exportFlags.Output,
+		},
+	},
+}
+
+// report is the duty report of a validator. Every proposal and attestation comes with the header of the canonical
+// block containing it, signed by the proposer of that block, so that the report can be checked against the chain
+// without trusting the node which produced it. Slots, epochs and indices are decimal strings and roots are 0x
+// prefixed hex strings, as in the beacon API.
+type report struct {
+	ValidatorIndex          string                     `json:"validator_index"`
+	Pubkey                  string                     `json:"pubkey"`
+	GenesisValidatorsRoot   string                     `json:"genesis_validators_root"`
+	StartEpoch              string                     `json:"start_epoch"`
+	EndEpoch                string                     `json:"end_epoch"`
+	HeadBlockRoot           string                     `json:"head_block_root"`
+	Proposals               []*proposal                `json:"proposals"`
+	Attestations            []*includedAttestation     `json:"attestations"`
+	MissedAttestationEpochs []string                   `json:"missed_attestation_epochs"`
+	SyncCommittees          []*syncCommitteeMembership `json:"sync_committees"`
+}
+
+type proposal struct {
+	Slot         string        `json:"slot"`
+	BlockRoot    string        `json:"block_root"`
+	SignedHeader *signedHeader `json:"signed_header"`
+}
+
+// includedAttestation is the first canonical inclusion of an attestation of the validator for a target epoch. The
+// proof is the Merkle branch of the attestation root against the inclusion block root at the generalized index,
+// ordered from the leaf to the root.
+type includedAttestation struct {
+	Slot                 string        `json:"slot"`
+	CommitteeIndex       string        `json:"committee_index"`
+	BeaconBlockRoot      string        `json:"beacon_block_root"`
+	SourceEpoch          string        `json:"source_epoch"`
+	SourceRoot           string        `json:"source_root"`
+	TargetEpoch          string        `json:"target_epoch"`
+	TargetRoot           string        `json:"target_root"`
+	AttestationRoot      string        `json:"attestation_root"`
+	InclusionSlot        string        `json:"inclusion_slot"`
+	InclusionBlockRoot   string        `json:"inclusion_block_root"`
+	GeneralizedIndex     string        `json:"generalized_index"`
+	Proof                []string      `json:"proof"`
+	InclusionBlockHeader *signedHeader `json:"inclusion_block_header"`
+}
+
+// syncCommitteeMembership lists the positions of the validator in the sync committee of a period.
+type syncCommitteeMembership struct {
+	Period     string   `json:"period"`
+	StartEpoch string   `json:"start_epoch"`
+	EndEpoch   string   `json:"end_epoch"`
+	Positions  []string `json:"positions"`
+}
+
+type signedHeader struct {
+	Message   *header `json:"message"`
+	Signature string  `json:"signature"`
+}
+
+type header struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+func cliActionExport(_ *cli.Context) error {
+	ctx := context.Background()
+	f := exportFlags
+	if f.StartEpoch > f.EndEpoch {
+		return fmt.Errorf("start epoch %d is after the end epoch %d", f.StartEpoch, f.EndEpoch)
+	}
+
+	d, err := openDB(ctx, f.DataDir, f.FreezerDir)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	bfs := backfill.NewStatus(d)
+	if err := bfs.Reload(ctx); err != nil {
+		return errors.Wrap(err, "could not read the backfill status")
+	}
+	b := &reportBuilder{
+		db:     d,
+		sg:     stategen.New(d, stategen.WithBackfillStatus(bfs)),
+		index:  types.ValidatorIndex(f.ValidatorIndex),
+		states: make(map[types.Epoch]state.BeaconState),
+	}
+	r, err := b.build(ctx, types.Epoch(f.StartEpoch), types.Epoch(f.EndEpoch))
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if f.Output != "" {
+		file, err := os.Create(f.Output)
+		if err != nil {
+			return errors.Wrapf(err, "could not create %s", f.Output)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				log.WithError(err).Errorf("Could not close %s", f.Output)
+			}
+		}()
+		out = file
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return errors.Wrap(err, "could not write report")
+	}
+	log.Printf("exported %d proposals, %d attestations and %d sync committee memberships of validator %d from epoch %s to %s",
+		len(r.Proposals), len(r.Attestations), len(r.SyncCommittees), f.ValidatorIndex, r.StartEpoch, r.EndEpoch)
+	return nil
+}
+
+type reportBuilder struct {
+	db    *kv.Store
+	sg    *stategen.State
+	index types.ValidatorIndex
+	// blocks are the canonical blocks from the last block before the start of the report to the end of the
+	// inclusion window of its last epoch, in slot order, and roots are their block roots.
+	blocks []interfaces.SignedBeaconBlock
+	roots  [][32]byte
+	// states are the canonical states at the start of recently used epochs.
+	states map[types.Epoch]state.BeaconState
+}
+
+func (b *reportBuilder) build(ctx context.Context, start, end types.Epoch) (*report, error) {
+	head, err := b.db.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	if err := wrapper.BeaconBlockIsNil(head); err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	headSlot := head.Block().Slot()
+	headEpoch := slots.ToEpoch(headSlot)
+	if start > headEpoch {
+		return nil, fmt.Errorf("start epoch %d is after the head epoch %d", start, headEpoch)
+	}
+	if end > headEpoch {
+		end = headEpoch
+	}
+	startSlot, err := slots.EpochStart(start)
+	if err != nil {
+		return nil, err
+	}
+	// Attestations of an epoch can be included until the end of the next epoch.
+	scanEnd, err := slots.EpochEnd(end + 1)
+	if err != nil {
+		return nil, err
+	}
+	if scanEnd > headSlot {
+		scanEnd = headSlot
+	}
+	if err := b.loadCanonicalBlocks(ctx, head, startSlot, scanEnd); err != nil {
+		return nil, err
+	}
+	headRoot, err := head.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash head block")
+	}
+
+	// The activation and exit epochs of a validator do not change once set, so the latest state of the report
+	// tells in which epochs the validator was active.
+	st, err := b.stateAtEpoch(ctx, end)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(b.index) >= uint64(st.NumValidators()) {
+		return nil, fmt.Errorf("validator %d does not exist at epoch %d", b.index, end)
+	}
+	val, err := st.ValidatorAtIndexReadOnly(b.index)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := val.PublicKey()
+	r := &report{
+		ValidatorIndex:          strconv.FormatUint(uint64(b.index), 10),
+		Pubkey:                  fmt.Sprintf("%#x", pubkey),
+		GenesisValidatorsRoot:   fmt.Sprintf("%#x", st.GenesisValidatorsRoot()),
+		StartEpoch:              strconv.FormatUint(uint64(start), 10),
+		EndEpoch:                strconv.FormatUint(uint64(end), 10),
+		HeadBlockRoot:           fmt.Sprintf("%#x", headRoot),
+		Proposals:               []*proposal{},
+		Attestations:            []*includedAttestation{},
+		MissedAttestationEpochs: []string{},
+		SyncCommittees:          []*syncCommitteeMembership{},
+	}
+
+	if r.SyncCommittees, err = b.syncCommittees(ctx, pubkey, start, end); err != nil {
+		return nil, err
+	}
+	endSlot, err := slots.EpochEnd(end)
+	if err != nil {
+		return nil, err
+	}
+	for i, blk := range b.blocks {
+		slot := blk.Block().Slot()
+		if slot < startSlot || slot > endSlot || blk.Block().ProposerIndex() != b.index {
+			continue
+		}
+		h, err := signedBlockHeader(blk)
+		if err != nil {
+			return nil, err
+		}
+		r.Proposals = append(r.Proposals, &proposal{
+			Slot:         strconv.FormatUint(uint64(slot), 10),
+			BlockRoot:    fmt.Sprintf("%#x", b.roots[i]),
+			SignedHeader: h,
+		})
+	}
+
+	attested, err := b.attestations(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for e := start; e <= end; e++ {
+		if att, ok := attested[e]; ok {
+			r.Attestations = append(r.Attestations, att)
+			continue
+		}
+		windowEnd, err := slots.EpochEnd(e + 1)
+		if err != nil {
+			return nil, err
+		}
+		if windowEnd <= headSlot && helpers.IsActiveValidatorUsingTrie(val, e) {
+			r.MissedAttestationEpochs = append(r.MissedAttestationEpochs, strconv.FormatUint(uint64(e), 10))
+		}
+	}
+	return r, nil
+}
+
+// loadCanonicalBlocks walks the chain back from the head block to the last block before the start slot, keeping
+// the blocks up to the end slot.
+func (b *reportBuilder) loadCanonicalBlocks(ctx context.Context, head interfaces.SignedBeaconBlock, startSlot, endSlot types.Slot) error {
+	blk := head
+	for {
+		if err := wrapper.BeaconBlockIsNil(blk); err != nil {
+			return fmt.Errorf("the history before slot %d is not available in the database, backfill it or report on later epochs", startSlot)
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash block")
+		}
+		slot := blk.Block().Slot()
+		if slot <= endSlot {
+			b.blocks = append(b.blocks, blk)
+			b.roots = append(b.roots, root)
+		}
+		if slot < startSlot || slot == params.BeaconConfig().GenesisSlot {
+			break
+		}
+		if blk, err = b.db.Block(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot())); err != nil {
+			return errors.Wrapf(err, "could not get parent of block at slot %d", slot)
+		}
+		if blk != nil && !blk.IsNil() && blk.Block().Slot() >= slot {
+			return fmt.Errorf("parent of block at slot %d is at slot %d", slot, blk.Block().Slot())
+		}
+	}
+	for i, j := 0, len(b.blocks)-1; i < j; i, j = i+1, j-1 {
+		b.blocks[i], b.blocks[j] = b.blocks[j], b.blocks[i]
+		b.roots[i], b.roots[j] = b.roots[j], b.roots[i]
+	}
+	return nil
+}
+
+// stateAtEpoch returns the canonical state at the start of the epoch. Only the states of the epoch and of the
+// previous one are kept, as the blocks are processed in slot order.
+func (b *reportBuilder) stateAtEpoch(ctx context.Context, epoch types.Epoch) (state.BeaconState, error) {
+	if st, ok := b.states[epoch]; ok {
+		return st, nil
+	}
+	slot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	i := sort.Search(len(b.blocks), func(i int) bool {
+		return b.blocks[i].Block().Slot() > slot
+	}) - 1
+	if i < 0 {
+		return nil, fmt.Errorf("no canonical block at or before slot %d", slot)
+	}
+	st, err := b.sg.StateByRoot(ctx, b.roots[i])
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state of block at slot %d", b.blocks[i].Block().Slot())
+	}
+	if st.Slot() < slot {
+		if st, err = transition.ProcessSlots(ctx, st, slot); err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	for e := range b.states {
+		if e+1 < epoch {
+			delete(b.states, e)
+		}
+	}
+	b.states[epoch] = st
+	return st, nil
+}
+
+// attestations returns the first canonical inclusion of an attestation of the validator for each target epoch.
+func (b *reportBuilder) attestations(ctx context.Context, start, end types.Epoch) (map[types.Epoch]*includedAttestation, error) {
+	attested := make(map[types.Epoch]*includedAttestation)
+	for i, blk := range b.blocks {
+		for j, att := range blk.Block().Body().Attestations() {
+			epoch := att.Data.Target.Epoch
+			if epoch < start || epoch > end || attested[epoch] != nil {
+				continue
+			}
+			st, err := b.stateAtEpoch(ctx, epoch)
+			if err != nil {
+				return nil, err
+			}
+			committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get committee %d of slot %d", att.Data.CommitteeIndex, att.Data.Slot)
+			}
+			indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get attesting indices")
+			}
+			if !containsIndex(indices, b.index) {
+				continue
+			}
+			if attested[epoch], err = includedAttestationAt(blk, b.roots[i], j); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return attested, nil
+}
+
+func containsIndex(indices []uint64, index types.ValidatorIndex) bool {
+	for _, i := range indices {
+		if i == uint64(index) {
+			return true
+		}
+	}
+	return false
+}
+
+// includedAttestationAt returns the attestation at the given index of the block, with its inclusion proof.
+func includedAttestationAt(blk interfaces.SignedBeaconBlock, blkRoot [32]byte, index int) (*includedAttestation, error) {
+	att := blk.Block().Body().Attestations()[index]
+	attRoot, err := att.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash attestation")
+	}
+	branch, gIndex, err := blocks.AttestationInclusionProof(blk.Block(), index)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation inclusion proof")
+	}
+	proof := make([]string, len(branch))
+	for i, node := range branch {
+		proof[i] = fmt.Sprintf("%#x", node)
+	}
+	h, err := signedBlockHeader(blk)
+	if err != nil {
+		return nil, err
+	}
+	data := att.Data
+	return &includedAttestation{
+		Slot:                 strconv.FormatUint(uint64(data.Slot), 10),
+		CommitteeIndex:       strconv.FormatUint(uint64(data.CommitteeIndex), 10),
+		BeaconBlockRoot:      fmt.Sprintf("%#x", data.BeaconBlockRoot),
+		SourceEpoch:          strconv.FormatUint(uint64(data.Source.Epoch), 10),
+		SourceRoot:           fmt.Sprintf("%#x", data.Source.Root),
+		TargetEpoch:          strconv.FormatUint(uint64(data.Target.Epoch), 10),
+		TargetRoot:           fmt.Sprintf("%#x", data.Target.Root),
+		AttestationRoot:      fmt.Sprintf("%#x", attRoot),
+		InclusionSlot:        strconv.FormatUint(uint64(blk.Block().Slot()), 10),
+		InclusionBlockRoot:   fmt.Sprintf("%#x", blkRoot),
+		GeneralizedIndex:     strconv.FormatUint(gIndex, 10),
+		Proof:                proof,
+		InclusionBlockHeader: h,
+	}, nil
+}
+
+// syncCommittees returns the positions of the validator in the sync committees of the periods overlapping the
+// epoch range, from the Altair fork on.
+func (b *reportBuilder) syncCommittees(ctx context.Context, pubkey [48]byte, start, end types.Epoch) ([]*syncCommitteeMembership, error) {
+	memberships := []*syncCommitteeMembership{}
+	epoch := start
+	if altairEpoch := params.BeaconConfig().AltairForkEpoch; epoch < altairEpoch {
+		epoch = altairEpoch
+	}
+	for epoch <= end {
+		st, err := b.stateAtEpoch(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		periodStart, err := slots.SyncCommitteePeriodStartEpoch(epoch)
+		if err != nil {
+			return nil, err
+		}
+		periodEnd := periodStart + params.BeaconConfig().EpochsPerSyncCommitteePeriod - 1
+		if st.Version() != version.Phase0 {
+			committee, err := st.CurrentSyncCommittee()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get current sync committee")
+			}
+			if positions := committeePositions(committee, pubkey); len(positions) > 0 {
+				memberships = append(memberships, &syncCommitteeMembership{
+					Period:     strconv.FormatUint(slots.SyncCommitteePeriod(epoch), 10),
+					StartEpoch: strconv.FormatUint(uint64(periodStart), 10),
+					EndEpoch:   strconv.FormatUint(uint64(periodEnd), 10),
+					Positions:  positions,
+				})
+			}
+		}
+		epoch = periodEnd + 1
+	}
+	return memberships, nil
+}
+
+func committeePositions(committee *ethpb.SyncCommittee, pubkey [48]byte) []string {
+	var positions []string
+	for i, k := range committee.Pubkeys {
+		if bytesutil.ToBytes48(k) == pubkey {
+			positions = append(positions, strconv.Itoa(i))
+		}
+	}
+	return positions
+}
+
+func signedBlockHeader(blk interfaces.SignedBeaconBlock) (*signedHeader, error) {
+	h, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	return &signedHeader{
+		Message: &header{
+			Slot:          strconv.FormatUint(uint64(h.Header.Slot), 10),
+			ProposerIndex: strconv.FormatUint(uint64(h.Header.ProposerIndex), 10),
+			ParentRoot:    fmt.Sprintf("%#x", h.Header.ParentRoot),
+			StateRoot:     fmt.Sprintf("%#x", h.Header.StateRoot),
+			BodyRoot:      fmt.Sprintf("%#x", h.Header.BodyRoot),
+		},
+		Signature: fmt.Sprintf("%#x", h.Signature),
+	}, nil
+}
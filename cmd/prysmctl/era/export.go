@@ -0,0 +1,123 @@
+package era
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	DataDir    string
+	FreezerDir string
+	OutputDir  string
+	StartEra   uint64
+	EndEra     uint64
+}{}
+
+const endEraFlagName = "end-era"
+
+var exportCmd = &cli.Command{
+	Name:   "export",
+	Usage:  "Write the finalized history of a stopped beacon node to era files.",
+	Action: cliActionExport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node",
+			Destination: &exportFlags.DataDir,
+			Value:       cmd.DefaultDataDir(),
+		},
+		&cli.StringFlag{
+			Name:        "freezer-dir",
+			Usage:       "freezer directory of the beacon node, if it was started with --freezer-dir",
+			Destination: &exportFlags.FreezerDir,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the era files to",
+			Destination: &exportFlags.OutputDir,
+			Value:       ".",
+		},
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export. Eras before the origin checkpoint of a checkpoint synced node can only be exported once backfilled",
+			Destination: &exportFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        endEraFlagName,
+			Usage:       "last era to export, defaults to the last finalized era",
+			Destination: &exportFlags.EndEra,
+		},
+	},
+}
+
+func cliActionExport(c *cli.Context) error {
+	ctx := context.Background()
+	f := exportFlags
+
+	d, err := openDB(ctx, f.DataDir, f.FreezerDir)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	last, err := lastFinalizedEra(ctx, d)
+	if err != nil {
+		return err
+	}
+	end := last
+	if c.IsSet(endEraFlagName) {
+		if f.EndEra > last {
+			return fmt.Errorf("era %d is not finalized, the last finalized era is %d", f.EndEra, last)
+		}
+		end = f.EndEra
+	}
+	if f.StartEra > end {
+		return fmt.Errorf("start era %d is after the end era %d", f.StartEra, end)
+	}
+
+	bfs := backfill.NewStatus(d)
+	if err := bfs.Reload(ctx); err != nil {
+		return errors.Wrap(err, "could not read the backfill status")
+	}
+	sg := stategen.New(d, stategen.WithBackfillStatus(bfs))
+	for era := f.StartEra; era <= end; era++ {
+		st, err := sg.EraState(ctx, era)
+		if err != nil {
+			return errors.Wrapf(err, "could not get state at the end of era %d", era)
+		}
+		blocks, err := d.CanonicalEraBlocks(ctx, st)
+		if err != nil {
+			return errors.Wrapf(err, "could not get blocks of era %d", era)
+		}
+		path, err := freezer.WriteEraFile(f.OutputDir, era, blocks, st)
+		if err != nil {
+			return err
+		}
+		log.Printf("exported era %d with %d blocks to %s", era, len(blocks), path)
+	}
+	return nil
+}
+
+// lastFinalizedEra returns the last era which ends at or before the finalized checkpoint.
+func lastFinalizedEra(ctx context.Context, d *kv.Store) (uint64, error) {
+	cp, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(fSlot / params.BeaconConfig().SlotsPerHistoricalRoot), nil
+}
@@ -0,0 +1,132 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/freezer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var importFlags = struct {
+	DataDir    string
+	FreezerDir string
+	EraPath    string
+}{}
+
+var importCmd = &cli.Command{
+	Name:   "import",
+	Usage:  "Backfill the history before the origin checkpoint of a stopped, checkpoint synced beacon node from era files.",
+	Action: cliActionImport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "datadir",
+			Usage:       "data directory of the beacon node",
+			Destination: &importFlags.DataDir,
+			Value:       cmd.DefaultDataDir(),
+		},
+		&cli.StringFlag{
+			Name:        "freezer-dir",
+			Usage:       "freezer directory of the beacon node, if it was started with --freezer-dir",
+			Destination: &importFlags.FreezerDir,
+		},
+		&cli.StringFlag{
+			Name:        "era-path",
+			Usage:       "era file, or directory of era files, to import",
+			Destination: &importFlags.EraPath,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionImport(_ *cli.Context) error {
+	ctx := context.Background()
+	f := importFlags
+
+	paths, err := freezer.EraFilePaths(f.EraPath)
+	if err != nil {
+		return err
+	}
+	d, err := openDB(ctx, f.DataDir, f.FreezerDir)
+	if err != nil {
+		return err
+	}
+	defer closeDB(d)
+
+	originRoot, err := d.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, kv.ErrNotFoundOriginBlockRoot) {
+		return errors.New("the database was synced from genesis and has no history to backfill")
+	}
+	if err != nil {
+		return err
+	}
+	origin, err := d.State(ctx, originRoot)
+	if err != nil {
+		return err
+	}
+	if origin == nil || origin.IsNil() {
+		return fmt.Errorf("origin checkpoint state %#x not found", originRoot)
+	}
+	bfs := backfill.NewStatus(d)
+	if err := bfs.Reload(ctx); err != nil {
+		return errors.Wrap(err, "could not read the backfill status")
+	}
+
+	for _, path := range paths {
+		era, blocks, st, err := freezer.ReadEraFile(path)
+		if err != nil {
+			return err
+		}
+		if err := freezer.VerifyHistoricalRoot(origin, era, st); err != nil {
+			return err
+		}
+		n, err := backfillEra(ctx, d, bfs, blocks)
+		if err != nil {
+			return errors.Wrapf(err, "could not backfill era %d", era)
+		}
+		log.Printf("imported %d blocks of era %d from %s", n, era, path)
+	}
+	if bfs.StartGap() < bfs.EndGap() {
+		log.Printf("history is backfilled up to slot %d, the origin checkpoint is at slot %d", bfs.StartGap(), bfs.EndGap())
+	}
+	return nil
+}
+
+// backfillEra saves the blocks of an era which fall in the backfill gap and advances the backfill position to
+// the last of them. The blocks must extend the backfilled chain, so eras are imported in order. It returns the
+// number of saved blocks.
+func backfillEra(ctx context.Context, d *kv.Store, bfs *backfill.Status, blocks []interfaces.SignedBeaconBlock) (int, error) {
+	missing := make([]interfaces.SignedBeaconBlock, 0, len(blocks))
+	for _, blk := range blocks {
+		if slot := blk.Block().Slot(); slot > bfs.StartGap() && slot < bfs.EndGap() {
+			missing = append(missing, blk)
+		}
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	bfRoot, err := d.BackfillBlockRoot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	first := missing[0].Block()
+	if !bytes.Equal(first.ParentRoot(), bfRoot[:]) {
+		return 0, fmt.Errorf("block at slot %d does not extend the history backfilled up to slot %d, import the earlier eras first", first.Slot(), bfs.StartGap())
+	}
+	if err := d.SaveBlocks(ctx, missing); err != nil {
+		return 0, err
+	}
+	last := missing[len(missing)-1].Block()
+	root, err := last.HashTreeRoot()
+	if err != nil {
+		return 0, err
+	}
+	return len(missing), bfs.Advance(ctx, last.Slot(), root)
+}
@@ -3,7 +3,15 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/canonical"
 	"github.com/prysmaticlabs/prysm/cmd/prysmctl/checkpoint"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/db"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/devnet"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/duties"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/era"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/p2p"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/state"
+	"github.com/prysmaticlabs/prysm/cmd/prysmctl/testnet"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -21,5 +29,13 @@ func main() {
 }
 
 func init() {
+	prysmctlCommands = append(prysmctlCommands, canonical.Commands...)
 	prysmctlCommands = append(prysmctlCommands, checkpoint.Commands...)
+	prysmctlCommands = append(prysmctlCommands, db.Commands...)
+	prysmctlCommands = append(prysmctlCommands, devnet.Commands...)
+	prysmctlCommands = append(prysmctlCommands, duties.Commands...)
+	prysmctlCommands = append(prysmctlCommands, era.Commands...)
+	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
+	prysmctlCommands = append(prysmctlCommands, state.Commands...)
+	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 }
@@ -0,0 +1,293 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Attack modes, each exercising one of the defenses of the target peer.
+const (
+	modeMalformedRequest = "malformed-request"
+	modeOversizedChunk   = "oversized-chunk"
+	modeSlowLoris        = "slow-loris"
+	modeInvalidGossip    = "invalid-gossip"
+)
+
+var attackModes = []string{modeMalformedRequest, modeOversizedChunk, modeSlowLoris, modeInvalidGossip}
+
+// responseTimeout is how long the target is given to answer or reset a request stream.
+const responseTimeout = 10 * time.Second
+
+var attackFlags = struct {
+	Peer       string
+	Mode       string
+	Count      uint64
+	Interval   time.Duration
+	ForkDigest string
+}{}
+
+var attackCmd = &cli.Command{
+	Name: "attack",
+	Usage: "Act as a misbehaving peer towards a beacon node under test, to verify its rate limiting, peer scoring " +
+		"and error handling. Only run it against nodes you operate.",
+	Action: cliActionAttack,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "peer",
+			Usage:       "multiaddr of the target beacon node, including its peer id",
+			Destination: &attackFlags.Peer,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "mode",
+			Usage:       fmt.Sprintf("misbehavior to exhibit, one of %s", strings.Join(attackModes, ", ")),
+			Destination: &attackFlags.Mode,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "count",
+			Usage:       "number of malformed requests or gossip messages to send",
+			Destination: &attackFlags.Count,
+			Value:       1,
+		},
+		&cli.DurationFlag{
+			Name:        "interval",
+			Usage:       "delay between messages, or between the bytes of a slow-loris request",
+			Destination: &attackFlags.Interval,
+			Value:       time.Second,
+		},
+		&cli.StringFlag{
+			Name:        "fork-digest",
+			Usage:       "hex encoded fork digest of the gossip topics of the target, required for invalid-gossip",
+			Destination: &attackFlags.ForkDigest,
+		},
+	},
+}
+
+func cliActionAttack(_ *cli.Context) error {
+	ctx := context.Background()
+	f := attackFlags
+
+	target, err := targetInfo(f.Peer)
+	if err != nil {
+		return err
+	}
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/0.0.0.0/tcp/0"))
+	if err != nil {
+		return errors.Wrap(err, "could not create libp2p host")
+	}
+	defer func() {
+		if err := h.Close(); err != nil {
+			log.WithError(err).Error("Could not close libp2p host")
+		}
+	}()
+	if err := h.Connect(ctx, *target); err != nil {
+		return errors.Wrapf(err, "could not connect to %s", f.Peer)
+	}
+	log.WithField("peer", target.ID).Info("Connected to target")
+
+	switch f.Mode {
+	case modeMalformedRequest:
+		return repeat(f.Count, f.Interval, func() error {
+			return sendRequest(ctx, h, target.ID, blocksByRangeProtocol(), malformedRequest())
+		})
+	case modeOversizedChunk:
+		return repeat(f.Count, f.Interval, func() error {
+			return sendRequest(ctx, h, target.ID, blocksByRangeProtocol(), oversizedChunk())
+		})
+	case modeSlowLoris:
+		return repeat(f.Count, 0, func() error {
+			return slowLoris(ctx, h, target.ID, f.Interval)
+		})
+	case modeInvalidGossip:
+		digest, err := hex.DecodeString(strings.TrimPrefix(f.ForkDigest, "0x"))
+		if err != nil || len(digest) != 4 {
+			return errors.New("invalid-gossip requires a 4 byte --fork-digest")
+		}
+		return publishInvalidGossip(ctx, h, digest, f.Count, f.Interval)
+	default:
+		return fmt.Errorf("unknown mode %q, expected one of %s", f.Mode, strings.Join(attackModes, ", "))
+	}
+}
+
+func targetInfo(addr string) (*peer.AddrInfo, error) {
+	maddr, err := ma.NewMultiaddr(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid peer multiaddr %s", addr)
+	}
+	return peer.AddrInfoFromP2pAddr(maddr)
+}
+
+func repeat(count uint64, interval time.Duration, f func() error) error {
+	for i := uint64(0); i < count; i++ {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+		if err := f(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func blocksByRangeProtocol() protocol.ID {
+	return protocol.ID(p2p.RPCBlocksByRangeTopicV2 + encoder.SszNetworkEncoder{}.ProtocolSuffix())
+}
+
+// malformedRequest announces a blocks by range request of the right size, followed by bytes that are not
+// a snappy frame.
+func malformedRequest() []byte {
+	req := &ethpb.BeaconBlocksByRangeRequest{}
+	b := proto.EncodeVarint(uint64(req.SizeSSZ()))
+	junk := make([]byte, 64)
+	if _, err := rand.Read(junk); err != nil {
+		log.WithError(err).Error("Could not read random bytes")
+	}
+	return append(b, junk...)
+}
+
+// oversizedChunk announces a chunk larger than the maximum chunk size, followed by a valid snappy frame
+// of a small part of it.
+func oversizedChunk() []byte {
+	b := proto.EncodeVarint(params.BeaconNetworkConfig().MaxChunkSize + 1)
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(make([]byte, 1024)); err != nil {
+		log.WithError(err).Error("Could not write snappy frame")
+	}
+	if err := w.Close(); err != nil {
+		log.WithError(err).Error("Could not close snappy writer")
+	}
+	return append(b, buf.Bytes()...)
+}
+
+// validRequest is a well formed blocks by range request, sent byte by byte by the slow-loris mode.
+func validRequest() ([]byte, error) {
+	var buf bytes.Buffer
+	req := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 0, Count: 1, Step: 1}
+	if _, err := (encoder.SszNetworkEncoder{}).EncodeWithMaxLength(&buf, req); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sendRequest writes the payload on a new stream of the protocol, and reports how the target responds.
+func sendRequest(ctx context.Context, h host.Host, pid peer.ID, proto protocol.ID, payload []byte) error {
+	s, err := h.NewStream(ctx, pid, proto)
+	if err != nil {
+		return errors.Wrap(err, "could not open stream")
+	}
+	defer func() {
+		if err := s.Reset(); err != nil {
+			log.WithError(err).Debug("Could not reset stream")
+		}
+	}()
+	if _, err := s.Write(payload); err != nil {
+		log.WithError(err).Info("Target rejected the request while it was written")
+		return nil
+	}
+	if err := s.CloseWrite(); err != nil {
+		log.WithError(err).Debug("Could not close the write side of the stream")
+	}
+	logResponse(s)
+	return nil
+}
+
+// slowLoris writes a valid request one byte per interval, and reports how long the target kept the stream
+// open.
+func slowLoris(ctx context.Context, h host.Host, pid peer.ID, interval time.Duration) error {
+	payload, err := validRequest()
+	if err != nil {
+		return err
+	}
+	s, err := h.NewStream(ctx, pid, blocksByRangeProtocol())
+	if err != nil {
+		return errors.Wrap(err, "could not open stream")
+	}
+	defer func() {
+		if err := s.Reset(); err != nil {
+			log.WithError(err).Debug("Could not reset stream")
+		}
+	}()
+	start := time.Now()
+	for i := range payload {
+		if _, err := s.Write(payload[i : i+1]); err != nil {
+			log.WithError(err).WithField("elapsed", time.Since(start)).WithField("bytesWritten", i).
+				Info("Target closed the slow stream")
+			return nil
+		}
+		time.Sleep(interval)
+	}
+	log.WithField("elapsed", time.Since(start)).Info("Target accepted the whole slow request")
+	logResponse(s)
+	return nil
+}
+
+func logResponse(s network.Stream) {
+	if err := s.SetReadDeadline(time.Now().Add(responseTimeout)); err != nil {
+		log.WithError(err).Debug("Could not set read deadline")
+	}
+	code := make([]byte, 1)
+	if _, err := s.Read(code); err != nil {
+		log.WithError(err).Info("Target closed the stream without a response")
+		return
+	}
+	log.WithField("responseCode", code[0]).Info("Target responded")
+}
+
+// publishInvalidGossip publishes random data as beacon blocks. The data is valid snappy, so the target
+// has to decode it before rejecting it.
+func publishInvalidGossip(ctx context.Context, h host.Host, digest []byte, count uint64, interval time.Duration) error {
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+		pubsub.WithNoAuthor(),
+		pubsub.WithMessageIdFn(func(pmsg *pubsubpb.Message) string {
+			h := hash.Hash(pmsg.Data)
+			return string(h[:20])
+		}),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not create gossipsub router")
+	}
+	topic, err := ps.Join(fmt.Sprintf(p2p.BlockSubnetTopicFormat, digest) + encoder.SszNetworkEncoder{}.ProtocolSuffix())
+	if err != nil {
+		return errors.Wrap(err, "could not join topic")
+	}
+	// Give the target time to graft us into its mesh of the topic.
+	time.Sleep(interval)
+	return repeat(count, interval, func() error {
+		junk := make([]byte, 512)
+		if _, err := rand.Read(junk); err != nil {
+			return err
+		}
+		if err := topic.Publish(ctx, snappy.Encode(nil /*dst*/, junk)); err != nil {
+			return errors.Wrap(err, "could not publish")
+		}
+		log.WithField("topic", topic.String()).Info("Published invalid gossip message")
+		return nil
+	})
+}
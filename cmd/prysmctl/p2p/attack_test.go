@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestPayloads(t *testing.T) {
+	e := encoder.SszNetworkEncoder{}
+
+	t.Run(modeMalformedRequest, func(t *testing.T) {
+		err := e.DecodeWithMaxLength(bytes.NewReader(malformedRequest()), &ethpb.BeaconBlocksByRangeRequest{})
+		require.NotNil(t, err)
+	})
+	t.Run(modeOversizedChunk, func(t *testing.T) {
+		b := oversizedChunk()
+		size, n := proto.DecodeVarint(b)
+		assert.Equal(t, params.BeaconNetworkConfig().MaxChunkSize+1, size)
+		require.Equal(t, true, n > 0)
+		err := e.DecodeWithMaxLength(bytes.NewReader(b), &ethpb.BeaconBlocksByRangeRequest{})
+		require.ErrorContains(t, "goes over the provided max limit", err)
+	})
+	t.Run(modeSlowLoris, func(t *testing.T) {
+		b, err := validRequest()
+		require.NoError(t, err)
+		req := &ethpb.BeaconBlocksByRangeRequest{}
+		require.NoError(t, e.DecodeWithMaxLength(bytes.NewReader(b), req))
+		assert.Equal(t, uint64(1), req.Count)
+	})
+}
+
+func TestSendRequest(t *testing.T) {
+	ctx := context.Background()
+	target, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, target.Close())
+	}()
+	received := make(chan error, 1)
+	target.SetStreamHandler(blocksByRangeProtocol(), func(s network.Stream) {
+		err := encoder.SszNetworkEncoder{}.DecodeWithMaxLength(s, &ethpb.BeaconBlocksByRangeRequest{})
+		received <- err
+		if _, err := s.Write([]byte{1}); err != nil {
+			t.Error(err)
+		}
+		require.NoError(t, s.Close())
+	})
+
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	require.NoError(t, h.Connect(ctx, peer.AddrInfo{ID: target.ID(), Addrs: target.Addrs()}))
+
+	require.NoError(t, sendRequest(ctx, h, target.ID(), blocksByRangeProtocol(), malformedRequest()))
+	select {
+	case err := <-received:
+		require.NotNil(t, err)
+	case <-time.After(responseTimeout):
+		t.Fatal("target did not receive the request")
+	}
+}
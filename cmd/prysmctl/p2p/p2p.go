@@ -0,0 +1,13 @@
+package p2p
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "p2p",
+		Usage: "commands for interacting with beacon nodes over the p2p network",
+		Subcommands: []*cli.Command{
+			attackCmd,
+		},
+	},
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+var diffFlags = struct {
+	JSON bool
+}{}
+
+var diffCmd = &cli.Command{
+	Name:      "diff",
+	ArgsUsage: "<ssz_a> <ssz_b>",
+	Usage: "Compare two ssz-encoded beacon states of the same fork field by field, including the fields of every validator, " +
+		"and report the generalized indices of the mismatching nodes of the state tree.",
+	Action: cliActionDiff,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:        "json",
+			Usage:       "write the report as JSON",
+			Destination: &diffFlags.JSON,
+		},
+	},
+}
+
+type diffReport struct {
+	StateRootA  string                  `json:"state_root_a"`
+	StateRootB  string                  `json:"state_root_b"`
+	Differences []*statediff.Difference `json:"differences"`
+}
+
+func cliActionDiff(c *cli.Context) error {
+	if c.Args().Len() != 2 {
+		return errors.New("expected the paths of two ssz-encoded beacon states")
+	}
+	report, err := diffStateFiles(c.Context, c.Args().Get(0), c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	if diffFlags.JSON {
+		return json.NewEncoder(os.Stdout).Encode(report)
+	}
+	return writeReport(os.Stdout, report)
+}
+
+func diffStateFiles(ctx context.Context, pathA, pathB string) (*diffReport, error) {
+	a, err := readState(pathA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := readState(pathB)
+	if err != nil {
+		return nil, err
+	}
+	rootA, err := a.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute state root of %s", pathA)
+	}
+	rootB, err := b.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute state root of %s", pathB)
+	}
+	diffs, err := statediff.Diff(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return &diffReport{
+		StateRootA:  fmt.Sprintf("%#x", rootA),
+		StateRootB:  fmt.Sprintf("%#x", rootB),
+		Differences: diffs,
+	}, nil
+}
+
+func readState(path string) (beaconstate.BeaconState, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	unmarshaler, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect the fork of the state in %s", path)
+	}
+	st, err := unmarshaler.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal the state in %s", path)
+	}
+	return st, nil
+}
+
+func writeReport(w io.Writer, report *diffReport) error {
+	fmt.Fprintf(w, "State root A: %s\nState root B: %s\n", report.StateRootA, report.StateRootB)
+	if len(report.Differences) == 0 {
+		_, err := fmt.Fprintln(w, "The states are identical")
+		return err
+	}
+	fmt.Fprintf(w, "%d differences:\n", len(report.Differences))
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "PATH\tGENERALIZED INDEX\tA\tB")
+	for _, d := range report.Differences {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", d.Path, d.GeneralizedIndex, d.A, d.B)
+	}
+	return tw.Flush()
+}
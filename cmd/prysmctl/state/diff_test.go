@@ -0,0 +1,47 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestDiffStateFiles(t *testing.T) {
+	a, _ := util.DeterministicGenesisStateAltair(t, 16)
+	b := a.Copy()
+	require.NoError(t, b.UpdateBalancesAtIndex(3, 1))
+
+	dir := t.TempDir()
+	pathA, pathB := filepath.Join(dir, "a.ssz"), filepath.Join(dir, "b.ssz")
+	for path, st := range map[string]interface{ MarshalSSZ() ([]byte, error) }{pathA: a, pathB: b} {
+		enc, err := st.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, file.WriteFile(path, enc))
+	}
+
+	report, err := diffStateFiles(context.Background(), pathA, pathB)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Differences))
+	assert.Equal(t, "balances[3]", report.Differences[0].Path)
+	assert.NotEqual(t, report.StateRootA, report.StateRootB)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeReport(buf, report))
+	assert.Equal(t, true, strings.Contains(buf.String(), "1 differences"))
+	assert.Equal(t, true, strings.Contains(buf.String(), "balances[3]"))
+
+	report, err = diffStateFiles(context.Background(), pathA, pathA)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Differences))
+	assert.Equal(t, report.StateRootA, report.StateRootB)
+
+	_, err = diffStateFiles(context.Background(), pathA, filepath.Join(dir, "missing.ssz"))
+	require.ErrorContains(t, "could not read", err)
+}
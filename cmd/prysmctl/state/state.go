@@ -0,0 +1,15 @@
+package state
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var Commands = []*cli.Command{
+	{
+		Name:  "state",
+		Usage: "commands for inspecting ssz-encoded beacon states",
+		Subcommands: []*cli.Command{
+			diffCmd,
+		},
+	},
+}
@@ -0,0 +1,276 @@
+package testnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/execution"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/io/file"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/interop"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var generateGenesisFlags = struct {
+	ChainConfigFile   string
+	ConfigName        string
+	NumValidators     uint64
+	GenesisTime       uint64
+	Fork              string
+	GethGenesisJSONIn string
+	OutputSSZ         string
+	OutputChainConfig string
+}{}
+
+var generateGenesisCmd = &cli.Command{
+	Name: "generate-genesis",
+	Usage: "Generate a genesis state at the given fork for a local testnet, with validators from the deterministic " +
+		"interop keys, and write the chain config with the forks up to the genesis fork scheduled at genesis.",
+	Action: cliActionGenerateGenesis,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "chain config YAML file to generate the genesis state with, instead of a named config",
+			Destination: &generateGenesisFlags.ChainConfigFile,
+		},
+		&cli.StringFlag{
+			Name:        "config-name",
+			Usage:       "name of the config to generate the genesis state with, when no chain config file is given",
+			Destination: &generateGenesisFlags.ConfigName,
+			Value:       params.MainnetName,
+		},
+		&cli.Uint64Flag{
+			Name:        "num-validators",
+			Usage:       "number of validators in the genesis state, with keys from the deterministic interop keys",
+			Destination: &generateGenesisFlags.NumValidators,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "genesis-time",
+			Usage:       "unix timestamp of the genesis, defaults to now",
+			Destination: &generateGenesisFlags.GenesisTime,
+		},
+		&cli.StringFlag{
+			Name:        "fork",
+			Usage:       fmt.Sprintf("fork of the genesis state, one of %s", strings.Join(genesisForks, ", ")),
+			Destination: &generateGenesisFlags.Fork,
+			Value:       version.String(version.Phase0),
+		},
+		&cli.StringFlag{
+			Name: "geth-genesis-json-in",
+			Usage: "genesis.json of the execution client, from which the latest execution payload header of a " +
+				"bellatrix genesis state is built. The header is left empty when it is not given",
+			Destination: &generateGenesisFlags.GethGenesisJSONIn,
+		},
+		&cli.StringFlag{
+			Name:        "output-ssz",
+			Usage:       "file to write the SSZ encoding of the genesis state to",
+			Destination: &generateGenesisFlags.OutputSSZ,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output-chain-config",
+			Usage:       "file to write the YAML chain config of the testnet to",
+			Destination: &generateGenesisFlags.OutputChainConfig,
+		},
+	},
+}
+
+// genesisForks are the forks a genesis state can be generated at. There is no Capella beacon state yet,
+// so a Capella genesis is rejected.
+var genesisForks = []string{
+	version.String(version.Phase0),
+	version.String(version.Altair),
+	version.String(version.Bellatrix),
+}
+
+func cliActionGenerateGenesis(_ *cli.Context) error {
+	ctx := context.Background()
+	f := generateGenesisFlags
+
+	if err := SetGenesisConfig(f.ChainConfigFile, f.ConfigName, f.Fork); err != nil {
+		return err
+	}
+	var gen *core.Genesis
+	if f.GethGenesisJSONIn != "" {
+		b, err := os.ReadFile(f.GethGenesisJSONIn) // #nosec G304
+		if err != nil {
+			return errors.Wrapf(err, "could not read %s", f.GethGenesisJSONIn)
+		}
+		gen = &core.Genesis{}
+		if err := json.Unmarshal(b, gen); err != nil {
+			return errors.Wrapf(err, "could not decode %s", f.GethGenesisJSONIn)
+		}
+	}
+	if f.GenesisTime == 0 {
+		log.Info("No --genesis-time specified, defaulting to now")
+	}
+
+	st, err := GenerateGenesis(ctx, f.Fork, f.GenesisTime, f.NumValidators, gen)
+	if err != nil {
+		return err
+	}
+	b, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal genesis state")
+	}
+	if err := file.WriteFile(f.OutputSSZ, b); err != nil {
+		return errors.Wrapf(err, "could not write genesis state to %s", f.OutputSSZ)
+	}
+	log.WithField("path", f.OutputSSZ).Info("Wrote genesis state")
+
+	if f.OutputChainConfig != "" {
+		if err := file.WriteFile(f.OutputChainConfig, params.ConfigToYaml(params.BeaconConfig())); err != nil {
+			return errors.Wrapf(err, "could not write chain config to %s", f.OutputChainConfig)
+		}
+		log.WithField("path", f.OutputChainConfig).Info("Wrote chain config")
+	}
+	return nil
+}
+
+// SetGenesisConfig activates the chain config of the testnet, in which the forks up to the genesis fork
+// are scheduled at genesis.
+func SetGenesisConfig(chainConfigFile, configName, fork string) error {
+	var cfg *params.BeaconChainConfig
+	if chainConfigFile != "" {
+		c, err := params.UnmarshalConfigFile(chainConfigFile, nil)
+		if err != nil {
+			return errors.Wrapf(err, "could not load chain config file %s", chainConfigFile)
+		}
+		cfg = c
+	} else {
+		c, err := params.ByName(configName)
+		if err != nil {
+			return errors.Wrapf(err, "could not find config %s", configName)
+		}
+		cfg = c.Copy()
+	}
+
+	v, err := forkVersion(fork)
+	if err != nil {
+		return err
+	}
+	if v >= version.Altair {
+		cfg.AltairForkEpoch = 0
+	}
+	if v >= version.Bellatrix {
+		cfg.BellatrixForkEpoch = 0
+	}
+	cfg.InitializeForkSchedule()
+	return params.SetActive(cfg)
+}
+
+// GenerateGenesis generates a phase0 genesis state from the deterministic interop keys, and upgrades
+// it to the given fork. The latest block header of the state is left as the phase0 genesis block
+// header, which is the genesis block the beacon node saves for any genesis state. A bellatrix genesis
+// state gets its latest execution payload header from the given execution genesis, if any.
+func GenerateGenesis(ctx context.Context, fork string, genesisTime, numValidators uint64, gen *core.Genesis) (state.BeaconState, error) {
+	v, err := forkVersion(fork)
+	if err != nil {
+		return nil, err
+	}
+	pb, _, err := interop.GenerateGenesisState(ctx, genesisTime, numValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate genesis state")
+	}
+	st, err := statenative.InitializeFromProtoUnsafePhase0(pb)
+	if err != nil {
+		return nil, err
+	}
+	if v == version.Phase0 {
+		return st, nil
+	}
+
+	upgraded, err := altair.UpgradeToAltair(ctx, st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not upgrade genesis state to altair")
+	}
+	genesisForkVersion := params.BeaconConfig().AltairForkVersion
+	if v >= version.Bellatrix {
+		upgraded, err = execution.UpgradeToBellatrix(upgraded)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not upgrade genesis state to bellatrix")
+		}
+		genesisForkVersion = params.BeaconConfig().BellatrixForkVersion
+		if gen != nil {
+			header, err := executionPayloadHeader(gen)
+			if err != nil {
+				return nil, err
+			}
+			wrapped, err := wrapper.WrappedExecutionPayloadHeader(header)
+			if err != nil {
+				return nil, err
+			}
+			if err := upgraded.SetLatestExecutionPayloadHeader(wrapped); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// A state starting at a fork has the version of that fork as both its previous and current version.
+	if err := upgraded.SetFork(&ethpb.Fork{
+		PreviousVersion: genesisForkVersion,
+		CurrentVersion:  genesisForkVersion,
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+	}); err != nil {
+		return nil, err
+	}
+	return upgraded, nil
+}
+
+// executionPayloadHeader builds the header of the genesis block of the execution chain described by the
+// execution client genesis.
+func executionPayloadHeader(gen *core.Genesis) (*enginev1.ExecutionPayloadHeader, error) {
+	block := gen.ToBlock(nil)
+	if block.BaseFee() == nil {
+		return nil, errors.New("execution genesis block has no base fee, london must be active at genesis")
+	}
+	txRoot, err := ssz.TransactionsRoot([][]byte{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute transactions root")
+	}
+	return &enginev1.ExecutionPayloadHeader{
+		ParentHash:       block.ParentHash().Bytes(),
+		FeeRecipient:     block.Coinbase().Bytes(),
+		StateRoot:        block.Root().Bytes(),
+		ReceiptsRoot:     block.ReceiptHash().Bytes(),
+		LogsBloom:        block.Bloom().Bytes(),
+		PrevRandao:       block.MixDigest().Bytes(),
+		BlockNumber:      block.NumberU64(),
+		GasLimit:         block.GasLimit(),
+		GasUsed:          block.GasUsed(),
+		Timestamp:        block.Time(),
+		ExtraData:        block.Extra(),
+		BaseFeePerGas:    bytesutil.PadTo(bytesutil.ReverseByteOrder(block.BaseFee().Bytes()), fieldparams.RootLength),
+		BlockHash:        block.Hash().Bytes(),
+		TransactionsRoot: txRoot[:],
+	}, nil
+}
+
+func forkVersion(fork string) (int, error) {
+	switch fork {
+	case version.String(version.Phase0):
+		return version.Phase0, nil
+	case version.String(version.Altair):
+		return version.Altair, nil
+	case version.String(version.Bellatrix):
+		return version.Bellatrix, nil
+	default:
+		return 0, fmt.Errorf("unsupported genesis fork %q, expected one of %s", fork, strings.Join(genesisForks, ", "))
+	}
+}
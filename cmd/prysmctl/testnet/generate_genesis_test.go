@@ -0,0 +1,94 @@
+package testnet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core"
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGenerateGenesis(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		fork        string
+		version     int
+		forkVersion func() []byte
+	}{
+		{
+			fork:        "phase0",
+			version:     version.Phase0,
+			forkVersion: func() []byte { return params.BeaconConfig().GenesisForkVersion },
+		},
+		{
+			fork:        "altair",
+			version:     version.Altair,
+			forkVersion: func() []byte { return params.BeaconConfig().AltairForkVersion },
+		},
+		{
+			fork:        "bellatrix",
+			version:     version.Bellatrix,
+			forkVersion: func() []byte { return params.BeaconConfig().BellatrixForkVersion },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fork, func(t *testing.T) {
+			require.NoError(t, SetGenesisConfig("", params.MinimalName, tt.fork))
+			st, err := GenerateGenesis(ctx, tt.fork, 1000, 64, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.version, st.Version())
+			assert.Equal(t, uint64(1000), st.GenesisTime())
+			assert.Equal(t, 64, st.NumValidators())
+			assert.DeepEqual(t, tt.forkVersion(), st.Fork().PreviousVersion)
+			assert.DeepEqual(t, tt.forkVersion(), st.Fork().CurrentVersion)
+		})
+	}
+
+	_, err := GenerateGenesis(ctx, "capella", 1000, 64, nil)
+	require.ErrorContains(t, "unsupported genesis fork", err)
+}
+
+func TestGenerateGenesis_ExecutionPayloadHeader(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	require.NoError(t, SetGenesisConfig("", params.MinimalName, "bellatrix"))
+	assert.Equal(t, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().AltairForkEpoch)
+	assert.Equal(t, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().BellatrixForkEpoch)
+
+	gen := &core.Genesis{
+		Config:     gethparams.AllEthashProtocolChanges,
+		Timestamp:  1000,
+		GasLimit:   30000000,
+		Difficulty: big.NewInt(1),
+		ExtraData:  []byte("prysm"),
+	}
+	st, err := GenerateGenesis(context.Background(), "bellatrix", 1000, 64, gen)
+	require.NoError(t, err)
+	header, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	block := gen.ToBlock(nil)
+	assert.DeepEqual(t, block.Hash().Bytes(), header.BlockHash)
+	assert.DeepEqual(t, block.Root().Bytes(), header.StateRoot)
+	assert.DeepEqual(t, []byte("prysm"), header.ExtraData)
+	assert.Equal(t, uint64(30000000), header.GasLimit)
+	assert.Equal(t, uint64(1000), header.Timestamp)
+	assert.Equal(t, uint64(gethparams.InitialBaseFee), new(big.Int).SetBytes(reverse(header.BaseFeePerGas)).Uint64())
+
+	gen.Config = &gethparams.ChainConfig{ChainID: big.NewInt(1)}
+	_, err = GenerateGenesis(context.Background(), "bellatrix", 1000, 64, gen)
+	require.ErrorContains(t, "no base fee", err)
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
@@ -0,0 +1,13 @@
+package testnet
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "testnet",
+		Usage: "commands for setting up local testnets",
+		Subcommands: []*cli.Command{
+			generateGenesisCmd,
+		},
+	},
+}
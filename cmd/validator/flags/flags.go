@@ -81,7 +81,7 @@ var (
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
 		Name:  "graffiti",
-		Usage: "String to include in proposed blocks",
+		Usage: "String to include in proposed blocks. When a graffiti file is given, this is only used if the file specifies no graffiti for the proposer",
 	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
@@ -113,6 +113,23 @@ var (
 		Usage: "Enable gRPC gateway for JSON requests",
 		Value: 7500,
 	}
+	// GRPCGatewaySocket specifies a unix domain socket to serve the gRPC gateway on.
+	GRPCGatewaySocket = &cli.StringFlag{
+		Name: "grpc-gateway-socket",
+		Usage: "Path of a unix domain socket on which the gateway server runs on, instead of " +
+			"--grpc-gateway-host and --grpc-gateway-port",
+	}
+	// GRPCGatewaySocketActivation serves the gRPC gateway on a socket passed by the service manager.
+	GRPCGatewaySocketActivation = &cli.BoolFlag{
+		Name: "grpc-gateway-socket-activation",
+		Usage: "Run the gateway server on the socket passed by the service manager, such as a systemd " +
+			"socket unit, instead of --grpc-gateway-host and --grpc-gateway-port",
+	}
+	// GRPCGatewayPathPrefix specifies a path prefix under which the gRPC gateway endpoints are served.
+	GRPCGatewayPathPrefix = &cli.StringFlag{
+		Name:  "grpc-gateway-path-prefix",
+		Usage: "Path prefix under which all the gateway endpoints are served, for example /validator behind a reverse proxy",
+	}
 	// GPRCGatewayCorsDomain serves preflight requests when serving gRPC JSON gateway.
 	GPRCGatewayCorsDomain = &cli.StringFlag{
 		Name: "grpc-gateway-corsdomain",
@@ -314,7 +331,7 @@ var (
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
-		Usage: "The path to a YAML file with graffiti values",
+		Usage: "The path to a YAML file with graffiti values, which is reloaded whenever it changes",
 	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
@@ -322,6 +339,100 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// SlashingProtectionPruningMarginFlag defines the number of epochs of slashing protection history
+	// kept before the finalized epoch when pruning the slashing protection database.
+	SlashingProtectionPruningMarginFlag = &cli.Uint64Flag{
+		Name: "slashing-protection-pruning-margin",
+		Usage: "Number of epochs of slashing protection history kept before the finalized epoch, when pruning " +
+			"is enabled with --enable-slashing-protection-history-pruning",
+		Value: 512,
+	}
+	// HeartbeatAddrFlag defines the address on which a primary validator client serves its heartbeat.
+	HeartbeatAddrFlag = &cli.StringFlag{
+		Name: "heartbeat-addr",
+		Usage: "Address on which the validator client serves the watermarks of its slashing protection history " +
+			"to a hot standby validator client (i.e. --heartbeat-addr=127.0.0.1:7600). Requires --heartbeat-secret-file",
+	}
+	// StandbyPrimaryURLFlag runs the validator client as a hot standby of the primary at the given URL.
+	StandbyPrimaryURLFlag = &cli.StringFlag{
+		Name: "standby-primary-url",
+		Usage: "Runs the validator client as a hot standby of the primary validator client serving its heartbeat " +
+			"at this URL (i.e. --standby-primary-url=http://10.0.0.1:7600). The standby performs no duties until a " +
+			"heartbeat was received and then lost for --standby-failover-timeout, then refuses to sign anything at or " +
+			"below the watermarks received from the primary, which are saved to its slashing protection database. " +
+			"Requires --heartbeat-secret-file",
+	}
+	// HeartbeatSecretFileFlag defines the path to the secret shared by a primary and a standby validator client.
+	HeartbeatSecretFileFlag = &cli.StringFlag{
+		Name:  "heartbeat-secret-file",
+		Usage: "Path to a file containing the secret shared by a primary and a hot standby validator client to authenticate heartbeats",
+	}
+	// StandbyFailoverTimeoutFlag defines how long a standby waits without heartbeat before taking over.
+	StandbyFailoverTimeoutFlag = &cli.DurationFlag{
+		Name:  "standby-failover-timeout",
+		Usage: "Duration without heartbeat from the primary validator client after which a hot standby takes over its duties",
+		Value: time.Minute,
+	}
+	// SigningAuditLogDirFlag enables the signing audit log in the given directory.
+	SigningAuditLogDirFlag = &cli.StringFlag{
+		Name: "signing-audit-log-dir",
+		Usage: "Directory of an append-only audit log recording every sign request of the validator client, with its " +
+			"type, slot, signing root, public key and outcome. The audit log is disabled when unset",
+	}
+	// SigningAuditLogMaxSizeFlag defines the size in megabytes above which the signing audit log is rotated.
+	SigningAuditLogMaxSizeFlag = &cli.Uint64Flag{
+		Name:  "signing-audit-log-max-size-mb",
+		Usage: "Size in megabytes above which the signing audit log file is rotated",
+		Value: 100,
+	}
+	// SigningAuditLogMaxFilesFlag defines the number of rotated signing audit log files which are kept.
+	SigningAuditLogMaxFilesFlag = &cli.IntFlag{
+		Name:  "signing-audit-log-max-files",
+		Usage: "Number of rotated signing audit log files kept, the oldest ones being removed. All files are kept when set to 0",
+		Value: 10,
+	}
+	// SigningAuditExportFileFlag defines the file the signing audit log is exported to.
+	SigningAuditExportFileFlag = &cli.StringFlag{
+		Name:  "signing-audit-export-file",
+		Usage: "Path of the file the records of the signing audit log are exported to, as JSON lines",
+	}
+	// RemoteSlashingProtectionURLFlag defines the URL of a remote slashing protection service checked before signing.
+	RemoteSlashingProtectionURLFlag = &cli.StringFlag{
+		Name: "remote-slashing-protection-url",
+		Usage: "URL of an external slashing protection service asked before signing every block and attestation " +
+			"(i.e. --remote-slashing-protection-url=http://10.0.0.1:9000), for validator clients sharing keys. " +
+			"Messages are not signed if the service refuses them or cannot be reached",
+	}
+	// RemoteSlashingProtectionTimeoutFlag defines how long the remote slashing protection service has to answer.
+	RemoteSlashingProtectionTimeoutFlag = &cli.DurationFlag{
+		Name:  "remote-slashing-protection-timeout",
+		Usage: "Duration after which a message is not signed if the remote slashing protection service did not answer",
+		Value: time.Second,
+	}
+	// DVTMiddlewareURLFlag defines the URL of the local distributed validator middleware to sign with.
+	DVTMiddlewareURLFlag = &cli.StringFlag{
+		Name: "dvt-middleware-url",
+		Usage: "URL of the Web3Signer compatible API of the local distributed validator middleware " +
+			"(i.e. --dvt-middleware-url=http://localhost:3600), which signs with the partial keys of the cluster members",
+	}
+	// DVTPublicKeysFlag defines the public keys of the distributed validators or the URL to retrieve them from.
+	DVTPublicKeysFlag = &cli.StringSliceFlag{
+		Name:  "dvt-public-keys",
+		Usage: "Comma separated list of the public keys of the distributed validators OR an url endpoint of the middleware to retrieve them from",
+	}
+	// DVTClusterMembersFlag defines the health endpoints of the members of the distributed validator cluster.
+	DVTClusterMembersFlag = &cli.StringSliceFlag{
+		Name: "dvt-cluster-members",
+		Usage: "Comma separated list of the URLs of the health endpoints of the middlewares of the cluster members " +
+			"(i.e. --dvt-cluster-members=http://10.0.0.2:3620/readyz), whose health is exported as metrics",
+	}
+	// DVTSigningLatencyFlag defines the time expected for the cluster to combine partial signatures.
+	DVTSigningLatencyFlag = &cli.DurationFlag{
+		Name: "dvt-signing-latency",
+		Usage: "Time expected for the distributed validator cluster to combine the partial signatures of its members. " +
+			"Attestations and aggregates are started this much earlier, or more if signing is observed to be slower",
+		Value: 500 * time.Millisecond,
+	}
 
 	// ProposerSettingsFlag defines the path or URL to a file with proposer config.
 	ProposerSettingsFlag = &cli.StringFlag{
@@ -18,4 +18,16 @@ var (
 			"Example: --interop-start-index=5 --interop-num-validators=3 would generate " +
 			"keys from index 5 to 7.",
 	}
+	// InteropSignerHost is the host the interop signer listens on.
+	InteropSignerHost = &cli.StringFlag{
+		Name:  "interop-signer-host",
+		Usage: "Host on which the interop signer listens for signing requests of validator clients.",
+		Value: "127.0.0.1",
+	}
+	// InteropSignerPort is the port the interop signer listens on.
+	InteropSignerPort = &cli.IntFlag{
+		Name:  "interop-signer-port",
+		Usage: "Port on which the interop signer listens for signing requests of validator clients.",
+		Value: 9000,
+	}
 )
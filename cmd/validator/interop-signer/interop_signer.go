@@ -0,0 +1,62 @@
+package interopsignercmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	interopsigner "github.com/prysmaticlabs/prysm/validator/interop-signer"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "interop-signer")
+
+const shutdownTimeout = 5 * time.Second
+
+// Commands for running a signer of deterministic interop keys.
+var Commands = &cli.Command{
+	Name:     "interop-signer",
+	Category: "interop",
+	Usage: "runs a remote signer for the deterministic interop keys of a range of validator indices, for local devnets. " +
+		"Validator clients use it with --validators-external-signer-url=http://<host>:<port> and " +
+		"--validators-external-signer-public-keys=http://<host>:<port>/api/v1/eth2/publicKeys. " +
+		"The signer has no slashing protection and must never be used with real keys",
+	Flags: cmd.WrapFlags([]cli.Flag{
+		flags.InteropStartIndex,
+		flags.InteropNumValidators,
+		flags.InteropSignerHost,
+		flags.InteropSignerPort,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+	},
+	Action: func(cliCtx *cli.Context) error {
+		addr := fmt.Sprintf("%s:%d", cliCtx.String(flags.InteropSignerHost.Name), cliCtx.Int(flags.InteropSignerPort.Name))
+		s, err := interopsigner.New(
+			cliCtx.Uint64(flags.InteropStartIndex.Name),
+			cliCtx.Uint64(flags.InteropNumValidators.Name),
+			addr,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.Start(); err != nil {
+			return err
+		}
+
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		<-sigc
+		log.Info("Shutting down interop signer")
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return s.Stop(ctx)
+	},
+}
@@ -15,6 +15,8 @@ import (
 	accountcommands "github.com/prysmaticlabs/prysm/cmd/validator/accounts"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/validator/db"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	interopsignercommands "github.com/prysmaticlabs/prysm/cmd/validator/interop-signer"
+	signingauditcommands "github.com/prysmaticlabs/prysm/cmd/validator/signing-audit"
 	slashingprotectioncommands "github.com/prysmaticlabs/prysm/cmd/validator/slashing-protection"
 	walletcommands "github.com/prysmaticlabs/prysm/cmd/validator/wallet"
 	"github.com/prysmaticlabs/prysm/cmd/validator/web"
@@ -59,6 +61,9 @@ var appFlags = []cli.Flag{
 	flags.RPCPort,
 	flags.GRPCGatewayPort,
 	flags.GRPCGatewayHost,
+	flags.GRPCGatewaySocket,
+	flags.GRPCGatewaySocketActivation,
+	flags.GRPCGatewayPathPrefix,
 	flags.GrpcRetriesFlag,
 	flags.GrpcRetryDelayFlag,
 	flags.GrpcHeadersFlag,
@@ -72,6 +77,20 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDutyCountDown,
+	flags.SlashingProtectionPruningMarginFlag,
+	flags.HeartbeatAddrFlag,
+	flags.StandbyPrimaryURLFlag,
+	flags.HeartbeatSecretFileFlag,
+	flags.StandbyFailoverTimeoutFlag,
+	flags.SigningAuditLogDirFlag,
+	flags.SigningAuditLogMaxSizeFlag,
+	flags.SigningAuditLogMaxFilesFlag,
+	flags.RemoteSlashingProtectionURLFlag,
+	flags.RemoteSlashingProtectionTimeoutFlag,
+	flags.DVTMiddlewareURLFlag,
+	flags.DVTPublicKeysFlag,
+	flags.DVTClusterMembersFlag,
+	flags.DVTSigningLatencyFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
@@ -128,6 +147,8 @@ func main() {
 		slashingprotectioncommands.Commands,
 		dbcommands.Commands,
 		web.Commands,
+		interopsignercommands.Commands,
+		signingauditcommands.Commands,
 	}
 
 	app.Flags = appFlags
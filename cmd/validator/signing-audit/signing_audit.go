@@ -0,0 +1,71 @@
+package signingauditcmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/io/file"
+	signingaudit "github.com/prysmaticlabs/prysm/validator/signing-audit"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "signing-audit")
+
+// Commands for the signing audit log of the validator client.
+var Commands = &cli.Command{
+	Name:     "signing-audit",
+	Category: "signing-audit",
+	Usage:    "defines commands for interacting with the signing audit log of the validator client",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: `exports the records of the signing audit log, including the rotated files, into a single file ` +
+				`of JSON lines in chronological order`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SigningAuditLogDirFlag,
+				flags.SigningAuditExportFileFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportSigningAudit(cliCtx); err != nil {
+					log.Fatalf("Could not export signing audit log: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+func exportSigningAudit(cliCtx *cli.Context) error {
+	dir := cliCtx.String(flags.SigningAuditLogDirFlag.Name)
+	if dir == "" {
+		return errors.Errorf("--%s is required", flags.SigningAuditLogDirFlag.Name)
+	}
+	outputPath := cliCtx.String(flags.SigningAuditExportFileFlag.Name)
+	if outputPath == "" {
+		return errors.Errorf("--%s is required", flags.SigningAuditExportFileFlag.Name)
+	}
+	if err := file.MkdirAll(filepath.Dir(outputPath)); err != nil {
+		return errors.Wrapf(err, "could not create output directory of %s", outputPath)
+	}
+	out, err := os.OpenFile(filepath.Clean(outputPath), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrapf(err, "could not create export file %s", outputPath)
+	}
+	count, err := signingaudit.Export(dir, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	log.WithField("records", count).Infof("Exported signing audit log to %s", outputPath)
+	return nil
+}
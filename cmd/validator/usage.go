@@ -96,6 +96,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCPort,
 			flags.GRPCGatewayPort,
 			flags.GRPCGatewayHost,
+			flags.GRPCGatewaySocket,
+			flags.GRPCGatewaySocketActivation,
+			flags.GRPCGatewayPathPrefix,
 			flags.GrpcRetriesFlag,
 			flags.GrpcRetryDelayFlag,
 			flags.GPRCGatewayCorsDomain,
@@ -107,6 +110,20 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
 			flags.EnableDutyCountDown,
+			flags.SlashingProtectionPruningMarginFlag,
+			flags.HeartbeatAddrFlag,
+			flags.StandbyPrimaryURLFlag,
+			flags.HeartbeatSecretFileFlag,
+			flags.StandbyFailoverTimeoutFlag,
+			flags.SigningAuditLogDirFlag,
+			flags.SigningAuditLogMaxSizeFlag,
+			flags.SigningAuditLogMaxFilesFlag,
+			flags.RemoteSlashingProtectionURLFlag,
+			flags.RemoteSlashingProtectionTimeoutFlag,
+			flags.DVTMiddlewareURLFlag,
+			flags.DVTPublicKeysFlag,
+			flags.DVTClusterMembersFlag,
+			flags.DVTSigningLatencyFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.ProposerSettingsFlag,
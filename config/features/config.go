@@ -54,18 +54,21 @@ type Flags struct {
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
 
 	// Bug fixes related flags.
-	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
+	DisableAttestTimely bool // DisableAttestTimely makes the validator wait for one third of the slot before attesting even when the block of the slot was received earlier.
 
 	EnableSlasher bool // Enable slasher in the beacon node runtime.
 	// EnableSlashingProtectionPruning for the validator client.
 	EnableSlashingProtectionPruning bool
 
-	EnableNativeState                bool // EnableNativeState defines whether the beacon state will be represented as a pure Go struct or a Go struct that wraps a proto struct.
 	EnablePullTips                   bool // EnablePullTips enables experimental disabling of boundary checks.
 	EnableVectorizedHTR              bool // EnableVectorizedHTR specifies whether the beacon state will use the optimized sha256 routines.
 	EnableForkChoiceDoublyLinkedTree bool // EnableForkChoiceDoublyLinkedTree specifies whether fork choice store will use a doubly linked tree.
 	EnableBatchGossipAggregation     bool // EnableBatchGossipAggregation specifies whether to further aggregate our gossip batches before verifying them.
 	EnableOnlyBlindedBeaconBlocks    bool // EnableOnlyBlindedBeaconBlocks enables only storing blinded beacon blocks in the DB post-Bellatrix fork.
+	EnableReorgLateBlocks            bool // EnableReorgLateBlocks specifies whether the proposer may orphan a late and weakly attested head block.
+	EnableBlockPackingOptimizer      bool // EnableBlockPackingOptimizer specifies whether the proposer selects the block operations by the reward they bring.
+	EnableValidatorTrieCache         bool // EnableValidatorTrieCache specifies whether the validators field trie is rebuilt from per-epoch snapshots.
+	EnableCheckpointStateRPC         bool // EnableCheckpointStateRPC specifies whether finalized states are served to trusted peers over req/resp.
 
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
@@ -208,11 +211,6 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
 	}
-	cfg.EnableNativeState = true
-	if ctx.Bool(disableNativeState.Name) {
-		logDisabled(disableNativeState)
-		cfg.EnableNativeState = false
-	}
 	if ctx.Bool(enablePullTips.Name) {
 		logEnabled(enablePullTips)
 		cfg.EnablePullTips = true
@@ -233,6 +231,22 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(EnableOnlyBlindedBeaconBlocks)
 		cfg.EnableOnlyBlindedBeaconBlocks = true
 	}
+	if ctx.Bool(enableReorgLateBlocks.Name) {
+		logEnabled(enableReorgLateBlocks)
+		cfg.EnableReorgLateBlocks = true
+	}
+	if ctx.Bool(enableBlockPackingOptimizer.Name) {
+		logEnabled(enableBlockPackingOptimizer)
+		cfg.EnableBlockPackingOptimizer = true
+	}
+	if ctx.Bool(enableValidatorTrieCache.Name) {
+		logEnabled(enableValidatorTrieCache)
+		cfg.EnableValidatorTrieCache = true
+	}
+	if ctx.Bool(enableCheckpointStateRPC.Name) {
+		logEnabled(enableCheckpointStateRPC)
+		cfg.EnableCheckpointStateRPC = true
+	}
 	Init(cfg)
 	return nil
 }
@@ -259,9 +273,9 @@ func ConfigureValidator(ctx *cli.Context) error {
 		logDisabled(disableAttestingHistoryDBCache)
 		cfg.DisableAttestingHistoryDBCache = true
 	}
-	if ctx.Bool(attestTimely.Name) {
-		logEnabled(attestTimely)
-		cfg.AttestTimely = true
+	if ctx.Bool(disableAttestTimely.Name) {
+		logDisabled(disableAttestTimely)
+		cfg.DisableAttestTimely = true
 	}
 	if ctx.Bool(enableSlashingProtectionPruning.Name) {
 		logEnabled(enableSlashingProtectionPruning)
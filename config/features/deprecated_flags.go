@@ -120,6 +120,16 @@ var (
 		Usage:  deprecatedUsage,
 		Hidden: true,
 	}
+	deprecatedAttestTimely = &cli.BoolFlag{
+		Name:   "attest-timely",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
+	deprecatedDisableNativeState = &cli.BoolFlag{
+		Name:   "disable-native-state",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
 )
 
 var deprecatedFlags = []cli.Flag{
@@ -144,4 +154,6 @@ var deprecatedFlags = []cli.Flag{
 	deprecatedDisableCorrectlyInsertOrphanedAtts,
 	deprecatedDisableCorrectlyPruneCanonicalAtts,
 	deprecatedEnableNativeState,
+	deprecatedAttestTimely,
+	deprecatedDisableNativeState,
 }
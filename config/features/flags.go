@@ -78,9 +78,10 @@ var (
 		Name:  "disable-broadcast-slashings",
 		Usage: "Disables broadcasting slashings submitted to the beacon node.",
 	}
-	attestTimely = &cli.BoolFlag{
-		Name:  "attest-timely",
-		Usage: "Fixes validator can attest timely after current block processes. See #8185 for more details",
+	disableAttestTimely = &cli.BoolFlag{
+		Name: "disable-attest-timely",
+		Usage: "Disables attesting as soon as the block of the slot is received. The validator then always " +
+			"waits for one third of the slot before attesting, unless the block was received before its duty started",
 	}
 	enableSlasherFlag = &cli.BoolFlag{
 		Name:  "slasher",
@@ -102,10 +103,6 @@ var (
 			" (Warning): Once enabled, this feature migrates your database in to a new schema and " +
 			"there is no going back. At worst, your entire database might get corrupted.",
 	}
-	disableNativeState = &cli.BoolFlag{
-		Name:  "disable-native-state",
-		Usage: "Disables representing the beacon state as a pure Go struct.",
-	}
 	enablePullTips = &cli.BoolFlag{
 		Name:  "experimental-disable-boundary-checks",
 		Usage: "Experimental disable of boundary checks, useful for debugging, may cause bad votes.",
@@ -126,6 +123,25 @@ var (
 		Name:  "enable-only-blinded-beacon-blocks",
 		Usage: "Enables storing only blinded beacon blocks in the database without full execution layer transactions",
 	}
+	enableReorgLateBlocks = &cli.BoolFlag{
+		Name:  "enable-reorg-late-blocks",
+		Usage: "Enables proposing on top of the parent of a late and weakly attested head block, orphaning the late block",
+	}
+	enableBlockPackingOptimizer = &cli.BoolFlag{
+		Name: "enable-block-packing-optimizer",
+		Usage: "Enables selecting the attestations and slashings of proposed blocks by the reward they bring to the " +
+			"proposer, instead of by their order in the pools",
+	}
+	enableValidatorTrieCache = &cli.BoolFlag{
+		Name: "enable-validator-trie-cache",
+		Usage: "Enables rebuilding the validator registry trie of beacon states from per-epoch snapshots, " +
+			"only rehashing the validators which changed since the snapshot",
+	}
+	enableCheckpointStateRPC = &cli.BoolFlag{
+		Name: "enable-checkpoint-state-rpc",
+		Usage: "Serves finalized beacon states to trusted peers over the beacon_state_by_checkpoint req/resp protocol, " +
+			"so that they can checkpoint sync from this node without an HTTP provider",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -146,7 +162,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	SepoliaTestnet,
 	Mainnet,
 	dynamicKeyReloadDebounceInterval,
-	attestTimely,
+	disableAttestTimely,
 	enableSlashingProtectionPruning,
 	enableDoppelGangerProtection,
 }...)
@@ -171,12 +187,15 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
 	enableHistoricalSpaceRepresentation,
-	disableNativeState,
 	enablePullTips,
 	enableVecHTR,
 	enableForkChoiceDoublyLinkedTree,
 	enableGossipBatchAggregation,
 	EnableOnlyBlindedBeaconBlocks,
+	enableReorgLateBlocks,
+	enableBlockPackingOptimizer,
+	enableValidatorTrieCache,
+	enableCheckpointStateRPC,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
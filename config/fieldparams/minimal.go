@@ -22,4 +22,6 @@ const (
 	FeeRecipientLength              = 20            // FeeRecipientLength defines the byte length of a fee recipient.
 	LogsBloomLength                 = 256           // LogsBloomLength defines the byte length of a logs bloom.
 	VersionLength                   = 4             // VersionLength defines the byte length of a fork version number.
+	BlobLength                      = 131072        // BlobLength defines the byte length of a blob, FIELD_ELEMENTS_PER_BLOB * BYTES_PER_FIELD_ELEMENT.
+	MaxBlobsPerBlock                = 4             // MAX_BLOBS_PER_BLOCK
 )
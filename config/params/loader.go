@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/math"
 	log "github.com/sirupsen/logrus"
@@ -82,6 +83,36 @@ func LoadChainConfigFile(path string, conf *BeaconChainConfig) error {
 	return SetActive(c)
 }
 
+// ValidatePreset checks that the config is compatible with the preset the binary was compiled with,
+// as the preset values which size the beacon state can not be changed at runtime.
+func ValidatePreset(c *BeaconChainConfig) error {
+	if c.PresetBase != fieldparams.Preset {
+		return errors.Errorf("config is based on the %s preset, but the binary was compiled for the %s preset",
+			c.PresetBase, fieldparams.Preset)
+	}
+	values := []struct {
+		name   string
+		config uint64
+		preset uint64
+	}{
+		{"SLOTS_PER_HISTORICAL_ROOT", uint64(c.SlotsPerHistoricalRoot), fieldparams.BlockRootsLength},
+		{"EPOCHS_PER_HISTORICAL_VECTOR", uint64(c.EpochsPerHistoricalVector), fieldparams.RandaoMixesLength},
+		{"EPOCHS_PER_SLASHINGS_VECTOR", uint64(c.EpochsPerSlashingsVector), fieldparams.SlashingsLength},
+		{"HISTORICAL_ROOTS_LIMIT", c.HistoricalRootsLimit, fieldparams.HistoricalRootsLength},
+		{"VALIDATOR_REGISTRY_LIMIT", c.ValidatorRegistryLimit, fieldparams.ValidatorRegistryLimit},
+		{"SYNC_COMMITTEE_SIZE", c.SyncCommitteeSize, fieldparams.SyncCommitteeLength},
+		{"SLOTS_PER_EPOCH * EPOCHS_PER_ETH1_VOTING_PERIOD", uint64(c.SlotsPerEpoch.Mul(uint64(c.EpochsPerEth1VotingPeriod))), fieldparams.Eth1DataVotesLength},
+		{"SLOTS_PER_EPOCH * MAX_ATTESTATIONS", uint64(c.SlotsPerEpoch.Mul(c.MaxAttestations)), fieldparams.CurrentEpochAttestationsLength},
+	}
+	for _, v := range values {
+		if v.config != v.preset {
+			return errors.Errorf("%s of the config is %d, but it is fixed to %d by the %s preset",
+				v.name, v.config, v.preset, fieldparams.Preset)
+		}
+	}
+	return nil
+}
+
 // ReplaceHexStringWithYAMLFormat will replace hex strings that the yaml parser will understand.
 func ReplaceHexStringWithYAMLFormat(line string) []string {
 	parts := strings.Split(line, "0x")
@@ -194,6 +225,8 @@ func ConfigToYaml(cfg *BeaconChainConfig) []byte {
 		fmt.Sprintf("ALTAIR_FORK_VERSION: %#x", cfg.AltairForkVersion),
 		fmt.Sprintf("BELLATRIX_FORK_EPOCH: %d", cfg.BellatrixForkEpoch),
 		fmt.Sprintf("BELLATRIX_FORK_VERSION: %#x", cfg.BellatrixForkVersion),
+		fmt.Sprintf("CAPELLA_FORK_EPOCH: %d", cfg.CapellaForkEpoch),
+		fmt.Sprintf("CAPELLA_FORK_VERSION: %#x", cfg.CapellaForkVersion),
 		fmt.Sprintf("SHARDING_FORK_EPOCH: %d", cfg.ShardingForkEpoch),
 		fmt.Sprintf("SHARDING_FORK_VERSION: %#x", cfg.ShardingForkVersion),
 		fmt.Sprintf("INACTIVITY_SCORE_BIAS: %d", cfg.InactivityScoreBias),
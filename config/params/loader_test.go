@@ -273,6 +273,19 @@ func TestConfigParityYaml(t *testing.T) {
 	assert.DeepEqual(t, params.BeaconConfig(), testCfg)
 }
 
+func TestValidatePreset(t *testing.T) {
+	require.NoError(t, params.ValidatePreset(params.MainnetConfig().Copy()))
+	require.ErrorContains(t, "config is based on the minimal preset", params.ValidatePreset(params.MinimalSpecConfig().Copy()))
+
+	cfg := params.MainnetConfig().Copy()
+	cfg.EpochsPerHistoricalVector = 16
+	require.ErrorContains(t, "EPOCHS_PER_HISTORICAL_VECTOR of the config is 16", params.ValidatePreset(cfg))
+
+	cfg = params.MainnetConfig().Copy()
+	cfg.SlotsPerEpoch = 8
+	require.ErrorContains(t, "SLOTS_PER_EPOCH * EPOCHS_PER_ETH1_VOTING_PERIOD", params.ValidatePreset(cfg))
+}
+
 // configFilePath sets the proper config and returns the relevant
 // config file path from eth2-spec-tests directory.
 func configFilePath(t *testing.T, config string) string {
@@ -31,8 +31,16 @@ var mainnetNetworkConfig = &NetworkConfig{
 	MaxChunkSize:                    1 << 20,      // 1 MiB
 	MaxChunkSizeBellatrix:           10 * 1 << 20, // 10 MiB
 	AttestationSubnetCount:          64,
+	AttestationSubnetExtraBits:      0,
+	AttestationSubnetPrefixBits:     6, // ceillog2(AttestationSubnetCount) + AttestationSubnetExtraBits
+	SubnetsPerNode:                  2,
+	EpochsPerSubnetSubscription:     256,
+	NodeIdBits:                      256,
 	AttestationPropagationSlotRange: 32,
 	MaxRequestBlocks:                1 << 10, // 1024
+	MaxRequestBlobSidecars:          512,     // MAX_REQUEST_BLOCKS_DENEB * MAX_BLOBS_PER_BLOCK
+	MinEpochsForBlobSidecarsRequest: 4096,    // 2**12 (= 4096 epochs, ~18 days)
+	MaxCheckpointStateSize:          1 << 30, // 1 GiB
 	TtfbTimeout:                     5 * time.Second,
 	RespTimeout:                     10 * time.Second,
 	MaximumGossipClockDisparity:     500 * time.Millisecond,
@@ -94,8 +102,9 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	EffectiveBalanceIncrement: 1 * 1e9,
 
 	// Initial value constants.
-	BLSWithdrawalPrefixByte: byte(0),
-	ZeroHash:                [32]byte{},
+	BLSWithdrawalPrefixByte:         byte(0),
+	ETH1AddressWithdrawalPrefixByte: byte(1),
+	ZeroHash:                        [32]byte{},
 
 	// Time parameter constants.
 	MinAttestationInclusionDelay:     1,
@@ -117,6 +126,11 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	ProposerScoreBoost: 40,
 	IntervalsPerSlot:   3,
 
+	// Late block reorg constants.
+	ReorgWeightThreshold:            20,
+	ReorgParentWeightThreshold:      160,
+	ReorgMaxEpochsSinceFinalization: 2,
+
 	// Ethereum PoW parameters.
 	DepositChainID:         1, // Chain ID of eth1 mainnet.
 	DepositNetworkID:       1, // Network ID of eth1 mainnet.
@@ -149,11 +163,16 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	ProportionalSlashingMultiplier: 1,
 
 	// Max operations per block constants.
-	MaxProposerSlashings: 16,
-	MaxAttesterSlashings: 2,
-	MaxAttestations:      128,
-	MaxDeposits:          16,
-	MaxVoluntaryExits:    16,
+	MaxProposerSlashings:     16,
+	MaxAttesterSlashings:     2,
+	MaxAttestations:          128,
+	MaxDeposits:              16,
+	MaxVoluntaryExits:        16,
+	MaxBlsToExecutionChanges: 16,
+
+	// Withdrawals sweep constants.
+	MaxWithdrawalsPerPayload:         16,
+	MaxValidatorsPerWithdrawalsSweep: 16384,
 
 	// BLS domain values.
 	DomainBeaconProposer:              bytesutil.Uint32ToBytes4(0x00000000),
@@ -166,6 +185,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DomainSyncCommittee:               bytesutil.Uint32ToBytes4(0x07000000),
 	DomainSyncCommitteeSelectionProof: bytesutil.Uint32ToBytes4(0x08000000),
 	DomainContributionAndProof:        bytesutil.Uint32ToBytes4(0x09000000),
+	DomainBLSToExecutionChange:        bytesutil.Uint32ToBytes4(0x0A000000),
 	DomainApplicationMask:             bytesutil.Uint32ToBytes4(0x00000001),
 	DomainApplicationBuilder:          bytesutil.Uint32ToBytes4(0x00000001),
 
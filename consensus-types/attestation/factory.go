@@ -0,0 +1,101 @@
+package attestation
+
+import (
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+var (
+	// ErrNilObjectWrapped is returned in a constructor when the underlying object is nil.
+	ErrNilObjectWrapped = errors.New("attempted to wrap nil object")
+	// errUnsupportedAttestation is returned when the struct type is not a supported attestation type.
+	errUnsupportedAttestation = errors.New("unsupported attestation")
+	// errUnsupportedAggregate is returned when the struct type is not a supported aggregate attestation
+	// and proof type.
+	errUnsupportedAggregate = errors.New("unsupported aggregate attestation and proof")
+	// errUnsupportedSignedAggregate is returned when the struct type is not a supported signed aggregate
+	// attestation and proof type.
+	errUnsupportedSignedAggregate = errors.New("unsupported signed aggregate attestation and proof")
+)
+
+// NewAttestation creates an attestation from a protobuf attestation.
+func NewAttestation(i interface{}) (*Attestation, error) {
+	switch a := i.(type) {
+	case *eth.Attestation:
+		return initAttestationFromProtoPhase0(a)
+	case nil:
+		return nil, ErrNilObjectWrapped
+	default:
+		return nil, errors.Wrapf(errUnsupportedAttestation, "unable to create attestation from type %T", i)
+	}
+}
+
+// NewAggregateAttestationAndProof creates an aggregate attestation and proof from a protobuf aggregate
+// attestation and proof.
+func NewAggregateAttestationAndProof(i interface{}) (*AggregateAttestationAndProof, error) {
+	switch a := i.(type) {
+	case *eth.AggregateAttestationAndProof:
+		return initAggregateFromProtoPhase0(a)
+	case nil:
+		return nil, ErrNilObjectWrapped
+	default:
+		return nil, errors.Wrapf(errUnsupportedAggregate, "unable to create aggregate from type %T", i)
+	}
+}
+
+// NewSignedAggregateAttestationAndProof creates a signed aggregate attestation and proof from a protobuf
+// signed aggregate attestation and proof.
+func NewSignedAggregateAttestationAndProof(i interface{}) (*SignedAggregateAttestationAndProof, error) {
+	switch a := i.(type) {
+	case *eth.SignedAggregateAttestationAndProof:
+		return initSignedAggregateFromProtoPhase0(a)
+	case nil:
+		return nil, ErrNilObjectWrapped
+	default:
+		return nil, errors.Wrapf(errUnsupportedSignedAggregate, "unable to create signed aggregate from type %T", i)
+	}
+}
+
+func initAttestationFromProtoPhase0(pb *eth.Attestation) (*Attestation, error) {
+	if pb == nil {
+		return nil, errNilAttestation
+	}
+	return &Attestation{
+		version:         version.Phase0,
+		aggregationBits: pb.AggregationBits,
+		data:            pb.Data,
+		signature:       pb.Signature,
+	}, nil
+}
+
+func initAggregateFromProtoPhase0(pb *eth.AggregateAttestationAndProof) (*AggregateAttestationAndProof, error) {
+	if pb == nil {
+		return nil, errNilAggregate
+	}
+	aggregate, err := initAttestationFromProtoPhase0(pb.Aggregate)
+	if err != nil {
+		return nil, err
+	}
+	return &AggregateAttestationAndProof{
+		version:         version.Phase0,
+		aggregatorIndex: pb.AggregatorIndex,
+		aggregate:       aggregate,
+		selectionProof:  pb.SelectionProof,
+	}, nil
+}
+
+func initSignedAggregateFromProtoPhase0(pb *eth.SignedAggregateAttestationAndProof) (*SignedAggregateAttestationAndProof, error) {
+	if pb == nil {
+		return nil, errNilSignedAggregate
+	}
+	message, err := initAggregateFromProtoPhase0(pb.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedAggregateAttestationAndProof{
+		version:   version.Phase0,
+		message:   message,
+		signature: pb.Signature,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package attestation
+
+import (
+	"testing"
+
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func Test_NewAttestation(t *testing.T) {
+	t.Run("Attestation", func(t *testing.T) {
+		a, err := NewAttestation(&eth.Attestation{Data: &eth.AttestationData{}})
+		require.NoError(t, err)
+		assert.Equal(t, version.Phase0, a.version)
+	})
+	t.Run("nil", func(t *testing.T) {
+		_, err := NewAttestation(nil)
+		require.ErrorIs(t, err, ErrNilObjectWrapped)
+	})
+	t.Run("nil attestation", func(t *testing.T) {
+		_, err := NewAttestation((*eth.Attestation)(nil))
+		require.ErrorIs(t, err, errNilAttestation)
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := NewAttestation(&eth.AttestationData{})
+		require.ErrorIs(t, err, errUnsupportedAttestation)
+	})
+}
+
+func Test_NewAggregateAttestationAndProof(t *testing.T) {
+	t.Run("AggregateAttestationAndProof", func(t *testing.T) {
+		a, err := NewAggregateAttestationAndProof(&eth.AggregateAttestationAndProof{Aggregate: &eth.Attestation{}})
+		require.NoError(t, err)
+		assert.Equal(t, version.Phase0, a.version)
+		assert.Equal(t, version.Phase0, a.aggregate.version)
+	})
+	t.Run("nil aggregate attestation", func(t *testing.T) {
+		_, err := NewAggregateAttestationAndProof(&eth.AggregateAttestationAndProof{})
+		require.ErrorIs(t, err, errNilAttestation)
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := NewAggregateAttestationAndProof(&eth.Attestation{})
+		require.ErrorIs(t, err, errUnsupportedAggregate)
+	})
+}
+
+func Test_NewSignedAggregateAttestationAndProof(t *testing.T) {
+	t.Run("SignedAggregateAttestationAndProof", func(t *testing.T) {
+		a, err := NewSignedAggregateAttestationAndProof(&eth.SignedAggregateAttestationAndProof{
+			Message: &eth.AggregateAttestationAndProof{Aggregate: &eth.Attestation{}}})
+		require.NoError(t, err)
+		assert.Equal(t, version.Phase0, a.version)
+		assert.Equal(t, version.Phase0, a.message.version)
+	})
+	t.Run("nil message", func(t *testing.T) {
+		_, err := NewSignedAggregateAttestationAndProof(&eth.SignedAggregateAttestationAndProof{})
+		require.ErrorIs(t, err, errNilAggregate)
+	})
+	t.Run("nil", func(t *testing.T) {
+		_, err := NewSignedAggregateAttestationAndProof(nil)
+		require.ErrorIs(t, err, ErrNilObjectWrapped)
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := NewSignedAggregateAttestationAndProof(&eth.AggregateAttestationAndProof{})
+		require.ErrorIs(t, err, errUnsupportedSignedAggregate)
+	})
+}
@@ -0,0 +1,110 @@
+package attestation
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Version of the underlying attestation.
+func (a *Attestation) Version() int {
+	return a.version
+}
+
+// AggregationBits returns the bitfield of the validators taking part in the attestation.
+func (a *Attestation) AggregationBits() bitfield.Bitlist {
+	return a.aggregationBits
+}
+
+// Data returns the attestation data.
+func (a *Attestation) Data() *eth.AttestationData {
+	return a.data
+}
+
+// Signature returns the aggregated signature of the attestation.
+func (a *Attestation) Signature() []byte {
+	return a.signature
+}
+
+// IsNil checks if the attestation or its data is nil.
+func (a *Attestation) IsNil() bool {
+	return a == nil || a.data == nil
+}
+
+// IsAggregated returns whether more than one validator took part in the attestation.
+func (a *Attestation) IsAggregated() bool {
+	return a.aggregationBits.Count() > 1
+}
+
+// Copy performs a deep copy of the attestation object.
+func (a *Attestation) Copy() (interfaces.Attestation, error) {
+	pb, err := a.PbPhase0Attestation()
+	if err != nil {
+		return nil, err
+	}
+	return initAttestationFromProtoPhase0(eth.CopyAttestation(pb))
+}
+
+// HashTreeRoot returns the ssz root of the attestation.
+func (a *Attestation) HashTreeRoot() ([32]byte, error) {
+	pb, err := a.PbPhase0Attestation()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return pb.HashTreeRoot()
+}
+
+// Version of the underlying aggregate.
+func (a *AggregateAttestationAndProof) Version() int {
+	return a.version
+}
+
+// AggregatorIndex returns the index of the validator which aggregated the attestation.
+func (a *AggregateAttestationAndProof) AggregatorIndex() types.ValidatorIndex {
+	return a.aggregatorIndex
+}
+
+// Aggregate returns the aggregated attestation.
+func (a *AggregateAttestationAndProof) Aggregate() interfaces.Attestation {
+	return a.aggregate
+}
+
+// SelectionProof returns the proof that the aggregator was selected for the slot of the attestation.
+func (a *AggregateAttestationAndProof) SelectionProof() []byte {
+	return a.selectionProof
+}
+
+// IsNil checks if the aggregate or its attestation is nil.
+func (a *AggregateAttestationAndProof) IsNil() bool {
+	return a == nil || a.aggregate.IsNil()
+}
+
+// HashTreeRoot returns the ssz root of the aggregate.
+func (a *AggregateAttestationAndProof) HashTreeRoot() ([32]byte, error) {
+	pb, err := a.PbPhase0AggregateAttestationAndProof()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return pb.HashTreeRoot()
+}
+
+// Version of the underlying signed aggregate.
+func (a *SignedAggregateAttestationAndProof) Version() int {
+	return a.version
+}
+
+// Message returns the signed aggregate attestation and proof.
+func (a *SignedAggregateAttestationAndProof) Message() interfaces.AggregateAttestationAndProof {
+	return a.message
+}
+
+// Signature returns the signature of the aggregator.
+func (a *SignedAggregateAttestationAndProof) Signature() []byte {
+	return a.signature
+}
+
+// IsNil checks if the signed aggregate or its message is nil.
+func (a *SignedAggregateAttestationAndProof) IsNil() bool {
+	return a == nil || a.message.IsNil()
+}
@@ -0,0 +1,52 @@
+package attestation
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func Test_Attestation_IsAggregated(t *testing.T) {
+	a, err := NewAttestation(&eth.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: &eth.AttestationData{}})
+	require.NoError(t, err)
+	assert.Equal(t, false, a.IsAggregated())
+	a, err = NewAttestation(&eth.Attestation{AggregationBits: bitfield.Bitlist{0b1011}, Data: &eth.AttestationData{}})
+	require.NoError(t, err)
+	assert.Equal(t, true, a.IsAggregated())
+}
+
+func Test_IsNil(t *testing.T) {
+	var a *Attestation
+	assert.Equal(t, true, a.IsNil())
+	a, err := NewAttestation(&eth.Attestation{})
+	require.NoError(t, err)
+	assert.Equal(t, true, a.IsNil())
+
+	signed, err := NewSignedAggregateAttestationAndProof(&eth.SignedAggregateAttestationAndProof{
+		Message: &eth.AggregateAttestationAndProof{Aggregate: &eth.Attestation{}}})
+	require.NoError(t, err)
+	assert.Equal(t, true, signed.IsNil())
+	signed, err = NewSignedAggregateAttestationAndProof(&eth.SignedAggregateAttestationAndProof{
+		Message: &eth.AggregateAttestationAndProof{Aggregate: &eth.Attestation{Data: &eth.AttestationData{}}}})
+	require.NoError(t, err)
+	assert.Equal(t, false, signed.IsNil())
+	assert.Equal(t, false, signed.Message().Aggregate().IsNil())
+}
+
+func Test_Attestation_Copy(t *testing.T) {
+	a, err := NewAttestation(&eth.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &eth.AttestationData{Slot: 5, BeaconBlockRoot: make([]byte, 32)},
+		Signature:       make([]byte, 96),
+	})
+	require.NoError(t, err)
+	cp, err := a.Copy()
+	require.NoError(t, err)
+	a.Data().Slot = 6
+	a.AggregationBits().SetBitAt(2, true)
+	assert.Equal(t, 5, int(cp.Data().Slot))
+	assert.Equal(t, false, cp.AggregationBits().BitAt(2))
+}
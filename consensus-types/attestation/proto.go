@@ -0,0 +1,99 @@
+package attestation
+
+import (
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"google.golang.org/protobuf/proto"
+)
+
+// Proto returns the protobuf attestation of the version of the attestation.
+func (a *Attestation) Proto() (proto.Message, error) {
+	if a == nil {
+		return nil, errNilAttestation
+	}
+	switch a.version {
+	case version.Phase0:
+		return a.PbPhase0Attestation()
+	default:
+		return nil, errors.Wrapf(errUnsupportedAttestation, "version %s", version.String(a.version))
+	}
+}
+
+// PbPhase0Attestation returns the phase 0 protobuf attestation.
+func (a *Attestation) PbPhase0Attestation() (*eth.Attestation, error) {
+	if a == nil {
+		return nil, errNilAttestation
+	}
+	if a.version != version.Phase0 {
+		return nil, errNotSupported("PbPhase0Attestation", a.version)
+	}
+	return &eth.Attestation{
+		AggregationBits: a.aggregationBits,
+		Data:            a.data,
+		Signature:       a.signature,
+	}, nil
+}
+
+// Proto returns the protobuf aggregate attestation and proof of the version of the aggregate.
+func (a *AggregateAttestationAndProof) Proto() (proto.Message, error) {
+	if a == nil {
+		return nil, errNilAggregate
+	}
+	switch a.version {
+	case version.Phase0:
+		return a.PbPhase0AggregateAttestationAndProof()
+	default:
+		return nil, errors.Wrapf(errUnsupportedAggregate, "version %s", version.String(a.version))
+	}
+}
+
+// PbPhase0AggregateAttestationAndProof returns the phase 0 protobuf aggregate attestation and proof.
+func (a *AggregateAttestationAndProof) PbPhase0AggregateAttestationAndProof() (*eth.AggregateAttestationAndProof, error) {
+	if a == nil {
+		return nil, errNilAggregate
+	}
+	if a.version != version.Phase0 {
+		return nil, errNotSupported("PbPhase0AggregateAttestationAndProof", a.version)
+	}
+	aggregate, err := a.aggregate.PbPhase0Attestation()
+	if err != nil {
+		return nil, err
+	}
+	return &eth.AggregateAttestationAndProof{
+		AggregatorIndex: a.aggregatorIndex,
+		Aggregate:       aggregate,
+		SelectionProof:  a.selectionProof,
+	}, nil
+}
+
+// Proto returns the protobuf signed aggregate attestation and proof of the version of the signed aggregate.
+func (a *SignedAggregateAttestationAndProof) Proto() (proto.Message, error) {
+	if a == nil {
+		return nil, errNilSignedAggregate
+	}
+	switch a.version {
+	case version.Phase0:
+		return a.PbPhase0SignedAggregateAttestationAndProof()
+	default:
+		return nil, errors.Wrapf(errUnsupportedSignedAggregate, "version %s", version.String(a.version))
+	}
+}
+
+// PbPhase0SignedAggregateAttestationAndProof returns the phase 0 protobuf signed aggregate attestation and proof.
+func (a *SignedAggregateAttestationAndProof) PbPhase0SignedAggregateAttestationAndProof() (*eth.SignedAggregateAttestationAndProof, error) {
+	if a == nil {
+		return nil, errNilSignedAggregate
+	}
+	if a.version != version.Phase0 {
+		return nil, errNotSupported("PbPhase0SignedAggregateAttestationAndProof", a.version)
+	}
+	message, err := a.message.PbPhase0AggregateAttestationAndProof()
+	if err != nil {
+		return nil, err
+	}
+	return &eth.SignedAggregateAttestationAndProof{
+		Message:   message,
+		Signature: a.signature,
+	}, nil
+}
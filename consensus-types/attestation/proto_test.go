@@ -0,0 +1,66 @@
+package attestation
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func signedAggregatePb() *eth.SignedAggregateAttestationAndProof {
+	return &eth.SignedAggregateAttestationAndProof{
+		Message: &eth.AggregateAttestationAndProof{
+			AggregatorIndex: 7,
+			Aggregate: &eth.Attestation{
+				AggregationBits: bitfield.Bitlist{0b1101},
+				Data: &eth.AttestationData{
+					Slot:            3,
+					CommitteeIndex:  1,
+					BeaconBlockRoot: make([]byte, 32),
+					Source:          &eth.Checkpoint{Root: make([]byte, 32)},
+					Target:          &eth.Checkpoint{Root: make([]byte, 32)},
+				},
+				Signature: make([]byte, 96),
+			},
+			SelectionProof: make([]byte, 96),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func Test_SignedAggregateAttestationAndProof_Proto(t *testing.T) {
+	pb := signedAggregatePb()
+	a, err := NewSignedAggregateAttestationAndProof(pb)
+	require.NoError(t, err)
+	result, err := a.Proto()
+	require.NoError(t, err)
+	assert.DeepEqual(t, pb, result)
+
+	wantRoot, err := pb.Message.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := a.Message().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+	wantRoot, err = pb.Message.Aggregate.HashTreeRoot()
+	require.NoError(t, err)
+	root, err = a.Message().Aggregate().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+}
+
+func Test_Proto_UnsupportedVersion(t *testing.T) {
+	a, err := NewSignedAggregateAttestationAndProof(signedAggregatePb())
+	require.NoError(t, err)
+	a.version = version.Altair
+	_, err = a.Proto()
+	require.ErrorIs(t, err, errUnsupportedSignedAggregate)
+	_, err = a.PbPhase0SignedAggregateAttestationAndProof()
+	require.ErrorIs(t, err, ErrUnsupportedGetter)
+
+	a.message.aggregate.version = version.Altair
+	_, err = a.Message().Aggregate().PbPhase0Attestation()
+	require.ErrorIs(t, err, ErrUnsupportedGetter)
+}
@@ -0,0 +1,58 @@
+// Package attestation implements version aware attestation types which are independent of their
+// protobuf representation. The protobuf objects are only built when requested, so that callers do not
+// need to switch on the concrete protobuf types and changes to the attestation format stay in this package.
+package attestation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+var (
+	// ErrUnsupportedGetter is returned when a getter access is not supported for a specific attestation version.
+	ErrUnsupportedGetter  = errors.New("unsupported getter")
+	errNilAttestation     = errors.New("received nil attestation")
+	errNilAggregate       = errors.New("received nil aggregate attestation and proof")
+	errNilSignedAggregate = errors.New("received nil signed aggregate attestation and proof")
+)
+
+var (
+	_ = interfaces.Attestation(&Attestation{})
+	_ = interfaces.AggregateAttestationAndProof(&AggregateAttestationAndProof{})
+	_ = interfaces.SignedAggregateAttestationAndProof(&SignedAggregateAttestationAndProof{})
+)
+
+// Attestation is the main attestation structure. It can represent any attestation type.
+type Attestation struct {
+	version         int
+	aggregationBits bitfield.Bitlist
+	data            *eth.AttestationData
+	signature       []byte
+}
+
+// AggregateAttestationAndProof is the main aggregate attestation and proof structure. It can represent any
+// aggregate type.
+type AggregateAttestationAndProof struct {
+	version         int
+	aggregatorIndex types.ValidatorIndex
+	aggregate       *Attestation
+	selectionProof  []byte
+}
+
+// SignedAggregateAttestationAndProof is the main signed aggregate attestation and proof structure. It can
+// represent any signed aggregate type.
+type SignedAggregateAttestationAndProof struct {
+	version   int
+	message   *AggregateAttestationAndProof
+	signature []byte
+}
+
+func errNotSupported(funcName string, ver int) error {
+	return errors.Wrap(ErrUnsupportedGetter, fmt.Sprintf("%s is not supported for %s", funcName, version.String(ver)))
+}
@@ -5,6 +5,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
@@ -571,6 +572,19 @@ func (b *BeaconBlockBody) ExecutionPayloadHeader() (*enginev1.ExecutionPayloadHe
 	return b.executionPayloadHeader, nil
 }
 
+// Execution returns the execution data of the block body, wrapping either the full execution
+// payload or the execution payload header depending on whether the body is blinded.
+func (b *BeaconBlockBody) Execution() (interfaces.ExecutionData, error) {
+	switch b.version {
+	case version.Bellatrix:
+		return wrapper.WrappedExecutionPayload(b.executionPayload)
+	case version.BellatrixBlind:
+		return wrapper.WrappedExecutionPayloadHeader(b.executionPayloadHeader)
+	default:
+		return nil, errNotSupported("Execution", b.version)
+	}
+}
+
 // HashTreeRoot returns the ssz root of the block body.
 func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	pb, err := b.Proto()
@@ -590,3 +604,23 @@ func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 		return [32]byte{}, errIncorrectBodyVersion
 	}
 }
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher.
+func (b *BeaconBlockBody) HashTreeRootWith(h *ssz.Hasher) error {
+	pb, err := b.Proto()
+	if err != nil {
+		return err
+	}
+	switch b.version {
+	case version.Phase0:
+		return pb.(*eth.BeaconBlockBody).HashTreeRootWith(h)
+	case version.Altair:
+		return pb.(*eth.BeaconBlockBodyAltair).HashTreeRootWith(h)
+	case version.Bellatrix:
+		return pb.(*eth.BeaconBlockBodyBellatrix).HashTreeRootWith(h)
+	case version.BellatrixBlind:
+		return pb.(*eth.BlindedBeaconBlockBodyBellatrix).HashTreeRootWith(h)
+	default:
+		return errIncorrectBodyVersion
+	}
+}
@@ -302,6 +302,26 @@ func Test_BeaconBlockBody_ExecutionPayloadHeader(t *testing.T) {
 	assert.Equal(t, result, eph)
 }
 
+func Test_BeaconBlockBody_Execution(t *testing.T) {
+	ep := &enginev1.ExecutionPayload{BlockHash: []byte{0x01}}
+	bb := &BeaconBlockBody{version: version.Bellatrix, executionPayload: ep}
+	result, err := bb.Execution()
+	require.NoError(t, err)
+	assert.DeepEqual(t, ep, result.Proto())
+	assert.DeepEqual(t, ep.BlockHash, result.BlockHash())
+
+	eph := &enginev1.ExecutionPayloadHeader{BlockHash: []byte{0x02}}
+	bb = &BeaconBlockBody{version: version.BellatrixBlind, executionPayloadHeader: eph}
+	result, err = bb.Execution()
+	require.NoError(t, err)
+	assert.DeepEqual(t, eph, result.Proto())
+	assert.DeepEqual(t, eph.BlockHash, result.BlockHash())
+
+	bb = &BeaconBlockBody{version: version.Altair}
+	_, err = bb.Execution()
+	require.ErrorContains(t, "Execution is not supported", err)
+}
+
 func Test_BeaconBlockBody_HashTreeRoot(t *testing.T) {
 	pb := util.HydrateBeaconBlockBody(&eth.BeaconBlockBody{})
 	expectedHTR, err := pb.HashTreeRoot()
@@ -0,0 +1,81 @@
+//go:build go1.18
+
+package blocks
+
+import (
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type sszProto interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+}
+
+// fuzzSignedBlockSSZ checks that any signed block decoded from SSZ is encoded back to the same bytes once
+// wrapped, and that its block root is the same as the one of the protobuf block.
+func fuzzSignedBlockSSZ(f *testing.F, seed sszProto, newPb func() sszProto, blockRoot func(sszProto) ([32]byte, error)) {
+	enc, err := seed.MarshalSSZ()
+	require.NoError(f, err)
+	f.Add(enc)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		pb := newPb()
+		if err := pb.UnmarshalSSZ(b); err != nil {
+			return
+		}
+		wantEnc, err := pb.MarshalSSZ()
+		require.NoError(t, err)
+		blk, err := NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		enc, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		require.DeepEqual(t, wantEnc, enc)
+
+		decoded := newPb()
+		require.NoError(t, decoded.UnmarshalSSZ(enc))
+		reenc, err := decoded.MarshalSSZ()
+		require.NoError(t, err)
+		require.DeepEqual(t, enc, reenc)
+
+		wantRoot, wantErr := blockRoot(pb)
+		root, err := blk.Block().HashTreeRoot()
+		require.Equal(t, wantErr != nil, err != nil)
+		require.Equal(t, wantRoot, root)
+	})
+}
+
+func FuzzSignedBeaconBlockSSZ_Phase0(f *testing.F) {
+	fuzzSignedBlockSSZ(f, util.NewBeaconBlock(),
+		func() sszProto { return &eth.SignedBeaconBlock{} },
+		func(pb sszProto) ([32]byte, error) { return pb.(*eth.SignedBeaconBlock).Block.HashTreeRoot() },
+	)
+}
+
+func FuzzSignedBeaconBlockSSZ_Altair(f *testing.F) {
+	fuzzSignedBlockSSZ(f, util.NewBeaconBlockAltair(),
+		func() sszProto { return &eth.SignedBeaconBlockAltair{} },
+		func(pb sszProto) ([32]byte, error) { return pb.(*eth.SignedBeaconBlockAltair).Block.HashTreeRoot() },
+	)
+}
+
+func FuzzSignedBeaconBlockSSZ_Bellatrix(f *testing.F) {
+	fuzzSignedBlockSSZ(f, util.NewBeaconBlockBellatrix(),
+		func() sszProto { return &eth.SignedBeaconBlockBellatrix{} },
+		func(pb sszProto) ([32]byte, error) { return pb.(*eth.SignedBeaconBlockBellatrix).Block.HashTreeRoot() },
+	)
+}
+
+func FuzzSignedBeaconBlockSSZ_BlindedBellatrix(f *testing.F) {
+	fuzzSignedBlockSSZ(f, util.NewBlindedBeaconBlockBellatrix(),
+		func() sszProto { return &eth.SignedBlindedBeaconBlockBellatrix{} },
+		func(pb sszProto) ([32]byte, error) {
+			return pb.(*eth.SignedBlindedBeaconBlockBellatrix).Block.HashTreeRoot()
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Attestation describes the method set of an attestation,
+// independently of its version.
+type Attestation interface {
+	Version() int
+	AggregationBits() bitfield.Bitlist
+	Data() *ethpb.AttestationData
+	Signature() []byte
+	IsNil() bool
+	IsAggregated() bool
+	Copy() (Attestation, error)
+	HashTreeRoot() ([32]byte, error)
+	Proto() (proto.Message, error)
+	PbPhase0Attestation() (*ethpb.Attestation, error)
+}
+
+// AggregateAttestationAndProof describes the method set of an
+// aggregate attestation along with the proof of its aggregator.
+type AggregateAttestationAndProof interface {
+	Version() int
+	AggregatorIndex() types.ValidatorIndex
+	Aggregate() Attestation
+	SelectionProof() []byte
+	IsNil() bool
+	HashTreeRoot() ([32]byte, error)
+	Proto() (proto.Message, error)
+	PbPhase0AggregateAttestationAndProof() (*ethpb.AggregateAttestationAndProof, error)
+}
+
+// SignedAggregateAttestationAndProof describes the method set of
+// a signed aggregate attestation and proof.
+type SignedAggregateAttestationAndProof interface {
+	Version() int
+	Message() AggregateAttestationAndProof
+	Signature() []byte
+	IsNil() bool
+	Proto() (proto.Message, error)
+	PbPhase0SignedAggregateAttestationAndProof() (*ethpb.SignedAggregateAttestationAndProof, error)
+}
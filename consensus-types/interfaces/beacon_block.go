@@ -3,6 +3,7 @@ package interfaces
 import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"google.golang.org/protobuf/proto"
@@ -26,6 +27,7 @@ type SignedBeaconBlock interface {
 	ssz.Unmarshaler
 	Version() int
 	Header() (*ethpb.SignedBeaconBlockHeader, error)
+	HeaderNoSig() (*ethpb.BeaconBlockHeader, error)
 }
 
 // BeaconBlock describes an interface which states the methods
@@ -60,7 +62,9 @@ type BeaconBlockBody interface {
 	VoluntaryExits() []*ethpb.SignedVoluntaryExit
 	SyncAggregate() (*ethpb.SyncAggregate, error)
 	IsNil() bool
-	HashTreeRoot() ([32]byte, error)
+	ssz.HashRoot
+	GetTree() (*ssz.Node, error)
+	Prove(field int) (*ssz.Proof, error)
 	Proto() proto.Message
 	Execution() (ExecutionData, error)
 }
@@ -87,4 +91,6 @@ type ExecutionData interface {
 	BaseFeePerGas() []byte
 	BlockHash() []byte
 	Transactions() ([][]byte, error)
+	TransactionsRoot() ([]byte, error)
+	Withdrawals() ([]*enginev1.Withdrawal, error)
 }
@@ -81,6 +81,10 @@ func (SignedBeaconBlock) Header() (*eth.SignedBeaconBlockHeader, error) {
 	panic("implement me")
 }
 
+func (SignedBeaconBlock) HeaderNoSig() (*eth.BeaconBlockHeader, error) {
+	panic("implement me")
+}
+
 type BeaconBlock struct {
 	Htr             [32]byte
 	HtrErr          error
@@ -198,6 +202,18 @@ func (BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	panic("implement me")
 }
 
+func (BeaconBlockBody) HashTreeRootWith(_ *ssz.Hasher) error {
+	panic("implement me")
+}
+
+func (BeaconBlockBody) GetTree() (*ssz.Node, error) {
+	panic("implement me")
+}
+
+func (BeaconBlockBody) Prove(_ int) (*ssz.Proof, error) {
+	panic("implement me")
+}
+
 func (BeaconBlockBody) Proto() proto.Message {
 	panic("implement me")
 }
@@ -5,6 +5,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -121,24 +122,30 @@ func (altairSignedBeaconBlock) Version() int {
 	return version.Altair
 }
 
+// Header returns the signed header of the block.
 func (w altairSignedBeaconBlock) Header() (*eth.SignedBeaconBlockHeader, error) {
-	root, err := w.b.Block.Body.HashTreeRoot()
+	h, err := w.HeaderNoSig()
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not hash block")
+		return nil, err
 	}
-
 	return &eth.SignedBeaconBlockHeader{
-		Header: &eth.BeaconBlockHeader{
-			Slot:          w.b.Block.Slot,
-			ProposerIndex: w.b.Block.ProposerIndex,
-			ParentRoot:    w.b.Block.ParentRoot,
-			StateRoot:     w.b.Block.StateRoot,
-			BodyRoot:      root[:],
-		},
-		Signature: w.Signature(),
+		Header:    h,
+		Signature: bytesutil.SafeCopyBytes(w.Signature()),
 	}, nil
 }
 
+// HeaderNoSig returns the unsigned header of the block.
+func (w altairSignedBeaconBlock) HeaderNoSig() (*eth.BeaconBlockHeader, error) {
+	if w.IsNil() || w.b.Block.Body == nil {
+		return nil, ErrNilObjectWrapped
+	}
+	root, err := hashBody(w.b.Block.Body)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeader(w.b.Block.Slot, w.b.Block.ProposerIndex, w.b.Block.ParentRoot, w.b.Block.StateRoot, root), nil
+}
+
 // altairBeaconBlock is the wrapper for the actual block.
 type altairBeaconBlock struct {
 	b *eth.BeaconBlockAltair
@@ -310,6 +317,21 @@ func (w altairBeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	return w.b.HashTreeRoot()
 }
 
+// HashTreeRootWith ssz hashes the block body with a hasher.
+func (w altairBeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) error {
+	return w.b.HashTreeRootWith(hh)
+}
+
+// GetTree returns the Merkle tree of the block body, whose leaves are the roots of the body fields.
+func (w altairBeaconBlockBody) GetTree() (*ssz.Node, error) {
+	return BeaconBlockBodyTree(w, AltairBodyFieldCount)
+}
+
+// Prove returns a Merkle proof of the root of the body field with the given index against the root of the body.
+func (w altairBeaconBlockBody) Prove(field int) (*ssz.Proof, error) {
+	return ProveBeaconBlockBodyField(w, AltairBodyFieldCount, field)
+}
+
 // Proto returns the underlying proto form of the block
 // body.
 func (w altairBeaconBlockBody) Proto() proto.Message {
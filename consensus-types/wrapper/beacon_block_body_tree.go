@@ -0,0 +1,191 @@
+package wrapper
+
+import (
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+)
+
+// Number of fields of the beacon block body of each fork.
+const (
+	Phase0BodyFieldCount    = 8
+	AltairBodyFieldCount    = 9
+	BellatrixBodyFieldCount = 10
+)
+
+// Indices of the fields of the beacon block body, in the order they are merkleized.
+const (
+	RandaoRevealBodyIndex = iota
+	Eth1DataBodyIndex
+	GraffitiBodyIndex
+	ProposerSlashingsBodyIndex
+	AttesterSlashingsBodyIndex
+	AttestationsBodyIndex
+	DepositsBodyIndex
+	VoluntaryExitsBodyIndex
+	SyncAggregateBodyIndex
+	ExecutionBodyIndex
+)
+
+var errFieldIndexOutOfRange = errors.New("field index out of range")
+
+// BeaconBlockBodyTree builds the Merkle tree of a beacon block body with the given number of fields. The leaves
+// of the tree are the hash tree roots of the body fields, so the root of the tree is the hash tree root of the body.
+func BeaconBlockBodyTree(b interfaces.BeaconBlockBody, fieldCount int) (*ssz.Node, error) {
+	roots, err := beaconBlockBodyFieldRoots(b, fieldCount)
+	if err != nil {
+		return nil, err
+	}
+	// Pad the leaves to the next power of two, as done when merkleizing the body.
+	leafCount := 1
+	for leafCount < fieldCount {
+		leafCount *= 2
+	}
+	for len(roots) < leafCount {
+		roots = append(roots, make([]byte, 32))
+	}
+	return ssz.TreeFromChunks(roots)
+}
+
+// ProveBeaconBlockBodyField returns a Merkle proof of the hash tree root of a field of a beacon block body with the
+// given number of fields against the hash tree root of the body.
+func ProveBeaconBlockBodyField(b interfaces.BeaconBlockBody, fieldCount, field int) (*ssz.Proof, error) {
+	if field < 0 || field >= fieldCount {
+		return nil, errors.Wrapf(errFieldIndexOutOfRange, "field %d of body with %d fields", field, fieldCount)
+	}
+	tree, err := BeaconBlockBodyTree(b, fieldCount)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Prove(BeaconBlockBodyFieldGeneralizedIndex(fieldCount, field))
+}
+
+// BeaconBlockBodyFieldGeneralizedIndex returns the generalized index of a field in the Merkle tree of a beacon block
+// body with the given number of fields.
+func BeaconBlockBodyFieldGeneralizedIndex(fieldCount, field int) int {
+	leafCount := 1
+	for leafCount < fieldCount {
+		leafCount *= 2
+	}
+	return leafCount + field
+}
+
+// beaconBlockBodyFieldRoots computes the hash tree roots of the first fieldCount fields of a beacon block body.
+func beaconBlockBodyFieldRoots(b interfaces.BeaconBlockBody, fieldCount int) ([][]byte, error) {
+	if b == nil || b.IsNil() {
+		return nil, ErrNilBeaconBlockBody
+	}
+	if fieldCount < Phase0BodyFieldCount || fieldCount > BellatrixBodyFieldCount {
+		return nil, errors.Errorf("unsupported number of body fields %d", fieldCount)
+	}
+	cfg := params.BeaconConfig()
+	roots := make([][]byte, fieldCount)
+
+	randao := b.RandaoReveal()
+	if len(randao) != fieldparams.BLSSignatureLength {
+		return nil, ssz.ErrBytesLengthFn("RandaoReveal", len(randao), fieldparams.BLSSignatureLength)
+	}
+	hh := ssz.NewHasher()
+	hh.PutBytes(randao)
+	randaoRoot, err := hh.HashRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute randao reveal root")
+	}
+	roots[RandaoRevealBodyIndex] = randaoRoot[:]
+
+	eth1DataRoot, err := b.Eth1Data().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute eth1 data root")
+	}
+	roots[Eth1DataBodyIndex] = eth1DataRoot[:]
+
+	graffiti := b.Graffiti()
+	if len(graffiti) != 32 {
+		return nil, ssz.ErrBytesLengthFn("Graffiti", len(graffiti), 32)
+	}
+	roots[GraffitiBodyIndex] = bytesutil.SafeCopyBytes(graffiti)
+
+	proposerSlashings := b.ProposerSlashings()
+	roots[ProposerSlashingsBodyIndex], err = listRoot(len(proposerSlashings), cfg.MaxProposerSlashings, func(i int, hh *ssz.Hasher) error {
+		return proposerSlashings[i].HashTreeRootWith(hh)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute proposer slashings root")
+	}
+	attesterSlashings := b.AttesterSlashings()
+	roots[AttesterSlashingsBodyIndex], err = listRoot(len(attesterSlashings), cfg.MaxAttesterSlashings, func(i int, hh *ssz.Hasher) error {
+		return attesterSlashings[i].HashTreeRootWith(hh)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attester slashings root")
+	}
+	attestations := b.Attestations()
+	roots[AttestationsBodyIndex], err = listRoot(len(attestations), cfg.MaxAttestations, func(i int, hh *ssz.Hasher) error {
+		return attestations[i].HashTreeRootWith(hh)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestations root")
+	}
+	deposits := b.Deposits()
+	roots[DepositsBodyIndex], err = listRoot(len(deposits), cfg.MaxDeposits, func(i int, hh *ssz.Hasher) error {
+		return deposits[i].HashTreeRootWith(hh)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposits root")
+	}
+	exits := b.VoluntaryExits()
+	roots[VoluntaryExitsBodyIndex], err = listRoot(len(exits), cfg.MaxVoluntaryExits, func(i int, hh *ssz.Hasher) error {
+		return exits[i].HashTreeRootWith(hh)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute voluntary exits root")
+	}
+
+	if fieldCount > SyncAggregateBodyIndex {
+		syncAggregate, err := b.SyncAggregate()
+		if err != nil {
+			return nil, err
+		}
+		syncAggregateRoot, err := syncAggregate.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute sync aggregate root")
+		}
+		roots[SyncAggregateBodyIndex] = syncAggregateRoot[:]
+	}
+	if fieldCount > ExecutionBodyIndex {
+		execution, err := b.Execution()
+		if err != nil {
+			return nil, err
+		}
+		// The root of a full execution payload and of its header are the same.
+		executionRoot, err := execution.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute execution root")
+		}
+		roots[ExecutionBodyIndex] = executionRoot[:]
+	}
+	return roots, nil
+}
+
+// listRoot computes the hash tree root of an SSZ list of containers with the given limit.
+func listRoot(num int, limit uint64, elemRootWith func(i int, hh *ssz.Hasher) error) ([]byte, error) {
+	if uint64(num) > limit {
+		return nil, ssz.ErrIncorrectListSize
+	}
+	hh := ssz.NewHasher()
+	indx := hh.Index()
+	for i := 0; i < num; i++ {
+		if err := elemRootWith(i, hh); err != nil {
+			return nil, err
+		}
+	}
+	hh.MerkleizeWithMixin(indx, uint64(num), limit)
+	root, err := hh.HashRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
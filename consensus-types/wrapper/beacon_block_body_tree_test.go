@@ -0,0 +1,109 @@
+package wrapper_test
+
+import (
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestBeaconBlockBody_GetTree(t *testing.T) {
+	bellatrix := util.NewBeaconBlockBellatrix()
+	bellatrix.Block.Body.Graffiti = bytesutil.PadTo([]byte("graffiti"), 32)
+	bellatrix.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}}
+	bellatrix.Block.Body.Attestations = []*ethpb.Attestation{util.HydrateAttestation(&ethpb.Attestation{})}
+	bellatrix.Block.Body.ExecutionPayload.BlockNumber = 100
+
+	tests := []struct {
+		name  string
+		block interface{}
+	}{
+		{name: "phase0", block: util.NewBeaconBlock()},
+		{name: "altair", block: util.NewBeaconBlockAltair()},
+		{name: "bellatrix", block: bellatrix},
+		{name: "blinded bellatrix", block: util.NewBlindedBeaconBlockBellatrix()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wsb, err := wrapper.WrappedSignedBeaconBlock(tt.block)
+			require.NoError(t, err)
+			body := wsb.Block().Body()
+			want, err := body.HashTreeRoot()
+			require.NoError(t, err)
+
+			tree, err := body.GetTree()
+			require.NoError(t, err)
+			assert.DeepEqual(t, want[:], tree.Hash())
+
+			hh := ssz.NewHasher()
+			require.NoError(t, body.HashTreeRootWith(hh))
+			got, err := hh.HashRoot()
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestBeaconBlockBody_Prove(t *testing.T) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Body.ExecutionPayload.BlockNumber = 100
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	body := wsb.Block().Body()
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	payloadRoot, err := b.Block.Body.ExecutionPayload.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := body.Prove(wrapper.ExecutionBodyIndex)
+	require.NoError(t, err)
+	assert.Equal(t, 25, proof.Index)
+	assert.DeepEqual(t, payloadRoot[:], proof.Leaf)
+	assert.Equal(t, 4, len(proof.Hashes))
+	ok, err := ssz.VerifyProof(bodyRoot[:], proof)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	// The proof of the payload header of the blinded body matches the proof of the full payload.
+	payload, err := body.Execution()
+	require.NoError(t, err)
+	header, err := wrapper.PayloadToHeader(payload)
+	require.NoError(t, err)
+	blinded := util.NewBlindedBeaconBlockBellatrix()
+	blinded.Block.Body.ExecutionPayloadHeader = header
+	blindedWsb, err := wrapper.WrappedSignedBeaconBlock(blinded)
+	require.NoError(t, err)
+	blindedProof, err := blindedWsb.Block().Body().Prove(wrapper.ExecutionBodyIndex)
+	require.NoError(t, err)
+	assert.DeepEqual(t, proof, blindedProof)
+
+	_, err = body.Prove(wrapper.BellatrixBodyFieldCount)
+	require.ErrorContains(t, "field index out of range", err)
+}
+
+func TestBeaconBlockBody_Prove_PreBellatrix(t *testing.T) {
+	wsb, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	var body interfaces.BeaconBlockBody = wsb.Block().Body()
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := body.Prove(wrapper.SyncAggregateBodyIndex)
+	require.NoError(t, err)
+	assert.Equal(t, 24, proof.Index)
+	ok, err := ssz.VerifyProof(bodyRoot[:], proof)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	_, err = body.Prove(wrapper.ExecutionBodyIndex)
+	require.ErrorContains(t, "field index out of range", err)
+}
@@ -5,6 +5,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -117,24 +118,30 @@ func (Phase0SignedBeaconBlock) Version() int {
 	return version.Phase0
 }
 
+// Header returns the signed header of the block.
 func (w Phase0SignedBeaconBlock) Header() (*eth.SignedBeaconBlockHeader, error) {
-	root, err := w.b.Block.Body.HashTreeRoot()
+	h, err := w.HeaderNoSig()
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not hash block")
+		return nil, err
 	}
-
 	return &eth.SignedBeaconBlockHeader{
-		Header: &eth.BeaconBlockHeader{
-			Slot:          w.b.Block.Slot,
-			ProposerIndex: w.b.Block.ProposerIndex,
-			ParentRoot:    w.b.Block.ParentRoot,
-			StateRoot:     w.b.Block.StateRoot,
-			BodyRoot:      root[:],
-		},
-		Signature: w.Signature(),
+		Header:    h,
+		Signature: bytesutil.SafeCopyBytes(w.Signature()),
 	}, nil
 }
 
+// HeaderNoSig returns the unsigned header of the block.
+func (w Phase0SignedBeaconBlock) HeaderNoSig() (*eth.BeaconBlockHeader, error) {
+	if w.IsNil() || w.b.Block.Body == nil {
+		return nil, ErrNilObjectWrapped
+	}
+	root, err := hashBody(w.b.Block.Body)
+	if err != nil {
+		return nil, err
+	}
+	return blockHeader(w.b.Block.Slot, w.b.Block.ProposerIndex, w.b.Block.ParentRoot, w.b.Block.StateRoot, root), nil
+}
+
 // Phase0BeaconBlock is the wrapper for the actual block.
 type Phase0BeaconBlock struct {
 	b *eth.BeaconBlock
@@ -298,6 +305,21 @@ func (w Phase0BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
 	return w.b.HashTreeRoot()
 }
 
+// HashTreeRootWith ssz hashes the block body with a hasher.
+func (w Phase0BeaconBlockBody) HashTreeRootWith(hh *ssz.Hasher) error {
+	return w.b.HashTreeRootWith(hh)
+}
+
+// GetTree returns the Merkle tree of the block body, whose leaves are the roots of the body fields.
+func (w Phase0BeaconBlockBody) GetTree() (*ssz.Node, error) {
+	return BeaconBlockBodyTree(w, Phase0BodyFieldCount)
+}
+
+// Prove returns a Merkle proof of the root of the body field with the given index against the root of the body.
+func (w Phase0BeaconBlockBody) Prove(field int) (*ssz.Proof, error) {
+	return ProveBeaconBlockBodyField(w, Phase0BodyFieldCount, field)
+}
+
 // Proto returns the underlying proto form of the block
 // body.
 func (w Phase0BeaconBlockBody) Proto() proto.Message {
@@ -46,6 +46,77 @@ func TestBellatrixSignedBlindedBeaconBlock_Header(t *testing.T) {
 	assert.DeepEqual(t, signature, header.Signature)
 }
 
+func TestBellatrixSignedBlindedBeaconBlock_HeaderMatchesFullBlock(t *testing.T) {
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 4
+	b.Block.ProposerIndex = 2
+	b.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	b.Block.Body.ExecutionPayload.Transactions = [][]byte{{0x01, 0x02}, {0x03}}
+	b.Signature = bytesutil.PadTo([]byte("sig"), 96)
+	full, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	converted, err := full.ToBlinded()
+	require.NoError(t, err)
+	pb, err := converted.PbBlindedBellatrixBlock()
+	require.NoError(t, err)
+	// Wrap the blinded block again so its header is computed from the blinded body.
+	blinded, err := wrapper.WrappedSignedBeaconBlock(pb)
+	require.NoError(t, err)
+
+	fullHeader, err := full.Header()
+	require.NoError(t, err)
+	blindedHeader, err := blinded.Header()
+	require.NoError(t, err)
+	convertedHeader, err := converted.Header()
+	require.NoError(t, err)
+	assert.DeepEqual(t, fullHeader, blindedHeader)
+	assert.DeepEqual(t, fullHeader, convertedHeader)
+
+	fullHeaderNoSig, err := full.HeaderNoSig()
+	require.NoError(t, err)
+	blindedHeaderNoSig, err := blinded.HeaderNoSig()
+	require.NoError(t, err)
+	assert.DeepEqual(t, fullHeaderNoSig, blindedHeaderNoSig)
+	assert.DeepEqual(t, fullHeader.Header, fullHeaderNoSig)
+
+	fullRoot, err := full.Block().HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := blindedHeaderNoSig.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, fullRoot, headerRoot)
+}
+
+func TestBellatrixSignedBlindedBeaconBlock_HeaderFollowsMutations(t *testing.T) {
+	wsb, err := wrapper.WrappedSignedBeaconBlock(util.NewBlindedBeaconBlockBellatrix())
+	require.NoError(t, err)
+	header, err := wsb.HeaderNoSig()
+	require.NoError(t, err)
+	// Modifying the returned header must not affect the block.
+	header.BodyRoot[0] = 0xff
+	header.ParentRoot[0] = 0xff
+
+	require.NoError(t, wrapper.SetBlockSlot(wsb, 10))
+	// The body is also modified through the underlying protobuf block, bypassing the block mutator.
+	pb, err := wsb.PbBlindedBellatrixBlock()
+	require.NoError(t, err)
+	pb.Block.Body.Graffiti = bytesutil.PadTo([]byte("graffiti"), 32)
+	wantBodyRoot, err := pb.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+
+	header, err = wsb.HeaderNoSig()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), header.Slot)
+	assert.DeepEqual(t, wantBodyRoot[:], header.BodyRoot)
+	assert.DeepEqual(t, make([]byte, 32), header.ParentRoot)
+
+	pb.Block.Body.Graffiti = bytesutil.PadTo([]byte("other"), 32)
+	wantBodyRoot, err = pb.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	signed, err := wsb.Header()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantBodyRoot[:], signed.Header.BodyRoot)
+}
+
 func TestBellatrixSignedBlindedBeaconBlock_Signature(t *testing.T) {
 	sig := []byte{0x11, 0x22}
 	wsb, err := wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBlindedBeaconBlockBellatrix{Block: &ethpb.BlindedBeaconBlockBellatrix{}, Signature: sig})
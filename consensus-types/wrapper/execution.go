@@ -139,6 +139,20 @@ func (e executionPayload) Transactions() ([][]byte, error) {
 	return e.p.Transactions, nil
 }
 
+// TransactionsRoot --
+func (e executionPayload) TransactionsRoot() ([]byte, error) {
+	txRoot, err := ssz.TransactionsRoot(e.p.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	return txRoot[:], nil
+}
+
+// Withdrawals --
+func (executionPayload) Withdrawals() ([]*enginev1.Withdrawal, error) {
+	return nil, ErrUnsupportedField
+}
+
 // executionPayloadHeader is a convenience wrapper around a blinded beacon block body's execution header data structure
 // This wrapper allows us to conform to a common interface so that beacon
 // blocks for future forks can also be applied across Prysm without issues.
@@ -265,13 +279,20 @@ func (executionPayloadHeader) Transactions() ([][]byte, error) {
 	return nil, ErrUnsupportedField
 }
 
+// TransactionsRoot --
+func (e executionPayloadHeader) TransactionsRoot() ([]byte, error) {
+	return e.p.TransactionsRoot, nil
+}
+
+// Withdrawals --
+func (executionPayloadHeader) Withdrawals() ([]*enginev1.Withdrawal, error) {
+	return nil, ErrUnsupportedField
+}
+
 // PayloadToHeader converts `payload` into execution payload header format.
+// The execution data may either be a full payload or a header, in which case a copy is returned.
 func PayloadToHeader(payload interfaces.ExecutionData) (*enginev1.ExecutionPayloadHeader, error) {
-	txs, err := payload.Transactions()
-	if err != nil {
-		return nil, err
-	}
-	txRoot, err := ssz.TransactionsRoot(txs)
+	txRoot, err := payload.TransactionsRoot()
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +310,7 @@ func PayloadToHeader(payload interfaces.ExecutionData) (*enginev1.ExecutionPaylo
 		ExtraData:        bytesutil.SafeCopyBytes(payload.ExtraData()),
 		BaseFeePerGas:    bytesutil.SafeCopyBytes(payload.BaseFeePerGas()),
 		BlockHash:        bytesutil.SafeCopyBytes(payload.BlockHash()),
-		TransactionsRoot: txRoot[:],
+		TransactionsRoot: bytesutil.SafeCopyBytes(txRoot),
 	}, nil
 }
 
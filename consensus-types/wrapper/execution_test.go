@@ -6,6 +6,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/encoding/ssz"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -81,6 +82,50 @@ func TestWrapExecutionPayloadHeader_SSZ(t *testing.T) {
 	assert.NoError(t, wsb.UnmarshalSSZ(encoded))
 }
 
+func TestExecutionData_TransactionsRoot(t *testing.T) {
+	payload := createWrappedPayload(t)
+	pb, ok := payload.Proto().(*enginev1.ExecutionPayload)
+	require.Equal(t, true, ok)
+	pb.Transactions = [][]byte{{0x01, 0x02}, {0x03}}
+	want, err := ssz.TransactionsRoot(pb.Transactions)
+	require.NoError(t, err)
+	got, err := payload.TransactionsRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:], got)
+
+	header, err := wrapper.PayloadToHeader(payload)
+	require.NoError(t, err)
+	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(header)
+	require.NoError(t, err)
+	got, err = wrappedHeader.TransactionsRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:], got)
+}
+
+func TestPayloadToHeader_FromHeader(t *testing.T) {
+	payload := createWrappedPayload(t)
+	want, err := wrapper.PayloadToHeader(payload)
+	require.NoError(t, err)
+	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(want)
+	require.NoError(t, err)
+	got, err := wrapper.PayloadToHeader(wrappedHeader)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+}
+
+func TestExecutionData_Withdrawals(t *testing.T) {
+	payload := createWrappedPayload(t)
+	_, err := payload.Withdrawals()
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedField)
+
+	header, err := wrapper.PayloadToHeader(payload)
+	require.NoError(t, err)
+	wrappedHeader, err := wrapper.WrappedExecutionPayloadHeader(header)
+	require.NoError(t, err)
+	_, err = wrappedHeader.Withdrawals()
+	require.ErrorIs(t, err, wrapper.ErrUnsupportedField)
+}
+
 func createWrappedPayload(t testing.TB) interfaces.ExecutionData {
 	wsb, err := wrapper.WrappedExecutionPayload(&enginev1.ExecutionPayload{
 		ParentHash:    make([]byte, fieldparams.RootLength),
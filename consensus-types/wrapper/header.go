@@ -0,0 +1,35 @@
+package wrapper
+
+import (
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// hashBody returns the root of a block body, which is the body root of the block header.
+func hashBody(body ssz.HashRoot) ([32]byte, error) {
+	root, err := body.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "could not hash block")
+	}
+	return root, nil
+}
+
+// blockHeader builds an unsigned block header from the given block fields. The byte slices
+// are copied so the header can be freely modified by callers.
+func blockHeader(
+	slot types.Slot,
+	proposerIndex types.ValidatorIndex,
+	parentRoot, stateRoot []byte,
+	bodyRoot [32]byte,
+) *eth.BeaconBlockHeader {
+	return &eth.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    bytesutil.SafeCopyBytes(parentRoot),
+		StateRoot:     bytesutil.SafeCopyBytes(stateRoot),
+		BodyRoot:      bodyRoot[:],
+	}
+}
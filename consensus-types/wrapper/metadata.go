@@ -28,6 +28,12 @@ func (m MetadataV0) AttnetsBitfield() bitfield.Bitvector64 {
 	return m.md.Attnets
 }
 
+// SyncnetsBitfield returns an empty bitfield, as phase 0 metadata
+// does not carry sync committee subnets.
+func (MetadataV0) SyncnetsBitfield() bitfield.Bitvector4 {
+	return bitfield.NewBitvector4()
+}
+
 // InnerObject returns the underlying metadata protobuf structure.
 func (m MetadataV0) InnerObject() interface{} {
 	return m.md
@@ -103,6 +109,11 @@ func (m MetadataV1) AttnetsBitfield() bitfield.Bitvector64 {
 	return m.md.Attnets
 }
 
+// SyncnetsBitfield returns the sync committee subnets bitfield stored in the metadata.
+func (m MetadataV1) SyncnetsBitfield() bitfield.Bitvector4 {
+	return m.md.Syncnets
+}
+
 // InnerObject returns the underlying metadata protobuf structure.
 func (m MetadataV1) InnerObject() interface{} {
 	return m.md
@@ -14,6 +14,8 @@ type BlockMutator struct {
 	Phase0    func(beaconBlock *eth.SignedBeaconBlock)
 	Altair    func(beaconBlock *eth.SignedBeaconBlockAltair)
 	Bellatrix func(beaconBlock *eth.SignedBeaconBlockBellatrix)
+	// BlindedBellatrix is optional, blinded blocks are unsupported when it is not set.
+	BlindedBellatrix func(beaconBlock *eth.SignedBlindedBeaconBlockBellatrix)
 }
 
 func (m BlockMutator) Apply(b interfaces.SignedBeaconBlock) error {
@@ -39,6 +41,16 @@ func (m BlockMutator) Apply(b interfaces.SignedBeaconBlock) error {
 		}
 		m.Bellatrix(bb)
 		return nil
+	case version.BellatrixBlind:
+		if m.BlindedBellatrix == nil {
+			break
+		}
+		bb, err := b.PbBlindedBellatrixBlock()
+		if err != nil {
+			return err
+		}
+		m.BlindedBellatrix(bb)
+		return nil
 	}
 	msg := fmt.Sprintf("version %d = %s", b.Version(), version.String(b.Version()))
 	return errors.Wrap(ErrUnsupportedSignedBeaconBlock, msg)
@@ -46,32 +58,36 @@ func (m BlockMutator) Apply(b interfaces.SignedBeaconBlock) error {
 
 func SetBlockStateRoot(b interfaces.SignedBeaconBlock, sr [32]byte) error {
 	return BlockMutator{
-		Phase0:    func(bb *eth.SignedBeaconBlock) { bb.Block.StateRoot = sr[:] },
-		Altair:    func(bb *eth.SignedBeaconBlockAltair) { bb.Block.StateRoot = sr[:] },
-		Bellatrix: func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.StateRoot = sr[:] },
+		Phase0:           func(bb *eth.SignedBeaconBlock) { bb.Block.StateRoot = sr[:] },
+		Altair:           func(bb *eth.SignedBeaconBlockAltair) { bb.Block.StateRoot = sr[:] },
+		Bellatrix:        func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.StateRoot = sr[:] },
+		BlindedBellatrix: func(bb *eth.SignedBlindedBeaconBlockBellatrix) { bb.Block.StateRoot = sr[:] },
 	}.Apply(b)
 }
 
 func SetBlockParentRoot(b interfaces.SignedBeaconBlock, pr [32]byte) error {
 	return BlockMutator{
-		Phase0:    func(bb *eth.SignedBeaconBlock) { bb.Block.ParentRoot = pr[:] },
-		Altair:    func(bb *eth.SignedBeaconBlockAltair) { bb.Block.ParentRoot = pr[:] },
-		Bellatrix: func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.ParentRoot = pr[:] },
+		Phase0:           func(bb *eth.SignedBeaconBlock) { bb.Block.ParentRoot = pr[:] },
+		Altair:           func(bb *eth.SignedBeaconBlockAltair) { bb.Block.ParentRoot = pr[:] },
+		Bellatrix:        func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.ParentRoot = pr[:] },
+		BlindedBellatrix: func(bb *eth.SignedBlindedBeaconBlockBellatrix) { bb.Block.ParentRoot = pr[:] },
 	}.Apply(b)
 }
 
 func SetBlockSlot(b interfaces.SignedBeaconBlock, s types.Slot) error {
 	return BlockMutator{
-		Phase0:    func(bb *eth.SignedBeaconBlock) { bb.Block.Slot = s },
-		Altair:    func(bb *eth.SignedBeaconBlockAltair) { bb.Block.Slot = s },
-		Bellatrix: func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.Slot = s },
+		Phase0:           func(bb *eth.SignedBeaconBlock) { bb.Block.Slot = s },
+		Altair:           func(bb *eth.SignedBeaconBlockAltair) { bb.Block.Slot = s },
+		Bellatrix:        func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.Slot = s },
+		BlindedBellatrix: func(bb *eth.SignedBlindedBeaconBlockBellatrix) { bb.Block.Slot = s },
 	}.Apply(b)
 }
 
 func SetProposerIndex(b interfaces.SignedBeaconBlock, idx types.ValidatorIndex) error {
 	return BlockMutator{
-		Phase0:    func(bb *eth.SignedBeaconBlock) { bb.Block.ProposerIndex = idx },
-		Altair:    func(bb *eth.SignedBeaconBlockAltair) { bb.Block.ProposerIndex = idx },
-		Bellatrix: func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.ProposerIndex = idx },
+		Phase0:           func(bb *eth.SignedBeaconBlock) { bb.Block.ProposerIndex = idx },
+		Altair:           func(bb *eth.SignedBeaconBlockAltair) { bb.Block.ProposerIndex = idx },
+		Bellatrix:        func(bb *eth.SignedBeaconBlockBellatrix) { bb.Block.ProposerIndex = idx },
+		BlindedBellatrix: func(bb *eth.SignedBlindedBeaconBlockBellatrix) { bb.Block.ProposerIndex = idx },
 	}.Apply(b)
 }
@@ -241,6 +241,27 @@ func TestPriorityQueue_RetrieveByKey(t *testing.T) {
 
 }
 
+func TestPriorityQueue_Items(t *testing.T) {
+	pq := New()
+	require.Equal(t, 0, len(pq.Items()))
+
+	tc := testCases()
+	for _, i := range tc {
+		require.NoError(t, pq.Push(i))
+	}
+
+	items := pq.Items()
+	require.Equal(t, len(tc), len(items))
+	for i := 1; i < len(items); i++ {
+		if items[i-1].Priority > items[i].Priority {
+			t.Fatalf("items are not ordered by priority: %d before %d", items[i-1].Priority, items[i].Priority)
+		}
+	}
+
+	// Items are not removed from the queue.
+	testValidateInternalData(t, pq, len(tc), true)
+}
+
 // testValidateInternalData checks the internal data structure of the PriorityQueue
 // and verifies that items are in-sync. Use drain only at the end of a test,
 // because it will mutate the input queue
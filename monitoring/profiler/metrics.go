@@ -0,0 +1,23 @@
+package profiler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "profiler")
+
+	// snapshotsCounter counts the profile snapshots captured by the service.
+	snapshotsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "profiler",
+			Name:      "snapshots_total",
+			Help:      "The total number of captured profile snapshots",
+		},
+		[]string{
+			"reason",
+		},
+	)
+)
@@ -0,0 +1,201 @@
+// Package profiler watches the memory and goroutine usage of the process and captures pprof
+// profiles to a debug directory when they cross the configured thresholds, easing the diagnosis
+// of leaks happening in production.
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// Reasons of a profile snapshot, included in the names of the profile files.
+const (
+	ReasonHeap       = "heap"
+	ReasonGoroutines = "goroutines"
+	ReasonManual     = "manual"
+)
+
+const (
+	defaultCheckInterval = 30 * time.Second
+	defaultCooldown      = 10 * time.Minute
+)
+
+// Profiles captured by a snapshot.
+var snapshotProfiles = []string{"heap", "goroutine"}
+
+// Snapshotter captures profile snapshots of the running process.
+type Snapshotter interface {
+	Snapshot(reason string) ([]string, error)
+}
+
+// Config for the profiler service.
+type Config struct {
+	// Dir is the directory the profiles are written to.
+	Dir string
+	// HeapThreshold is the number of bytes of allocated heap objects above which a snapshot is
+	// captured. Zero disables the check.
+	HeapThreshold uint64
+	// GoroutineThreshold is the number of goroutines above which a snapshot is captured. Zero
+	// disables the check.
+	GoroutineThreshold int
+	// CheckInterval is the interval at which the memory and goroutine counts are checked.
+	CheckInterval time.Duration
+	// Cooldown is the minimum time between two snapshots triggered by a threshold.
+	Cooldown time.Duration
+}
+
+// Service periodically checks the memory and goroutine counts of the process, and captures
+// heap and goroutine profiles when they cross their thresholds.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock               sync.Mutex
+	lastSnapshot       time.Time
+	heapExceeded       bool
+	goroutinesExceeded bool
+	now                func() time.Time
+	numGoroutine       func() int
+	heapAlloc          func() uint64
+}
+
+// NewService sets up a new profiler service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("nil config")
+	}
+	if cfg.Dir == "" {
+		return nil, errors.New("no profile directory specified")
+	}
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = defaultCheckInterval
+	}
+	if cfg.Cooldown == 0 {
+		cfg.Cooldown = defaultCooldown
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:          cfg,
+		ctx:          ctx,
+		cancel:       cancel,
+		now:          time.Now,
+		numGoroutine: runtime.NumGoroutine,
+		heapAlloc: func() uint64 {
+			var m runtime.MemStats
+			runtime.ReadMemStats(&m)
+			return m.HeapAlloc
+		},
+	}, nil
+}
+
+// Start the profiler service.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"dir":                s.cfg.Dir,
+		"heapThreshold":      s.cfg.HeapThreshold,
+		"goroutineThreshold": s.cfg.GoroutineThreshold,
+	}).Info("Starting profiler")
+	go s.run()
+}
+
+// Stop the profiler service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the profiler service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.check()
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting profiler")
+			return
+		}
+	}
+}
+
+// check captures a snapshot when the heap or the goroutine count cross their threshold. A
+// snapshot is only captured again once the count went back below its threshold, and no sooner
+// than the cooldown after the previous snapshot.
+func (s *Service) check() {
+	heapAlloc := s.heapAlloc()
+	goroutines := s.numGoroutine()
+
+	s.lock.Lock()
+	heapExceeded := s.cfg.HeapThreshold > 0 && heapAlloc >= s.cfg.HeapThreshold
+	goroutinesExceeded := s.cfg.GoroutineThreshold > 0 && goroutines >= s.cfg.GoroutineThreshold
+	var reason string
+	switch {
+	case heapExceeded && !s.heapExceeded:
+		reason = ReasonHeap
+	case goroutinesExceeded && !s.goroutinesExceeded:
+		reason = ReasonGoroutines
+	}
+	s.heapExceeded = heapExceeded
+	s.goroutinesExceeded = goroutinesExceeded
+	inCooldown := !s.lastSnapshot.IsZero() && s.now().Sub(s.lastSnapshot) < s.cfg.Cooldown
+	s.lock.Unlock()
+
+	if reason == "" || inCooldown {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"heapAllocBytes": heapAlloc,
+		"goroutines":     goroutines,
+		"reason":         reason,
+	}).Warn("Profiling threshold crossed, capturing profile snapshot")
+	if _, err := s.Snapshot(reason); err != nil {
+		log.WithError(err).Error("Could not capture profile snapshot")
+	}
+}
+
+// Snapshot writes the heap and goroutine profiles of the process to the profile directory, and
+// returns the paths of the written files.
+func (s *Service) Snapshot(reason string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := file.MkdirAll(s.cfg.Dir); err != nil {
+		return nil, errors.Wrap(err, "could not create profile directory")
+	}
+	now := s.now()
+	timestamp := now.UTC().Format("20060102T150405Z")
+	files := make([]string, 0, len(snapshotProfiles))
+	for _, name := range snapshotProfiles {
+		buf := new(bytes.Buffer)
+		if err := pprof.Lookup(name).WriteTo(buf, 0); err != nil {
+			return nil, errors.Wrapf(err, "could not write %s profile", name)
+		}
+		path := filepath.Join(s.cfg.Dir, fmt.Sprintf("%s-%s-%s.pprof", timestamp, reason, name))
+		if err := file.WriteFile(path, buf.Bytes()); err != nil {
+			return nil, errors.Wrapf(err, "could not save %s profile", name)
+		}
+		files = append(files, path)
+	}
+	s.lastSnapshot = now
+	snapshotsCounter.WithLabelValues(reason).Inc()
+	log.WithFields(logrus.Fields{
+		"files":  files,
+		"reason": reason,
+	}).Info("Captured profile snapshot")
+	return files, nil
+}
@@ -0,0 +1,84 @@
+package profiler
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestService_Snapshot(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	s, err := NewService(context.Background(), &Config{Dir: dir})
+	require.NoError(t, err)
+
+	files, err := s.Snapshot(ReasonManual)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+	for _, f := range files {
+		assert.Equal(t, dir, filepath.Dir(f))
+		assert.Equal(t, true, strings.Contains(filepath.Base(f), ReasonManual))
+		assert.Equal(t, true, file.FileExists(f))
+	}
+}
+
+func TestService_CheckThresholds(t *testing.T) {
+	now := time.Unix(1000, 0)
+	heap := uint64(10)
+	goroutines := 10
+	s, err := NewService(context.Background(), &Config{
+		Dir:                filepath.Join(t.TempDir(), "profiles"),
+		HeapThreshold:      100,
+		GoroutineThreshold: 100,
+		Cooldown:           time.Minute,
+	})
+	require.NoError(t, err)
+	s.now = func() time.Time { return now }
+	s.heapAlloc = func() uint64 { return heap }
+	s.numGoroutine = func() int { return goroutines }
+	snapshots := func() int {
+		if exists, err := file.HasDir(s.cfg.Dir); err != nil || !exists {
+			return 0
+		}
+		dirFiles, err := file.DirFiles(s.cfg.Dir)
+		require.NoError(t, err)
+		return len(dirFiles) / len(snapshotProfiles)
+	}
+
+	// Below the thresholds.
+	s.check()
+	assert.Equal(t, 0, snapshots())
+
+	// Crossing the heap threshold captures a snapshot, once.
+	heap = 200
+	s.check()
+	assert.Equal(t, 1, snapshots())
+	now = now.Add(2 * time.Minute)
+	s.check()
+	assert.Equal(t, 1, snapshots())
+
+	// Crossing the goroutine threshold within the cooldown does not capture a snapshot.
+	s.lastSnapshot = now
+	goroutines = 200
+	now = now.Add(time.Second)
+	s.check()
+	assert.Equal(t, 1, snapshots())
+
+	// Crossing the heap threshold again after the cooldown captures a snapshot.
+	heap = 10
+	s.check()
+	heap = 200
+	now = now.Add(2 * time.Minute)
+	s.check()
+	assert.Equal(t, 2, snapshots())
+}
+
+func TestNewService_NoDir(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{})
+	require.ErrorContains(t, "no profile directory specified", err)
+}
@@ -38,9 +38,9 @@ func IsForkNextEpoch(genesisTime time.Time, genesisValidatorsRoot []byte) (bool,
 	return isForkEpoch, nil
 }
 
-// ForkDigestFromEpoch retrieves the fork digest from the current schedule determined
+// DigestAtEpoch retrieves the fork digest from the current schedule determined
 // by the provided epoch.
-func ForkDigestFromEpoch(currentEpoch types.Epoch, genesisValidatorsRoot []byte) ([4]byte, error) {
+func DigestAtEpoch(currentEpoch types.Epoch, genesisValidatorsRoot []byte) ([4]byte, error) {
 	if len(genesisValidatorsRoot) == 0 {
 		return [4]byte{}, errors.New("genesis validators root is not set")
 	}
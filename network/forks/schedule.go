@@ -0,0 +1,100 @@
+package forks
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+// scheduledVersions are the runtime versions of the forks which are scheduled by epoch, in
+// activation order.
+var scheduledVersions = []int{version.Phase0, version.Altair, version.Bellatrix, version.Capella}
+
+// ForkEpoch returns the activation epoch of the fork of the given runtime version, as set in
+// the active chain config.
+func ForkEpoch(v int) (types.Epoch, error) {
+	return forkEpoch(params.BeaconConfig(), v)
+}
+
+func forkEpoch(cfg *params.BeaconChainConfig, v int) (types.Epoch, error) {
+	switch v {
+	case version.Phase0:
+		return cfg.GenesisEpoch, nil
+	case version.Altair:
+		return cfg.AltairForkEpoch, nil
+	case version.Bellatrix, version.BellatrixBlind:
+		return cfg.BellatrixForkEpoch, nil
+	case version.Capella:
+		return cfg.CapellaForkEpoch, nil
+	default:
+		return 0, errors.Wrapf(ErrVersionNotFound, "no fork epoch for version %s", version.String(v))
+	}
+}
+
+// IsActive returns true if the fork of the given runtime version is active at the epoch.
+func IsActive(v int, epoch types.Epoch) bool {
+	activation, err := ForkEpoch(v)
+	if err != nil {
+		return false
+	}
+	return epoch >= activation
+}
+
+// ActivatesAt returns true if the fork of the given runtime version activates at the epoch.
+func ActivatesAt(v int, epoch types.Epoch) bool {
+	activation, err := ForkEpoch(v)
+	if err != nil {
+		return false
+	}
+	return epoch == activation
+}
+
+// VersionAtEpoch returns the runtime version of the latest fork active at the epoch.
+func VersionAtEpoch(epoch types.Epoch) int {
+	active := version.Phase0
+	for _, v := range scheduledVersions {
+		if IsActive(v, epoch) {
+			active = v
+		}
+	}
+	return active
+}
+
+// IsForkEpoch returns true if a fork other than the genesis one activates at the epoch.
+func IsForkEpoch(epoch types.Epoch) bool {
+	for _, v := range scheduledVersions[1:] {
+		if ActivatesAt(v, epoch) {
+			return true
+		}
+	}
+	return false
+}
+
+// DigestOfVersion returns the fork digest of the fork of the given runtime version.
+func DigestOfVersion(v int, genesisValidatorsRoot []byte) ([4]byte, error) {
+	activation, err := ForkEpoch(v)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return DigestAtEpoch(activation, genesisValidatorsRoot)
+}
+
+// ValidateForkEpochs checks that the forks of the config are scheduled in activation order.
+func ValidateForkEpochs(cfg *params.BeaconChainConfig) error {
+	previous := cfg.GenesisEpoch
+	for i := 1; i < len(scheduledVersions); i++ {
+		epoch, err := forkEpoch(cfg, scheduledVersions[i])
+		if err != nil {
+			return err
+		}
+		if epoch < previous {
+			return errors.Errorf(
+				"%s fork epoch %d is before the %s fork epoch %d",
+				version.String(scheduledVersions[i]), epoch, version.String(scheduledVersions[i-1]), previous,
+			)
+		}
+		previous = epoch
+	}
+	return nil
+}
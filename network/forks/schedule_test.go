@@ -0,0 +1,83 @@
+package forks
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestVersionAtEpoch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 10
+	cfg.BellatrixForkEpoch = 20
+	cfg.CapellaForkEpoch = 20
+	params.OverrideBeaconConfig(cfg)
+
+	tests := []struct {
+		epoch types.Epoch
+		want  int
+	}{
+		{epoch: 0, want: version.Phase0},
+		{epoch: 9, want: version.Phase0},
+		{epoch: 10, want: version.Altair},
+		{epoch: 19, want: version.Altair},
+		{epoch: 20, want: version.Capella},
+		{epoch: 100, want: version.Capella},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, VersionAtEpoch(tt.epoch), "epoch %d", tt.epoch)
+	}
+
+	assert.Equal(t, false, IsActive(version.Altair, 9))
+	assert.Equal(t, true, IsActive(version.Altair, 10))
+	assert.Equal(t, true, IsActive(version.BellatrixBlind, 20))
+	assert.Equal(t, false, IsActive(-1, 20))
+	assert.Equal(t, false, IsForkEpoch(0))
+	assert.Equal(t, true, IsForkEpoch(10))
+	assert.Equal(t, true, ActivatesAt(version.Bellatrix, 20))
+	assert.Equal(t, false, ActivatesAt(version.Bellatrix, 21))
+	_, err := ForkEpoch(-1)
+	require.ErrorIs(t, err, ErrVersionNotFound)
+}
+
+func TestDigestOfVersion(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 10
+	cfg.BellatrixForkEpoch = 20
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{'A'}, 32)
+
+	digest, err := DigestOfVersion(version.Altair, genesisValidatorsRoot)
+	require.NoError(t, err)
+	want, err := signing.ComputeForkDigest(cfg.AltairForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, want, digest)
+
+	digest, err = DigestOfVersion(version.BellatrixBlind, genesisValidatorsRoot)
+	require.NoError(t, err)
+	want, err = DigestAtEpoch(25, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, want, digest)
+}
+
+func TestValidateForkEpochs(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	require.NoError(t, ValidateForkEpochs(cfg))
+
+	cfg.AltairForkEpoch = 10
+	cfg.BellatrixForkEpoch = 5
+	require.ErrorContains(t, "bellatrix fork epoch 5 is before the altair fork epoch 10", ValidateForkEpochs(cfg))
+
+	cfg.BellatrixForkEpoch = 10
+	cfg.CapellaForkEpoch = 9
+	require.ErrorContains(t, "capella fork epoch 9 is before the bellatrix fork epoch 10", ValidateForkEpochs(cfg))
+}
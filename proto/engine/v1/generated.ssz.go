@@ -1,9 +1,10 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 1ff7027b5ff36aabafba387be30535ceee20e61226f5d23ec138769493c9e0be
+// Hash: 4f4b54d454f02d43cd48d0d1f311bba0ed290c0a14c2cfdbfd85147397326d56
 package enginev1
 
 import (
 	ssz "github.com/prysmaticlabs/fastssz"
+	github_com_prysmaticlabs_prysm_consensus_types_primitives "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 )
 
 // MarshalSSZ ssz marshals the ExecutionPayload object
@@ -711,3 +712,96 @@ func (e *ExecutionPayloadHeader) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	return
 }
+
+// MarshalSSZ ssz marshals the Withdrawal object
+func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
+}
+
+// MarshalSSZTo ssz marshals the Withdrawal object to a target array
+func (w *Withdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'WithdrawalIndex'
+	dst = ssz.MarshalUint64(dst, w.WithdrawalIndex)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(w.ValidatorIndex))
+
+	// Field (2) 'ExecutionAddress'
+	if size := len(w.ExecutionAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionAddress", size, 20)
+		return
+	}
+	dst = append(dst, w.ExecutionAddress...)
+
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, w.Amount)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Withdrawal object
+func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 44 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'WithdrawalIndex'
+	w.WithdrawalIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ValidatorIndex'
+	w.ValidatorIndex = github_com_prysmaticlabs_prysm_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'ExecutionAddress'
+	if cap(w.ExecutionAddress) == 0 {
+		w.ExecutionAddress = make([]byte, 0, len(buf[16:36]))
+	}
+	w.ExecutionAddress = append(w.ExecutionAddress, buf[16:36]...)
+
+	// Field (3) 'Amount'
+	w.Amount = ssz.UnmarshallUint64(buf[36:44])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Withdrawal object
+func (w *Withdrawal) SizeSSZ() (size int) {
+	size = 44
+	return
+}
+
+// HashTreeRoot ssz hashes the Withdrawal object
+func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
+}
+
+// HashTreeRootWith ssz hashes the Withdrawal object with a hasher
+func (w *Withdrawal) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'WithdrawalIndex'
+	hh.PutUint64(w.WithdrawalIndex)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(uint64(w.ValidatorIndex))
+
+	// Field (2) 'ExecutionAddress'
+	if size := len(w.ExecutionAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionAddress", size, 20)
+		return
+	}
+	hh.PutBytes(w.ExecutionAddress)
+
+	// Field (3) 'Amount'
+	hh.PutUint64(w.Amount)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
@@ -234,6 +234,38 @@ func V1ExitToV1Alpha1(v1Exit *ethpbv1.SignedVoluntaryExit) *ethpbalpha.SignedVol
 	}
 }
 
+// V1SignedBLSToExecutionChangeToV1Alpha1 converts a v1 SignedBLSToExecutionChange to v1alpha1.
+func V1SignedBLSToExecutionChangeToV1Alpha1(change *ethpbv1.SignedBLSToExecutionChange) *ethpbalpha.SignedBLSToExecutionChange {
+	if change == nil || change.Message == nil {
+		return &ethpbalpha.SignedBLSToExecutionChange{}
+	}
+	return &ethpbalpha.SignedBLSToExecutionChange{
+		Message: &ethpbalpha.BLSToExecutionChange{
+			ValidatorIndex:     change.Message.ValidatorIndex,
+			FromBlsPubkey:      bytesutil.SafeCopyBytes(change.Message.FromBlsPubkey),
+			ToExecutionAddress: bytesutil.SafeCopyBytes(change.Message.ToExecutionAddress),
+		},
+		Signature: bytesutil.SafeCopyBytes(change.Signature),
+	}
+}
+
+// V1Alpha1BlobSidecarToV1 converts a v1alpha1 BlobSidecar to v1.
+func V1Alpha1BlobSidecarToV1(sidecar *ethpbalpha.BlobSidecar) *ethpbv1.BlobSidecar {
+	if sidecar == nil {
+		return &ethpbv1.BlobSidecar{}
+	}
+	return &ethpbv1.BlobSidecar{
+		BlockRoot:       bytesutil.SafeCopyBytes(sidecar.BlockRoot),
+		Index:           sidecar.Index,
+		Slot:            sidecar.Slot,
+		BlockParentRoot: bytesutil.SafeCopyBytes(sidecar.BlockParentRoot),
+		ProposerIndex:   sidecar.ProposerIndex,
+		Blob:            bytesutil.SafeCopyBytes(sidecar.Blob),
+		KzgCommitment:   bytesutil.SafeCopyBytes(sidecar.KzgCommitment),
+		KzgProof:        bytesutil.SafeCopyBytes(sidecar.KzgProof),
+	}
+}
+
 // V1AttToV1Alpha1 converts a v1 attestation to v1alpha1.
 func V1AttToV1Alpha1(v1Att *ethpbv1.Attestation) *ethpbalpha.Attestation {
 	if v1Att == nil {
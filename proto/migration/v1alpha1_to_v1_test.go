@@ -242,6 +242,24 @@ func Test_V1ExitToV1Alpha1(t *testing.T) {
 	assert.DeepEqual(t, alphaRoot, v1Root)
 }
 
+func Test_V1SignedBLSToExecutionChangeToV1Alpha1(t *testing.T) {
+	change := &ethpbv1.SignedBLSToExecutionChange{
+		Message: &ethpbv1.BLSToExecutionChange{
+			ValidatorIndex:     validatorIndex,
+			FromBlsPubkey:      bytesutil.PadTo([]byte("pubkey"), 48),
+			ToExecutionAddress: bytesutil.PadTo([]byte("address"), 20),
+		},
+		Signature: signature,
+	}
+
+	alphaChange := V1SignedBLSToExecutionChangeToV1Alpha1(change)
+	require.NotNil(t, alphaChange.Message)
+	assert.Equal(t, validatorIndex, alphaChange.Message.ValidatorIndex)
+	assert.DeepEqual(t, change.Message.FromBlsPubkey, alphaChange.Message.FromBlsPubkey)
+	assert.DeepEqual(t, change.Message.ToExecutionAddress, alphaChange.Message.ToExecutionAddress)
+	assert.DeepEqual(t, signature, alphaChange.Signature)
+}
+
 func Test_V1AttSlashingToV1Alpha1(t *testing.T) {
 	v1Attestation := &ethpbv1.IndexedAttestation{
 		AttestingIndices: attestingIndices,
@@ -567,8 +585,8 @@ func TestBeaconStateToProto(t *testing.T) {
 	assert.DeepEqual(t, bytesutil.PadTo([]byte("lbhparentroot"), 32), resultLatestBlockHeader.ParentRoot)
 	assert.DeepEqual(t, bytesutil.PadTo([]byte("lbhstateroot"), 32), resultLatestBlockHeader.StateRoot)
 	assert.DeepEqual(t, bytesutil.PadTo([]byte("lbhbodyroot"), 32), resultLatestBlockHeader.BodyRoot)
-	assert.DeepEqual(t, [][]byte{bytesutil.PadTo([]byte("blockroots"), 32)}, result.BlockRoots)
-	assert.DeepEqual(t, [][]byte{bytesutil.PadTo([]byte("stateroots"), 32)}, result.StateRoots)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("blockroots"), 32), result.BlockRoots[0])
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("stateroots"), 32), result.StateRoots[0])
 	assert.DeepEqual(t, [][]byte{bytesutil.PadTo([]byte("historicalroots"), 32)}, result.HistoricalRoots)
 	resultEth1Data := result.Eth1Data
 	require.NotNil(t, resultEth1Data)
@@ -594,7 +612,7 @@ func TestBeaconStateToProto(t *testing.T) {
 	assert.Equal(t, types.Epoch(12), resultValidator.ExitEpoch)
 	assert.Equal(t, types.Epoch(13), resultValidator.WithdrawableEpoch)
 	assert.DeepEqual(t, []uint64{14}, result.Balances)
-	assert.DeepEqual(t, [][]byte{bytesutil.PadTo([]byte("randaomixes"), 32)}, result.RandaoMixes)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte("randaomixes"), 32), result.RandaoMixes[0])
 	assert.DeepEqual(t, []uint64{15}, result.Slashings)
 	require.Equal(t, 1, len(result.PreviousEpochAttestations))
 	resultPrevEpochAtt := result.PreviousEpochAttestations[0]
@@ -322,6 +322,21 @@ func CopySignedVoluntaryExit(exit *SignedVoluntaryExit) *SignedVoluntaryExit {
 	}
 }
 
+// CopySignedBLSToExecutionChange copies the provided SignedBLSToExecutionChange.
+func CopySignedBLSToExecutionChange(change *SignedBLSToExecutionChange) *SignedBLSToExecutionChange {
+	if change == nil {
+		return nil
+	}
+	return &SignedBLSToExecutionChange{
+		Message: &BLSToExecutionChange{
+			ValidatorIndex:     change.Message.ValidatorIndex,
+			FromBlsPubkey:      bytesutil.SafeCopyBytes(change.Message.FromBlsPubkey),
+			ToExecutionAddress: bytesutil.SafeCopyBytes(change.Message.ToExecutionAddress),
+		},
+		Signature: bytesutil.SafeCopyBytes(change.Signature),
+	}
+}
+
 // CopyValidator copies the provided validator.
 func CopyValidator(val *Validator) *Validator {
 	pubKey := make([]byte, len(val.PublicKey))
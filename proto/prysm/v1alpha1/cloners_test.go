@@ -239,6 +239,23 @@ func TestCopySignedVoluntaryExit(t *testing.T) {
 	assert.NotEmpty(t, got, "Copied signed voluntary exit has empty fields")
 }
 
+func TestCopySignedBLSToExecutionChange(t *testing.T) {
+	c := &v1alpha1.SignedBLSToExecutionChange{
+		Message: &v1alpha1.BLSToExecutionChange{
+			ValidatorIndex:     123,
+			FromBlsPubkey:      bytes(),
+			ToExecutionAddress: bytes(),
+		},
+		Signature: bytes(),
+	}
+
+	got := v1alpha1.CopySignedBLSToExecutionChange(c)
+	if !reflect.DeepEqual(got, c) {
+		t.Errorf("CopySignedBLSToExecutionChange() = %v, want %v", got, c)
+	}
+	assert.NotEmpty(t, got, "Copied signed BLS to execution change has empty fields")
+}
+
 func TestCopyValidator(t *testing.T) {
 	v := genValidator()
 
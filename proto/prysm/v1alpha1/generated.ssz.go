@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 6da179429d9bb3146b40fa8cc4b1d5d82ed937e745d956c5228c28083fc40ecc
+// Hash: 4a689ad1d4fd82c010422ffc9c3cdba0e08e854054089c495aa93fcae80a7ab5
 package eth
 
 import (
@@ -4804,6 +4804,188 @@ func (s *SignedValidatorRegistrationV1) HashTreeRootWith(hh *ssz.Hasher) (err er
 	return
 }
 
+// MarshalSSZ ssz marshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BLSToExecutionChange object to a target array
+func (b *BLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ValidatorIndex))
+
+	// Field (1) 'FromBlsPubkey'
+	if size := len(b.FromBlsPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.FromBlsPubkey", size, 48)
+		return
+	}
+	dst = append(dst, b.FromBlsPubkey...)
+
+	// Field (2) 'ToExecutionAddress'
+	if size := len(b.ToExecutionAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.ToExecutionAddress", size, 20)
+		return
+	}
+	dst = append(dst, b.ToExecutionAddress...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 76 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	b.ValidatorIndex = github_com_prysmaticlabs_prysm_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'FromBlsPubkey'
+	if cap(b.FromBlsPubkey) == 0 {
+		b.FromBlsPubkey = make([]byte, 0, len(buf[8:56]))
+	}
+	b.FromBlsPubkey = append(b.FromBlsPubkey, buf[8:56]...)
+
+	// Field (2) 'ToExecutionAddress'
+	if cap(b.ToExecutionAddress) == 0 {
+		b.ToExecutionAddress = make([]byte, 0, len(buf[56:76]))
+	}
+	b.ToExecutionAddress = append(b.ToExecutionAddress, buf[56:76]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BLSToExecutionChange object
+func (b *BLSToExecutionChange) SizeSSZ() (size int) {
+	size = 76
+	return
+}
+
+// HashTreeRoot ssz hashes the BLSToExecutionChange object
+func (b *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
+func (b *BLSToExecutionChange) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(uint64(b.ValidatorIndex))
+
+	// Field (1) 'FromBlsPubkey'
+	if size := len(b.FromBlsPubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.FromBlsPubkey", size, 48)
+		return
+	}
+	hh.PutBytes(b.FromBlsPubkey)
+
+	// Field (2) 'ToExecutionAddress'
+	if size := len(b.ToExecutionAddress); size != 20 {
+		err = ssz.ErrBytesLengthFn("--.ToExecutionAddress", size, 20)
+		return
+	}
+	hh.PutBytes(b.ToExecutionAddress)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBLSToExecutionChange object to a target array
+func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 172 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:76]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[76:172]))
+	}
+	s.Signature = append(s.Signature, buf[76:172]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) SizeSSZ() (size int) {
+	size = 172
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBLSToExecutionChange object with a hasher
+func (s *SignedBLSToExecutionChange) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("--.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the Deposit_Data object
 func (d *Deposit_Data) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -8357,6 +8539,294 @@ func (p *PowBlock) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the BlobSidecars object
+func (b *BlobSidecars) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobSidecars object to a target array
+func (b *BlobSidecars) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Sidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Sidecars) * 131256
+
+	// Field (0) 'Sidecars'
+	if size := len(b.Sidecars); size > 4 {
+		err = ssz.ErrListTooBigFn("--.Sidecars", size, 4)
+		return
+	}
+	for ii := 0; ii < len(b.Sidecars); ii++ {
+		if dst, err = b.Sidecars[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobSidecars object
+func (b *BlobSidecars) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Sidecars'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Sidecars'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DivideInt2(len(buf), 131256, 4)
+		if err != nil {
+			return err
+		}
+		b.Sidecars = make([]*BlobSidecar, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Sidecars[ii] == nil {
+				b.Sidecars[ii] = new(BlobSidecar)
+			}
+			if err = b.Sidecars[ii].UnmarshalSSZ(buf[ii*131256 : (ii+1)*131256]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobSidecars object
+func (b *BlobSidecars) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Sidecars'
+	size += len(b.Sidecars) * 131256
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobSidecars object
+func (b *BlobSidecars) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobSidecars object with a hasher
+func (b *BlobSidecars) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Sidecars'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Sidecars))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Sidecars {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 4)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 4)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlobSidecar object
+func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobSidecar object to a target array
+func (b *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'BlockRoot'
+	if size := len(b.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BlockRoot...)
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (3) 'BlockParentRoot'
+	if size := len(b.BlockParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BlockParentRoot...)
+
+	// Field (4) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.ProposerIndex))
+
+	// Field (5) 'Blob'
+	if size := len(b.Blob); size != 131072 {
+		err = ssz.ErrBytesLengthFn("--.Blob", size, 131072)
+		return
+	}
+	dst = append(dst, b.Blob...)
+
+	// Field (6) 'KzgCommitment'
+	if size := len(b.KzgCommitment); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgCommitment", size, 48)
+		return
+	}
+	dst = append(dst, b.KzgCommitment...)
+
+	// Field (7) 'KzgProof'
+	if size := len(b.KzgProof); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgProof", size, 48)
+		return
+	}
+	dst = append(dst, b.KzgProof...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobSidecar object
+func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 131256 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'BlockRoot'
+	if cap(b.BlockRoot) == 0 {
+		b.BlockRoot = make([]byte, 0, len(buf[0:32]))
+	}
+	b.BlockRoot = append(b.BlockRoot, buf[0:32]...)
+
+	// Field (1) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (2) 'Slot'
+	b.Slot = github_com_prysmaticlabs_prysm_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[40:48]))
+
+	// Field (3) 'BlockParentRoot'
+	if cap(b.BlockParentRoot) == 0 {
+		b.BlockParentRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.BlockParentRoot = append(b.BlockParentRoot, buf[48:80]...)
+
+	// Field (4) 'ProposerIndex'
+	b.ProposerIndex = github_com_prysmaticlabs_prysm_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (5) 'Blob'
+	if cap(b.Blob) == 0 {
+		b.Blob = make([]byte, 0, len(buf[88:131160]))
+	}
+	b.Blob = append(b.Blob, buf[88:131160]...)
+
+	// Field (6) 'KzgCommitment'
+	if cap(b.KzgCommitment) == 0 {
+		b.KzgCommitment = make([]byte, 0, len(buf[131160:131208]))
+	}
+	b.KzgCommitment = append(b.KzgCommitment, buf[131160:131208]...)
+
+	// Field (7) 'KzgProof'
+	if cap(b.KzgProof) == 0 {
+		b.KzgProof = make([]byte, 0, len(buf[131208:131256]))
+	}
+	b.KzgProof = append(b.KzgProof, buf[131208:131256]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobSidecar object
+func (b *BlobSidecar) SizeSSZ() (size int) {
+	size = 131256
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobSidecar object
+func (b *BlobSidecar) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobSidecar object with a hasher
+func (b *BlobSidecar) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoot'
+	if size := len(b.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BlockRoot)
+
+	// Field (1) 'Index'
+	hh.PutUint64(b.Index)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(uint64(b.Slot))
+
+	// Field (3) 'BlockParentRoot'
+	if size := len(b.BlockParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BlockParentRoot)
+
+	// Field (4) 'ProposerIndex'
+	hh.PutUint64(uint64(b.ProposerIndex))
+
+	// Field (5) 'Blob'
+	if size := len(b.Blob); size != 131072 {
+		err = ssz.ErrBytesLengthFn("--.Blob", size, 131072)
+		return
+	}
+	hh.PutBytes(b.Blob)
+
+	// Field (6) 'KzgCommitment'
+	if size := len(b.KzgCommitment); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgCommitment", size, 48)
+		return
+	}
+	hh.PutBytes(b.KzgCommitment)
+
+	// Field (7) 'KzgProof'
+	if size := len(b.KzgProof); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgProof", size, 48)
+		return
+	}
+	hh.PutBytes(b.KzgProof)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the Status object
 func (s *Status) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -8554,6 +9024,145 @@ func (b *BeaconBlocksByRangeRequest) HashTreeRootWith(hh *ssz.Hasher) (err error
 	return
 }
 
+// MarshalSSZ ssz marshals the BlobSidecarsByRangeRequest object
+func (b *BlobSidecarsByRangeRequest) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobSidecarsByRangeRequest object to a target array
+func (b *BlobSidecarsByRangeRequest) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'StartSlot'
+	dst = ssz.MarshalUint64(dst, uint64(b.StartSlot))
+
+	// Field (1) 'Count'
+	dst = ssz.MarshalUint64(dst, b.Count)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobSidecarsByRangeRequest object
+func (b *BlobSidecarsByRangeRequest) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'StartSlot'
+	b.StartSlot = github_com_prysmaticlabs_prysm_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Count'
+	b.Count = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobSidecarsByRangeRequest object
+func (b *BlobSidecarsByRangeRequest) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobSidecarsByRangeRequest object
+func (b *BlobSidecarsByRangeRequest) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobSidecarsByRangeRequest object with a hasher
+func (b *BlobSidecarsByRangeRequest) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'StartSlot'
+	hh.PutUint64(uint64(b.StartSlot))
+
+	// Field (1) 'Count'
+	hh.PutUint64(b.Count)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the BlobIdentifier object
+func (b *BlobIdentifier) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobIdentifier object to a target array
+func (b *BlobIdentifier) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'BlockRoot'
+	if size := len(b.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BlockRoot...)
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobIdentifier object
+func (b *BlobIdentifier) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'BlockRoot'
+	if cap(b.BlockRoot) == 0 {
+		b.BlockRoot = make([]byte, 0, len(buf[0:32]))
+	}
+	b.BlockRoot = append(b.BlockRoot, buf[0:32]...)
+
+	// Field (1) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[32:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobIdentifier object
+func (b *BlobIdentifier) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobIdentifier object
+func (b *BlobIdentifier) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobIdentifier object with a hasher
+func (b *BlobIdentifier) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoot'
+	if size := len(b.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BlockRoot)
+
+	// Field (1) 'Index'
+	hh.PutUint64(b.Index)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the ENRForkID object
 func (e *ENRForkID) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -5,6 +5,7 @@ const (
 	Altair
 	Bellatrix
 	BellatrixBlind
+	Capella
 )
 
 func String(version int) string {
@@ -17,6 +18,8 @@ func String(version int) string {
 		return "bellatrix"
 	case BellatrixBlind:
 		return "bellatrix-blind"
+	case Capella:
+		return "capella"
 	default:
 		return "unknown version"
 	}
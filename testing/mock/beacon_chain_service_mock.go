@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChain_StreamChainHeadServer,BeaconChain_StreamAttestationsServer,BeaconChain_StreamBlocksServer,BeaconChain_StreamValidatorsInfoServer,BeaconChain_StreamIndexedAttestationsServer)
+// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconChain_StreamChainHeadServer,BeaconChain_StreamAttestationsServer,BeaconChain_StreamBlocksServer,BeaconChain_StreamCanonicalBlocksServer,BeaconChain_StreamValidatorsInfoServer,BeaconChain_StreamIndexedAttestationsServer)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -370,6 +370,125 @@ func (mr *MockBeaconChain_StreamBlocksServerMockRecorder) SetTrailer(arg0 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChain_StreamBlocksServer)(nil).SetTrailer), arg0)
 }
 
+// MockBeaconChain_StreamCanonicalBlocksServer is a mock of BeaconChain_StreamCanonicalBlocksServer interface.
+type MockBeaconChain_StreamCanonicalBlocksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder
+}
+
+// MockBeaconChain_StreamCanonicalBlocksServerMockRecorder is the mock recorder for MockBeaconChain_StreamCanonicalBlocksServer.
+type MockBeaconChain_StreamCanonicalBlocksServerMockRecorder struct {
+	mock *MockBeaconChain_StreamCanonicalBlocksServer
+}
+
+// NewMockBeaconChain_StreamCanonicalBlocksServer creates a new mock instance.
+func NewMockBeaconChain_StreamCanonicalBlocksServer(ctrl *gomock.Controller) *MockBeaconChain_StreamCanonicalBlocksServer {
+	mock := &MockBeaconChain_StreamCanonicalBlocksServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamCanonicalBlocksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) EXPECT() *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) Send(arg0 *eth.CanonicalBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconChain_StreamCanonicalBlocksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconChain_StreamCanonicalBlocksServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChain_StreamCanonicalBlocksServer)(nil).SetTrailer), arg0)
+}
+
 // MockBeaconChain_StreamValidatorsInfoServer is a mock of BeaconChain_StreamValidatorsInfoServer interface.
 type MockBeaconChain_StreamValidatorsInfoServer struct {
 	ctrl     *gomock.Controller
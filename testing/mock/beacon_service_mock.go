@@ -58,6 +58,26 @@ func (mr *MockBeaconChainClientMockRecorder) AttestationPool(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationPool", reflect.TypeOf((*MockBeaconChainClient)(nil).AttestationPool), varargs...)
 }
 
+// GetAttestationInclusions mocks base method.
+func (m *MockBeaconChainClient) GetAttestationInclusions(arg0 context.Context, arg1 *eth.AttestationInclusionsRequest, arg2 ...grpc.CallOption) (*eth.AttestationInclusionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAttestationInclusions", varargs...)
+	ret0, _ := ret[0].(*eth.AttestationInclusionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttestationInclusions indicates an expected call of GetAttestationInclusions.
+func (mr *MockBeaconChainClientMockRecorder) GetAttestationInclusions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttestationInclusions", reflect.TypeOf((*MockBeaconChainClient)(nil).GetAttestationInclusions), varargs...)
+}
+
 // GetBeaconConfig mocks base method.
 func (m *MockBeaconChainClient) GetBeaconConfig(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.BeaconConfig, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +98,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetBeaconConfig(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconConfig", reflect.TypeOf((*MockBeaconChainClient)(nil).GetBeaconConfig), varargs...)
 }
 
+// GetBlockAncestor mocks base method.
+func (m *MockBeaconChainClient) GetBlockAncestor(arg0 context.Context, arg1 *eth.BlockAncestorRequest, arg2 ...grpc.CallOption) (*eth.BlockAncestorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockAncestor", varargs...)
+	ret0, _ := ret[0].(*eth.BlockAncestorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockAncestor indicates an expected call of GetBlockAncestor.
+func (mr *MockBeaconChainClientMockRecorder) GetBlockAncestor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockAncestor", reflect.TypeOf((*MockBeaconChainClient)(nil).GetBlockAncestor), varargs...)
+}
+
 // GetChainHead mocks base method.
 func (m *MockBeaconChainClient) GetChainHead(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*eth.ChainHead, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +258,26 @@ func (mr *MockBeaconChainClientMockRecorder) GetValidatorQueue(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorQueue", reflect.TypeOf((*MockBeaconChainClient)(nil).GetValidatorQueue), varargs...)
 }
 
+// IsBlockAncestor mocks base method.
+func (m *MockBeaconChainClient) IsBlockAncestor(arg0 context.Context, arg1 *eth.IsBlockAncestorRequest, arg2 ...grpc.CallOption) (*eth.IsBlockAncestorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlockAncestor", varargs...)
+	ret0, _ := ret[0].(*eth.IsBlockAncestorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlockAncestor indicates an expected call of IsBlockAncestor.
+func (mr *MockBeaconChainClientMockRecorder) IsBlockAncestor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlockAncestor", reflect.TypeOf((*MockBeaconChainClient)(nil).IsBlockAncestor), varargs...)
+}
+
 // ListAttestations mocks base method.
 func (m *MockBeaconChainClient) ListAttestations(arg0 context.Context, arg1 *eth.ListAttestationsRequest, arg2 ...grpc.CallOption) (*eth.ListAttestationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -418,6 +478,26 @@ func (mr *MockBeaconChainClientMockRecorder) StreamBlocks(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamBlocks", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamBlocks), varargs...)
 }
 
+// StreamCanonicalBlocks mocks base method.
+func (m *MockBeaconChainClient) StreamCanonicalBlocks(arg0 context.Context, arg1 *eth.CanonicalBlocksRequest, arg2 ...grpc.CallOption) (eth.BeaconChain_StreamCanonicalBlocksClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamCanonicalBlocks", varargs...)
+	ret0, _ := ret[0].(eth.BeaconChain_StreamCanonicalBlocksClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamCanonicalBlocks indicates an expected call of StreamCanonicalBlocks.
+func (mr *MockBeaconChainClientMockRecorder) StreamCanonicalBlocks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamCanonicalBlocks", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamCanonicalBlocks), varargs...)
+}
+
 // StreamChainHead mocks base method.
 func (m *MockBeaconChainClient) StreamChainHead(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (eth.BeaconChain_StreamChainHeadClient, error) {
 	m.ctrl.T.Helper()
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamDutiesClient)
+// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamDutiesClient,BeaconNodeValidator_StreamProposerDutiesClient)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -118,6 +118,26 @@ func (mr *MockBeaconNodeValidatorClientMockRecorder) GetBeaconBlock(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconBlock", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetBeaconBlock), varargs...)
 }
 
+// GetBeaconBlockWithPayload mocks base method.
+func (m *MockBeaconNodeValidatorClient) GetBeaconBlockWithPayload(arg0 context.Context, arg1 *eth.BlockWithPayloadRequest, arg2 ...grpc.CallOption) (*eth.GenericBeaconBlock, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBeaconBlockWithPayload", varargs...)
+	ret0, _ := ret[0].(*eth.GenericBeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeaconBlockWithPayload indicates an expected call of GetBeaconBlockWithPayload.
+func (mr *MockBeaconNodeValidatorClientMockRecorder) GetBeaconBlockWithPayload(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconBlockWithPayload", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetBeaconBlockWithPayload), varargs...)
+}
+
 // GetBlock mocks base method.
 func (m *MockBeaconNodeValidatorClient) GetBlock(arg0 context.Context, arg1 *eth.BlockRequest, arg2 ...grpc.CallOption) (*eth.BeaconBlock, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +238,26 @@ func (mr *MockBeaconNodeValidatorClientMockRecorder) GetSyncSubcommitteeIndex(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncSubcommitteeIndex", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetSyncSubcommitteeIndex), varargs...)
 }
 
+// GetValidatorRegistrations mocks base method.
+func (m *MockBeaconNodeValidatorClient) GetValidatorRegistrations(arg0 context.Context, arg1 *eth.ValidatorRegistrationsRequest, arg2 ...grpc.CallOption) (*eth.SignedValidatorRegistrationsV1, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidatorRegistrations", varargs...)
+	ret0, _ := ret[0].(*eth.SignedValidatorRegistrationsV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorRegistrations indicates an expected call of GetValidatorRegistrations.
+func (mr *MockBeaconNodeValidatorClientMockRecorder) GetValidatorRegistrations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorRegistrations", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).GetValidatorRegistrations), varargs...)
+}
+
 // MultipleValidatorStatus mocks base method.
 func (m *MockBeaconNodeValidatorClient) MultipleValidatorStatus(arg0 context.Context, arg1 *eth.MultipleValidatorStatusRequest, arg2 ...grpc.CallOption) (*eth.MultipleValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +298,26 @@ func (mr *MockBeaconNodeValidatorClientMockRecorder) PrepareBeaconProposer(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareBeaconProposer", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).PrepareBeaconProposer), varargs...)
 }
 
+// PrepareSyncCommitteeSubnets mocks base method.
+func (m *MockBeaconNodeValidatorClient) PrepareSyncCommitteeSubnets(arg0 context.Context, arg1 *eth.PrepareSyncCommitteeSubnetsRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrepareSyncCommitteeSubnets", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareSyncCommitteeSubnets indicates an expected call of PrepareSyncCommitteeSubnets.
+func (mr *MockBeaconNodeValidatorClientMockRecorder) PrepareSyncCommitteeSubnets(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareSyncCommitteeSubnets", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).PrepareSyncCommitteeSubnets), varargs...)
+}
+
 // ProposeAttestation mocks base method.
 func (m *MockBeaconNodeValidatorClient) ProposeAttestation(arg0 context.Context, arg1 *eth.Attestation, arg2 ...grpc.CallOption) (*eth.AttestResponse, error) {
 	m.ctrl.T.Helper()
@@ -378,6 +438,26 @@ func (mr *MockBeaconNodeValidatorClientMockRecorder) StreamDuties(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).StreamDuties), varargs...)
 }
 
+// StreamProposerDuties mocks base method.
+func (m *MockBeaconNodeValidatorClient) StreamProposerDuties(arg0 context.Context, arg1 *eth.ProposerDutiesRequest, arg2 ...grpc.CallOption) (eth.BeaconNodeValidator_StreamProposerDutiesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamProposerDuties", varargs...)
+	ret0, _ := ret[0].(eth.BeaconNodeValidator_StreamProposerDutiesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamProposerDuties indicates an expected call of StreamProposerDuties.
+func (mr *MockBeaconNodeValidatorClientMockRecorder) StreamProposerDuties(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProposerDuties", reflect.TypeOf((*MockBeaconNodeValidatorClient)(nil).StreamProposerDuties), varargs...)
+}
+
 // SubmitAggregateSelectionProof mocks base method.
 func (m *MockBeaconNodeValidatorClient) SubmitAggregateSelectionProof(arg0 context.Context, arg1 *eth.AggregateSelectionRequest, arg2 ...grpc.CallOption) (*eth.AggregateSelectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -946,3 +1026,126 @@ func (mr *MockBeaconNodeValidator_StreamDutiesClientMockRecorder) Trailer() *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconNodeValidator_StreamDutiesClient)(nil).Trailer))
 }
+
+// MockBeaconNodeValidator_StreamProposerDutiesClient is a mock of BeaconNodeValidator_StreamProposerDutiesClient interface.
+type MockBeaconNodeValidator_StreamProposerDutiesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder
+}
+
+// MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder is the mock recorder for MockBeaconNodeValidator_StreamProposerDutiesClient.
+type MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder struct {
+	mock *MockBeaconNodeValidator_StreamProposerDutiesClient
+}
+
+// NewMockBeaconNodeValidator_StreamProposerDutiesClient creates a new mock instance.
+func NewMockBeaconNodeValidator_StreamProposerDutiesClient(ctrl *gomock.Controller) *MockBeaconNodeValidator_StreamProposerDutiesClient {
+	mock := &MockBeaconNodeValidator_StreamProposerDutiesClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) EXPECT() *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) Recv() (*eth.ProposerDutiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.ProposerDutiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesClient)(nil).Trailer))
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamDutiesServer)
+// Source: github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1 (interfaces: BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamDutiesServer,BeaconNodeValidator_StreamProposerDutiesServer)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -97,6 +97,21 @@ func (mr *MockBeaconNodeValidatorServerMockRecorder) GetBeaconBlock(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconBlock", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).GetBeaconBlock), arg0, arg1)
 }
 
+// GetBeaconBlockWithPayload mocks base method.
+func (m *MockBeaconNodeValidatorServer) GetBeaconBlockWithPayload(arg0 context.Context, arg1 *eth.BlockWithPayloadRequest) (*eth.GenericBeaconBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeaconBlockWithPayload", arg0, arg1)
+	ret0, _ := ret[0].(*eth.GenericBeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeaconBlockWithPayload indicates an expected call of GetBeaconBlockWithPayload.
+func (mr *MockBeaconNodeValidatorServerMockRecorder) GetBeaconBlockWithPayload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeaconBlockWithPayload", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).GetBeaconBlockWithPayload), arg0, arg1)
+}
+
 // GetBlock mocks base method.
 func (m *MockBeaconNodeValidatorServer) GetBlock(arg0 context.Context, arg1 *eth.BlockRequest) (*eth.BeaconBlock, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +187,21 @@ func (mr *MockBeaconNodeValidatorServerMockRecorder) GetSyncSubcommitteeIndex(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncSubcommitteeIndex", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).GetSyncSubcommitteeIndex), arg0, arg1)
 }
 
+// GetValidatorRegistrations mocks base method.
+func (m *MockBeaconNodeValidatorServer) GetValidatorRegistrations(arg0 context.Context, arg1 *eth.ValidatorRegistrationsRequest) (*eth.SignedValidatorRegistrationsV1, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorRegistrations", arg0, arg1)
+	ret0, _ := ret[0].(*eth.SignedValidatorRegistrationsV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorRegistrations indicates an expected call of GetValidatorRegistrations.
+func (mr *MockBeaconNodeValidatorServerMockRecorder) GetValidatorRegistrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorRegistrations", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).GetValidatorRegistrations), arg0, arg1)
+}
+
 // MultipleValidatorStatus mocks base method.
 func (m *MockBeaconNodeValidatorServer) MultipleValidatorStatus(arg0 context.Context, arg1 *eth.MultipleValidatorStatusRequest) (*eth.MultipleValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +232,21 @@ func (mr *MockBeaconNodeValidatorServerMockRecorder) PrepareBeaconProposer(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareBeaconProposer", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).PrepareBeaconProposer), arg0, arg1)
 }
 
+// PrepareSyncCommitteeSubnets mocks base method.
+func (m *MockBeaconNodeValidatorServer) PrepareSyncCommitteeSubnets(arg0 context.Context, arg1 *eth.PrepareSyncCommitteeSubnetsRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareSyncCommitteeSubnets", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareSyncCommitteeSubnets indicates an expected call of PrepareSyncCommitteeSubnets.
+func (mr *MockBeaconNodeValidatorServerMockRecorder) PrepareSyncCommitteeSubnets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareSyncCommitteeSubnets", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).PrepareSyncCommitteeSubnets), arg0, arg1)
+}
+
 // ProposeAttestation mocks base method.
 func (m *MockBeaconNodeValidatorServer) ProposeAttestation(arg0 context.Context, arg1 *eth.Attestation) (*eth.AttestResponse, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +335,20 @@ func (mr *MockBeaconNodeValidatorServerMockRecorder) StreamDuties(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).StreamDuties), arg0, arg1)
 }
 
+// StreamProposerDuties mocks base method.
+func (m *MockBeaconNodeValidatorServer) StreamProposerDuties(arg0 *eth.ProposerDutiesRequest, arg1 eth.BeaconNodeValidator_StreamProposerDutiesServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamProposerDuties", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamProposerDuties indicates an expected call of StreamProposerDuties.
+func (mr *MockBeaconNodeValidatorServerMockRecorder) StreamProposerDuties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProposerDuties", reflect.TypeOf((*MockBeaconNodeValidatorServer)(nil).StreamProposerDuties), arg0, arg1)
+}
+
 // SubmitAggregateSelectionProof mocks base method.
 func (m *MockBeaconNodeValidatorServer) SubmitAggregateSelectionProof(arg0 context.Context, arg1 *eth.AggregateSelectionRequest) (*eth.AggregateSelectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -794,3 +853,122 @@ func (mr *MockBeaconNodeValidator_StreamDutiesServerMockRecorder) SetTrailer(arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconNodeValidator_StreamDutiesServer)(nil).SetTrailer), arg0)
 }
+
+// MockBeaconNodeValidator_StreamProposerDutiesServer is a mock of BeaconNodeValidator_StreamProposerDutiesServer interface.
+type MockBeaconNodeValidator_StreamProposerDutiesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder
+}
+
+// MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder is the mock recorder for MockBeaconNodeValidator_StreamProposerDutiesServer.
+type MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder struct {
+	mock *MockBeaconNodeValidator_StreamProposerDutiesServer
+}
+
+// NewMockBeaconNodeValidator_StreamProposerDutiesServer creates a new mock instance.
+func NewMockBeaconNodeValidator_StreamProposerDutiesServer(ctrl *gomock.Controller) *MockBeaconNodeValidator_StreamProposerDutiesServer {
+	mock := &MockBeaconNodeValidator_StreamProposerDutiesServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) EXPECT() *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) Send(arg0 *eth.ProposerDutiesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconNodeValidator_StreamProposerDutiesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconNodeValidator_StreamProposerDutiesServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconNodeValidator_StreamProposerDutiesServer)(nil).SetTrailer), arg0)
+}
@@ -0,0 +1,11 @@
+package light_client
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/spectest/shared/altair/light_client"
+)
+
+func TestMinimal_Altair_LightClient_UpdateRanking(t *testing.T) {
+	light_client.RunUpdateRankingTest(t, "minimal")
+}
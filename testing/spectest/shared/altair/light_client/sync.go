@@ -0,0 +1,116 @@
+package light_client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/api/lightclient"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type SyncMeta struct {
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	TrustedBlockRoot      string `json:"trusted_block_root"`
+}
+
+type SyncStep struct {
+	ProcessUpdate *ProcessUpdateStep `json:"process_update"`
+	ForceUpdate   *ForceUpdateStep   `json:"force_update"`
+}
+
+type ProcessUpdateStep struct {
+	Update      string      `json:"update"`
+	CurrentSlot uint64      `json:"current_slot"`
+	Checks      *SyncChecks `json:"checks"`
+}
+
+type ForceUpdateStep struct {
+	CurrentSlot uint64      `json:"current_slot"`
+	Checks      *SyncChecks `json:"checks"`
+}
+
+type SyncChecks struct {
+	FinalizedHeader  *HeaderCheck `json:"finalized_header"`
+	OptimisticHeader *HeaderCheck `json:"optimistic_header"`
+}
+
+type HeaderCheck struct {
+	Slot       uint64 `json:"slot"`
+	BeaconRoot string `json:"beacon_root"`
+}
+
+// RunSyncTest executes light client sync spec tests, which process updates and force updates in a
+// light client store initialized from a bootstrap.
+func RunSyncTest(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "light_client/sync/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			file, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "meta.yaml")
+			require.NoError(t, err)
+			meta := &SyncMeta{}
+			require.NoError(t, utils.UnmarshalYaml(file, meta), "Failed to Unmarshal")
+			gvr, err := hexutil.Decode(meta.GenesisValidatorsRoot)
+			require.NoError(t, err)
+			trustedBlockRoot, err := hexutil.Decode(meta.TrustedBlockRoot)
+			require.NoError(t, err)
+
+			bootstrap := &lightclient.Bootstrap{}
+			require.NoError(t, bootstrap.UnmarshalSSZ(sszFile(t, testsFolderPath, folder.Name(), "bootstrap.ssz_snappy")), "Failed to unmarshal")
+			store, err := lightclient.NewStore(bytesutil.ToBytes32(trustedBlockRoot), bootstrap)
+			require.NoError(t, err)
+
+			file, err = util.BazelFileBytes(testsFolderPath, folder.Name(), "steps.yaml")
+			require.NoError(t, err)
+			var steps []SyncStep
+			require.NoError(t, utils.UnmarshalYaml(file, &steps), "Failed to Unmarshal")
+			for i, step := range steps {
+				var checks *SyncChecks
+				switch {
+				case step.ProcessUpdate != nil:
+					update := &lightclient.Update{}
+					require.NoError(t, update.UnmarshalSSZ(sszFile(t, testsFolderPath, folder.Name(), fmt.Sprint(step.ProcessUpdate.Update, ".ssz_snappy"))), "Failed to unmarshal")
+					require.NoError(t, store.ProcessUpdate(update, types.Slot(step.ProcessUpdate.CurrentSlot), gvr), "step %d", i)
+					checks = step.ProcessUpdate.Checks
+				case step.ForceUpdate != nil:
+					require.NoError(t, store.ProcessForceUpdate(types.Slot(step.ForceUpdate.CurrentSlot)), "step %d", i)
+					checks = step.ForceUpdate.Checks
+				default:
+					t.Fatalf("unknown step %d", i)
+				}
+				if checks == nil {
+					continue
+				}
+				if checks.FinalizedHeader != nil {
+					checkHeader(t, store.FinalizedHeader, checks.FinalizedHeader)
+				}
+				if checks.OptimisticHeader != nil {
+					checkHeader(t, store.OptimisticHeader, checks.OptimisticHeader)
+				}
+			}
+		})
+	}
+}
+
+func checkHeader(t *testing.T, header *ethpbv1.BeaconBlockHeader, check *HeaderCheck) {
+	require.Equal(t, types.Slot(check.Slot), header.Slot)
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, check.BeaconRoot, fmt.Sprintf("%#x", root))
+}
+
+func sszFile(t *testing.T, folderPath, testName, fileName string) []byte {
+	file, err := util.BazelFileBytes(folderPath, testName, fileName)
+	require.NoError(t, err)
+	ssz, err := snappy.Decode(nil /* dst */, file)
+	require.NoError(t, err, "Failed to decompress")
+	return ssz
+}
@@ -0,0 +1,42 @@
+package light_client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/api/lightclient"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type UpdateRankingMeta struct {
+	UpdatesCount int `json:"updates_count"`
+}
+
+// RunUpdateRankingTest executes light client update ranking spec tests, in which the updates are
+// sorted from the best to the worst.
+func RunUpdateRankingTest(t *testing.T, config string) {
+	require.NoError(t, utils.SetConfig(t, config))
+
+	testFolders, testsFolderPath := utils.TestFolders(t, config, "altair", "light_client/update_ranking/pyspec_tests")
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			file, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "meta.yaml")
+			require.NoError(t, err)
+			meta := &UpdateRankingMeta{}
+			require.NoError(t, utils.UnmarshalYaml(file, meta), "Failed to Unmarshal")
+
+			updates := make([]*lightclient.Update, meta.UpdatesCount)
+			for i := range updates {
+				updates[i] = &lightclient.Update{}
+				require.NoError(t, updates[i].UnmarshalSSZ(sszFile(t, testsFolderPath, folder.Name(), fmt.Sprintf("updates_%d.ssz_snappy", i))), "Failed to unmarshal")
+			}
+			for i := 0; i < len(updates); i++ {
+				for j := i + 1; j < len(updates); j++ {
+					require.Equal(t, false, lightclient.IsBetterUpdate(updates[j].LightClientUpdate, updates[i].LightClientUpdate), "update %d ranks above update %d", j, i)
+				}
+			}
+		})
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"testing"
 
 	fssz "github.com/prysmaticlabs/fastssz"
-	stateAltair "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	common "github.com/prysmaticlabs/prysm/testing/spectest/shared/common/ssz_static"
@@ -21,7 +21,7 @@ func customHtr(t *testing.T, htrs []common.HTR, object interface{}) []common.HTR
 	switch object.(type) {
 	case *ethpb.BeaconStateAltair:
 		htrs = append(htrs, func(s interface{}) ([32]byte, error) {
-			beaconState, err := stateAltair.InitializeFromProto(s.(*ethpb.BeaconStateAltair))
+			beaconState, err := statenative.InitializeFromProtoAltair(s.(*ethpb.BeaconStateAltair))
 			require.NoError(t, err)
 			return beaconState.HashTreeRoot(context.Background())
 		})
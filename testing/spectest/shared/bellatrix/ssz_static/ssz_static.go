@@ -6,7 +6,7 @@ import (
 	"testing"
 
 	fssz "github.com/prysmaticlabs/fastssz"
-	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -22,7 +22,7 @@ func customHtr(t *testing.T, htrs []common.HTR, object interface{}) []common.HTR
 	switch object.(type) {
 	case *ethpb.BeaconStateBellatrix:
 		htrs = append(htrs, func(s interface{}) ([32]byte, error) {
-			beaconState, err := v3.InitializeFromProto(s.(*ethpb.BeaconStateBellatrix))
+			beaconState, err := statenative.InitializeFromProtoBellatrix(s.(*ethpb.BeaconStateBellatrix))
 			require.NoError(t, err)
 			return beaconState.HashTreeRoot(context.Background())
 		})
@@ -8,9 +8,7 @@ import (
 	"github.com/golang/snappy"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
-	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -132,7 +130,7 @@ func Run(t *testing.T, config string, fork int) {
 func unmarshalPhase0State(t *testing.T, raw []byte) state.BeaconState {
 	base := &ethpb.BeaconState{}
 	require.NoError(t, base.UnmarshalSSZ(raw))
-	st, err := v1.InitializeFromProto(base)
+	st, err := statenative.InitializeFromProtoPhase0(base)
 	require.NoError(t, err)
 	return st
 }
@@ -156,7 +154,7 @@ func unmarshalSignedPhase0Block(t *testing.T, raw []byte) interfaces.SignedBeaco
 func unmarshalAltairState(t *testing.T, raw []byte) state.BeaconState {
 	base := &ethpb.BeaconStateAltair{}
 	require.NoError(t, base.UnmarshalSSZ(raw))
-	st, err := v2.InitializeFromProto(base)
+	st, err := statenative.InitializeFromProtoAltair(base)
 	require.NoError(t, err)
 	return st
 }
@@ -180,7 +178,7 @@ func unmarshalSignedAltairBlock(t *testing.T, raw []byte) interfaces.SignedBeaco
 func unmarshalBellatrixState(t *testing.T, raw []byte) state.BeaconState {
 	base := &ethpb.BeaconStateBellatrix{}
 	require.NoError(t, base.UnmarshalSSZ(raw))
-	st, err := v3.InitializeFromProto(base)
+	st, err := statenative.InitializeFromProtoBellatrix(base)
 	require.NoError(t, err)
 	return st
 }
@@ -6,7 +6,7 @@ import (
 	"testing"
 
 	fssz "github.com/prysmaticlabs/fastssz"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	common "github.com/prysmaticlabs/prysm/testing/spectest/shared/common/ssz_static"
@@ -21,7 +21,7 @@ func customHtr(t *testing.T, htrs []common.HTR, object interface{}) []common.HTR
 	switch object.(type) {
 	case *ethpb.BeaconState:
 		htrs = append(htrs, func(s interface{}) ([32]byte, error) {
-			beaconState, err := v1.InitializeFromProto(s.(*ethpb.BeaconState))
+			beaconState, err := statenative.InitializeFromProtoPhase0(s.(*ethpb.BeaconState))
 			require.NoError(t, err)
 			return beaconState.HashTreeRoot(context.TODO())
 		})
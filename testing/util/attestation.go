@@ -11,8 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
-	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
-	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	statenative "github.com/prysmaticlabs/prysm/beacon-chain/state/state-native"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -70,21 +69,21 @@ func GenerateAttestations(
 		var headState state.BeaconState
 		switch bState.Version() {
 		case version.Phase0:
-			pbState, err := v1.ProtobufBeaconState(bState.CloneInnerState())
+			pbState, err := statenative.ProtobufBeaconStatePhase0(bState.CloneInnerState())
 			if err != nil {
 				return nil, err
 			}
-			genState, err := v1.InitializeFromProtoUnsafe(pbState)
+			genState, err := statenative.InitializeFromProtoUnsafePhase0(pbState)
 			if err != nil {
 				return nil, err
 			}
 			headState = genState
 		case version.Altair:
-			pbState, err := v2.ProtobufBeaconState(bState.CloneInnerState())
+			pbState, err := statenative.ProtobufBeaconStateAltair(bState.CloneInnerState())
 			if err != nil {
 				return nil, err
 			}
-			genState, err := v2.InitializeFromProtoUnsafe(pbState)
+			genState, err := statenative.InitializeFromProtoUnsafeAltair(pbState)
 			if err != nil {
 				return nil, err
 			}
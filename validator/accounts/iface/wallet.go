@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
 )
 
@@ -11,6 +12,7 @@ import (
 type InitKeymanagerConfig struct {
 	ListenForChanges bool
 	Web3SignerConfig *remoteweb3signer.SetupConfig
+	DVTConfig        *dvt.SetupConfig
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
@@ -174,6 +174,14 @@ func (_ MockValidator) ReceiveBlocks(_ context.Context, _ chan<- error) {
 	panic("implement me")
 }
 
+func (_ MockValidator) ReceiveProposerDuties(_ context.Context, _ chan<- *ethpb.ProposerDutiesResponse) {
+	panic("implement me")
+}
+
+func (_ MockValidator) UpdateProposerDuties(_ *ethpb.ProposerDutiesResponse) {
+	panic("implement me")
+}
+
 func (_ MockValidator) HandleKeyReload(_ context.Context, _ [][48]byte) (bool, error) {
 	panic("implement me")
 }
@@ -182,6 +190,11 @@ func (_ MockValidator) CheckDoppelGanger(_ context.Context) error {
 	panic("implement me")
 }
 
+// PruneSlashingProtection for mocking
+func (_ MockValidator) PruneSlashingProtection(_ context.Context) {
+	panic("implement me")
+}
+
 // PushProposerSettings for mocking
 func (_ MockValidator) PushProposerSettings(_ context.Context, _ keymanager.IKeymanager) error {
 	panic("implement me")
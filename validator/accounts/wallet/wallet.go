@@ -17,6 +17,7 @@ import (
 	accountsprompt "github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
@@ -57,6 +58,7 @@ var (
 		keymanager.Derived:    "HD Wallet",
 		keymanager.Remote:     "Remote Signing Wallet (Advanced)",
 		keymanager.Web3Signer: "Consensys Web3Signer (Advanced)",
+		keymanager.DVT:        "Distributed Validator Middleware (Advanced)",
 	}
 	// ValidateExistingPass checks that an input cannot be empty.
 	ValidateExistingPass = func(input string) error {
@@ -206,6 +208,17 @@ func NewWalletForWeb3Signer() *Wallet {
 	}
 }
 
+// NewWalletForDVT returns a new wallet for a distributed validator middleware which is temporary and not
+// stored locally.
+func NewWalletForDVT() *Wallet {
+	return &Wallet{
+		walletDir:      "",
+		accountsPath:   "",
+		keymanagerKind: keymanager.DVT,
+		walletPassword: "",
+	}
+}
+
 // OpenWallet instantiates a wallet from a specified path. It checks the
 // type of keymanager associated with the wallet by reading files in the wallet
 // path, if applicable. If a wallet does not exist, returns an appropriate error.
@@ -317,6 +330,18 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
 		}
+	case keymanager.DVT:
+		config := cfg.DVTConfig
+		if config == nil || config.Signer == nil {
+			return nil, errors.New("distributed validator config is nil")
+		}
+		if !bytesutil.IsValidRoot(config.Signer.GenesisValidatorsRoot) {
+			return nil, errors.New("distributed validator keymanager requires a genesis validators root value")
+		}
+		km, err = dvt.NewKeymanager(ctx, config)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize distributed validator keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -121,6 +121,8 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		)
 	case keymanager.Web3Signer:
 		return nil, errors.New("web3signer keymanager does not require persistent wallets.")
+	case keymanager.DVT:
+		return nil, errors.New("distributed validator keymanager does not require persistent wallets.")
 	default:
 		return nil, errors.Wrapf(err, errKeymanagerNotSupported, w.KeymanagerKind())
 	}
@@ -202,6 +204,9 @@ func ExtractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 	if keymanagerKind == keymanager.Web3Signer {
 		return nil, errors.New("web3signer keymanager does not require persistent wallets.")
 	}
+	if keymanagerKind == keymanager.DVT {
+		return nil, errors.New("distributed validator keymanager does not require persistent wallets.")
+	}
 	return createWalletConfig, nil
 }
 
@@ -270,6 +275,7 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
 			wallet.KeymanagerKindSelections[keymanager.Web3Signer],
+			wallet.KeymanagerKindSelections[keymanager.DVT],
 		},
 	}
 	selection, _, err := promptSelect.Run()
@@ -6,23 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/async"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
-	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
-	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
-	prysmTime "github.com/prysmaticlabs/prysm/time"
-	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -100,7 +95,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		return
 	}
 
-	sig, _, err := v.signAtt(ctx, pubKey, data, slot)
+	sig, _, recordSignature, err := v.signAtt(ctx, pubKey, data, slot)
 	if err != nil {
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
@@ -120,6 +115,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		}
 	}
 	if !found {
+		recordSignature(nil)
 		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -137,7 +133,9 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 
 	// Set the signature of the attestation and send it out to the beacon node.
 	indexedAtt.Signature = sig
-	if err := v.slashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot); err != nil {
+	err = v.slashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot)
+	recordSignature(err)
+	if err != nil {
 		log.WithError(err).Error("Failed attestation slashing protection check")
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
@@ -195,24 +193,32 @@ func (v *validator) duty(pubKey [fieldparams.BLSPubkeyLength]byte) (*ethpb.Dutie
 	return nil, fmt.Errorf("pubkey %#x not in duties", bytesutil.Trunc(pubKey[:]))
 }
 
-// Given validator's public key, this function returns the signature of an attestation data and its signing root.
-func (v *validator) signAtt(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, slot types.Slot) ([]byte, [32]byte, error) {
+// Given validator's public key, this function returns the signature of an attestation data, its signing root and
+// the recorder of the signature in the signing audit log.
+func (v *validator) signAtt(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, slot types.Slot,
+) ([]byte, [32]byte, signatureRecorder, error) {
 	domain, root, err := v.getDomainAndSigningRoot(ctx, data)
 	if err != nil {
-		return nil, [32]byte{}, err
+		return nil, [32]byte{}, nil, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	req := &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
 		SigningSlot:     slot,
-	})
+	}
+	if err := v.remoteAttestationCheck(ctx, pubKey, data, root); err != nil {
+		v.signingAudit.RecordRefused(req, err)
+		return nil, [32]byte{}, nil, err
+	}
+	sig, record, err := v.signUnrecorded(ctx, req)
 	if err != nil {
-		return nil, [32]byte{}, err
+		return nil, [32]byte{}, nil, err
 	}
 
-	return sig.Marshal(), root, nil
+	return sig.Marshal(), root, record, nil
 }
 
 func (v *validator) getDomainAndSigningRoot(ctx context.Context, data *ethpb.AttestationData) (*ethpb.DomainResponse, [32]byte, error) {
@@ -246,55 +252,6 @@ func (v *validator) saveAttesterIndexToData(data *ethpb.AttestationData, index t
 	return nil
 }
 
-// waitOneThirdOrValidBlock waits until (a) or (b) whichever comes first:
-//   (a) the validator has received a valid block that is the same slot as input slot
-//   (b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
-func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot types.Slot) {
-	ctx, span := trace.StartSpan(ctx, "validator.waitOneThirdOrValidBlock")
-	defer span.End()
-
-	// Don't need to wait if requested slot is the same as highest valid slot.
-	v.highestValidSlotLock.Lock()
-	if slot <= v.highestValidSlot {
-		v.highestValidSlotLock.Unlock()
-		return
-	}
-	v.highestValidSlotLock.Unlock()
-
-	delay := slots.DivideSlotBy(3 /* a third of the slot duration */)
-	startTime := slots.StartTime(v.genesisTime, slot)
-	finalTime := startTime.Add(delay)
-	wait := prysmTime.Until(finalTime)
-	if wait <= 0 {
-		return
-	}
-	t := time.NewTimer(wait)
-	defer t.Stop()
-
-	bChannel := make(chan interfaces.SignedBeaconBlock, 1)
-	sub := v.blockFeed.Subscribe(bChannel)
-	defer sub.Unsubscribe()
-
-	for {
-		select {
-		case b := <-bChannel:
-			if features.Get().AttestTimely {
-				if slot <= b.Block().Slot() {
-					return
-				}
-			}
-		case <-ctx.Done():
-			tracing.AnnotateError(span, ctx.Err())
-			return
-		case <-sub.Err():
-			log.Error("Subscriber closed, exiting goroutine")
-			return
-		case <-t.C:
-			return
-		}
-	}
-}
-
 func attestationLogFields(pubKey [fieldparams.BLSPubkeyLength]byte, indexedAtt *ethpb.IndexedAttestation) logrus.Fields {
 	return logrus.Fields{
 		"attesterPublicKey": fmt.Sprintf("%#x", pubKey),
@@ -62,6 +62,14 @@ func (v *validator) slashableAttestationCheck(
 		)
 	}
 	fmtKey := "0x" + hex.EncodeToString(pubKey[:])
+	if v.standby != nil {
+		if err := v.standby.CheckAttestation(pubKey, indexedAtt.Data.Source.Epoch, indexedAtt.Data.Target.Epoch); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return err
+		}
+	}
 	slashingKind, err := v.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
 	if err != nil {
 		if v.emitAccountMetrics {
@@ -96,3 +104,20 @@ func (v *validator) slashableAttestationCheck(
 	}
 	return nil
 }
+
+// remoteAttestationCheck asks the remote slashing protection service, when configured, whether the attestation
+// may be signed. The attestation is refused if the service could not be reached.
+func (v *validator) remoteAttestationCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, signingRoot [32]byte,
+) error {
+	if v.remoteProtection == nil {
+		return nil
+	}
+	if err := v.remoteProtection.CheckAttestation(ctx, pubKey, data, signingRoot); err != nil {
+		if v.emitAccountMetrics {
+			ValidatorAttestFailVecSlasher.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Inc()
+		}
+		return err
+	}
+	return nil
+}
@@ -2,7 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/config/features"
@@ -11,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	remoteprotection "github.com/prysmaticlabs/prysm/validator/remote-protection"
 )
 
 func Test_slashableAttestationCheck(t *testing.T) {
@@ -197,3 +202,22 @@ func Test_slashableAttestationCheck_GenesisEpoch(t *testing.T) {
 	require.Equal(t, true, exists)
 	require.Equal(t, types.Epoch(0), e)
 }
+
+func Test_remoteAttestationCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &remoteprotection.AttestationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.NoError(t, json.NewEncoder(w).Encode(&remoteprotection.Response{Allowed: req.TargetEpoch > req.SourceEpoch}))
+	}))
+	defer srv.Close()
+	c, err := remoteprotection.New(srv.URL, time.Second)
+	require.NoError(t, err)
+	v := &validator{remoteProtection: c}
+	ctx := context.Background()
+
+	data := &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 1}, Target: &ethpb.Checkpoint{Epoch: 2}}
+	require.NoError(t, v.remoteAttestationCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, data, [32]byte{}))
+	data.Source.Epoch = 2
+	err = v.remoteAttestationCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, data, [32]byte{})
+	require.ErrorContains(t, "rejected by remote slashing protection", err)
+}
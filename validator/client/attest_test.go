@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
-	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
@@ -474,7 +473,7 @@ func TestSignAttestation(t *testing.T) {
 		},
 	}
 	validator.keyManager = km
-	sig, sr, err := validator.signAtt(ctx, pubKey, att.Data, att.Data.Slot)
+	sig, sr, _, err := validator.signAtt(ctx, pubKey, att.Data, att.Data.Slot)
 	require.NoError(t, err, "%x,%x,%v", sig, sr, err)
 	require.Equal(t, "b6a60f8497bd328908be83634d045"+
 		"dd7a32f5e246b2c4031fc2f316983f362e36fc27fd3d6d5a2b15"+
@@ -524,9 +523,6 @@ func TestServer_WaitToSlotOneThird_SameReqSlot(t *testing.T) {
 }
 
 func TestServer_WaitToSlotOneThird_ReceiveBlockSlot(t *testing.T) {
-	resetCfg := features.InitWithReset(&features.Flags{AttestTimely: true})
-	defer resetCfg()
-
 	currentTime := uint64(time.Now().Unix())
 	currentSlot := types.Slot(4)
 	genesisTime := currentTime - uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot))
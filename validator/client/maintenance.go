@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// keysInMaintenance returns the validator public keys whose maintenance window contains the given epoch.
+// Their duties are skipped until the end of their window. A log is emitted whenever a validator enters or
+// leaves its maintenance window, so that the skipped duties can be audited.
+func (v *validator) keysInMaintenance(ctx context.Context, epoch types.Epoch) (map[[fieldparams.BLSPubkeyLength]byte]bool, error) {
+	if v.db == nil {
+		return nil, nil
+	}
+	windows, err := v.db.MaintenanceWindows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inMaintenance := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for pubKey, window := range windows {
+		if !window.Contains(epoch) {
+			continue
+		}
+		inMaintenance[pubKey] = true
+		if !v.inMaintenance[pubKey] {
+			log.WithFields(logrus.Fields{
+				"pubKey":     fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+				"startEpoch": window.StartEpoch,
+				"endEpoch":   window.EndEpoch,
+			}).Warn("Validator entered its maintenance window, skipping all of its duties")
+		}
+	}
+	for pubKey := range v.inMaintenance {
+		if !inMaintenance[pubKey] {
+			log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator left its maintenance window, resuming its duties")
+		}
+	}
+	v.inMaintenance = inMaintenance
+	return inMaintenance, nil
+}
@@ -201,6 +201,19 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorAttestationWaitHistogramVec used to track how long into the slot the validator waited before attesting,
+	// labeled by whether the wait ended on the arrival of the block of the slot or on the deadline.
+	ValidatorAttestationWaitHistogramVec = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "attestation_wait_seconds",
+			Help:      "Time into the slot the validator waited before attesting",
+			Buckets:   []float64{0.25, 0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4, 6, 8, 12},
+		},
+		[]string{
+			"trigger",
+		},
+	)
 	// ValidatorInactivityScoreGaugeVec used to track validator inactivity scores.
 	ValidatorInactivityScoreGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -386,6 +399,18 @@ func (v *validator) logForEachValidator(index int, pubKey []byte, resp *ethpb.Va
 			}
 		}
 	}
+	perf := &EpochPerformance{
+		Epoch:                prevEpoch,
+		CorrectlyVotedSource: correctlyVotedSource,
+		CorrectlyVotedTarget: correctlyVotedTarget,
+		CorrectlyVotedHead:   correctlyVotedHead,
+		BalanceBefore:        balBeforeEpoch,
+		BalanceAfter:         balAfterEpoch,
+	}
+	if slots.ToEpoch(slot) < params.BeaconConfig().AltairForkEpoch && index < len(resp.InclusionDistances) {
+		perf.InclusionDistance = resp.InclusionDistances[index]
+	}
+	v.performance.recordEpoch(pubKeyBytes, perf)
 	v.prevBalance[pubKeyBytes] = balBeforeEpoch
 }
 
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"sort"
+	"sync"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// performanceHistoryEpochs is the number of most recent epochs for which the performance of each key is kept.
+const performanceHistoryEpochs = 32
+
+// EpochPerformance is the performance of a validator key over an epoch, as reported by the beacon node after the
+// epoch transition.
+type EpochPerformance struct {
+	Epoch                types.Epoch
+	CorrectlyVotedSource bool
+	CorrectlyVotedTarget bool
+	CorrectlyVotedHead   bool
+	// InclusionDistance is only known before the Altair fork.
+	InclusionDistance types.Slot
+	BalanceBefore     uint64
+	BalanceAfter      uint64
+}
+
+// BalanceDelta returns the change of the balance of the validator over the epoch transition, in Gwei.
+func (e *EpochPerformance) BalanceDelta() int64 {
+	return int64(e.BalanceAfter) - int64(e.BalanceBefore)
+}
+
+// KeyPerformance is a report of the recent performance and of the upcoming duties of a validator key.
+type KeyPerformance struct {
+	PublicKey [fieldparams.BLSPubkeyLength]byte
+	// Epochs holds the performance of the key in the most recent epochs, oldest first.
+	Epochs                         []*EpochPerformance
+	SyncCommitteeMessagesSubmitted uint64
+	SyncCommitteeMessagesFailed    uint64
+	// NextAttestationSlot is nil if no upcoming attestation duty is known.
+	NextAttestationSlot *types.Slot
+	NextProposalSlots   []types.Slot
+	InSyncCommittee     bool
+}
+
+type keyPerformance struct {
+	epochs             []*EpochPerformance
+	syncMessagesSent   uint64
+	syncMessagesFailed uint64
+}
+
+// PerformanceTracker keeps track of the recent performance of the validating keys and of their duties, so that it
+// can be exposed to the web UI and to external dashboards through the validator client API.
+type PerformanceTracker struct {
+	lock   sync.RWMutex
+	keys   map[[fieldparams.BLSPubkeyLength]byte]*keyPerformance
+	duties *ethpb.DutiesResponse
+}
+
+// NewPerformanceTracker creates a tracker of the performance of the validating keys.
+func NewPerformanceTracker() *PerformanceTracker {
+	return &PerformanceTracker{
+		keys: make(map[[fieldparams.BLSPubkeyLength]byte]*keyPerformance),
+	}
+}
+
+func (p *PerformanceTracker) key(pubKey [fieldparams.BLSPubkeyLength]byte) *keyPerformance {
+	k, ok := p.keys[pubKey]
+	if !ok {
+		k = &keyPerformance{}
+		p.keys[pubKey] = k
+	}
+	return k
+}
+
+// recordEpoch records the performance of a key over an epoch. Only the most recent epochs are kept.
+func (p *PerformanceTracker) recordEpoch(pubKey [fieldparams.BLSPubkeyLength]byte, perf *EpochPerformance) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	k := p.key(pubKey)
+	if n := len(k.epochs); n > 0 && k.epochs[n-1].Epoch >= perf.Epoch {
+		// The performance of this epoch was already recorded.
+		return
+	}
+	k.epochs = append(k.epochs, perf)
+	if len(k.epochs) > performanceHistoryEpochs {
+		k.epochs = k.epochs[len(k.epochs)-performanceHistoryEpochs:]
+	}
+}
+
+// recordSyncCommitteeMessage records the outcome of the submission of a sync committee message of a key.
+func (p *PerformanceTracker) recordSyncCommitteeMessage(pubKey [fieldparams.BLSPubkeyLength]byte, submitted bool) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	k := p.key(pubKey)
+	if submitted {
+		k.syncMessagesSent++
+	} else {
+		k.syncMessagesFailed++
+	}
+}
+
+// setDuties records the latest duties of the validating keys.
+func (p *PerformanceTracker) setDuties(duties *ethpb.DutiesResponse) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.duties = duties
+}
+
+// Report returns the performance of the given keys, along with their duties from the given slot on. The keys
+// are sorted by public key. All the keys tracked are reported if no key is given.
+func (p *PerformanceTracker) Report(pubKeys [][fieldparams.BLSPubkeyLength]byte, slot types.Slot) []*KeyPerformance {
+	if p == nil {
+		return nil
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if len(pubKeys) == 0 {
+		seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+		for pubKey := range p.keys {
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+		if p.duties != nil {
+			for _, duty := range p.duties.CurrentEpochDuties {
+				pubKey := bytesutil.ToBytes48(duty.PublicKey)
+				if !seen[pubKey] {
+					seen[pubKey] = true
+					pubKeys = append(pubKeys, pubKey)
+				}
+			}
+		}
+	}
+
+	reports := make([]*KeyPerformance, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		report := &KeyPerformance{PublicKey: pubKey}
+		if k, ok := p.keys[pubKey]; ok {
+			report.Epochs = make([]*EpochPerformance, len(k.epochs))
+			for i, e := range k.epochs {
+				epoch := *e
+				report.Epochs[i] = &epoch
+			}
+			report.SyncCommitteeMessagesSubmitted = k.syncMessagesSent
+			report.SyncCommitteeMessagesFailed = k.syncMessagesFailed
+		}
+		p.setNextDuties(report, slot)
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return string(reports[i].PublicKey[:]) < string(reports[j].PublicKey[:])
+	})
+	return reports
+}
+
+// setNextDuties fills in the duties of the key of a report from the given slot on, looking at the duties of the
+// current epoch before the duties of the next epoch.
+func (p *PerformanceTracker) setNextDuties(report *KeyPerformance, slot types.Slot) {
+	if p.duties == nil {
+		return
+	}
+	for i, duties := range [][]*ethpb.DutiesResponse_Duty{p.duties.CurrentEpochDuties, p.duties.NextEpochDuties} {
+		for _, duty := range duties {
+			if bytesutil.ToBytes48(duty.PublicKey) != report.PublicKey {
+				continue
+			}
+			if i == 0 {
+				report.InSyncCommittee = duty.IsSyncCommittee
+			}
+			if report.NextAttestationSlot == nil && duty.AttesterSlot >= slot {
+				attesterSlot := duty.AttesterSlot
+				report.NextAttestationSlot = &attesterSlot
+			}
+			for _, proposerSlot := range duty.ProposerSlots {
+				if proposerSlot >= slot {
+					report.NextProposalSlots = append(report.NextProposalSlots, proposerSlot)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestPerformanceTracker_RecordEpoch(t *testing.T) {
+	p := NewPerformanceTracker()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	for i := types.Epoch(0); i < performanceHistoryEpochs+5; i++ {
+		p.recordEpoch(pubKey, &EpochPerformance{Epoch: i, BalanceBefore: 32, BalanceAfter: 33})
+	}
+	// Recording an epoch twice is a no-op.
+	p.recordEpoch(pubKey, &EpochPerformance{Epoch: 10})
+
+	reports := p.Report(nil, 0)
+	require.Equal(t, 1, len(reports))
+	require.Equal(t, performanceHistoryEpochs, len(reports[0].Epochs))
+	assert.Equal(t, types.Epoch(5), reports[0].Epochs[0].Epoch)
+	assert.Equal(t, types.Epoch(performanceHistoryEpochs+4), reports[0].Epochs[performanceHistoryEpochs-1].Epoch)
+	assert.Equal(t, int64(1), reports[0].Epochs[0].BalanceDelta())
+
+	// The report is a copy of the tracked performance.
+	reports[0].Epochs[0].Epoch = 100
+	assert.Equal(t, types.Epoch(5), p.Report(nil, 0)[0].Epochs[0].Epoch)
+}
+
+func TestPerformanceTracker_SyncCommitteeMessages(t *testing.T) {
+	p := NewPerformanceTracker()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	p.recordSyncCommitteeMessage(pubKey, true)
+	p.recordSyncCommitteeMessage(pubKey, true)
+	p.recordSyncCommitteeMessage(pubKey, false)
+
+	reports := p.Report([][fieldparams.BLSPubkeyLength]byte{pubKey}, 0)
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, uint64(2), reports[0].SyncCommitteeMessagesSubmitted)
+	assert.Equal(t, uint64(1), reports[0].SyncCommitteeMessagesFailed)
+}
+
+func TestPerformanceTracker_NextDuties(t *testing.T) {
+	p := NewPerformanceTracker()
+	pubKey1 := [fieldparams.BLSPubkeyLength]byte{1}
+	pubKey2 := [fieldparams.BLSPubkeyLength]byte{2}
+	p.setDuties(&ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey2[:], AttesterSlot: 3, ProposerSlots: []types.Slot{2, 7}, IsSyncCommittee: true},
+			{PublicKey: pubKey1[:], AttesterSlot: 10},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: pubKey2[:], AttesterSlot: 40, ProposerSlots: []types.Slot{35}},
+			{PublicKey: pubKey1[:], AttesterSlot: 33},
+		},
+	})
+
+	reports := p.Report(nil, 5)
+	require.Equal(t, 2, len(reports))
+
+	assert.Equal(t, pubKey1, reports[0].PublicKey)
+	require.NotNil(t, reports[0].NextAttestationSlot)
+	assert.Equal(t, types.Slot(10), *reports[0].NextAttestationSlot)
+	assert.Equal(t, 0, len(reports[0].NextProposalSlots))
+	assert.Equal(t, false, reports[0].InSyncCommittee)
+
+	assert.Equal(t, pubKey2, reports[1].PublicKey)
+	require.NotNil(t, reports[1].NextAttestationSlot)
+	assert.Equal(t, types.Slot(40), *reports[1].NextAttestationSlot)
+	assert.DeepEqual(t, []types.Slot{7, 35}, reports[1].NextProposalSlots)
+	assert.Equal(t, true, reports[1].InSyncCommittee)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -95,7 +96,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		return
 	}
 
-	sig, signingRoot, err := v.signBlock(ctx, pubKey, epoch, slot, wb)
+	sig, signingRoot, recordSignature, err := v.signBlock(ctx, pubKey, epoch, slot, wb)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
@@ -106,11 +107,14 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 
 	blk, err := wrapper.BuildSignedBeaconBlock(wb, sig)
 	if err != nil {
+		recordSignature(nil)
 		log.WithError(err).Error("Failed to build signed beacon block")
 		return
 	}
 
-	if err := v.slashableProposalCheck(ctx, pubKey, blk, signingRoot); err != nil {
+	err = v.slashableProposalCheck(ctx, pubKey, blk, signingRoot)
+	recordSignature(err)
+	if err != nil {
 		log.WithFields(
 			blockLogFields(pubKey, wb, nil),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -164,6 +168,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 		if p.GasLimit() != 0 {
 			log = log.WithField("gasUtilized", float64(p.GasUsed())/float64(p.GasLimit()))
 		}
+		// Report the gas limit of the proposed payload against the gas limit preferred in the proposer settings.
+		log = log.WithField("gasLimit", p.GasLimit())
+		if gasLimit, ok := v.preferredGasLimit(pubKey); ok {
+			log = log.WithField("preferredGasLimit", gasLimit)
+		}
 	}
 
 	blkRoot := fmt.Sprintf("%#x", bytesutil.Trunc(blkResp.BlockRoot))
@@ -181,6 +190,24 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [f
 	}
 }
 
+// preferredGasLimit returns the gas limit set in the builder settings of the proposer settings for the validator, which
+// is the gas limit registered with the builder. The settings of the validator take precedence over the default settings.
+func (v *validator) preferredGasLimit(pubKey [fieldparams.BLSPubkeyLength]byte) (uint64, bool) {
+	if v.ProposerSettings == nil {
+		return 0, false
+	}
+	option := v.ProposerSettings.DefaultConfig
+	if v.ProposerSettings.ProposeConfig != nil {
+		if o, ok := v.ProposerSettings.ProposeConfig[pubKey]; ok && o != nil {
+			option = o
+		}
+	}
+	if option == nil || option.BuilderConfig == nil || option.BuilderConfig.GasLimit == 0 {
+		return 0, false
+	}
+	return option.BuilderConfig.GasLimit, true
+}
+
 // ProposeExit performs a voluntary exit on a validator.
 // The exit is signed by the validator before being sent to the beacon node for broadcasting.
 func ProposeExit(
@@ -239,7 +266,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 	if err != nil {
 		return nil, err
 	}
-	randaoReveal, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	randaoReveal, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -253,31 +280,42 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 }
 
 // Sign block with proposer domain and private key.
-// Returns the signature, block signing root, and any error.
-func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot, b interfaces.BeaconBlock) ([]byte, [32]byte, error) {
+// Returns the signature, block signing root, the recorder of the signature in the signing audit log, and any error.
+func (v *validator) signBlock(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot, b interfaces.BeaconBlock,
+) ([]byte, [32]byte, signatureRecorder, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainBeaconProposer[:])
 	if err != nil {
-		return nil, [32]byte{}, errors.Wrap(err, domainDataErr)
+		return nil, [32]byte{}, nil, errors.Wrap(err, domainDataErr)
 	}
 	if domain == nil {
-		return nil, [32]byte{}, errors.New(domainDataErr)
+		return nil, [32]byte{}, nil, errors.New(domainDataErr)
 	}
 
 	blockRoot, err := signing.ComputeSigningRoot(b, domain.SignatureDomain)
 	if err != nil {
-		return nil, [32]byte{}, errors.Wrap(err, signingRootErr)
+		return nil, [32]byte{}, nil, errors.Wrap(err, signingRootErr)
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	req := &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     blockRoot[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          b.AsSignRequestObject(),
 		SigningSlot:     slot,
-	})
+	}
+	if err := v.proposalIntentCheck(ctx, pubKey, b.Slot(), blockRoot); err != nil {
+		v.signingAudit.RecordRefused(req, err)
+		return nil, [32]byte{}, nil, err
+	}
+	if err := v.remoteProposalCheck(ctx, pubKey, b.Slot(), blockRoot); err != nil {
+		v.signingAudit.RecordRefused(req, err)
+		return nil, [32]byte{}, nil, err
+	}
+	sig, record, err := v.signUnrecorded(ctx, req)
 	if err != nil {
-		return nil, [32]byte{}, errors.Wrap(err, "could not sign block proposal")
+		return nil, [32]byte{}, nil, errors.Wrap(err, "could not sign block proposal")
 	}
-	return sig.Marshal(), blockRoot, nil
+	return sig.Marshal(), blockRoot, record, nil
 }
 
 // Sign voluntary exit with proposer domain and private key.
@@ -318,27 +356,34 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
+// Gets the graffiti from file or cli for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
-	if len(v.graffiti) != 0 {
-		return v.graffiti, nil
-	}
+	v.graffitiLock.Lock()
+	defer v.graffitiLock.Unlock()
 
 	if v.graffitiStruct == nil {
+		if len(v.graffiti) != 0 {
+			return v.graffiti, nil
+		}
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
-	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
-	if err != nil {
-		return []byte{}, err
-	}
-	g, ok := v.graffitiStruct.Specific[idx.Index]
-	if ok {
+	// When specified, graffiti of the validator public key in the file takes the first priority.
+	if g, ok := v.graffitiStruct.ForPublicKey(pubKey); ok {
 		return []byte(g), nil
 	}
 
+	// When specified, graffiti of the validator index in the file takes the second priority.
+	if len(v.graffitiStruct.Specific) != 0 {
+		idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+		if err != nil {
+			return []byte{}, err
+		}
+		if g, ok := v.graffitiStruct.Specific[idx.Index]; ok {
+			return []byte(g), nil
+		}
+	}
+
 	// When specified, a graffiti from the ordered list in the file take third priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
@@ -358,10 +403,32 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(v.graffitiStruct.Random[i]), nil
 	}
 
-	// Finally, default graffiti if specified in the file will be used.
+	// Default graffiti if specified in the file will be used.
 	if v.graffitiStruct.Default != "" {
 		return []byte(v.graffitiStruct.Default), nil
 	}
 
+	// Finally, default graffiti from the command line is used as a fallback.
+	if len(v.graffiti) != 0 {
+		return v.graffiti, nil
+	}
+
 	return []byte{}, nil
 }
+
+// Replaces the graffiti of the validator with the contents of a reloaded graffiti file.
+// The rotation through the ordered graffiti restarts when the contents of the file changed.
+func (v *validator) setGraffitiStruct(ctx context.Context, g *graffiti.Graffiti) {
+	v.graffitiLock.Lock()
+	defer v.graffitiLock.Unlock()
+	if v.graffitiStruct != nil && v.graffitiStruct.Hash == g.Hash {
+		return
+	}
+	orderedIndex, err := v.db.GraffitiOrderedIndex(ctx, g.Hash)
+	if err != nil {
+		log.WithError(err).Error("Could not read graffiti ordered index from disk")
+		return
+	}
+	v.graffitiStruct = g
+	v.graffitiOrderedIndex = orderedIndex
+}
@@ -9,6 +9,8 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/sirupsen/logrus"
 )
 
@@ -58,8 +60,17 @@ func (v *validator) slashableProposalCheck(
 		)
 	}
 
+	if v.standby != nil {
+		if err := v.standby.CheckProposal(pubKey, blk.Slot()); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return err
+		}
+	}
+
 	if features.Get().RemoteSlasherProtection {
-		blockHdr, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(signedBlock)
+		blockHdr, err := signedBlock.Header()
 		if err != nil {
 			return errors.Wrap(err, "failed to get block header from block")
 		}
@@ -83,6 +94,53 @@ func (v *validator) slashableProposalCheck(
 	return nil
 }
 
+// remoteProposalCheck asks the remote slashing protection service, when configured, whether the block may be
+// signed. The block is refused if the service could not be reached.
+func (v *validator) remoteProposalCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	if v.remoteProtection == nil {
+		return nil
+	}
+	if err := v.remoteProtection.CheckProposal(ctx, pubKey, slot, signingRoot); err != nil {
+		if v.emitAccountMetrics {
+			ValidatorProposeFailVecSlasher.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Inc()
+		}
+		return err
+	}
+	return nil
+}
+
+// proposalIntentCheck records the intent to sign the block with the given signing root before it is signed,
+// as the proposal history is only updated once the block is signed. It rejects the block if the validator
+// already intended to sign a different block at the same slot, which it may have signed before the validator
+// client restarted without recording it in the proposal history or broadcasting it.
+func (v *validator) proposalIntentCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	intent, err := v.db.ProposalIntent(ctx, pubKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to get proposal intent")
+	}
+	if intent != nil && intent.Slot == slot {
+		if intent.SigningRoot != signingRoot {
+			return errors.New(failedBlockSignLocalErr)
+		}
+		return nil
+	}
+	// Only the intent of the highest slot is kept, proposals at lower slots are covered by the proposal history.
+	if intent != nil && intent.Slot > slot {
+		return nil
+	}
+	if err := v.db.SaveProposalIntent(ctx, pubKey, &kv.ProposalIntent{
+		Slot:        slot,
+		SigningRoot: signingRoot,
+	}); err != nil {
+		return errors.Wrap(err, "failed to save proposal intent")
+	}
+	return nil
+}
+
 func blockLogFields(pubKey [fieldparams.BLSPubkeyLength]byte, blk interfaces.BeaconBlock, sig []byte) logrus.Fields {
 	fields := logrus.Fields{
 		"proposerPublicKey": fmt.Sprintf("%#x", pubKey),
@@ -2,7 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/config/features"
@@ -14,6 +18,8 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	remoteprotection "github.com/prysmaticlabs/prysm/validator/remote-protection"
 )
 
 func Test_slashableProposalCheck_PreventsLowerThanMinProposal(t *testing.T) {
@@ -189,3 +195,55 @@ func Test_slashableProposalCheck_RemoteProtection(t *testing.T) {
 	err = validator.slashableProposalCheck(context.Background(), pubKey, sBlock, [32]byte{2})
 	require.NoError(t, err, "Expected allowed block not to throw error")
 }
+
+func Test_proposalIntentCheck(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	require.NoError(t, validator.proposalIntentCheck(ctx, pubKey, 10, [32]byte{1}))
+	intent, err := validator.db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, &kv.ProposalIntent{Slot: 10, SigningRoot: [32]byte{1}}, intent)
+
+	// The same block can be signed again, a different block at the same slot cannot.
+	require.NoError(t, validator.proposalIntentCheck(ctx, pubKey, 10, [32]byte{1}))
+	require.ErrorContains(t, failedBlockSignLocalErr, validator.proposalIntentCheck(ctx, pubKey, 10, [32]byte{2}))
+
+	// Intents at lower slots are left to the proposal history and do not replace the highest intent.
+	require.NoError(t, validator.proposalIntentCheck(ctx, pubKey, 9, [32]byte{3}))
+	intent, err = validator.db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(10), intent.Slot)
+
+	require.NoError(t, validator.proposalIntentCheck(ctx, pubKey, 11, [32]byte{4}))
+	intent, err = validator.db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, &kv.ProposalIntent{Slot: 11, SigningRoot: [32]byte{4}}, intent)
+}
+
+func Test_remoteProposalCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &remoteprotection.ProposalRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.NoError(t, json.NewEncoder(w).Encode(&remoteprotection.Response{Allowed: req.Slot != 5}))
+	}))
+	c, err := remoteprotection.New(srv.URL, time.Second)
+	require.NoError(t, err)
+	v := &validator{remoteProtection: c}
+	ctx := context.Background()
+
+	require.NoError(t, v.remoteProposalCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, 4, [32]byte{}))
+	err = v.remoteProposalCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, 5, [32]byte{})
+	require.ErrorContains(t, "rejected by remote slashing protection", err)
+
+	// Blocks are refused when the service cannot be reached.
+	srv.Close()
+	err = v.remoteProposalCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, 4, [32]byte{})
+	require.ErrorContains(t, "refusing to sign", err)
+
+	// Nothing is checked when no service is configured.
+	require.NoError(t, (&validator{}).remoteProposalCheck(ctx, [fieldparams.BLSPubkeyLength]byte{1}, 5, [32]byte{}))
+}
@@ -4,15 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
-	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	validatorserviceconfig "github.com/prysmaticlabs/prysm/config/validator/service"
 	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/mock"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -426,6 +428,66 @@ func TestProposeBlock_BlocksDoubleProposal_After54KEpochs(t *testing.T) {
 	require.LogsContain(t, hook, failedBlockSignLocalErr)
 }
 
+func TestProposeBlock_DoesNotSignDifferentBlockAfterRestart(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	slot := params.BeaconConfig().SlotsPerEpoch.Mul(5).Add(2)
+	proposerDomain := make([]byte, 32)
+
+	signedBlock := util.NewBeaconBlock()
+	signedBlock.Block.Slot = slot
+	otherBlock := util.NewBeaconBlock()
+	otherBlock.Block.Slot = slot
+	otherBlock.Block.Body.Graffiti = bytesutil.PadTo([]byte("someothergraffiti"), 32)
+
+	// The validator client crashed after signing the block, before recording it in the proposal history.
+	wb, err := wrapper.WrappedBeaconBlock(signedBlock.Block)
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(wb, proposerDomain)
+	require.NoError(t, err)
+	require.NoError(t, validator.db.SaveProposalIntent(context.Background(), pubKey, &kv.ProposalIntent{
+		Slot:        slot,
+		SigningRoot: signingRoot,
+	}))
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(4).Return(&ethpb.DomainResponse{SignatureDomain: proposerDomain}, nil /*err*/)
+	m.validatorClient.EXPECT().GetBeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.BlockRequest{}),
+	).Return(&ethpb.GenericBeaconBlock{
+		Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: otherBlock.Block},
+	}, nil /*err*/)
+	m.validatorClient.EXPECT().GetBeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.BlockRequest{}),
+	).Return(&ethpb.GenericBeaconBlock{
+		Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: signedBlock.Block},
+	}, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeBeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.GenericSignedBeaconBlock{}),
+	).Times(1).Return(&ethpb.ProposeResponse{BlockRoot: make([]byte, 32)}, nil /*error*/)
+
+	// A different block for the slot is not signed after the restart.
+	validator.ProposeBlock(context.Background(), slot, pubKey)
+	require.LogsContain(t, hook, failedBlockSignLocalErr)
+	_, exists, err := validator.db.ProposalHistoryForSlot(context.Background(), pubKey, slot)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	// The block signed before the restart is still proposed.
+	hook.Reset()
+	validator.ProposeBlock(context.Background(), slot, pubKey)
+	require.LogsDoNotContain(t, hook, failedBlockSignLocalErr)
+	require.LogsContain(t, hook, "Submitted new block")
+}
+
 func TestProposeBlock_AllowsPastProposals(t *testing.T) {
 	slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().WeakSubjectivityPeriod + 9))
 
@@ -597,6 +659,62 @@ func testProposeBlock(t *testing.T, graffiti []byte) {
 	}
 }
 
+func TestValidator_preferredGasLimit(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	tests := []struct {
+		name     string
+		settings *validatorserviceconfig.ProposerSettings
+		gasLimit uint64
+		ok       bool
+	}{
+		{
+			name: "no proposer settings",
+		},
+		{
+			name: "default config",
+			settings: &validatorserviceconfig.ProposerSettings{
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 40000000},
+				},
+			},
+			gasLimit: 40000000,
+			ok:       true,
+		},
+		{
+			name: "propose config overrides default config",
+			settings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+					pubKey: {BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 35000000}},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 40000000},
+				},
+			},
+			gasLimit: 35000000,
+			ok:       true,
+		},
+		{
+			name: "propose config without builder config",
+			settings: &validatorserviceconfig.ProposerSettings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorserviceconfig.ProposerOption{
+					pubKey: {},
+				},
+				DefaultConfig: &validatorserviceconfig.ProposerOption{
+					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: 40000000},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{ProposerSettings: tt.settings}
+			gasLimit, ok := v.preferredGasLimit(pubKey)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.gasLimit, gasLimit)
+		})
+	}
+}
+
 func TestProposeExit_ValidatorIndexFailed(t *testing.T) {
 	_, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -805,7 +923,7 @@ func TestSignBlock(t *testing.T) {
 	validator.keyManager = km
 	b, err := wrapper.WrappedBeaconBlock(blk.Block)
 	require.NoError(t, err)
-	sig, blockRoot, err := validator.signBlock(ctx, pubKey, 0, 0, b)
+	sig, blockRoot, _, err := validator.signBlock(ctx, pubKey, 0, 0, b)
 	require.NoError(t, err, "%x,%v", sig, err)
 	require.Equal(t, "a049e1dc723e5a8b5bd14f292973572dffd53785ddb337"+
 		"82f20bf762cbe10ee7b9b4f5ae1ad6ff2089d352403750bed402b94b58469c072536"+
@@ -846,7 +964,7 @@ func TestSignAltairBlock(t *testing.T) {
 	validator.keyManager = km
 	wb, err := wrapper.WrappedBeaconBlock(blk.Block)
 	require.NoError(t, err)
-	sig, blockRoot, err := validator.signBlock(ctx, pubKey, 0, 0, wb)
+	sig, blockRoot, _, err := validator.signBlock(ctx, pubKey, 0, 0, wb)
 	require.NoError(t, err, "%x,%v", sig, err)
 	// Verify the returned block root matches the expected root using the proposer signature
 	// domain.
@@ -882,7 +1000,7 @@ func TestSignBellatrixBlock(t *testing.T) {
 	validator.keyManager = km
 	wb, err := wrapper.WrappedBeaconBlock(blk.Block)
 	require.NoError(t, err)
-	sig, blockRoot, err := validator.signBlock(ctx, pubKey, 0, 0, wb)
+	sig, blockRoot, _, err := validator.signBlock(ctx, pubKey, 0, 0, wb)
 	require.NoError(t, err, "%x,%v", sig, err)
 	// Verify the returned block root matches the expected root using the proposer signature
 	// domain.
@@ -904,9 +1022,10 @@ func TestGetGraffiti_Ok(t *testing.T) {
 		v    *validator
 		want []byte
 	}{
-		{name: "use default cli graffiti",
+		{name: "use file graffiti over cli graffiti",
 			v: &validator{
-				graffiti: []byte{'b'},
+				validatorClient: m.validatorClient,
+				graffiti:        []byte{'b'},
 				graffitiStruct: &graffiti.Graffiti{
 					Default: "c",
 					Random:  []string{"d", "e"},
@@ -916,6 +1035,14 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					},
 				},
 			},
+			want: []byte{'g'},
+		},
+		{name: "use default cli graffiti, none specified in file",
+			v: &validator{
+				validatorClient: m.validatorClient,
+				graffiti:        []byte{'b'},
+				graffitiStruct:  &graffiti.Graffiti{},
+			},
 			want: []byte{'b'},
 		},
 		{name: "use default file graffiti",
@@ -951,6 +1078,21 @@ func TestGetGraffiti_Ok(t *testing.T) {
 			},
 			want: []byte{'g'},
 		},
+		{name: "use validator file graffiti, has public key",
+			v: &validator{
+				validatorClient: m.validatorClient,
+				graffitiStruct: &graffiti.Graffiti{
+					Default: "c",
+					Specific: map[types.ValidatorIndex]string{
+						2: "g",
+					},
+					SpecificPublicKeys: map[string]string{
+						hexutil.Encode(pubKey[:]): "h",
+					},
+				},
+			},
+			want: []byte{'h'},
+		},
 		{name: "use validator file graffiti, none specified",
 			v: &validator{
 				validatorClient: m.validatorClient,
@@ -962,7 +1104,7 @@ func TestGetGraffiti_Ok(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if !strings.Contains(tt.name, "use default cli graffiti") {
+			if _, ok := tt.v.graffitiStruct.ForPublicKey(pubKey); !ok && len(tt.v.graffitiStruct.Specific) != 0 {
 				m.validatorClient.EXPECT().
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
@@ -981,11 +1123,6 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	m := &mocks{
 		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
 	}
-	m.validatorClient.EXPECT().
-		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
-		Times(5).
-		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
-
 	v := &validator{
 		db:              valDB,
 		validatorClient: m.validatorClient,
@@ -1000,3 +1137,37 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		require.DeepEqual(t, want, got)
 	}
 }
+
+func TestSetGraffitiStruct(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	ctx := context.Background()
+	g := &graffiti.Graffiti{
+		Hash:    [32]byte{1},
+		Ordered: []string{"a", "b", "c"},
+	}
+	_, err := valDB.GraffitiOrderedIndex(ctx, g.Hash)
+	require.NoError(t, err)
+	v := &validator{
+		db:             valDB,
+		graffitiStruct: g,
+	}
+	got, err := v.getGraffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'a'}, got)
+
+	// Reloading an unchanged file keeps the rotation going.
+	v.setGraffitiStruct(ctx, &graffiti.Graffiti{Hash: g.Hash, Ordered: g.Ordered})
+	got, err = v.getGraffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'b'}, got)
+
+	// A changed file restarts the rotation.
+	v.setGraffitiStruct(ctx, &graffiti.Graffiti{
+		Hash:    [32]byte{2},
+		Ordered: []string{"d", "e"},
+	})
+	got, err = v.getGraffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'d'}, got)
+}
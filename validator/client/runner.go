@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/config/params"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
@@ -44,6 +45,8 @@ func run(ctx context.Context, v iface.Validator) {
 
 	connectionErrorChannel := make(chan error, 1)
 	go v.ReceiveBlocks(ctx, connectionErrorChannel)
+	proposerDutiesChan := make(chan *ethpb.ProposerDutiesResponse, 1)
+	go v.ReceiveProposerDuties(ctx, proposerDutiesChan)
 	if err := v.UpdateDuties(ctx, headSlot); err != nil {
 		handleAssignmentError(err, headSlot)
 	}
@@ -76,6 +79,8 @@ func run(ctx context.Context, v iface.Validator) {
 				go v.ReceiveBlocks(ctx, connectionErrorChannel)
 				continue
 			}
+		case res := <-proposerDutiesChan:
+			v.UpdateProposerDuties(res)
 		case newKeys := <-accountsChangedChan:
 			anyActive, err := v.HandleKeyReload(ctx, newKeys)
 			if err != nil {
@@ -121,6 +126,7 @@ func run(ctx context.Context, v iface.Validator) {
 						log.Warnf("Failed to update proposer settings: %v", err)
 					}
 				}()
+				go v.PruneSlashingProtection(ctx)
 			}
 
 			// Start fetching domain data for the next epoch.
@@ -25,8 +25,12 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	remoteprotection "github.com/prysmaticlabs/prysm/validator/remote-protection"
+	signingaudit "github.com/prysmaticlabs/prysm/validator/signing-audit"
+	"github.com/prysmaticlabs/prysm/validator/standby"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -61,6 +65,7 @@ type ValidatorService struct {
 	walletInitializedFeed *event.Feed
 	wallet                *wallet.Wallet
 	graffitiStruct        *graffiti.Graffiti
+	graffitiFile          string
 	dataDir               string
 	withCert              string
 	endpoint              string
@@ -70,7 +75,13 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
+	DVTConfig             *dvt.SetupConfig
 	ProposerSettings      *validatorserviceconfig.ProposerSettings
+	pruningMargin         types.Epoch
+	standby               *standby.Monitor
+	signingAudit          *signingaudit.Log
+	remoteProtection      *remoteprotection.Client
+	performance           *PerformanceTracker
 }
 
 // Config for the validator service.
@@ -86,6 +97,7 @@ type Config struct {
 	GrpcMaxCallRecvMsgSizeFlag int
 	GrpcRetryDelay             time.Duration
 	GraffitiStruct             *graffiti.Graffiti
+	GraffitiFile               string
 	Validator                  iface.Validator
 	ValDB                      db.Database
 	CertFlag                   string
@@ -94,7 +106,12 @@ type Config struct {
 	GraffitiFlag               string
 	Endpoint                   string
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
+	DVTConfig                  *dvt.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
+	PruningMargin              types.Epoch
+	Standby                    *standby.Monitor
+	SigningAudit               *signingaudit.Log
+	RemoteProtection           *remoteprotection.Client
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -121,9 +138,16 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
+		graffitiFile:          cfg.GraffitiFile,
 		logDutyCountDown:      cfg.LogDutyCountDown,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
+		DVTConfig:             cfg.DVTConfig,
 		ProposerSettings:      cfg.ProposerSettings,
+		pruningMargin:         cfg.PruningMargin,
+		standby:               cfg.Standby,
+		signingAudit:          cfg.SigningAudit,
+		remoteProtection:      cfg.RemoteProtection,
+		performance:           NewPerformanceTracker(),
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -208,8 +232,14 @@ func (v *ValidatorService) Start() {
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
 		Web3SignerConfig:               v.Web3SignerConfig,
+		DVTConfig:                      v.DVTConfig,
 		ProposerSettings:               v.ProposerSettings,
 		walletIntializedChannel:        make(chan *wallet.Wallet, 1),
+		pruningMargin:                  v.pruningMargin,
+		standby:                        v.standby,
+		signingAudit:                   v.signingAudit,
+		remoteProtection:               v.remoteProtection,
+		performance:                    v.performance,
 	}
 	// To resolve a race condition at startup due to the interface
 	// nature of the abstracted block type. We initialize
@@ -222,6 +252,11 @@ func (v *ValidatorService) Start() {
 	close(tempChan)
 
 	v.validator = valStruct
+	if v.graffitiFile != "" {
+		go graffiti.WatchGraffitiFile(v.ctx, v.graffitiFile, func(g *graffiti.Graffiti) {
+			valStruct.setGraffitiStruct(v.ctx, g)
+		})
+	}
 	go run(v.ctx, v.validator)
 }
 
@@ -252,6 +287,12 @@ func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
 
+// PerformanceReport returns the recent performance of the given validating keys, along with their duties from
+// the given slot on. All the keys tracked are reported if no key is given.
+func (v *ValidatorService) PerformanceReport(pubKeys [][fieldparams.BLSPubkeyLength]byte, slot types.Slot) []*KeyPerformance {
+	return v.performance.Report(pubKeys, slot)
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
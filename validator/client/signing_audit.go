@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+)
+
+// signatureRecorder records the outcome of a signature in the signing audit log, once the slashing protection
+// checks run after signing are done. It is called with the error of these checks, which refuse the signature.
+type signatureRecorder func(refusal error)
+
+// sign signs the request with the keymanager of the validator. Every sign request goes through it, or
+// through auditedSigner or signUnrecorded, so that it is recorded in the signing audit log when it is enabled.
+func (v *validator) sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return v.auditedSigner(v.keyManager.Sign)(ctx, req)
+}
+
+// auditedSigner wraps a signing function to record its requests and their outcome in the signing audit log.
+func (v *validator) auditedSigner(sign iface.SigningFunc) iface.SigningFunc {
+	return func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		sig, err := sign(ctx, req)
+		v.signingAudit.Record(req, err)
+		return sig, err
+	}
+}
+
+// signUnrecorded signs the request with the keymanager of the validator, leaving the record of a successful
+// signature to the returned recorder. It is used for blocks and attestations, which are checked against the
+// slashing protection history after signing, so that signatures refused by the check are recorded as refused.
+func (v *validator) signUnrecorded(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, signatureRecorder, error) {
+	sig, err := v.keyManager.Sign(ctx, req)
+	if err != nil {
+		v.signingAudit.Record(req, err)
+		return nil, nil, err
+	}
+	return sig, func(refusal error) {
+		if refusal != nil {
+			v.signingAudit.RecordRefused(req, refusal)
+			return
+		}
+		v.signingAudit.Record(req, nil)
+	}, nil
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	signingaudit "github.com/prysmaticlabs/prysm/validator/signing-audit"
+)
+
+func TestAuditedSigner_RecordsSignRequests(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	auditLog, err := signingaudit.New(dir, 1<<20, 0)
+	require.NoError(t, err)
+	v := &validator{signingAudit: auditLog}
+
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	signed := v.auditedSigner(func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		return key.Sign(req.SigningRoot), nil
+	})
+	failed := v.auditedSigner(func(_ context.Context, _ *validatorpb.SignRequest) (bls.Signature, error) {
+		return nil, errors.New("signer unavailable")
+	})
+	req := &validatorpb.SignRequest{
+		PublicKey:   key.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		SigningSlot: 5,
+		Object:      &validatorpb.SignRequest_Slot{Slot: 5},
+	}
+	_, err = signed(context.Background(), req)
+	require.NoError(t, err)
+	_, err = failed(context.Background(), req)
+	require.ErrorContains(t, "signer unavailable", err)
+	require.NoError(t, auditLog.Stop())
+
+	buf := &bytes.Buffer{}
+	count, err := signingaudit.Export(dir, buf)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, true, strings.Contains(lines[0], `"outcome":"signed"`))
+	assert.Equal(t, true, strings.Contains(lines[1], `"outcome":"failed"`))
+}
+
+func TestSignUnrecorded_RecordsRefusedSignatures(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	auditLog, err := signingaudit.New(dir, 1<<20, 0)
+	require.NoError(t, err)
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], key.PublicKey().Marshal())
+	v := &validator{
+		signingAudit: auditLog,
+		keyManager:   &mockKeymanager{keysMap: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{pubKey: key}},
+	}
+	req := &validatorpb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: make([]byte, 32),
+		SigningSlot: 5,
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: 5}},
+	}
+
+	// Nothing is recorded until the outcome of the slashing protection check is known.
+	_, record, err := v.signUnrecorded(context.Background(), req)
+	require.NoError(t, err)
+	record(errors.New("slashable attestation"))
+	_, record, err = v.signUnrecorded(context.Background(), req)
+	require.NoError(t, err)
+	record(nil)
+	_, _, err = v.signUnrecorded(context.Background(), &validatorpb.SignRequest{
+		PublicKey: []byte{1},
+		Object:    &validatorpb.SignRequest_Slot{Slot: 5},
+	})
+	require.ErrorContains(t, "not found", err)
+	require.NoError(t, auditLog.Stop())
+
+	buf := &bytes.Buffer{}
+	count, err := signingaudit.Export(dir, buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, true, strings.Contains(lines[0], `"outcome":"refused"`))
+	assert.Equal(t, true, strings.Contains(lines[0], `"error":"slashable attestation"`))
+	assert.Equal(t, true, strings.Contains(lines[1], `"outcome":"signed"`))
+	assert.Equal(t, true, strings.Contains(lines[2], `"outcome":"failed"`))
+}
+
+func TestSubmitAttestation_RecordsSlashableAttestationAsRefused(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	dir := filepath.Join(t.TempDir(), "audit")
+	auditLog, err := signingaudit.New(dir, 1<<20, 0)
+	require.NoError(t, err)
+	validator.signingAudit = auditLog
+	validatorIndex := types.ValidatorIndex(7)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			CommitteeIndex: 5,
+			Committee:      []types.ValidatorIndex{0, validatorIndex},
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	// Two attestations with the same source and target but different head block roots, a double vote.
+	for _, root := range []string{"A", "D"} {
+		blockRoot := bytesutil.ToBytes32([]byte(root))
+		m.validatorClient.EXPECT().GetAttestationData(
+			gomock.Any(), // ctx
+			gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+		).Return(&ethpb.AttestationData{
+			BeaconBlockRoot: blockRoot[:],
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32), Epoch: 4},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32), Epoch: 3},
+		}, nil)
+	}
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(4).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Return(&ethpb.AttestResponse{AttestationDataRoot: make([]byte, 32)}, nil /* error */)
+
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	require.NoError(t, auditLog.Stop())
+
+	buf := &bytes.Buffer{}
+	count, err := signingaudit.Export(dir, buf)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, true, strings.Contains(lines[0], `"outcome":"signed"`))
+	assert.Equal(t, true, strings.Contains(lines[1], `"outcome":"refused"`))
+}
+
+func TestAuditedSigner_DisabledAuditLog(t *testing.T) {
+	v := &validator{}
+	sign := v.auditedSigner(func(_ context.Context, _ *validatorpb.SignRequest) (bls.Signature, error) {
+		return nil, nil
+	})
+	_, err := sign(context.Background(), &validatorpb.SignRequest{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PruneSlashingProtection prunes the slashing protection history of the validator keys older than
+// the finalized epoch minus the pruning margin, when the pruning of slashing protection history is
+// enabled. The history is only pruned when the finalized epoch advanced since the previous pruning.
+func (v *validator) PruneSlashingProtection(ctx context.Context) {
+	if !features.Get().EnableSlashingProtectionPruning {
+		return
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.PruneSlashingProtection")
+	defer span.End()
+
+	// Pruning the history of many keys may take longer than an epoch, in which case the next
+	// pruning waits for the current one.
+	v.pruningLock.Lock()
+	defer v.pruningLock.Unlock()
+
+	head, err := v.beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Could not get chain head to prune slashing protection history")
+		return
+	}
+	if head.FinalizedEpoch <= v.pruningMargin {
+		return
+	}
+	cutoff := head.FinalizedEpoch - v.pruningMargin
+	if cutoff <= v.prunedEpoch {
+		return
+	}
+	pruned, err := v.db.PruneSlashingProtection(ctx, cutoff)
+	if err != nil {
+		log.WithError(err).Error("Could not prune slashing protection history")
+		return
+	}
+	v.prunedEpoch = cutoff
+	log.WithFields(logrus.Fields{
+		"finalizedEpoch": head.FinalizedEpoch,
+		"cutoffEpoch":    cutoff,
+		"prunedRecords":  pruned,
+	}).Debug("Pruned slashing protection history")
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/consensus-types/interfaces"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// waitTriggerBlock labels waits which ended on the arrival of the block of the slot.
+	waitTriggerBlock = "block"
+	// waitTriggerDeadline labels waits which ended on the deadline.
+	waitTriggerDeadline = "deadline"
+)
+
+// signingLatencyReporter is implemented by keymanagers which take noticeably long to sign, such as the one of
+// distributed validators which waits for the partial signatures of the cluster members.
+type signingLatencyReporter interface {
+	SigningLatency() time.Duration
+}
+
+// signingLeadTime returns how much earlier than their deadline duties start, so that the keymanager has the
+// time to sign before the deadline. It is bounded to a third of a slot so that duties never start in the
+// previous slot.
+func (v *validator) signingLeadTime() time.Duration {
+	reporter, ok := v.keyManager.(signingLatencyReporter)
+	if !ok {
+		return 0
+	}
+	lead := reporter.SigningLatency()
+	if limit := slots.DivideSlotBy(3 /* a third of the slot duration */); lead > limit {
+		return limit
+	}
+	return lead
+}
+
+// waitOneThirdOrValidBlock waits until (a) or (b) whichever comes first:
+//   (a) the validator has received a valid block that is the same slot as input slot
+//   (b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
+// Attesting as soon as the block arrives makes the vote for the head more likely to be correct, as the block
+// reaches the rest of the network at about the same time. One third of the slot remains the safety deadline
+// for blocks which are late or missing. The deadline is moved earlier by the signing latency of the keymanager.
+func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot types.Slot) {
+	ctx, span := trace.StartSpan(ctx, "validator.waitOneThirdOrValidBlock")
+	defer span.End()
+
+	deadline := slots.DivideSlotBy(3 /* a third of the slot duration */) - v.signingLeadTime()
+	trigger := waitTriggerDeadline
+	if v.waitForSlotBlock(ctx, slot, deadline, !features.Get().DisableAttestTimely) {
+		trigger = waitTriggerBlock
+	}
+	if ctx.Err() != nil {
+		tracing.AnnotateError(span, ctx.Err())
+		return
+	}
+	waited := prysmTime.Since(slots.StartTime(v.genesisTime, slot))
+	ValidatorAttestationWaitHistogramVec.WithLabelValues(trigger).Observe(waited.Seconds())
+}
+
+// waitForSlotBlock waits until the given deadline into the slot has passed, and reports whether the block of the
+// slot was received before it. If stopOnBlock is set, the wait ends as soon as the block of the slot is received
+// rather than on the deadline. A block received before the wait started always ends it immediately.
+func (v *validator) waitForSlotBlock(ctx context.Context, slot types.Slot, deadline time.Duration, stopOnBlock bool) bool {
+	// Don't need to wait if requested slot is the same as highest valid slot.
+	v.highestValidSlotLock.Lock()
+	if slot <= v.highestValidSlot {
+		v.highestValidSlotLock.Unlock()
+		return true
+	}
+	v.highestValidSlotLock.Unlock()
+
+	finalTime := slots.StartTime(v.genesisTime, slot).Add(deadline)
+	wait := prysmTime.Until(finalTime)
+	if wait <= 0 {
+		return false
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	bChannel := make(chan interfaces.SignedBeaconBlock, 1)
+	sub := v.blockFeed.Subscribe(bChannel)
+	defer sub.Unsubscribe()
+
+	received := false
+	for {
+		select {
+		case b := <-bChannel:
+			if slot <= b.Block().Slot() {
+				received = true
+				if stopOnBlock {
+					return true
+				}
+			}
+		case <-ctx.Done():
+			return received
+		case <-sub.Err():
+			log.Error("Subscriber closed, exiting goroutine")
+			return received
+		case <-t.C:
+			return received
+		}
+	}
+}
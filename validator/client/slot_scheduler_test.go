@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/consensus-types/wrapper"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func TestWaitForSlotBlock(t *testing.T) {
+	currentSlot := types.Slot(4)
+	deadline := 500 * time.Millisecond
+	sendBlock := func(t *testing.T, v *validator, slot types.Slot) {
+		time.Sleep(100 * time.Millisecond)
+		wsb, err := wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}})
+		require.NoError(t, err)
+		v.blockFeed.Send(wsb)
+	}
+	newValidator := func() *validator {
+		genesisTime := uint64(time.Now().Unix()) - uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot))
+		return &validator{
+			genesisTime: genesisTime,
+			blockFeed:   new(event.Feed),
+		}
+	}
+
+	t.Run("block stops the wait", func(t *testing.T) {
+		v := newValidator()
+		go sendBlock(t, v, currentSlot)
+		start := time.Now()
+		assert.Equal(t, true, v.waitForSlotBlock(context.Background(), currentSlot, time.Second+deadline, true))
+		assert.Equal(t, true, time.Since(start) < time.Second)
+	})
+	t.Run("block of a previous slot does not stop the wait", func(t *testing.T) {
+		v := newValidator()
+		go sendBlock(t, v, currentSlot-1)
+		assert.Equal(t, false, v.waitForSlotBlock(context.Background(), currentSlot, time.Second+deadline, true))
+		assert.Equal(t, true, time.Now().After(time.Unix(int64(v.genesisTime), 0).Add(
+			time.Duration(uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot)))*time.Second+deadline)))
+	})
+	t.Run("waits for the deadline when not stopping on block", func(t *testing.T) {
+		v := newValidator()
+		go sendBlock(t, v, currentSlot)
+		assert.Equal(t, true, v.waitForSlotBlock(context.Background(), currentSlot, time.Second+deadline, false))
+		assert.Equal(t, true, time.Now().After(time.Unix(int64(v.genesisTime), 0).Add(
+			time.Duration(uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot)))*time.Second+deadline)))
+	})
+	t.Run("block received before the wait", func(t *testing.T) {
+		v := newValidator()
+		v.highestValidSlot = currentSlot
+		start := time.Now()
+		assert.Equal(t, true, v.waitForSlotBlock(context.Background(), currentSlot, time.Second+deadline, false))
+		assert.Equal(t, true, time.Since(start) < 100*time.Millisecond)
+	})
+	t.Run("deadline passed", func(t *testing.T) {
+		v := newValidator()
+		assert.Equal(t, false, v.waitForSlotBlock(context.Background(), currentSlot-1, deadline, true))
+	})
+}
+
+type latencyKeymanager struct {
+	keymanager.IKeymanager
+	latency time.Duration
+}
+
+func (km *latencyKeymanager) SigningLatency() time.Duration {
+	return km.latency
+}
+
+func TestSigningLeadTime(t *testing.T) {
+	v := &validator{}
+	assert.Equal(t, time.Duration(0), v.signingLeadTime())
+
+	v.keyManager = &latencyKeymanager{latency: time.Second}
+	assert.Equal(t, time.Second, v.signingLeadTime())
+
+	// Duties never start before the slot.
+	v.keyManager = &latencyKeymanager{latency: time.Minute}
+	assert.Equal(t, slots.DivideSlotBy(3), v.signingLeadTime())
+}
@@ -228,6 +228,9 @@ func (_ *FakeValidator) CheckDoppelGanger(_ context.Context) error {
 	return nil
 }
 
+// PruneSlashingProtection for mocking
+func (_ *FakeValidator) PruneSlashingProtection(_ context.Context) {}
+
 // ReceiveBlocks for mocking
 func (fv *FakeValidator) ReceiveBlocks(_ context.Context, connectionErrorChannel chan<- error) {
 	fv.ReceiveBlocksCalled++
@@ -236,6 +239,13 @@ func (fv *FakeValidator) ReceiveBlocks(_ context.Context, connectionErrorChannel
 	}
 }
 
+// ReceiveProposerDuties for mocking
+func (_ *FakeValidator) ReceiveProposerDuties(_ context.Context, _ chan<- *ethpb.ProposerDutiesResponse) {
+}
+
+// UpdateProposerDuties for mocking
+func (_ *FakeValidator) UpdateProposerDuties(_ *ethpb.ProposerDutiesResponse) {}
+
 // HandleKeyReload for mocking
 func (fv *FakeValidator) HandleKeyReload(_ context.Context, newKeys [][fieldparams.BLSPubkeyLength]byte) (anyActive bool, err error) {
 	fv.HandleKeyReloadCalled = true
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -40,10 +41,16 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	remoteprotection "github.com/prysmaticlabs/prysm/validator/remote-protection"
+	signingaudit "github.com/prysmaticlabs/prysm/validator/signing-audit"
+	"github.com/prysmaticlabs/prysm/validator/standby"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -78,7 +85,10 @@ type validator struct {
 	prevBalance                        map[[fieldparams.BLSPubkeyLength]byte]uint64
 	pubkeyToValidatorIndex             map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
+	inMaintenance                      map[[fieldparams.BLSPubkeyLength]byte]bool
 	graffitiOrderedIndex               uint64
+	graffitiLock                       sync.Mutex
+	upcomingSyncCommitteePeriod        uint64
 	aggregatedSlotCommitteeIDCache     *lru.Cache
 	domainDataCache                    *ristretto.Cache
 	highestValidSlot                   types.Slot
@@ -98,8 +108,16 @@ type validator struct {
 	voteStats                          voteStats
 	syncCommitteeStats                 syncCommitteeStats
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
+	DVTConfig                          *dvt.SetupConfig
 	ProposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletIntializedChannel            chan *wallet.Wallet
+	pruningLock                        sync.Mutex
+	pruningMargin                      types.Epoch
+	prunedEpoch                        types.Epoch
+	standby                            *standby.Monitor
+	signingAudit                       *signingaudit.Log
+	remoteProtection                   *remoteprotection.Client
+	performance                        *PerformanceTracker
 }
 
 type validatorStatus struct {
@@ -141,7 +159,14 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if v.Web3SignerConfig != nil {
 				v.Web3SignerConfig.GenesisValidatorsRoot = genesisRoot
 			}
-			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true, Web3SignerConfig: v.Web3SignerConfig})
+			if v.DVTConfig != nil && v.DVTConfig.Signer != nil {
+				v.DVTConfig.Signer.GenesisValidatorsRoot = genesisRoot
+			}
+			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{
+				ListenForChanges: true,
+				Web3SignerConfig: v.Web3SignerConfig,
+				DVTConfig:        v.DVTConfig,
+			})
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
@@ -369,6 +394,89 @@ func (v *validator) ReceiveBlocks(ctx context.Context, connectionErrorChannel ch
 	}
 }
 
+// ReceiveProposerDuties starts a gRPC client stream listener to obtain the proposer duties of the
+// validating keys from the beacon node, which pushes them whenever a new epoch or a reorg changes
+// them. The duties are forwarded to the channel, until the context is canceled. Beacon nodes which
+// do not support the stream leave the validator to the proposer duties fetched with its other duties.
+func (v *validator) ReceiveProposerDuties(ctx context.Context, dutiesChan chan<- *ethpb.ProposerDutiesResponse) {
+	for {
+		err := v.receiveProposerDuties(ctx, dutiesChan)
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Warn("Beacon node does not support streaming proposer duties, relying on the duties of each epoch")
+			return
+		}
+		log.WithError(err).Warn("Proposer duties stream interrupted, retrying")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backOffPeriod):
+		}
+	}
+}
+
+func (v *validator) receiveProposerDuties(ctx context.Context, dutiesChan chan<- *ethpb.ProposerDutiesResponse) error {
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	stream, err := v.validatorClient.StreamProposerDuties(ctx, &ethpb.ProposerDutiesRequest{
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			continue
+		}
+		select {
+		case dutiesChan <- res:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// UpdateProposerDuties updates the proposer slots of the current epoch duties of the validator with
+// the proposer duties pushed by the beacon node. Duties for any other epoch are ignored, the duties
+// of the next epoch being fetched at its start.
+func (v *validator) UpdateProposerDuties(res *ethpb.ProposerDutiesResponse) {
+	if v.duties == nil || res == nil {
+		return
+	}
+	if res.Epoch != slots.ToEpoch(slots.CurrentSlot(v.genesisTime)) {
+		return
+	}
+	proposerSlots := make(map[[fieldparams.BLSPubkeyLength]byte][]types.Slot, len(res.Duties))
+	for _, duty := range res.Duties {
+		proposerSlots[bytesutil.ToBytes48(duty.PublicKey)] = duty.ProposerSlots
+	}
+	for _, duty := range v.duties.CurrentEpochDuties {
+		ss, ok := proposerSlots[bytesutil.ToBytes48(duty.PublicKey)]
+		if !ok {
+			continue
+		}
+		changed := len(ss) != len(duty.ProposerSlots)
+		for i := 0; !changed && i < len(ss); i++ {
+			changed = ss[i] != duty.ProposerSlots[i]
+		}
+		if changed {
+			log.WithFields(logrus.Fields{
+				"pubKey":        fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
+				"proposerSlots": ss,
+			}).Info("Proposer duties changed")
+		}
+		duty.ProposerSlots = ss
+	}
+}
+
 func (v *validator) checkAndLogValidatorStatus(statuses []*validatorStatus) bool {
 	nonexistentIndex := types.ValidatorIndex(^uint64(0))
 	var validatorActivated bool
@@ -600,13 +708,18 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	}
 
 	v.duties = resp
+	v.performance.setDuties(resp)
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
+	v.logUpcomingSyncCommittee(slot, v.duties.CurrentEpochDuties)
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
 	go func() {
 		if err := v.subscribeToSubnets(context.Background(), resp); err != nil {
 			log.WithError(err).Error("Failed to subscribe to subnets")
 		}
+		if err := v.subscribeToSyncSubnets(context.Background(), req.Epoch, resp); err != nil {
+			log.WithError(err).Error("Failed to subscribe to sync committee subnets")
+		}
 	}()
 
 	return nil
@@ -678,10 +791,58 @@ func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesRes
 	return err
 }
 
+// subscribeToSyncSubnets asks the beacon node to subscribe to the sync committee subnets of the
+// validators in the current or next sync committee until the end of their sync committee period.
+func (v *validator) subscribeToSyncSubnets(ctx context.Context, epoch types.Epoch, res *ethpb.DutiesResponse) error {
+	untilEpochs := make(map[types.ValidatorIndex]types.Epoch)
+	subscribe := func(duties []*ethpb.DutiesResponse_Duty, epoch types.Epoch) {
+		untilEpoch := types.Epoch(slots.SyncCommitteePeriod(epoch)+1) * params.BeaconConfig().EpochsPerSyncCommitteePeriod
+		for _, duty := range duties {
+			if !duty.IsSyncCommittee {
+				continue
+			}
+			if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+				continue
+			}
+			if untilEpoch > untilEpochs[duty.ValidatorIndex] {
+				untilEpochs[duty.ValidatorIndex] = untilEpoch
+			}
+		}
+	}
+	subscribe(res.CurrentEpochDuties, epoch)
+	subscribe(res.NextEpochDuties, epoch+1)
+	if len(untilEpochs) == 0 {
+		return nil
+	}
+
+	subscriptions := make([]*ethpb.SyncCommitteeSubnetSubscription, 0, len(untilEpochs))
+	for idx, untilEpoch := range untilEpochs {
+		subscriptions = append(subscriptions, &ethpb.SyncCommitteeSubnetSubscription{
+			ValidatorIndex: idx,
+			UntilEpoch:     untilEpoch,
+		})
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].ValidatorIndex < subscriptions[j].ValidatorIndex
+	})
+	_, err := v.validatorClient.PrepareSyncCommitteeSubnets(ctx, &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: subscriptions,
+	})
+	return err
+}
+
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole, error) {
+	// A hot standby performs no duties while the primary validator client is alive.
+	if v.standby != nil && !v.standby.Active() {
+		return make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole), nil
+	}
+	inMaintenance, err := v.keysInMaintenance(ctx, slots.ToEpoch(slot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get maintenance windows")
+	}
 	rolesAt := make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole)
 	for validator, duty := range v.duties.Duties {
 		var roles []iface.ValidatorRole
@@ -689,6 +850,9 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		if duty == nil {
 			continue
 		}
+		if inMaintenance[bytesutil.ToBytes48(duty.PublicKey)] {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
@@ -943,6 +1107,48 @@ func (v *validator) logDuties(slot types.Slot, duties []*ethpb.DutiesResponse_Du
 	}
 }
 
+// logUpcomingSyncCommittee logs, once per sync committee period, the validating keys which are members of the sync
+// committee of the next period along with the exact boundaries of the period, so that operators can avoid maintenance
+// during their sync committee duty.
+func (v *validator) logUpcomingSyncCommittee(slot types.Slot, duties []*ethpb.DutiesResponse_Duty) {
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(slot)) + 1
+	if period <= v.upcomingSyncCommitteePeriod {
+		return
+	}
+	pubKeys := make([]string, 0)
+	for _, duty := range duties {
+		if duty.IsNextPeriodSyncCommittee {
+			pubKeys = append(pubKeys, fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)))
+		}
+	}
+	if len(pubKeys) == 0 {
+		return
+	}
+	v.upcomingSyncCommitteePeriod = period
+
+	epochsPerPeriod := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	startEpoch := types.Epoch(period) * epochsPerPeriod
+	endEpoch := startEpoch + epochsPerPeriod - 1
+	startSlot, err := slots.EpochStart(startEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not compute start slot of the next sync committee period")
+		return
+	}
+	endSlot, err := slots.EpochEnd(endEpoch)
+	if err != nil {
+		log.WithError(err).Error("Could not compute end slot of the next sync committee period")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"pubKeys":    pubKeys,
+		"period":     period,
+		"startEpoch": startEpoch,
+		"endEpoch":   endEpoch,
+		"startTime":  slots.StartTime(v.genesisTime, startSlot).UTC().Format(time.RFC3339),
+		"endTime":    slots.StartTime(v.genesisTime, endSlot+1).UTC().Format(time.RFC3339),
+	}).Info("Validators will be in the next sync committee, avoid maintenance during the period")
+}
+
 // PushProposerSettings calls the prepareBeaconProposer RPC to set the fee recipient and also the register validator API if using a custom builder.
 func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKeymanager) error {
 	// only used after Bellatrix
@@ -972,7 +1178,7 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 		log.Info("No public keys have been imported. Skipping Push Proposer Settings")
 		return nil
 	}
-	feeRecipients, signedRegisterValidatorRequests, err := v.buildProposerSettingsRequests(ctx, pubkeys, km.Sign)
+	feeRecipients, signedRegisterValidatorRequests, err := v.buildProposerSettingsRequests(ctx, pubkeys, v.auditedSigner(km.Sign))
 	if err != nil {
 		return err
 	}
@@ -32,13 +32,17 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/util"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/standby"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -336,6 +340,49 @@ func TestCanonicalHeadSlot_FailedRPC(t *testing.T) {
 	assert.ErrorContains(t, "failed", err)
 }
 
+func TestPruneSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock2.NewMockBeaconChainClient(ctrl)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	atts := make([]*ethpb.IndexedAttestation, 0)
+	signingRoots := make([][32]byte, 0)
+	for e := types.Epoch(0); e < 20; e++ {
+		atts = append(atts, &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: e},
+			Target: &ethpb.Checkpoint{Epoch: e + 1},
+		}})
+		signingRoots = append(signingRoots, [32]byte{byte(e)})
+	}
+	require.NoError(t, db.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, atts))
+	v := validator{
+		beaconClient:  client,
+		db:            db,
+		pruningMargin: 10,
+	}
+
+	// Nothing is pruned unless enabled.
+	v.PruneSlashingProtection(ctx)
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableSlashingProtectionPruning: true})
+	defer resetCfg()
+	client.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{FinalizedEpoch: 20}, nil).Times(2)
+	v.PruneSlashingProtection(ctx)
+	assert.Equal(t, types.Epoch(10), v.prunedEpoch)
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 11, len(history))
+
+	// The history is pruned again only once the finalized epoch advanced.
+	v.PruneSlashingProtection(ctx)
+	assert.Equal(t, types.Epoch(10), v.prunedEpoch)
+}
+
 func TestCanonicalHeadSlot_OK(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -603,6 +650,88 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, resp.Duties[0].ValidatorIndex, v.duties.Duties[0].ValidatorIndex, "Unexpected validator assignments")
 }
 
+func TestLogUpcomingSyncCommittee(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v := &validator{}
+	duties := []*ethpb.DutiesResponse_Duty{
+		{PublicKey: []byte{'a'}, IsNextPeriodSyncCommittee: true},
+		{PublicKey: []byte{'b'}},
+	}
+	epochsPerPeriod := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	v.logUpcomingSyncCommittee(params.BeaconConfig().SlotsPerEpoch, duties)
+	require.LogsContain(t, hook, "Validators will be in the next sync committee")
+	require.LogsContain(t, hook, fmt.Sprintf("startEpoch=%d", epochsPerPeriod))
+	require.LogsContain(t, hook, fmt.Sprintf("endEpoch=%d", 2*epochsPerPeriod-1))
+	require.LogsContain(t, hook, "pubKeys=\"[0x61]\"")
+
+	// The upcoming sync committee is only logged once per period.
+	hook.Reset()
+	v.logUpcomingSyncCommittee(2*params.BeaconConfig().SlotsPerEpoch, duties)
+	require.LogsDoNotContain(t, hook, "Validators will be in the next sync committee")
+}
+
+func TestReceiveProposerDuties_ForwardsDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	stream := mock2.NewMockBeaconNodeValidator_StreamProposerDutiesClient(ctrl)
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], privKey.PublicKey().Marshal())
+	v := validator{
+		validatorClient: client,
+		keyManager: &mockKeymanager{
+			keysMap: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{pubKey: privKey},
+		},
+	}
+	res := &ethpb.ProposerDutiesResponse{
+		Epoch:  1,
+		Duties: []*ethpb.ProposerDutiesResponse_Duty{{PublicKey: pubKey[:], ProposerSlots: []types.Slot{33}}},
+	}
+	client.EXPECT().StreamProposerDuties(
+		gomock.Any(),
+		&ethpb.ProposerDutiesRequest{PublicKeys: [][]byte{pubKey[:]}},
+	).Return(stream, nil)
+	stream.EXPECT().Recv().Return(res, nil)
+	stream.EXPECT().Recv().Return(nil, status.Error(codes.Unimplemented, "not supported"))
+
+	dutiesChan := make(chan *ethpb.ProposerDutiesResponse, 1)
+	hook := logTest.NewGlobal()
+	v.ReceiveProposerDuties(context.Background(), dutiesChan)
+	assert.DeepEqual(t, res, <-dutiesChan)
+	require.LogsContain(t, hook, "Beacon node does not support streaming proposer duties")
+}
+
+func TestUpdateProposerDuties(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{2}
+	v := validator{
+		genesisTime: uint64(time.Now().Unix()),
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: pubKey[:], ProposerSlots: []types.Slot{1}},
+				{PublicKey: otherPubKey[:], ProposerSlots: []types.Slot{2}},
+			},
+		},
+	}
+
+	// Duties of another epoch are ignored.
+	v.UpdateProposerDuties(&ethpb.ProposerDutiesResponse{
+		Epoch:  1,
+		Duties: []*ethpb.ProposerDutiesResponse_Duty{{PublicKey: pubKey[:], ProposerSlots: []types.Slot{33}}},
+	})
+	assert.DeepEqual(t, []types.Slot{1}, v.duties.CurrentEpochDuties[0].ProposerSlots)
+
+	v.UpdateProposerDuties(&ethpb.ProposerDutiesResponse{
+		Epoch:  0,
+		Duties: []*ethpb.ProposerDutiesResponse_Duty{{PublicKey: pubKey[:], ProposerSlots: []types.Slot{3, 4}}},
+	})
+	assert.DeepEqual(t, []types.Slot{3, 4}, v.duties.CurrentEpochDuties[0].ProposerSlots)
+	assert.DeepEqual(t, []types.Slot{2}, v.duties.CurrentEpochDuties[1].ProposerSlots)
+}
+
 func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctrl := gomock.NewController(t)
@@ -657,6 +786,45 @@ func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
 	}
 }
 
+func TestSubscribeToSyncSubnets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	v := validator{
+		validatorClient: client,
+	}
+	period := params.BeaconConfig().EpochsPerSyncCommitteePeriod
+	epoch := period - 1
+
+	// No request is sent without sync committee duties.
+	res := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE}},
+		NextEpochDuties:    []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE}},
+	}
+	require.NoError(t, v.subscribeToSyncSubnets(context.Background(), epoch, res))
+
+	// The last epoch of a period subscribes the validators of both sync committees.
+	res = &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE, IsSyncCommittee: true},
+			{ValidatorIndex: 2, Status: ethpb.ValidatorStatus_ACTIVE},
+			{ValidatorIndex: 3, Status: ethpb.ValidatorStatus_PENDING, IsSyncCommittee: true},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+			{ValidatorIndex: 2, Status: ethpb.ValidatorStatus_ACTIVE, IsSyncCommittee: true},
+			{ValidatorIndex: 3, Status: ethpb.ValidatorStatus_PENDING, IsSyncCommittee: true},
+		},
+	}
+	client.EXPECT().PrepareSyncCommitteeSubnets(gomock.Any(), &ethpb.PrepareSyncCommitteeSubnetsRequest{
+		Subscriptions: []*ethpb.SyncCommitteeSubnetSubscription{
+			{ValidatorIndex: 1, UntilEpoch: period},
+			{ValidatorIndex: 2, UntilEpoch: 2 * period},
+		},
+	}).Return(&emptypb.Empty{}, nil)
+	require.NoError(t, v.subscribeToSyncSubnets(context.Background(), epoch, res))
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	v, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -759,6 +927,57 @@ func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 	assert.Equal(t, iface.RoleAttester, roleMap[bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())][0])
 }
 
+func TestRolesAt_SkipsKeysInMaintenance(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				AttesterSlot:   params.BeaconConfig().SlotsPerEpoch,
+				PublicKey:      pubKey[:],
+			},
+		},
+	}
+	require.NoError(t, v.db.SaveMaintenanceWindow(context.Background(), pubKey, &kv.MaintenanceWindow{StartEpoch: 1, EndEpoch: 2}))
+
+	roleMap, err := v.RolesAt(context.Background(), params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+	require.LogsContain(t, hook, "Validator entered its maintenance window")
+
+	roleMap, err = v.RolesAt(context.Background(), 2*params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roleMap[pubKey]))
+	require.LogsContain(t, hook, "Validator left its maintenance window")
+}
+
+func TestRolesAt_StandbySkipsDuties(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	pubKey := bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				AttesterSlot:   1,
+				PublicKey:      pubKey[:],
+			},
+		},
+	}
+	monitor, err := standby.NewMonitor(context.Background(), v.db, "http://127.0.0.1:0", []byte("secret"), time.Second, time.Minute)
+	require.NoError(t, err)
+	v.standby = monitor
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+}
+
 func TestCheckAndLogValidatorStatus_OK(t *testing.T) {
 	nonexistentIndex := types.ValidatorIndex(^uint64(0))
 	type statusTest struct {
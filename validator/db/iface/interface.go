@@ -32,10 +32,18 @@ type ValidatorDB interface {
 	// Proposer protection related methods.
 	HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Slot, bool, error)
 	LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Slot, bool, error)
+	RaiseLowestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) error
 	ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*kv.Proposal, error)
 	ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error)
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error
 	ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	ProposalIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*kv.ProposalIntent, error)
+	SaveProposalIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, intent *kv.ProposalIntent) error
+
+	// Maintenance window related methods.
+	MaintenanceWindows(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte]*kv.MaintenanceWindow, error)
+	SaveMaintenanceWindow(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, window *kv.MaintenanceWindow) error
+	DeleteMaintenanceWindow(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
 
 	// Attester protection related methods.
 	// Methods to store and read blacklisted public keys from EIP-3076
@@ -45,6 +53,9 @@ type ValidatorDB interface {
 	SigningRootAtTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, target types.Epoch) ([32]byte, error)
 	LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
 	LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
+	RaiseLowestSignedEpochs(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, source, target types.Epoch) error
+	HighestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
+	HighestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error)
 	AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
 	CheckSlashableAttestation(
 		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
@@ -58,6 +69,7 @@ type ValidatorDB interface {
 	AttestationHistoryForPubKey(
 		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte,
 	) ([]*kv.AttestationRecord, error)
+	PruneSlashingProtection(ctx context.Context, cutoff types.Epoch) (uint64, error)
 
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
@@ -577,3 +577,62 @@ func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldpar
 	})
 	return lowestSignedTargetEpoch, exists, err
 }
+
+// RaiseLowestSignedEpochs raises the lowest signed source and target epochs of a validator public
+// key to the given epochs, so that no attestation with a lower source or a target at or below the
+// given target is signed. Lower epochs leave them unchanged.
+func (s *Store) RaiseLowestSignedEpochs(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, source, target types.Epoch,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedEpochs")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		if err := raiseLowestSignedTo(tx.Bucket(lowestSignedSourceBucket), pubKey[:], uint64(source)); err != nil {
+			return err
+		}
+		return raiseLowestSignedTo(tx.Bucket(lowestSignedTargetBucket), pubKey[:], uint64(target))
+	})
+}
+
+// HighestSignedSourceEpoch returns the highest source epoch of the attestations signed by a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) HighestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedSourceEpoch")
+	defer span.End()
+	return s.highestAttestedEpoch(publicKey, attestationSourceEpochsBucket)
+}
+
+// HighestSignedTargetEpoch returns the highest target epoch of the attestations signed by a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) HighestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedTargetEpoch")
+	defer span.End()
+	return s.highestAttestedEpoch(publicKey, attestationTargetEpochsBucket)
+}
+
+// highestAttestedEpoch returns the last key of the given epochs bucket of a public key, which is keyed by big
+// endian epochs.
+func (s *Store) highestAttestedEpoch(publicKey [fieldparams.BLSPubkeyLength]byte, epochsBucket []byte) (types.Epoch, bool, error) {
+	var highest types.Epoch
+	var exists bool
+	err := s.view(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(pubKeysBucket).Bucket(publicKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		bucket := pkBucket.Bucket(epochsBucket)
+		if bucket == nil {
+			return nil
+		}
+		k, _ := bucket.Cursor().Last()
+		// 8 because bytesutil.BytesToEpochBigEndian will return 0 if input is less than 8 bytes.
+		if len(k) < 8 {
+			return nil
+		}
+		exists = true
+		highest = bytesutil.BytesToEpochBigEndian(k)
+		return nil
+	})
+	return highest, exists, err
+}
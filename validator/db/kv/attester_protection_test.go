@@ -576,3 +576,59 @@ func TestStore_flushAttestationRecords_InProgress(t *testing.T) {
 	s.flushAttestationRecords(context.Background(), nil)
 	assert.LogsContain(t, hook, "Attempted to flush attestation records when already in progress")
 }
+
+func TestHighestSignedEpochs_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	validatorDB, err := NewKVStore(ctx, t.TempDir(), &Config{})
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, validatorDB.Close(), "Failed to close database")
+		require.NoError(t, validatorDB.ClearDB(), "Failed to clear database")
+	})
+	p0 := [fieldparams.BLSPubkeyLength]byte{0}
+	_, exists, err := validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	_, exists, err = validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, p0, [32]byte{1}, createAttestation(100, 300)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, p0, [32]byte{2}, createAttestation(200, 201)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, p0, [32]byte{3}, createAttestation(99, 100)))
+
+	source, exists, err := validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(200), source)
+	target, exists, err := validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(300), target)
+}
+
+func TestStore_RaiseLowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	p0 := [fieldparams.BLSPubkeyLength]byte{0}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{p0})
+
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, p0, [32]byte{1}, createAttestation(2, 3)))
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, p0, 5, 6))
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(5), source)
+	target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(6), target)
+
+	// Lower epochs leave the lowest signed epochs unchanged.
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, p0, 1, 7))
+	source, _, err = validatorDB.LowestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(5), source)
+	target, _, err = validatorDB.LowestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, types.Epoch(7), target)
+}
@@ -151,6 +151,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			proposalIntentsBucket,
+			maintenanceWindowsBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// maintenanceWindowLength is the length of an encoded maintenance window, its start and end epochs.
+const maintenanceWindowLength = 16
+
+// MaintenanceWindow is a range of epochs during which a validator is marked inactive, and all of its
+// duties are skipped. It starts at StartEpoch and ends before EndEpoch.
+type MaintenanceWindow struct {
+	StartEpoch types.Epoch
+	EndEpoch   types.Epoch
+}
+
+// Contains returns true if the given epoch is within the maintenance window.
+func (w *MaintenanceWindow) Contains(epoch types.Epoch) bool {
+	return epoch >= w.StartEpoch && epoch < w.EndEpoch
+}
+
+// MaintenanceWindows returns the maintenance windows of all validator public keys which have one.
+func (s *Store) MaintenanceWindows(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte]*MaintenanceWindow, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.MaintenanceWindows")
+	defer span.End()
+
+	windows := make(map[[fieldparams.BLSPubkeyLength]byte]*MaintenanceWindow)
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(maintenanceWindowsBucket).ForEach(func(k, v []byte) error {
+			if len(v) != maintenanceWindowLength {
+				return fmt.Errorf("invalid maintenance window of length %d for public key %#x", len(v), k)
+			}
+			windows[bytesutil.ToBytes48(k)] = &MaintenanceWindow{
+				StartEpoch: bytesutil.BytesToEpochBigEndian(v[:8]),
+				EndEpoch:   bytesutil.BytesToEpochBigEndian(v[8:]),
+			}
+			return nil
+		})
+	})
+	return windows, err
+}
+
+// SaveMaintenanceWindow saves the maintenance window of the validator public key, replacing its
+// previous window.
+func (s *Store) SaveMaintenanceWindow(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, window *MaintenanceWindow) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveMaintenanceWindow")
+	defer span.End()
+
+	enc := make([]byte, 0, maintenanceWindowLength)
+	enc = append(enc, bytesutil.EpochToBytesBigEndian(window.StartEpoch)...)
+	enc = append(enc, bytesutil.EpochToBytesBigEndian(window.EndEpoch)...)
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(maintenanceWindowsBucket).Put(pubKey[:], enc)
+	})
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of the validator public key.
+func (s *Store) DeleteMaintenanceWindow(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.DeleteMaintenanceWindow")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(maintenanceWindowsBucket).Delete(pubKey[:])
+	})
+}
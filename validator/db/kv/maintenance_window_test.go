@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_MaintenanceWindows(t *testing.T) {
+	ctx := context.Background()
+	first := [fieldparams.BLSPubkeyLength]byte{1}
+	second := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{first, second})
+
+	windows, err := db.MaintenanceWindows(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(windows))
+
+	require.NoError(t, db.SaveMaintenanceWindow(ctx, first, &MaintenanceWindow{StartEpoch: 10, EndEpoch: 12}))
+	require.NoError(t, db.SaveMaintenanceWindow(ctx, second, &MaintenanceWindow{StartEpoch: 3, EndEpoch: 4}))
+	// A later window replaces the previous one.
+	require.NoError(t, db.SaveMaintenanceWindow(ctx, second, &MaintenanceWindow{StartEpoch: 5, EndEpoch: 8}))
+	windows, err = db.MaintenanceWindows(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(windows))
+	assert.DeepEqual(t, &MaintenanceWindow{StartEpoch: 10, EndEpoch: 12}, windows[first])
+	assert.DeepEqual(t, &MaintenanceWindow{StartEpoch: 5, EndEpoch: 8}, windows[second])
+
+	require.NoError(t, db.DeleteMaintenanceWindow(ctx, first))
+	windows, err = db.MaintenanceWindows(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(windows))
+	_, ok := windows[first]
+	assert.Equal(t, false, ok)
+}
+
+func TestMaintenanceWindow_Contains(t *testing.T) {
+	w := &MaintenanceWindow{StartEpoch: 5, EndEpoch: 8}
+	assert.Equal(t, false, w.Contains(4))
+	assert.Equal(t, true, w.Contains(5))
+	assert.Equal(t, true, w.Contains(7))
+	assert.Equal(t, false, w.Contains(8))
+}
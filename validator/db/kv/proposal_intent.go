@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// proposalIntentLength is the length of an encoded proposal intent, a slot followed by a signing root.
+const proposalIntentLength = 8 + fieldparams.RootLength
+
+// ProposalIntent is the block a validator is about to sign, recorded before requesting its signature. Unlike
+// the proposal history, which is saved once the block is signed, the intent covers the window between signing
+// and recording a block, so a validator client restarting in that window does not sign a different block
+// for the same slot.
+type ProposalIntent struct {
+	Slot        types.Slot
+	SigningRoot [32]byte
+}
+
+// ProposalIntent returns the last proposal intent recorded for the given validator public key, or nil if
+// there is none.
+func (s *Store) ProposalIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*ProposalIntent, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalIntent")
+	defer span.End()
+
+	var intent *ProposalIntent
+	err := s.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(proposalIntentsBucket).Get(pubKey[:])
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != proposalIntentLength {
+			return fmt.Errorf("invalid proposal intent of length %d for public key %#x", len(enc), pubKey)
+		}
+		intent = &ProposalIntent{
+			Slot:        bytesutil.BytesToSlotBigEndian(enc[:8]),
+			SigningRoot: bytesutil.ToBytes32(enc[8:]),
+		}
+		return nil
+	})
+	return intent, err
+}
+
+// SaveProposalIntent records the given proposal intent for the validator public key, replacing its
+// previous intent. It must be saved before the block is signed.
+func (s *Store) SaveProposalIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, intent *ProposalIntent) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalIntent")
+	defer span.End()
+
+	enc := make([]byte, 0, proposalIntentLength)
+	enc = append(enc, bytesutil.SlotToBytesBigEndian(intent.Slot)...)
+	enc = append(enc, intent.SigningRoot[:]...)
+	return s.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(proposalIntentsBucket).Put(pubKey[:], enc)
+	})
+}
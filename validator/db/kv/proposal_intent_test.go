@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_ProposalIntent(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	intent, err := db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, intent == nil)
+
+	first := &ProposalIntent{Slot: 5, SigningRoot: [32]byte{'a'}}
+	require.NoError(t, db.SaveProposalIntent(ctx, pubKey, first))
+	intent, err = db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, first, intent)
+
+	// A later intent replaces the previous one.
+	second := &ProposalIntent{Slot: 9, SigningRoot: [32]byte{'b'}}
+	require.NoError(t, db.SaveProposalIntent(ctx, pubKey, second))
+	intent, err = db.ProposalIntent(ctx, pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, second, intent)
+
+	// Intents are kept per public key.
+	intent, err = db.ProposalIntent(ctx, [fieldparams.BLSPubkeyLength]byte{2})
+	require.NoError(t, err)
+	assert.Equal(t, true, intent == nil)
+}
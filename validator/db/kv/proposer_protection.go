@@ -167,6 +167,17 @@ func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams
 	return lowestSignedProposalSlot, exists, err
 }
 
+// RaiseLowestSignedProposal raises the lowest signed proposal slot of a validator public key to
+// the given slot, so that no block at or below it is signed. A lower slot leaves it unchanged.
+func (s *Store) RaiseLowestSignedProposal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) error {
+	_, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedProposal")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		return raiseLowestSignedTo(tx.Bucket(lowestSignedProposalsBucket), pubKey[:], uint64(slot))
+	})
+}
+
 // HighestSignedProposal returns the highest signed proposal slot for a validator public key.
 // If no data exists, a boolean of value false is returned.
 func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (types.Slot, bool, error) {
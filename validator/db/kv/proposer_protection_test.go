@@ -242,6 +242,31 @@ func TestStore_LowestSignedProposal(t *testing.T) {
 	assert.Equal(t, types.Slot(1), slot)
 }
 
+func TestStore_RaiseLowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+
+	// The lowest signed slot is set even without any proposal history.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 10))
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), slot)
+
+	// A lower slot does not lower it, neither does saving a higher proposal.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 5))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 12, []byte{1}))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), slot)
+
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 20))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), slot)
+}
+
 func TestStore_HighestSignedProposal(t *testing.T) {
 	ctx := context.Background()
 	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
@@ -0,0 +1,296 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Number of public keys whose slashing protection history is pruned in a single transaction.
+const slashingProtectionPruningBatchSize = 64
+
+// PruneSlashingProtection deletes the attestation records of every public key with a target epoch
+// before the given cutoff epoch, along with the block proposals at slots before the start of the
+// cutoff epoch, and returns the number of deleted records. Public keys are pruned in batches of
+// separate transactions, so that pruning can be interrupted by the context between batches.
+//
+// The cutoff must not be greater than the finalized epoch: attestations signed from then on have a
+// source epoch at or above the finalized epoch, and blocks are proposed at slots after it, so that
+// none of them can conflict with the deleted records. In addition, the following invariants hold
+// for every public key once pruned, and are checked before committing each batch:
+//
+//   - the most recent attestation and block proposal are never deleted, even if older than the
+//     cutoff, so that the highest signed epochs and slot are preserved;
+//   - the lowest signed source and target epochs and proposal slot are raised to at least the
+//     oldest remaining records, so that EIP-3076 minimal slashing protection refuses to sign
+//     anything older than the remaining history. They may be higher, when raised to the
+//     watermarks of a primary validator client by a hot standby.
+func (s *Store) PruneSlashingProtection(ctx context.Context, cutoff types.Epoch) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneSlashingProtection")
+	defer span.End()
+	attestingKeys, err := s.bucketKeys(pubKeysBucket)
+	if err != nil {
+		return 0, err
+	}
+	pruned, err := s.pruneInBatches(ctx, attestingKeys, func(tx *bolt.Tx, pubKey []byte) (uint64, error) {
+		return pruneAttestationHistory(tx, pubKey, cutoff)
+	})
+	if err != nil {
+		return pruned, errors.Wrap(err, "could not prune attestation history")
+	}
+	cutoffSlot, err := slots.EpochStart(cutoff)
+	if err != nil {
+		return pruned, err
+	}
+	proposingKeys, err := s.bucketKeys(historicProposalsBucket)
+	if err != nil {
+		return pruned, err
+	}
+	prunedProposals, err := s.pruneInBatches(ctx, proposingKeys, func(tx *bolt.Tx, pubKey []byte) (uint64, error) {
+		return pruneProposalHistory(tx, pubKey, cutoffSlot)
+	})
+	pruned += prunedProposals
+	if err != nil {
+		return pruned, errors.Wrap(err, "could not prune proposal history")
+	}
+	return pruned, nil
+}
+
+// bucketKeys returns a copy of the keys of the given top level bucket.
+func (s *Store) bucketKeys(bucketName []byte) ([][]byte, error) {
+	var keys [][]byte
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).ForEach(func(k, _ []byte) error {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+			return nil
+		})
+	})
+	return keys, err
+}
+
+// pruneInBatches applies the pruning function to the given public keys, committing a transaction
+// every slashingProtectionPruningBatchSize keys.
+func (s *Store) pruneInBatches(
+	ctx context.Context, pubKeys [][]byte, prune func(tx *bolt.Tx, pubKey []byte) (uint64, error),
+) (uint64, error) {
+	var pruned uint64
+	for start := 0; start < len(pubKeys); start += slashingProtectionPruningBatchSize {
+		if ctx.Err() != nil {
+			return pruned, ctx.Err()
+		}
+		end := start + slashingProtectionPruningBatchSize
+		if end > len(pubKeys) {
+			end = len(pubKeys)
+		}
+		var batchPruned uint64
+		err := s.update(func(tx *bolt.Tx) error {
+			for _, pubKey := range pubKeys[start:end] {
+				n, err := prune(tx, pubKey)
+				if err != nil {
+					return errors.Wrapf(err, "public key %#x", pubKey)
+				}
+				batchPruned += n
+			}
+			return nil
+		})
+		if err != nil {
+			return pruned, err
+		}
+		pruned += batchPruned
+	}
+	return pruned, nil
+}
+
+// pruneAttestationHistory deletes the attestation records of a public key with a target epoch
+// before the cutoff, except for the highest target epoch, and returns the number of deleted
+// target epochs.
+func pruneAttestationHistory(tx *bolt.Tx, pubKey []byte, cutoff types.Epoch) (uint64, error) {
+	pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey)
+	if pkBucket == nil {
+		return 0, nil
+	}
+	targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
+	if targetEpochsBucket == nil {
+		return 0, nil
+	}
+	highestTargetBytes, _ := targetEpochsBucket.Cursor().Last()
+	if highestTargetBytes == nil {
+		return 0, nil
+	}
+	highestTargetBytes = bytesutil.SafeCopyBytes(highestTargetBytes)
+	if highestTarget := bytesutil.BytesToEpochBigEndian(highestTargetBytes); highestTarget < cutoff {
+		cutoff = highestTarget
+	}
+
+	pruned, err := deleteKeysBefore(targetEpochsBucket, uint64(cutoff))
+	if err != nil || pruned == 0 {
+		return 0, err
+	}
+	if _, err := deleteKeysBefore(pkBucket.Bucket(attestationSigningRootsBucket), uint64(cutoff)); err != nil {
+		return 0, err
+	}
+	sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+	if err := pruneSourceEpochs(sourceEpochsBucket, cutoff); err != nil {
+		return 0, err
+	}
+
+	// Raise the lowest signed epochs to the oldest remaining records.
+	lowestTarget, err := raiseLowestSigned(tx.Bucket(lowestSignedTargetBucket), pubKey, targetEpochsBucket)
+	if err != nil {
+		return 0, err
+	}
+	lowestSource, err := raiseLowestSigned(tx.Bucket(lowestSignedSourceBucket), pubKey, sourceEpochsBucket)
+	if err != nil {
+		return 0, err
+	}
+
+	// Integrity checks, failing the batch transaction when not met.
+	if last, _ := targetEpochsBucket.Cursor().Last(); !bytes.Equal(last, highestTargetBytes) {
+		return 0, errors.New("highest signed target epoch was pruned")
+	}
+	if first, _ := targetEpochsBucket.Cursor().First(); lowestTarget < bytesutil.BytesToUint64BigEndian(first) {
+		return 0, errors.New("lowest signed target epoch is lower than the remaining target epochs")
+	}
+	if sourceEpochsBucket != nil {
+		first, _ := sourceEpochsBucket.Cursor().First()
+		if first == nil {
+			return 0, errors.New("source epoch of the highest signed target epoch was pruned")
+		}
+		if lowestSource < bytesutil.BytesToUint64BigEndian(first) {
+			return 0, errors.New("lowest signed source epoch is lower than the remaining source epochs")
+		}
+	}
+	return pruned, nil
+}
+
+// pruneSourceEpochs removes the target epochs before the cutoff from the attested targets of each
+// source epoch, deleting the source epochs left without any target.
+func pruneSourceEpochs(sourceEpochsBucket *bolt.Bucket, cutoff types.Epoch) error {
+	if sourceEpochsBucket == nil {
+		return nil
+	}
+	// The bucket is not modified while iterating over it, which would invalidate the cursor.
+	updates := make(map[string][]byte)
+	c := sourceEpochsBucket.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if bytesutil.BytesToEpochBigEndian(k) >= cutoff {
+			// Source epochs are lower than their target epochs.
+			break
+		}
+		remaining := make([]byte, 0, len(v))
+		for i := 0; i+8 <= len(v); i += 8 {
+			if bytesutil.BytesToEpochBigEndian(v[i:i+8]) >= cutoff {
+				remaining = append(remaining, v[i:i+8]...)
+			}
+		}
+		if len(remaining) != len(v) {
+			updates[string(k)] = remaining
+		}
+	}
+	for k, remaining := range updates {
+		if len(remaining) == 0 {
+			if err := sourceEpochsBucket.Delete([]byte(k)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := sourceEpochsBucket.Put([]byte(k), remaining); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneProposalHistory deletes the proposals of a public key at slots before the cutoff, except
+// for the highest slot, and returns the number of deleted proposals.
+func pruneProposalHistory(tx *bolt.Tx, pubKey []byte, cutoff types.Slot) (uint64, error) {
+	valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey)
+	if valBucket == nil {
+		return 0, nil
+	}
+	highestSlotBytes, _ := valBucket.Cursor().Last()
+	if highestSlotBytes == nil {
+		return 0, nil
+	}
+	highestSlotBytes = bytesutil.SafeCopyBytes(highestSlotBytes)
+	if highestSlot := bytesutil.BytesToSlotBigEndian(highestSlotBytes); highestSlot < cutoff {
+		cutoff = highestSlot
+	}
+	pruned, err := deleteKeysBefore(valBucket, uint64(cutoff))
+	if err != nil || pruned == 0 {
+		return 0, err
+	}
+	lowestSlot, err := raiseLowestSigned(tx.Bucket(lowestSignedProposalsBucket), pubKey, valBucket)
+	if err != nil {
+		return 0, err
+	}
+
+	// Integrity checks, failing the batch transaction when not met.
+	if last, _ := valBucket.Cursor().Last(); !bytes.Equal(last, highestSlotBytes) {
+		return 0, errors.New("highest signed proposal was pruned")
+	}
+	if first, _ := valBucket.Cursor().First(); lowestSlot < bytesutil.BytesToUint64BigEndian(first) {
+		return 0, errors.New("lowest signed proposal is lower than the remaining proposals")
+	}
+	return pruned, nil
+}
+
+// deleteKeysBefore deletes the keys of a bucket holding big endian encoded epochs or slots lower
+// than the given value, and returns the number of deleted keys.
+func deleteKeysBefore(bkt *bolt.Bucket, before uint64) (uint64, error) {
+	if bkt == nil {
+		return 0, nil
+	}
+	var deleted uint64
+	c := bkt.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		if bytesutil.BytesToUint64BigEndian(k) >= before {
+			break
+		}
+		if err := c.Delete(); err != nil {
+			return 0, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// raiseLowestSigned raises the lowest signed epoch or slot of a public key to the first key of the
+// given history bucket, if higher, and returns the resulting value.
+func raiseLowestSigned(lowestBucket *bolt.Bucket, pubKey []byte, history *bolt.Bucket) (uint64, error) {
+	var lowest uint64
+	lowestBytes := lowestBucket.Get(pubKey)
+	if len(lowestBytes) >= 8 {
+		lowest = bytesutil.BytesToUint64BigEndian(lowestBytes)
+	}
+	if history == nil {
+		return lowest, nil
+	}
+	first, _ := history.Cursor().First()
+	if first == nil {
+		return lowest, nil
+	}
+	if oldest := bytesutil.BytesToUint64BigEndian(first); len(lowestBytes) < 8 || oldest > lowest {
+		lowest = oldest
+		if err := lowestBucket.Put(pubKey, bytesutil.Uint64ToBytesBigEndian(lowest)); err != nil {
+			return 0, err
+		}
+	}
+	return lowest, nil
+}
+
+// raiseLowestSignedTo raises the lowest signed epoch or slot of a public key to the given value,
+// if lower or not set.
+func raiseLowestSignedTo(lowestBucket *bolt.Bucket, pubKey []byte, value uint64) error {
+	lowestBytes := lowestBucket.Get(pubKey)
+	if len(lowestBytes) >= 8 && bytesutil.BytesToUint64BigEndian(lowestBytes) >= value {
+		return nil
+	}
+	return lowestBucket.Put(pubKey, bytesutil.Uint64ToBytesBigEndian(value))
+}
@@ -0,0 +1,125 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// setupSlashingProtectionHistory saves an attestation with source epoch e and target epoch e+1,
+// and a block proposal at the first slot of epoch e, for every epoch e before the given one.
+func setupSlashingProtectionHistory(t *testing.T, validatorDB *Store, pubKey [fieldparams.BLSPubkeyLength]byte, numEpochs types.Epoch) {
+	ctx := context.Background()
+	atts := make([]*ethpb.IndexedAttestation, 0, numEpochs)
+	signingRoots := make([][32]byte, 0, numEpochs)
+	for e := types.Epoch(0); e < numEpochs; e++ {
+		atts = append(atts, createAttestation(e, e+1))
+		var signingRoot [32]byte
+		copy(signingRoot[:], fmt.Sprintf("%d", e))
+		signingRoots = append(signingRoots, signingRoot)
+		slot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(e))
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]))
+	}
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, atts))
+}
+
+func TestStore_PruneSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := setupDB(t, pubKeys)
+	for _, pubKey := range pubKeys {
+		setupSlashingProtectionHistory(t, validatorDB, pubKey, 20)
+	}
+
+	pruned, err := validatorDB.PruneSlashingProtection(ctx, 10)
+	require.NoError(t, err)
+	// Targets 1 to 9 and proposals of epochs 0 to 9, for each public key.
+	assert.Equal(t, uint64(2*(9+10)), pruned)
+
+	for _, pubKey := range pubKeys {
+		history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 11, len(history))
+		for _, record := range history {
+			assert.Equal(t, true, record.Target >= 10, "Target epoch %d was not pruned", record.Target)
+		}
+		_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, params.BeaconConfig().SlotsPerEpoch.Mul(9))
+		require.NoError(t, err)
+		assert.Equal(t, false, exists)
+		_, exists, err = validatorDB.ProposalHistoryForSlot(ctx, pubKey, params.BeaconConfig().SlotsPerEpoch.Mul(10))
+		require.NoError(t, err)
+		assert.Equal(t, true, exists)
+
+		// The lowest signed values are raised to the oldest remaining records.
+		lowestSource, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(9), lowestSource)
+		lowestTarget, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, types.Epoch(10), lowestTarget)
+		lowestProposal, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(10), lowestProposal)
+
+		// Remaining records still protect from slashable attestations.
+		slashingKind, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, [32]byte{}, createAttestation(9, 21))
+		require.NotNil(t, err)
+		assert.Equal(t, SurroundingVote, slashingKind)
+	}
+
+	// Pruning again at the same epoch is a no-op.
+	pruned, err = validatorDB.PruneSlashingProtection(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), pruned)
+}
+
+func TestStore_PruneSlashingProtection_KeepsLatestRecords(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	setupSlashingProtectionHistory(t, validatorDB, pubKey, 20)
+
+	// All the records are older than the cutoff, but the latest ones are kept.
+	_, err := validatorDB.PruneSlashingProtection(ctx, 100)
+	require.NoError(t, err)
+	history, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.Equal(t, types.Epoch(19), history[0].Source)
+	assert.Equal(t, types.Epoch(20), history[0].Target)
+	lowestTarget, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(20), lowestTarget)
+
+	lastSlot := params.BeaconConfig().SlotsPerEpoch.Mul(19)
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, lastSlot)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	lowestProposal, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, lastSlot, lowestProposal)
+	highestProposal, _, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, lastSlot, highestProposal)
+}
+
+func TestStore_PruneSlashingProtection_ContextCanceled(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	setupSlashingProtectionHistory(t, validatorDB, pubKey, 20)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := validatorDB.PruneSlashingProtection(ctx, 10)
+	require.ErrorContains(t, "context canceled", err)
+	history, err := validatorDB.AttestationHistoryForPubKey(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 20, len(history))
+}
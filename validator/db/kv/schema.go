@@ -8,6 +8,12 @@ var (
 	historicProposalsBucket            = []byte("proposal-history-bucket-interchange")
 	deprecatedAttestationHistoryBucket = []byte("attestation-history-bucket-interchange")
 
+	// Last block each validator intended to sign, recorded before signing it.
+	proposalIntentsBucket = []byte("proposal-intents-bucket")
+
+	// Maintenance windows during which the duties of a validator are skipped.
+	maintenanceWindowsBucket = []byte("maintenance-windows-bucket")
+
 	// Buckets for lowest signed source and target epoch for individual validator.
 	lowestSignedSourceBucket = []byte("lowest-signed-source-bucket")
 	lowestSignedTargetBucket = []byte("lowest-signed-target-bucket")
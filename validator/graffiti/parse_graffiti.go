@@ -2,9 +2,12 @@ package graffiti
 
 import (
 	"encoding/hex"
+	"fmt"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"gopkg.in/yaml.v2"
@@ -22,6 +25,14 @@ type Graffiti struct {
 	Ordered  []string                        `yaml:"ordered,omitempty"`
 	Random   []string                        `yaml:"random,omitempty"`
 	Specific map[types.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// SpecificPublicKeys maps 0x-prefixed, lowercase hex encoded validator public keys to their graffiti.
+	SpecificPublicKeys map[string]string `yaml:"specific_public_keys,omitempty"`
+}
+
+// ForPublicKey returns the graffiti specified for a validator public key, if any.
+func (g *Graffiti) ForPublicKey(pubKey [fieldparams.BLSPubkeyLength]byte) (string, bool) {
+	graffiti, ok := g.SpecificPublicKeys[hexutil.Encode(pubKey[:])]
+	return graffiti, ok
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -43,6 +54,21 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		g.Specific[i] = ParseHexGraffiti(o)
 	}
 
+	specificPublicKeys := make(map[string]string, len(g.SpecificPublicKeys))
+	for k, v := range g.SpecificPublicKeys {
+		pubKey, err := parsePublicKey(k)
+		if err != nil {
+			log.WithError(err).WithField("publicKey", k).Error("Ignoring graffiti of invalid validator public key")
+			continue
+		}
+		specificPublicKeys[pubKey] = ParseHexGraffiti(v)
+	}
+	if len(specificPublicKeys) > 0 {
+		g.SpecificPublicKeys = specificPublicKeys
+	} else {
+		g.SpecificPublicKeys = nil
+	}
+
 	for i, v := range g.Ordered {
 		g.Ordered[i] = ParseHexGraffiti(v)
 	}
@@ -57,6 +83,23 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	return g, nil
 }
 
+// parsePublicKey normalizes a hex encoded validator public key, with or without a 0x prefix,
+// to its 0x-prefixed lowercase form.
+func parsePublicKey(key string) (string, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if !strings.HasPrefix(key, hex0xPrefix) {
+		key = hex0xPrefix + key
+	}
+	pubKey, err := hexutil.Decode(key)
+	if err != nil {
+		return "", err
+	}
+	if len(pubKey) != fieldparams.BLSPubkeyLength {
+		return "", fmt.Errorf("public key has length %d, expected %d", len(pubKey), fieldparams.BLSPubkeyLength)
+	}
+	return hexutil.Encode(pubKey), nil
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
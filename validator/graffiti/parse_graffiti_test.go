@@ -1,12 +1,16 @@
 package graffiti
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 )
@@ -218,3 +222,36 @@ func TestParseHexGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGraffitiFile_PublicKeys(t *testing.T) {
+	pubKey := "0x" + strings.Repeat("ab", fieldparams.BLSPubkeyLength)
+	input := []byte(`
+specific_public_keys:
+  ` + strings.ToUpper(pubKey[2:]) + `: Yolo
+  0x1234: "Too short"
+  ` + "0x" + strings.Repeat("cd", fieldparams.BLSPubkeyLength) + `: "hex:0x4d656f77"`)
+
+	dirName := t.TempDir() + "somedir"
+	err := os.MkdirAll(dirName, os.ModePerm)
+	require.NoError(t, err)
+	someFileName := filepath.Join(dirName, "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	wanted := &Graffiti{
+		Hash: hash.Hash(input),
+		SpecificPublicKeys: map[string]string{
+			pubKey: "Yolo",
+			"0x" + strings.Repeat("cd", fieldparams.BLSPubkeyLength): "Meow",
+		},
+	}
+	require.DeepEqual(t, wanted, got)
+
+	g, ok := got.ForPublicKey(bytesutil.ToBytes48(bytes.Repeat([]byte{0xab}, fieldparams.BLSPubkeyLength)))
+	require.Equal(t, true, ok)
+	assert.Equal(t, "Yolo", g)
+	_, ok = got.ForPublicKey([fieldparams.BLSPubkeyLength]byte{})
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,64 @@
+package graffiti
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/async"
+)
+
+// reloadDebounceInterval is the time to wait for a graffiti file to settle after a change before reloading it.
+var reloadDebounceInterval = time.Second
+
+// WatchGraffitiFile listens for changes to the graffiti file at the given path and calls the handler with the
+// newly parsed graffiti whenever its contents change. Changes are debounced so that editors writing a file in
+// several steps only trigger a single reload, and files which fail to parse are ignored. This blocks until the
+// context is canceled.
+func WatchGraffitiFile(ctx context.Context, path string, handler func(*Graffiti)) {
+	path = filepath.Clean(path)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	// The directory of the file is watched rather than the file itself, as editors
+	// commonly replace a file on save which would silently stop a watch on the file.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not add file %s to file watcher", path)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+
+	go async.Debounce(ctx, reloadDebounceInterval, fileChangesChan, func(_ interface{}) {
+		g, err := ParseGraffitiFile(path)
+		if err != nil {
+			log.WithError(err).Errorf("Could not reload graffiti file %s", path)
+			return
+		}
+		log.WithField("path", path).Info("Reloaded graffiti file")
+		handler(g)
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != path || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			fileChangesChan <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
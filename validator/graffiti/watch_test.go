@@ -0,0 +1,34 @@
+package graffiti
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestWatchGraffitiFile(t *testing.T) {
+	reloadDebounceInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fileName := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, os.WriteFile(fileName, []byte(`default: "Mr T was here"`), os.ModePerm))
+
+	reloaded := make(chan *Graffiti, 1)
+	go WatchGraffitiFile(ctx, fileName, func(g *Graffiti) {
+		reloaded <- g
+	})
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, os.WriteFile(fileName, []byte(`default: "Mr R was here"`), os.ModePerm))
+
+	select {
+	case g := <-reloaded:
+		require.Equal(t, "Mr R was here", g.Default)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Graffiti file was not reloaded")
+	}
+}
@@ -0,0 +1,5 @@
+package interopsigner
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "interop-signer")
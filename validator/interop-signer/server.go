@@ -0,0 +1,156 @@
+// Package interopsigner implements a minimal remote signer holding the deterministic interop keys, which
+// serves the subset of the web3signer API used by the validator client. Many validator clients of a local
+// devnet can sign through it without generating and importing a keystore per validator.
+//
+// The signer has no slashing protection of its own and must only be used with interop keys on test networks.
+package interopsigner
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/runtime/interop"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	upcheckPath    = "/upcheck"
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+
+	readHeaderTimeout = 5 * time.Second
+)
+
+// signRequest holds the only field of a web3signer signing request used by the signer. The typed fields of
+// the request are not checked against the signing root.
+type signRequest struct {
+	SigningRoot hexutil.Bytes `json:"signingRoot"`
+}
+
+type signResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// Server signs with the interop keys of a range of validator indices over HTTP.
+type Server struct {
+	pubKeys []string
+	keys    map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	server  *http.Server
+}
+
+// New generates the interop keys of the validator indices starting at the given index and returns a signer
+// serving them on the given address.
+func New(startIndex, numKeys uint64, addr string) (*Server, error) {
+	if numKeys == 0 {
+		return nil, errors.New("no interop keys to sign with")
+	}
+	secretKeys, publicKeys, err := interop.DeterministicallyGenerateKeys(startIndex, numKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate interop keys")
+	}
+	s := &Server{
+		pubKeys: make([]string, len(publicKeys)),
+		keys:    make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, len(publicKeys)),
+	}
+	for i, pk := range publicKeys {
+		s.pubKeys[i] = hexutil.Encode(pk.Marshal())
+		s.keys[bytesutil.ToBytes48(pk.Marshal())] = secretKeys[i]
+	}
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	return s, nil
+}
+
+// Handler returns the HTTP handler of the signer API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(upcheckPath, s.handleUpcheck)
+	mux.HandleFunc(publicKeysPath, s.handlePublicKeys)
+	mux.HandleFunc(signPath, s.handleSign)
+	return mux
+}
+
+// Start listens on the address of the signer and serves requests in the background.
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", s.server.Addr)
+	}
+	log.WithFields(logrus.Fields{
+		"address":    ln.Addr().String(),
+		"publicKeys": len(s.pubKeys),
+	}).Info("Serving interop keys")
+	go func() {
+		if err := s.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Interop signer stopped")
+		}
+	}()
+	return nil
+}
+
+// Stop shuts the signer down, waiting for the pending requests to complete.
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) handleUpcheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, "OK")
+}
+
+func (s *Server) handlePublicKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, s.pubKeys)
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	pubKey, err := hexutil.Decode(strings.TrimPrefix(r.URL.Path, signPath))
+	if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+		http.Error(w, "invalid public key", http.StatusBadRequest)
+		return
+	}
+	sk, ok := s.keys[bytesutil.ToBytes48(pubKey)]
+	if !ok {
+		http.Error(w, "public key not found", http.StatusNotFound)
+		return
+	}
+	var req signRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid signing request", http.StatusBadRequest)
+		return
+	}
+	if len(req.SigningRoot) != fieldparams.RootLength {
+		http.Error(w, "invalid signing root", http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, &signResponse{Signature: sk.Sign(req.SigningRoot).Marshal()})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
@@ -0,0 +1,83 @@
+package interopsigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/runtime/interop"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestServer_PublicKeys(t *testing.T) {
+	s, err := New(3, 2, "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + publicKeysPath)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var got []string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+
+	_, want, err := interop.DeterministicallyGenerateKeys(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, len(want), len(got))
+	for i := range want {
+		assert.Equal(t, hexutil.Encode(want[i].Marshal()), got[i])
+	}
+}
+
+func TestServer_Sign(t *testing.T) {
+	s, err := New(0, 1, "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+	pubKey := s.pubKeys[0]
+	root := bytes.Repeat([]byte{'a'}, 32)
+
+	resp := postSignRequest(t, srv.URL+signPath+pubKey, &signRequest{SigningRoot: root})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var got signResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	require.NoError(t, resp.Body.Close())
+	sig, err := bls.SignatureFromBytes(got.Signature)
+	require.NoError(t, err)
+	pk, err := bls.PublicKeyFromBytes(hexutil.MustDecode(pubKey))
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(pk, root))
+
+	resp = postSignRequest(t, srv.URL+signPath+hexutil.Encode(make([]byte, 48)), &signRequest{SigningRoot: root})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	resp = postSignRequest(t, srv.URL+signPath+pubKey, &signRequest{SigningRoot: root[:31]})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	resp = postSignRequest(t, srv.URL+signPath+"0x1234", &signRequest{SigningRoot: root})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestNew_NoKeys(t *testing.T) {
+	_, err := New(0, 0, "127.0.0.1:0")
+	require.ErrorContains(t, "no interop keys", err)
+}
+
+func postSignRequest(t *testing.T, url string, req *signRequest) *http.Response {
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	return resp
+}
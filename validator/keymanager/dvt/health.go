@@ -0,0 +1,95 @@
+package dvt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// healthCheckTimeout bounds the time a cluster member has to answer a health check.
+const healthCheckTimeout = 2 * time.Second
+
+// member is a member of the distributed validator cluster, whose health is checked.
+type member struct {
+	url     string
+	name    string
+	healthy bool
+	checked bool
+}
+
+// monitorCluster checks the health of the cluster members every slot until the context is canceled.
+func (km *Keymanager) monitorCluster(ctx context.Context) {
+	client := &http.Client{Timeout: healthCheckTimeout}
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		km.checkCluster(ctx, client)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkCluster checks the health of all the cluster members concurrently.
+func (km *Keymanager) checkCluster(ctx context.Context, client *http.Client) {
+	var wg sync.WaitGroup
+	for _, m := range km.members {
+		wg.Add(1)
+		go func(m *member) {
+			defer wg.Done()
+			m.check(ctx, client)
+		}(m)
+	}
+	wg.Wait()
+}
+
+// check updates the health metrics of the member, and logs when it goes down or recovers.
+func (m *member) check(ctx context.Context, client *http.Client) {
+	start := time.Now()
+	err := m.ping(ctx, client)
+	clusterMemberResponseSeconds.WithLabelValues(m.name).Set(time.Since(start).Seconds())
+	if err != nil {
+		clusterMemberUp.WithLabelValues(m.name).Set(0)
+		clusterMemberFailedChecksTotal.WithLabelValues(m.name).Inc()
+		if m.healthy || !m.checked {
+			log.WithError(err).WithField("member", m.name).Warn("Distributed validator cluster member is not healthy")
+		}
+	} else {
+		clusterMemberUp.WithLabelValues(m.name).Set(1)
+		if !m.healthy && m.checked {
+			log.WithField("member", m.name).Info("Distributed validator cluster member is healthy again")
+		}
+	}
+	m.healthy = err == nil
+	m.checked = true
+}
+
+func (m *member) ping(ctx context.Context, client *http.Client) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close health check response body")
+		}
+	}()
+	if _, err := io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16)); err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+	return nil
+}
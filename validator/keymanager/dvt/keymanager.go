@@ -0,0 +1,113 @@
+// Package dvt defines a keymanager for distributed validators, whose keys are split between the members of
+// a cluster. Signing is delegated to the local distributed validator middleware, which exposes a Web3Signer
+// compatible API and returns the signature combined from the partial signatures of the cluster members.
+package dvt
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+)
+
+// latencyWeight is the weight of the previous estimate of the signing latency in its moving average, so
+// that a single slow signature does not shift the duty timing.
+const latencyWeight = 7
+
+// SetupConfig includes configuration values for initializing a distributed validator keymanager.
+type SetupConfig struct {
+	// Signer configures the Web3Signer compatible API of the local middleware.
+	Signer *remoteweb3signer.SetupConfig
+	// ClusterMembers are the URLs of the health endpoints of the middlewares of the cluster members.
+	ClusterMembers []string
+	// SigningLatency is the expected time taken by the cluster to combine the partial signatures.
+	SigningLatency time.Duration
+}
+
+// Keymanager signs through the middleware of a distributed validator cluster.
+type Keymanager struct {
+	*remoteweb3signer.Keymanager
+	signingLatency time.Duration
+	members        []*member
+
+	lock            sync.RWMutex
+	observedLatency time.Duration
+}
+
+// NewKeymanager instantiates a new distributed validator keymanager. The health of the cluster members is
+// checked until the context is canceled.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg == nil || cfg.Signer == nil {
+		return nil, errors.New("distributed validator middleware is not configured")
+	}
+	if cfg.SigningLatency < 0 {
+		return nil, errors.New("distributed validator signing latency cannot be negative")
+	}
+	members := make([]*member, 0, len(cfg.ClusterMembers))
+	for _, m := range cfg.ClusterMembers {
+		u, err := url.ParseRequestURI(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cluster member url %s is invalid", m)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("cluster member url must be in the format of http(s)://host:port/path, got %s", m)
+		}
+		members = append(members, &member{url: u.String(), name: u.Host})
+	}
+	signer, err := remoteweb3signer.NewKeymanager(ctx, cfg.Signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize distributed validator middleware client")
+	}
+	km := &Keymanager{
+		Keymanager:     signer,
+		signingLatency: cfg.SigningLatency,
+		members:        members,
+	}
+	if len(members) > 0 {
+		go km.monitorCluster(ctx)
+	}
+	return km, nil
+}
+
+// Sign signs the message through the middleware, which waits for enough partial signatures of the cluster
+// members to combine them into the signature of the validator.
+func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
+	start := time.Now()
+	sig, err := km.Keymanager.Sign(ctx, request)
+	if err != nil {
+		signErrorsTotal.Inc()
+		return nil, err
+	}
+	elapsed := time.Since(start)
+	signingLatencyHistogram.Observe(elapsed.Seconds())
+	km.observeLatency(elapsed)
+	return sig, nil
+}
+
+// SigningLatency returns how long the cluster is expected to take to sign a message: the configured
+// latency, or the moving average of the observed latencies if it is greater.
+func (km *Keymanager) SigningLatency() time.Duration {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.observedLatency > km.signingLatency {
+		return km.observedLatency
+	}
+	return km.signingLatency
+}
+
+func (km *Keymanager) observeLatency(latency time.Duration) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.observedLatency == 0 {
+		km.observedLatency = latency
+	} else {
+		km.observedLatency = (latencyWeight*km.observedLatency + latency) / (latencyWeight + 1)
+	}
+	observedSigningLatency.Set(km.observedLatency.Seconds())
+}
@@ -0,0 +1,108 @@
+package dvt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer/v1/mock"
+)
+
+func signerConfig(endpoint string) *remoteweb3signer.SetupConfig {
+	return &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          endpoint,
+		GenesisValidatorsRoot: bytesutil.PadTo([]byte{1}, 32),
+		ProvidedPublicKeys:    [][48]byte{{1}},
+	}
+}
+
+func TestNewKeymanager_InvalidConfig(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewKeymanager(ctx, &SetupConfig{})
+	require.ErrorContains(t, "not configured", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Signer: signerConfig("http://localhost:3600"), SigningLatency: -time.Second})
+	require.ErrorContains(t, "cannot be negative", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Signer: signerConfig("http://localhost:3600"), ClusterMembers: []string{"localhost"}})
+	require.ErrorContains(t, "cluster member url", err)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("message"))
+	middleware := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/v1/eth2/sign/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, err := w.Write([]byte(hexutil.Encode(sig.Marshal())))
+		require.NoError(t, err)
+	}))
+	defer middleware.Close()
+
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Signer:         signerConfig(middleware.URL),
+		SigningLatency: time.Millisecond,
+	})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{1}}, keys)
+
+	assert.Equal(t, time.Millisecond, km.SigningLatency())
+	got, err := km.Sign(context.Background(), mock.GetMockSignRequest("ATTESTATION"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), got.Marshal())
+	// The observed latency of the middleware exceeds the configured one.
+	assert.Equal(t, true, km.SigningLatency() >= 10*time.Millisecond)
+}
+
+func TestKeymanager_ObserveLatency(t *testing.T) {
+	km := &Keymanager{signingLatency: time.Second}
+	km.observeLatency(800 * time.Millisecond)
+	assert.Equal(t, time.Second, km.SigningLatency())
+	km.observeLatency(4 * time.Second)
+	assert.Equal(t, 1200*time.Millisecond, km.SigningLatency())
+}
+
+func TestKeymanager_CheckCluster(t *testing.T) {
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer up.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	km := &Keymanager{members: []*member{
+		{url: up.URL + "/readyz", name: "up"},
+		{url: unavailable.URL + "/readyz", name: "unavailable"},
+		{url: flaky.URL + "/readyz", name: "flaky"},
+	}}
+	client := &http.Client{Timeout: healthCheckTimeout}
+	km.checkCluster(context.Background(), client)
+	assert.Equal(t, true, km.members[0].healthy)
+	assert.Equal(t, false, km.members[1].healthy)
+	assert.Equal(t, true, km.members[2].healthy)
+
+	// The member goes down.
+	flaky.Close()
+	km.checkCluster(context.Background(), client)
+	assert.Equal(t, true, km.members[0].healthy)
+	assert.Equal(t, false, km.members[1].healthy)
+	assert.Equal(t, false, km.members[2].healthy)
+}
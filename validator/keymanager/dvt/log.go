@@ -0,0 +1,5 @@
+package dvt
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "dvt")
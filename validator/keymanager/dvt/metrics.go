@@ -0,0 +1,45 @@
+package dvt
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signingLatencyHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dvt_signing_latency_seconds",
+			Help:    "Time taken by the distributed validator middleware to return a combined signature.",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 1.5, 2, 3, 4},
+		},
+	)
+	observedSigningLatency = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dvt_observed_signing_latency_seconds",
+		Help: "Moving average of the time taken by the distributed validator middleware to return a combined signature.",
+	})
+	signErrorsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "dvt_sign_errors_total",
+		Help: "The number of sign requests which the distributed validator middleware failed to sign.",
+	})
+	clusterMemberUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dvt_cluster_member_up",
+			Help: "Whether the last health check of the distributed validator cluster member succeeded.",
+		},
+		[]string{"member"},
+	)
+	clusterMemberResponseSeconds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dvt_cluster_member_response_seconds",
+			Help: "Time taken by the distributed validator cluster member to answer the last health check.",
+		},
+		[]string{"member"},
+	)
+	clusterMemberFailedChecksTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dvt_cluster_member_failed_checks_total",
+			Help: "The number of failed health checks of the distributed validator cluster member.",
+		},
+		[]string{"member"},
+	)
+)
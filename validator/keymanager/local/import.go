@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/k0kubun/go-ansi"
 	"github.com/pkg/errors"
@@ -16,7 +18,14 @@ import (
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
-// ImportKeystores into the local keymanager from an external source.
+// importBatchSize is the number of decrypted keystores written to the wallet at once. Writing the
+// accounts in batches lets an interrupted import of many keystores be resumed, as the keys already
+// in the wallet are not decrypted again.
+const importBatchSize = 500
+
+// ImportKeystores into the local keymanager from an external source. The keystores are decrypted
+// in parallel across the available CPU cores, and the keystores of keys already in the wallet are
+// reported as duplicates.
 func (km *Keymanager) ImportKeystores(
 	ctx context.Context,
 	keystores []*keymanager.Keystore,
@@ -28,59 +37,116 @@ func (km *Keymanager) ImportKeystores(
 	if len(passwords) != len(keystores) {
 		return nil, ErrMismatchedNumPasswords
 	}
-	decryptor := keystorev4.New()
-	bar := initializeProgressBar(len(keystores), "Importing accounts...")
-	keys := map[string]string{}
 	statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(keystores))
-	var err error
-
-	for i := 0; i < len(keystores); i++ {
-		var privKeyBytes []byte
-		var pubKeyBytes []byte
-		privKeyBytes, pubKeyBytes, _, err = km.attemptDecryptKeystore(decryptor, keystores[i], passwords[i])
-		if err != nil {
+	keys := make(map[string]bool)
+	if km.accountsStore != nil {
+		for _, pubKey := range km.accountsStore.PublicKeys {
+			keys[string(pubKey)] = true
+		}
+	}
+	// Skip the decryption of the keystores whose public key is already in the wallet.
+	toDecrypt := make([]int, 0, len(keystores))
+	for i, keystore := range keystores {
+		pubKeyBytes, err := hex.DecodeString(keystore.Pubkey)
+		if err == nil && keys[string(pubKeyBytes)] {
 			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
-				Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
-				Message: err.Error(),
+				Status: ethpbservice.ImportedKeystoreStatus_DUPLICATE,
 			}
 			continue
 		}
-		if err := bar.Add(1); err != nil {
-			log.Error(err)
+		toDecrypt = append(toDecrypt, i)
+	}
+
+	bar := initializeProgressBar(len(toDecrypt), "Importing accounts...")
+	for start := 0; start < len(toDecrypt); start += importBatchSize {
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "import interrupted, the keys imported so far are saved")
+		}
+		end := start + importBatchSize
+		if end > len(toDecrypt) {
+			end = len(toDecrypt)
 		}
-		// if key exists prior to being added then output log that duplicate key was found
-		if _, ok := keys[string(pubKeyBytes)]; ok {
-			log.Warnf("Duplicate key in import will be ignored: %#x", pubKeyBytes)
+		batch := toDecrypt[start:end]
+		decrypted := km.decryptKeystores(keystores, passwords, batch, bar)
+		privKeys := make([][]byte, 0, len(batch))
+		pubKeys := make([][]byte, 0, len(batch))
+		for j, result := range decrypted {
+			i := batch[j]
+			if result.err != nil {
+				statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+					Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
+					Message: result.err.Error(),
+				}
+				continue
+			}
+			// if key exists prior to being added then output log that duplicate key was found
+			if keys[string(result.pubKey)] {
+				log.Warnf("Duplicate key in import will be ignored: %#x", result.pubKey)
+				statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+					Status: ethpbservice.ImportedKeystoreStatus_DUPLICATE,
+				}
+				continue
+			}
+			keys[string(result.pubKey)] = true
+			privKeys = append(privKeys, result.privKey)
+			pubKeys = append(pubKeys, result.pubKey)
 			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
-				Status: ethpbservice.ImportedKeystoreStatus_DUPLICATE,
+				Status: ethpbservice.ImportedKeystoreStatus_IMPORTED,
 			}
+		}
+		if len(privKeys) == 0 {
 			continue
 		}
-		keys[string(pubKeyBytes)] = string(privKeyBytes)
-		statuses[i] = &ethpbservice.ImportedKeystoreStatus{
-			Status: ethpbservice.ImportedKeystoreStatus_IMPORTED,
+		// Write the accounts to disk into a single keystore.
+		if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+			return nil, err
 		}
 	}
-	privKeys := make([][]byte, 0)
-	pubKeys := make([][]byte, 0)
-	for pubKey, privKey := range keys {
-		pubKeys = append(pubKeys, []byte(pubKey))
-		privKeys = append(privKeys, []byte(privKey))
-	}
+	return statuses, nil
+}
 
-	// Write the accounts to disk into a single keystore.
-	accountsKeystore, err := km.CreateAccountsKeystore(ctx, privKeys, pubKeys)
-	if err != nil {
-		return nil, err
+type decryptedKeystore struct {
+	privKey []byte
+	pubKey  []byte
+	err     error
+}
+
+// decryptKeystores decrypts the keystores at the given indices, with one worker per available CPU
+// core, as the key derivation of EIP-2335 keystores is deliberately slow.
+func (km *Keymanager) decryptKeystores(
+	keystores []*keymanager.Keystore, passwords []string, indices []int, bar *progressbar.ProgressBar,
+) []*decryptedKeystore {
+	results := make([]*decryptedKeystore, len(indices))
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(indices) {
+		workers = len(indices)
 	}
-	encodedAccounts, err := json.MarshalIndent(accountsKeystore, "", "\t")
-	if err != nil {
-		return nil, err
+	jobs := make(chan int, len(indices))
+	for j := range indices {
+		jobs <- j
 	}
-	if err := km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts); err != nil {
-		return nil, err
+	close(jobs)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decryptor := keystorev4.New()
+			for j := range jobs {
+				i := indices[j]
+				privKey, pubKey, _, err := km.attemptDecryptKeystore(decryptor, keystores[i], passwords[i])
+				results[j] = &decryptedKeystore{privKey: privKey, pubKey: pubKey, err: err}
+				if err != nil {
+					continue
+				}
+				if err := bar.Add(1); err != nil {
+					log.Error(err)
+				}
+			}
+		}()
 	}
-	return statuses, nil
+	wg.Wait()
+	return results
 }
 
 // ImportKeypairs directly into the keymanager.
@@ -190,4 +190,23 @@ func TestLocalKeymanager_ImportKeystores(t *testing.T) {
 			statuses[2].Message,
 		)
 	})
+	t.Run("keys already in the wallet are not decrypted again", func(t *testing.T) {
+		keystore1 := createRandomKeystore(t, password)
+		statuses, err := dr.ImportKeystores(ctx, []*keymanager.Keystore{keystore1}, []string{password})
+		require.NoError(t, err)
+		require.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, statuses[0].Status)
+		numKeys := len(dr.accountsStore.PublicKeys)
+
+		// The wrong password is never used, as the first keystore is skipped before its decryption.
+		keystore2 := createRandomKeystore(t, password)
+		statuses, err = dr.ImportKeystores(
+			ctx,
+			[]*keymanager.Keystore{keystore1, keystore2},
+			[]string{"foobar", password},
+		)
+		require.NoError(t, err)
+		require.Equal(t, ethpbservice.ImportedKeystoreStatus_DUPLICATE, statuses[0].Status)
+		require.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, statuses[1].Status)
+		require.Equal(t, numKeys+1, len(dr.accountsStore.PublicKeys))
+	})
 }
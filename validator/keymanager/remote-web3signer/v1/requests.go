@@ -224,9 +224,16 @@ func GetSyncCommitteeSelectionProofSignRequest(request *validatorpb.SignRequest,
 	if !ok {
 		return nil, errors.New("failed to cast request object to sync committee selection proof")
 	}
-	if syncCommitteeSelectionProof == nil {
+	if syncCommitteeSelectionProof == nil || syncCommitteeSelectionProof.SyncAggregatorSelectionData == nil {
 		return nil, errors.New("invalid sign request: SyncCommitteeSelectionProof is nil")
 	}
+	if syncCommitteeSelectionProof.SyncAggregatorSelectionData.Slot != request.SigningSlot {
+		return nil, fmt.Errorf(
+			"invalid sign request: selection data slot %d does not match signing slot %d",
+			syncCommitteeSelectionProof.SyncAggregatorSelectionData.Slot,
+			request.SigningSlot,
+		)
+	}
 	fork, err := MapForkInfo(request.SigningSlot, genesisValidatorsRoot)
 	if err != nil {
 		return nil, err
@@ -252,9 +259,16 @@ func GetSyncCommitteeContributionAndProofSignRequest(request *validatorpb.SignRe
 	if !ok {
 		return nil, errors.New("failed to cast request object to sync committee contribution and proof")
 	}
-	if syncCommitteeContributionAndProof == nil {
+	if syncCommitteeContributionAndProof == nil || syncCommitteeContributionAndProof.ContributionAndProof == nil {
 		return nil, errors.New("invalid sign request: SyncCommitteeContributionAndProof is nil")
 	}
+	if c := syncCommitteeContributionAndProof.ContributionAndProof.Contribution; c != nil && c.Slot != request.SigningSlot {
+		return nil, fmt.Errorf(
+			"invalid sign request: contribution slot %d does not match signing slot %d",
+			c.Slot,
+			request.SigningSlot,
+		)
+	}
 	fork, err := MapForkInfo(request.SigningSlot, genesisValidatorsRoot)
 	if err != nil {
 		return nil, err
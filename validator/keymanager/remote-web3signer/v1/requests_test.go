@@ -241,6 +241,18 @@ func TestGetSyncCommitteeContributionAndProofSignRequest(t *testing.T) {
 			want:    mock.MockSyncCommitteeContributionAndProofSignRequest(),
 			wantErr: false,
 		},
+		{
+			name: "Contribution slot does not match signing slot",
+			args: args{
+				request: func() *validatorpb.SignRequest {
+					r := mock.GetMockSignRequest("SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF")
+					r.SigningSlot = 1
+					return r
+				}(),
+				genesisValidatorsRoot: make([]byte, fieldparams.RootLength),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -311,6 +323,18 @@ func TestGetSyncCommitteeSelectionProofSignRequest(t *testing.T) {
 			want:    mock.MockSyncCommitteeSelectionProofSignRequest(),
 			wantErr: false,
 		},
+		{
+			name: "Selection data slot does not match signing slot",
+			args: args{
+				request: func() *validatorpb.SignRequest {
+					r := mock.GetMockSignRequest("SYNC_COMMITTEE_SELECTION_PROOF")
+					r.SigningSlot = 1
+					return r
+				}(),
+				genesisValidatorsRoot: make([]byte, fieldparams.RootLength),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -93,7 +93,7 @@ type SyncCommitteeMessageSignRequest struct {
 type SyncCommitteeSelectionProofSignRequest struct {
 	Type                        string                       `json:"type" validate:"required"`
 	ForkInfo                    *ForkInfo                    `json:"fork_info" validate:"required"`
-	SigningRoot                 hexutil.Bytes                `json:"signingRoot"`
+	SigningRoot                 hexutil.Bytes                `json:"signingRoot" validate:"required"`
 	SyncAggregatorSelectionData *SyncAggregatorSelectionData `json:"sync_aggregator_selection_data" validate:"required"`
 }
 
@@ -101,7 +101,7 @@ type SyncCommitteeSelectionProofSignRequest struct {
 type SyncCommitteeContributionAndProofSignRequest struct {
 	Type                 string                `json:"type" validate:"required"`
 	ForkInfo             *ForkInfo             `json:"fork_info" validate:"required"`
-	SigningRoot          hexutil.Bytes         `json:"signingRoot"`
+	SigningRoot          hexutil.Bytes         `json:"signingRoot" validate:"required"`
 	ContributionAndProof *ContributionAndProof `json:"contribution_and_proof" validate:"required"`
 }
 
@@ -321,9 +321,9 @@ type SyncAggregatorSelectionData struct {
 
 // ContributionAndProof a sub property of AggregatorSelectionSignRequest.
 type ContributionAndProof struct {
-	AggregatorIndex string                     `json:"aggregator_index"` /* uint64 */
-	SelectionProof  hexutil.Bytes              `json:"selection_proof"`  /* 96 byte hexadecimal */
-	Contribution    *SyncCommitteeContribution `json:"contribution"`
+	AggregatorIndex string                     `json:"aggregator_index"`                    /* uint64 */
+	SelectionProof  hexutil.Bytes              `json:"selection_proof" validate:"required"` /* 96 byte hexadecimal */
+	Contribution    *SyncCommitteeContribution `json:"contribution" validate:"required"`
 }
 
 // SyncCommitteeContribution a sub property of AggregatorSelectionSignRequest.
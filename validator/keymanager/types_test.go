@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
@@ -18,6 +19,7 @@ var (
 	_ = keymanager.IKeymanager(&local.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
 	_ = keymanager.IKeymanager(&remote.Keymanager{})
+	_ = keymanager.IKeymanager(&dvt.Keymanager{})
 
 	// More granular assertions.
 	_ = keymanager.KeysFetcher(&local.Keymanager{})
@@ -29,6 +31,8 @@ var (
 
 	_ = keymanager.PublicKeyAdder(&remoteweb3signer.Keymanager{})
 	_ = keymanager.PublicKeyDeleter(&remoteweb3signer.Keymanager{})
+	_ = keymanager.PublicKeyAdder(&dvt.Keymanager{})
+	_ = keymanager.PublicKeyDeleter(&dvt.Keymanager{})
 )
 
 func TestKeystoreContainsPath(t *testing.T) {
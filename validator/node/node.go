@@ -4,6 +4,7 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -31,6 +32,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	validatorServiceConfig "github.com/prysmaticlabs/prysm/config/validator/service"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
@@ -47,10 +49,14 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	remoteprotection "github.com/prysmaticlabs/prysm/validator/remote-protection"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	validatormiddleware "github.com/prysmaticlabs/prysm/validator/rpc/apimiddleware"
+	signingaudit "github.com/prysmaticlabs/prysm/validator/signing-audit"
+	"github.com/prysmaticlabs/prysm/validator/standby"
 	"github.com/prysmaticlabs/prysm/validator/web"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -118,6 +124,9 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
 			return nil, err
 		}
+		if err := params.ValidatePreset(params.BeaconConfig()); err != nil {
+			return nil, errors.Wrapf(err, "chain config file %s is not supported by this build", chainConfigFileName)
+		}
 	}
 
 	configureFastSSZHashingAlgorithm()
@@ -128,6 +137,9 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) || cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
 			log.Warn("Remote Keymanager API enabled. Prysm web does not properly support web3signer at this time")
 		}
+		if cliCtx.IsSet(flags.DVTMiddlewareURLFlag.Name) {
+			log.Warn("Prysm web does not properly support distributed validators at this time")
+		}
 		log.Info("Enabling web portal to manage the validator client")
 		if err := validatorClient.initializeForWeb(cliCtx); err != nil {
 			return nil, err
@@ -194,6 +206,8 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		// Custom Check For Web3Signer
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 			c.wallet = wallet.NewWalletForWeb3Signer()
+		} else if cliCtx.IsSet(flags.DVTMiddlewareURLFlag.Name) {
+			c.wallet = wallet.NewWalletForDVT()
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 				return nil, wallet.ErrNoWalletFound
@@ -275,6 +289,8 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		c.wallet = wallet.NewWalletForWeb3Signer()
+	} else if cliCtx.IsSet(flags.DVTMiddlewareURLFlag.Name) {
+		c.wallet = wallet.NewWalletForDVT()
 	} else {
 		// Read the wallet password file from the cli context.
 		if err = setWalletPasswordFilePath(cliCtx); err != nil {
@@ -391,12 +407,14 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	}
 
 	gStruct := &g.Graffiti{}
-	var err error
+	var graffitiFile string
 	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
-		n := c.cliCtx.String(flags.GraffitiFileFlag.Name)
-		gStruct, err = g.ParseGraffitiFile(n)
+		graffitiFile = c.cliCtx.String(flags.GraffitiFileFlag.Name)
+		parsed, err := g.ParseGraffitiFile(graffitiFile)
 		if err != nil {
 			log.WithError(err).Warn("Could not parse graffiti file")
+		} else {
+			gStruct = parsed
 		}
 	}
 
@@ -405,11 +423,37 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	dvtc, err := dvtConfig(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
 	bpc, err := proposerSettings(c.cliCtx)
 	if err != nil {
 		return err
 	}
 
+	standbyMonitor, err := c.registerHeartbeatServices(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	signingAudit, err := c.registerSigningAuditService(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	var remoteProtection *remoteprotection.Client
+	if cliCtx.IsSet(flags.RemoteSlashingProtectionURLFlag.Name) {
+		remoteProtection, err = remoteprotection.New(
+			cliCtx.String(flags.RemoteSlashingProtectionURLFlag.Name),
+			cliCtx.Duration(flags.RemoteSlashingProtectionTimeoutFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -427,9 +471,15 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		Wallet:                     c.wallet,
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
+		GraffitiFile:               graffitiFile,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
 		Web3SignerConfig:           wsc,
+		DVTConfig:                  dvtc,
 		ProposerSettings:           bpc,
+		PruningMargin:              types.Epoch(c.cliCtx.Uint64(flags.SlashingProtectionPruningMarginFlag.Name)),
+		Standby:                    standbyMonitor,
+		SigningAudit:               signingAudit,
+		RemoteProtection:           remoteProtection,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -438,6 +488,72 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(v)
 }
 
+// registerHeartbeatServices registers the heartbeat server of a primary validator client and the heartbeat
+// monitor of a hot standby, as configured by the flags. The monitor of a standby is returned so that the
+// validator service can skip duties and enforce the watermarks of the primary.
+func (c *ValidatorClient) registerHeartbeatServices(cliCtx *cli.Context) (*standby.Monitor, error) {
+	serve := cliCtx.IsSet(flags.HeartbeatAddrFlag.Name)
+	follow := cliCtx.IsSet(flags.StandbyPrimaryURLFlag.Name)
+	if !serve && !follow {
+		return nil, nil
+	}
+	if !cliCtx.IsSet(flags.HeartbeatSecretFileFlag.Name) {
+		return nil, fmt.Errorf("--%s is required to serve or follow a heartbeat", flags.HeartbeatSecretFileFlag.Name)
+	}
+	secret, err := file.ReadFileAsBytes(cliCtx.String(flags.HeartbeatSecretFileFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read heartbeat secret")
+	}
+	secret = bytes.TrimSpace(secret)
+	if serve {
+		server, err := standby.NewServer(c.db, cliCtx.String(flags.HeartbeatAddrFlag.Name), secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize heartbeat server")
+		}
+		if err := c.services.RegisterService(server); err != nil {
+			return nil, err
+		}
+	}
+	if !follow {
+		return nil, nil
+	}
+	monitor, err := standby.NewMonitor(
+		cliCtx.Context,
+		c.db,
+		cliCtx.String(flags.StandbyPrimaryURLFlag.Name),
+		secret,
+		standby.DefaultPollInterval,
+		cliCtx.Duration(flags.StandbyFailoverTimeoutFlag.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize heartbeat monitor")
+	}
+	if err := c.services.RegisterService(monitor); err != nil {
+		return nil, err
+	}
+	return monitor, nil
+}
+
+// registerSigningAuditService registers the signing audit log, when enabled by the flags. It is registered
+// before the validator service, so that it is stopped after the last sign request was recorded.
+func (c *ValidatorClient) registerSigningAuditService(cliCtx *cli.Context) (*signingaudit.Log, error) {
+	if !cliCtx.IsSet(flags.SigningAuditLogDirFlag.Name) {
+		return nil, nil
+	}
+	auditLog, err := signingaudit.New(
+		cliCtx.String(flags.SigningAuditLogDirFlag.Name),
+		int64(cliCtx.Uint64(flags.SigningAuditLogMaxSizeFlag.Name))*1024*1024,
+		cliCtx.Int(flags.SigningAuditLogMaxFilesFlag.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize signing audit log")
+	}
+	if err := c.services.RegisterService(auditLog); err != nil {
+		return nil, err
+	}
+	return auditLog, nil
+}
+
 func web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
 	var web3signerConfig *remoteweb3signer.SetupConfig
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
@@ -456,35 +572,73 @@ func web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
 		}
-		if cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
-			publicKeysSlice := cliCtx.StringSlice(flags.Web3SignerPublicValidatorKeysFlag.Name)
-			pks := make([]string, 0)
-			if len(publicKeysSlice) == 1 {
-				pURL, err := url.ParseRequestURI(publicKeysSlice[0])
-				if err == nil && pURL.Scheme != "" && pURL.Host != "" {
-					web3signerConfig.PublicKeysURL = publicKeysSlice[0]
-				} else {
-					pks = strings.Split(publicKeysSlice[0], ",")
-				}
-			} else if len(publicKeysSlice) > 1 {
-				pks = publicKeysSlice
-			}
-			if len(pks) > 0 {
-				var validatorKeys [][48]byte
-				for _, key := range pks {
-					decodedKey, decodeErr := hexutil.Decode(key)
-					if decodeErr != nil {
-						return nil, errors.Wrapf(decodeErr, "could not decode public key for web3signer: %s", key)
-					}
-					validatorKeys = append(validatorKeys, bytesutil.ToBytes48(decodedKey))
-				}
-				web3signerConfig.ProvidedPublicKeys = validatorKeys
-			}
+		if err := setSignerPublicKeys(cliCtx, flags.Web3SignerPublicValidatorKeysFlag.Name, "web3signer", web3signerConfig); err != nil {
+			return nil, err
 		}
 	}
 	return web3signerConfig, nil
 }
 
+// setSignerPublicKeys sets the public keys of the signer config from the flag, which holds either a list of
+// public keys or the URL to retrieve them from.
+func setSignerPublicKeys(cliCtx *cli.Context, flagName, signerName string, cfg *remoteweb3signer.SetupConfig) error {
+	if !cliCtx.IsSet(flagName) {
+		return nil
+	}
+	publicKeysSlice := cliCtx.StringSlice(flagName)
+	pks := make([]string, 0)
+	if len(publicKeysSlice) == 1 {
+		pURL, err := url.ParseRequestURI(publicKeysSlice[0])
+		if err == nil && pURL.Scheme != "" && pURL.Host != "" {
+			cfg.PublicKeysURL = publicKeysSlice[0]
+		} else {
+			pks = strings.Split(publicKeysSlice[0], ",")
+		}
+	} else if len(publicKeysSlice) > 1 {
+		pks = publicKeysSlice
+	}
+	if len(pks) > 0 {
+		var validatorKeys [][48]byte
+		for _, key := range pks {
+			decodedKey, decodeErr := hexutil.Decode(key)
+			if decodeErr != nil {
+				return errors.Wrapf(decodeErr, "could not decode public key for %s: %s", signerName, key)
+			}
+			validatorKeys = append(validatorKeys, bytesutil.ToBytes48(decodedKey))
+		}
+		cfg.ProvidedPublicKeys = validatorKeys
+	}
+	return nil
+}
+
+// dvtConfig returns the config of the keymanager of a distributed validator, if the validator client signs
+// through the middleware of a distributed validator cluster.
+func dvtConfig(cliCtx *cli.Context) (*dvt.SetupConfig, error) {
+	if !cliCtx.IsSet(flags.DVTMiddlewareURLFlag.Name) {
+		return nil, nil
+	}
+	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		return nil, fmt.Errorf("cannot specify both %s and %s", flags.Web3SignerURLFlag.Name, flags.DVTMiddlewareURLFlag.Name)
+	}
+	urlStr := cliCtx.String(flags.DVTMiddlewareURLFlag.Name)
+	u, err := url.ParseRequestURI(urlStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "distributed validator middleware url %s is invalid", urlStr)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("distributed validator middleware url must be in the format of http(s)://host:port url used: %v", urlStr)
+	}
+	signer := &remoteweb3signer.SetupConfig{BaseEndpoint: u.String()}
+	if err := setSignerPublicKeys(cliCtx, flags.DVTPublicKeysFlag.Name, "distributed validator", signer); err != nil {
+		return nil, err
+	}
+	return &dvt.SetupConfig{
+		Signer:         signer,
+		ClusterMembers: cliCtx.StringSlice(flags.DVTClusterMembersFlag.Name),
+		SigningLatency: cliCtx.Duration(flags.DVTSigningLatencyFlag.Name),
+	}, nil
+}
+
 func proposerSettings(cliCtx *cli.Context) (*validatorServiceConfig.ProposerSettings, error) {
 	var fileConfig *validatorServiceConfig.ProposerSettingsPayload
 
@@ -730,6 +884,9 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		gateway.WithApiMiddleware(&validatormiddleware.ValidatorEndpointFactory{}),
 		gateway.WithMuxHandler(muxHandler),
 		gateway.WithTimeout(uint64(timeout)),
+		gateway.WithSocketPath(cliCtx.String(flags.GRPCGatewaySocket.Name)),
+		gateway.WithSocketActivation(cliCtx.Bool(flags.GRPCGatewaySocketActivation.Name)),
+		gateway.WithPathPrefix(cliCtx.String(flags.GRPCGatewayPathPrefix.Name)),
 	}
 	gw, err := gateway.New(cliCtx.Context, opts...)
 	if err != nil {
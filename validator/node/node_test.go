@@ -22,9 +22,11 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/dvt"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
+	"time"
 )
 
 // Test that the sharding node can build with default flag values.
@@ -214,6 +216,64 @@ func TestWeb3SignerConfig(t *testing.T) {
 	}
 }
 
+func TestDVTConfig(t *testing.T) {
+	pubkey, err := hexutil.Decode("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c")
+	require.NoError(t, err)
+
+	newContext := func(t *testing.T, values map[string][]string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet(t.Name(), 0)
+		set.String(flags.DVTMiddlewareURLFlag.Name, "", "")
+		set.String(flags.Web3SignerURLFlag.Name, "", "")
+		set.Duration(flags.DVTSigningLatencyFlag.Name, flags.DVTSigningLatencyFlag.Value, "")
+		require.NoError(t, (&cli.StringSliceFlag{Name: flags.DVTPublicKeysFlag.Name}).Apply(set))
+		require.NoError(t, (&cli.StringSliceFlag{Name: flags.DVTClusterMembersFlag.Name}).Apply(set))
+		for name, vals := range values {
+			for _, v := range vals {
+				require.NoError(t, set.Set(name, v))
+			}
+		}
+		return cli.NewContext(&app, set, nil)
+	}
+
+	got, err := dvtConfig(newContext(t, nil))
+	require.NoError(t, err)
+	require.Equal(t, (*dvt.SetupConfig)(nil), got)
+
+	got, err = dvtConfig(newContext(t, map[string][]string{
+		flags.DVTMiddlewareURLFlag.Name:  {"http://localhost:3600"},
+		flags.DVTPublicKeysFlag.Name:     {hexutil.Encode(pubkey)},
+		flags.DVTClusterMembersFlag.Name: {"http://10.0.0.2:3620/readyz", "http://10.0.0.3:3620/readyz"},
+		flags.DVTSigningLatencyFlag.Name: {"1s"},
+	}))
+	require.NoError(t, err)
+	require.DeepEqual(t, &dvt.SetupConfig{
+		Signer: &remoteweb3signer.SetupConfig{
+			BaseEndpoint:       "http://localhost:3600",
+			ProvidedPublicKeys: [][48]byte{bytesutil.ToBytes48(pubkey)},
+		},
+		ClusterMembers: []string{"http://10.0.0.2:3620/readyz", "http://10.0.0.3:3620/readyz"},
+		SigningLatency: time.Second,
+	}, got)
+
+	_, err = dvtConfig(newContext(t, map[string][]string{
+		flags.DVTMiddlewareURLFlag.Name: {"http://localhost:3600"},
+		flags.Web3SignerURLFlag.Name:    {"http://localhost:9000"},
+	}))
+	require.ErrorContains(t, "cannot specify both", err)
+
+	_, err = dvtConfig(newContext(t, map[string][]string{
+		flags.DVTMiddlewareURLFlag.Name: {"localhost"},
+	}))
+	require.ErrorContains(t, "distributed validator middleware url", err)
+
+	_, err = dvtConfig(newContext(t, map[string][]string{
+		flags.DVTMiddlewareURLFlag.Name: {"http://localhost:3600"},
+		flags.DVTPublicKeysFlag.Name:    {"0x1234,localhost"},
+	}))
+	require.ErrorContains(t, "could not decode public key for distributed validator", err)
+}
+
 func TestProposerSettings(t *testing.T) {
 	hook := logtest.NewGlobal()
 
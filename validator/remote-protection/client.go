@@ -0,0 +1,151 @@
+// Package remoteprotection queries an external slashing protection service before the validator client signs
+// blocks and attestations, for setups in which several validator clients, or the nodes of a distributed
+// validator, share keys and need a common view of what was signed. Checks fail closed: a message is only
+// signed once the service explicitly allowed it.
+package remoteprotection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const (
+	proposalPath    = "/v1/check/proposal"
+	attestationPath = "/v1/check/attestation"
+	// maxResponseSize bounds the size of a response read from the service.
+	maxResponseSize = 1 << 16
+)
+
+// ErrSlashable is returned when the remote slashing protection service refuses a message.
+var ErrSlashable = errors.New("rejected by remote slashing protection")
+
+// ProposalRequest is the request to check a block proposal.
+type ProposalRequest struct {
+	PublicKey   hexutil.Bytes `json:"public_key"`
+	Slot        types.Slot    `json:"slot"`
+	SigningRoot hexutil.Bytes `json:"signing_root"`
+}
+
+// AttestationRequest is the request to check an attestation.
+type AttestationRequest struct {
+	PublicKey   hexutil.Bytes `json:"public_key"`
+	SourceEpoch types.Epoch   `json:"source_epoch"`
+	TargetEpoch types.Epoch   `json:"target_epoch"`
+	SigningRoot hexutil.Bytes `json:"signing_root"`
+}
+
+// Response is the answer of the service to a check. The message may only be signed if it is allowed.
+type Response struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Client checks messages against a remote slashing protection service.
+type Client struct {
+	url    string
+	client *http.Client
+}
+
+// New returns a client of the remote slashing protection service at the URL. Checks which take longer than
+// the timeout fail.
+func New(serviceURL string, timeout time.Duration) (*Client, error) {
+	u, err := url.ParseRequestURI(serviceURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote slashing protection url %s is invalid", serviceURL)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("remote slashing protection url must be in the format of http(s)://host:port, got %s", serviceURL)
+	}
+	if timeout <= 0 {
+		return nil, errors.New("remote slashing protection timeout must be positive")
+	}
+	return &Client{
+		url:    u.String(),
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// CheckProposal asks the service whether the block with the signing root may be signed.
+func (c *Client) CheckProposal(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	return c.check(ctx, "proposal", proposalPath, &ProposalRequest{
+		PublicKey:   pubKey[:],
+		Slot:        slot,
+		SigningRoot: signingRoot[:],
+	})
+}
+
+// CheckAttestation asks the service whether the attestation with the signing root may be signed.
+func (c *Client) CheckAttestation(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, data *ethpb.AttestationData, signingRoot [32]byte,
+) error {
+	if data == nil || data.Source == nil || data.Target == nil {
+		return errors.New("nil attestation data")
+	}
+	return c.check(ctx, "attestation", attestationPath, &AttestationRequest{
+		PublicKey:   pubKey[:],
+		SourceEpoch: data.Source.Epoch,
+		TargetEpoch: data.Target.Epoch,
+		SigningRoot: signingRoot[:],
+	})
+}
+
+func (c *Client) check(ctx context.Context, msgType, path string, msg interface{}) error {
+	start := time.Now()
+	resp, err := c.post(ctx, path, msg)
+	checkLatency.WithLabelValues(msgType).Observe(time.Since(start).Seconds())
+	if err != nil {
+		checkRefused.WithLabelValues(msgType, "error").Inc()
+		return errors.Wrap(err, "could not check message with remote slashing protection, refusing to sign")
+	}
+	if !resp.Allowed {
+		checkRefused.WithLabelValues(msgType, "slashable").Inc()
+		if resp.Reason != "" {
+			return fmt.Errorf("%w: %s", ErrSlashable, resp.Reason)
+		}
+		return ErrSlashable
+	}
+	return nil
+}
+
+func (c *Client) post(ctx context.Context, path string, msg interface{}) (*Response, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close remote slashing protection response body")
+		}
+	}()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected remote slashing protection response status %d", httpResp.StatusCode)
+	}
+	resp := &Response{}
+	if err := json.NewDecoder(io.LimitReader(httpResp.Body, maxResponseSize)).Decode(resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode remote slashing protection response")
+	}
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package remoteprotection
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestClient_CheckProposal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, proposalPath, r.URL.Path)
+		req := &ProposalRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		resp := &Response{Allowed: req.Slot > 10}
+		if !resp.Allowed {
+			resp.Reason = "double proposal"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	c, err := New(srv.URL, time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, c.CheckProposal(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, 11, [32]byte{2}))
+	err = c.CheckProposal(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, 10, [32]byte{2})
+	assert.Equal(t, true, errors.Is(err, ErrSlashable))
+	assert.ErrorContains(t, "double proposal", err)
+}
+
+func TestClient_CheckAttestation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, attestationPath, r.URL.Path)
+		req := &AttestationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.NoError(t, json.NewEncoder(w).Encode(&Response{Allowed: req.TargetEpoch > req.SourceEpoch}))
+	}))
+	defer srv.Close()
+	c, err := New(srv.URL, time.Second)
+	require.NoError(t, err)
+
+	data := &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 1}, Target: &ethpb.Checkpoint{Epoch: 2}}
+	require.NoError(t, c.CheckAttestation(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, data, [32]byte{}))
+	data.Target.Epoch = 1
+	err = c.CheckAttestation(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, data, [32]byte{})
+	assert.Equal(t, true, errors.Is(err, ErrSlashable))
+}
+
+func TestClient_FailsClosed(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+		},
+		{
+			name: "invalid response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, err := w.Write([]byte("allowed"))
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "timeout",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+				require.NoError(t, json.NewEncoder(w).Encode(&Response{Allowed: true}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.handler)
+			defer srv.Close()
+			c, err := New(srv.URL, 50*time.Millisecond)
+			require.NoError(t, err)
+			err = c.CheckProposal(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, 1, [32]byte{})
+			assert.ErrorContains(t, "refusing to sign", err)
+		})
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := New("localhost:8000", time.Second)
+	require.ErrorContains(t, "url", err)
+	_, err = New("http://localhost:8000", 0)
+	require.ErrorContains(t, "timeout", err)
+}
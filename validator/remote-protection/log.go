@@ -0,0 +1,5 @@
+package remoteprotection
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "remote-protection")
@@ -0,0 +1,24 @@
+package remoteprotection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	checkLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_slashing_protection_latency_seconds",
+			Help:    "Time taken by the remote slashing protection service to check a message before it is signed.",
+			Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+		},
+		[]string{"type"},
+	)
+	checkRefused = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "remote_slashing_protection_refused_total",
+			Help: "The number of messages which were not signed after their check by the remote slashing protection service.",
+		},
+		[]string{"type", "reason"},
+	)
+)
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListMaintenanceWindows returns the maintenance windows of the validator keys, during which their
+// duties are skipped.
+func (s *Server) ListMaintenanceWindows(ctx context.Context, _ *empty.Empty) (*pb.MaintenanceWindowsResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	windows, err := s.valDB.MaintenanceWindows(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get maintenance windows: %v", err)
+	}
+	res := &pb.MaintenanceWindowsResponse{
+		Windows: make([]*pb.MaintenanceWindow, 0, len(windows)),
+	}
+	for pubKey, window := range windows {
+		key := pubKey
+		res.Windows = append(res.Windows, &pb.MaintenanceWindow{
+			PublicKey:  key[:],
+			StartEpoch: uint64(window.StartEpoch),
+			EndEpoch:   uint64(window.EndEpoch),
+		})
+	}
+	return res, nil
+}
+
+// SetMaintenanceWindow marks the validator keys specified in a request inactive from its start epoch
+// until its end epoch, replacing their previous maintenance windows. The validator client skips all the
+// duties of these keys during the window.
+func (s *Server) SetMaintenanceWindow(ctx context.Context, req *pb.SetMaintenanceWindowRequest) (*empty.Empty, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified")
+	}
+	if req.EndEpoch <= req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "End epoch %d must be greater than start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+	if err := validatePublicKeys(req.PublicKeys); err != nil {
+		return nil, err
+	}
+	window := &kv.MaintenanceWindow{
+		StartEpoch: types.Epoch(req.StartEpoch),
+		EndEpoch:   types.Epoch(req.EndEpoch),
+	}
+	for _, key := range req.PublicKeys {
+		if err := s.valDB.SaveMaintenanceWindow(ctx, bytesutil.ToBytes48(key), window); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save maintenance window: %v", err)
+		}
+		log.WithFields(logrus.Fields{
+			"pubKey":     fmt.Sprintf("%#x", key),
+			"startEpoch": window.StartEpoch,
+			"endEpoch":   window.EndEpoch,
+		}).Info("Set validator maintenance window")
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteMaintenanceWindows deletes the maintenance windows of the validator keys specified in a request,
+// so that they resume their duties.
+func (s *Server) DeleteMaintenanceWindows(ctx context.Context, req *pb.DeleteMaintenanceWindowsRequest) (*empty.Empty, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified")
+	}
+	if err := validatePublicKeys(req.PublicKeys); err != nil {
+		return nil, err
+	}
+	for _, key := range req.PublicKeys {
+		if err := s.valDB.DeleteMaintenanceWindow(ctx, bytesutil.ToBytes48(key)); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not delete maintenance window: %v", err)
+		}
+		log.WithField("pubKey", fmt.Sprintf("%#x", key)).Info("Deleted validator maintenance window")
+	}
+	return &empty.Empty{}, nil
+}
+
+func validatePublicKeys(keys [][]byte) error {
+	for _, key := range keys {
+		if len(key) != fieldparams.BLSPubkeyLength {
+			return status.Errorf(codes.InvalidArgument, "Invalid public key length %d for %#x", len(key), key)
+		}
+	}
+	return nil
+}
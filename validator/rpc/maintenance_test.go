@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	mocks "github.com/prysmaticlabs/prysm/validator/testing"
+)
+
+func TestServer_MaintenanceWindows(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	s := &Server{}
+
+	_, err = s.ListMaintenanceWindows(ctx, &empty.Empty{})
+	require.ErrorContains(t, "Validator database not yet initialized", err)
+
+	s.valDB = dbtest.SetupDB(t, pubKeys)
+	_, err = s.SetMaintenanceWindow(ctx, &pb.SetMaintenanceWindowRequest{StartEpoch: 1, EndEpoch: 2})
+	require.ErrorContains(t, "No public keys specified", err)
+	_, err = s.SetMaintenanceWindow(ctx, &pb.SetMaintenanceWindowRequest{
+		PublicKeys: [][]byte{pubKeys[0][:]},
+		StartEpoch: 2,
+		EndEpoch:   2,
+	})
+	require.ErrorContains(t, "End epoch 2 must be greater than start epoch 2", err)
+	_, err = s.SetMaintenanceWindow(ctx, &pb.SetMaintenanceWindowRequest{
+		PublicKeys: [][]byte{{1, 2, 3}},
+		StartEpoch: 1,
+		EndEpoch:   2,
+	})
+	require.ErrorContains(t, "Invalid public key length", err)
+
+	_, err = s.SetMaintenanceWindow(ctx, &pb.SetMaintenanceWindowRequest{
+		PublicKeys: [][]byte{pubKeys[0][:], pubKeys[1][:]},
+		StartEpoch: 10,
+		EndEpoch:   20,
+	})
+	require.NoError(t, err)
+	res, err := s.ListMaintenanceWindows(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Windows))
+	for _, window := range res.Windows {
+		assert.Equal(t, uint64(10), window.StartEpoch)
+		assert.Equal(t, uint64(20), window.EndEpoch)
+	}
+
+	_, err = s.DeleteMaintenanceWindows(ctx, &pb.DeleteMaintenanceWindowsRequest{
+		PublicKeys: [][]byte{pubKeys[0][:]},
+	})
+	require.NoError(t, err)
+	res, err = s.ListMaintenanceWindows(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Windows))
+	assert.DeepEqual(t, pubKeys[1][:], res.Windows[0].PublicKey)
+}
@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorPerformanceReport returns the recent performance of the validating keys tracked by the validator
+// client, such as their attestation success and inclusion distance, their balance changes and their sync committee
+// participation, along with their upcoming duties.
+func (s *Server) GetValidatorPerformanceReport(
+	ctx context.Context, req *pb.ValidatorPerformanceReportRequest,
+) (*pb.ValidatorPerformanceReportResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not yet initialized")
+	}
+	if err := validatePublicKeys(req.PublicKeys); err != nil {
+		return nil, err
+	}
+	genesis, err := s.genesisFetcher.GenesisInfo(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get genesis info: %v", err)
+	}
+	currentSlot := slots.CurrentSlot(uint64(time.Unix(genesis.GenesisTime.Seconds, 0).Unix()))
+
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.PublicKeys))
+	for i, key := range req.PublicKeys {
+		pubKeys[i] = bytesutil.ToBytes48(key)
+	}
+	reports := s.validatorService.PerformanceReport(pubKeys, currentSlot)
+	res := &pb.ValidatorPerformanceReportResponse{
+		Reports: make([]*pb.ValidatorPerformanceReport, len(reports)),
+	}
+	for i, report := range reports {
+		res.Reports[i] = performanceReportToProto(report)
+	}
+	return res, nil
+}
+
+func performanceReportToProto(report *client.KeyPerformance) *pb.ValidatorPerformanceReport {
+	res := &pb.ValidatorPerformanceReport{
+		PublicKey:                      bytesutil.SafeCopyBytes(report.PublicKey[:]),
+		Epochs:                         make([]*pb.EpochPerformance, len(report.Epochs)),
+		SyncCommitteeMessagesSubmitted: report.SyncCommitteeMessagesSubmitted,
+		SyncCommitteeMessagesFailed:    report.SyncCommitteeMessagesFailed,
+		NextProposalSlots:              make([]uint64, len(report.NextProposalSlots)),
+		InSyncCommittee:                report.InSyncCommittee,
+	}
+	var correctTarget, includedCount uint64
+	var totalDistance uint64
+	for i, e := range report.Epochs {
+		res.Epochs[i] = &pb.EpochPerformance{
+			Epoch:                uint64(e.Epoch),
+			CorrectlyVotedSource: e.CorrectlyVotedSource,
+			CorrectlyVotedTarget: e.CorrectlyVotedTarget,
+			CorrectlyVotedHead:   e.CorrectlyVotedHead,
+			InclusionDistance:    uint64(e.InclusionDistance),
+			Balance:              e.BalanceAfter,
+			BalanceDelta:         e.BalanceDelta(),
+		}
+		if e.CorrectlyVotedTarget {
+			correctTarget++
+		}
+		if e.InclusionDistance > 0 {
+			includedCount++
+			totalDistance += uint64(e.InclusionDistance)
+		}
+	}
+	if len(report.Epochs) > 0 {
+		res.AttestationSuccessRate = float64(correctTarget) / float64(len(report.Epochs))
+	}
+	if includedCount > 0 {
+		res.AverageInclusionDistance = float64(totalDistance) / float64(includedCount)
+	}
+	if report.NextAttestationSlot != nil {
+		res.HasNextAttestation = true
+		res.NextAttestationSlot = uint64(*report.NextAttestationSlot)
+	}
+	for i, slot := range report.NextProposalSlots {
+		res.NextProposalSlots[i] = uint64(slot)
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+func TestServer_GetValidatorPerformanceReport(t *testing.T) {
+	ctx := context.Background()
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+		genesisFetcher:   &mockGenesisFetcher{},
+	}
+
+	_, err = s.GetValidatorPerformanceReport(ctx, &pb.ValidatorPerformanceReportRequest{PublicKeys: [][]byte{{1}}})
+	require.ErrorContains(t, "Invalid public key length", err)
+
+	pubKey := make([]byte, fieldparams.BLSPubkeyLength)
+	res, err := s.GetValidatorPerformanceReport(ctx, &pb.ValidatorPerformanceReportRequest{PublicKeys: [][]byte{pubKey}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Reports))
+	assert.DeepEqual(t, pubKey, res.Reports[0].PublicKey)
+	assert.Equal(t, 0, len(res.Reports[0].Epochs))
+	assert.Equal(t, false, res.Reports[0].HasNextAttestation)
+}
+
+func TestPerformanceReportToProto(t *testing.T) {
+	nextAttestationSlot := types.Slot(12)
+	report := &client.KeyPerformance{
+		PublicKey: [fieldparams.BLSPubkeyLength]byte{1},
+		Epochs: []*client.EpochPerformance{
+			{Epoch: 1, CorrectlyVotedSource: true, CorrectlyVotedTarget: true, InclusionDistance: 1, BalanceBefore: 32e9, BalanceAfter: 32e9 + 10},
+			{Epoch: 2, CorrectlyVotedSource: true, InclusionDistance: 3, BalanceBefore: 32e9 + 10, BalanceAfter: 32e9 + 5},
+			{Epoch: 3, BalanceBefore: 32e9 + 5, BalanceAfter: 32e9},
+			{Epoch: 4, CorrectlyVotedSource: true, CorrectlyVotedTarget: true, CorrectlyVotedHead: true, BalanceBefore: 32e9, BalanceAfter: 32e9 + 20},
+		},
+		SyncCommitteeMessagesSubmitted: 5,
+		SyncCommitteeMessagesFailed:    1,
+		NextAttestationSlot:            &nextAttestationSlot,
+		NextProposalSlots:              []types.Slot{20},
+		InSyncCommittee:                true,
+	}
+	res := performanceReportToProto(report)
+	require.Equal(t, 4, len(res.Epochs))
+	assert.Equal(t, int64(10), res.Epochs[0].BalanceDelta)
+	assert.Equal(t, int64(-5), res.Epochs[1].BalanceDelta)
+	assert.Equal(t, uint64(32e9+20), res.Epochs[3].Balance)
+	assert.Equal(t, 0.5, res.AttestationSuccessRate)
+	assert.Equal(t, 2.0, res.AverageInclusionDistance)
+	assert.Equal(t, uint64(5), res.SyncCommitteeMessagesSubmitted)
+	assert.Equal(t, uint64(1), res.SyncCommitteeMessagesFailed)
+	assert.Equal(t, true, res.HasNextAttestation)
+	assert.Equal(t, uint64(12), res.NextAttestationSlot)
+	assert.DeepEqual(t, []uint64{20}, res.NextProposalSlots)
+	assert.Equal(t, true, res.InSyncCommittee)
+}
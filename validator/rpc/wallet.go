@@ -131,7 +131,8 @@ func (s *Server) WalletConfig(_ context.Context, _ *empty.Empty) (*pb.WalletResp
 		keymanagerKind = pb.KeymanagerKind_IMPORTED
 	case keymanager.Remote:
 		keymanagerKind = pb.KeymanagerKind_REMOTE
-	case keymanager.Web3Signer:
+	case keymanager.Web3Signer, keymanager.DVT:
+		// The middleware of a distributed validator exposes the Web3Signer API.
 		keymanagerKind = pb.KeymanagerKind_WEB3SIGNER
 	}
 
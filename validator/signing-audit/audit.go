@@ -0,0 +1,203 @@
+// Package signingaudit keeps an append-only log of every sign request of the validator client, for
+// compliance and post-incident forensics. The log is written as JSON lines to a file which is rotated
+// once it reaches a maximum size.
+package signingaudit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/io/file"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+)
+
+const (
+	// FileName is the name of the audit log file which records are appended to.
+	FileName = "signing-audit.log"
+	// Rotated audit log files are named after the time of their rotation, so that they sort in
+	// chronological order.
+	rotatedFilePrefix = "signing-audit-"
+	rotatedFileSuffix = ".log"
+	rotatedTimeFormat = "20060102T150405.000000000Z"
+)
+
+// Log is the signing audit log of the validator client.
+type Log struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	now      func() time.Time
+
+	lock     sync.Mutex
+	file     *os.File
+	size     int64
+	writeErr error
+}
+
+// New opens the signing audit log in the directory. The log file is rotated once it is larger than
+// maxSize bytes, and only the latest maxFiles rotated files are kept. Rotated files are never removed
+// when maxFiles is 0.
+func New(dir string, maxSize int64, maxFiles int) (*Log, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("maximum size of the signing audit log must be positive")
+	}
+	if maxFiles < 0 {
+		return nil, errors.New("maximum number of signing audit log files cannot be negative")
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create signing audit log directory %s", dir)
+	}
+	l := &Log{dir: dir, maxSize: maxSize, maxFiles: maxFiles, now: time.Now}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Start the signing audit log service. Records are written as sign requests are made.
+func (l *Log) Start() {
+	log.WithField("path", filepath.Join(l.dir, FileName)).Info("Recording all sign requests in the signing audit log")
+}
+
+// Stop closes the audit log file.
+func (l *Log) Stop() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// Status returns the error of the last failed write to the audit log, if any.
+func (l *Log) Status() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.writeErr
+}
+
+// Record appends the sign request and its outcome to the audit log. Failures to write to the log are
+// logged and reported by the status of the service, and never fail the sign request itself.
+func (l *Log) Record(req *validatorpb.SignRequest, signErr error) {
+	if signErr != nil {
+		l.record(req, OutcomeFailed, signErr)
+		return
+	}
+	l.record(req, OutcomeSigned, nil)
+}
+
+// RecordRefused appends a sign request refused by slashing protection to the audit log, with the reason
+// of the refusal. The request may have been refused before or after it was signed by the keymanager, its
+// signature is never used in the latter case.
+func (l *Log) RecordRefused(req *validatorpb.SignRequest, reason error) {
+	l.record(req, OutcomeRefused, reason)
+}
+
+func (l *Log) record(req *validatorpb.SignRequest, outcome string, reason error) {
+	if l == nil || req == nil {
+		return
+	}
+	if err := l.append(newRecord(l.now(), req, outcome, reason)); err != nil {
+		log.WithError(err).Error("Could not record sign request in the signing audit log")
+	}
+}
+
+func (l *Log) append(rec *Record) error {
+	enc, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	enc = append(enc, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return errors.New("signing audit log is closed")
+	}
+	if l.size > 0 && l.size+int64(len(enc)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			l.writeErr = err
+			return errors.Wrap(err, "could not rotate signing audit log")
+		}
+	}
+	n, err := l.file.Write(enc)
+	l.size += int64(n)
+	l.writeErr = err
+	return err
+}
+
+// open opens the audit log file for appending.
+//
+// WARNING: Caller must acquire the lock before using, unless the log is being created.
+func (l *Log) open() error {
+	f, err := os.OpenFile(filepath.Join(l.dir, FileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not open signing audit log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat signing audit log")
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// rotate renames the audit log file after the current time, opens a new one and removes the oldest
+// rotated files.
+//
+// WARNING: Caller must acquire the lock before using.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file = nil
+	rotated := filepath.Join(l.dir, rotatedFilePrefix+l.now().UTC().Format(rotatedTimeFormat)+rotatedFileSuffix)
+	if err := os.Rename(filepath.Join(l.dir, FileName), rotated); err != nil {
+		return err
+	}
+	if err := l.open(); err != nil {
+		return err
+	}
+	if l.maxFiles == 0 {
+		return nil
+	}
+	files, err := rotatedFiles(l.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > l.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// rotatedFiles returns the paths of the rotated audit log files of the directory, oldest first.
+func rotatedFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, rotatedFilePrefix) || !strings.HasSuffix(name, rotatedFileSuffix) {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,119 @@
+package signingaudit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func attestationRequest(slot types.Slot) *validatorpb.SignRequest {
+	return &validatorpb.SignRequest{
+		PublicKey:   []byte{1, 2},
+		SigningRoot: []byte{3, 4},
+		SigningSlot: slot,
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: slot}},
+	}
+}
+
+func exportRecords(t *testing.T, dir string) []*Record {
+	buf := &bytes.Buffer{}
+	count, err := Export(dir, buf)
+	require.NoError(t, err)
+	var records []*Record
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		rec := &Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), rec))
+		records = append(records, rec)
+	}
+	require.Equal(t, count, len(records))
+	return records
+}
+
+func TestLog_Record(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	l, err := New(dir, 1<<20, 0)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+
+	l.Record(attestationRequest(7), nil)
+	l.Record(&validatorpb.SignRequest{
+		PublicKey: []byte{5},
+		Object:    &validatorpb.SignRequest_Epoch{Epoch: 2},
+	}, errors.New("remote signer unavailable"))
+	l.RecordRefused(attestationRequest(7), errors.New("slashable attestation"))
+	require.NoError(t, l.Stop())
+	require.NoError(t, l.Status())
+
+	records := exportRecords(t, dir)
+	require.Equal(t, 3, len(records))
+	assert.DeepEqual(t, &Record{
+		Time:        now.UTC(),
+		Type:        "attestation",
+		Slot:        7,
+		SigningRoot: "0x0304",
+		PublicKey:   "0x0102",
+		Outcome:     OutcomeSigned,
+	}, records[0])
+	assert.Equal(t, "randao_reveal", records[1].Type)
+	assert.Equal(t, OutcomeFailed, records[1].Outcome)
+	assert.Equal(t, "remote signer unavailable", records[1].Error)
+	assert.Equal(t, OutcomeRefused, records[2].Outcome)
+	assert.Equal(t, "slashable attestation", records[2].Error)
+
+	// Records are appended to the existing log when it is opened again.
+	l, err = New(dir, 1<<20, 0)
+	require.NoError(t, err)
+	l.Record(attestationRequest(8), nil)
+	require.NoError(t, l.Stop())
+	assert.Equal(t, 4, len(exportRecords(t, dir)))
+}
+
+func TestLog_Rotation(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "audit")
+	enc, err := json.Marshal(newRecord(time.Unix(0, 0), attestationRequest(7), OutcomeSigned, nil))
+	require.NoError(t, err)
+	// Each file holds two records.
+	l, err := New(dir, int64(2*(len(enc)+1)), 2)
+	require.NoError(t, err)
+	now := time.Unix(0, 0)
+	l.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	for i := 0; i < 7; i++ {
+		l.Record(attestationRequest(7), nil)
+	}
+	require.NoError(t, l.Stop())
+
+	files, err := rotatedFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(files))
+
+	// The records of the removed rotated file are lost, the others are exported in order.
+	records := exportRecords(t, dir)
+	require.Equal(t, 5, len(records))
+	for i := 1; i < len(records); i++ {
+		assert.Equal(t, true, records[i-1].Time.Before(records[i].Time))
+	}
+	assert.Equal(t, time.Unix(3, 0).UTC(), records[0].Time)
+}
+
+func TestNew_InvalidLimits(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "audit"), 0, 1)
+	require.ErrorContains(t, "maximum size", err)
+	_, err = New(filepath.Join(t.TempDir(), "audit"), 1, -1)
+	require.ErrorContains(t, "maximum number", err)
+}
@@ -0,0 +1,64 @@
+package signingaudit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+)
+
+// Export writes the records of the signing audit log in the directory to w, as JSON lines in
+// chronological order, including the ones of the rotated files. It returns the number of exported
+// records. Every line is checked to be a valid record, so that a corrupted log is noticed on export.
+func Export(dir string, w io.Writer) (int, error) {
+	files, err := rotatedFiles(dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not list signing audit log files in %s", dir)
+	}
+	current := filepath.Join(dir, FileName)
+	if file.FileExists(current) {
+		files = append(files, current)
+	}
+	enc := json.NewEncoder(w)
+	count := 0
+	for _, path := range files {
+		n, err := exportFile(path, enc)
+		count += n
+		if err != nil {
+			return count, errors.Wrapf(err, "could not export signing audit log file %s", path)
+		}
+	}
+	return count, nil
+}
+
+func exportFile(path string, enc *json.Encoder) (int, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close signing audit log file")
+		}
+	}()
+	scanner := bufio.NewScanner(f)
+	count := 0
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return count, errors.Wrapf(err, "invalid record on line %d", line)
+		}
+		if err := enc.Encode(rec); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, scanner.Err()
+}
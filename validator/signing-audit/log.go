@@ -0,0 +1,5 @@
+package signingaudit
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "signing-audit")
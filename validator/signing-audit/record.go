@@ -0,0 +1,74 @@
+package signingaudit
+
+import (
+	"fmt"
+	"time"
+
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+)
+
+// Outcomes of the sign requests recorded in the audit log.
+const (
+	OutcomeSigned  = "signed"
+	OutcomeFailed  = "failed"
+	OutcomeRefused = "refused"
+)
+
+// Record is an entry of the signing audit log, describing a sign request of the validator client.
+type Record struct {
+	Time        time.Time  `json:"time"`
+	Type        string     `json:"type"`
+	Slot        types.Slot `json:"slot"`
+	SigningRoot string     `json:"signing_root"`
+	PublicKey   string     `json:"public_key"`
+	Outcome     string     `json:"outcome"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// newRecord returns the record of a sign request with the given outcome. The error is the reason of a failed
+// or refused request.
+func newRecord(t time.Time, req *validatorpb.SignRequest, outcome string, err error) *Record {
+	rec := &Record{
+		Time:        t.UTC(),
+		Type:        requestType(req),
+		Slot:        req.SigningSlot,
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+		PublicKey:   fmt.Sprintf("%#x", req.PublicKey),
+		Outcome:     outcome,
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	return rec
+}
+
+// requestType returns the type of the object signed by the request.
+func requestType(req *validatorpb.SignRequest) string {
+	switch req.Object.(type) {
+	case *validatorpb.SignRequest_Block, *validatorpb.SignRequest_BlockV2, *validatorpb.SignRequest_BlockV3:
+		return "block"
+	case *validatorpb.SignRequest_BlindedBlockV3:
+		return "blinded_block"
+	case *validatorpb.SignRequest_AttestationData:
+		return "attestation"
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		return "aggregate_and_proof"
+	case *validatorpb.SignRequest_Exit:
+		return "voluntary_exit"
+	case *validatorpb.SignRequest_Slot:
+		return "aggregation_slot"
+	case *validatorpb.SignRequest_Epoch:
+		return "randao_reveal"
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		return "sync_committee_selection_proof"
+	case *validatorpb.SignRequest_ContributionAndProof:
+		return "sync_committee_contribution_and_proof"
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		return "sync_committee_message"
+	case *validatorpb.SignRequest_Registration:
+		return "validator_registration"
+	default:
+		return "unknown"
+	}
+}
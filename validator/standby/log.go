@@ -0,0 +1,5 @@
+package standby
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "standby")
@@ -0,0 +1,274 @@
+package standby
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPollInterval is the interval at which a standby polls the heartbeat of the primary.
+	DefaultPollInterval = 2 * time.Second
+	// maxHeartbeatSize bounds the size of a heartbeat read from the primary.
+	maxHeartbeatSize = 1 << 24
+)
+
+// errUnauthorized is returned when the primary rejects the heartbeat secret of the standby.
+var errUnauthorized = errors.New("heartbeat secret rejected by the primary validator client")
+
+// Monitor polls the heartbeat of a primary validator client from a standby. The standby is promoted once no
+// heartbeat was received for the failover timeout, and demoted again as soon as the primary is back. A standby
+// which never received a heartbeat is never promoted, as it knows nothing of what the primary signed.
+//
+// The watermarks received from the primary are persisted to the slashing protection database of the standby
+// by raising its lowest signed proposal slot and attestation epochs, so that they are still enforced after a
+// restart of the standby.
+type Monitor struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              iface.ValidatorDB
+	url             string
+	secret          []byte
+	client          *http.Client
+	pollInterval    time.Duration
+	failoverTimeout time.Duration
+	lock            sync.RWMutex
+	lastHeartbeat   time.Time
+	promoted        bool
+	watermarks      map[[fieldparams.BLSPubkeyLength]byte]*Watermark
+}
+
+// NewMonitor returns a monitor of the heartbeat served by the primary at the given URL, polled every
+// poll interval. The watermarks received are persisted to the given slashing protection database.
+func NewMonitor(
+	ctx context.Context, db iface.ValidatorDB, url string, secret []byte, pollInterval, failoverTimeout time.Duration,
+) (*Monitor, error) {
+	if db == nil {
+		return nil, errors.New("no slashing protection database provided")
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("no heartbeat secret provided")
+	}
+	if pollInterval <= 0 || failoverTimeout <= pollInterval {
+		return nil, fmt.Errorf("failover timeout %v must be greater than the heartbeat poll interval %v", failoverTimeout, pollInterval)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Monitor{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              db,
+		url:             url + heartbeatPath,
+		secret:          secret,
+		client:          &http.Client{Timeout: pollInterval},
+		pollInterval:    pollInterval,
+		failoverTimeout: failoverTimeout,
+		watermarks:      make(map[[fieldparams.BLSPubkeyLength]byte]*Watermark),
+	}, nil
+}
+
+// Start polls the heartbeat of the primary in the background.
+func (m *Monitor) Start() {
+	log.WithFields(logrus.Fields{
+		"primary":         m.url,
+		"failoverTimeout": m.failoverTimeout,
+	}).Info("Running as a hot standby, duties are skipped while the primary validator client is alive")
+	go m.run()
+}
+
+// Stop stops polling the heartbeat.
+func (m *Monitor) Stop() error {
+	m.cancel()
+	return nil
+}
+
+// Status always returns nil, a missing heartbeat is the expected trigger of a failover.
+func (m *Monitor) Status() error {
+	return nil
+}
+
+// Active returns true if the standby was promoted and should perform the duties of its keys.
+func (m *Monitor) Active() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.promoted
+}
+
+// CheckProposal returns an error if the primary signed a proposal at or above the given slot.
+func (m *Monitor) CheckProposal(pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	w, ok := m.watermarks[pubKey]
+	if !ok || w.ProposalSlot == nil {
+		return nil
+	}
+	if slot <= *w.ProposalSlot {
+		return fmt.Errorf(
+			"could not sign block with slot <= highest slot signed by the primary validator client, %d <= %d",
+			slot,
+			*w.ProposalSlot,
+		)
+	}
+	return nil
+}
+
+// CheckAttestation returns an error if the primary signed an attestation with a target at or above the given
+// target epoch, or with a source above the given source epoch.
+func (m *Monitor) CheckAttestation(pubKey [fieldparams.BLSPubkeyLength]byte, source, target types.Epoch) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	w, ok := m.watermarks[pubKey]
+	if !ok {
+		return nil
+	}
+	if w.SourceEpoch != nil && source < *w.SourceEpoch {
+		return fmt.Errorf(
+			"could not sign attestation with source < highest source signed by the primary validator client, %d < %d",
+			source,
+			*w.SourceEpoch,
+		)
+	}
+	if w.TargetEpoch != nil && target <= *w.TargetEpoch {
+		return fmt.Errorf(
+			"could not sign attestation with target <= highest target signed by the primary validator client, %d <= %d",
+			target,
+			*w.TargetEpoch,
+		)
+	}
+	return nil
+}
+
+func (m *Monitor) run() {
+	ticker := time.NewTicker(m.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.poll()
+		}
+	}
+}
+
+// poll fetches the heartbeat of the primary and updates the promotion of the standby.
+func (m *Monitor) poll() {
+	hb, err := m.fetch()
+	switch {
+	case errors.Is(err, errUnauthorized):
+		log.WithError(err).Error("Could not authenticate to the primary validator client, check the heartbeat secret")
+	case err != nil:
+		log.WithError(err).Warn("Could not fetch heartbeat of the primary validator client")
+	}
+	var raised []*Watermark
+	if hb != nil {
+		raised = m.mergeWatermarks(hb.Watermarks)
+	}
+	// The database is written outside of the lock, so that signing checks are not blocked meanwhile.
+	m.persistWatermarks(raised)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if hb != nil {
+		m.lastHeartbeat = time.Now()
+	}
+	if m.lastHeartbeat.IsZero() {
+		// Without any heartbeat received, the standby cannot enforce the watermarks of the primary.
+		return
+	}
+	stale := time.Since(m.lastHeartbeat) > m.failoverTimeout
+	switch {
+	case stale && !m.promoted:
+		m.promoted = true
+		log.WithFields(logrus.Fields{
+			"lastHeartbeat": m.lastHeartbeat,
+			"watermarks":    len(m.watermarks),
+		}).Warn("Primary validator client heartbeat lost, promoting standby")
+	case !stale && m.promoted:
+		m.promoted = false
+		log.Error("Primary validator client heartbeat is back, demoting standby. Duties signed by the standby " +
+			"are unknown to the primary, check both slashing protection histories")
+	}
+}
+
+// mergeWatermarks merges the watermarks received from the primary into the known ones, and returns copies of
+// the watermarks which were raised.
+func (m *Monitor) mergeWatermarks(watermarks []*Watermark) []*Watermark {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var raised []*Watermark
+	for _, w := range watermarks {
+		if w == nil || len(w.PublicKey) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(w.PublicKey)
+		existing, ok := m.watermarks[pubKey]
+		if !ok {
+			existing = &Watermark{PublicKey: bytesutil.SafeCopyBytes(w.PublicKey)}
+			m.watermarks[pubKey] = existing
+		}
+		if existing.merge(w) {
+			c := *existing
+			raised = append(raised, &c)
+		}
+	}
+	return raised
+}
+
+// persistWatermarks raises the lowest signed proposal slot and attestation epochs of the slashing protection
+// database to the given watermarks. Failures are logged, the watermarks are still enforced from memory.
+func (m *Monitor) persistWatermarks(watermarks []*Watermark) {
+	for _, w := range watermarks {
+		pubKey := bytesutil.ToBytes48(w.PublicKey)
+		if w.ProposalSlot != nil {
+			if err := m.db.RaiseLowestSignedProposal(m.ctx, pubKey, *w.ProposalSlot); err != nil {
+				log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Error(
+					"Could not persist the proposal watermark of the primary validator client")
+			}
+		}
+		if w.SourceEpoch != nil && w.TargetEpoch != nil {
+			if err := m.db.RaiseLowestSignedEpochs(m.ctx, pubKey, *w.SourceEpoch, *w.TargetEpoch); err != nil {
+				log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Error(
+					"Could not persist the attestation watermark of the primary validator client")
+			}
+		}
+	}
+}
+
+func (m *Monitor) fetch() (*Heartbeat, error) {
+	req, err := http.NewRequestWithContext(m.ctx, http.MethodGet, m.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", string(bearer(m.secret)))
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close heartbeat response body")
+		}
+	}()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, errUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected heartbeat response status %d", resp.StatusCode)
+	}
+	hb := &Heartbeat{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxHeartbeatSize)).Decode(hb); err != nil {
+		return nil, errors.Wrap(err, "could not decode heartbeat")
+	}
+	return hb, nil
+}
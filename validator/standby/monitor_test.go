@@ -0,0 +1,113 @@
+package standby
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestMonitor_PromotesAndEnforcesWatermarks(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	slot := types.Slot(20)
+	source, target := types.Epoch(3), types.Epoch(4)
+	var alive int32 = 1
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if atomic.LoadInt32(&alive) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&Heartbeat{Watermarks: []*Watermark{
+			{PublicKey: pubKey[:], ProposalSlot: &slot, SourceEpoch: &source, TargetEpoch: &target},
+		}}))
+	}))
+	defer primary.Close()
+
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	m, err := NewMonitor(context.Background(), db, primary.URL, []byte("secret"), time.Millisecond, time.Hour)
+	require.NoError(t, err)
+	// The client timeout derived from the poll interval is too short for a loaded test machine.
+	m.client = &http.Client{Timeout: 10 * time.Second}
+	m.poll()
+	assert.Equal(t, false, m.Active())
+
+	require.ErrorContains(t, "highest slot signed by the primary", m.CheckProposal(pubKey, 20))
+	require.NoError(t, m.CheckProposal(pubKey, 21))
+	require.NoError(t, m.CheckProposal([fieldparams.BLSPubkeyLength]byte{2}, 1))
+	require.ErrorContains(t, "highest source signed by the primary", m.CheckAttestation(pubKey, 2, 5))
+	require.ErrorContains(t, "highest target signed by the primary", m.CheckAttestation(pubKey, 3, 4))
+	require.NoError(t, m.CheckAttestation(pubKey, 3, 5))
+
+	// The watermarks are persisted to the slashing protection database of the standby.
+	lowestSlot, exists, err := db.LowestSignedProposal(context.Background(), pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, slot, lowestSlot)
+	lowestSource, _, err := db.LowestSignedSourceEpoch(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, source, lowestSource)
+	lowestTarget, _, err := db.LowestSignedTargetEpoch(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, target, lowestTarget)
+
+	// A lower watermark served by the primary does not lower the enforced bound.
+	slot = 10
+	m.poll()
+	require.ErrorContains(t, "highest slot signed by the primary", m.CheckProposal(pubKey, 20))
+	lowestSlot, _, err = db.LowestSignedProposal(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), lowestSlot)
+
+	atomic.StoreInt32(&alive, 0)
+	m.failoverTimeout = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	m.poll()
+	assert.Equal(t, true, m.Active())
+	require.ErrorContains(t, "highest slot signed by the primary", m.CheckProposal(pubKey, 20))
+
+	atomic.StoreInt32(&alive, 1)
+	m.poll()
+	assert.Equal(t, false, m.Active())
+}
+
+func TestMonitor_NotPromotedWithoutHeartbeat(t *testing.T) {
+	hook := logTest.NewGlobal()
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer primary.Close()
+
+	db := dbtest.SetupDB(t, nil)
+	m, err := NewMonitor(context.Background(), db, primary.URL, []byte("wrong"), time.Millisecond, 2*time.Millisecond)
+	require.NoError(t, err)
+	m.client = &http.Client{Timeout: 10 * time.Second}
+	m.poll()
+	time.Sleep(5 * time.Millisecond)
+	m.poll()
+	assert.Equal(t, false, m.Active())
+	require.LogsContain(t, hook, "check the heartbeat secret")
+}
+
+func TestNewMonitor_InvalidTimeout(t *testing.T) {
+	db := dbtest.SetupDB(t, nil)
+	_, err := NewMonitor(context.Background(), db, "http://localhost", []byte("secret"), time.Second, time.Second)
+	require.ErrorContains(t, "must be greater than the heartbeat poll interval", err)
+	_, err = NewMonitor(context.Background(), db, "http://localhost", nil, time.Second, time.Minute)
+	require.ErrorContains(t, "no heartbeat secret", err)
+	_, err = NewMonitor(context.Background(), nil, "http://localhost", []byte("secret"), time.Second, time.Minute)
+	require.ErrorContains(t, "no slashing protection database", err)
+}
@@ -0,0 +1,110 @@
+package standby
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+)
+
+const (
+	heartbeatPath = "/heartbeat"
+
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// Server serves the heartbeat of a primary validator client over HTTP. Requests must carry the shared secret
+// as a bearer token.
+type Server struct {
+	db       iface.ValidatorDB
+	secret   []byte
+	server   *http.Server
+	startErr error
+}
+
+// NewServer returns a heartbeat server listening on the given address.
+func NewServer(db iface.ValidatorDB, addr string, secret []byte) (*Server, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("no heartbeat secret provided")
+	}
+	s := &Server{
+		db:     db,
+		secret: secret,
+	}
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	return s, nil
+}
+
+// Handler returns the HTTP handler of the heartbeat.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(heartbeatPath, s.handleHeartbeat)
+	return mux
+}
+
+// Start listens on the address of the server and serves heartbeats in the background.
+func (s *Server) Start() {
+	ln, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		s.startErr = errors.Wrapf(err, "could not listen on %s", s.server.Addr)
+		log.WithError(s.startErr).Error("Could not start heartbeat server")
+		return
+	}
+	log.WithField("address", ln.Addr().String()).Info("Serving heartbeat to standby validator clients")
+	go func() {
+		if err := s.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Heartbeat server stopped")
+		}
+	}()
+}
+
+// Stop shuts the server down, waiting for the pending requests to complete.
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status returns an error if the server could not start listening.
+func (s *Server) Status() error {
+	return s.startErr
+}
+
+func (s *Server) handleHeartbeat(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !authorized(r, s.secret) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	watermarks, err := Watermarks(r.Context(), s.db)
+	if err != nil {
+		log.WithError(err).Error("Could not read watermarks")
+		http.Error(w, "could not read watermarks", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&Heartbeat{Watermarks: watermarks}); err != nil {
+		log.WithError(err).Error("Could not write heartbeat")
+	}
+}
+
+func authorized(r *http.Request, secret []byte) bool {
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), bearer(secret)) == 1
+}
+
+func bearer(secret []byte) []byte {
+	return append([]byte("Bearer "), secret...)
+}
@@ -0,0 +1,63 @@
+package standby
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_Heartbeat(t *testing.T) {
+	ctx := context.Background()
+	p0 := [fieldparams.BLSPubkeyLength]byte{1}
+	p1 := [fieldparams.BLSPubkeyLength]byte{2}
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{p0, p1})
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, p0, 10, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, p0, 20, []byte{2}))
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, p1, [32]byte{1}, &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 4},
+			Target: &ethpb.Checkpoint{Epoch: 5},
+		},
+	}))
+
+	s, err := NewServer(db, "127.0.0.1:0", []byte("secret"))
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, heartbeatPath, nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, heartbeatPath, nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	hb := &Heartbeat{}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(hb))
+	require.Equal(t, 2, len(hb.Watermarks))
+	for _, w := range hb.Watermarks {
+		switch {
+		case string(w.PublicKey) == string(p0[:]):
+			require.NotNil(t, w.ProposalSlot)
+			assert.Equal(t, types.Slot(20), *w.ProposalSlot)
+			assert.Equal(t, true, w.SourceEpoch == nil)
+		case string(w.PublicKey) == string(p1[:]):
+			assert.Equal(t, true, w.ProposalSlot == nil)
+			require.NotNil(t, w.TargetEpoch)
+			assert.Equal(t, types.Epoch(4), *w.SourceEpoch)
+			assert.Equal(t, types.Epoch(5), *w.TargetEpoch)
+		default:
+			t.Fatalf("unexpected public key %#x", w.PublicKey)
+		}
+	}
+}
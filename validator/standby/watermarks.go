@@ -0,0 +1,98 @@
+// Package standby implements a heartbeat between a primary validator client and a hot standby holding the
+// same keys. The primary serves the highest slot and epochs it signed for each key, its watermarks, and the
+// standby polls them continuously. The standby performs no duties while the primary heartbeat is fresh. Once
+// the heartbeat goes stale it takes over, refusing to sign anything at or below the last watermarks received
+// from the primary, which would otherwise be missing from its own slashing protection history.
+package standby
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	types "github.com/prysmaticlabs/prysm/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/iface"
+)
+
+// Watermark holds the highest signed proposal slot and attestation epochs of a validator key. A nil field
+// means the key never signed a message of that kind.
+type Watermark struct {
+	PublicKey    hexutil.Bytes `json:"public_key"`
+	ProposalSlot *types.Slot   `json:"proposal_slot,omitempty"`
+	SourceEpoch  *types.Epoch  `json:"source_epoch,omitempty"`
+	TargetEpoch  *types.Epoch  `json:"target_epoch,omitempty"`
+}
+
+// Heartbeat is the message served by the primary validator client.
+type Heartbeat struct {
+	Watermarks []*Watermark `json:"watermarks"`
+}
+
+// Watermarks reads the watermarks of every key with a signing history from the slashing protection database.
+func Watermarks(ctx context.Context, db iface.ValidatorDB) ([]*Watermark, error) {
+	proposed, err := db.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposed public keys")
+	}
+	attested, err := db.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested public keys")
+	}
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(attested))
+	watermarks := make([]*Watermark, 0, len(attested))
+	for _, pubKey := range append(proposed, attested...) {
+		if seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		w := &Watermark{PublicKey: bytesutil.SafeCopyBytes(pubKey[:])}
+		slot, exists, err := db.HighestSignedProposal(ctx, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get highest signed proposal of %#x", pubKey)
+		}
+		if exists {
+			w.ProposalSlot = &slot
+		}
+		source, exists, err := db.HighestSignedSourceEpoch(ctx, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get highest signed source epoch of %#x", pubKey)
+		}
+		if exists {
+			w.SourceEpoch = &source
+		}
+		target, exists, err := db.HighestSignedTargetEpoch(ctx, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get highest signed target epoch of %#x", pubKey)
+		}
+		if exists {
+			w.TargetEpoch = &target
+		}
+		watermarks = append(watermarks, w)
+	}
+	return watermarks, nil
+}
+
+// merge raises the watermark to the values of another watermark of the same key, and returns true if any
+// value was raised. Watermarks never decrease, so that a primary restarted with a pruned or older database
+// cannot lower the bound enforced by the standby.
+func (w *Watermark) merge(other *Watermark) bool {
+	raised := false
+	if other.ProposalSlot != nil && (w.ProposalSlot == nil || *other.ProposalSlot > *w.ProposalSlot) {
+		slot := *other.ProposalSlot
+		w.ProposalSlot = &slot
+		raised = true
+	}
+	if other.SourceEpoch != nil && (w.SourceEpoch == nil || *other.SourceEpoch > *w.SourceEpoch) {
+		source := *other.SourceEpoch
+		w.SourceEpoch = &source
+		raised = true
+	}
+	if other.TargetEpoch != nil && (w.TargetEpoch == nil || *other.TargetEpoch > *w.TargetEpoch) {
+		target := *other.TargetEpoch
+		w.TargetEpoch = &target
+		raised = true
+	}
+	return raised
+}